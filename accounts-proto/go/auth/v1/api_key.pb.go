@@ -0,0 +1,184 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: auth/v1/api_key.proto
+
+package authv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VerifyAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // Full API key
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAPIKeyRequest) Reset() {
+	*x = VerifyAPIKeyRequest{}
+	mi := &file_auth_v1_api_key_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAPIKeyRequest) ProtoMessage() {}
+
+func (x *VerifyAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_api_key_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_api_key_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VerifyAPIKeyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type VerifyAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Scopes        []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"` // Scopes granted to the key
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAPIKeyResponse) Reset() {
+	*x = VerifyAPIKeyResponse{}
+	mi := &file_auth_v1_api_key_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAPIKeyResponse) ProtoMessage() {}
+
+func (x *VerifyAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_api_key_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_api_key_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VerifyAPIKeyResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VerifyAPIKeyResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+var File_auth_v1_api_key_proto protoreflect.FileDescriptor
+
+const file_auth_v1_api_key_proto_rawDesc = "" +
+	"\n" +
+	"\x15auth/v1/api_key.proto\x12\aauth.v1\x1a#third_party/validate/validate.proto\"3\n" +
+	"\x13VerifyAPIKeyRequest\x12\x1c\n" +
+	"\x03key\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\x03key\"Q\n" +
+	"\x14VerifyAPIKeyResponse\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes2\\\n" +
+	"\rAPIKeyService\x12K\n" +
+	"\fVerifyAPIKey\x12\x1c.auth.v1.VerifyAPIKeyRequest\x1a\x1d.auth.v1.VerifyAPIKeyResponseB;Z9github.com/mandacode-com/accounts-proto/go/auth/v1;authv1b\x06proto3"
+
+var (
+	file_auth_v1_api_key_proto_rawDescOnce sync.Once
+	file_auth_v1_api_key_proto_rawDescData []byte
+)
+
+func file_auth_v1_api_key_proto_rawDescGZIP() []byte {
+	file_auth_v1_api_key_proto_rawDescOnce.Do(func() {
+		file_auth_v1_api_key_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_auth_v1_api_key_proto_rawDesc), len(file_auth_v1_api_key_proto_rawDesc)))
+	})
+	return file_auth_v1_api_key_proto_rawDescData
+}
+
+var file_auth_v1_api_key_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_auth_v1_api_key_proto_goTypes = []any{
+	(*VerifyAPIKeyRequest)(nil),  // 0: auth.v1.VerifyAPIKeyRequest
+	(*VerifyAPIKeyResponse)(nil), // 1: auth.v1.VerifyAPIKeyResponse
+}
+var file_auth_v1_api_key_proto_depIdxs = []int32{
+	0, // 0: auth.v1.APIKeyService.VerifyAPIKey:input_type -> auth.v1.VerifyAPIKeyRequest
+	1, // 1: auth.v1.APIKeyService.VerifyAPIKey:output_type -> auth.v1.VerifyAPIKeyResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_auth_v1_api_key_proto_init() }
+func file_auth_v1_api_key_proto_init() {
+	if File_auth_v1_api_key_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_api_key_proto_rawDesc), len(file_auth_v1_api_key_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auth_v1_api_key_proto_goTypes,
+		DependencyIndexes: file_auth_v1_api_key_proto_depIdxs,
+		MessageInfos:      file_auth_v1_api_key_proto_msgTypes,
+	}.Build()
+	File_auth_v1_api_key_proto = out.File
+	file_auth_v1_api_key_proto_goTypes = nil
+	file_auth_v1_api_key_proto_depIdxs = nil
+}
@@ -0,0 +1,274 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: auth/v1/api_key.proto
+
+package authv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _api_key_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on VerifyAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyAPIKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyAPIKeyRequestMultiError, or nil if none found.
+func (m *VerifyAPIKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyAPIKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 256 {
+		err := VerifyAPIKeyRequestValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VerifyAPIKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by VerifyAPIKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyAPIKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyAPIKeyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyAPIKeyRequestMultiError) AllErrors() []error { return m }
+
+// VerifyAPIKeyRequestValidationError is the validation error returned by
+// VerifyAPIKeyRequest.Validate if the designated constraints aren't met.
+type VerifyAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyAPIKeyRequestValidationError) ErrorName() string {
+	return "VerifyAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyAPIKeyRequestValidationError{}
+
+// Validate checks the field values on VerifyAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyAPIKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyAPIKeyResponseMultiError, or nil if none found.
+func (m *VerifyAPIKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyAPIKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = VerifyAPIKeyResponseValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VerifyAPIKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *VerifyAPIKeyResponse) _validateUuid(uuid string) error {
+	if matched := _api_key_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// VerifyAPIKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by VerifyAPIKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyAPIKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyAPIKeyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyAPIKeyResponseMultiError) AllErrors() []error { return m }
+
+// VerifyAPIKeyResponseValidationError is the validation error returned by
+// VerifyAPIKeyResponse.Validate if the designated constraints aren't met.
+type VerifyAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyAPIKeyResponseValidationError) ErrorName() string {
+	return "VerifyAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyAPIKeyResponseValidationError{}
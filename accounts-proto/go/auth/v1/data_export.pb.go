@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: auth/v1/data_export.proto
+
+package authv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_auth_v1_data_export_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_data_export_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_data_export_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ExportUserDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data is the JSON document holding the data of the user
+	Data          []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	mi := &file_auth_v1_data_export_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResponse) ProtoMessage() {}
+
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_data_export_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_data_export_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportUserDataResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_auth_v1_data_export_proto protoreflect.FileDescriptor
+
+const file_auth_v1_data_export_proto_rawDesc = "" +
+	"\n" +
+	"\x19auth/v1/data_export.proto\x12\aauth.v1\x1a#third_party/validate/validate.proto\":\n" +
+	"\x15ExportUserDataRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"5\n" +
+	"\x16ExportUserDataResponse\x12\x1b\n" +
+	"\x04data\x18\x01 \x01(\fB\a\xfaB\x04z\x02\x10\x01R\x04data2f\n" +
+	"\x11DataExportService\x12Q\n" +
+	"\x0eExportUserData\x12\x1e.auth.v1.ExportUserDataRequest\x1a\x1f.auth.v1.ExportUserDataResponseB;Z9github.com/mandacode-com/accounts-proto/go/auth/v1;authv1b\x06proto3"
+
+var (
+	file_auth_v1_data_export_proto_rawDescOnce sync.Once
+	file_auth_v1_data_export_proto_rawDescData []byte
+)
+
+func file_auth_v1_data_export_proto_rawDescGZIP() []byte {
+	file_auth_v1_data_export_proto_rawDescOnce.Do(func() {
+		file_auth_v1_data_export_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_auth_v1_data_export_proto_rawDesc), len(file_auth_v1_data_export_proto_rawDesc)))
+	})
+	return file_auth_v1_data_export_proto_rawDescData
+}
+
+var file_auth_v1_data_export_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_auth_v1_data_export_proto_goTypes = []any{
+	(*ExportUserDataRequest)(nil),  // 0: auth.v1.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil), // 1: auth.v1.ExportUserDataResponse
+}
+var file_auth_v1_data_export_proto_depIdxs = []int32{
+	0, // 0: auth.v1.DataExportService.ExportUserData:input_type -> auth.v1.ExportUserDataRequest
+	1, // 1: auth.v1.DataExportService.ExportUserData:output_type -> auth.v1.ExportUserDataResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_auth_v1_data_export_proto_init() }
+func file_auth_v1_data_export_proto_init() {
+	if File_auth_v1_data_export_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_data_export_proto_rawDesc), len(file_auth_v1_data_export_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auth_v1_data_export_proto_goTypes,
+		DependencyIndexes: file_auth_v1_data_export_proto_depIdxs,
+		MessageInfos:      file_auth_v1_data_export_proto_msgTypes,
+	}.Build()
+	File_auth_v1_data_export_proto = out.File
+	file_auth_v1_data_export_proto_goTypes = nil
+	file_auth_v1_data_export_proto_depIdxs = nil
+}
@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: auth/v1/data_export.proto
+
+package authv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DataExportService_ExportUserData_FullMethodName = "/auth.v1.DataExportService/ExportUserData"
+)
+
+// DataExportServiceClient is the client API for DataExportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DataExportServiceClient interface {
+	// ExportUserData exports everything the auth service holds about a user
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
+}
+
+type dataExportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDataExportServiceClient(cc grpc.ClientConnInterface) DataExportServiceClient {
+	return &dataExportServiceClient{cc}
+}
+
+func (c *dataExportServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, DataExportService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataExportServiceServer is the server API for DataExportService service.
+// All implementations must embed UnimplementedDataExportServiceServer
+// for forward compatibility.
+type DataExportServiceServer interface {
+	// ExportUserData exports everything the auth service holds about a user
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
+	mustEmbedUnimplementedDataExportServiceServer()
+}
+
+// UnimplementedDataExportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDataExportServiceServer struct{}
+
+func (UnimplementedDataExportServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedDataExportServiceServer) mustEmbedUnimplementedDataExportServiceServer() {}
+func (UnimplementedDataExportServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeDataExportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DataExportServiceServer will
+// result in compilation errors.
+type UnsafeDataExportServiceServer interface {
+	mustEmbedUnimplementedDataExportServiceServer()
+}
+
+func RegisterDataExportServiceServer(s grpc.ServiceRegistrar, srv DataExportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDataExportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DataExportService_ServiceDesc, srv)
+}
+
+func _DataExportService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataExportServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataExportService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataExportServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DataExportService_ServiceDesc is the grpc.ServiceDesc for DataExportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DataExportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.v1.DataExportService",
+	HandlerType: (*DataExportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportUserData",
+			Handler:    _DataExportService_ExportUserData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth/v1/data_export.proto",
+}
@@ -0,0 +1,766 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: auth/v1/oauth_client.proto
+
+package authv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OAuthClient is a registered OAuth client, without its secret
+type OAuthClient struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ClientId        string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RedirectUris    []string               `protobuf:"bytes,3,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`                  // Redirect URIs of the client
+	Scopes          []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`                                                  // Scopes the client may be granted
+	GrantTypes      []string               `protobuf:"bytes,5,rep,name=grant_types,json=grantTypes,proto3" json:"grant_types,omitempty"`                        // Grant types the client may use
+	Confidential    bool                   `protobuf:"varint,6,opt,name=confidential,proto3" json:"confidential,omitempty"`                                     // Whether the client has a secret
+	Disabled        bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`                                             // Whether the client is disabled
+	SecretRotatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=secret_rotated_at,json=secretRotatedAt,proto3,oneof" json:"secret_rotated_at,omitempty"` // Timestamp when the secret was last rotated
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                           // Client creation timestamp
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                          // Client update timestamp
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OAuthClient) Reset() {
+	*x = OAuthClient{}
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthClient) ProtoMessage() {}
+
+func (x *OAuthClient) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthClient.ProtoReflect.Descriptor instead.
+func (*OAuthClient) Descriptor() ([]byte, []int) {
+	return file_auth_v1_oauth_client_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OAuthClient) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OAuthClient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OAuthClient) GetRedirectUris() []string {
+	if x != nil {
+		return x.RedirectUris
+	}
+	return nil
+}
+
+func (x *OAuthClient) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OAuthClient) GetGrantTypes() []string {
+	if x != nil {
+		return x.GrantTypes
+	}
+	return nil
+}
+
+func (x *OAuthClient) GetConfidential() bool {
+	if x != nil {
+		return x.Confidential
+	}
+	return false
+}
+
+func (x *OAuthClient) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *OAuthClient) GetSecretRotatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SecretRotatedAt
+	}
+	return nil
+}
+
+func (x *OAuthClient) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OAuthClient) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateClientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RedirectUris  []string               `protobuf:"bytes,2,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	GrantTypes    []string               `protobuf:"bytes,4,rep,name=grant_types,json=grantTypes,proto3" json:"grant_types,omitempty"`
+	Confidential  bool                   `protobuf:"varint,5,opt,name=confidential,proto3" json:"confidential,omitempty"` // Whether the client gets a secret
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClientRequest) Reset() {
+	*x = CreateClientRequest{}
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClientRequest) ProtoMessage() {}
+
+func (x *CreateClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClientRequest.ProtoReflect.Descriptor instead.
+func (*CreateClientRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_oauth_client_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateClientRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateClientRequest) GetRedirectUris() []string {
+	if x != nil {
+		return x.RedirectUris
+	}
+	return nil
+}
+
+func (x *CreateClientRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateClientRequest) GetGrantTypes() []string {
+	if x != nil {
+		return x.GrantTypes
+	}
+	return nil
+}
+
+func (x *CreateClientRequest) GetConfidential() bool {
+	if x != nil {
+		return x.Confidential
+	}
+	return false
+}
+
+type CreateClientResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Client        *OAuthClient           `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Secret        *string                `protobuf:"bytes,2,opt,name=secret,proto3,oneof" json:"secret,omitempty"` // Plain text secret of a confidential client, which is not stored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClientResponse) Reset() {
+	*x = CreateClientResponse{}
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClientResponse) ProtoMessage() {}
+
+func (x *CreateClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClientResponse.ProtoReflect.Descriptor instead.
+func (*CreateClientResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_oauth_client_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateClientResponse) GetClient() *OAuthClient {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *CreateClientResponse) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+type GetClientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClientRequest) Reset() {
+	*x = GetClientRequest{}
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientRequest) ProtoMessage() {}
+
+func (x *GetClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientRequest.ProtoReflect.Descriptor instead.
+func (*GetClientRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_oauth_client_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetClientRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type GetClientResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Client        *OAuthClient           `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClientResponse) Reset() {
+	*x = GetClientResponse{}
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientResponse) ProtoMessage() {}
+
+func (x *GetClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientResponse.ProtoReflect.Descriptor instead.
+func (*GetClientResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_oauth_client_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetClientResponse) GetClient() *OAuthClient {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+type RotateClientSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateClientSecretRequest) Reset() {
+	*x = RotateClientSecretRequest{}
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateClientSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateClientSecretRequest) ProtoMessage() {}
+
+func (x *RotateClientSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateClientSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateClientSecretRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_oauth_client_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RotateClientSecretRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type RotateClientSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Client        *OAuthClient           `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // New plain text secret, which is not stored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateClientSecretResponse) Reset() {
+	*x = RotateClientSecretResponse{}
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateClientSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateClientSecretResponse) ProtoMessage() {}
+
+func (x *RotateClientSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateClientSecretResponse.ProtoReflect.Descriptor instead.
+func (*RotateClientSecretResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_oauth_client_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RotateClientSecretResponse) GetClient() *OAuthClient {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *RotateClientSecretResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type DisableClientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableClientRequest) Reset() {
+	*x = DisableClientRequest{}
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableClientRequest) ProtoMessage() {}
+
+func (x *DisableClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableClientRequest.ProtoReflect.Descriptor instead.
+func (*DisableClientRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_oauth_client_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DisableClientRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type DisableClientResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Client        *OAuthClient           `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableClientResponse) Reset() {
+	*x = DisableClientResponse{}
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableClientResponse) ProtoMessage() {}
+
+func (x *DisableClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableClientResponse.ProtoReflect.Descriptor instead.
+func (*DisableClientResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_oauth_client_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DisableClientResponse) GetClient() *OAuthClient {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+type EnableClientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnableClientRequest) Reset() {
+	*x = EnableClientRequest{}
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableClientRequest) ProtoMessage() {}
+
+func (x *EnableClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableClientRequest.ProtoReflect.Descriptor instead.
+func (*EnableClientRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_oauth_client_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EnableClientRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type EnableClientResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Client        *OAuthClient           `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnableClientResponse) Reset() {
+	*x = EnableClientResponse{}
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableClientResponse) ProtoMessage() {}
+
+func (x *EnableClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_oauth_client_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableClientResponse.ProtoReflect.Descriptor instead.
+func (*EnableClientResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_oauth_client_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EnableClientResponse) GetClient() *OAuthClient {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+var File_auth_v1_oauth_client_proto protoreflect.FileDescriptor
+
+const file_auth_v1_oauth_client_proto_rawDesc = "" +
+	"\n" +
+	"\x1aauth/v1/oauth_client.proto\x12\aauth.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a#third_party/validate/validate.proto\"\xc7\x03\n" +
+	"\vOAuthClient\x12$\n" +
+	"\tclient_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bclientId\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12#\n" +
+	"\rredirect_uris\x18\x03 \x03(\tR\fredirectUris\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x1f\n" +
+	"\vgrant_types\x18\x05 \x03(\tR\n" +
+	"grantTypes\x12\"\n" +
+	"\fconfidential\x18\x06 \x01(\bR\fconfidential\x12\x1a\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabled\x12K\n" +
+	"\x11secret_rotated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x0fsecretRotatedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x14\n" +
+	"\x12_secret_rotated_at\"\xbe\x02\n" +
+	"\x13CreateClientRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x04name\x122\n" +
+	"\rredirect_uris\x18\x02 \x03(\tB\r\xfaB\n" +
+	"\x92\x01\a\"\x05r\x03\x88\x01\x01R\fredirectUris\x12)\n" +
+	"\x06scopes\x18\x03 \x03(\tB\x11\xfaB\x0e\x92\x01\v\b\x01\"\ar\x05\x10\x01\x18\xff\x01R\x06scopes\x12\x83\x01\n" +
+	"\vgrant_types\x18\x04 \x03(\tBb\xfaB_\x92\x01\\\b\x01\"XrVR\x12authorization_codeR\x12client_credentialsR,urn:ietf:params:oauth:grant-type:device_codeR\n" +
+	"grantTypes\x12\"\n" +
+	"\fconfidential\x18\x05 \x01(\bR\fconfidential\"v\n" +
+	"\x14CreateClientResponse\x126\n" +
+	"\x06client\x18\x01 \x01(\v2\x14.auth.v1.OAuthClientB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06client\x12\x1b\n" +
+	"\x06secret\x18\x02 \x01(\tH\x00R\x06secret\x88\x01\x01B\t\n" +
+	"\a_secret\"8\n" +
+	"\x10GetClientRequest\x12$\n" +
+	"\tclient_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bclientId\"K\n" +
+	"\x11GetClientResponse\x126\n" +
+	"\x06client\x18\x01 \x01(\v2\x14.auth.v1.OAuthClientB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06client\"A\n" +
+	"\x19RotateClientSecretRequest\x12$\n" +
+	"\tclient_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bclientId\"u\n" +
+	"\x1aRotateClientSecretResponse\x126\n" +
+	"\x06client\x18\x01 \x01(\v2\x14.auth.v1.OAuthClientB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06client\x12\x1f\n" +
+	"\x06secret\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06secret\"<\n" +
+	"\x14DisableClientRequest\x12$\n" +
+	"\tclient_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bclientId\"O\n" +
+	"\x15DisableClientResponse\x126\n" +
+	"\x06client\x18\x01 \x01(\v2\x14.auth.v1.OAuthClientB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06client\";\n" +
+	"\x13EnableClientRequest\x12$\n" +
+	"\tclient_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bclientId\"N\n" +
+	"\x14EnableClientResponse\x126\n" +
+	"\x06client\x18\x01 \x01(\v2\x14.auth.v1.OAuthClientB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06client2\xa6\x03\n" +
+	"\x17OAuthClientAdminService\x12K\n" +
+	"\fCreateClient\x12\x1c.auth.v1.CreateClientRequest\x1a\x1d.auth.v1.CreateClientResponse\x12B\n" +
+	"\tGetClient\x12\x19.auth.v1.GetClientRequest\x1a\x1a.auth.v1.GetClientResponse\x12]\n" +
+	"\x12RotateClientSecret\x12\".auth.v1.RotateClientSecretRequest\x1a#.auth.v1.RotateClientSecretResponse\x12N\n" +
+	"\rDisableClient\x12\x1d.auth.v1.DisableClientRequest\x1a\x1e.auth.v1.DisableClientResponse\x12K\n" +
+	"\fEnableClient\x12\x1c.auth.v1.EnableClientRequest\x1a\x1d.auth.v1.EnableClientResponseB;Z9github.com/mandacode-com/accounts-proto/go/auth/v1;authv1b\x06proto3"
+
+var (
+	file_auth_v1_oauth_client_proto_rawDescOnce sync.Once
+	file_auth_v1_oauth_client_proto_rawDescData []byte
+)
+
+func file_auth_v1_oauth_client_proto_rawDescGZIP() []byte {
+	file_auth_v1_oauth_client_proto_rawDescOnce.Do(func() {
+		file_auth_v1_oauth_client_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_auth_v1_oauth_client_proto_rawDesc), len(file_auth_v1_oauth_client_proto_rawDesc)))
+	})
+	return file_auth_v1_oauth_client_proto_rawDescData
+}
+
+var file_auth_v1_oauth_client_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_auth_v1_oauth_client_proto_goTypes = []any{
+	(*OAuthClient)(nil),                // 0: auth.v1.OAuthClient
+	(*CreateClientRequest)(nil),        // 1: auth.v1.CreateClientRequest
+	(*CreateClientResponse)(nil),       // 2: auth.v1.CreateClientResponse
+	(*GetClientRequest)(nil),           // 3: auth.v1.GetClientRequest
+	(*GetClientResponse)(nil),          // 4: auth.v1.GetClientResponse
+	(*RotateClientSecretRequest)(nil),  // 5: auth.v1.RotateClientSecretRequest
+	(*RotateClientSecretResponse)(nil), // 6: auth.v1.RotateClientSecretResponse
+	(*DisableClientRequest)(nil),       // 7: auth.v1.DisableClientRequest
+	(*DisableClientResponse)(nil),      // 8: auth.v1.DisableClientResponse
+	(*EnableClientRequest)(nil),        // 9: auth.v1.EnableClientRequest
+	(*EnableClientResponse)(nil),       // 10: auth.v1.EnableClientResponse
+	(*timestamppb.Timestamp)(nil),      // 11: google.protobuf.Timestamp
+}
+var file_auth_v1_oauth_client_proto_depIdxs = []int32{
+	11, // 0: auth.v1.OAuthClient.secret_rotated_at:type_name -> google.protobuf.Timestamp
+	11, // 1: auth.v1.OAuthClient.created_at:type_name -> google.protobuf.Timestamp
+	11, // 2: auth.v1.OAuthClient.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: auth.v1.CreateClientResponse.client:type_name -> auth.v1.OAuthClient
+	0,  // 4: auth.v1.GetClientResponse.client:type_name -> auth.v1.OAuthClient
+	0,  // 5: auth.v1.RotateClientSecretResponse.client:type_name -> auth.v1.OAuthClient
+	0,  // 6: auth.v1.DisableClientResponse.client:type_name -> auth.v1.OAuthClient
+	0,  // 7: auth.v1.EnableClientResponse.client:type_name -> auth.v1.OAuthClient
+	1,  // 8: auth.v1.OAuthClientAdminService.CreateClient:input_type -> auth.v1.CreateClientRequest
+	3,  // 9: auth.v1.OAuthClientAdminService.GetClient:input_type -> auth.v1.GetClientRequest
+	5,  // 10: auth.v1.OAuthClientAdminService.RotateClientSecret:input_type -> auth.v1.RotateClientSecretRequest
+	7,  // 11: auth.v1.OAuthClientAdminService.DisableClient:input_type -> auth.v1.DisableClientRequest
+	9,  // 12: auth.v1.OAuthClientAdminService.EnableClient:input_type -> auth.v1.EnableClientRequest
+	2,  // 13: auth.v1.OAuthClientAdminService.CreateClient:output_type -> auth.v1.CreateClientResponse
+	4,  // 14: auth.v1.OAuthClientAdminService.GetClient:output_type -> auth.v1.GetClientResponse
+	6,  // 15: auth.v1.OAuthClientAdminService.RotateClientSecret:output_type -> auth.v1.RotateClientSecretResponse
+	8,  // 16: auth.v1.OAuthClientAdminService.DisableClient:output_type -> auth.v1.DisableClientResponse
+	10, // 17: auth.v1.OAuthClientAdminService.EnableClient:output_type -> auth.v1.EnableClientResponse
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_auth_v1_oauth_client_proto_init() }
+func file_auth_v1_oauth_client_proto_init() {
+	if File_auth_v1_oauth_client_proto != nil {
+		return
+	}
+	file_auth_v1_oauth_client_proto_msgTypes[0].OneofWrappers = []any{}
+	file_auth_v1_oauth_client_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_oauth_client_proto_rawDesc), len(file_auth_v1_oauth_client_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auth_v1_oauth_client_proto_goTypes,
+		DependencyIndexes: file_auth_v1_oauth_client_proto_depIdxs,
+		MessageInfos:      file_auth_v1_oauth_client_proto_msgTypes,
+	}.Build()
+	File_auth_v1_oauth_client_proto = out.File
+	file_auth_v1_oauth_client_proto_goTypes = nil
+	file_auth_v1_oauth_client_proto_depIdxs = nil
+}
@@ -0,0 +1,1628 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: auth/v1/oauth_client.proto
+
+package authv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on OAuthClient with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OAuthClient) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OAuthClient with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OAuthClientMultiError, or
+// nil if none found.
+func (m *OAuthClient) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OAuthClient) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClientId()) < 1 {
+		err := OAuthClientValidationError{
+			field:  "ClientId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := OAuthClientValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Confidential
+
+	// no validation rules for Disabled
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OAuthClientValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OAuthClientValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OAuthClientValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OAuthClientValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OAuthClientValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OAuthClientValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.SecretRotatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetSecretRotatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OAuthClientValidationError{
+						field:  "SecretRotatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OAuthClientValidationError{
+						field:  "SecretRotatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSecretRotatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OAuthClientValidationError{
+					field:  "SecretRotatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OAuthClientMultiError(errors)
+	}
+
+	return nil
+}
+
+// OAuthClientMultiError is an error wrapping multiple validation errors
+// returned by OAuthClient.ValidateAll() if the designated constraints aren't met.
+type OAuthClientMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OAuthClientMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OAuthClientMultiError) AllErrors() []error { return m }
+
+// OAuthClientValidationError is the validation error returned by
+// OAuthClient.Validate if the designated constraints aren't met.
+type OAuthClientValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OAuthClientValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OAuthClientValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OAuthClientValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OAuthClientValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OAuthClientValidationError) ErrorName() string { return "OAuthClientValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OAuthClientValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOAuthClient.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OAuthClientValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OAuthClientValidationError{}
+
+// Validate checks the field values on CreateClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateClientRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateClientRequestMultiError, or nil if none found.
+func (m *CreateClientRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateClientRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := CreateClientRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRedirectUris() {
+		_, _ = idx, item
+
+		if uri, err := url.Parse(item); err != nil {
+			err = CreateClientRequestValidationError{
+				field:  fmt.Sprintf("RedirectUris[%v]", idx),
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := CreateClientRequestValidationError{
+				field:  fmt.Sprintf("RedirectUris[%v]", idx),
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetScopes()) < 1 {
+		err := CreateClientRequestValidationError{
+			field:  "Scopes",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 255 {
+			err := CreateClientRequestValidationError{
+				field:  fmt.Sprintf("Scopes[%v]", idx),
+				reason: "value length must be between 1 and 255 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetGrantTypes()) < 1 {
+		err := CreateClientRequestValidationError{
+			field:  "GrantTypes",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetGrantTypes() {
+		_, _ = idx, item
+
+		if _, ok := _CreateClientRequest_GrantTypes_InLookup[item]; !ok {
+			err := CreateClientRequestValidationError{
+				field:  fmt.Sprintf("GrantTypes[%v]", idx),
+				reason: "value must be in list [authorization_code client_credentials urn:ietf:params:oauth:grant-type:device_code]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Confidential
+
+	if len(errors) > 0 {
+		return CreateClientRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateClientRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateClientRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateClientRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateClientRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateClientRequestMultiError) AllErrors() []error { return m }
+
+// CreateClientRequestValidationError is the validation error returned by
+// CreateClientRequest.Validate if the designated constraints aren't met.
+type CreateClientRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateClientRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateClientRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateClientRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateClientRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateClientRequestValidationError) ErrorName() string {
+	return "CreateClientRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateClientRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateClientRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateClientRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateClientRequestValidationError{}
+
+var _CreateClientRequest_GrantTypes_InLookup = map[string]struct{}{
+	"authorization_code":                           {},
+	"client_credentials":                           {},
+	"urn:ietf:params:oauth:grant-type:device_code": {},
+}
+
+// Validate checks the field values on CreateClientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateClientResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateClientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateClientResponseMultiError, or nil if none found.
+func (m *CreateClientResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateClientResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetClient() == nil {
+		err := CreateClientResponseValidationError{
+			field:  "Client",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetClient()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateClientResponseValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateClientResponseValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClient()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateClientResponseValidationError{
+				field:  "Client",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Secret != nil {
+		// no validation rules for Secret
+	}
+
+	if len(errors) > 0 {
+		return CreateClientResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateClientResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateClientResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateClientResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateClientResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateClientResponseMultiError) AllErrors() []error { return m }
+
+// CreateClientResponseValidationError is the validation error returned by
+// CreateClientResponse.Validate if the designated constraints aren't met.
+type CreateClientResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateClientResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateClientResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateClientResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateClientResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateClientResponseValidationError) ErrorName() string {
+	return "CreateClientResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateClientResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateClientResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateClientResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateClientResponseValidationError{}
+
+// Validate checks the field values on GetClientRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetClientRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClientRequestMultiError, or nil if none found.
+func (m *GetClientRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClientRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClientId()) < 1 {
+		err := GetClientRequestValidationError{
+			field:  "ClientId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetClientRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClientRequestMultiError is an error wrapping multiple validation errors
+// returned by GetClientRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetClientRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClientRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClientRequestMultiError) AllErrors() []error { return m }
+
+// GetClientRequestValidationError is the validation error returned by
+// GetClientRequest.Validate if the designated constraints aren't met.
+type GetClientRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClientRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClientRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClientRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClientRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClientRequestValidationError) ErrorName() string { return "GetClientRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetClientRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClientRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClientRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClientRequestValidationError{}
+
+// Validate checks the field values on GetClientResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetClientResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClientResponseMultiError, or nil if none found.
+func (m *GetClientResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClientResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetClient() == nil {
+		err := GetClientResponseValidationError{
+			field:  "Client",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetClient()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetClientResponseValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetClientResponseValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClient()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetClientResponseValidationError{
+				field:  "Client",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetClientResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClientResponseMultiError is an error wrapping multiple validation errors
+// returned by GetClientResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetClientResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClientResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClientResponseMultiError) AllErrors() []error { return m }
+
+// GetClientResponseValidationError is the validation error returned by
+// GetClientResponse.Validate if the designated constraints aren't met.
+type GetClientResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClientResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClientResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClientResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClientResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClientResponseValidationError) ErrorName() string {
+	return "GetClientResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetClientResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClientResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClientResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClientResponseValidationError{}
+
+// Validate checks the field values on RotateClientSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateClientSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateClientSecretRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateClientSecretRequestMultiError, or nil if none found.
+func (m *RotateClientSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateClientSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClientId()) < 1 {
+		err := RotateClientSecretRequestValidationError{
+			field:  "ClientId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RotateClientSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateClientSecretRequestMultiError is an error wrapping multiple validation
+// errors returned by RotateClientSecretRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RotateClientSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateClientSecretRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateClientSecretRequestMultiError) AllErrors() []error { return m }
+
+// RotateClientSecretRequestValidationError is the validation error returned by
+// RotateClientSecretRequest.Validate if the designated constraints aren't met.
+type RotateClientSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateClientSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateClientSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateClientSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateClientSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateClientSecretRequestValidationError) ErrorName() string {
+	return "RotateClientSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateClientSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateClientSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateClientSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateClientSecretRequestValidationError{}
+
+// Validate checks the field values on RotateClientSecretResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateClientSecretResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateClientSecretResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateClientSecretResponseMultiError, or nil if none found.
+func (m *RotateClientSecretResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateClientSecretResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetClient() == nil {
+		err := RotateClientSecretResponseValidationError{
+			field:  "Client",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetClient()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RotateClientSecretResponseValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RotateClientSecretResponseValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClient()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RotateClientSecretResponseValidationError{
+				field:  "Client",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetSecret()) < 1 {
+		err := RotateClientSecretResponseValidationError{
+			field:  "Secret",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RotateClientSecretResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateClientSecretResponseMultiError is an error wrapping multiple
+// validation errors returned by RotateClientSecretResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RotateClientSecretResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateClientSecretResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateClientSecretResponseMultiError) AllErrors() []error { return m }
+
+// RotateClientSecretResponseValidationError is the validation error returned
+// by RotateClientSecretResponse.Validate if the designated constraints aren't met.
+type RotateClientSecretResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateClientSecretResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateClientSecretResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateClientSecretResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateClientSecretResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateClientSecretResponseValidationError) ErrorName() string {
+	return "RotateClientSecretResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateClientSecretResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateClientSecretResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateClientSecretResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateClientSecretResponseValidationError{}
+
+// Validate checks the field values on DisableClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableClientRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableClientRequestMultiError, or nil if none found.
+func (m *DisableClientRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableClientRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClientId()) < 1 {
+		err := DisableClientRequestValidationError{
+			field:  "ClientId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DisableClientRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableClientRequestMultiError is an error wrapping multiple validation
+// errors returned by DisableClientRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DisableClientRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableClientRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableClientRequestMultiError) AllErrors() []error { return m }
+
+// DisableClientRequestValidationError is the validation error returned by
+// DisableClientRequest.Validate if the designated constraints aren't met.
+type DisableClientRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableClientRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableClientRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableClientRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableClientRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableClientRequestValidationError) ErrorName() string {
+	return "DisableClientRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableClientRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableClientRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableClientRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableClientRequestValidationError{}
+
+// Validate checks the field values on DisableClientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableClientResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableClientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableClientResponseMultiError, or nil if none found.
+func (m *DisableClientResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableClientResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetClient() == nil {
+		err := DisableClientResponseValidationError{
+			field:  "Client",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetClient()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DisableClientResponseValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DisableClientResponseValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClient()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DisableClientResponseValidationError{
+				field:  "Client",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DisableClientResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableClientResponseMultiError is an error wrapping multiple validation
+// errors returned by DisableClientResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DisableClientResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableClientResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableClientResponseMultiError) AllErrors() []error { return m }
+
+// DisableClientResponseValidationError is the validation error returned by
+// DisableClientResponse.Validate if the designated constraints aren't met.
+type DisableClientResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableClientResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableClientResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableClientResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableClientResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableClientResponseValidationError) ErrorName() string {
+	return "DisableClientResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableClientResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableClientResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableClientResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableClientResponseValidationError{}
+
+// Validate checks the field values on EnableClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnableClientRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnableClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnableClientRequestMultiError, or nil if none found.
+func (m *EnableClientRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnableClientRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClientId()) < 1 {
+		err := EnableClientRequestValidationError{
+			field:  "ClientId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EnableClientRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnableClientRequestMultiError is an error wrapping multiple validation
+// errors returned by EnableClientRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EnableClientRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnableClientRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnableClientRequestMultiError) AllErrors() []error { return m }
+
+// EnableClientRequestValidationError is the validation error returned by
+// EnableClientRequest.Validate if the designated constraints aren't met.
+type EnableClientRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnableClientRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnableClientRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnableClientRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnableClientRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnableClientRequestValidationError) ErrorName() string {
+	return "EnableClientRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnableClientRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnableClientRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnableClientRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnableClientRequestValidationError{}
+
+// Validate checks the field values on EnableClientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnableClientResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnableClientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnableClientResponseMultiError, or nil if none found.
+func (m *EnableClientResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnableClientResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetClient() == nil {
+		err := EnableClientResponseValidationError{
+			field:  "Client",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetClient()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EnableClientResponseValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EnableClientResponseValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClient()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EnableClientResponseValidationError{
+				field:  "Client",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EnableClientResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnableClientResponseMultiError is an error wrapping multiple validation
+// errors returned by EnableClientResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EnableClientResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnableClientResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnableClientResponseMultiError) AllErrors() []error { return m }
+
+// EnableClientResponseValidationError is the validation error returned by
+// EnableClientResponse.Validate if the designated constraints aren't met.
+type EnableClientResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnableClientResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnableClientResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnableClientResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnableClientResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnableClientResponseValidationError) ErrorName() string {
+	return "EnableClientResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnableClientResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnableClientResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnableClientResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnableClientResponseValidationError{}
@@ -0,0 +1,286 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: auth/v1/oauth_client.proto
+
+package authv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OAuthClientAdminService_CreateClient_FullMethodName       = "/auth.v1.OAuthClientAdminService/CreateClient"
+	OAuthClientAdminService_GetClient_FullMethodName          = "/auth.v1.OAuthClientAdminService/GetClient"
+	OAuthClientAdminService_RotateClientSecret_FullMethodName = "/auth.v1.OAuthClientAdminService/RotateClientSecret"
+	OAuthClientAdminService_DisableClient_FullMethodName      = "/auth.v1.OAuthClientAdminService/DisableClient"
+	OAuthClientAdminService_EnableClient_FullMethodName       = "/auth.v1.OAuthClientAdminService/EnableClient"
+)
+
+// OAuthClientAdminServiceClient is the client API for OAuthClientAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OAuthClientAdminServiceClient interface {
+	// CreateClient registers a new OAuth client with a generated client ID
+	CreateClient(ctx context.Context, in *CreateClientRequest, opts ...grpc.CallOption) (*CreateClientResponse, error)
+	// GetClient retrieves a registered OAuth client by client ID
+	GetClient(ctx context.Context, in *GetClientRequest, opts ...grpc.CallOption) (*GetClientResponse, error)
+	// RotateClientSecret generates a new secret for a confidential client,
+	// invalidating the previous one
+	RotateClientSecret(ctx context.Context, in *RotateClientSecretRequest, opts ...grpc.CallOption) (*RotateClientSecretResponse, error)
+	// DisableClient disables a client so that it can no longer obtain tokens
+	DisableClient(ctx context.Context, in *DisableClientRequest, opts ...grpc.CallOption) (*DisableClientResponse, error)
+	// EnableClient re-enables a disabled client
+	EnableClient(ctx context.Context, in *EnableClientRequest, opts ...grpc.CallOption) (*EnableClientResponse, error)
+}
+
+type oAuthClientAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOAuthClientAdminServiceClient(cc grpc.ClientConnInterface) OAuthClientAdminServiceClient {
+	return &oAuthClientAdminServiceClient{cc}
+}
+
+func (c *oAuthClientAdminServiceClient) CreateClient(ctx context.Context, in *CreateClientRequest, opts ...grpc.CallOption) (*CreateClientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateClientResponse)
+	err := c.cc.Invoke(ctx, OAuthClientAdminService_CreateClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthClientAdminServiceClient) GetClient(ctx context.Context, in *GetClientRequest, opts ...grpc.CallOption) (*GetClientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClientResponse)
+	err := c.cc.Invoke(ctx, OAuthClientAdminService_GetClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthClientAdminServiceClient) RotateClientSecret(ctx context.Context, in *RotateClientSecretRequest, opts ...grpc.CallOption) (*RotateClientSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateClientSecretResponse)
+	err := c.cc.Invoke(ctx, OAuthClientAdminService_RotateClientSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthClientAdminServiceClient) DisableClient(ctx context.Context, in *DisableClientRequest, opts ...grpc.CallOption) (*DisableClientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableClientResponse)
+	err := c.cc.Invoke(ctx, OAuthClientAdminService_DisableClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthClientAdminServiceClient) EnableClient(ctx context.Context, in *EnableClientRequest, opts ...grpc.CallOption) (*EnableClientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnableClientResponse)
+	err := c.cc.Invoke(ctx, OAuthClientAdminService_EnableClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OAuthClientAdminServiceServer is the server API for OAuthClientAdminService service.
+// All implementations must embed UnimplementedOAuthClientAdminServiceServer
+// for forward compatibility.
+type OAuthClientAdminServiceServer interface {
+	// CreateClient registers a new OAuth client with a generated client ID
+	CreateClient(context.Context, *CreateClientRequest) (*CreateClientResponse, error)
+	// GetClient retrieves a registered OAuth client by client ID
+	GetClient(context.Context, *GetClientRequest) (*GetClientResponse, error)
+	// RotateClientSecret generates a new secret for a confidential client,
+	// invalidating the previous one
+	RotateClientSecret(context.Context, *RotateClientSecretRequest) (*RotateClientSecretResponse, error)
+	// DisableClient disables a client so that it can no longer obtain tokens
+	DisableClient(context.Context, *DisableClientRequest) (*DisableClientResponse, error)
+	// EnableClient re-enables a disabled client
+	EnableClient(context.Context, *EnableClientRequest) (*EnableClientResponse, error)
+	mustEmbedUnimplementedOAuthClientAdminServiceServer()
+}
+
+// UnimplementedOAuthClientAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOAuthClientAdminServiceServer struct{}
+
+func (UnimplementedOAuthClientAdminServiceServer) CreateClient(context.Context, *CreateClientRequest) (*CreateClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClient not implemented")
+}
+func (UnimplementedOAuthClientAdminServiceServer) GetClient(context.Context, *GetClientRequest) (*GetClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClient not implemented")
+}
+func (UnimplementedOAuthClientAdminServiceServer) RotateClientSecret(context.Context, *RotateClientSecretRequest) (*RotateClientSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateClientSecret not implemented")
+}
+func (UnimplementedOAuthClientAdminServiceServer) DisableClient(context.Context, *DisableClientRequest) (*DisableClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableClient not implemented")
+}
+func (UnimplementedOAuthClientAdminServiceServer) EnableClient(context.Context, *EnableClientRequest) (*EnableClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableClient not implemented")
+}
+func (UnimplementedOAuthClientAdminServiceServer) mustEmbedUnimplementedOAuthClientAdminServiceServer() {
+}
+func (UnimplementedOAuthClientAdminServiceServer) testEmbeddedByValue() {}
+
+// UnsafeOAuthClientAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OAuthClientAdminServiceServer will
+// result in compilation errors.
+type UnsafeOAuthClientAdminServiceServer interface {
+	mustEmbedUnimplementedOAuthClientAdminServiceServer()
+}
+
+func RegisterOAuthClientAdminServiceServer(s grpc.ServiceRegistrar, srv OAuthClientAdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOAuthClientAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OAuthClientAdminService_ServiceDesc, srv)
+}
+
+func _OAuthClientAdminService_CreateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientAdminServiceServer).CreateClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthClientAdminService_CreateClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientAdminServiceServer).CreateClient(ctx, req.(*CreateClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthClientAdminService_GetClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientAdminServiceServer).GetClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthClientAdminService_GetClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientAdminServiceServer).GetClient(ctx, req.(*GetClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthClientAdminService_RotateClientSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateClientSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientAdminServiceServer).RotateClientSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthClientAdminService_RotateClientSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientAdminServiceServer).RotateClientSecret(ctx, req.(*RotateClientSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthClientAdminService_DisableClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientAdminServiceServer).DisableClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthClientAdminService_DisableClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientAdminServiceServer).DisableClient(ctx, req.(*DisableClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthClientAdminService_EnableClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientAdminServiceServer).EnableClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthClientAdminService_EnableClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientAdminServiceServer).EnableClient(ctx, req.(*EnableClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OAuthClientAdminService_ServiceDesc is the grpc.ServiceDesc for OAuthClientAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OAuthClientAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.v1.OAuthClientAdminService",
+	HandlerType: (*OAuthClientAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateClient",
+			Handler:    _OAuthClientAdminService_CreateClient_Handler,
+		},
+		{
+			MethodName: "GetClient",
+			Handler:    _OAuthClientAdminService_GetClient_Handler,
+		},
+		{
+			MethodName: "RotateClientSecret",
+			Handler:    _OAuthClientAdminService_RotateClientSecret_Handler,
+		},
+		{
+			MethodName: "DisableClient",
+			Handler:    _OAuthClientAdminService_DisableClient_Handler,
+		},
+		{
+			MethodName: "EnableClient",
+			Handler:    _OAuthClientAdminService_EnableClient_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth/v1/oauth_client.proto",
+}
@@ -0,0 +1,158 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: mailer/v1/account_deletion_scheduled.proto
+
+package mailerv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AccountDeletionScheduledEvent tells a user their account will be deleted,
+// with a link cancelling the deletion
+type AccountDeletionScheduledEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	CancelLink    string                 `protobuf:"bytes,2,opt,name=cancel_link,json=cancelLink,proto3" json:"cancel_link,omitempty"`
+	DeleteAfter   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=delete_after,json=deleteAfter,proto3" json:"delete_after,omitempty"`
+	EventTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountDeletionScheduledEvent) Reset() {
+	*x = AccountDeletionScheduledEvent{}
+	mi := &file_mailer_v1_account_deletion_scheduled_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountDeletionScheduledEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountDeletionScheduledEvent) ProtoMessage() {}
+
+func (x *AccountDeletionScheduledEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mailer_v1_account_deletion_scheduled_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountDeletionScheduledEvent.ProtoReflect.Descriptor instead.
+func (*AccountDeletionScheduledEvent) Descriptor() ([]byte, []int) {
+	return file_mailer_v1_account_deletion_scheduled_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccountDeletionScheduledEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AccountDeletionScheduledEvent) GetCancelLink() string {
+	if x != nil {
+		return x.CancelLink
+	}
+	return ""
+}
+
+func (x *AccountDeletionScheduledEvent) GetDeleteAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeleteAfter
+	}
+	return nil
+}
+
+func (x *AccountDeletionScheduledEvent) GetEventTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+var File_mailer_v1_account_deletion_scheduled_proto protoreflect.FileDescriptor
+
+const file_mailer_v1_account_deletion_scheduled_proto_rawDesc = "" +
+	"\n" +
+	"*mailer/v1/account_deletion_scheduled.proto\x12\tmailer.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a#third_party/validate/validate.proto\"\xed\x01\n" +
+	"\x1dAccountDeletionScheduledEvent\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12)\n" +
+	"\vcancel_link\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\n" +
+	"cancelLink\x12G\n" +
+	"\fdelete_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\vdeleteAfter\x129\n" +
+	"\n" +
+	"event_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\teventTimeB?Z=github.com/mandacode-com/accounts-proto/go/mailer/v1;mailerv1b\x06proto3"
+
+var (
+	file_mailer_v1_account_deletion_scheduled_proto_rawDescOnce sync.Once
+	file_mailer_v1_account_deletion_scheduled_proto_rawDescData []byte
+)
+
+func file_mailer_v1_account_deletion_scheduled_proto_rawDescGZIP() []byte {
+	file_mailer_v1_account_deletion_scheduled_proto_rawDescOnce.Do(func() {
+		file_mailer_v1_account_deletion_scheduled_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mailer_v1_account_deletion_scheduled_proto_rawDesc), len(file_mailer_v1_account_deletion_scheduled_proto_rawDesc)))
+	})
+	return file_mailer_v1_account_deletion_scheduled_proto_rawDescData
+}
+
+var file_mailer_v1_account_deletion_scheduled_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_mailer_v1_account_deletion_scheduled_proto_goTypes = []any{
+	(*AccountDeletionScheduledEvent)(nil), // 0: mailer.v1.AccountDeletionScheduledEvent
+	(*timestamppb.Timestamp)(nil),         // 1: google.protobuf.Timestamp
+}
+var file_mailer_v1_account_deletion_scheduled_proto_depIdxs = []int32{
+	1, // 0: mailer.v1.AccountDeletionScheduledEvent.delete_after:type_name -> google.protobuf.Timestamp
+	1, // 1: mailer.v1.AccountDeletionScheduledEvent.event_time:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_mailer_v1_account_deletion_scheduled_proto_init() }
+func file_mailer_v1_account_deletion_scheduled_proto_init() {
+	if File_mailer_v1_account_deletion_scheduled_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mailer_v1_account_deletion_scheduled_proto_rawDesc), len(file_mailer_v1_account_deletion_scheduled_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mailer_v1_account_deletion_scheduled_proto_goTypes,
+		DependencyIndexes: file_mailer_v1_account_deletion_scheduled_proto_depIdxs,
+		MessageInfos:      file_mailer_v1_account_deletion_scheduled_proto_msgTypes,
+	}.Build()
+	File_mailer_v1_account_deletion_scheduled_proto = out.File
+	file_mailer_v1_account_deletion_scheduled_proto_goTypes = nil
+	file_mailer_v1_account_deletion_scheduled_proto_depIdxs = nil
+}
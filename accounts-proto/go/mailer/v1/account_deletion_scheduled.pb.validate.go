@@ -0,0 +1,262 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: mailer/v1/account_deletion_scheduled.proto
+
+package mailerv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on AccountDeletionScheduledEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccountDeletionScheduledEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccountDeletionScheduledEvent with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AccountDeletionScheduledEventMultiError, or nil if none found.
+func (m *AccountDeletionScheduledEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccountDeletionScheduledEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = AccountDeletionScheduledEventValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if uri, err := url.Parse(m.GetCancelLink()); err != nil {
+		err = AccountDeletionScheduledEventValidationError{
+			field:  "CancelLink",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := AccountDeletionScheduledEventValidationError{
+			field:  "CancelLink",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDeleteAfter() == nil {
+		err := AccountDeletionScheduledEventValidationError{
+			field:  "DeleteAfter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEventTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccountDeletionScheduledEventValidationError{
+					field:  "EventTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccountDeletionScheduledEventValidationError{
+					field:  "EventTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEventTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccountDeletionScheduledEventValidationError{
+				field:  "EventTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AccountDeletionScheduledEventMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AccountDeletionScheduledEvent) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *AccountDeletionScheduledEvent) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// AccountDeletionScheduledEventMultiError is an error wrapping multiple
+// validation errors returned by AccountDeletionScheduledEvent.ValidateAll()
+// if the designated constraints aren't met.
+type AccountDeletionScheduledEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccountDeletionScheduledEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccountDeletionScheduledEventMultiError) AllErrors() []error { return m }
+
+// AccountDeletionScheduledEventValidationError is the validation error
+// returned by AccountDeletionScheduledEvent.Validate if the designated
+// constraints aren't met.
+type AccountDeletionScheduledEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccountDeletionScheduledEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccountDeletionScheduledEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccountDeletionScheduledEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccountDeletionScheduledEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccountDeletionScheduledEventValidationError) ErrorName() string {
+	return "AccountDeletionScheduledEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccountDeletionScheduledEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccountDeletionScheduledEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccountDeletionScheduledEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccountDeletionScheduledEventValidationError{}
@@ -0,0 +1,207 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: mailer/v1/new_login_alert.proto
+
+package mailerv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NewLoginAlertEvent warns a user about a sign in from a new device or country
+type NewLoginAlertEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Email      string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	LoginTime  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=login_time,json=loginTime,proto3" json:"login_time,omitempty"`
+	IpAddress  string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Location   string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"` // City and country of the sign in, if known
+	UserAgent  string                 `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	NewDevice  bool                   `protobuf:"varint,6,opt,name=new_device,json=newDevice,proto3" json:"new_device,omitempty"`
+	NewCountry bool                   `protobuf:"varint,7,opt,name=new_country,json=newCountry,proto3" json:"new_country,omitempty"`
+	// The page where the user can review and revoke sessions
+	SecurityLink  string                 `protobuf:"bytes,8,opt,name=security_link,json=securityLink,proto3" json:"security_link,omitempty"`
+	EventTime     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewLoginAlertEvent) Reset() {
+	*x = NewLoginAlertEvent{}
+	mi := &file_mailer_v1_new_login_alert_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewLoginAlertEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewLoginAlertEvent) ProtoMessage() {}
+
+func (x *NewLoginAlertEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mailer_v1_new_login_alert_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewLoginAlertEvent.ProtoReflect.Descriptor instead.
+func (*NewLoginAlertEvent) Descriptor() ([]byte, []int) {
+	return file_mailer_v1_new_login_alert_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NewLoginAlertEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *NewLoginAlertEvent) GetLoginTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LoginTime
+	}
+	return nil
+}
+
+func (x *NewLoginAlertEvent) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *NewLoginAlertEvent) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *NewLoginAlertEvent) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *NewLoginAlertEvent) GetNewDevice() bool {
+	if x != nil {
+		return x.NewDevice
+	}
+	return false
+}
+
+func (x *NewLoginAlertEvent) GetNewCountry() bool {
+	if x != nil {
+		return x.NewCountry
+	}
+	return false
+}
+
+func (x *NewLoginAlertEvent) GetSecurityLink() string {
+	if x != nil {
+		return x.SecurityLink
+	}
+	return ""
+}
+
+func (x *NewLoginAlertEvent) GetEventTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+var File_mailer_v1_new_login_alert_proto protoreflect.FileDescriptor
+
+const file_mailer_v1_new_login_alert_proto_rawDesc = "" +
+	"\n" +
+	"\x1fmailer/v1/new_login_alert.proto\x12\tmailer.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a#third_party/validate/validate.proto\"\xfc\x02\n" +
+	"\x12NewLoginAlertEvent\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12C\n" +
+	"\n" +
+	"login_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\tloginTime\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"new_device\x18\x06 \x01(\bR\tnewDevice\x12\x1f\n" +
+	"\vnew_country\x18\a \x01(\bR\n" +
+	"newCountry\x12-\n" +
+	"\rsecurity_link\x18\b \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\fsecurityLink\x129\n" +
+	"\n" +
+	"event_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\teventTimeB?Z=github.com/mandacode-com/accounts-proto/go/mailer/v1;mailerv1b\x06proto3"
+
+var (
+	file_mailer_v1_new_login_alert_proto_rawDescOnce sync.Once
+	file_mailer_v1_new_login_alert_proto_rawDescData []byte
+)
+
+func file_mailer_v1_new_login_alert_proto_rawDescGZIP() []byte {
+	file_mailer_v1_new_login_alert_proto_rawDescOnce.Do(func() {
+		file_mailer_v1_new_login_alert_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mailer_v1_new_login_alert_proto_rawDesc), len(file_mailer_v1_new_login_alert_proto_rawDesc)))
+	})
+	return file_mailer_v1_new_login_alert_proto_rawDescData
+}
+
+var file_mailer_v1_new_login_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_mailer_v1_new_login_alert_proto_goTypes = []any{
+	(*NewLoginAlertEvent)(nil),    // 0: mailer.v1.NewLoginAlertEvent
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_mailer_v1_new_login_alert_proto_depIdxs = []int32{
+	1, // 0: mailer.v1.NewLoginAlertEvent.login_time:type_name -> google.protobuf.Timestamp
+	1, // 1: mailer.v1.NewLoginAlertEvent.event_time:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_mailer_v1_new_login_alert_proto_init() }
+func file_mailer_v1_new_login_alert_proto_init() {
+	if File_mailer_v1_new_login_alert_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mailer_v1_new_login_alert_proto_rawDesc), len(file_mailer_v1_new_login_alert_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mailer_v1_new_login_alert_proto_goTypes,
+		DependencyIndexes: file_mailer_v1_new_login_alert_proto_depIdxs,
+		MessageInfos:      file_mailer_v1_new_login_alert_proto_msgTypes,
+	}.Build()
+	File_mailer_v1_new_login_alert_proto = out.File
+	file_mailer_v1_new_login_alert_proto_goTypes = nil
+	file_mailer_v1_new_login_alert_proto_depIdxs = nil
+}
@@ -0,0 +1,271 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: mailer/v1/new_login_alert.proto
+
+package mailerv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on NewLoginAlertEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NewLoginAlertEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NewLoginAlertEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NewLoginAlertEventMultiError, or nil if none found.
+func (m *NewLoginAlertEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NewLoginAlertEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = NewLoginAlertEventValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLoginTime() == nil {
+		err := NewLoginAlertEventValidationError{
+			field:  "LoginTime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IpAddress
+
+	// no validation rules for Location
+
+	// no validation rules for UserAgent
+
+	// no validation rules for NewDevice
+
+	// no validation rules for NewCountry
+
+	if uri, err := url.Parse(m.GetSecurityLink()); err != nil {
+		err = NewLoginAlertEventValidationError{
+			field:  "SecurityLink",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := NewLoginAlertEventValidationError{
+			field:  "SecurityLink",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEventTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NewLoginAlertEventValidationError{
+					field:  "EventTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NewLoginAlertEventValidationError{
+					field:  "EventTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEventTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NewLoginAlertEventValidationError{
+				field:  "EventTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NewLoginAlertEventMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *NewLoginAlertEvent) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *NewLoginAlertEvent) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// NewLoginAlertEventMultiError is an error wrapping multiple validation errors
+// returned by NewLoginAlertEvent.ValidateAll() if the designated constraints
+// aren't met.
+type NewLoginAlertEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NewLoginAlertEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NewLoginAlertEventMultiError) AllErrors() []error { return m }
+
+// NewLoginAlertEventValidationError is the validation error returned by
+// NewLoginAlertEvent.Validate if the designated constraints aren't met.
+type NewLoginAlertEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NewLoginAlertEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NewLoginAlertEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NewLoginAlertEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NewLoginAlertEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NewLoginAlertEventValidationError) ErrorName() string {
+	return "NewLoginAlertEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NewLoginAlertEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNewLoginAlertEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NewLoginAlertEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NewLoginAlertEventValidationError{}
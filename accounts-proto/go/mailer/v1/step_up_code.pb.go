@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: mailer/v1/step_up_code.proto
+
+package mailerv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StepUpCodeEvent carries the code confirming a sign in which requires a
+// step-up
+type StepUpCodeEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	EventTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepUpCodeEvent) Reset() {
+	*x = StepUpCodeEvent{}
+	mi := &file_mailer_v1_step_up_code_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepUpCodeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepUpCodeEvent) ProtoMessage() {}
+
+func (x *StepUpCodeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mailer_v1_step_up_code_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepUpCodeEvent.ProtoReflect.Descriptor instead.
+func (*StepUpCodeEvent) Descriptor() ([]byte, []int) {
+	return file_mailer_v1_step_up_code_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StepUpCodeEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *StepUpCodeEvent) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StepUpCodeEvent) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *StepUpCodeEvent) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *StepUpCodeEvent) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *StepUpCodeEvent) GetEventTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+var File_mailer_v1_step_up_code_proto protoreflect.FileDescriptor
+
+const file_mailer_v1_step_up_code_proto_rawDesc = "" +
+	"\n" +
+	"\x1cmailer/v1/step_up_code.proto\x12\tmailer.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a#third_party/validate/validate.proto\"\x95\x02\n" +
+	"\x0fStepUpCodeEvent\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12%\n" +
+	"\x04code\x18\x02 \x01(\tB\x11\xfaB\x0er\f\x10\x012\b^[0-9]+$R\x04code\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12C\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\texpiresAt\x129\n" +
+	"\n" +
+	"event_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\teventTimeB?Z=github.com/mandacode-com/accounts-proto/go/mailer/v1;mailerv1b\x06proto3"
+
+var (
+	file_mailer_v1_step_up_code_proto_rawDescOnce sync.Once
+	file_mailer_v1_step_up_code_proto_rawDescData []byte
+)
+
+func file_mailer_v1_step_up_code_proto_rawDescGZIP() []byte {
+	file_mailer_v1_step_up_code_proto_rawDescOnce.Do(func() {
+		file_mailer_v1_step_up_code_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mailer_v1_step_up_code_proto_rawDesc), len(file_mailer_v1_step_up_code_proto_rawDesc)))
+	})
+	return file_mailer_v1_step_up_code_proto_rawDescData
+}
+
+var file_mailer_v1_step_up_code_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_mailer_v1_step_up_code_proto_goTypes = []any{
+	(*StepUpCodeEvent)(nil),       // 0: mailer.v1.StepUpCodeEvent
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_mailer_v1_step_up_code_proto_depIdxs = []int32{
+	1, // 0: mailer.v1.StepUpCodeEvent.expires_at:type_name -> google.protobuf.Timestamp
+	1, // 1: mailer.v1.StepUpCodeEvent.event_time:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_mailer_v1_step_up_code_proto_init() }
+func file_mailer_v1_step_up_code_proto_init() {
+	if File_mailer_v1_step_up_code_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mailer_v1_step_up_code_proto_rawDesc), len(file_mailer_v1_step_up_code_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mailer_v1_step_up_code_proto_goTypes,
+		DependencyIndexes: file_mailer_v1_step_up_code_proto_depIdxs,
+		MessageInfos:      file_mailer_v1_step_up_code_proto_msgTypes,
+	}.Build()
+	File_mailer_v1_step_up_code_proto = out.File
+	file_mailer_v1_step_up_code_proto_goTypes = nil
+	file_mailer_v1_step_up_code_proto_depIdxs = nil
+}
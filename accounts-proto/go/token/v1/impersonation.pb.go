@@ -0,0 +1,312 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: token/v1/impersonation.proto
+
+package tokenv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GenerateImpersonationTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`    // Impersonated user, used as the subject of the token
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // Admin impersonating the user, carried in the act claim
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateImpersonationTokenRequest) Reset() {
+	*x = GenerateImpersonationTokenRequest{}
+	mi := &file_token_v1_impersonation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateImpersonationTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateImpersonationTokenRequest) ProtoMessage() {}
+
+func (x *GenerateImpersonationTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_impersonation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateImpersonationTokenRequest.ProtoReflect.Descriptor instead.
+func (*GenerateImpersonationTokenRequest) Descriptor() ([]byte, []int) {
+	return file_token_v1_impersonation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenerateImpersonationTokenRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GenerateImpersonationTokenRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type GenerateImpersonationTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                           // The generated impersonation token
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Expiration time in Unix timestamp format
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateImpersonationTokenResponse) Reset() {
+	*x = GenerateImpersonationTokenResponse{}
+	mi := &file_token_v1_impersonation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateImpersonationTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateImpersonationTokenResponse) ProtoMessage() {}
+
+func (x *GenerateImpersonationTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_impersonation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateImpersonationTokenResponse.ProtoReflect.Descriptor instead.
+func (*GenerateImpersonationTokenResponse) Descriptor() ([]byte, []int) {
+	return file_token_v1_impersonation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenerateImpersonationTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GenerateImpersonationTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type VerifyAccessTokenActorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // The access token to verify
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAccessTokenActorRequest) Reset() {
+	*x = VerifyAccessTokenActorRequest{}
+	mi := &file_token_v1_impersonation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAccessTokenActorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAccessTokenActorRequest) ProtoMessage() {}
+
+func (x *VerifyAccessTokenActorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_impersonation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAccessTokenActorRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAccessTokenActorRequest) Descriptor() ([]byte, []int) {
+	return file_token_v1_impersonation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VerifyAccessTokenActorRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyAccessTokenActorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`                         // Indicates if the token is valid
+	UserId        *string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`    // User ID associated with the token, if valid
+	ActorId       *string                `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"` // Admin acting as the user, only set for impersonation tokens
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAccessTokenActorResponse) Reset() {
+	*x = VerifyAccessTokenActorResponse{}
+	mi := &file_token_v1_impersonation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAccessTokenActorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAccessTokenActorResponse) ProtoMessage() {}
+
+func (x *VerifyAccessTokenActorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_impersonation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAccessTokenActorResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAccessTokenActorResponse) Descriptor() ([]byte, []int) {
+	return file_token_v1_impersonation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VerifyAccessTokenActorResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyAccessTokenActorResponse) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *VerifyAccessTokenActorResponse) GetActorId() string {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return ""
+}
+
+var File_token_v1_impersonation_proto protoreflect.FileDescriptor
+
+const file_token_v1_impersonation_proto_rawDesc = "" +
+	"\n" +
+	"\x1ctoken/v1/impersonation.proto\x12\btoken.v1\x1a#third_party/validate/validate.proto\"k\n" +
+	"!GenerateImpersonationTokenRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12#\n" +
+	"\bactor_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\aactorId\"k\n" +
+	"\"GenerateImpersonationTokenResponse\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\x12&\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\texpiresAt\">\n" +
+	"\x1dVerifyAccessTokenActorRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\"\xa1\x01\n" +
+	"\x1eVerifyAccessTokenActorResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12&\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\x06userId\x88\x01\x01\x12(\n" +
+	"\bactor_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x01R\aactorId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\v\n" +
+	"\t_actor_id2\xfc\x01\n" +
+	"\x14ImpersonationService\x12w\n" +
+	"\x1aGenerateImpersonationToken\x12+.token.v1.GenerateImpersonationTokenRequest\x1a,.token.v1.GenerateImpersonationTokenResponse\x12k\n" +
+	"\x16VerifyAccessTokenActor\x12'.token.v1.VerifyAccessTokenActorRequest\x1a(.token.v1.VerifyAccessTokenActorResponseB=Z;github.com/mandacode-com/accounts-proto/go/token/v1;tokenv1b\x06proto3"
+
+var (
+	file_token_v1_impersonation_proto_rawDescOnce sync.Once
+	file_token_v1_impersonation_proto_rawDescData []byte
+)
+
+func file_token_v1_impersonation_proto_rawDescGZIP() []byte {
+	file_token_v1_impersonation_proto_rawDescOnce.Do(func() {
+		file_token_v1_impersonation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_token_v1_impersonation_proto_rawDesc), len(file_token_v1_impersonation_proto_rawDesc)))
+	})
+	return file_token_v1_impersonation_proto_rawDescData
+}
+
+var file_token_v1_impersonation_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_token_v1_impersonation_proto_goTypes = []any{
+	(*GenerateImpersonationTokenRequest)(nil),  // 0: token.v1.GenerateImpersonationTokenRequest
+	(*GenerateImpersonationTokenResponse)(nil), // 1: token.v1.GenerateImpersonationTokenResponse
+	(*VerifyAccessTokenActorRequest)(nil),      // 2: token.v1.VerifyAccessTokenActorRequest
+	(*VerifyAccessTokenActorResponse)(nil),     // 3: token.v1.VerifyAccessTokenActorResponse
+}
+var file_token_v1_impersonation_proto_depIdxs = []int32{
+	0, // 0: token.v1.ImpersonationService.GenerateImpersonationToken:input_type -> token.v1.GenerateImpersonationTokenRequest
+	2, // 1: token.v1.ImpersonationService.VerifyAccessTokenActor:input_type -> token.v1.VerifyAccessTokenActorRequest
+	1, // 2: token.v1.ImpersonationService.GenerateImpersonationToken:output_type -> token.v1.GenerateImpersonationTokenResponse
+	3, // 3: token.v1.ImpersonationService.VerifyAccessTokenActor:output_type -> token.v1.VerifyAccessTokenActorResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_token_v1_impersonation_proto_init() }
+func file_token_v1_impersonation_proto_init() {
+	if File_token_v1_impersonation_proto != nil {
+		return
+	}
+	file_token_v1_impersonation_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_token_v1_impersonation_proto_rawDesc), len(file_token_v1_impersonation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_token_v1_impersonation_proto_goTypes,
+		DependencyIndexes: file_token_v1_impersonation_proto_depIdxs,
+		MessageInfos:      file_token_v1_impersonation_proto_msgTypes,
+	}.Build()
+	File_token_v1_impersonation_proto = out.File
+	file_token_v1_impersonation_proto_goTypes = nil
+	file_token_v1_impersonation_proto_depIdxs = nil
+}
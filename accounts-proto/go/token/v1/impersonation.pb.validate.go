@@ -0,0 +1,562 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: token/v1/impersonation.proto
+
+package tokenv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _impersonation_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on GenerateImpersonationTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GenerateImpersonationTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateImpersonationTokenRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GenerateImpersonationTokenRequestMultiError, or nil if none found.
+func (m *GenerateImpersonationTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateImpersonationTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GenerateImpersonationTokenRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetActorId()); err != nil {
+		err = GenerateImpersonationTokenRequestValidationError{
+			field:  "ActorId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GenerateImpersonationTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GenerateImpersonationTokenRequest) _validateUuid(uuid string) error {
+	if matched := _impersonation_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GenerateImpersonationTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GenerateImpersonationTokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateImpersonationTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateImpersonationTokenRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateImpersonationTokenRequestMultiError) AllErrors() []error { return m }
+
+// GenerateImpersonationTokenRequestValidationError is the validation error
+// returned by GenerateImpersonationTokenRequest.Validate if the designated
+// constraints aren't met.
+type GenerateImpersonationTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateImpersonationTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateImpersonationTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateImpersonationTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateImpersonationTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateImpersonationTokenRequestValidationError) ErrorName() string {
+	return "GenerateImpersonationTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateImpersonationTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateImpersonationTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateImpersonationTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateImpersonationTokenRequestValidationError{}
+
+// Validate checks the field values on GenerateImpersonationTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GenerateImpersonationTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateImpersonationTokenResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GenerateImpersonationTokenResponseMultiError, or nil if none found.
+func (m *GenerateImpersonationTokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateImpersonationTokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := GenerateImpersonationTokenResponseValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpiresAt() <= 0 {
+		err := GenerateImpersonationTokenResponseValidationError{
+			field:  "ExpiresAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GenerateImpersonationTokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateImpersonationTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GenerateImpersonationTokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateImpersonationTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateImpersonationTokenResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateImpersonationTokenResponseMultiError) AllErrors() []error { return m }
+
+// GenerateImpersonationTokenResponseValidationError is the validation error
+// returned by GenerateImpersonationTokenResponse.Validate if the designated
+// constraints aren't met.
+type GenerateImpersonationTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateImpersonationTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateImpersonationTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateImpersonationTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateImpersonationTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateImpersonationTokenResponseValidationError) ErrorName() string {
+	return "GenerateImpersonationTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateImpersonationTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateImpersonationTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateImpersonationTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateImpersonationTokenResponseValidationError{}
+
+// Validate checks the field values on VerifyAccessTokenActorRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyAccessTokenActorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyAccessTokenActorRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// VerifyAccessTokenActorRequestMultiError, or nil if none found.
+func (m *VerifyAccessTokenActorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyAccessTokenActorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := VerifyAccessTokenActorRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VerifyAccessTokenActorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyAccessTokenActorRequestMultiError is an error wrapping multiple
+// validation errors returned by VerifyAccessTokenActorRequest.ValidateAll()
+// if the designated constraints aren't met.
+type VerifyAccessTokenActorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyAccessTokenActorRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyAccessTokenActorRequestMultiError) AllErrors() []error { return m }
+
+// VerifyAccessTokenActorRequestValidationError is the validation error
+// returned by VerifyAccessTokenActorRequest.Validate if the designated
+// constraints aren't met.
+type VerifyAccessTokenActorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyAccessTokenActorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyAccessTokenActorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyAccessTokenActorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyAccessTokenActorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyAccessTokenActorRequestValidationError) ErrorName() string {
+	return "VerifyAccessTokenActorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyAccessTokenActorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyAccessTokenActorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyAccessTokenActorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyAccessTokenActorRequestValidationError{}
+
+// Validate checks the field values on VerifyAccessTokenActorResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyAccessTokenActorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyAccessTokenActorResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// VerifyAccessTokenActorResponseMultiError, or nil if none found.
+func (m *VerifyAccessTokenActorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyAccessTokenActorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Valid
+
+	if m.UserId != nil {
+
+		if err := m._validateUuid(m.GetUserId()); err != nil {
+			err = VerifyAccessTokenActorResponseValidationError{
+				field:  "UserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.ActorId != nil {
+
+		if err := m._validateUuid(m.GetActorId()); err != nil {
+			err = VerifyAccessTokenActorResponseValidationError{
+				field:  "ActorId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return VerifyAccessTokenActorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *VerifyAccessTokenActorResponse) _validateUuid(uuid string) error {
+	if matched := _impersonation_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// VerifyAccessTokenActorResponseMultiError is an error wrapping multiple
+// validation errors returned by VerifyAccessTokenActorResponse.ValidateAll()
+// if the designated constraints aren't met.
+type VerifyAccessTokenActorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyAccessTokenActorResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyAccessTokenActorResponseMultiError) AllErrors() []error { return m }
+
+// VerifyAccessTokenActorResponseValidationError is the validation error
+// returned by VerifyAccessTokenActorResponse.Validate if the designated
+// constraints aren't met.
+type VerifyAccessTokenActorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyAccessTokenActorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyAccessTokenActorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyAccessTokenActorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyAccessTokenActorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyAccessTokenActorResponseValidationError) ErrorName() string {
+	return "VerifyAccessTokenActorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyAccessTokenActorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyAccessTokenActorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyAccessTokenActorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyAccessTokenActorResponseValidationError{}
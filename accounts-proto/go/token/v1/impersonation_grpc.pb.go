@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: token/v1/impersonation.proto
+
+package tokenv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ImpersonationService_GenerateImpersonationToken_FullMethodName = "/token.v1.ImpersonationService/GenerateImpersonationToken"
+	ImpersonationService_VerifyAccessTokenActor_FullMethodName     = "/token.v1.ImpersonationService/VerifyAccessTokenActor"
+)
+
+// ImpersonationServiceClient is the client API for ImpersonationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ImpersonationServiceClient interface {
+	// Generates a short-lived access token letting an admin act as a user. The
+	// admin is carried in the act claim of the token.
+	GenerateImpersonationToken(ctx context.Context, in *GenerateImpersonationTokenRequest, opts ...grpc.CallOption) (*GenerateImpersonationTokenResponse, error)
+	// Verifies an access token of a user like TokenService.VerifyAccessToken,
+	// and also returns the admin acting as the user for impersonation tokens
+	VerifyAccessTokenActor(ctx context.Context, in *VerifyAccessTokenActorRequest, opts ...grpc.CallOption) (*VerifyAccessTokenActorResponse, error)
+}
+
+type impersonationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewImpersonationServiceClient(cc grpc.ClientConnInterface) ImpersonationServiceClient {
+	return &impersonationServiceClient{cc}
+}
+
+func (c *impersonationServiceClient) GenerateImpersonationToken(ctx context.Context, in *GenerateImpersonationTokenRequest, opts ...grpc.CallOption) (*GenerateImpersonationTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateImpersonationTokenResponse)
+	err := c.cc.Invoke(ctx, ImpersonationService_GenerateImpersonationToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *impersonationServiceClient) VerifyAccessTokenActor(ctx context.Context, in *VerifyAccessTokenActorRequest, opts ...grpc.CallOption) (*VerifyAccessTokenActorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAccessTokenActorResponse)
+	err := c.cc.Invoke(ctx, ImpersonationService_VerifyAccessTokenActor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImpersonationServiceServer is the server API for ImpersonationService service.
+// All implementations must embed UnimplementedImpersonationServiceServer
+// for forward compatibility.
+type ImpersonationServiceServer interface {
+	// Generates a short-lived access token letting an admin act as a user. The
+	// admin is carried in the act claim of the token.
+	GenerateImpersonationToken(context.Context, *GenerateImpersonationTokenRequest) (*GenerateImpersonationTokenResponse, error)
+	// Verifies an access token of a user like TokenService.VerifyAccessToken,
+	// and also returns the admin acting as the user for impersonation tokens
+	VerifyAccessTokenActor(context.Context, *VerifyAccessTokenActorRequest) (*VerifyAccessTokenActorResponse, error)
+	mustEmbedUnimplementedImpersonationServiceServer()
+}
+
+// UnimplementedImpersonationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedImpersonationServiceServer struct{}
+
+func (UnimplementedImpersonationServiceServer) GenerateImpersonationToken(context.Context, *GenerateImpersonationTokenRequest) (*GenerateImpersonationTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateImpersonationToken not implemented")
+}
+func (UnimplementedImpersonationServiceServer) VerifyAccessTokenActor(context.Context, *VerifyAccessTokenActorRequest) (*VerifyAccessTokenActorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAccessTokenActor not implemented")
+}
+func (UnimplementedImpersonationServiceServer) mustEmbedUnimplementedImpersonationServiceServer() {}
+func (UnimplementedImpersonationServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeImpersonationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ImpersonationServiceServer will
+// result in compilation errors.
+type UnsafeImpersonationServiceServer interface {
+	mustEmbedUnimplementedImpersonationServiceServer()
+}
+
+func RegisterImpersonationServiceServer(s grpc.ServiceRegistrar, srv ImpersonationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedImpersonationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ImpersonationService_ServiceDesc, srv)
+}
+
+func _ImpersonationService_GenerateImpersonationToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateImpersonationTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImpersonationServiceServer).GenerateImpersonationToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImpersonationService_GenerateImpersonationToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImpersonationServiceServer).GenerateImpersonationToken(ctx, req.(*GenerateImpersonationTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImpersonationService_VerifyAccessTokenActor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAccessTokenActorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImpersonationServiceServer).VerifyAccessTokenActor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImpersonationService_VerifyAccessTokenActor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImpersonationServiceServer).VerifyAccessTokenActor(ctx, req.(*VerifyAccessTokenActorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ImpersonationService_ServiceDesc is the grpc.ServiceDesc for ImpersonationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ImpersonationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "token.v1.ImpersonationService",
+	HandlerType: (*ImpersonationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateImpersonationToken",
+			Handler:    _ImpersonationService_GenerateImpersonationToken_Handler,
+		},
+		{
+			MethodName: "VerifyAccessTokenActor",
+			Handler:    _ImpersonationService_VerifyAccessTokenActor_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "token/v1/impersonation.proto",
+}
@@ -0,0 +1,1162 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: token/v1/token.proto
+
+package tokenv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Access token messages
+type GenerateAccessTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Roles         []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"` // Roles of the user, carried in the roles claim
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateAccessTokenRequest) Reset() {
+	*x = GenerateAccessTokenRequest{}
+	mi := &file_token_v1_token_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateAccessTokenRequest) ProtoMessage() {}
+
+func (x *GenerateAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*GenerateAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenerateAccessTokenRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GenerateAccessTokenRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type GenerateAccessTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                           // The generated access token
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Expiration time in Unix timestamp format
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateAccessTokenResponse) Reset() {
+	*x = GenerateAccessTokenResponse{}
+	mi := &file_token_v1_token_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateAccessTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateAccessTokenResponse) ProtoMessage() {}
+
+func (x *GenerateAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*GenerateAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenerateAccessTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GenerateAccessTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type GenerateOrgAccessTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Roles         []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`                    // Roles of the user, carried in the roles claim
+	OrgId         string                 `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`       // Active organization of the user, carried in the org_id claim
+	OrgRole       string                 `protobuf:"bytes,4,opt,name=org_role,json=orgRole,proto3" json:"org_role,omitempty"` // Role of the user in the organization, carried in the org_role claim
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateOrgAccessTokenRequest) Reset() {
+	*x = GenerateOrgAccessTokenRequest{}
+	mi := &file_token_v1_token_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateOrgAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateOrgAccessTokenRequest) ProtoMessage() {}
+
+func (x *GenerateOrgAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateOrgAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*GenerateOrgAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenerateOrgAccessTokenRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GenerateOrgAccessTokenRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *GenerateOrgAccessTokenRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GenerateOrgAccessTokenRequest) GetOrgRole() string {
+	if x != nil {
+		return x.OrgRole
+	}
+	return ""
+}
+
+type GenerateOrgAccessTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                           // The generated access token
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Expiration time in Unix timestamp format
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateOrgAccessTokenResponse) Reset() {
+	*x = GenerateOrgAccessTokenResponse{}
+	mi := &file_token_v1_token_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateOrgAccessTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateOrgAccessTokenResponse) ProtoMessage() {}
+
+func (x *GenerateOrgAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateOrgAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*GenerateOrgAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GenerateOrgAccessTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GenerateOrgAccessTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type VerifyAccessTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // The access token to verify
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAccessTokenRequest) Reset() {
+	*x = VerifyAccessTokenRequest{}
+	mi := &file_token_v1_token_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAccessTokenRequest) ProtoMessage() {}
+
+func (x *VerifyAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VerifyAccessTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyAccessTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`                      // Indicates if the token is valid
+	UserId        *string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"` // User ID associated with the token, if valid
+	Roles         []string               `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`                       // Roles of the user carried by the token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAccessTokenResponse) Reset() {
+	*x = VerifyAccessTokenResponse{}
+	mi := &file_token_v1_token_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAccessTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAccessTokenResponse) ProtoMessage() {}
+
+func (x *VerifyAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VerifyAccessTokenResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyAccessTokenResponse) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *VerifyAccessTokenResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// Client access token messages
+type GenerateClientAccessTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // Client ID of the client, used as the subject of the token
+	Scopes        []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`                     // Scopes granted to the client
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateClientAccessTokenRequest) Reset() {
+	*x = GenerateClientAccessTokenRequest{}
+	mi := &file_token_v1_token_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateClientAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateClientAccessTokenRequest) ProtoMessage() {}
+
+func (x *GenerateClientAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateClientAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*GenerateClientAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GenerateClientAccessTokenRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *GenerateClientAccessTokenRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type GenerateClientAccessTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                           // The generated client access token
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Expiration time in Unix timestamp format
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateClientAccessTokenResponse) Reset() {
+	*x = GenerateClientAccessTokenResponse{}
+	mi := &file_token_v1_token_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateClientAccessTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateClientAccessTokenResponse) ProtoMessage() {}
+
+func (x *GenerateClientAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateClientAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*GenerateClientAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GenerateClientAccessTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GenerateClientAccessTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type VerifyClientAccessTokenRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                         // The client access token to verify
+	RequiredScopes []string               `protobuf:"bytes,2,rep,name=required_scopes,json=requiredScopes,proto3" json:"required_scopes,omitempty"` // Scopes the token must grant
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VerifyClientAccessTokenRequest) Reset() {
+	*x = VerifyClientAccessTokenRequest{}
+	mi := &file_token_v1_token_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyClientAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyClientAccessTokenRequest) ProtoMessage() {}
+
+func (x *VerifyClientAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyClientAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*VerifyClientAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VerifyClientAccessTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *VerifyClientAccessTokenRequest) GetRequiredScopes() []string {
+	if x != nil {
+		return x.RequiredScopes
+	}
+	return nil
+}
+
+type VerifyClientAccessTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`                            // Indicates if the token is valid
+	ClientId      *string                `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"` // Client ID associated with the token, if valid
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`                           // Scopes granted to the client, if valid
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyClientAccessTokenResponse) Reset() {
+	*x = VerifyClientAccessTokenResponse{}
+	mi := &file_token_v1_token_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyClientAccessTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyClientAccessTokenResponse) ProtoMessage() {}
+
+func (x *VerifyClientAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyClientAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*VerifyClientAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VerifyClientAccessTokenResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyClientAccessTokenResponse) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *VerifyClientAccessTokenResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// Refresh token messages
+type GenerateRefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateRefreshTokenRequest) Reset() {
+	*x = GenerateRefreshTokenRequest{}
+	mi := &file_token_v1_token_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRefreshTokenRequest) ProtoMessage() {}
+
+func (x *GenerateRefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*GenerateRefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GenerateRefreshTokenRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GenerateRefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                           // The generated refresh token
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Expiration time in Unix timestamp format
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateRefreshTokenResponse) Reset() {
+	*x = GenerateRefreshTokenResponse{}
+	mi := &file_token_v1_token_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRefreshTokenResponse) ProtoMessage() {}
+
+func (x *GenerateRefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*GenerateRefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GenerateRefreshTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GenerateRefreshTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type VerifyRefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // The refresh token to verify
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyRefreshTokenRequest) Reset() {
+	*x = VerifyRefreshTokenRequest{}
+	mi := &file_token_v1_token_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyRefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyRefreshTokenRequest) ProtoMessage() {}
+
+func (x *VerifyRefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyRefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*VerifyRefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VerifyRefreshTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyRefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`                      // Indicates if the token is valid
+	UserId        *string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"` // User ID associated with the token, if valid
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyRefreshTokenResponse) Reset() {
+	*x = VerifyRefreshTokenResponse{}
+	mi := &file_token_v1_token_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyRefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyRefreshTokenResponse) ProtoMessage() {}
+
+func (x *VerifyRefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyRefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*VerifyRefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VerifyRefreshTokenResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyRefreshTokenResponse) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+// Email verification token messages
+type GenerateEmailVerificationTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID for which the verification token is generated
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                 // Email address for which the verification token is generated
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`                   // Optional code to include in the token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateEmailVerificationTokenRequest) Reset() {
+	*x = GenerateEmailVerificationTokenRequest{}
+	mi := &file_token_v1_token_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateEmailVerificationTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateEmailVerificationTokenRequest) ProtoMessage() {}
+
+func (x *GenerateEmailVerificationTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateEmailVerificationTokenRequest.ProtoReflect.Descriptor instead.
+func (*GenerateEmailVerificationTokenRequest) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GenerateEmailVerificationTokenRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GenerateEmailVerificationTokenRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *GenerateEmailVerificationTokenRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type GenerateEmailVerificationTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                           // The generated email verification token
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Expiration time in Unix timestamp format
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateEmailVerificationTokenResponse) Reset() {
+	*x = GenerateEmailVerificationTokenResponse{}
+	mi := &file_token_v1_token_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateEmailVerificationTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateEmailVerificationTokenResponse) ProtoMessage() {}
+
+func (x *GenerateEmailVerificationTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateEmailVerificationTokenResponse.ProtoReflect.Descriptor instead.
+func (*GenerateEmailVerificationTokenResponse) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GenerateEmailVerificationTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GenerateEmailVerificationTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type VerifyEmailVerificationTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // The email verification token to verify
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailVerificationTokenRequest) Reset() {
+	*x = VerifyEmailVerificationTokenRequest{}
+	mi := &file_token_v1_token_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailVerificationTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailVerificationTokenRequest) ProtoMessage() {}
+
+func (x *VerifyEmailVerificationTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailVerificationTokenRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailVerificationTokenRequest) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VerifyEmailVerificationTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyEmailVerificationTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`                      // Indicates if the token is valid
+	UserId        *string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"` // User ID associated with the token, if valid
+	Email         *string                `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`                 // Email address associated with the token, if valid
+	Code          *string                `protobuf:"bytes,4,opt,name=code,proto3,oneof" json:"code,omitempty"`                   // Code associated with the token, if valid
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailVerificationTokenResponse) Reset() {
+	*x = VerifyEmailVerificationTokenResponse{}
+	mi := &file_token_v1_token_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailVerificationTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailVerificationTokenResponse) ProtoMessage() {}
+
+func (x *VerifyEmailVerificationTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_token_v1_token_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailVerificationTokenResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailVerificationTokenResponse) Descriptor() ([]byte, []int) {
+	return file_token_v1_token_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VerifyEmailVerificationTokenResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyEmailVerificationTokenResponse) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *VerifyEmailVerificationTokenResponse) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *VerifyEmailVerificationTokenResponse) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+var File_token_v1_token_proto protoreflect.FileDescriptor
+
+const file_token_v1_token_proto_rawDesc = "" +
+	"\n" +
+	"\x14token/v1/token.proto\x12\btoken.v1\x1a#third_party/validate/validate.proto\"U\n" +
+	"\x1aGenerateAccessTokenRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12\x14\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles\"d\n" +
+	"\x1bGenerateAccessTokenResponse\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\x12&\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\texpiresAt\"\x9d\x01\n" +
+	"\x1dGenerateOrgAccessTokenRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12\x14\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles\x12\x1f\n" +
+	"\x06org_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x05orgId\x12\"\n" +
+	"\borg_role\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aorgRole\"g\n" +
+	"\x1eGenerateOrgAccessTokenResponse\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\x12&\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\texpiresAt\"9\n" +
+	"\x18VerifyAccessTokenRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\"{\n" +
+	"\x19VerifyAccessTokenResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12&\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\x06userId\x88\x01\x01\x12\x14\n" +
+	"\x05roles\x18\x03 \x03(\tR\x05rolesB\n" +
+	"\n" +
+	"\b_user_id\"n\n" +
+	" GenerateClientAccessTokenRequest\x12$\n" +
+	"\tclient_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bclientId\x12$\n" +
+	"\x06scopes\x18\x02 \x03(\tB\f\xfaB\t\x92\x01\x06\"\x04r\x02\x10\x01R\x06scopes\"j\n" +
+	"!GenerateClientAccessTokenResponse\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\x12&\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\texpiresAt\"v\n" +
+	"\x1eVerifyClientAccessTokenRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\x125\n" +
+	"\x0frequired_scopes\x18\x02 \x03(\tB\f\xfaB\t\x92\x01\x06\"\x04r\x02\x10\x01R\x0erequiredScopes\"\x88\x01\n" +
+	"\x1fVerifyClientAccessTokenResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12)\n" +
+	"\tclient_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01H\x00R\bclientId\x88\x01\x01\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopesB\f\n" +
+	"\n" +
+	"_client_id\"@\n" +
+	"\x1bGenerateRefreshTokenRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"e\n" +
+	"\x1cGenerateRefreshTokenResponse\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\x12&\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\texpiresAt\":\n" +
+	"\x19VerifyRefreshTokenRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\"f\n" +
+	"\x1aVerifyRefreshTokenResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12&\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"\x86\x01\n" +
+	"%GenerateEmailVerificationTokenRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
+	"\x05email\x18\x02 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12\x1b\n" +
+	"\x04code\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04code\"o\n" +
+	"&GenerateEmailVerificationTokenResponse\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\x12&\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\texpiresAt\"D\n" +
+	"#VerifyEmailVerificationTokenRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\"\xc9\x01\n" +
+	"$VerifyEmailVerificationTokenResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12&\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\x06userId\x88\x01\x01\x12\"\n" +
+	"\x05email\x18\x03 \x01(\tB\a\xfaB\x04r\x02`\x01H\x01R\x05email\x88\x01\x01\x12 \n" +
+	"\x04code\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01H\x02R\x04code\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\b\n" +
+	"\x06_emailB\a\n" +
+	"\x05_code2\xf0\a\n" +
+	"\fTokenService\x12b\n" +
+	"\x13GenerateAccessToken\x12$.token.v1.GenerateAccessTokenRequest\x1a%.token.v1.GenerateAccessTokenResponse\x12k\n" +
+	"\x16GenerateOrgAccessToken\x12'.token.v1.GenerateOrgAccessTokenRequest\x1a(.token.v1.GenerateOrgAccessTokenResponse\x12\\\n" +
+	"\x11VerifyAccessToken\x12\".token.v1.VerifyAccessTokenRequest\x1a#.token.v1.VerifyAccessTokenResponse\x12t\n" +
+	"\x19GenerateClientAccessToken\x12*.token.v1.GenerateClientAccessTokenRequest\x1a+.token.v1.GenerateClientAccessTokenResponse\x12n\n" +
+	"\x17VerifyClientAccessToken\x12(.token.v1.VerifyClientAccessTokenRequest\x1a).token.v1.VerifyClientAccessTokenResponse\x12e\n" +
+	"\x14GenerateRefreshToken\x12%.token.v1.GenerateRefreshTokenRequest\x1a&.token.v1.GenerateRefreshTokenResponse\x12_\n" +
+	"\x12VerifyRefreshToken\x12#.token.v1.VerifyRefreshTokenRequest\x1a$.token.v1.VerifyRefreshTokenResponse\x12\x83\x01\n" +
+	"\x1eGenerateEmailVerificationToken\x12/.token.v1.GenerateEmailVerificationTokenRequest\x1a0.token.v1.GenerateEmailVerificationTokenResponse\x12}\n" +
+	"\x1cVerifyEmailVerificationToken\x12-.token.v1.VerifyEmailVerificationTokenRequest\x1a..token.v1.VerifyEmailVerificationTokenResponseB=Z;github.com/mandacode-com/accounts-proto/go/token/v1;tokenv1b\x06proto3"
+
+var (
+	file_token_v1_token_proto_rawDescOnce sync.Once
+	file_token_v1_token_proto_rawDescData []byte
+)
+
+func file_token_v1_token_proto_rawDescGZIP() []byte {
+	file_token_v1_token_proto_rawDescOnce.Do(func() {
+		file_token_v1_token_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_token_v1_token_proto_rawDesc), len(file_token_v1_token_proto_rawDesc)))
+	})
+	return file_token_v1_token_proto_rawDescData
+}
+
+var file_token_v1_token_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_token_v1_token_proto_goTypes = []any{
+	(*GenerateAccessTokenRequest)(nil),             // 0: token.v1.GenerateAccessTokenRequest
+	(*GenerateAccessTokenResponse)(nil),            // 1: token.v1.GenerateAccessTokenResponse
+	(*GenerateOrgAccessTokenRequest)(nil),          // 2: token.v1.GenerateOrgAccessTokenRequest
+	(*GenerateOrgAccessTokenResponse)(nil),         // 3: token.v1.GenerateOrgAccessTokenResponse
+	(*VerifyAccessTokenRequest)(nil),               // 4: token.v1.VerifyAccessTokenRequest
+	(*VerifyAccessTokenResponse)(nil),              // 5: token.v1.VerifyAccessTokenResponse
+	(*GenerateClientAccessTokenRequest)(nil),       // 6: token.v1.GenerateClientAccessTokenRequest
+	(*GenerateClientAccessTokenResponse)(nil),      // 7: token.v1.GenerateClientAccessTokenResponse
+	(*VerifyClientAccessTokenRequest)(nil),         // 8: token.v1.VerifyClientAccessTokenRequest
+	(*VerifyClientAccessTokenResponse)(nil),        // 9: token.v1.VerifyClientAccessTokenResponse
+	(*GenerateRefreshTokenRequest)(nil),            // 10: token.v1.GenerateRefreshTokenRequest
+	(*GenerateRefreshTokenResponse)(nil),           // 11: token.v1.GenerateRefreshTokenResponse
+	(*VerifyRefreshTokenRequest)(nil),              // 12: token.v1.VerifyRefreshTokenRequest
+	(*VerifyRefreshTokenResponse)(nil),             // 13: token.v1.VerifyRefreshTokenResponse
+	(*GenerateEmailVerificationTokenRequest)(nil),  // 14: token.v1.GenerateEmailVerificationTokenRequest
+	(*GenerateEmailVerificationTokenResponse)(nil), // 15: token.v1.GenerateEmailVerificationTokenResponse
+	(*VerifyEmailVerificationTokenRequest)(nil),    // 16: token.v1.VerifyEmailVerificationTokenRequest
+	(*VerifyEmailVerificationTokenResponse)(nil),   // 17: token.v1.VerifyEmailVerificationTokenResponse
+}
+var file_token_v1_token_proto_depIdxs = []int32{
+	0,  // 0: token.v1.TokenService.GenerateAccessToken:input_type -> token.v1.GenerateAccessTokenRequest
+	2,  // 1: token.v1.TokenService.GenerateOrgAccessToken:input_type -> token.v1.GenerateOrgAccessTokenRequest
+	4,  // 2: token.v1.TokenService.VerifyAccessToken:input_type -> token.v1.VerifyAccessTokenRequest
+	6,  // 3: token.v1.TokenService.GenerateClientAccessToken:input_type -> token.v1.GenerateClientAccessTokenRequest
+	8,  // 4: token.v1.TokenService.VerifyClientAccessToken:input_type -> token.v1.VerifyClientAccessTokenRequest
+	10, // 5: token.v1.TokenService.GenerateRefreshToken:input_type -> token.v1.GenerateRefreshTokenRequest
+	12, // 6: token.v1.TokenService.VerifyRefreshToken:input_type -> token.v1.VerifyRefreshTokenRequest
+	14, // 7: token.v1.TokenService.GenerateEmailVerificationToken:input_type -> token.v1.GenerateEmailVerificationTokenRequest
+	16, // 8: token.v1.TokenService.VerifyEmailVerificationToken:input_type -> token.v1.VerifyEmailVerificationTokenRequest
+	1,  // 9: token.v1.TokenService.GenerateAccessToken:output_type -> token.v1.GenerateAccessTokenResponse
+	3,  // 10: token.v1.TokenService.GenerateOrgAccessToken:output_type -> token.v1.GenerateOrgAccessTokenResponse
+	5,  // 11: token.v1.TokenService.VerifyAccessToken:output_type -> token.v1.VerifyAccessTokenResponse
+	7,  // 12: token.v1.TokenService.GenerateClientAccessToken:output_type -> token.v1.GenerateClientAccessTokenResponse
+	9,  // 13: token.v1.TokenService.VerifyClientAccessToken:output_type -> token.v1.VerifyClientAccessTokenResponse
+	11, // 14: token.v1.TokenService.GenerateRefreshToken:output_type -> token.v1.GenerateRefreshTokenResponse
+	13, // 15: token.v1.TokenService.VerifyRefreshToken:output_type -> token.v1.VerifyRefreshTokenResponse
+	15, // 16: token.v1.TokenService.GenerateEmailVerificationToken:output_type -> token.v1.GenerateEmailVerificationTokenResponse
+	17, // 17: token.v1.TokenService.VerifyEmailVerificationToken:output_type -> token.v1.VerifyEmailVerificationTokenResponse
+	9,  // [9:18] is the sub-list for method output_type
+	0,  // [0:9] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_token_v1_token_proto_init() }
+func file_token_v1_token_proto_init() {
+	if File_token_v1_token_proto != nil {
+		return
+	}
+	file_token_v1_token_proto_msgTypes[5].OneofWrappers = []any{}
+	file_token_v1_token_proto_msgTypes[9].OneofWrappers = []any{}
+	file_token_v1_token_proto_msgTypes[13].OneofWrappers = []any{}
+	file_token_v1_token_proto_msgTypes[17].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_token_v1_token_proto_rawDesc), len(file_token_v1_token_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_token_v1_token_proto_goTypes,
+		DependencyIndexes: file_token_v1_token_proto_depIdxs,
+		MessageInfos:      file_token_v1_token_proto_msgTypes,
+	}.Build()
+	File_token_v1_token_proto = out.File
+	file_token_v1_token_proto_goTypes = nil
+	file_token_v1_token_proto_depIdxs = nil
+}
@@ -0,0 +1,573 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: user/event/v1/user_event.proto
+
+package usereventv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED    EventType = 0
+	EventType_USER_DELETED              EventType = 1
+	EventType_USER_ARCHIVED             EventType = 2
+	EventType_USER_RESTORED             EventType = 3
+	EventType_USER_BLOCKED              EventType = 4
+	EventType_USER_UNBLOCKED            EventType = 5
+	EventType_ROLES_CHANGED             EventType = 6
+	EventType_USER_ACTIVE_CHANGED       EventType = 7
+	EventType_USER_EMAIL_CHANGED        EventType = 8
+	EventType_USER_MINOR_STATUS_CHANGED EventType = 9
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNSPECIFIED",
+		1: "USER_DELETED",
+		2: "USER_ARCHIVED",
+		3: "USER_RESTORED",
+		4: "USER_BLOCKED",
+		5: "USER_UNBLOCKED",
+		6: "ROLES_CHANGED",
+		7: "USER_ACTIVE_CHANGED",
+		8: "USER_EMAIL_CHANGED",
+		9: "USER_MINOR_STATUS_CHANGED",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":    0,
+		"USER_DELETED":              1,
+		"USER_ARCHIVED":             2,
+		"USER_RESTORED":             3,
+		"USER_BLOCKED":              4,
+		"USER_UNBLOCKED":            5,
+		"ROLES_CHANGED":             6,
+		"USER_ACTIVE_CHANGED":       7,
+		"USER_EMAIL_CHANGED":        8,
+		"USER_MINOR_STATUS_CHANGED": 9,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_event_v1_user_event_proto_enumTypes[0].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_user_event_v1_user_event_proto_enumTypes[0]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_user_event_v1_user_event_proto_rawDescGZIP(), []int{0}
+}
+
+type UserEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	EventType EventType              `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=user.event.v1.EventType" json:"event_type,omitempty"`
+	UserId    string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SyncCode  *string                `protobuf:"bytes,3,opt,name=sync_code,json=syncCode,proto3,oneof" json:"sync_code,omitempty"` // Sync code for tracking
+	EventTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	// Version of the user after the change, increasing with every change, so
+	// that consumers apply the events of a user in order and skip the stale ones
+	SyncVersion int64 `protobuf:"varint,6,opt,name=sync_version,json=syncVersion,proto3" json:"sync_version,omitempty"`
+	// Payload of the events which carry more than the user ID
+	//
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UserEvent_RolesChanged
+	//	*UserEvent_ActiveChanged
+	//	*UserEvent_EmailChanged
+	//	*UserEvent_MinorStatusChanged
+	Payload       isUserEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserEvent) Reset() {
+	*x = UserEvent{}
+	mi := &file_user_event_v1_user_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEvent) ProtoMessage() {}
+
+func (x *UserEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_event_v1_user_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEvent.ProtoReflect.Descriptor instead.
+func (*UserEvent) Descriptor() ([]byte, []int) {
+	return file_user_event_v1_user_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserEvent) GetEventType() EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *UserEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserEvent) GetSyncCode() string {
+	if x != nil && x.SyncCode != nil {
+		return *x.SyncCode
+	}
+	return ""
+}
+
+func (x *UserEvent) GetEventTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+func (x *UserEvent) GetSyncVersion() int64 {
+	if x != nil {
+		return x.SyncVersion
+	}
+	return 0
+}
+
+func (x *UserEvent) GetPayload() isUserEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UserEvent) GetRolesChanged() *RolesChanged {
+	if x != nil {
+		if x, ok := x.Payload.(*UserEvent_RolesChanged); ok {
+			return x.RolesChanged
+		}
+	}
+	return nil
+}
+
+func (x *UserEvent) GetActiveChanged() *ActiveChanged {
+	if x != nil {
+		if x, ok := x.Payload.(*UserEvent_ActiveChanged); ok {
+			return x.ActiveChanged
+		}
+	}
+	return nil
+}
+
+func (x *UserEvent) GetEmailChanged() *EmailChanged {
+	if x != nil {
+		if x, ok := x.Payload.(*UserEvent_EmailChanged); ok {
+			return x.EmailChanged
+		}
+	}
+	return nil
+}
+
+func (x *UserEvent) GetMinorStatusChanged() *MinorStatusChanged {
+	if x != nil {
+		if x, ok := x.Payload.(*UserEvent_MinorStatusChanged); ok {
+			return x.MinorStatusChanged
+		}
+	}
+	return nil
+}
+
+type isUserEvent_Payload interface {
+	isUserEvent_Payload()
+}
+
+type UserEvent_RolesChanged struct {
+	RolesChanged *RolesChanged `protobuf:"bytes,5,opt,name=roles_changed,json=rolesChanged,proto3,oneof"` // Set on ROLES_CHANGED events
+}
+
+type UserEvent_ActiveChanged struct {
+	ActiveChanged *ActiveChanged `protobuf:"bytes,7,opt,name=active_changed,json=activeChanged,proto3,oneof"` // Set on USER_ACTIVE_CHANGED events
+}
+
+type UserEvent_EmailChanged struct {
+	EmailChanged *EmailChanged `protobuf:"bytes,8,opt,name=email_changed,json=emailChanged,proto3,oneof"` // Set on USER_EMAIL_CHANGED events
+}
+
+type UserEvent_MinorStatusChanged struct {
+	// Set on USER_MINOR_STATUS_CHANGED events
+	MinorStatusChanged *MinorStatusChanged `protobuf:"bytes,9,opt,name=minor_status_changed,json=minorStatusChanged,proto3,oneof"`
+}
+
+func (*UserEvent_RolesChanged) isUserEvent_Payload() {}
+
+func (*UserEvent_ActiveChanged) isUserEvent_Payload() {}
+
+func (*UserEvent_EmailChanged) isUserEvent_Payload() {}
+
+func (*UserEvent_MinorStatusChanged) isUserEvent_Payload() {}
+
+// RolesChanged carries the roles of a user after they changed, including the
+// implicit user role, and the permissions they grant
+type RolesChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []string               `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	Permissions   []string               `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolesChanged) Reset() {
+	*x = RolesChanged{}
+	mi := &file_user_event_v1_user_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolesChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolesChanged) ProtoMessage() {}
+
+func (x *RolesChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_user_event_v1_user_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolesChanged.ProtoReflect.Descriptor instead.
+func (*RolesChanged) Descriptor() ([]byte, []int) {
+	return file_user_event_v1_user_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RolesChanged) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *RolesChanged) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// ActiveChanged carries whether a user is active after it changed
+type ActiveChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsActive      bool                   `protobuf:"varint,1,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActiveChanged) Reset() {
+	*x = ActiveChanged{}
+	mi := &file_user_event_v1_user_event_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActiveChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveChanged) ProtoMessage() {}
+
+func (x *ActiveChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_user_event_v1_user_event_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveChanged.ProtoReflect.Descriptor instead.
+func (*ActiveChanged) Descriptor() ([]byte, []int) {
+	return file_user_event_v1_user_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActiveChanged) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+// EmailChanged carries the email address of a user after it changed.
+// Reverted marks the undoing of a change from the link sent to the old
+// address, which suggests the account was taken over
+type EmailChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Reverted      bool                   `protobuf:"varint,2,opt,name=reverted,proto3" json:"reverted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailChanged) Reset() {
+	*x = EmailChanged{}
+	mi := &file_user_event_v1_user_event_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailChanged) ProtoMessage() {}
+
+func (x *EmailChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_user_event_v1_user_event_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailChanged.ProtoReflect.Descriptor instead.
+func (*EmailChanged) Descriptor() ([]byte, []int) {
+	return file_user_event_v1_user_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EmailChanged) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *EmailChanged) GetReverted() bool {
+	if x != nil {
+		return x.Reverted
+	}
+	return false
+}
+
+// MinorStatusChanged carries whether a user is a minor, and the status of the
+// consent of their guardian, so that services can restrict the features
+// available to minors
+type MinorStatusChanged struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IsMinor         bool                   `protobuf:"varint,1,opt,name=is_minor,json=isMinor,proto3" json:"is_minor,omitempty"`
+	GuardianConsent string                 `protobuf:"bytes,2,opt,name=guardian_consent,json=guardianConsent,proto3" json:"guardian_consent,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MinorStatusChanged) Reset() {
+	*x = MinorStatusChanged{}
+	mi := &file_user_event_v1_user_event_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MinorStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinorStatusChanged) ProtoMessage() {}
+
+func (x *MinorStatusChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_user_event_v1_user_event_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinorStatusChanged.ProtoReflect.Descriptor instead.
+func (*MinorStatusChanged) Descriptor() ([]byte, []int) {
+	return file_user_event_v1_user_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MinorStatusChanged) GetIsMinor() bool {
+	if x != nil {
+		return x.IsMinor
+	}
+	return false
+}
+
+func (x *MinorStatusChanged) GetGuardianConsent() string {
+	if x != nil {
+		return x.GuardianConsent
+	}
+	return ""
+}
+
+var File_user_event_v1_user_event_proto protoreflect.FileDescriptor
+
+const file_user_event_v1_user_event_proto_rawDesc = "" +
+	"\n" +
+	"\x1euser/event/v1/user_event.proto\x12\ruser.event.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a#third_party/validate/validate.proto\"\xb9\x04\n" +
+	"\tUserEvent\x12A\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\x0e2\x18.user.event.v1.EventTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\teventType\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12)\n" +
+	"\tsync_code\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01H\x01R\bsyncCode\x88\x01\x01\x129\n" +
+	"\n" +
+	"event_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\teventTime\x12!\n" +
+	"\fsync_version\x18\x06 \x01(\x03R\vsyncVersion\x12B\n" +
+	"\rroles_changed\x18\x05 \x01(\v2\x1b.user.event.v1.RolesChangedH\x00R\frolesChanged\x12E\n" +
+	"\x0eactive_changed\x18\a \x01(\v2\x1c.user.event.v1.ActiveChangedH\x00R\ractiveChanged\x12B\n" +
+	"\remail_changed\x18\b \x01(\v2\x1b.user.event.v1.EmailChangedH\x00R\femailChanged\x12U\n" +
+	"\x14minor_status_changed\x18\t \x01(\v2!.user.event.v1.MinorStatusChangedH\x00R\x12minorStatusChangedB\t\n" +
+	"\apayloadB\f\n" +
+	"\n" +
+	"_sync_code\"F\n" +
+	"\fRolesChanged\x12\x14\n" +
+	"\x05roles\x18\x01 \x03(\tR\x05roles\x12 \n" +
+	"\vpermissions\x18\x02 \x03(\tR\vpermissions\",\n" +
+	"\rActiveChanged\x12\x1b\n" +
+	"\tis_active\x18\x01 \x01(\bR\bisActive\"I\n" +
+	"\fEmailChanged\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12\x1a\n" +
+	"\breverted\x18\x02 \x01(\bR\breverted\"\x81\x01\n" +
+	"\x12MinorStatusChanged\x12\x19\n" +
+	"\bis_minor\x18\x01 \x01(\bR\aisMinor\x12P\n" +
+	"\x10guardian_consent\x18\x02 \x01(\tB%\xfaB\"r R\fnot_requiredR\apendingR\agrantedR\x0fguardianConsent*\xe8\x01\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fUSER_DELETED\x10\x01\x12\x11\n" +
+	"\rUSER_ARCHIVED\x10\x02\x12\x11\n" +
+	"\rUSER_RESTORED\x10\x03\x12\x10\n" +
+	"\fUSER_BLOCKED\x10\x04\x12\x12\n" +
+	"\x0eUSER_UNBLOCKED\x10\x05\x12\x11\n" +
+	"\rROLES_CHANGED\x10\x06\x12\x17\n" +
+	"\x13USER_ACTIVE_CHANGED\x10\a\x12\x16\n" +
+	"\x12USER_EMAIL_CHANGED\x10\b\x12\x1d\n" +
+	"\x19USER_MINOR_STATUS_CHANGED\x10\tBFZDgithub.com/mandacode-com/accounts-proto/go/user/event/v1;usereventv1b\x06proto3"
+
+var (
+	file_user_event_v1_user_event_proto_rawDescOnce sync.Once
+	file_user_event_v1_user_event_proto_rawDescData []byte
+)
+
+func file_user_event_v1_user_event_proto_rawDescGZIP() []byte {
+	file_user_event_v1_user_event_proto_rawDescOnce.Do(func() {
+		file_user_event_v1_user_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_event_v1_user_event_proto_rawDesc), len(file_user_event_v1_user_event_proto_rawDesc)))
+	})
+	return file_user_event_v1_user_event_proto_rawDescData
+}
+
+var file_user_event_v1_user_event_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_user_event_v1_user_event_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_user_event_v1_user_event_proto_goTypes = []any{
+	(EventType)(0),                // 0: user.event.v1.EventType
+	(*UserEvent)(nil),             // 1: user.event.v1.UserEvent
+	(*RolesChanged)(nil),          // 2: user.event.v1.RolesChanged
+	(*ActiveChanged)(nil),         // 3: user.event.v1.ActiveChanged
+	(*EmailChanged)(nil),          // 4: user.event.v1.EmailChanged
+	(*MinorStatusChanged)(nil),    // 5: user.event.v1.MinorStatusChanged
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+}
+var file_user_event_v1_user_event_proto_depIdxs = []int32{
+	0, // 0: user.event.v1.UserEvent.event_type:type_name -> user.event.v1.EventType
+	6, // 1: user.event.v1.UserEvent.event_time:type_name -> google.protobuf.Timestamp
+	2, // 2: user.event.v1.UserEvent.roles_changed:type_name -> user.event.v1.RolesChanged
+	3, // 3: user.event.v1.UserEvent.active_changed:type_name -> user.event.v1.ActiveChanged
+	4, // 4: user.event.v1.UserEvent.email_changed:type_name -> user.event.v1.EmailChanged
+	5, // 5: user.event.v1.UserEvent.minor_status_changed:type_name -> user.event.v1.MinorStatusChanged
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_user_event_v1_user_event_proto_init() }
+func file_user_event_v1_user_event_proto_init() {
+	if File_user_event_v1_user_event_proto != nil {
+		return
+	}
+	file_user_event_v1_user_event_proto_msgTypes[0].OneofWrappers = []any{
+		(*UserEvent_RolesChanged)(nil),
+		(*UserEvent_ActiveChanged)(nil),
+		(*UserEvent_EmailChanged)(nil),
+		(*UserEvent_MinorStatusChanged)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_event_v1_user_event_proto_rawDesc), len(file_user_event_v1_user_event_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_user_event_v1_user_event_proto_goTypes,
+		DependencyIndexes: file_user_event_v1_user_event_proto_depIdxs,
+		EnumInfos:         file_user_event_v1_user_event_proto_enumTypes,
+		MessageInfos:      file_user_event_v1_user_event_proto_msgTypes,
+	}.Build()
+	File_user_event_v1_user_event_proto = out.File
+	file_user_event_v1_user_event_proto_goTypes = nil
+	file_user_event_v1_user_event_proto_depIdxs = nil
+}
@@ -0,0 +1,869 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/event/v1/user_event.proto
+
+package usereventv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _user_event_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on UserEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserEventMultiError, or nil
+// if none found.
+func (m *UserEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := EventType_name[int32(m.GetEventType())]; !ok {
+		err := UserEventValidationError{
+			field:  "EventType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UserEventValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEventTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserEventValidationError{
+					field:  "EventTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserEventValidationError{
+					field:  "EventTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEventTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserEventValidationError{
+				field:  "EventTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SyncVersion
+
+	switch v := m.Payload.(type) {
+	case *UserEvent_RolesChanged:
+		if v == nil {
+			err := UserEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRolesChanged()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserEventValidationError{
+						field:  "RolesChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserEventValidationError{
+						field:  "RolesChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRolesChanged()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserEventValidationError{
+					field:  "RolesChanged",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UserEvent_ActiveChanged:
+		if v == nil {
+			err := UserEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetActiveChanged()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserEventValidationError{
+						field:  "ActiveChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserEventValidationError{
+						field:  "ActiveChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetActiveChanged()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserEventValidationError{
+					field:  "ActiveChanged",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UserEvent_EmailChanged:
+		if v == nil {
+			err := UserEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetEmailChanged()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserEventValidationError{
+						field:  "EmailChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserEventValidationError{
+						field:  "EmailChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEmailChanged()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserEventValidationError{
+					field:  "EmailChanged",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UserEvent_MinorStatusChanged:
+		if v == nil {
+			err := UserEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMinorStatusChanged()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserEventValidationError{
+						field:  "MinorStatusChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserEventValidationError{
+						field:  "MinorStatusChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMinorStatusChanged()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserEventValidationError{
+					field:  "MinorStatusChanged",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if m.SyncCode != nil {
+
+		if utf8.RuneCountInString(m.GetSyncCode()) < 1 {
+			err := UserEventValidationError{
+				field:  "SyncCode",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserEventMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UserEvent) _validateUuid(uuid string) error {
+	if matched := _user_event_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UserEventMultiError is an error wrapping multiple validation errors returned
+// by UserEvent.ValidateAll() if the designated constraints aren't met.
+type UserEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserEventMultiError) AllErrors() []error { return m }
+
+// UserEventValidationError is the validation error returned by
+// UserEvent.Validate if the designated constraints aren't met.
+type UserEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserEventValidationError) ErrorName() string { return "UserEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserEventValidationError{}
+
+// Validate checks the field values on RolesChanged with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RolesChanged) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RolesChanged with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RolesChangedMultiError, or
+// nil if none found.
+func (m *RolesChanged) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RolesChanged) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RolesChangedMultiError(errors)
+	}
+
+	return nil
+}
+
+// RolesChangedMultiError is an error wrapping multiple validation errors
+// returned by RolesChanged.ValidateAll() if the designated constraints aren't met.
+type RolesChangedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RolesChangedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RolesChangedMultiError) AllErrors() []error { return m }
+
+// RolesChangedValidationError is the validation error returned by
+// RolesChanged.Validate if the designated constraints aren't met.
+type RolesChangedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RolesChangedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RolesChangedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RolesChangedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RolesChangedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RolesChangedValidationError) ErrorName() string { return "RolesChangedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RolesChangedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRolesChanged.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RolesChangedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RolesChangedValidationError{}
+
+// Validate checks the field values on ActiveChanged with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ActiveChanged) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActiveChanged with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ActiveChangedMultiError, or
+// nil if none found.
+func (m *ActiveChanged) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActiveChanged) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsActive
+
+	if len(errors) > 0 {
+		return ActiveChangedMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActiveChangedMultiError is an error wrapping multiple validation errors
+// returned by ActiveChanged.ValidateAll() if the designated constraints
+// aren't met.
+type ActiveChangedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActiveChangedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActiveChangedMultiError) AllErrors() []error { return m }
+
+// ActiveChangedValidationError is the validation error returned by
+// ActiveChanged.Validate if the designated constraints aren't met.
+type ActiveChangedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActiveChangedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActiveChangedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActiveChangedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActiveChangedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActiveChangedValidationError) ErrorName() string { return "ActiveChangedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ActiveChangedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActiveChanged.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActiveChangedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActiveChangedValidationError{}
+
+// Validate checks the field values on EmailChanged with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EmailChanged) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EmailChanged with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EmailChangedMultiError, or
+// nil if none found.
+func (m *EmailChanged) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EmailChanged) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = EmailChangedValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Reverted
+
+	if len(errors) > 0 {
+		return EmailChangedMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *EmailChanged) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *EmailChanged) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// EmailChangedMultiError is an error wrapping multiple validation errors
+// returned by EmailChanged.ValidateAll() if the designated constraints aren't met.
+type EmailChangedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EmailChangedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EmailChangedMultiError) AllErrors() []error { return m }
+
+// EmailChangedValidationError is the validation error returned by
+// EmailChanged.Validate if the designated constraints aren't met.
+type EmailChangedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EmailChangedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EmailChangedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EmailChangedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EmailChangedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EmailChangedValidationError) ErrorName() string { return "EmailChangedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EmailChangedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEmailChanged.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EmailChangedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EmailChangedValidationError{}
+
+// Validate checks the field values on MinorStatusChanged with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MinorStatusChanged) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MinorStatusChanged with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MinorStatusChangedMultiError, or nil if none found.
+func (m *MinorStatusChanged) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MinorStatusChanged) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsMinor
+
+	if _, ok := _MinorStatusChanged_GuardianConsent_InLookup[m.GetGuardianConsent()]; !ok {
+		err := MinorStatusChangedValidationError{
+			field:  "GuardianConsent",
+			reason: "value must be in list [not_required pending granted]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MinorStatusChangedMultiError(errors)
+	}
+
+	return nil
+}
+
+// MinorStatusChangedMultiError is an error wrapping multiple validation errors
+// returned by MinorStatusChanged.ValidateAll() if the designated constraints
+// aren't met.
+type MinorStatusChangedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MinorStatusChangedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MinorStatusChangedMultiError) AllErrors() []error { return m }
+
+// MinorStatusChangedValidationError is the validation error returned by
+// MinorStatusChanged.Validate if the designated constraints aren't met.
+type MinorStatusChangedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MinorStatusChangedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MinorStatusChangedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MinorStatusChangedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MinorStatusChangedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MinorStatusChangedValidationError) ErrorName() string {
+	return "MinorStatusChangedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MinorStatusChangedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMinorStatusChanged.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MinorStatusChangedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MinorStatusChangedValidationError{}
+
+var _MinorStatusChanged_GuardianConsent_InLookup = map[string]struct{}{
+	"not_required": {},
+	"pending":      {},
+	"granted":      {},
+}
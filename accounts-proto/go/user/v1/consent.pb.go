@@ -0,0 +1,470 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: user/v1/consent.proto
+
+package userv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConsentDocument is a published version of a document which users consent to
+type ConsentDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    string                 `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	ContentUrl    string                 `protobuf:"bytes,5,opt,name=content_url,json=contentUrl,proto3" json:"content_url,omitempty"`
+	Mandatory     bool                   `protobuf:"varint,6,opt,name=mandatory,proto3" json:"mandatory,omitempty"`
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsentDocument) Reset() {
+	*x = ConsentDocument{}
+	mi := &file_user_v1_consent_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsentDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsentDocument) ProtoMessage() {}
+
+func (x *ConsentDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_consent_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsentDocument.ProtoReflect.Descriptor instead.
+func (*ConsentDocument) Descriptor() ([]byte, []int) {
+	return file_user_v1_consent_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConsentDocument) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *ConsentDocument) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ConsentDocument) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ConsentDocument) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ConsentDocument) GetContentUrl() string {
+	if x != nil {
+		return x.ContentUrl
+	}
+	return ""
+}
+
+func (x *ConsentDocument) GetMandatory() bool {
+	if x != nil {
+		return x.Mandatory
+	}
+	return false
+}
+
+func (x *ConsentDocument) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+// ConsentChoice is the choice of a user on a document
+type ConsentChoice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    string                 `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Accepted      bool                   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsentChoice) Reset() {
+	*x = ConsentChoice{}
+	mi := &file_user_v1_consent_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsentChoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsentChoice) ProtoMessage() {}
+
+func (x *ConsentChoice) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_consent_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsentChoice.ProtoReflect.Descriptor instead.
+func (*ConsentChoice) Descriptor() ([]byte, []int) {
+	return file_user_v1_consent_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConsentChoice) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *ConsentChoice) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+type ListPendingConsentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingConsentsRequest) Reset() {
+	*x = ListPendingConsentsRequest{}
+	mi := &file_user_v1_consent_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingConsentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingConsentsRequest) ProtoMessage() {}
+
+func (x *ListPendingConsentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_consent_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingConsentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingConsentsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_consent_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPendingConsentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListPendingConsentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Documents     []*ConsentDocument     `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingConsentsResponse) Reset() {
+	*x = ListPendingConsentsResponse{}
+	mi := &file_user_v1_consent_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingConsentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingConsentsResponse) ProtoMessage() {}
+
+func (x *ListPendingConsentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_consent_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingConsentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingConsentsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_consent_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPendingConsentsResponse) GetDocuments() []*ConsentDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type RecordConsentsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Consents []*ConsentChoice       `protobuf:"bytes,2,rep,name=consents,proto3" json:"consents,omitempty"`
+	// Where the user made the choices
+	Ip        string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent string `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// ID of the user recording the choices, recorded in the audit trail
+	ActorId       *string `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordConsentsRequest) Reset() {
+	*x = RecordConsentsRequest{}
+	mi := &file_user_v1_consent_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordConsentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordConsentsRequest) ProtoMessage() {}
+
+func (x *RecordConsentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_consent_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordConsentsRequest.ProtoReflect.Descriptor instead.
+func (*RecordConsentsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_consent_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecordConsentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecordConsentsRequest) GetConsents() []*ConsentChoice {
+	if x != nil {
+		return x.Consents
+	}
+	return nil
+}
+
+func (x *RecordConsentsRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *RecordConsentsRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *RecordConsentsRequest) GetActorId() string {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return ""
+}
+
+type RecordConsentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordConsentsResponse) Reset() {
+	*x = RecordConsentsResponse{}
+	mi := &file_user_v1_consent_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordConsentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordConsentsResponse) ProtoMessage() {}
+
+func (x *RecordConsentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_consent_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordConsentsResponse.ProtoReflect.Descriptor instead.
+func (*RecordConsentsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_consent_proto_rawDescGZIP(), []int{5}
+}
+
+var File_user_v1_consent_proto protoreflect.FileDescriptor
+
+const file_user_v1_consent_proto_rawDesc = "" +
+	"\n" +
+	"\x15user/v1/consent.proto\x12\auser.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a#third_party/validate/validate.proto\"\xd7\x02\n" +
+	"\x0fConsentDocument\x12)\n" +
+	"\vdocument_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"documentId\x12F\n" +
+	"\x04kind\x18\x02 \x01(\tB2\xfaB/r-R\x10terms_of_serviceR\x0eprivacy_policyR\tmarketingR\x04kind\x12!\n" +
+	"\aversion\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\aversion\x12\x1d\n" +
+	"\x05title\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05title\x12(\n" +
+	"\vcontent_url\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"contentUrl\x12\x1c\n" +
+	"\tmandatory\x18\x06 \x01(\bR\tmandatory\x12G\n" +
+	"\fpublished_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\vpublishedAt\"V\n" +
+	"\rConsentChoice\x12)\n" +
+	"\vdocument_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"documentId\x12\x1a\n" +
+	"\baccepted\x18\x02 \x01(\bR\baccepted\"?\n" +
+	"\x1aListPendingConsentsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"U\n" +
+	"\x1bListPendingConsentsResponse\x126\n" +
+	"\tdocuments\x18\x01 \x03(\v2\x18.user.v1.ConsentDocumentR\tdocuments\"\xde\x01\n" +
+	"\x15RecordConsentsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12<\n" +
+	"\bconsents\x18\x02 \x03(\v2\x16.user.v1.ConsentChoiceB\b\xfaB\x05\x92\x01\x02\b\x01R\bconsents\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12(\n" +
+	"\bactor_id\x18\x05 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\aactorId\x88\x01\x01B\v\n" +
+	"\t_actor_id\"\x18\n" +
+	"\x16RecordConsentsResponse2\xc5\x01\n" +
+	"\x0eConsentService\x12`\n" +
+	"\x13ListPendingConsents\x12#.user.v1.ListPendingConsentsRequest\x1a$.user.v1.ListPendingConsentsResponse\x12Q\n" +
+	"\x0eRecordConsents\x12\x1e.user.v1.RecordConsentsRequest\x1a\x1f.user.v1.RecordConsentsResponseB;Z9github.com/mandacode-com/accounts-proto/go/user/v1;userv1b\x06proto3"
+
+var (
+	file_user_v1_consent_proto_rawDescOnce sync.Once
+	file_user_v1_consent_proto_rawDescData []byte
+)
+
+func file_user_v1_consent_proto_rawDescGZIP() []byte {
+	file_user_v1_consent_proto_rawDescOnce.Do(func() {
+		file_user_v1_consent_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_v1_consent_proto_rawDesc), len(file_user_v1_consent_proto_rawDesc)))
+	})
+	return file_user_v1_consent_proto_rawDescData
+}
+
+var file_user_v1_consent_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_user_v1_consent_proto_goTypes = []any{
+	(*ConsentDocument)(nil),             // 0: user.v1.ConsentDocument
+	(*ConsentChoice)(nil),               // 1: user.v1.ConsentChoice
+	(*ListPendingConsentsRequest)(nil),  // 2: user.v1.ListPendingConsentsRequest
+	(*ListPendingConsentsResponse)(nil), // 3: user.v1.ListPendingConsentsResponse
+	(*RecordConsentsRequest)(nil),       // 4: user.v1.RecordConsentsRequest
+	(*RecordConsentsResponse)(nil),      // 5: user.v1.RecordConsentsResponse
+	(*timestamppb.Timestamp)(nil),       // 6: google.protobuf.Timestamp
+}
+var file_user_v1_consent_proto_depIdxs = []int32{
+	6, // 0: user.v1.ConsentDocument.published_at:type_name -> google.protobuf.Timestamp
+	0, // 1: user.v1.ListPendingConsentsResponse.documents:type_name -> user.v1.ConsentDocument
+	1, // 2: user.v1.RecordConsentsRequest.consents:type_name -> user.v1.ConsentChoice
+	2, // 3: user.v1.ConsentService.ListPendingConsents:input_type -> user.v1.ListPendingConsentsRequest
+	4, // 4: user.v1.ConsentService.RecordConsents:input_type -> user.v1.RecordConsentsRequest
+	3, // 5: user.v1.ConsentService.ListPendingConsents:output_type -> user.v1.ListPendingConsentsResponse
+	5, // 6: user.v1.ConsentService.RecordConsents:output_type -> user.v1.RecordConsentsResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_user_v1_consent_proto_init() }
+func file_user_v1_consent_proto_init() {
+	if File_user_v1_consent_proto != nil {
+		return
+	}
+	file_user_v1_consent_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_consent_proto_rawDesc), len(file_user_v1_consent_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_v1_consent_proto_goTypes,
+		DependencyIndexes: file_user_v1_consent_proto_depIdxs,
+		MessageInfos:      file_user_v1_consent_proto_msgTypes,
+	}.Build()
+	File_user_v1_consent_proto = out.File
+	file_user_v1_consent_proto_goTypes = nil
+	file_user_v1_consent_proto_depIdxs = nil
+}
@@ -0,0 +1,892 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/v1/consent.proto
+
+package userv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _consent_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on ConsentDocument with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConsentDocument) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsentDocument with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConsentDocumentMultiError, or nil if none found.
+func (m *ConsentDocument) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsentDocument) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetDocumentId()); err != nil {
+		err = ConsentDocumentValidationError{
+			field:  "DocumentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ConsentDocument_Kind_InLookup[m.GetKind()]; !ok {
+		err := ConsentDocumentValidationError{
+			field:  "Kind",
+			reason: "value must be in list [terms_of_service privacy_policy marketing]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 1 {
+		err := ConsentDocumentValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTitle()) < 1 {
+		err := ConsentDocumentValidationError{
+			field:  "Title",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetContentUrl()) < 1 {
+		err := ConsentDocumentValidationError{
+			field:  "ContentUrl",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Mandatory
+
+	if m.GetPublishedAt() == nil {
+		err := ConsentDocumentValidationError{
+			field:  "PublishedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConsentDocumentMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ConsentDocument) _validateUuid(uuid string) error {
+	if matched := _consent_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ConsentDocumentMultiError is an error wrapping multiple validation errors
+// returned by ConsentDocument.ValidateAll() if the designated constraints
+// aren't met.
+type ConsentDocumentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsentDocumentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsentDocumentMultiError) AllErrors() []error { return m }
+
+// ConsentDocumentValidationError is the validation error returned by
+// ConsentDocument.Validate if the designated constraints aren't met.
+type ConsentDocumentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsentDocumentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsentDocumentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsentDocumentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsentDocumentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsentDocumentValidationError) ErrorName() string { return "ConsentDocumentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConsentDocumentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsentDocument.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsentDocumentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsentDocumentValidationError{}
+
+var _ConsentDocument_Kind_InLookup = map[string]struct{}{
+	"terms_of_service": {},
+	"privacy_policy":   {},
+	"marketing":        {},
+}
+
+// Validate checks the field values on ConsentChoice with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConsentChoice) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsentChoice with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConsentChoiceMultiError, or
+// nil if none found.
+func (m *ConsentChoice) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsentChoice) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetDocumentId()); err != nil {
+		err = ConsentChoiceValidationError{
+			field:  "DocumentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Accepted
+
+	if len(errors) > 0 {
+		return ConsentChoiceMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ConsentChoice) _validateUuid(uuid string) error {
+	if matched := _consent_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ConsentChoiceMultiError is an error wrapping multiple validation errors
+// returned by ConsentChoice.ValidateAll() if the designated constraints
+// aren't met.
+type ConsentChoiceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsentChoiceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsentChoiceMultiError) AllErrors() []error { return m }
+
+// ConsentChoiceValidationError is the validation error returned by
+// ConsentChoice.Validate if the designated constraints aren't met.
+type ConsentChoiceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsentChoiceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsentChoiceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsentChoiceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsentChoiceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsentChoiceValidationError) ErrorName() string { return "ConsentChoiceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConsentChoiceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsentChoice.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsentChoiceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsentChoiceValidationError{}
+
+// Validate checks the field values on ListPendingConsentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPendingConsentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingConsentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingConsentsRequestMultiError, or nil if none found.
+func (m *ListPendingConsentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingConsentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ListPendingConsentsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListPendingConsentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListPendingConsentsRequest) _validateUuid(uuid string) error {
+	if matched := _consent_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListPendingConsentsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListPendingConsentsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListPendingConsentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingConsentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingConsentsRequestMultiError) AllErrors() []error { return m }
+
+// ListPendingConsentsRequestValidationError is the validation error returned
+// by ListPendingConsentsRequest.Validate if the designated constraints aren't met.
+type ListPendingConsentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingConsentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingConsentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingConsentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingConsentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingConsentsRequestValidationError) ErrorName() string {
+	return "ListPendingConsentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingConsentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingConsentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingConsentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingConsentsRequestValidationError{}
+
+// Validate checks the field values on ListPendingConsentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPendingConsentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingConsentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingConsentsResponseMultiError, or nil if none found.
+func (m *ListPendingConsentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingConsentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDocuments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPendingConsentsResponseValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPendingConsentsResponseValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPendingConsentsResponseValidationError{
+					field:  fmt.Sprintf("Documents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPendingConsentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingConsentsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPendingConsentsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListPendingConsentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingConsentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingConsentsResponseMultiError) AllErrors() []error { return m }
+
+// ListPendingConsentsResponseValidationError is the validation error returned
+// by ListPendingConsentsResponse.Validate if the designated constraints
+// aren't met.
+type ListPendingConsentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingConsentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingConsentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingConsentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingConsentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingConsentsResponseValidationError) ErrorName() string {
+	return "ListPendingConsentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingConsentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingConsentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingConsentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingConsentsResponseValidationError{}
+
+// Validate checks the field values on RecordConsentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecordConsentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordConsentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecordConsentsRequestMultiError, or nil if none found.
+func (m *RecordConsentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordConsentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RecordConsentsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetConsents()) < 1 {
+		err := RecordConsentsRequestValidationError{
+			field:  "Consents",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetConsents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecordConsentsRequestValidationError{
+						field:  fmt.Sprintf("Consents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecordConsentsRequestValidationError{
+						field:  fmt.Sprintf("Consents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecordConsentsRequestValidationError{
+					field:  fmt.Sprintf("Consents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Ip
+
+	// no validation rules for UserAgent
+
+	if m.ActorId != nil {
+
+		if err := m._validateUuid(m.GetActorId()); err != nil {
+			err = RecordConsentsRequestValidationError{
+				field:  "ActorId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RecordConsentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RecordConsentsRequest) _validateUuid(uuid string) error {
+	if matched := _consent_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RecordConsentsRequestMultiError is an error wrapping multiple validation
+// errors returned by RecordConsentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RecordConsentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordConsentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordConsentsRequestMultiError) AllErrors() []error { return m }
+
+// RecordConsentsRequestValidationError is the validation error returned by
+// RecordConsentsRequest.Validate if the designated constraints aren't met.
+type RecordConsentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordConsentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordConsentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordConsentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordConsentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordConsentsRequestValidationError) ErrorName() string {
+	return "RecordConsentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecordConsentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordConsentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordConsentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordConsentsRequestValidationError{}
+
+// Validate checks the field values on RecordConsentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecordConsentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordConsentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecordConsentsResponseMultiError, or nil if none found.
+func (m *RecordConsentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordConsentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RecordConsentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordConsentsResponseMultiError is an error wrapping multiple validation
+// errors returned by RecordConsentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RecordConsentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordConsentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordConsentsResponseMultiError) AllErrors() []error { return m }
+
+// RecordConsentsResponseValidationError is the validation error returned by
+// RecordConsentsResponse.Validate if the designated constraints aren't met.
+type RecordConsentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordConsentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordConsentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordConsentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordConsentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordConsentsResponseValidationError) ErrorName() string {
+	return "RecordConsentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecordConsentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordConsentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordConsentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordConsentsResponseValidationError{}
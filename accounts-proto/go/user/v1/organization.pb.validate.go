@@ -0,0 +1,492 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/v1/organization.proto
+
+package userv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _organization_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on Membership with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Membership) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Membership with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MembershipMultiError, or
+// nil if none found.
+func (m *Membership) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Membership) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrgId()); err != nil {
+		err = MembershipValidationError{
+			field:  "OrgId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = MembershipValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _Membership_Role_InLookup[m.GetRole()]; !ok {
+		err := MembershipValidationError{
+			field:  "Role",
+			reason: "value must be in list [owner admin member]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MembershipValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MembershipValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MembershipValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MembershipMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *Membership) _validateUuid(uuid string) error {
+	if matched := _organization_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// MembershipMultiError is an error wrapping multiple validation errors
+// returned by Membership.ValidateAll() if the designated constraints aren't met.
+type MembershipMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MembershipMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MembershipMultiError) AllErrors() []error { return m }
+
+// MembershipValidationError is the validation error returned by
+// Membership.Validate if the designated constraints aren't met.
+type MembershipValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MembershipValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MembershipValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MembershipValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MembershipValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MembershipValidationError) ErrorName() string { return "MembershipValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MembershipValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMembership.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MembershipValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MembershipValidationError{}
+
+var _Membership_Role_InLookup = map[string]struct{}{
+	"owner":  {},
+	"admin":  {},
+	"member": {},
+}
+
+// Validate checks the field values on GetMembershipRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMembershipRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMembershipRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMembershipRequestMultiError, or nil if none found.
+func (m *GetMembershipRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMembershipRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrgId()); err != nil {
+		err = GetMembershipRequestValidationError{
+			field:  "OrgId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetMembershipRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetMembershipRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetMembershipRequest) _validateUuid(uuid string) error {
+	if matched := _organization_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetMembershipRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMembershipRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMembershipRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMembershipRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMembershipRequestMultiError) AllErrors() []error { return m }
+
+// GetMembershipRequestValidationError is the validation error returned by
+// GetMembershipRequest.Validate if the designated constraints aren't met.
+type GetMembershipRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMembershipRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMembershipRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMembershipRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMembershipRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMembershipRequestValidationError) ErrorName() string {
+	return "GetMembershipRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMembershipRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMembershipRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMembershipRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMembershipRequestValidationError{}
+
+// Validate checks the field values on GetMembershipResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMembershipResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMembershipResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMembershipResponseMultiError, or nil if none found.
+func (m *GetMembershipResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMembershipResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMembership() == nil {
+		err := GetMembershipResponseValidationError{
+			field:  "Membership",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMembership()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMembershipResponseValidationError{
+					field:  "Membership",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMembershipResponseValidationError{
+					field:  "Membership",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMembership()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMembershipResponseValidationError{
+				field:  "Membership",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetMembershipResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMembershipResponseMultiError is an error wrapping multiple validation
+// errors returned by GetMembershipResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetMembershipResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMembershipResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMembershipResponseMultiError) AllErrors() []error { return m }
+
+// GetMembershipResponseValidationError is the validation error returned by
+// GetMembershipResponse.Validate if the designated constraints aren't met.
+type GetMembershipResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMembershipResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMembershipResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMembershipResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMembershipResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMembershipResponseValidationError) ErrorName() string {
+	return "GetMembershipResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMembershipResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMembershipResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMembershipResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMembershipResponseValidationError{}
@@ -0,0 +1,297 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: user/v1/user_status.proto
+
+package userv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UserStatus is the status and roles of a user, as of its version
+type UserStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsBlocked     bool                   `protobuf:"varint,3,opt,name=is_blocked,json=isBlocked,proto3" json:"is_blocked,omitempty"`
+	IsArchived    bool                   `protobuf:"varint,4,opt,name=is_archived,json=isArchived,proto3" json:"is_archived,omitempty"`
+	Roles         []string               `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`                                 // Names of the roles, including the user role
+	SyncVersion   int64                  `protobuf:"varint,6,opt,name=sync_version,json=syncVersion,proto3" json:"sync_version,omitempty"` // Version of the user, as in the user events
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserStatus) Reset() {
+	*x = UserStatus{}
+	mi := &file_user_v1_user_status_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStatus) ProtoMessage() {}
+
+func (x *UserStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_status_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStatus.ProtoReflect.Descriptor instead.
+func (*UserStatus) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_status_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserStatus) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserStatus) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UserStatus) GetIsBlocked() bool {
+	if x != nil {
+		return x.IsBlocked
+	}
+	return false
+}
+
+func (x *UserStatus) GetIsArchived() bool {
+	if x != nil {
+		return x.IsArchived
+	}
+	return false
+}
+
+func (x *UserStatus) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *UserStatus) GetSyncVersion() int64 {
+	if x != nil {
+		return x.SyncVersion
+	}
+	return 0
+}
+
+type ListUserStatusesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the last user of the previous page, if any
+	AfterUserId   *string `protobuf:"bytes,1,opt,name=after_user_id,json=afterUserId,proto3,oneof" json:"after_user_id,omitempty"`
+	PageSize      int32   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserStatusesRequest) Reset() {
+	*x = ListUserStatusesRequest{}
+	mi := &file_user_v1_user_status_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserStatusesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserStatusesRequest) ProtoMessage() {}
+
+func (x *ListUserStatusesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_status_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserStatusesRequest.ProtoReflect.Descriptor instead.
+func (*ListUserStatusesRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_status_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListUserStatusesRequest) GetAfterUserId() string {
+	if x != nil && x.AfterUserId != nil {
+		return *x.AfterUserId
+	}
+	return ""
+}
+
+func (x *ListUserStatusesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListUserStatusesResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Statuses []*UserStatus          `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	// ID of the last user of the page, unset on the last page
+	NextUserId    *string `protobuf:"bytes,2,opt,name=next_user_id,json=nextUserId,proto3,oneof" json:"next_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserStatusesResponse) Reset() {
+	*x = ListUserStatusesResponse{}
+	mi := &file_user_v1_user_status_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserStatusesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserStatusesResponse) ProtoMessage() {}
+
+func (x *ListUserStatusesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_status_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserStatusesResponse.ProtoReflect.Descriptor instead.
+func (*ListUserStatusesResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_status_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListUserStatusesResponse) GetStatuses() []*UserStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListUserStatusesResponse) GetNextUserId() string {
+	if x != nil && x.NextUserId != nil {
+		return *x.NextUserId
+	}
+	return ""
+}
+
+var File_user_v1_user_status_proto protoreflect.FileDescriptor
+
+const file_user_v1_user_status_proto_rawDesc = "" +
+	"\n" +
+	"\x19user/v1/user_status.proto\x12\auser.v1\x1a#third_party/validate/validate.proto\"\xc5\x01\n" +
+	"\n" +
+	"UserStatus\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"is_blocked\x18\x03 \x01(\bR\tisBlocked\x12\x1f\n" +
+	"\vis_archived\x18\x04 \x01(\bR\n" +
+	"isArchived\x12\x14\n" +
+	"\x05roles\x18\x05 \x03(\tR\x05roles\x12!\n" +
+	"\fsync_version\x18\x06 \x01(\x03R\vsyncVersion\"\x87\x01\n" +
+	"\x17ListUserStatusesRequest\x121\n" +
+	"\rafter_user_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\vafterUserId\x88\x01\x01\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x01R\bpageSizeB\x10\n" +
+	"\x0e_after_user_id\"\x8d\x01\n" +
+	"\x18ListUserStatusesResponse\x12/\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x13.user.v1.UserStatusR\bstatuses\x12/\n" +
+	"\fnext_user_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\n" +
+	"nextUserId\x88\x01\x01B\x0f\n" +
+	"\r_next_user_id2l\n" +
+	"\x11UserStatusService\x12W\n" +
+	"\x10ListUserStatuses\x12 .user.v1.ListUserStatusesRequest\x1a!.user.v1.ListUserStatusesResponseB;Z9github.com/mandacode-com/accounts-proto/go/user/v1;userv1b\x06proto3"
+
+var (
+	file_user_v1_user_status_proto_rawDescOnce sync.Once
+	file_user_v1_user_status_proto_rawDescData []byte
+)
+
+func file_user_v1_user_status_proto_rawDescGZIP() []byte {
+	file_user_v1_user_status_proto_rawDescOnce.Do(func() {
+		file_user_v1_user_status_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_v1_user_status_proto_rawDesc), len(file_user_v1_user_status_proto_rawDesc)))
+	})
+	return file_user_v1_user_status_proto_rawDescData
+}
+
+var file_user_v1_user_status_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_user_v1_user_status_proto_goTypes = []any{
+	(*UserStatus)(nil),               // 0: user.v1.UserStatus
+	(*ListUserStatusesRequest)(nil),  // 1: user.v1.ListUserStatusesRequest
+	(*ListUserStatusesResponse)(nil), // 2: user.v1.ListUserStatusesResponse
+}
+var file_user_v1_user_status_proto_depIdxs = []int32{
+	0, // 0: user.v1.ListUserStatusesResponse.statuses:type_name -> user.v1.UserStatus
+	1, // 1: user.v1.UserStatusService.ListUserStatuses:input_type -> user.v1.ListUserStatusesRequest
+	2, // 2: user.v1.UserStatusService.ListUserStatuses:output_type -> user.v1.ListUserStatusesResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_user_v1_user_status_proto_init() }
+func file_user_v1_user_status_proto_init() {
+	if File_user_v1_user_status_proto != nil {
+		return
+	}
+	file_user_v1_user_status_proto_msgTypes[1].OneofWrappers = []any{}
+	file_user_v1_user_status_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_status_proto_rawDesc), len(file_user_v1_user_status_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_v1_user_status_proto_goTypes,
+		DependencyIndexes: file_user_v1_user_status_proto_depIdxs,
+		MessageInfos:      file_user_v1_user_status_proto_msgTypes,
+	}.Build()
+	File_user_v1_user_status_proto = out.File
+	file_user_v1_user_status_proto_goTypes = nil
+	file_user_v1_user_status_proto_depIdxs = nil
+}
@@ -0,0 +1,463 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/v1/user_status.proto
+
+package userv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _user_status_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on UserStatus with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserStatus with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserStatusMultiError, or
+// nil if none found.
+func (m *UserStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UserStatusValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IsActive
+
+	// no validation rules for IsBlocked
+
+	// no validation rules for IsArchived
+
+	// no validation rules for SyncVersion
+
+	if len(errors) > 0 {
+		return UserStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UserStatus) _validateUuid(uuid string) error {
+	if matched := _user_status_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UserStatusMultiError is an error wrapping multiple validation errors
+// returned by UserStatus.ValidateAll() if the designated constraints aren't met.
+type UserStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserStatusMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserStatusMultiError) AllErrors() []error { return m }
+
+// UserStatusValidationError is the validation error returned by
+// UserStatus.Validate if the designated constraints aren't met.
+type UserStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserStatusValidationError) ErrorName() string { return "UserStatusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserStatusValidationError{}
+
+// Validate checks the field values on ListUserStatusesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserStatusesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserStatusesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserStatusesRequestMultiError, or nil if none found.
+func (m *ListUserStatusesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserStatusesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 1 || val > 1000 {
+		err := ListUserStatusesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.AfterUserId != nil {
+
+		if err := m._validateUuid(m.GetAfterUserId()); err != nil {
+			err = ListUserStatusesRequestValidationError{
+				field:  "AfterUserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListUserStatusesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListUserStatusesRequest) _validateUuid(uuid string) error {
+	if matched := _user_status_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListUserStatusesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUserStatusesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserStatusesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserStatusesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserStatusesRequestMultiError) AllErrors() []error { return m }
+
+// ListUserStatusesRequestValidationError is the validation error returned by
+// ListUserStatusesRequest.Validate if the designated constraints aren't met.
+type ListUserStatusesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserStatusesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserStatusesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserStatusesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserStatusesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserStatusesRequestValidationError) ErrorName() string {
+	return "ListUserStatusesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserStatusesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserStatusesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserStatusesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserStatusesRequestValidationError{}
+
+// Validate checks the field values on ListUserStatusesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserStatusesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserStatusesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserStatusesResponseMultiError, or nil if none found.
+func (m *ListUserStatusesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserStatusesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUserStatusesResponseValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUserStatusesResponseValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUserStatusesResponseValidationError{
+					field:  fmt.Sprintf("Statuses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.NextUserId != nil {
+
+		if err := m._validateUuid(m.GetNextUserId()); err != nil {
+			err = ListUserStatusesResponseValidationError{
+				field:  "NextUserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListUserStatusesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListUserStatusesResponse) _validateUuid(uuid string) error {
+	if matched := _user_status_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListUserStatusesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListUserStatusesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserStatusesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserStatusesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserStatusesResponseMultiError) AllErrors() []error { return m }
+
+// ListUserStatusesResponseValidationError is the validation error returned by
+// ListUserStatusesResponse.Validate if the designated constraints aren't met.
+type ListUserStatusesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserStatusesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserStatusesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserStatusesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserStatusesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserStatusesResponseValidationError) ErrorName() string {
+	return "ListUserStatusesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserStatusesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserStatusesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserStatusesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserStatusesResponseValidationError{}
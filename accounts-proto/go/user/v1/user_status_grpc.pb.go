@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: user/v1/user_status.proto
+
+package userv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UserStatusService_ListUserStatuses_FullMethodName = "/user.v1.UserStatusService/ListUserStatuses"
+)
+
+// UserStatusServiceClient is the client API for UserStatusService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserStatusServiceClient interface {
+	// ListUserStatuses lists the status and roles of the users, ordered by ID,
+	// so that services projecting them from the user events can catch up with
+	// the users which changed before they consumed the events
+	ListUserStatuses(ctx context.Context, in *ListUserStatusesRequest, opts ...grpc.CallOption) (*ListUserStatusesResponse, error)
+}
+
+type userStatusServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserStatusServiceClient(cc grpc.ClientConnInterface) UserStatusServiceClient {
+	return &userStatusServiceClient{cc}
+}
+
+func (c *userStatusServiceClient) ListUserStatuses(ctx context.Context, in *ListUserStatusesRequest, opts ...grpc.CallOption) (*ListUserStatusesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserStatusesResponse)
+	err := c.cc.Invoke(ctx, UserStatusService_ListUserStatuses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserStatusServiceServer is the server API for UserStatusService service.
+// All implementations must embed UnimplementedUserStatusServiceServer
+// for forward compatibility.
+type UserStatusServiceServer interface {
+	// ListUserStatuses lists the status and roles of the users, ordered by ID,
+	// so that services projecting them from the user events can catch up with
+	// the users which changed before they consumed the events
+	ListUserStatuses(context.Context, *ListUserStatusesRequest) (*ListUserStatusesResponse, error)
+	mustEmbedUnimplementedUserStatusServiceServer()
+}
+
+// UnimplementedUserStatusServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserStatusServiceServer struct{}
+
+func (UnimplementedUserStatusServiceServer) ListUserStatuses(context.Context, *ListUserStatusesRequest) (*ListUserStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserStatuses not implemented")
+}
+func (UnimplementedUserStatusServiceServer) mustEmbedUnimplementedUserStatusServiceServer() {}
+func (UnimplementedUserStatusServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeUserStatusServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserStatusServiceServer will
+// result in compilation errors.
+type UnsafeUserStatusServiceServer interface {
+	mustEmbedUnimplementedUserStatusServiceServer()
+}
+
+func RegisterUserStatusServiceServer(s grpc.ServiceRegistrar, srv UserStatusServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserStatusServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserStatusService_ServiceDesc, srv)
+}
+
+func _UserStatusService_ListUserStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserStatusServiceServer).ListUserStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserStatusService_ListUserStatuses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserStatusServiceServer).ListUserStatuses(ctx, req.(*ListUserStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserStatusService_ServiceDesc is the grpc.ServiceDesc for UserStatusService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserStatusService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.v1.UserStatusService",
+	HandlerType: (*UserStatusServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListUserStatuses",
+			Handler:    _UserStatusService_ListUserStatuses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user/v1/user_status.proto",
+}
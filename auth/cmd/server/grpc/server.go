@@ -18,6 +18,10 @@ type GRPCServer struct {
 	server           *grpc.Server
 	localUserHandler authv1.LocalUserServiceServer
 	oauthUserHandler authv1.OAuthUserServiceServer
+	exportHandler    authv1.DataExportServiceServer
+	clientHandler    authv1.OAuthClientAdminServiceServer
+	apiKeyHandler    authv1.APIKeyServiceServer
+	sessionHandler   authv1.SessionAdminServiceServer
 	logger           *zap.Logger
 	port             int
 }
@@ -27,6 +31,10 @@ func NewGRPCServer(
 	logger *zap.Logger,
 	localUserHandler authv1.LocalUserServiceServer,
 	oauthUserHandler authv1.OAuthUserServiceServer,
+	exportHandler authv1.DataExportServiceServer,
+	clientHandler authv1.OAuthClientAdminServiceServer,
+	apiKeyHandler authv1.APIKeyServiceServer,
+	sessionHandler authv1.SessionAdminServiceServer,
 	servingServices []string,
 ) (server.Server, error) {
 	server := grpc.NewServer()
@@ -44,11 +52,19 @@ func NewGRPCServer(
 	// Register the token handler
 	authv1.RegisterLocalUserServiceServer(server, localUserHandler)
 	authv1.RegisterOAuthUserServiceServer(server, oauthUserHandler)
+	authv1.RegisterDataExportServiceServer(server, exportHandler)
+	authv1.RegisterOAuthClientAdminServiceServer(server, clientHandler)
+	authv1.RegisterAPIKeyServiceServer(server, apiKeyHandler)
+	authv1.RegisterSessionAdminServiceServer(server, sessionHandler)
 
 	return &GRPCServer{
 		server:           server,
 		localUserHandler: localUserHandler,
 		oauthUserHandler: oauthUserHandler,
+		exportHandler:    exportHandler,
+		clientHandler:    clientHandler,
+		apiKeyHandler:    apiKeyHandler,
+		sessionHandler:   sessionHandler,
 		logger:           logger,
 		port:             port,
 	}, nil
@@ -11,31 +11,111 @@ import (
 	"go.uber.org/zap"
 	"mandacode.com/accounts/auth/internal/handler/v1/http"
 	httpmiddleware "mandacode.com/accounts/auth/internal/middleware/http"
+	"mandacode.com/accounts/auth/internal/usecase/token"
 )
 
+// RateLimits are the rate limiting middlewares of the route groups.
+type RateLimits struct {
+	Login   gin.HandlerFunc // Sign in routes, by IP
+	Token   gin.HandlerFunc // Refresh and logout, by IP
+	Device  gin.HandlerFunc // Device authorization, by IP
+	OIDC    gin.HandlerFunc // OpenID Connect provider, by IP
+	Account gin.HandlerFunc // Routes of signed in users, by user
+	Admin   gin.HandlerFunc // Admin API, by route
+}
+
 type Server struct {
 	http             *http.Server
 	engine           *gin.Engine
 	logger           *zap.Logger
 	localAuthHandler *httphandlerv1.LocalAuthHandler
 	oauthHandler     *httphandlerv1.OAuthHandler
+	deviceHandler    *httphandlerv1.DeviceAuthHandler
+	oidcHandler      *httphandlerv1.OIDCHandler
+	apiKeyHandler    *httphandlerv1.APIKeyHandler
+	sessionHandler   *httphandlerv1.SessionHandler
+	tokenHandler     *httphandlerv1.TokenHandler
+	orgTokenHandler  *httphandlerv1.OrganizationTokenHandler
+	historyHandler   *httphandlerv1.LoginHistoryHandler
+	stepUpHandler    *httphandlerv1.StepUpHandler
+	restoreHandler   *httphandlerv1.RestoreHandler
+	consentHandler   *httphandlerv1.ConsentHandler
+	impersonation    *httphandlerv1.ImpersonationHandler
+	verifyUsecase    *token.VerifyUsecase
+	captcha          gin.HandlerFunc
+	requestInfo      gin.HandlerFunc
+	rateLimits       RateLimits
+	adminHeaderKey   string
+	adminAPIKey      string
 	port             int
+	trustedProxies   []string
 	sessionName      string
 	sessionStore     sessions.Store
 }
 
 // Start implements server.Server.
 func (s *Server) Start(ctx context.Context) error {
+	// Without trusted proxies, the client IP is the peer address and X-Forwarded-For is ignored
+	if err := s.engine.SetTrustedProxies(s.trustedProxies); err != nil {
+		return err
+	}
 	s.engine.Use(gin.Recovery())
 	s.engine.Use(sessions.Sessions(s.sessionName, s.sessionStore))
 	s.engine.Use(httpmiddleware.ErrorHandler(s.logger))
+	s.engine.Use(s.requestInfo)
 
-	localAuthGroup := s.engine.Group("/v1/auth/local")
-	s.localAuthHandler.RegisterRoutes(localAuthGroup)
+	localAuthGroup := s.engine.Group("/v1/auth/local", s.rateLimits.Login)
+	s.localAuthHandler.RegisterRoutes(localAuthGroup, s.captcha)
 
-	oauthGroup := s.engine.Group("/v1/auth/oauth")
+	oauthGroup := s.engine.Group("/v1/auth/oauth", s.rateLimits.Login)
 	s.oauthHandler.RegisterRoutes(oauthGroup)
 
+	tokenGroup := s.engine.Group("/v1/auth", s.rateLimits.Token)
+	s.tokenHandler.RegisterRoutes(tokenGroup)
+
+	// Organization tokens are minted from the caller's own access token, never from an impersonation token
+	orgTokenGroup := s.engine.Group("/v1/auth/organization-token")
+	orgTokenGroup.Use(httpmiddleware.AccessTokenAuth(s.verifyUsecase), httpmiddleware.DenyImpersonation(), s.rateLimits.Account)
+	s.orgTokenHandler.RegisterRoutes(orgTokenGroup)
+
+	stepUpGroup := s.engine.Group("/v1/auth/step-up", s.rateLimits.Login)
+	s.stepUpHandler.RegisterRoutes(stepUpGroup)
+	restoreGroup := s.engine.Group("/v1/auth/restore", s.rateLimits.Login)
+	s.restoreHandler.RegisterRoutes(restoreGroup)
+	consentGroup := s.engine.Group("/v1/auth/consent", s.rateLimits.Login)
+	s.consentHandler.RegisterRoutes(consentGroup)
+
+	deviceGroup := s.engine.Group("/v1/auth/device", s.rateLimits.Device)
+	s.deviceHandler.RegisterRoutes(deviceGroup, httpmiddleware.AccessTokenAuth(s.verifyUsecase), httpmiddleware.DenyImpersonation())
+
+	// Authorization signs the user in with the refresh token of the cookie session, which impersonation never
+	// issues, so impersonation tokens cannot reach it
+	oidcGroup := s.engine.Group("/v1/oidc", s.rateLimits.OIDC)
+	s.oidcHandler.RegisterRoutes(oidcGroup)
+
+	wellKnownGroup := s.engine.Group("/.well-known", s.rateLimits.OIDC)
+	s.oidcHandler.RegisterWellKnownRoutes(wellKnownGroup)
+
+	adminGroup := s.engine.Group("/v1/admin", s.rateLimits.Admin)
+	adminGroup.Use(httpmiddleware.AdminKeyAuth(s.adminHeaderKey, s.adminAPIKey))
+
+	// Impersonation also requires the admin's own access token, which identifies them in the token and the audit trail
+	impersonationGroup := adminGroup.Group("/impersonations")
+	impersonationGroup.Use(httpmiddleware.AccessTokenAuth(s.verifyUsecase), httpmiddleware.DenyImpersonation())
+	s.impersonation.RegisterAdminRoutes(impersonationGroup)
+
+	apiKeyGroup := s.engine.Group("/v1/auth/api-keys")
+	apiKeyGroup.Use(httpmiddleware.AccessTokenAuth(s.verifyUsecase), s.rateLimits.Account)
+	s.apiKeyHandler.RegisterRoutes(apiKeyGroup, httpmiddleware.DenyImpersonation())
+
+	sessionGroup := s.engine.Group("/v1/auth/sessions")
+	sessionGroup.Use(httpmiddleware.AccessTokenAuth(s.verifyUsecase), s.rateLimits.Account)
+	s.sessionHandler.RegisterRoutes(sessionGroup, httpmiddleware.DenyImpersonation())
+
+	historyGroup := s.engine.Group("/v1/auth/login-history")
+	historyGroup.Use(httpmiddleware.AccessTokenAuth(s.verifyUsecase), s.rateLimits.Account)
+	s.historyHandler.RegisterRoutes(historyGroup)
+
 	s.logger.Info("starting HTTP server", zap.Int("port", s.port))
 	if err := s.http.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 		s.logger.Error("failed to start HTTP server", zap.Error(err))
@@ -60,8 +140,26 @@ func NewServer(
 	logger *zap.Logger,
 	localAuthHandler *httphandlerv1.LocalAuthHandler,
 	oauthHandler *httphandlerv1.OAuthHandler,
+	deviceHandler *httphandlerv1.DeviceAuthHandler,
+	oidcHandler *httphandlerv1.OIDCHandler,
+	apiKeyHandler *httphandlerv1.APIKeyHandler,
+	sessionHandler *httphandlerv1.SessionHandler,
+	tokenHandler *httphandlerv1.TokenHandler,
+	orgTokenHandler *httphandlerv1.OrganizationTokenHandler,
+	historyHandler *httphandlerv1.LoginHistoryHandler,
+	stepUpHandler *httphandlerv1.StepUpHandler,
+	restoreHandler *httphandlerv1.RestoreHandler,
+	consentHandler *httphandlerv1.ConsentHandler,
+	impersonation *httphandlerv1.ImpersonationHandler,
+	verifyUsecase *token.VerifyUsecase,
+	captcha gin.HandlerFunc,
+	requestInfo gin.HandlerFunc,
+	rateLimits RateLimits,
+	adminHeaderKey string,
+	adminAPIKey string,
 	sessionName string,
 	sessionStore sessions.Store,
+	trustedProxies []string,
 ) server.Server {
 	engine := gin.Default()
 	return &Server{
@@ -69,8 +167,26 @@ func NewServer(
 		engine:           engine,
 		logger:           logger,
 		port:             port,
+		trustedProxies:   trustedProxies,
 		localAuthHandler: localAuthHandler,
 		oauthHandler:     oauthHandler,
+		deviceHandler:    deviceHandler,
+		oidcHandler:      oidcHandler,
+		apiKeyHandler:    apiKeyHandler,
+		sessionHandler:   sessionHandler,
+		tokenHandler:     tokenHandler,
+		orgTokenHandler:  orgTokenHandler,
+		historyHandler:   historyHandler,
+		stepUpHandler:    stepUpHandler,
+		restoreHandler:   restoreHandler,
+		consentHandler:   consentHandler,
+		impersonation:    impersonation,
+		verifyUsecase:    verifyUsecase,
+		captcha:          captcha,
+		requestInfo:      requestInfo,
+		rateLimits:       rateLimits,
+		adminHeaderKey:   adminHeaderKey,
+		adminAPIKey:      adminAPIKey,
 		sessionName:      sessionName,
 		sessionStore:     sessionStore,
 	}
@@ -7,7 +7,11 @@ import (
 	"os/signal"
 
 	sessionredis "github.com/gin-contrib/sessions/redis"
+	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	authv1 "github.com/mandacode-com/accounts-proto/go/auth/v1"
+	tokenv1 "github.com/mandacode-com/accounts-proto/go/token/v1"
+	userv1 "github.com/mandacode-com/accounts-proto/go/user/v1"
 	"github.com/mandacode-com/golib/server"
 	"github.com/redis/go-redis/v9"
 	"github.com/segmentio/kafka-go"
@@ -15,23 +19,50 @@ import (
 	grpcserver "mandacode.com/accounts/auth/cmd/server/grpc"
 	httpserver "mandacode.com/accounts/auth/cmd/server/http"
 	kafkaserver "mandacode.com/accounts/auth/cmd/server/kafka"
+	userstatusserver "mandacode.com/accounts/auth/cmd/server/userstatus"
 	"mandacode.com/accounts/auth/config"
 	"mandacode.com/accounts/auth/ent/authaccount"
+	"mandacode.com/accounts/shared/captcha"
+	"mandacode.com/accounts/shared/ratelimit"
 
 	_ "mandacode.com/accounts/auth/ent/runtime"
 	grpchandlerv1 "mandacode.com/accounts/auth/internal/handler/v1/grpc"
 	"mandacode.com/accounts/auth/internal/handler/v1/http"
 	kafkahandlerv1 "mandacode.com/accounts/auth/internal/handler/v1/kafka"
+	auditinfra "mandacode.com/accounts/auth/internal/infra/audit"
 	dbinfra "mandacode.com/accounts/auth/internal/infra/database"
+	geoipinfra "mandacode.com/accounts/auth/internal/infra/geoip"
+	idtokeninfra "mandacode.com/accounts/auth/internal/infra/idtoken"
+	"mandacode.com/accounts/auth/internal/infra/mailer"
 	"mandacode.com/accounts/auth/internal/infra/oauthapi"
 	signupinfra "mandacode.com/accounts/auth/internal/infra/signup"
 	tokeninfra "mandacode.com/accounts/auth/internal/infra/token"
+	userinfra "mandacode.com/accounts/auth/internal/infra/user"
+	httpmiddleware "mandacode.com/accounts/auth/internal/middleware/http"
 	coderepo "mandacode.com/accounts/auth/internal/repository/code"
+	consentrepo "mandacode.com/accounts/auth/internal/repository/consent"
 	dbrepository "mandacode.com/accounts/auth/internal/repository/database"
+	devicerepo "mandacode.com/accounts/auth/internal/repository/device"
+	orgrepo "mandacode.com/accounts/auth/internal/repository/organization"
+	rbacrepo "mandacode.com/accounts/auth/internal/repository/rbac"
+	restorerepo "mandacode.com/accounts/auth/internal/repository/restore"
+	stepuprepo "mandacode.com/accounts/auth/internal/repository/stepup"
 	tokenrepo "mandacode.com/accounts/auth/internal/repository/token"
+	userrepo "mandacode.com/accounts/auth/internal/repository/user"
+	userstatusrepo "mandacode.com/accounts/auth/internal/repository/userstatus"
+	"mandacode.com/accounts/auth/internal/usecase/apikey"
 	"mandacode.com/accounts/auth/internal/usecase/authuser"
+	"mandacode.com/accounts/auth/internal/usecase/dataexport"
+	"mandacode.com/accounts/auth/internal/usecase/impersonation"
 	"mandacode.com/accounts/auth/internal/usecase/login"
+	"mandacode.com/accounts/auth/internal/usecase/loginhistory"
+	"mandacode.com/accounts/auth/internal/usecase/oauthclient"
+	"mandacode.com/accounts/auth/internal/usecase/oidc"
+	"mandacode.com/accounts/auth/internal/usecase/risk"
+	"mandacode.com/accounts/auth/internal/usecase/token"
 	"mandacode.com/accounts/auth/internal/usecase/userevent"
+	"mandacode.com/accounts/auth/internal/usecase/usersession"
+	"mandacode.com/accounts/auth/internal/usecase/userstatus"
 	"mandacode.com/accounts/auth/internal/util"
 )
 
@@ -55,6 +86,11 @@ func main() {
 		Password: cfg.LoginCodeStore.Password,
 		DB:       cfg.LoginCodeStore.DB,
 	})
+	deviceCodeStore := redis.NewClient(&redis.Options{
+		Addr:     cfg.DeviceCodeStore.Address,
+		Password: cfg.DeviceCodeStore.Password,
+		DB:       cfg.DeviceCodeStore.DB,
+	})
 	sessionStore, err := sessionredis.NewStore(
 		cfg.SessionStore.DB,
 		"tcp",
@@ -72,10 +108,32 @@ func main() {
 	if err != nil {
 		logger.Fatal("failed to create database client", zap.Error(err))
 	}
-	tokenClient, _, err := tokeninfra.NewTokenClient(cfg.TokenClient.Address)
+	tokenClient, tokenConn, err := tokeninfra.NewTokenClient(cfg.TokenClient.Address)
 	if err != nil {
 		logger.Fatal("failed to create token client", zap.Error(err))
 	}
+	impersonationClient := tokenv1.NewImpersonationServiceClient(tokenConn)
+	organizationClient, userConn, err := userinfra.NewOrganizationClient(cfg.UserClient.Address)
+	if err != nil {
+		logger.Fatal("failed to create user client", zap.Error(err))
+	}
+	defer userConn.Close()
+	rbacClient := userv1.NewRBACServiceClient(userConn)
+
+	mailEventWriter := &kafka.Writer{
+		Addr:                   kafka.TCP(cfg.MailEventWriter.Address...),
+		Topic:                  cfg.MailEventWriter.Topic,
+		Balancer:               &kafka.Hash{},
+		AllowAutoTopicCreation: true,
+	}
+	defer mailEventWriter.Close()
+	auditEventWriter := &kafka.Writer{
+		Addr:                   kafka.TCP(cfg.AuditEventWriter.Address...),
+		Topic:                  cfg.AuditEventWriter.Topic,
+		Balancer:               &kafka.Hash{},
+		AllowAutoTopicCreation: true,
+	}
+	defer auditEventWriter.Close()
 
 	userEventReader := kafka.NewReader(kafka.ReaderConfig{
 		Brokers: cfg.UserEventReader.Brokers,
@@ -109,26 +167,112 @@ func main() {
 		validator,
 	)
 
+	idTokenSigner, err := idtokeninfra.NewIDTokenSignerByStr(cfg.OIDC.SigningPrivateKey, cfg.OIDC.Issuer, cfg.OIDC.IDTokenTTL)
+	if err != nil {
+		logger.Fatal("failed to create ID token signer", zap.Error(err))
+	}
+	geoLocator, err := geoipinfra.NewLocator(cfg.LoginHistory.GeoIPDatabasePath)
+	if err != nil {
+		logger.Fatal("failed to open GeoIP database", zap.Error(err))
+	}
+	defer geoLocator.Close()
+	mailSender := mailer.NewMailer(mailEventWriter)
+	auditEmitter := auditinfra.NewEmitter(auditEventWriter)
+	captchaVerifier, err := captcha.NewVerifier(cfg.Captcha.Provider, cfg.Captcha.Secret, &http.Client{
+		Timeout: cfg.Captcha.Timeout,
+	})
+	if err != nil {
+		logger.Fatal("failed to create CAPTCHA verifier", zap.Error(err))
+	}
+	captchaFailures := captcha.NewFailureTracker(loginCodeStore, cfg.LoginCodeStore.Prefix+"captcha:", cfg.Captcha.FailureWindow)
+
 	// Initialize random code generators
 	loginCodeGenerator := util.NewRandomGenerator(32)
+	deviceCodeGenerator := util.NewRandomGenerator(32)
+	userCodeGenerator := util.NewUserCodeGenerator(8)
+	authorizationCodeGenerator := util.NewRandomGenerator(32)
+	clientSecretGenerator := util.NewRandomGenerator(32)
+	apiKeyPrefixGenerator := util.NewRandomGenerator(4)
+	apiKeySecretGenerator := util.NewRandomGenerator(24)
+	stepUpChallengeIDGenerator := util.NewRandomGenerator(32)
+	stepUpCodeGenerator := util.NewNumericCodeGenerator(6)
+	restoreTokenGenerator := util.NewRandomGenerator(32)
 
 	// Initialize repositories
 	authAccountRepo := dbrepository.NewAuthAccountRepository(dbClient)
-	tokenRepo := tokenrepo.NewTokenRepository(tokenClient)
+	oauthClientRepo := dbrepository.NewOAuthClientRepository(dbClient)
+	apiKeyRepo := dbrepository.NewAPIKeyRepository(dbClient)
+	sessionRepo := dbrepository.NewSessionRepository(dbClient)
+	loginAttemptRepo := dbrepository.NewLoginAttemptRepository(dbClient)
+	userStatusRepo := dbrepository.NewUserStatusRepository(dbClient)
+	tokenRepo := tokenrepo.NewTokenRepository(tokenClient, impersonationClient, userStatusRepo)
 
 	// Initialize code managers
 	loginCodeManager := coderepo.NewCodeManager(loginCodeGenerator, cfg.LoginCodeStore.Timeout, loginCodeStore, cfg.LoginCodeStore.Prefix)
+	authorizationCodeManager := coderepo.NewCodeManager(authorizationCodeGenerator, cfg.OIDC.AuthorizationCodeTTL, loginCodeStore, cfg.LoginCodeStore.Prefix+"authorization:")
+	stepUpChallengeManager := stepuprepo.NewChallengeManager(
+		stepUpChallengeIDGenerator,
+		stepUpCodeGenerator,
+		cfg.Risk.StepUpCodeTTL,
+		cfg.Risk.StepUpMaxAttempts,
+		loginCodeStore,
+		cfg.LoginCodeStore.Prefix+"stepup:",
+	)
+	restoreChallengeManager := restorerepo.NewTokenManager(restoreTokenGenerator, cfg.Restore.ChallengeTTL, loginCodeStore, cfg.LoginCodeStore.Prefix+"restore:")
+	cancelLinkManager := restorerepo.NewTokenManager(restoreTokenGenerator, cfg.Restore.GracePeriod, loginCodeStore, cfg.LoginCodeStore.Prefix+"cancel_deletion:")
+	consentChallengeManager := restorerepo.NewTokenManager(restoreTokenGenerator, cfg.Consent.ChallengeTTL, loginCodeStore, cfg.LoginCodeStore.Prefix+"consent:")
+	deviceCodeManager := devicerepo.NewDeviceCodeManager(
+		deviceCodeGenerator,
+		userCodeGenerator,
+		cfg.DeviceCodeStore.Timeout,
+		cfg.DeviceAuth.PollInterval,
+		deviceCodeStore,
+		cfg.DeviceCodeStore.Prefix,
+	)
 
 	// Initialize use cases
-	localUserUsecase := authuser.NewLocalUserUsecase(authAccountRepo)
-	oauthUserUsecase := authuser.NewOAuthUserUsecase(authAccountRepo, oauthApis)
-	localLoginUsecase := login.NewLocalLoginUsecase(authAccountRepo, tokenRepo, loginCodeManager)
-	oauthLoginUsecase := login.NewOAuthLoginUsecase(authAccountRepo, tokenRepo, loginCodeManager, singupApi, oauthApis)
-	userEventUsecase := userevent.NewUserEventUsecase(authAccountRepo)
+	riskEvaluator, err := risk.NewEvaluator(loginAttemptRepo, geoLocator, risk.Rules{
+		Enabled:                  cfg.Risk.Enabled,
+		DenyCIDRs:                cfg.Risk.DenyCIDRs,
+		StepUpCIDRs:              cfg.Risk.StepUpCIDRs,
+		FailedAttemptsWindow:     cfg.Risk.FailedAttemptsWindow,
+		FailedAttemptsStepUp:     cfg.Risk.FailedAttemptsStepUp,
+		FailedAttemptsDeny:       cfg.Risk.FailedAttemptsDeny,
+		ImpossibleTravelSpeedKMH: cfg.Risk.ImpossibleTravelSpeedKMH,
+		ImpossibleTravelAction:   risk.Decision(cfg.Risk.ImpossibleTravelAction),
+		NewDeviceAction:          risk.Decision(cfg.Risk.NewDeviceAction),
+	}, logger)
+	if err != nil {
+		logger.Fatal("failed to create risk evaluator", zap.Error(err))
+	}
+	localUserUsecase := authuser.NewLocalUserUsecase(authAccountRepo, userStatusRepo)
+	oauthUserUsecase := authuser.NewOAuthUserUsecase(authAccountRepo, userStatusRepo, oauthApis)
+	userStatusUsecase := userstatus.NewUserStatusUsecase(userStatusRepo)
+	loginHistoryUsecase := loginhistory.NewLoginHistoryUsecase(loginAttemptRepo, authAccountRepo, geoLocator, mailSender, cfg.LoginHistory.SecurityURL, logger)
+	restoreUsecase := login.NewRestoreUsecase(userStatusUsecase, restoreChallengeManager, cancelLinkManager, userrepo.NewUserRepository(userv1.NewUserManagementServiceClient(userConn)), tokenRepo, sessionRepo, loginHistoryUsecase, logger)
+	stepUpUsecase := login.NewStepUpUsecase(riskEvaluator, stepUpChallengeManager, authAccountRepo, tokenRepo, sessionRepo, loginHistoryUsecase, userStatusUsecase, restoreUsecase, mailSender, logger)
+	consentUsecase := login.NewConsentUsecase(userStatusUsecase, consentChallengeManager, consentrepo.NewConsentRepository(userv1.NewConsentServiceClient(userConn)), stepUpUsecase, restoreUsecase, tokenRepo, sessionRepo, loginHistoryUsecase, logger)
+	localLoginUsecase := login.NewLocalLoginUsecase(authAccountRepo, tokenRepo, loginCodeManager, sessionRepo, loginHistoryUsecase, userStatusUsecase, stepUpUsecase, restoreUsecase, consentUsecase)
+	oauthLoginUsecase := login.NewOAuthLoginUsecase(authAccountRepo, tokenRepo, loginCodeManager, singupApi, oauthApis, sessionRepo, loginHistoryUsecase, userStatusUsecase, stepUpUsecase, restoreUsecase, consentUsecase)
+	deviceLoginUsecase := login.NewDeviceLoginUsecase(oauthClientRepo, tokenRepo, deviceCodeManager, userCodeGenerator, cfg.DeviceAuth.VerificationURI, sessionRepo, loginHistoryUsecase, userStatusUsecase, consentUsecase, stepUpUsecase)
+	oidcProviderUsecase := oidc.NewProviderUsecase(authAccountRepo, oauthClientRepo, tokenRepo, authorizationCodeManager, idTokenSigner, cfg.OIDC.LoginURL, sessionRepo, userStatusUsecase, consentUsecase)
+	adminClientUsecase := oauthclient.NewAdminClientUsecase(oauthClientRepo, clientSecretGenerator, validator, auditEmitter)
+	apiKeyUsecase := apikey.NewAPIKeyUsecase(apiKeyRepo, userStatusUsecase, apiKeyPrefixGenerator, apiKeySecretGenerator, cfg.APIKey.AllowedScopes, cfg.APIKey.MaxPerUser)
+	sessionUsecase := usersession.NewSessionUsecase(sessionRepo, auditEmitter)
+	impersonationUsecase := impersonation.NewImpersonationUsecase(tokenRepo, authAccountRepo, rbacrepo.NewRBACRepository(rbacClient), userStatusUsecase, mailSender, auditEmitter, logger)
+	exportUsecase := dataexport.NewExportUsecase(authAccountRepo, sessionRepo, apiKeyRepo, loginAttemptRepo, userStatusRepo)
+	verifyUsecase := token.NewVerifyUsecase(tokenRepo)
+	refreshUsecase := token.NewRefreshUsecase(tokenRepo, sessionRepo, userStatusUsecase)
+	orgTokenUsecase := token.NewOrganizationTokenUsecase(tokenRepo, orgrepo.NewOrganizationRepository(organizationClient), userStatusUsecase)
+	userEventUsecase := userevent.NewUserEventUsecase(authAccountRepo, apiKeyRepo, sessionRepo, loginAttemptRepo, userStatusRepo, cancelLinkManager, mailSender, cfg.Restore.CancelLinkURL)
 
 	// Initialize handlers
 	localUserHandler := grpchandlerv1.NewLocalUserHandler(localUserUsecase, logger)
 	oauthUserHandler := grpchandlerv1.NewOAuthUserHandler(oauthUserUsecase, logger)
+	exportHandler := grpchandlerv1.NewDataExportHandler(exportUsecase, logger)
+	oauthClientAdminHandler := grpchandlerv1.NewOAuthClientAdminHandler(adminClientUsecase, logger)
+	apiKeyGRPCHandler := grpchandlerv1.NewAPIKeyHandler(apiKeyUsecase, logger)
+	sessionAdminHandler := grpchandlerv1.NewSessionAdminHandler(sessionUsecase, logger)
 
 	localAuthHandler, err := httphandlerv1.NewLocalAuthHandler(localLoginUsecase, logger, validator)
 	if err != nil {
@@ -138,6 +282,69 @@ func main() {
 	if err != nil {
 		logger.Fatal("failed to create OAuth handler", zap.Error(err))
 	}
+	deviceAuthHandler, err := httphandlerv1.NewDeviceAuthHandler(deviceLoginUsecase, logger, validator)
+	if err != nil {
+		logger.Fatal("failed to create device auth handler", zap.Error(err))
+	}
+	oidcHandler, err := httphandlerv1.NewOIDCHandler(oidcProviderUsecase, logger, validator)
+	if err != nil {
+		logger.Fatal("failed to create OIDC handler", zap.Error(err))
+	}
+	apiKeyHandler, err := httphandlerv1.NewAPIKeyHandler(apiKeyUsecase, logger, validator)
+	if err != nil {
+		logger.Fatal("failed to create API key handler", zap.Error(err))
+	}
+	sessionHandler, err := httphandlerv1.NewSessionHandler(sessionUsecase, logger)
+	if err != nil {
+		logger.Fatal("failed to create session handler", zap.Error(err))
+	}
+	tokenHandler, err := httphandlerv1.NewTokenHandler(refreshUsecase, sessionUsecase, cfg.CSRF.TrustedOrigins, logger, validator)
+	if err != nil {
+		logger.Fatal("failed to create token handler", zap.Error(err))
+	}
+	orgTokenHandler, err := httphandlerv1.NewOrganizationTokenHandler(orgTokenUsecase, logger, validator)
+	if err != nil {
+		logger.Fatal("failed to create organization token handler", zap.Error(err))
+	}
+	loginHistoryHandler, err := httphandlerv1.NewLoginHistoryHandler(loginHistoryUsecase, logger)
+	if err != nil {
+		logger.Fatal("failed to create login history handler", zap.Error(err))
+	}
+	stepUpHandler, err := httphandlerv1.NewStepUpHandler(stepUpUsecase, logger, validator)
+	if err != nil {
+		logger.Fatal("failed to create step-up handler", zap.Error(err))
+	}
+	restoreHandler, err := httphandlerv1.NewRestoreHandler(restoreUsecase, logger, validator)
+	if err != nil {
+		logger.Fatal("failed to create restore handler", zap.Error(err))
+	}
+	consentHandler, err := httphandlerv1.NewConsentHandler(consentUsecase, logger, validator)
+	if err != nil {
+		logger.Fatal("failed to create consent handler", zap.Error(err))
+	}
+	impersonationHandler, err := httphandlerv1.NewImpersonationHandler(impersonationUsecase, logger, validator)
+	if err != nil {
+		logger.Fatal("failed to create impersonation handler", zap.Error(err))
+	}
+	captchaMiddleware := captcha.Middleware(captchaVerifier, captchaFailures, cfg.Captcha.FailureThreshold, logger)
+	var rateLimiter *ratelimit.Limiter
+	if cfg.RateLimit.Enabled {
+		rateLimiter = ratelimit.NewLimiter(loginCodeStore, cfg.LoginCodeStore.Prefix+"ratelimit:", func(c *gin.Context) string {
+			userID, err := httpmiddleware.UserIDFromContext(c)
+			if err != nil {
+				return ""
+			}
+			return userID.String()
+		}, logger)
+	}
+	rateLimits := httpserver.RateLimits{
+		Login:   rateLimiter.Limit(rateLimitRule("login", cfg.RateLimit.Login, ratelimit.ByIP)),
+		Token:   rateLimiter.Limit(rateLimitRule("token", cfg.RateLimit.Token, ratelimit.ByIP)),
+		Device:  rateLimiter.Limit(rateLimitRule("device", cfg.RateLimit.Device, ratelimit.ByIP)),
+		OIDC:    rateLimiter.Limit(rateLimitRule("oidc", cfg.RateLimit.OIDC, ratelimit.ByIP)),
+		Account: rateLimiter.Limit(rateLimitRule("account", cfg.RateLimit.Account, ratelimit.ByUser)),
+		Admin:   rateLimiter.Limit(rateLimitRule("admin", cfg.RateLimit.Admin, ratelimit.ByRoute)),
+	}
 	userEventHandler := kafkahandlerv1.NewUserEventHandler(userEventUsecase)
 
 	// Initialize servers
@@ -146,8 +353,26 @@ func main() {
 		logger,
 		localAuthHandler,
 		oauthHandler,
+		deviceAuthHandler,
+		oidcHandler,
+		apiKeyHandler,
+		sessionHandler,
+		tokenHandler,
+		orgTokenHandler,
+		loginHistoryHandler,
+		stepUpHandler,
+		restoreHandler,
+		consentHandler,
+		impersonationHandler,
+		verifyUsecase,
+		captchaMiddleware,
+		httpmiddleware.RequestInfo(cfg.HTTPServer.RequestIDHeader),
+		rateLimits,
+		cfg.AdminAPI.HeaderKey,
+		cfg.AdminAPI.APIKey,
 		cfg.SessionStore.SessionName,
 		sessionStore,
+		cfg.HTTPServer.TrustedProxies,
 	)
 	kafkaServer := kafkaserver.NewKafkaServer(logger, []*kafkaserver.ReaderHandler{
 		{
@@ -160,22 +385,37 @@ func main() {
 		logger,
 		localUserHandler,
 		oauthUserHandler,
+		exportHandler,
+		oauthClientAdminHandler,
+		apiKeyGRPCHandler,
+		sessionAdminHandler,
 		[]string{
 			"accounts.auth.v1.LocalUserService",
 			"accounts.auth.v1.OAuthUserService",
+			authv1.DataExportService_ServiceDesc.ServiceName,
+			authv1.OAuthClientAdminService_ServiceDesc.ServiceName,
+			authv1.APIKeyService_ServiceDesc.ServiceName,
+			authv1.SessionAdminService_ServiceDesc.ServiceName,
 		},
 	)
 	if err != nil {
 		logger.Fatal("failed to create gRPC server", zap.Error(err))
 	}
 
-	serverManager := server.NewServerManager(
-		[]server.Server{
-			httpServer,
-			kafkaServer,
-			grpcServer,
-		},
-	)
+	servers := []server.Server{
+		httpServer,
+		kafkaServer,
+		grpcServer,
+	}
+
+	// Initialize user status sync, which catches up with the users which changed before the user events were consumed
+	if cfg.UserStatusSync.Enabled {
+		userStatusSource := userstatusrepo.NewUserStatusRepository(userv1.NewUserStatusServiceClient(userConn))
+		userStatusSyncUsecase := userstatus.NewSyncUsecase(userStatusRepo, userStatusSource, cfg.UserStatusSync.PageSize)
+		servers = append(servers, userstatusserver.NewServer(userStatusSyncUsecase, logger))
+	}
+
+	serverManager := server.NewServerManager(servers)
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -191,3 +431,13 @@ func main() {
 		logger.Fatal("failed to start server", zap.Error(err))
 	}
 }
+
+// rateLimitRule converts a configured rate limit into the rule of a route group
+func rateLimitRule(name string, cfg config.RateLimitRuleConfig, key ratelimit.Key) ratelimit.Rule {
+	return ratelimit.Rule{
+		Name:   name,
+		Limit:  cfg.Limit,
+		Window: cfg.Window,
+		Key:    key,
+	}
+}
@@ -0,0 +1,74 @@
+package userstatusserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/mandacode-com/golib/server"
+	"go.uber.org/zap"
+	"mandacode.com/accounts/auth/internal/usecase/userstatus"
+)
+
+// Server syncs the user statuses from the user service once at startup, alongside the consumption of the user
+// events.
+//
+// Every replica may run the server, as applying a status twice has no effect.
+type Server struct {
+	syncUsecase *userstatus.SyncUsecase
+	logger      *zap.Logger
+	stop        chan struct{}
+	done        chan struct{}
+}
+
+// Start implements server.Server.
+func (s *Server) Start(ctx context.Context) error {
+	defer close(s.done)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	s.logger.Info("starting user status sync")
+	start := time.Now()
+	result, err := s.syncUsecase.Sync(ctx)
+	if err != nil {
+		s.logger.Error("failed to sync user statuses", zap.Error(err),
+			zap.Int("applied", result.Applied),
+			zap.Int("skipped", result.Skipped),
+		)
+	} else {
+		s.logger.Info("synced user statuses",
+			zap.Int("applied", result.Applied),
+			zap.Int("skipped", result.Skipped),
+			zap.Duration("duration", time.Since(start)),
+		)
+	}
+
+	<-ctx.Done()
+	return nil
+}
+
+// Stop implements server.Server.
+//
+// It interrupts the sync if it is still running. The statuses applied so far are kept.
+func (s *Server) Stop(ctx context.Context) error {
+	close(s.stop)
+	<-s.done
+	return nil
+}
+
+// NewServer creates a server syncing the user statuses at startup.
+func NewServer(syncUsecase *userstatus.SyncUsecase, logger *zap.Logger) server.Server {
+	return &Server{
+		syncUsecase: syncUsecase,
+		logger:      logger,
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
@@ -47,7 +47,9 @@ type SessionStoreConfig struct {
 }
 
 type HTTPServerConfig struct {
-	Port int `validate:"required,min=1,max=65535"`
+	Port            int      `validate:"required,min=1,max=65535"`
+	RequestIDHeader string   `validate:"required"`
+	TrustedProxies  []string `validate:"dive,cidr|ip"` // Proxies whose X-Forwarded-For is trusted for the client IP, none by default
 }
 type GRPCServerConfig struct {
 	Port int `validate:"required,min=1,max=65535"`
@@ -56,24 +58,126 @@ type GRPCServerConfig struct {
 type GRPCClientConfig struct {
 	Address string `validate:"required"`
 }
+type DeviceAuthConfig struct {
+	VerificationURI string        `validate:"required,url"`
+	PollInterval    time.Duration `validate:"required,min=1"`
+}
+
+type OIDCConfig struct {
+	Issuer               string        `validate:"required,url"`
+	LoginURL             string        `validate:"required,url"`
+	SigningPrivateKey    string        `validate:"required"`
+	IDTokenTTL           time.Duration `validate:"required,min=1"`
+	AuthorizationCodeTTL time.Duration `validate:"required,min=1"`
+}
+
+type AdminAPIConfig struct {
+	HeaderKey string `validate:"required"`
+	APIKey    string `validate:"required,min=32"`
+}
+
+type APIKeyConfig struct {
+	AllowedScopes []string `validate:"required,min=1,dive,required"`
+	MaxPerUser    int      `validate:"required,min=1"`
+}
+
+type LoginHistoryConfig struct {
+	GeoIPDatabasePath string `validate:"omitempty,file"`
+	SecurityURL       string `validate:"required,url"`
+}
+
+type RiskConfig struct {
+	Enabled                  bool
+	DenyCIDRs                []string      `validate:"omitempty,dive,cidr"`
+	StepUpCIDRs              []string      `validate:"omitempty,dive,cidr"`
+	FailedAttemptsWindow     time.Duration `validate:"required,min=1"`
+	FailedAttemptsStepUp     int           `validate:"min=0"`
+	FailedAttemptsDeny       int           `validate:"min=0"`
+	ImpossibleTravelSpeedKMH float64       `validate:"min=0"`
+	ImpossibleTravelAction   string        `validate:"oneof=allow step_up deny"`
+	NewDeviceAction          string        `validate:"oneof=allow step_up deny"`
+	StepUpCodeTTL            time.Duration `validate:"required,min=1"`
+	StepUpMaxAttempts        int           `validate:"required,min=1"`
+}
+
+type CaptchaConfig struct {
+	Provider         string        `validate:"omitempty,oneof=hcaptcha turnstile recaptcha fake"`
+	Secret           string        `validate:"required_if=Provider hcaptcha,required_if=Provider turnstile,required_if=Provider recaptcha"`
+	FailureThreshold int64         `validate:"min=0"`
+	FailureWindow    time.Duration `validate:"required,min=1"`
+	Timeout          time.Duration `validate:"required,min=1"`
+}
+
+type RateLimitRuleConfig struct {
+	Limit  int           `validate:"min=0"`
+	Window time.Duration `validate:"required,min=1"`
+}
+
+type RateLimitConfig struct {
+	Enabled bool
+	Login   RateLimitRuleConfig `validate:"required"`
+	Token   RateLimitRuleConfig `validate:"required"`
+	Device  RateLimitRuleConfig `validate:"required"`
+	OIDC    RateLimitRuleConfig `validate:"required"`
+	Account RateLimitRuleConfig `validate:"required"`
+	Admin   RateLimitRuleConfig `validate:"required"`
+}
+
+type CSRFConfig struct {
+	TrustedOrigins []string `validate:"required,min=1,dive,url"`
+}
+
 type SignupAPIConfig struct {
 	Endpoint string        `validate:"required,url"`
 	Timeout  time.Duration `validate:"required,min=1"`
 }
 
+type RestoreConfig struct {
+	ChallengeTTL  time.Duration `validate:"required,min=1"`
+	GracePeriod   time.Duration `validate:"required,min=1"` // Deletion delay of the user service, which the cancel links last
+	CancelLinkURL string        `validate:"required,url"`
+}
+
+type ConsentConfig struct {
+	ChallengeTTL time.Duration `validate:"required,min=1"`
+}
+
+// UserStatusSyncConfig configures the sync of the user statuses from the user service at startup, which
+// catches up with the users which changed before the auth service consumed the user events.
+type UserStatusSyncConfig struct {
+	Enabled  bool
+	PageSize int `validate:"required,min=1,max=1000"`
+}
+
 type Config struct {
-	Env             string              `validate:"required,oneof=dev prod"`
-	HTTPServer      HTTPServerConfig    `validate:"required"`
-	GRPCServer      GRPCServerConfig    `validate:"required"`
-	TokenClient     GRPCClientConfig    `validate:"required"`
-	DatabaseURL     string              `validate:"required"`
-	LoginCodeStore  RedisStoreConfig    `validate:"required"`
-	SessionStore    SessionStoreConfig  `validate:"required"`
-	UserEventReader KafkaReaderConfig   `validate:"required"`
-	SignupAPI       SignupAPIConfig     `validate:"required"`
-	GoogleOAuth     OAuthProviderConfig `validate:"required"`
-	NaverOAuth      OAuthProviderConfig `validate:"required"`
-	KakaoOAuth      OAuthProviderConfig `validate:"required"`
+	Env              string               `validate:"required,oneof=dev prod"`
+	HTTPServer       HTTPServerConfig     `validate:"required"`
+	GRPCServer       GRPCServerConfig     `validate:"required"`
+	TokenClient      GRPCClientConfig     `validate:"required"`
+	UserClient       GRPCClientConfig     `validate:"required"`
+	DatabaseURL      string               `validate:"required"`
+	LoginCodeStore   RedisStoreConfig     `validate:"required"`
+	DeviceCodeStore  RedisStoreConfig     `validate:"required"`
+	DeviceAuth       DeviceAuthConfig     `validate:"required"`
+	OIDC             OIDCConfig           `validate:"required"`
+	AdminAPI         AdminAPIConfig       `validate:"required"`
+	APIKey           APIKeyConfig         `validate:"required"`
+	CSRF             CSRFConfig           `validate:"required"`
+	LoginHistory     LoginHistoryConfig   `validate:"required"`
+	Risk             RiskConfig           `validate:"required"`
+	Captcha          CaptchaConfig        `validate:"required"`
+	RateLimit        RateLimitConfig      `validate:"required"`
+	MailEventWriter  KafkaWriterConfig    `validate:"required"`
+	AuditEventWriter KafkaWriterConfig    `validate:"required"`
+	SessionStore     SessionStoreConfig   `validate:"required"`
+	UserEventReader  KafkaReaderConfig    `validate:"required"`
+	SignupAPI        SignupAPIConfig      `validate:"required"`
+	Restore          RestoreConfig        `validate:"required"`
+	Consent          ConsentConfig        `validate:"required"`
+	UserStatusSync   UserStatusSyncConfig `validate:"required"`
+	GoogleOAuth      OAuthProviderConfig  `validate:"required"`
+	NaverOAuth       OAuthProviderConfig  `validate:"required"`
+	KakaoOAuth       OAuthProviderConfig  `validate:"required"`
 }
 
 // LoadConfig loads env vars from .env (if exists) and returns structured config
@@ -99,15 +203,129 @@ func LoadConfig(validator *validator.Validate) (*Config, error) {
 	if err != nil {
 		return nil, errors.New("Invalid LOGIN_CODE_TTL format", "Failed to parse login code TTL", errcode.ErrInvalidInput)
 	}
+	deviceCodeStoreDB, err := strconv.Atoi(getEnv("DEVICE_CODE_STORE_DB", "0"))
+	if err != nil {
+		return nil, err
+	}
+	deviceCodeTTL, err := time.ParseDuration(getEnv("DEVICE_CODE_TTL", "10m"))
+	if err != nil {
+		return nil, errors.New("Invalid DEVICE_CODE_TTL format", "Failed to parse device code TTL", errcode.ErrInvalidInput)
+	}
+	devicePollInterval, err := time.ParseDuration(getEnv("DEVICE_POLL_INTERVAL", "5s"))
+	if err != nil {
+		return nil, errors.New("Invalid DEVICE_POLL_INTERVAL format", "Failed to parse device poll interval", errcode.ErrInvalidInput)
+	}
+	idTokenTTL, err := time.ParseDuration(getEnv("OIDC_ID_TOKEN_TTL", "1h"))
+	if err != nil {
+		return nil, errors.New("Invalid OIDC_ID_TOKEN_TTL format", "Failed to parse ID token TTL", errcode.ErrInvalidInput)
+	}
+	authorizationCodeTTL, err := time.ParseDuration(getEnv("OIDC_AUTHORIZATION_CODE_TTL", "1m"))
+	if err != nil {
+		return nil, errors.New("Invalid OIDC_AUTHORIZATION_CODE_TTL format", "Failed to parse authorization code TTL", errcode.ErrInvalidInput)
+	}
+	apiKeyMaxPerUser, err := strconv.Atoi(getEnv("API_KEY_MAX_PER_USER", "20"))
+	if err != nil {
+		return nil, err
+	}
 	signupTimeout, err := time.ParseDuration(getEnv("SIGNUP_API_TIMEOUT", "30s"))
 	if err != nil {
 		return nil, errors.New("Invalid SIGNUP_API_TIMEOUT format", "Failed to parse signup API timeout", errcode.ErrInvalidInput)
 	}
+	restoreChallengeTTL, err := time.ParseDuration(getEnv("RESTORE_CHALLENGE_TTL", "10m"))
+	if err != nil {
+		return nil, errors.New("Invalid RESTORE_CHALLENGE_TTL format", "Failed to parse restore challenge TTL", errcode.ErrInvalidInput)
+	}
+	restoreGracePeriod, err := time.ParseDuration(getEnv("RESTORE_GRACE_PERIOD", "24h"))
+	if err != nil {
+		return nil, errors.New("Invalid RESTORE_GRACE_PERIOD format", "Failed to parse restore grace period", errcode.ErrInvalidInput)
+	}
+	consentChallengeTTL, err := time.ParseDuration(getEnv("CONSENT_CHALLENGE_TTL", "10m"))
+	if err != nil {
+		return nil, errors.New("Invalid CONSENT_CHALLENGE_TTL format", "Failed to parse consent challenge TTL", errcode.ErrInvalidInput)
+	}
+	riskEnabled, err := strconv.ParseBool(getEnv("RISK_ENABLED", "false"))
+	if err != nil {
+		return nil, errors.New("Invalid RISK_ENABLED format", "Failed to parse risk enabled flag", errcode.ErrInvalidInput)
+	}
+	riskFailedAttemptsWindow, err := time.ParseDuration(getEnv("RISK_FAILED_ATTEMPTS_WINDOW", "15m"))
+	if err != nil {
+		return nil, errors.New("Invalid RISK_FAILED_ATTEMPTS_WINDOW format", "Failed to parse failed attempts window", errcode.ErrInvalidInput)
+	}
+	riskFailedAttemptsStepUp, err := strconv.Atoi(getEnv("RISK_FAILED_ATTEMPTS_STEP_UP", "3"))
+	if err != nil {
+		return nil, err
+	}
+	riskFailedAttemptsDeny, err := strconv.Atoi(getEnv("RISK_FAILED_ATTEMPTS_DENY", "0"))
+	if err != nil {
+		return nil, err
+	}
+	riskImpossibleTravelSpeed, err := strconv.ParseFloat(getEnv("RISK_IMPOSSIBLE_TRAVEL_SPEED_KMH", "1000"), 64)
+	if err != nil {
+		return nil, errors.New("Invalid RISK_IMPOSSIBLE_TRAVEL_SPEED_KMH format", "Failed to parse impossible travel speed", errcode.ErrInvalidInput)
+	}
+	stepUpCodeTTL, err := time.ParseDuration(getEnv("STEP_UP_CODE_TTL", "10m"))
+	if err != nil {
+		return nil, errors.New("Invalid STEP_UP_CODE_TTL format", "Failed to parse step-up code TTL", errcode.ErrInvalidInput)
+	}
+	stepUpMaxAttempts, err := strconv.Atoi(getEnv("STEP_UP_MAX_ATTEMPTS", "5"))
+	if err != nil {
+		return nil, err
+	}
+	captchaFailureThreshold, err := strconv.ParseInt(getEnv("CAPTCHA_FAILURE_THRESHOLD", "3"), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	captchaFailureWindow, err := time.ParseDuration(getEnv("CAPTCHA_FAILURE_WINDOW", "15m"))
+	if err != nil {
+		return nil, errors.New("Invalid CAPTCHA_FAILURE_WINDOW format", "Failed to parse CAPTCHA failure window", errcode.ErrInvalidInput)
+	}
+	captchaTimeout, err := time.ParseDuration(getEnv("CAPTCHA_TIMEOUT", "5s"))
+	if err != nil {
+		return nil, errors.New("Invalid CAPTCHA_TIMEOUT format", "Failed to parse CAPTCHA timeout", errcode.ErrInvalidInput)
+	}
+	userStatusSyncEnabled, err := strconv.ParseBool(getEnv("USER_STATUS_SYNC_ENABLED", "false"))
+	if err != nil {
+		return nil, errors.New("Invalid USER_STATUS_SYNC_ENABLED format", "Failed to parse user status sync enabled flag", errcode.ErrInvalidInput)
+	}
+	userStatusSyncPageSize, err := strconv.Atoi(getEnv("USER_STATUS_SYNC_PAGE_SIZE", "500"))
+	if err != nil {
+		return nil, errors.New("Invalid USER_STATUS_SYNC_PAGE_SIZE format", "Failed to parse user status sync page size", errcode.ErrInvalidInput)
+	}
+	rateLimitEnabled, err := strconv.ParseBool(getEnv("RATE_LIMIT_ENABLED", "true"))
+	if err != nil {
+		return nil, errors.New("Invalid RATE_LIMIT_ENABLED format", "Failed to parse rate limit enabled flag", errcode.ErrInvalidInput)
+	}
+	loginRateLimit, err := parseRateLimit("RATE_LIMIT_LOGIN", "20/1m")
+	if err != nil {
+		return nil, err
+	}
+	tokenRateLimit, err := parseRateLimit("RATE_LIMIT_TOKEN", "60/1m")
+	if err != nil {
+		return nil, err
+	}
+	deviceRateLimit, err := parseRateLimit("RATE_LIMIT_DEVICE", "60/1m")
+	if err != nil {
+		return nil, err
+	}
+	oidcRateLimit, err := parseRateLimit("RATE_LIMIT_OIDC", "120/1m")
+	if err != nil {
+		return nil, err
+	}
+	accountRateLimit, err := parseRateLimit("RATE_LIMIT_ACCOUNT", "60/1m")
+	if err != nil {
+		return nil, err
+	}
+	adminRateLimit, err := parseRateLimit("RATE_LIMIT_ADMIN", "600/1m")
+	if err != nil {
+		return nil, err
+	}
 
 	config := &Config{
 		Env: getEnv("ENV", "dev"),
 		HTTPServer: HTTPServerConfig{
-			Port: httpPort,
+			Port:            httpPort,
+			RequestIDHeader: getEnv("REQUEST_ID_HEADER_KEY", "X-Request-ID"),
+			TrustedProxies:  splitList(getEnv("TRUSTED_PROXIES", "")),
 		},
 		GRPCServer: GRPCServerConfig{
 			Port: grpcPort,
@@ -115,7 +333,10 @@ func LoadConfig(validator *validator.Validate) (*Config, error) {
 		TokenClient: GRPCClientConfig{
 			Address: getEnv("TOKEN_CLIENT_ADDR", ""),
 		},
-		DatabaseURL:    getEnv("DATABASE_URL", ""),
+		UserClient: GRPCClientConfig{
+			Address: getEnv("USER_CLIENT_ADDR", ""),
+		},
+		DatabaseURL: getEnv("DATABASE_URL", ""),
 		LoginCodeStore: RedisStoreConfig{
 			Address:  getEnv("LOGIN_CODE_STORE_ADDRESS", ""),
 			Password: getEnv("LOGIN_CODE_STORE_PASSWORD", ""),
@@ -124,6 +345,77 @@ func LoadConfig(validator *validator.Validate) (*Config, error) {
 			HashKey:  getEnv("LOGIN_CODE_STORE_HASH_KEY", "default_login_code_hash_key"),
 			Timeout:  loginCodeTTL,
 		},
+		DeviceCodeStore: RedisStoreConfig{
+			Address:  getEnv("DEVICE_CODE_STORE_ADDRESS", ""),
+			Password: getEnv("DEVICE_CODE_STORE_PASSWORD", ""),
+			DB:       deviceCodeStoreDB,
+			Prefix:   getEnv("DEVICE_CODE_STORE_PREFIX", "device_code:"),
+			HashKey:  getEnv("DEVICE_CODE_STORE_HASH_KEY", "default_device_code_hash_key"),
+			Timeout:  deviceCodeTTL,
+		},
+		DeviceAuth: DeviceAuthConfig{
+			VerificationURI: getEnv("DEVICE_VERIFICATION_URI", ""),
+			PollInterval:    devicePollInterval,
+		},
+		OIDC: OIDCConfig{
+			Issuer:               getEnv("OIDC_ISSUER", ""),
+			LoginURL:             getEnv("OIDC_LOGIN_URL", ""),
+			SigningPrivateKey:    getEnv("OIDC_SIGNING_PRIVATE_KEY", ""),
+			IDTokenTTL:           idTokenTTL,
+			AuthorizationCodeTTL: authorizationCodeTTL,
+		},
+		AdminAPI: AdminAPIConfig{
+			HeaderKey: getEnv("ADMIN_API_HEADER_KEY", "X-Admin-Key"),
+			APIKey:    getEnv("ADMIN_API_KEY", ""),
+		},
+		APIKey: APIKeyConfig{
+			AllowedScopes: strings.Split(getEnv("API_KEY_ALLOWED_SCOPES", "profile:read,profile:write,user:read"), ","),
+			MaxPerUser:    apiKeyMaxPerUser,
+		},
+		CSRF: CSRFConfig{
+			TrustedOrigins: strings.Split(getEnv("CSRF_TRUSTED_ORIGINS", ""), ","),
+		},
+		LoginHistory: LoginHistoryConfig{
+			GeoIPDatabasePath: getEnv("GEOIP_DATABASE_PATH", ""),
+			SecurityURL:       getEnv("LOGIN_ALERT_SECURITY_URL", ""),
+		},
+		Risk: RiskConfig{
+			Enabled:                  riskEnabled,
+			DenyCIDRs:                splitList(getEnv("RISK_DENY_CIDRS", "")),
+			StepUpCIDRs:              splitList(getEnv("RISK_STEP_UP_CIDRS", "")),
+			FailedAttemptsWindow:     riskFailedAttemptsWindow,
+			FailedAttemptsStepUp:     riskFailedAttemptsStepUp,
+			FailedAttemptsDeny:       riskFailedAttemptsDeny,
+			ImpossibleTravelSpeedKMH: riskImpossibleTravelSpeed,
+			ImpossibleTravelAction:   getEnv("RISK_IMPOSSIBLE_TRAVEL_ACTION", "step_up"),
+			NewDeviceAction:          getEnv("RISK_NEW_DEVICE_ACTION", "allow"),
+			StepUpCodeTTL:            stepUpCodeTTL,
+			StepUpMaxAttempts:        stepUpMaxAttempts,
+		},
+		Captcha: CaptchaConfig{
+			Provider:         getEnv("CAPTCHA_PROVIDER", ""),
+			Secret:           getEnv("CAPTCHA_SECRET", ""),
+			FailureThreshold: captchaFailureThreshold,
+			FailureWindow:    captchaFailureWindow,
+			Timeout:          captchaTimeout,
+		},
+		RateLimit: RateLimitConfig{
+			Enabled: rateLimitEnabled,
+			Login:   loginRateLimit,
+			Token:   tokenRateLimit,
+			Device:  deviceRateLimit,
+			OIDC:    oidcRateLimit,
+			Account: accountRateLimit,
+			Admin:   adminRateLimit,
+		},
+		MailEventWriter: KafkaWriterConfig{
+			Address: strings.Split(getEnv("MAIL_EVENT_WRITER_ADDRESS", ""), ","),
+			Topic:   getEnv("MAIL_EVENT_WRITER_TOPIC", ""),
+		},
+		AuditEventWriter: KafkaWriterConfig{
+			Address: strings.Split(getEnv("AUDIT_EVENT_WRITER_ADDRESS", ""), ","),
+			Topic:   getEnv("AUDIT_EVENT_WRITER_TOPIC", "audit_event"),
+		},
 		SessionStore: SessionStoreConfig{
 			Address:     getEnv("SESSION_STORE_ADDRESS", ""),
 			Password:    getEnv("SESSION_STORE_PASSWORD", ""),
@@ -140,6 +432,18 @@ func LoadConfig(validator *validator.Validate) (*Config, error) {
 			Endpoint: getEnv("SIGNUP_API_ENDPOINT", ""),
 			Timeout:  signupTimeout,
 		},
+		Restore: RestoreConfig{
+			ChallengeTTL:  restoreChallengeTTL,
+			GracePeriod:   restoreGracePeriod,
+			CancelLinkURL: getEnv("RESTORE_CANCEL_LINK", ""),
+		},
+		Consent: ConsentConfig{
+			ChallengeTTL: consentChallengeTTL,
+		},
+		UserStatusSync: UserStatusSyncConfig{
+			Enabled:  userStatusSyncEnabled,
+			PageSize: userStatusSyncPageSize,
+		},
 		GoogleOAuth: OAuthProviderConfig{
 			ClientID:     getEnv("GOOGLE_CLIENT_ID", ""),
 			ClientSecret: getEnv("GOOGLE_CLIENT_SECRET", ""),
@@ -171,3 +475,32 @@ func getEnv(key, fallback string) string {
 	}
 	return val
 }
+
+// splitList splits a comma separated env value, dropping empty entries so that an unset value yields an empty list
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseRateLimit parses a rate limit env value of the form "<limit>/<window>", such as "20/1m"
+func parseRateLimit(key, fallback string) (RateLimitRuleConfig, error) {
+	value := getEnv(key, fallback)
+	rawLimit, rawWindow, ok := strings.Cut(value, "/")
+	if !ok {
+		return RateLimitRuleConfig{}, errors.New("Invalid "+key+" format", "Failed to parse rate limit", errcode.ErrInvalidInput)
+	}
+	limit, err := strconv.Atoi(rawLimit)
+	if err != nil {
+		return RateLimitRuleConfig{}, errors.New("Invalid "+key+" format", "Failed to parse rate limit", errcode.ErrInvalidInput)
+	}
+	window, err := time.ParseDuration(rawWindow)
+	if err != nil {
+		return RateLimitRuleConfig{}, errors.New("Invalid "+key+" format", "Failed to parse rate limit", errcode.ErrInvalidInput)
+	}
+	return RateLimitRuleConfig{Limit: limit, Window: window}, nil
+}
@@ -12,7 +12,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"mandacode.com/accounts/auth/ent/apikey"
 	"mandacode.com/accounts/auth/ent/authaccount"
+	"mandacode.com/accounts/auth/ent/loginattempt"
+	"mandacode.com/accounts/auth/ent/oauthclient"
+	"mandacode.com/accounts/auth/ent/session"
+	"mandacode.com/accounts/auth/ent/userstatus"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +78,12 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			authaccount.Table: authaccount.ValidColumn,
+			apikey.Table:       apikey.ValidColumn,
+			authaccount.Table:  authaccount.ValidColumn,
+			loginattempt.Table: loginattempt.ValidColumn,
+			oauthclient.Table:  oauthclient.ValidColumn,
+			session.Table:      session.ValidColumn,
+			userstatus.Table:   userstatus.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
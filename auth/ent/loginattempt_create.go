@@ -0,0 +1,458 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/auth/ent/loginattempt"
+)
+
+// LoginAttemptCreate is the builder for creating a LoginAttempt entity.
+type LoginAttemptCreate struct {
+	config
+	mutation *LoginAttemptMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (lac *LoginAttemptCreate) SetUserID(u uuid.UUID) *LoginAttemptCreate {
+	lac.mutation.SetUserID(u)
+	return lac
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableUserID(u *uuid.UUID) *LoginAttemptCreate {
+	if u != nil {
+		lac.SetUserID(*u)
+	}
+	return lac
+}
+
+// SetLoginMethod sets the "login_method" field.
+func (lac *LoginAttemptCreate) SetLoginMethod(lm loginattempt.LoginMethod) *LoginAttemptCreate {
+	lac.mutation.SetLoginMethod(lm)
+	return lac
+}
+
+// SetProvider sets the "provider" field.
+func (lac *LoginAttemptCreate) SetProvider(s string) *LoginAttemptCreate {
+	lac.mutation.SetProvider(s)
+	return lac
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableProvider(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetProvider(*s)
+	}
+	return lac
+}
+
+// SetSuccess sets the "success" field.
+func (lac *LoginAttemptCreate) SetSuccess(b bool) *LoginAttemptCreate {
+	lac.mutation.SetSuccess(b)
+	return lac
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (lac *LoginAttemptCreate) SetFailureReason(s string) *LoginAttemptCreate {
+	lac.mutation.SetFailureReason(s)
+	return lac
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableFailureReason(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetFailureReason(*s)
+	}
+	return lac
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (lac *LoginAttemptCreate) SetIPAddress(s string) *LoginAttemptCreate {
+	lac.mutation.SetIPAddress(s)
+	return lac
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableIPAddress(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetIPAddress(*s)
+	}
+	return lac
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (lac *LoginAttemptCreate) SetUserAgent(s string) *LoginAttemptCreate {
+	lac.mutation.SetUserAgent(s)
+	return lac
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableUserAgent(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetUserAgent(*s)
+	}
+	return lac
+}
+
+// SetDeviceID sets the "device_id" field.
+func (lac *LoginAttemptCreate) SetDeviceID(s string) *LoginAttemptCreate {
+	lac.mutation.SetDeviceID(s)
+	return lac
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableDeviceID(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetDeviceID(*s)
+	}
+	return lac
+}
+
+// SetCountry sets the "country" field.
+func (lac *LoginAttemptCreate) SetCountry(s string) *LoginAttemptCreate {
+	lac.mutation.SetCountry(s)
+	return lac
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableCountry(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetCountry(*s)
+	}
+	return lac
+}
+
+// SetCity sets the "city" field.
+func (lac *LoginAttemptCreate) SetCity(s string) *LoginAttemptCreate {
+	lac.mutation.SetCity(s)
+	return lac
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableCity(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetCity(*s)
+	}
+	return lac
+}
+
+// SetLatitude sets the "latitude" field.
+func (lac *LoginAttemptCreate) SetLatitude(f float64) *LoginAttemptCreate {
+	lac.mutation.SetLatitude(f)
+	return lac
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableLatitude(f *float64) *LoginAttemptCreate {
+	if f != nil {
+		lac.SetLatitude(*f)
+	}
+	return lac
+}
+
+// SetLongitude sets the "longitude" field.
+func (lac *LoginAttemptCreate) SetLongitude(f float64) *LoginAttemptCreate {
+	lac.mutation.SetLongitude(f)
+	return lac
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableLongitude(f *float64) *LoginAttemptCreate {
+	if f != nil {
+		lac.SetLongitude(*f)
+	}
+	return lac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lac *LoginAttemptCreate) SetCreatedAt(t time.Time) *LoginAttemptCreate {
+	lac.mutation.SetCreatedAt(t)
+	return lac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableCreatedAt(t *time.Time) *LoginAttemptCreate {
+	if t != nil {
+		lac.SetCreatedAt(*t)
+	}
+	return lac
+}
+
+// SetID sets the "id" field.
+func (lac *LoginAttemptCreate) SetID(u uuid.UUID) *LoginAttemptCreate {
+	lac.mutation.SetID(u)
+	return lac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableID(u *uuid.UUID) *LoginAttemptCreate {
+	if u != nil {
+		lac.SetID(*u)
+	}
+	return lac
+}
+
+// Mutation returns the LoginAttemptMutation object of the builder.
+func (lac *LoginAttemptCreate) Mutation() *LoginAttemptMutation {
+	return lac.mutation
+}
+
+// Save creates the LoginAttempt in the database.
+func (lac *LoginAttemptCreate) Save(ctx context.Context) (*LoginAttempt, error) {
+	lac.defaults()
+	return withHooks(ctx, lac.sqlSave, lac.mutation, lac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lac *LoginAttemptCreate) SaveX(ctx context.Context) *LoginAttempt {
+	v, err := lac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lac *LoginAttemptCreate) Exec(ctx context.Context) error {
+	_, err := lac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lac *LoginAttemptCreate) ExecX(ctx context.Context) {
+	if err := lac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lac *LoginAttemptCreate) defaults() {
+	if _, ok := lac.mutation.IPAddress(); !ok {
+		v := loginattempt.DefaultIPAddress
+		lac.mutation.SetIPAddress(v)
+	}
+	if _, ok := lac.mutation.UserAgent(); !ok {
+		v := loginattempt.DefaultUserAgent
+		lac.mutation.SetUserAgent(v)
+	}
+	if _, ok := lac.mutation.DeviceID(); !ok {
+		v := loginattempt.DefaultDeviceID
+		lac.mutation.SetDeviceID(v)
+	}
+	if _, ok := lac.mutation.CreatedAt(); !ok {
+		v := loginattempt.DefaultCreatedAt()
+		lac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := lac.mutation.ID(); !ok {
+		v := loginattempt.DefaultID()
+		lac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lac *LoginAttemptCreate) check() error {
+	if _, ok := lac.mutation.LoginMethod(); !ok {
+		return &ValidationError{Name: "login_method", err: errors.New(`ent: missing required field "LoginAttempt.login_method"`)}
+	}
+	if v, ok := lac.mutation.LoginMethod(); ok {
+		if err := loginattempt.LoginMethodValidator(v); err != nil {
+			return &ValidationError{Name: "login_method", err: fmt.Errorf(`ent: validator failed for field "LoginAttempt.login_method": %w`, err)}
+		}
+	}
+	if _, ok := lac.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "LoginAttempt.success"`)}
+	}
+	if _, ok := lac.mutation.IPAddress(); !ok {
+		return &ValidationError{Name: "ip_address", err: errors.New(`ent: missing required field "LoginAttempt.ip_address"`)}
+	}
+	if _, ok := lac.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "LoginAttempt.user_agent"`)}
+	}
+	if _, ok := lac.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "LoginAttempt.device_id"`)}
+	}
+	if _, ok := lac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoginAttempt.created_at"`)}
+	}
+	return nil
+}
+
+func (lac *LoginAttemptCreate) sqlSave(ctx context.Context) (*LoginAttempt, error) {
+	if err := lac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	lac.mutation.id = &_node.ID
+	lac.mutation.done = true
+	return _node, nil
+}
+
+func (lac *LoginAttemptCreate) createSpec() (*LoginAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginAttempt{config: lac.config}
+		_spec = sqlgraph.NewCreateSpec(loginattempt.Table, sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeUUID))
+	)
+	if id, ok := lac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := lac.mutation.UserID(); ok {
+		_spec.SetField(loginattempt.FieldUserID, field.TypeUUID, value)
+		_node.UserID = &value
+	}
+	if value, ok := lac.mutation.LoginMethod(); ok {
+		_spec.SetField(loginattempt.FieldLoginMethod, field.TypeEnum, value)
+		_node.LoginMethod = value
+	}
+	if value, ok := lac.mutation.Provider(); ok {
+		_spec.SetField(loginattempt.FieldProvider, field.TypeString, value)
+		_node.Provider = &value
+	}
+	if value, ok := lac.mutation.Success(); ok {
+		_spec.SetField(loginattempt.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := lac.mutation.FailureReason(); ok {
+		_spec.SetField(loginattempt.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = &value
+	}
+	if value, ok := lac.mutation.IPAddress(); ok {
+		_spec.SetField(loginattempt.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := lac.mutation.UserAgent(); ok {
+		_spec.SetField(loginattempt.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := lac.mutation.DeviceID(); ok {
+		_spec.SetField(loginattempt.FieldDeviceID, field.TypeString, value)
+		_node.DeviceID = value
+	}
+	if value, ok := lac.mutation.Country(); ok {
+		_spec.SetField(loginattempt.FieldCountry, field.TypeString, value)
+		_node.Country = &value
+	}
+	if value, ok := lac.mutation.City(); ok {
+		_spec.SetField(loginattempt.FieldCity, field.TypeString, value)
+		_node.City = &value
+	}
+	if value, ok := lac.mutation.Latitude(); ok {
+		_spec.SetField(loginattempt.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = &value
+	}
+	if value, ok := lac.mutation.Longitude(); ok {
+		_spec.SetField(loginattempt.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = &value
+	}
+	if value, ok := lac.mutation.CreatedAt(); ok {
+		_spec.SetField(loginattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// LoginAttemptCreateBulk is the builder for creating many LoginAttempt entities in bulk.
+type LoginAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*LoginAttemptCreate
+}
+
+// Save creates the LoginAttempt entities in the database.
+func (lacb *LoginAttemptCreateBulk) Save(ctx context.Context) ([]*LoginAttempt, error) {
+	if lacb.err != nil {
+		return nil, lacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lacb.builders))
+	nodes := make([]*LoginAttempt, len(lacb.builders))
+	mutators := make([]Mutator, len(lacb.builders))
+	for i := range lacb.builders {
+		func(i int, root context.Context) {
+			builder := lacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lacb *LoginAttemptCreateBulk) SaveX(ctx context.Context) []*LoginAttempt {
+	v, err := lacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lacb *LoginAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := lacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lacb *LoginAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := lacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
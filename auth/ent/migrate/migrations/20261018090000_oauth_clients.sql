@@ -0,0 +1,13 @@
+-- Create "oauth_clients" table
+CREATE TABLE "public"."oauth_clients" (
+  "id" uuid NOT NULL,
+  "client_id" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "secret_hash" character varying NULL,
+  "redirect_uris" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "oauth_clients_client_id_key" to table: "oauth_clients"
+CREATE UNIQUE INDEX "oauth_clients_client_id_key" ON "public"."oauth_clients" ("client_id");
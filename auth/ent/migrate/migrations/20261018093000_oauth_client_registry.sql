@@ -0,0 +1,4 @@
+-- Modify "oauth_clients" table
+ALTER TABLE "public"."oauth_clients" ADD COLUMN "scopes" jsonb NOT NULL DEFAULT '["openid", "email", "offline_access"]', ADD COLUMN "grant_types" jsonb NOT NULL DEFAULT '["authorization_code"]', ADD COLUMN "is_disabled" boolean NOT NULL DEFAULT false, ADD COLUMN "secret_rotated_at" timestamptz NULL;
+-- Backfill existing clients through the defaults above, then drop the JSON defaults which ent sets itself
+ALTER TABLE "public"."oauth_clients" ALTER COLUMN "scopes" DROP DEFAULT, ALTER COLUMN "grant_types" DROP DEFAULT;
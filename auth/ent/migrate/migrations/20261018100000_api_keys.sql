@@ -0,0 +1,19 @@
+-- Create "api_keys" table
+CREATE TABLE "public"."api_keys" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "prefix" character varying NOT NULL,
+  "key_hash" character varying NOT NULL,
+  "scopes" jsonb NOT NULL,
+  "expires_at" timestamptz NULL,
+  "last_used_at" timestamptz NULL,
+  "is_suspended" boolean NOT NULL DEFAULT false,
+  "revoked_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "api_keys_key_hash_key" to table: "api_keys"
+CREATE UNIQUE INDEX "api_keys_key_hash_key" ON "public"."api_keys" ("key_hash");
+-- Create index "apikey_user_id" to table: "api_keys"
+CREATE INDEX "apikey_user_id" ON "public"."api_keys" ("user_id");
@@ -0,0 +1,18 @@
+-- Create "sessions" table
+CREATE TABLE "public"."sessions" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "refresh_token_hash" character varying NOT NULL,
+  "login_method" character varying NOT NULL,
+  "provider" character varying NULL,
+  "user_agent" character varying NOT NULL DEFAULT '',
+  "ip_address" character varying NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL,
+  "last_seen_at" timestamptz NOT NULL,
+  "revoked_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "sessions_refresh_token_hash_key" to table: "sessions"
+CREATE UNIQUE INDEX "sessions_refresh_token_hash_key" ON "public"."sessions" ("refresh_token_hash");
+-- Create index "session_user_id" to table: "sessions"
+CREATE INDEX "session_user_id" ON "public"."sessions" ("user_id");
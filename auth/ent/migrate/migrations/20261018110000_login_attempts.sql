@@ -0,0 +1,18 @@
+-- Create "login_attempts" table
+CREATE TABLE "public"."login_attempts" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NULL,
+  "login_method" character varying NOT NULL,
+  "provider" character varying NULL,
+  "success" boolean NOT NULL,
+  "failure_reason" character varying NULL,
+  "ip_address" character varying NOT NULL DEFAULT '',
+  "user_agent" character varying NOT NULL DEFAULT '',
+  "device_id" character varying NOT NULL DEFAULT '',
+  "country" character varying NULL,
+  "city" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "loginattempt_user_id_created_at" to table: "login_attempts"
+CREATE INDEX "loginattempt_user_id_created_at" ON "public"."login_attempts" ("user_id", "created_at");
@@ -0,0 +1,2 @@
+-- Modify "login_attempts" table
+ALTER TABLE "public"."login_attempts" ADD COLUMN "latitude" double precision NULL, ADD COLUMN "longitude" double precision NULL;
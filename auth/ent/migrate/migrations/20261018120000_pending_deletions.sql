@@ -0,0 +1,9 @@
+-- Create "pending_deletions" table
+CREATE TABLE "public"."pending_deletions" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "pending_deletions_user_id_key" to table: "pending_deletions"
+CREATE UNIQUE INDEX "pending_deletions_user_id_key" ON "public"."pending_deletions" ("user_id");
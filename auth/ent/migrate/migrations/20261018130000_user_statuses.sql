@@ -0,0 +1,18 @@
+-- Create "user_statuses" table
+CREATE TABLE "public"."user_statuses" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "is_active" boolean NOT NULL DEFAULT true,
+  "is_blocked" boolean NOT NULL DEFAULT false,
+  "is_archived" boolean NOT NULL DEFAULT false,
+  "sync_code" character varying NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "user_statuses_user_id_key" to table: "user_statuses"
+CREATE UNIQUE INDEX "user_statuses_user_id_key" ON "public"."user_statuses" ("user_id");
+-- Carry over the archived users
+INSERT INTO "public"."user_statuses" ("id", "user_id", "is_archived", "updated_at")
+SELECT "id", "user_id", true, "created_at" FROM "public"."pending_deletions";
+-- Drop "pending_deletions" table
+DROP TABLE "public"."pending_deletions";
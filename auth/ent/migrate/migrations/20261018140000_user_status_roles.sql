@@ -0,0 +1,4 @@
+-- Modify "user_statuses" table
+ALTER TABLE "public"."user_statuses" ADD COLUMN "roles" jsonb NOT NULL DEFAULT '[]';
+-- Roles are set by the application from now on
+ALTER TABLE "public"."user_statuses" ALTER COLUMN "roles" DROP DEFAULT;
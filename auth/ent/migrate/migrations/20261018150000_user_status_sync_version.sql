@@ -0,0 +1,3 @@
+-- Modify "user_statuses" table
+-- The statuses start at version 0, below every user version, until the next event or the bootstrap sync
+ALTER TABLE "public"."user_statuses" DROP COLUMN "sync_code", ADD COLUMN "sync_version" bigint NOT NULL DEFAULT 0;
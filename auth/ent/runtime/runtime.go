@@ -6,14 +6,45 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"mandacode.com/accounts/auth/ent/apikey"
 	"mandacode.com/accounts/auth/ent/authaccount"
+	"mandacode.com/accounts/auth/ent/loginattempt"
+	"mandacode.com/accounts/auth/ent/oauthclient"
 	"mandacode.com/accounts/auth/ent/schema"
+	"mandacode.com/accounts/auth/ent/session"
+	"mandacode.com/accounts/auth/ent/userstatus"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[2].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[3].Descriptor()
+	// apikey.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apikey.PrefixValidator = apikeyDescPrefix.Validators[0].(func(string) error)
+	// apikeyDescKeyHash is the schema descriptor for key_hash field.
+	apikeyDescKeyHash := apikeyFields[4].Descriptor()
+	// apikey.KeyHashValidator is a validator for the "key_hash" field. It is called by the builders before save.
+	apikey.KeyHashValidator = apikeyDescKeyHash.Validators[0].(func(string) error)
+	// apikeyDescIsSuspended is the schema descriptor for is_suspended field.
+	apikeyDescIsSuspended := apikeyFields[8].Descriptor()
+	// apikey.DefaultIsSuspended holds the default value on creation for the is_suspended field.
+	apikey.DefaultIsSuspended = apikeyDescIsSuspended.Default.(bool)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[10].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[0].Descriptor()
+	// apikey.DefaultID holds the default value on creation for the id field.
+	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
 	authaccountHooks := schema.AuthAccount{}.Hooks()
 	authaccount.Hooks[0] = authaccountHooks[0]
 	authaccount.Hooks[1] = authaccountHooks[1]
@@ -41,6 +72,122 @@ func init() {
 	authaccountDescID := authaccountFields[0].Descriptor()
 	// authaccount.DefaultID holds the default value on creation for the id field.
 	authaccount.DefaultID = authaccountDescID.Default.(func() uuid.UUID)
+	loginattemptFields := schema.LoginAttempt{}.Fields()
+	_ = loginattemptFields
+	// loginattemptDescIPAddress is the schema descriptor for ip_address field.
+	loginattemptDescIPAddress := loginattemptFields[6].Descriptor()
+	// loginattempt.DefaultIPAddress holds the default value on creation for the ip_address field.
+	loginattempt.DefaultIPAddress = loginattemptDescIPAddress.Default.(string)
+	// loginattemptDescUserAgent is the schema descriptor for user_agent field.
+	loginattemptDescUserAgent := loginattemptFields[7].Descriptor()
+	// loginattempt.DefaultUserAgent holds the default value on creation for the user_agent field.
+	loginattempt.DefaultUserAgent = loginattemptDescUserAgent.Default.(string)
+	// loginattemptDescDeviceID is the schema descriptor for device_id field.
+	loginattemptDescDeviceID := loginattemptFields[8].Descriptor()
+	// loginattempt.DefaultDeviceID holds the default value on creation for the device_id field.
+	loginattempt.DefaultDeviceID = loginattemptDescDeviceID.Default.(string)
+	// loginattemptDescCreatedAt is the schema descriptor for created_at field.
+	loginattemptDescCreatedAt := loginattemptFields[13].Descriptor()
+	// loginattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginattempt.DefaultCreatedAt = loginattemptDescCreatedAt.Default.(func() time.Time)
+	// loginattemptDescID is the schema descriptor for id field.
+	loginattemptDescID := loginattemptFields[0].Descriptor()
+	// loginattempt.DefaultID holds the default value on creation for the id field.
+	loginattempt.DefaultID = loginattemptDescID.Default.(func() uuid.UUID)
+	oauthclientFields := schema.OAuthClient{}.Fields()
+	_ = oauthclientFields
+	// oauthclientDescClientID is the schema descriptor for client_id field.
+	oauthclientDescClientID := oauthclientFields[1].Descriptor()
+	// oauthclient.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	oauthclient.ClientIDValidator = oauthclientDescClientID.Validators[0].(func(string) error)
+	// oauthclientDescName is the schema descriptor for name field.
+	oauthclientDescName := oauthclientFields[2].Descriptor()
+	// oauthclient.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	oauthclient.NameValidator = oauthclientDescName.Validators[0].(func(string) error)
+	// oauthclientDescScopes is the schema descriptor for scopes field.
+	oauthclientDescScopes := oauthclientFields[5].Descriptor()
+	// oauthclient.DefaultScopes holds the default value on creation for the scopes field.
+	oauthclient.DefaultScopes = oauthclientDescScopes.Default.([]string)
+	// oauthclientDescGrantTypes is the schema descriptor for grant_types field.
+	oauthclientDescGrantTypes := oauthclientFields[6].Descriptor()
+	// oauthclient.DefaultGrantTypes holds the default value on creation for the grant_types field.
+	oauthclient.DefaultGrantTypes = oauthclientDescGrantTypes.Default.([]string)
+	// oauthclientDescIsDisabled is the schema descriptor for is_disabled field.
+	oauthclientDescIsDisabled := oauthclientFields[7].Descriptor()
+	// oauthclient.DefaultIsDisabled holds the default value on creation for the is_disabled field.
+	oauthclient.DefaultIsDisabled = oauthclientDescIsDisabled.Default.(bool)
+	// oauthclientDescCreatedAt is the schema descriptor for created_at field.
+	oauthclientDescCreatedAt := oauthclientFields[9].Descriptor()
+	// oauthclient.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthclient.DefaultCreatedAt = oauthclientDescCreatedAt.Default.(func() time.Time)
+	// oauthclientDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthclientDescUpdatedAt := oauthclientFields[10].Descriptor()
+	// oauthclient.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthclient.DefaultUpdatedAt = oauthclientDescUpdatedAt.Default.(func() time.Time)
+	// oauthclient.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthclient.UpdateDefaultUpdatedAt = oauthclientDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oauthclientDescID is the schema descriptor for id field.
+	oauthclientDescID := oauthclientFields[0].Descriptor()
+	// oauthclient.DefaultID holds the default value on creation for the id field.
+	oauthclient.DefaultID = oauthclientDescID.Default.(func() uuid.UUID)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescRefreshTokenHash is the schema descriptor for refresh_token_hash field.
+	sessionDescRefreshTokenHash := sessionFields[2].Descriptor()
+	// session.RefreshTokenHashValidator is a validator for the "refresh_token_hash" field. It is called by the builders before save.
+	session.RefreshTokenHashValidator = sessionDescRefreshTokenHash.Validators[0].(func(string) error)
+	// sessionDescUserAgent is the schema descriptor for user_agent field.
+	sessionDescUserAgent := sessionFields[5].Descriptor()
+	// session.DefaultUserAgent holds the default value on creation for the user_agent field.
+	session.DefaultUserAgent = sessionDescUserAgent.Default.(string)
+	// sessionDescIPAddress is the schema descriptor for ip_address field.
+	sessionDescIPAddress := sessionFields[6].Descriptor()
+	// session.DefaultIPAddress holds the default value on creation for the ip_address field.
+	session.DefaultIPAddress = sessionDescIPAddress.Default.(string)
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[7].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescLastSeenAt is the schema descriptor for last_seen_at field.
+	sessionDescLastSeenAt := sessionFields[8].Descriptor()
+	// session.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	session.DefaultLastSeenAt = sessionDescLastSeenAt.Default.(func() time.Time)
+	// sessionDescID is the schema descriptor for id field.
+	sessionDescID := sessionFields[0].Descriptor()
+	// session.DefaultID holds the default value on creation for the id field.
+	session.DefaultID = sessionDescID.Default.(func() uuid.UUID)
+	userstatusFields := schema.UserStatus{}.Fields()
+	_ = userstatusFields
+	// userstatusDescIsActive is the schema descriptor for is_active field.
+	userstatusDescIsActive := userstatusFields[2].Descriptor()
+	// userstatus.DefaultIsActive holds the default value on creation for the is_active field.
+	userstatus.DefaultIsActive = userstatusDescIsActive.Default.(bool)
+	// userstatusDescIsBlocked is the schema descriptor for is_blocked field.
+	userstatusDescIsBlocked := userstatusFields[3].Descriptor()
+	// userstatus.DefaultIsBlocked holds the default value on creation for the is_blocked field.
+	userstatus.DefaultIsBlocked = userstatusDescIsBlocked.Default.(bool)
+	// userstatusDescIsArchived is the schema descriptor for is_archived field.
+	userstatusDescIsArchived := userstatusFields[4].Descriptor()
+	// userstatus.DefaultIsArchived holds the default value on creation for the is_archived field.
+	userstatus.DefaultIsArchived = userstatusDescIsArchived.Default.(bool)
+	// userstatusDescRoles is the schema descriptor for roles field.
+	userstatusDescRoles := userstatusFields[5].Descriptor()
+	// userstatus.DefaultRoles holds the default value on creation for the roles field.
+	userstatus.DefaultRoles = userstatusDescRoles.Default.([]string)
+	// userstatusDescSyncVersion is the schema descriptor for sync_version field.
+	userstatusDescSyncVersion := userstatusFields[6].Descriptor()
+	// userstatus.DefaultSyncVersion holds the default value on creation for the sync_version field.
+	userstatus.DefaultSyncVersion = userstatusDescSyncVersion.Default.(int64)
+	// userstatusDescUpdatedAt is the schema descriptor for updated_at field.
+	userstatusDescUpdatedAt := userstatusFields[7].Descriptor()
+	// userstatus.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userstatus.DefaultUpdatedAt = userstatusDescUpdatedAt.Default.(func() time.Time)
+	// userstatus.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userstatus.UpdateDefaultUpdatedAt = userstatusDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userstatusDescID is the schema descriptor for id field.
+	userstatusDescID := userstatusFields[0].Descriptor()
+	// userstatus.DefaultID holds the default value on creation for the id field.
+	userstatus.DefaultID = userstatusDescID.Default.(func() uuid.UUID)
 }
 
 const (
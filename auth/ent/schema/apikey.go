@@ -0,0 +1,105 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// APIKey holds the schema definition for the APIKey entity.
+type APIKey struct {
+	ent.Schema
+}
+
+// Annotations of the APIKey.
+func (APIKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "api_keys"},
+	}
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		// Internal PK
+		field.UUID("id", uuid.UUID{}).
+			Immutable().
+			Unique().
+			Default(uuid.New).
+			Comment("The unique identifier for the API key"),
+
+		// User ID
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable().
+			Comment("The unique identifier for the user who owns the API key"),
+
+		// Name
+		field.String("name").
+			NotEmpty().
+			Comment("The name given to the API key by its owner"),
+
+		// Prefix
+		field.String("prefix").
+			NotEmpty().
+			Immutable().
+			Comment("The non-secret beginning of the key, shown to identify it"),
+
+		// KeyHash
+		field.String("key_hash").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Sensitive().
+			Comment("The SHA-256 hash of the full key"),
+
+		// Scopes
+		field.Strings("scopes").
+			Comment("The scopes granted to the API key"),
+
+		// ExpiresAt
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("The time when the API key expires, unset for keys which do not expire"),
+
+		// LastUsedAt
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			Comment("The time when the API key was last verified"),
+
+		// IsSuspended
+		field.Bool("is_suspended").
+			Default(false).
+			Comment("Indicates if the API key is suspended because its owner is blocked"),
+
+		// RevokedAt
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("The time when the API key was revoked"),
+
+		// CreatedAt
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("The time when the API key was created"),
+	}
+}
+
+// Indexes of the APIKey.
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+// Edges of the APIKey.
+func (APIKey) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,132 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LoginAttempt holds the schema definition for the LoginAttempt entity.
+type LoginAttempt struct {
+	ent.Schema
+}
+
+// Annotations of the LoginAttempt.
+func (LoginAttempt) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "login_attempts"},
+	}
+}
+
+// Fields of the LoginAttempt.
+func (LoginAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		// Internal PK
+		field.UUID("id", uuid.UUID{}).
+			Immutable().
+			Unique().
+			Default(uuid.New).
+			Comment("The unique identifier for the login attempt"),
+
+		// User ID
+		field.UUID("user_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The unique identifier for the user, unset if the attempt could not be attributed to a user"),
+
+		// LoginMethod
+		field.Enum("login_method").
+			Values("local", "oauth", "device").
+			Immutable().
+			Comment("The method used to sign in"),
+
+		// Provider
+		field.String("provider").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The OAuth provider or device client used to sign in"),
+
+		// Success
+		field.Bool("success").
+			Immutable().
+			Comment("Indicates if the attempt succeeded"),
+
+		// FailureReason
+		field.String("failure_reason").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The reason the attempt failed"),
+
+		// IPAddress
+		field.String("ip_address").
+			Default("").
+			Immutable().
+			Comment("The IP address the attempt was made from"),
+
+		// UserAgent
+		field.String("user_agent").
+			Default("").
+			Immutable().
+			Comment("The user agent the attempt was made with"),
+
+		// DeviceID
+		field.String("device_id").
+			Default("").
+			Immutable().
+			Comment("The device identifier sent by the client, if any"),
+
+		// Country
+		field.String("country").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The ISO 3166-1 country code resolved from the IP address"),
+
+		// City
+		field.String("city").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The city name resolved from the IP address"),
+
+		// Latitude
+		field.Float("latitude").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The approximate latitude resolved from the IP address"),
+
+		// Longitude
+		field.Float("longitude").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The approximate longitude resolved from the IP address"),
+
+		// CreatedAt
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("The time when the attempt was made"),
+	}
+}
+
+// Indexes of the LoginAttempt.
+func (LoginAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
+
+// Edges of the LoginAttempt.
+func (LoginAttempt) Edges() []ent.Edge {
+	return nil
+}
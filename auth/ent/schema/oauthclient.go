@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// OAuthClient holds the schema definition for the OAuthClient entity.
+type OAuthClient struct {
+	ent.Schema
+}
+
+// Annotations of the OAuthClient.
+func (OAuthClient) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "oauth_clients"},
+	}
+}
+
+// Fields of the OAuthClient.
+func (OAuthClient) Fields() []ent.Field {
+	return []ent.Field{
+		// Internal PK
+		field.UUID("id", uuid.UUID{}).
+			Immutable().
+			Unique().
+			Default(uuid.New).
+			Comment("The unique identifier for the OAuth client"),
+
+		// ClientID
+		field.String("client_id").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("The public identifier of the client used in OAuth requests"),
+
+		// Name
+		field.String("name").
+			NotEmpty().
+			Comment("The human readable name of the client"),
+
+		// SecretHash
+		field.String("secret_hash").
+			Optional().
+			Nillable().
+			Sensitive().
+			Comment("The hashed client secret, unset for public clients which must use PKCE"),
+
+		// RedirectURIs
+		field.Strings("redirect_uris").
+			Comment("The redirect URIs registered for the client"),
+
+		// Scopes
+		field.Strings("scopes").
+			Default([]string{"openid", "email", "offline_access"}).
+			Comment("The scopes the client is allowed to request"),
+
+		// GrantTypes
+		field.Strings("grant_types").
+			Default([]string{"authorization_code"}).
+			Comment("The grant types the client is allowed to use"),
+
+		// IsDisabled
+		field.Bool("is_disabled").
+			Default(false).
+			Comment("Indicates if the client has been disabled and can no longer obtain tokens"),
+
+		// SecretRotatedAt
+		field.Time("secret_rotated_at").
+			Optional().
+			Nillable().
+			Comment("The time when the client secret was last rotated"),
+
+		// CreatedAt
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("The time when the client was registered"),
+
+		// UpdatedAt
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("The time when the client was last updated"),
+	}
+}
+
+// Edges of the OAuthClient.
+func (OAuthClient) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,100 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Session holds the schema definition for the Session entity.
+type Session struct {
+	ent.Schema
+}
+
+// Annotations of the Session.
+func (Session) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sessions"},
+	}
+}
+
+// Fields of the Session.
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		// Internal PK
+		field.UUID("id", uuid.UUID{}).
+			Immutable().
+			Unique().
+			Default(uuid.New).
+			Comment("The unique identifier for the session"),
+
+		// User ID
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable().
+			Comment("The unique identifier for the user who owns the session"),
+
+		// RefreshTokenHash
+		field.String("refresh_token_hash").
+			NotEmpty().
+			Unique().
+			Sensitive().
+			Comment("The SHA-256 hash of the latest refresh token issued for the session"),
+
+		// LoginMethod
+		field.Enum("login_method").
+			Values("local", "oauth", "device", "oidc").
+			Immutable().
+			Comment("The method used to sign in"),
+
+		// Provider
+		field.String("provider").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The OAuth provider or OIDC client the user signed in with"),
+
+		// UserAgent
+		field.String("user_agent").
+			Default("").
+			Comment("The user agent of the device which signed in"),
+
+		// IPAddress
+		field.String("ip_address").
+			Default("").
+			Comment("The IP address the session was last seen from"),
+
+		// CreatedAt
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("The time when the session was created"),
+
+		// LastSeenAt
+		field.Time("last_seen_at").
+			Default(time.Now).
+			Comment("The time when the session was last used"),
+
+		// RevokedAt
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("The time when the session was revoked"),
+	}
+}
+
+// Indexes of the Session.
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+// Edges of the Session.
+func (Session) Edges() []ent.Edge {
+	return nil
+}
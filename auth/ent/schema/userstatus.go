@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UserStatus holds the schema definition for the UserStatus entity.
+//
+// A user status is the projection of the status and roles of a user in the user service, fed by the user events.
+// Users without a status have not changed status or roles since they signed up, and are active.
+type UserStatus struct {
+	ent.Schema
+}
+
+// Annotations of the UserStatus.
+func (UserStatus) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "user_statuses"},
+	}
+}
+
+// Fields of the UserStatus.
+func (UserStatus) Fields() []ent.Field {
+	return []ent.Field{
+		// Internal PK
+		field.UUID("id", uuid.UUID{}).
+			Immutable().
+			Unique().
+			Default(uuid.New).
+			Comment("The unique identifier for the user status"),
+
+		// User ID
+		field.UUID("user_id", uuid.UUID{}).
+			Unique().
+			Immutable().
+			Comment("The unique identifier for the user"),
+
+		// Status
+		field.Bool("is_active").
+			Default(true).
+			Comment("Whether the user is active. Inactive users cannot sign in"),
+		field.Bool("is_blocked").
+			Default(false).
+			Comment("Whether the user is blocked. Blocked users cannot sign in"),
+		field.Bool("is_archived").
+			Default(false).
+			Comment("Whether the user is archived, pending deletion unless they restore their account"),
+
+		// Roles
+		field.Strings("roles").
+			Default([]string{}).
+			Comment("The roles of the user, carried in their access tokens"),
+
+		// SyncVersion
+		field.Int64("sync_version").
+			Default(0).
+			Comment("The version of the user as of the last user event applied to the status. Older events are skipped"),
+
+		// UpdatedAt
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("The time when the status was last updated"),
+	}
+}
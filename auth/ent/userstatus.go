@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/auth/ent/userstatus"
+)
+
+// UserStatus is the model entity for the UserStatus schema.
+type UserStatus struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the user status
+	ID uuid.UUID `json:"id,omitempty"`
+	// The unique identifier for the user
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Whether the user is active. Inactive users cannot sign in
+	IsActive bool `json:"is_active,omitempty"`
+	// Whether the user is blocked. Blocked users cannot sign in
+	IsBlocked bool `json:"is_blocked,omitempty"`
+	// Whether the user is archived, pending deletion unless they restore their account
+	IsArchived bool `json:"is_archived,omitempty"`
+	// The roles of the user, carried in their access tokens
+	Roles []string `json:"roles,omitempty"`
+	// The version of the user as of the last user event applied to the status. Older events are skipped
+	SyncVersion int64 `json:"sync_version,omitempty"`
+	// The time when the status was last updated
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserStatus) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userstatus.FieldRoles:
+			values[i] = new([]byte)
+		case userstatus.FieldIsActive, userstatus.FieldIsBlocked, userstatus.FieldIsArchived:
+			values[i] = new(sql.NullBool)
+		case userstatus.FieldSyncVersion:
+			values[i] = new(sql.NullInt64)
+		case userstatus.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case userstatus.FieldID, userstatus.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserStatus fields.
+func (us *UserStatus) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userstatus.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				us.ID = *value
+			}
+		case userstatus.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				us.UserID = *value
+			}
+		case userstatus.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				us.IsActive = value.Bool
+			}
+		case userstatus.FieldIsBlocked:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_blocked", values[i])
+			} else if value.Valid {
+				us.IsBlocked = value.Bool
+			}
+		case userstatus.FieldIsArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_archived", values[i])
+			} else if value.Valid {
+				us.IsArchived = value.Bool
+			}
+		case userstatus.FieldRoles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field roles", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &us.Roles); err != nil {
+					return fmt.Errorf("unmarshal field roles: %w", err)
+				}
+			}
+		case userstatus.FieldSyncVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sync_version", values[i])
+			} else if value.Valid {
+				us.SyncVersion = value.Int64
+			}
+		case userstatus.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				us.UpdatedAt = value.Time
+			}
+		default:
+			us.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserStatus.
+// This includes values selected through modifiers, order, etc.
+func (us *UserStatus) Value(name string) (ent.Value, error) {
+	return us.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserStatus.
+// Note that you need to call UserStatus.Unwrap() before calling this method if this UserStatus
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (us *UserStatus) Update() *UserStatusUpdateOne {
+	return NewUserStatusClient(us.config).UpdateOne(us)
+}
+
+// Unwrap unwraps the UserStatus entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (us *UserStatus) Unwrap() *UserStatus {
+	_tx, ok := us.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserStatus is not a transactional entity")
+	}
+	us.config.driver = _tx.drv
+	return us
+}
+
+// String implements the fmt.Stringer.
+func (us *UserStatus) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserStatus(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", us.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", us.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", us.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("is_blocked=")
+	builder.WriteString(fmt.Sprintf("%v", us.IsBlocked))
+	builder.WriteString(", ")
+	builder.WriteString("is_archived=")
+	builder.WriteString(fmt.Sprintf("%v", us.IsArchived))
+	builder.WriteString(", ")
+	builder.WriteString("roles=")
+	builder.WriteString(fmt.Sprintf("%v", us.Roles))
+	builder.WriteString(", ")
+	builder.WriteString("sync_version=")
+	builder.WriteString(fmt.Sprintf("%v", us.SyncVersion))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(us.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserStatusSlice is a parsable slice of UserStatus.
+type UserStatusSlice []*UserStatus
@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userstatus
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/auth/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldEQ(FieldUserID, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsBlocked applies equality check predicate on the "is_blocked" field. It's identical to IsBlockedEQ.
+func IsBlocked(v bool) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldEQ(FieldIsBlocked, v))
+}
+
+// IsArchived applies equality check predicate on the "is_archived" field. It's identical to IsArchivedEQ.
+func IsArchived(v bool) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldEQ(FieldIsArchived, v))
+}
+
+// SyncVersion applies equality check predicate on the "sync_version" field. It's identical to SyncVersionEQ.
+func SyncVersion(v int64) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldEQ(FieldSyncVersion, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldLTE(FieldUserID, v))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// IsBlockedEQ applies the EQ predicate on the "is_blocked" field.
+func IsBlockedEQ(v bool) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldEQ(FieldIsBlocked, v))
+}
+
+// IsBlockedNEQ applies the NEQ predicate on the "is_blocked" field.
+func IsBlockedNEQ(v bool) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldNEQ(FieldIsBlocked, v))
+}
+
+// IsArchivedEQ applies the EQ predicate on the "is_archived" field.
+func IsArchivedEQ(v bool) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldEQ(FieldIsArchived, v))
+}
+
+// IsArchivedNEQ applies the NEQ predicate on the "is_archived" field.
+func IsArchivedNEQ(v bool) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldNEQ(FieldIsArchived, v))
+}
+
+// SyncVersionEQ applies the EQ predicate on the "sync_version" field.
+func SyncVersionEQ(v int64) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldEQ(FieldSyncVersion, v))
+}
+
+// SyncVersionNEQ applies the NEQ predicate on the "sync_version" field.
+func SyncVersionNEQ(v int64) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldNEQ(FieldSyncVersion, v))
+}
+
+// SyncVersionIn applies the In predicate on the "sync_version" field.
+func SyncVersionIn(vs ...int64) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldIn(FieldSyncVersion, vs...))
+}
+
+// SyncVersionNotIn applies the NotIn predicate on the "sync_version" field.
+func SyncVersionNotIn(vs ...int64) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldNotIn(FieldSyncVersion, vs...))
+}
+
+// SyncVersionGT applies the GT predicate on the "sync_version" field.
+func SyncVersionGT(v int64) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldGT(FieldSyncVersion, v))
+}
+
+// SyncVersionGTE applies the GTE predicate on the "sync_version" field.
+func SyncVersionGTE(v int64) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldGTE(FieldSyncVersion, v))
+}
+
+// SyncVersionLT applies the LT predicate on the "sync_version" field.
+func SyncVersionLT(v int64) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldLT(FieldSyncVersion, v))
+}
+
+// SyncVersionLTE applies the LTE predicate on the "sync_version" field.
+func SyncVersionLTE(v int64) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldLTE(FieldSyncVersion, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UserStatus {
+	return predicate.UserStatus(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserStatus) predicate.UserStatus {
+	return predicate.UserStatus(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserStatus) predicate.UserStatus {
+	return predicate.UserStatus(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserStatus) predicate.UserStatus {
+	return predicate.UserStatus(sql.NotPredicates(p))
+}
@@ -0,0 +1,356 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/auth/ent/userstatus"
+)
+
+// UserStatusCreate is the builder for creating a UserStatus entity.
+type UserStatusCreate struct {
+	config
+	mutation *UserStatusMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (usc *UserStatusCreate) SetUserID(u uuid.UUID) *UserStatusCreate {
+	usc.mutation.SetUserID(u)
+	return usc
+}
+
+// SetIsActive sets the "is_active" field.
+func (usc *UserStatusCreate) SetIsActive(b bool) *UserStatusCreate {
+	usc.mutation.SetIsActive(b)
+	return usc
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (usc *UserStatusCreate) SetNillableIsActive(b *bool) *UserStatusCreate {
+	if b != nil {
+		usc.SetIsActive(*b)
+	}
+	return usc
+}
+
+// SetIsBlocked sets the "is_blocked" field.
+func (usc *UserStatusCreate) SetIsBlocked(b bool) *UserStatusCreate {
+	usc.mutation.SetIsBlocked(b)
+	return usc
+}
+
+// SetNillableIsBlocked sets the "is_blocked" field if the given value is not nil.
+func (usc *UserStatusCreate) SetNillableIsBlocked(b *bool) *UserStatusCreate {
+	if b != nil {
+		usc.SetIsBlocked(*b)
+	}
+	return usc
+}
+
+// SetIsArchived sets the "is_archived" field.
+func (usc *UserStatusCreate) SetIsArchived(b bool) *UserStatusCreate {
+	usc.mutation.SetIsArchived(b)
+	return usc
+}
+
+// SetNillableIsArchived sets the "is_archived" field if the given value is not nil.
+func (usc *UserStatusCreate) SetNillableIsArchived(b *bool) *UserStatusCreate {
+	if b != nil {
+		usc.SetIsArchived(*b)
+	}
+	return usc
+}
+
+// SetRoles sets the "roles" field.
+func (usc *UserStatusCreate) SetRoles(s []string) *UserStatusCreate {
+	usc.mutation.SetRoles(s)
+	return usc
+}
+
+// SetSyncVersion sets the "sync_version" field.
+func (usc *UserStatusCreate) SetSyncVersion(i int64) *UserStatusCreate {
+	usc.mutation.SetSyncVersion(i)
+	return usc
+}
+
+// SetNillableSyncVersion sets the "sync_version" field if the given value is not nil.
+func (usc *UserStatusCreate) SetNillableSyncVersion(i *int64) *UserStatusCreate {
+	if i != nil {
+		usc.SetSyncVersion(*i)
+	}
+	return usc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (usc *UserStatusCreate) SetUpdatedAt(t time.Time) *UserStatusCreate {
+	usc.mutation.SetUpdatedAt(t)
+	return usc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (usc *UserStatusCreate) SetNillableUpdatedAt(t *time.Time) *UserStatusCreate {
+	if t != nil {
+		usc.SetUpdatedAt(*t)
+	}
+	return usc
+}
+
+// SetID sets the "id" field.
+func (usc *UserStatusCreate) SetID(u uuid.UUID) *UserStatusCreate {
+	usc.mutation.SetID(u)
+	return usc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (usc *UserStatusCreate) SetNillableID(u *uuid.UUID) *UserStatusCreate {
+	if u != nil {
+		usc.SetID(*u)
+	}
+	return usc
+}
+
+// Mutation returns the UserStatusMutation object of the builder.
+func (usc *UserStatusCreate) Mutation() *UserStatusMutation {
+	return usc.mutation
+}
+
+// Save creates the UserStatus in the database.
+func (usc *UserStatusCreate) Save(ctx context.Context) (*UserStatus, error) {
+	usc.defaults()
+	return withHooks(ctx, usc.sqlSave, usc.mutation, usc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (usc *UserStatusCreate) SaveX(ctx context.Context) *UserStatus {
+	v, err := usc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (usc *UserStatusCreate) Exec(ctx context.Context) error {
+	_, err := usc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (usc *UserStatusCreate) ExecX(ctx context.Context) {
+	if err := usc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (usc *UserStatusCreate) defaults() {
+	if _, ok := usc.mutation.IsActive(); !ok {
+		v := userstatus.DefaultIsActive
+		usc.mutation.SetIsActive(v)
+	}
+	if _, ok := usc.mutation.IsBlocked(); !ok {
+		v := userstatus.DefaultIsBlocked
+		usc.mutation.SetIsBlocked(v)
+	}
+	if _, ok := usc.mutation.IsArchived(); !ok {
+		v := userstatus.DefaultIsArchived
+		usc.mutation.SetIsArchived(v)
+	}
+	if _, ok := usc.mutation.Roles(); !ok {
+		v := userstatus.DefaultRoles
+		usc.mutation.SetRoles(v)
+	}
+	if _, ok := usc.mutation.SyncVersion(); !ok {
+		v := userstatus.DefaultSyncVersion
+		usc.mutation.SetSyncVersion(v)
+	}
+	if _, ok := usc.mutation.UpdatedAt(); !ok {
+		v := userstatus.DefaultUpdatedAt()
+		usc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := usc.mutation.ID(); !ok {
+		v := userstatus.DefaultID()
+		usc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (usc *UserStatusCreate) check() error {
+	if _, ok := usc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserStatus.user_id"`)}
+	}
+	if _, ok := usc.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "UserStatus.is_active"`)}
+	}
+	if _, ok := usc.mutation.IsBlocked(); !ok {
+		return &ValidationError{Name: "is_blocked", err: errors.New(`ent: missing required field "UserStatus.is_blocked"`)}
+	}
+	if _, ok := usc.mutation.IsArchived(); !ok {
+		return &ValidationError{Name: "is_archived", err: errors.New(`ent: missing required field "UserStatus.is_archived"`)}
+	}
+	if _, ok := usc.mutation.Roles(); !ok {
+		return &ValidationError{Name: "roles", err: errors.New(`ent: missing required field "UserStatus.roles"`)}
+	}
+	if _, ok := usc.mutation.SyncVersion(); !ok {
+		return &ValidationError{Name: "sync_version", err: errors.New(`ent: missing required field "UserStatus.sync_version"`)}
+	}
+	if _, ok := usc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserStatus.updated_at"`)}
+	}
+	return nil
+}
+
+func (usc *UserStatusCreate) sqlSave(ctx context.Context) (*UserStatus, error) {
+	if err := usc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := usc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, usc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	usc.mutation.id = &_node.ID
+	usc.mutation.done = true
+	return _node, nil
+}
+
+func (usc *UserStatusCreate) createSpec() (*UserStatus, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserStatus{config: usc.config}
+		_spec = sqlgraph.NewCreateSpec(userstatus.Table, sqlgraph.NewFieldSpec(userstatus.FieldID, field.TypeUUID))
+	)
+	if id, ok := usc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := usc.mutation.UserID(); ok {
+		_spec.SetField(userstatus.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := usc.mutation.IsActive(); ok {
+		_spec.SetField(userstatus.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := usc.mutation.IsBlocked(); ok {
+		_spec.SetField(userstatus.FieldIsBlocked, field.TypeBool, value)
+		_node.IsBlocked = value
+	}
+	if value, ok := usc.mutation.IsArchived(); ok {
+		_spec.SetField(userstatus.FieldIsArchived, field.TypeBool, value)
+		_node.IsArchived = value
+	}
+	if value, ok := usc.mutation.Roles(); ok {
+		_spec.SetField(userstatus.FieldRoles, field.TypeJSON, value)
+		_node.Roles = value
+	}
+	if value, ok := usc.mutation.SyncVersion(); ok {
+		_spec.SetField(userstatus.FieldSyncVersion, field.TypeInt64, value)
+		_node.SyncVersion = value
+	}
+	if value, ok := usc.mutation.UpdatedAt(); ok {
+		_spec.SetField(userstatus.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// UserStatusCreateBulk is the builder for creating many UserStatus entities in bulk.
+type UserStatusCreateBulk struct {
+	config
+	err      error
+	builders []*UserStatusCreate
+}
+
+// Save creates the UserStatus entities in the database.
+func (uscb *UserStatusCreateBulk) Save(ctx context.Context) ([]*UserStatus, error) {
+	if uscb.err != nil {
+		return nil, uscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uscb.builders))
+	nodes := make([]*UserStatus, len(uscb.builders))
+	mutators := make([]Mutator, len(uscb.builders))
+	for i := range uscb.builders {
+		func(i int, root context.Context) {
+			builder := uscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserStatusMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uscb *UserStatusCreateBulk) SaveX(ctx context.Context) []*UserStatus {
+	v, err := uscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uscb *UserStatusCreateBulk) Exec(ctx context.Context) error {
+	_, err := uscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uscb *UserStatusCreateBulk) ExecX(ctx context.Context) {
+	if err := uscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,528 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/auth/ent/predicate"
+	"mandacode.com/accounts/auth/ent/userstatus"
+)
+
+// UserStatusQuery is the builder for querying UserStatus entities.
+type UserStatusQuery struct {
+	config
+	ctx        *QueryContext
+	order      []userstatus.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UserStatus
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UserStatusQuery builder.
+func (usq *UserStatusQuery) Where(ps ...predicate.UserStatus) *UserStatusQuery {
+	usq.predicates = append(usq.predicates, ps...)
+	return usq
+}
+
+// Limit the number of records to be returned by this query.
+func (usq *UserStatusQuery) Limit(limit int) *UserStatusQuery {
+	usq.ctx.Limit = &limit
+	return usq
+}
+
+// Offset to start from.
+func (usq *UserStatusQuery) Offset(offset int) *UserStatusQuery {
+	usq.ctx.Offset = &offset
+	return usq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (usq *UserStatusQuery) Unique(unique bool) *UserStatusQuery {
+	usq.ctx.Unique = &unique
+	return usq
+}
+
+// Order specifies how the records should be ordered.
+func (usq *UserStatusQuery) Order(o ...userstatus.OrderOption) *UserStatusQuery {
+	usq.order = append(usq.order, o...)
+	return usq
+}
+
+// First returns the first UserStatus entity from the query.
+// Returns a *NotFoundError when no UserStatus was found.
+func (usq *UserStatusQuery) First(ctx context.Context) (*UserStatus, error) {
+	nodes, err := usq.Limit(1).All(setContextOp(ctx, usq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{userstatus.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (usq *UserStatusQuery) FirstX(ctx context.Context) *UserStatus {
+	node, err := usq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UserStatus ID from the query.
+// Returns a *NotFoundError when no UserStatus ID was found.
+func (usq *UserStatusQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = usq.Limit(1).IDs(setContextOp(ctx, usq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{userstatus.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (usq *UserStatusQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := usq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UserStatus entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UserStatus entity is found.
+// Returns a *NotFoundError when no UserStatus entities are found.
+func (usq *UserStatusQuery) Only(ctx context.Context) (*UserStatus, error) {
+	nodes, err := usq.Limit(2).All(setContextOp(ctx, usq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{userstatus.Label}
+	default:
+		return nil, &NotSingularError{userstatus.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (usq *UserStatusQuery) OnlyX(ctx context.Context) *UserStatus {
+	node, err := usq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UserStatus ID in the query.
+// Returns a *NotSingularError when more than one UserStatus ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (usq *UserStatusQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = usq.Limit(2).IDs(setContextOp(ctx, usq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{userstatus.Label}
+	default:
+		err = &NotSingularError{userstatus.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (usq *UserStatusQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := usq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UserStatusSlice.
+func (usq *UserStatusQuery) All(ctx context.Context) ([]*UserStatus, error) {
+	ctx = setContextOp(ctx, usq.ctx, ent.OpQueryAll)
+	if err := usq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UserStatus, *UserStatusQuery]()
+	return withInterceptors[[]*UserStatus](ctx, usq, qr, usq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (usq *UserStatusQuery) AllX(ctx context.Context) []*UserStatus {
+	nodes, err := usq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UserStatus IDs.
+func (usq *UserStatusQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if usq.ctx.Unique == nil && usq.path != nil {
+		usq.Unique(true)
+	}
+	ctx = setContextOp(ctx, usq.ctx, ent.OpQueryIDs)
+	if err = usq.Select(userstatus.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (usq *UserStatusQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := usq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (usq *UserStatusQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, usq.ctx, ent.OpQueryCount)
+	if err := usq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, usq, querierCount[*UserStatusQuery](), usq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (usq *UserStatusQuery) CountX(ctx context.Context) int {
+	count, err := usq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (usq *UserStatusQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, usq.ctx, ent.OpQueryExist)
+	switch _, err := usq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (usq *UserStatusQuery) ExistX(ctx context.Context) bool {
+	exist, err := usq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserStatusQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (usq *UserStatusQuery) Clone() *UserStatusQuery {
+	if usq == nil {
+		return nil
+	}
+	return &UserStatusQuery{
+		config:     usq.config,
+		ctx:        usq.ctx.Clone(),
+		order:      append([]userstatus.OrderOption{}, usq.order...),
+		inters:     append([]Interceptor{}, usq.inters...),
+		predicates: append([]predicate.UserStatus{}, usq.predicates...),
+		// clone intermediate query.
+		sql:  usq.sql.Clone(),
+		path: usq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UserStatus.Query().
+//		GroupBy(userstatus.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (usq *UserStatusQuery) GroupBy(field string, fields ...string) *UserStatusGroupBy {
+	usq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserStatusGroupBy{build: usq}
+	grbuild.flds = &usq.ctx.Fields
+	grbuild.label = userstatus.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//	}
+//
+//	client.UserStatus.Query().
+//		Select(userstatus.FieldUserID).
+//		Scan(ctx, &v)
+func (usq *UserStatusQuery) Select(fields ...string) *UserStatusSelect {
+	usq.ctx.Fields = append(usq.ctx.Fields, fields...)
+	sbuild := &UserStatusSelect{UserStatusQuery: usq}
+	sbuild.label = userstatus.Label
+	sbuild.flds, sbuild.scan = &usq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UserStatusSelect configured with the given aggregations.
+func (usq *UserStatusQuery) Aggregate(fns ...AggregateFunc) *UserStatusSelect {
+	return usq.Select().Aggregate(fns...)
+}
+
+func (usq *UserStatusQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range usq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, usq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range usq.ctx.Fields {
+		if !userstatus.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if usq.path != nil {
+		prev, err := usq.path(ctx)
+		if err != nil {
+			return err
+		}
+		usq.sql = prev
+	}
+	return nil
+}
+
+func (usq *UserStatusQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserStatus, error) {
+	var (
+		nodes = []*UserStatus{}
+		_spec = usq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UserStatus).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UserStatus{config: usq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, usq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (usq *UserStatusQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := usq.querySpec()
+	_spec.Node.Columns = usq.ctx.Fields
+	if len(usq.ctx.Fields) > 0 {
+		_spec.Unique = usq.ctx.Unique != nil && *usq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, usq.driver, _spec)
+}
+
+func (usq *UserStatusQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(userstatus.Table, userstatus.Columns, sqlgraph.NewFieldSpec(userstatus.FieldID, field.TypeUUID))
+	_spec.From = usq.sql
+	if unique := usq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if usq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := usq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userstatus.FieldID)
+		for i := range fields {
+			if fields[i] != userstatus.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := usq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := usq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := usq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := usq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (usq *UserStatusQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(usq.driver.Dialect())
+	t1 := builder.Table(userstatus.Table)
+	columns := usq.ctx.Fields
+	if len(columns) == 0 {
+		columns = userstatus.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if usq.sql != nil {
+		selector = usq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if usq.ctx.Unique != nil && *usq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range usq.predicates {
+		p(selector)
+	}
+	for _, p := range usq.order {
+		p(selector)
+	}
+	if offset := usq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := usq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// UserStatusGroupBy is the group-by builder for UserStatus entities.
+type UserStatusGroupBy struct {
+	selector
+	build *UserStatusQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (usgb *UserStatusGroupBy) Aggregate(fns ...AggregateFunc) *UserStatusGroupBy {
+	usgb.fns = append(usgb.fns, fns...)
+	return usgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (usgb *UserStatusGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, usgb.build.ctx, ent.OpQueryGroupBy)
+	if err := usgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserStatusQuery, *UserStatusGroupBy](ctx, usgb.build, usgb, usgb.build.inters, v)
+}
+
+func (usgb *UserStatusGroupBy) sqlScan(ctx context.Context, root *UserStatusQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(usgb.fns))
+	for _, fn := range usgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*usgb.flds)+len(usgb.fns))
+		for _, f := range *usgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*usgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := usgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UserStatusSelect is the builder for selecting fields of UserStatus entities.
+type UserStatusSelect struct {
+	*UserStatusQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (uss *UserStatusSelect) Aggregate(fns ...AggregateFunc) *UserStatusSelect {
+	uss.fns = append(uss.fns, fns...)
+	return uss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uss *UserStatusSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, uss.ctx, ent.OpQuerySelect)
+	if err := uss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserStatusQuery, *UserStatusSelect](ctx, uss.UserStatusQuery, uss, uss.inters, v)
+}
+
+func (uss *UserStatusSelect) sqlScan(ctx context.Context, root *UserStatusQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(uss.fns))
+	for _, fn := range uss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*uss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
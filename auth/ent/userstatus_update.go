@@ -0,0 +1,409 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"mandacode.com/accounts/auth/ent/predicate"
+	"mandacode.com/accounts/auth/ent/userstatus"
+)
+
+// UserStatusUpdate is the builder for updating UserStatus entities.
+type UserStatusUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserStatusMutation
+}
+
+// Where appends a list predicates to the UserStatusUpdate builder.
+func (usu *UserStatusUpdate) Where(ps ...predicate.UserStatus) *UserStatusUpdate {
+	usu.mutation.Where(ps...)
+	return usu
+}
+
+// SetIsActive sets the "is_active" field.
+func (usu *UserStatusUpdate) SetIsActive(b bool) *UserStatusUpdate {
+	usu.mutation.SetIsActive(b)
+	return usu
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (usu *UserStatusUpdate) SetNillableIsActive(b *bool) *UserStatusUpdate {
+	if b != nil {
+		usu.SetIsActive(*b)
+	}
+	return usu
+}
+
+// SetIsBlocked sets the "is_blocked" field.
+func (usu *UserStatusUpdate) SetIsBlocked(b bool) *UserStatusUpdate {
+	usu.mutation.SetIsBlocked(b)
+	return usu
+}
+
+// SetNillableIsBlocked sets the "is_blocked" field if the given value is not nil.
+func (usu *UserStatusUpdate) SetNillableIsBlocked(b *bool) *UserStatusUpdate {
+	if b != nil {
+		usu.SetIsBlocked(*b)
+	}
+	return usu
+}
+
+// SetIsArchived sets the "is_archived" field.
+func (usu *UserStatusUpdate) SetIsArchived(b bool) *UserStatusUpdate {
+	usu.mutation.SetIsArchived(b)
+	return usu
+}
+
+// SetNillableIsArchived sets the "is_archived" field if the given value is not nil.
+func (usu *UserStatusUpdate) SetNillableIsArchived(b *bool) *UserStatusUpdate {
+	if b != nil {
+		usu.SetIsArchived(*b)
+	}
+	return usu
+}
+
+// SetRoles sets the "roles" field.
+func (usu *UserStatusUpdate) SetRoles(s []string) *UserStatusUpdate {
+	usu.mutation.SetRoles(s)
+	return usu
+}
+
+// AppendRoles appends s to the "roles" field.
+func (usu *UserStatusUpdate) AppendRoles(s []string) *UserStatusUpdate {
+	usu.mutation.AppendRoles(s)
+	return usu
+}
+
+// SetSyncVersion sets the "sync_version" field.
+func (usu *UserStatusUpdate) SetSyncVersion(i int64) *UserStatusUpdate {
+	usu.mutation.ResetSyncVersion()
+	usu.mutation.SetSyncVersion(i)
+	return usu
+}
+
+// SetNillableSyncVersion sets the "sync_version" field if the given value is not nil.
+func (usu *UserStatusUpdate) SetNillableSyncVersion(i *int64) *UserStatusUpdate {
+	if i != nil {
+		usu.SetSyncVersion(*i)
+	}
+	return usu
+}
+
+// AddSyncVersion adds i to the "sync_version" field.
+func (usu *UserStatusUpdate) AddSyncVersion(i int64) *UserStatusUpdate {
+	usu.mutation.AddSyncVersion(i)
+	return usu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (usu *UserStatusUpdate) SetUpdatedAt(t time.Time) *UserStatusUpdate {
+	usu.mutation.SetUpdatedAt(t)
+	return usu
+}
+
+// Mutation returns the UserStatusMutation object of the builder.
+func (usu *UserStatusUpdate) Mutation() *UserStatusMutation {
+	return usu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (usu *UserStatusUpdate) Save(ctx context.Context) (int, error) {
+	usu.defaults()
+	return withHooks(ctx, usu.sqlSave, usu.mutation, usu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (usu *UserStatusUpdate) SaveX(ctx context.Context) int {
+	affected, err := usu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (usu *UserStatusUpdate) Exec(ctx context.Context) error {
+	_, err := usu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (usu *UserStatusUpdate) ExecX(ctx context.Context) {
+	if err := usu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (usu *UserStatusUpdate) defaults() {
+	if _, ok := usu.mutation.UpdatedAt(); !ok {
+		v := userstatus.UpdateDefaultUpdatedAt()
+		usu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (usu *UserStatusUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(userstatus.Table, userstatus.Columns, sqlgraph.NewFieldSpec(userstatus.FieldID, field.TypeUUID))
+	if ps := usu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := usu.mutation.IsActive(); ok {
+		_spec.SetField(userstatus.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := usu.mutation.IsBlocked(); ok {
+		_spec.SetField(userstatus.FieldIsBlocked, field.TypeBool, value)
+	}
+	if value, ok := usu.mutation.IsArchived(); ok {
+		_spec.SetField(userstatus.FieldIsArchived, field.TypeBool, value)
+	}
+	if value, ok := usu.mutation.Roles(); ok {
+		_spec.SetField(userstatus.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := usu.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, userstatus.FieldRoles, value)
+		})
+	}
+	if value, ok := usu.mutation.SyncVersion(); ok {
+		_spec.SetField(userstatus.FieldSyncVersion, field.TypeInt64, value)
+	}
+	if value, ok := usu.mutation.AddedSyncVersion(); ok {
+		_spec.AddField(userstatus.FieldSyncVersion, field.TypeInt64, value)
+	}
+	if value, ok := usu.mutation.UpdatedAt(); ok {
+		_spec.SetField(userstatus.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, usu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userstatus.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	usu.mutation.done = true
+	return n, nil
+}
+
+// UserStatusUpdateOne is the builder for updating a single UserStatus entity.
+type UserStatusUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserStatusMutation
+}
+
+// SetIsActive sets the "is_active" field.
+func (usuo *UserStatusUpdateOne) SetIsActive(b bool) *UserStatusUpdateOne {
+	usuo.mutation.SetIsActive(b)
+	return usuo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (usuo *UserStatusUpdateOne) SetNillableIsActive(b *bool) *UserStatusUpdateOne {
+	if b != nil {
+		usuo.SetIsActive(*b)
+	}
+	return usuo
+}
+
+// SetIsBlocked sets the "is_blocked" field.
+func (usuo *UserStatusUpdateOne) SetIsBlocked(b bool) *UserStatusUpdateOne {
+	usuo.mutation.SetIsBlocked(b)
+	return usuo
+}
+
+// SetNillableIsBlocked sets the "is_blocked" field if the given value is not nil.
+func (usuo *UserStatusUpdateOne) SetNillableIsBlocked(b *bool) *UserStatusUpdateOne {
+	if b != nil {
+		usuo.SetIsBlocked(*b)
+	}
+	return usuo
+}
+
+// SetIsArchived sets the "is_archived" field.
+func (usuo *UserStatusUpdateOne) SetIsArchived(b bool) *UserStatusUpdateOne {
+	usuo.mutation.SetIsArchived(b)
+	return usuo
+}
+
+// SetNillableIsArchived sets the "is_archived" field if the given value is not nil.
+func (usuo *UserStatusUpdateOne) SetNillableIsArchived(b *bool) *UserStatusUpdateOne {
+	if b != nil {
+		usuo.SetIsArchived(*b)
+	}
+	return usuo
+}
+
+// SetRoles sets the "roles" field.
+func (usuo *UserStatusUpdateOne) SetRoles(s []string) *UserStatusUpdateOne {
+	usuo.mutation.SetRoles(s)
+	return usuo
+}
+
+// AppendRoles appends s to the "roles" field.
+func (usuo *UserStatusUpdateOne) AppendRoles(s []string) *UserStatusUpdateOne {
+	usuo.mutation.AppendRoles(s)
+	return usuo
+}
+
+// SetSyncVersion sets the "sync_version" field.
+func (usuo *UserStatusUpdateOne) SetSyncVersion(i int64) *UserStatusUpdateOne {
+	usuo.mutation.ResetSyncVersion()
+	usuo.mutation.SetSyncVersion(i)
+	return usuo
+}
+
+// SetNillableSyncVersion sets the "sync_version" field if the given value is not nil.
+func (usuo *UserStatusUpdateOne) SetNillableSyncVersion(i *int64) *UserStatusUpdateOne {
+	if i != nil {
+		usuo.SetSyncVersion(*i)
+	}
+	return usuo
+}
+
+// AddSyncVersion adds i to the "sync_version" field.
+func (usuo *UserStatusUpdateOne) AddSyncVersion(i int64) *UserStatusUpdateOne {
+	usuo.mutation.AddSyncVersion(i)
+	return usuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (usuo *UserStatusUpdateOne) SetUpdatedAt(t time.Time) *UserStatusUpdateOne {
+	usuo.mutation.SetUpdatedAt(t)
+	return usuo
+}
+
+// Mutation returns the UserStatusMutation object of the builder.
+func (usuo *UserStatusUpdateOne) Mutation() *UserStatusMutation {
+	return usuo.mutation
+}
+
+// Where appends a list predicates to the UserStatusUpdate builder.
+func (usuo *UserStatusUpdateOne) Where(ps ...predicate.UserStatus) *UserStatusUpdateOne {
+	usuo.mutation.Where(ps...)
+	return usuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (usuo *UserStatusUpdateOne) Select(field string, fields ...string) *UserStatusUpdateOne {
+	usuo.fields = append([]string{field}, fields...)
+	return usuo
+}
+
+// Save executes the query and returns the updated UserStatus entity.
+func (usuo *UserStatusUpdateOne) Save(ctx context.Context) (*UserStatus, error) {
+	usuo.defaults()
+	return withHooks(ctx, usuo.sqlSave, usuo.mutation, usuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (usuo *UserStatusUpdateOne) SaveX(ctx context.Context) *UserStatus {
+	node, err := usuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (usuo *UserStatusUpdateOne) Exec(ctx context.Context) error {
+	_, err := usuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (usuo *UserStatusUpdateOne) ExecX(ctx context.Context) {
+	if err := usuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (usuo *UserStatusUpdateOne) defaults() {
+	if _, ok := usuo.mutation.UpdatedAt(); !ok {
+		v := userstatus.UpdateDefaultUpdatedAt()
+		usuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (usuo *UserStatusUpdateOne) sqlSave(ctx context.Context) (_node *UserStatus, err error) {
+	_spec := sqlgraph.NewUpdateSpec(userstatus.Table, userstatus.Columns, sqlgraph.NewFieldSpec(userstatus.FieldID, field.TypeUUID))
+	id, ok := usuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserStatus.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := usuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userstatus.FieldID)
+		for _, f := range fields {
+			if !userstatus.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userstatus.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := usuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := usuo.mutation.IsActive(); ok {
+		_spec.SetField(userstatus.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := usuo.mutation.IsBlocked(); ok {
+		_spec.SetField(userstatus.FieldIsBlocked, field.TypeBool, value)
+	}
+	if value, ok := usuo.mutation.IsArchived(); ok {
+		_spec.SetField(userstatus.FieldIsArchived, field.TypeBool, value)
+	}
+	if value, ok := usuo.mutation.Roles(); ok {
+		_spec.SetField(userstatus.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := usuo.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, userstatus.FieldRoles, value)
+		})
+	}
+	if value, ok := usuo.mutation.SyncVersion(); ok {
+		_spec.SetField(userstatus.FieldSyncVersion, field.TypeInt64, value)
+	}
+	if value, ok := usuo.mutation.AddedSyncVersion(); ok {
+		_spec.AddField(userstatus.FieldSyncVersion, field.TypeInt64, value)
+	}
+	if value, ok := usuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(userstatus.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &UserStatus{config: usuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, usuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userstatus.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	usuo.mutation.done = true
+	return _node, nil
+}
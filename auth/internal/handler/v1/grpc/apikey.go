@@ -0,0 +1,51 @@
+package grpchandlerv1
+
+import (
+	"context"
+
+	authv1 "github.com/mandacode-com/accounts-proto/go/auth/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"mandacode.com/accounts/auth/internal/usecase/apikey"
+)
+
+type APIKeyHandler struct {
+	authv1.UnimplementedAPIKeyServiceServer
+	apiKey *apikey.APIKeyUsecase
+	logger *zap.Logger
+}
+
+// VerifyAPIKey implements authv1.APIKeyServiceServer.
+func (h *APIKeyHandler) VerifyAPIKey(ctx context.Context, req *authv1.VerifyAPIKeyRequest) (*authv1.VerifyAPIKeyResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("VerifyAPIKey request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	output, err := h.apiKey.VerifyAPIKey(ctx, req.Key)
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			if appErr.Code() != errcode.ErrUnauthorized {
+				h.logger.Error("Failed to verify API key", zap.Error(err))
+			}
+			return nil, status.Error(errcode.MapCodeToGRPC(appErr.Code()), appErr.Public())
+		}
+		h.logger.Error("Failed to verify API key", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to verify API key: %v", err)
+	}
+
+	return &authv1.VerifyAPIKeyResponse{
+		UserId: output.UserID.String(),
+		Scopes: output.Scopes,
+	}, nil
+}
+
+func NewAPIKeyHandler(apiKey *apikey.APIKeyUsecase, logger *zap.Logger) authv1.APIKeyServiceServer {
+	return &APIKeyHandler{
+		apiKey: apiKey,
+		logger: logger,
+	}
+}
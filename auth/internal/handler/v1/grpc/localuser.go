@@ -62,7 +62,7 @@ func (l *LocalUserHandler) CreateLocalUser(ctx context.Context, req *authv1.Crea
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
 	}
 
-	createdUser, err := l.userUsecase.CreateLocalAuthUser(ctx, userID, req.Email, req.Password)
+	createdUser, err := l.userUsecase.CreateLocalAuthUser(ctx, userID, req.Email, req.Password, req.IsActive)
 	if err != nil {
 		l.logger.Error("Failed to create local user", zap.Error(err), zap.String("user_id", req.UserId))
 		if appErr, ok := err.(*errors.AppError); ok {
@@ -129,6 +129,28 @@ func (l *LocalUserHandler) UpdateLocalUserEmail(ctx context.Context, req *authv1
 	}, nil
 }
 
+// IsEmailAvailable implements authv1.LocalUserServiceServer.
+func (l *LocalUserHandler) IsEmailAvailable(ctx context.Context, req *authv1.IsEmailAvailableRequest) (*authv1.IsEmailAvailableResponse, error) {
+	if err := req.Validate(); err != nil {
+		l.logger.Error("IsEmailAvailable request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	available, err := l.userUsecase.IsEmailAvailable(ctx, req.Email)
+	if err != nil {
+		l.logger.Error("Failed to check email availability", zap.Error(err))
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, status.Error(errcode.MapCodeToGRPC(appErr.Code()), appErr.Public())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to check email availability: %v", err)
+	}
+
+	return &authv1.IsEmailAvailableResponse{
+		Email:     req.Email,
+		Available: available,
+	}, nil
+}
+
 // NewUserHandler creates a new UserHandler with the provided use case and logger.
 func NewLocalUserHandler(userUsecase authuser.LocalUserUsecase, logger *zap.Logger) authv1.LocalUserServiceServer {
 	return &LocalUserHandler{
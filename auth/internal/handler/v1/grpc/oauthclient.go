@@ -0,0 +1,154 @@
+package grpchandlerv1
+
+import (
+	"context"
+
+	authv1 "github.com/mandacode-com/accounts-proto/go/auth/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	"mandacode.com/accounts/auth/internal/usecase/oauthclient"
+	oauthclientdto "mandacode.com/accounts/auth/internal/usecase/oauthclient/dto"
+)
+
+type OAuthClientAdminHandler struct {
+	authv1.UnimplementedOAuthClientAdminServiceServer
+	adminClient *oauthclient.AdminClientUsecase
+	logger      *zap.Logger
+}
+
+// CreateClient implements authv1.OAuthClientAdminServiceServer.
+func (h *OAuthClientAdminHandler) CreateClient(ctx context.Context, req *authv1.CreateClientRequest) (*authv1.CreateClientResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("CreateClient request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	output, err := h.adminClient.CreateClient(ctx, oauthclientdto.CreateClientInput{
+		Name:         req.Name,
+		RedirectURIs: req.RedirectUris,
+		Scopes:       req.Scopes,
+		GrantTypes:   req.GrantTypes,
+		Confidential: req.Confidential,
+	})
+	if err != nil {
+		h.logger.Error("Failed to create OAuth client", zap.Error(err))
+		return nil, oauthClientError(err, "failed to create OAuth client")
+	}
+
+	return &authv1.CreateClientResponse{
+		Client: newOAuthClient(output.Client),
+		Secret: output.Secret,
+	}, nil
+}
+
+// GetClient implements authv1.OAuthClientAdminServiceServer.
+func (h *OAuthClientAdminHandler) GetClient(ctx context.Context, req *authv1.GetClientRequest) (*authv1.GetClientResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("GetClient request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	client, err := h.adminClient.GetClient(ctx, req.ClientId)
+	if err != nil {
+		h.logger.Error("Failed to get OAuth client", zap.Error(err), zap.String("client_id", req.ClientId))
+		return nil, oauthClientError(err, "failed to get OAuth client")
+	}
+
+	return &authv1.GetClientResponse{
+		Client: newOAuthClient(client),
+	}, nil
+}
+
+// RotateClientSecret implements authv1.OAuthClientAdminServiceServer.
+func (h *OAuthClientAdminHandler) RotateClientSecret(ctx context.Context, req *authv1.RotateClientSecretRequest) (*authv1.RotateClientSecretResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("RotateClientSecret request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	output, err := h.adminClient.RotateSecret(ctx, req.ClientId)
+	if err != nil {
+		h.logger.Error("Failed to rotate OAuth client secret", zap.Error(err), zap.String("client_id", req.ClientId))
+		return nil, oauthClientError(err, "failed to rotate OAuth client secret")
+	}
+
+	return &authv1.RotateClientSecretResponse{
+		Client: newOAuthClient(output.Client),
+		Secret: *output.Secret,
+	}, nil
+}
+
+// DisableClient implements authv1.OAuthClientAdminServiceServer.
+func (h *OAuthClientAdminHandler) DisableClient(ctx context.Context, req *authv1.DisableClientRequest) (*authv1.DisableClientResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("DisableClient request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	client, err := h.adminClient.DisableClient(ctx, req.ClientId)
+	if err != nil {
+		h.logger.Error("Failed to disable OAuth client", zap.Error(err), zap.String("client_id", req.ClientId))
+		return nil, oauthClientError(err, "failed to disable OAuth client")
+	}
+
+	return &authv1.DisableClientResponse{
+		Client: newOAuthClient(client),
+	}, nil
+}
+
+// EnableClient implements authv1.OAuthClientAdminServiceServer.
+func (h *OAuthClientAdminHandler) EnableClient(ctx context.Context, req *authv1.EnableClientRequest) (*authv1.EnableClientResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("EnableClient request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	client, err := h.adminClient.EnableClient(ctx, req.ClientId)
+	if err != nil {
+		h.logger.Error("Failed to enable OAuth client", zap.Error(err), zap.String("client_id", req.ClientId))
+		return nil, oauthClientError(err, "failed to enable OAuth client")
+	}
+
+	return &authv1.EnableClientResponse{
+		Client: newOAuthClient(client),
+	}, nil
+}
+
+// oauthClientError converts an error of the admin client usecase to a gRPC status.
+func oauthClientError(err error, message string) error {
+	if appErr, ok := err.(*errors.AppError); ok {
+		return status.Error(errcode.MapCodeToGRPC(appErr.Code()), appErr.Public())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+// newOAuthClient converts a client to its protobuf message.
+func newOAuthClient(client *dbmodels.SecureOAuthClient) *authv1.OAuthClient {
+	out := &authv1.OAuthClient{
+		ClientId:     client.ClientID,
+		Name:         client.Name,
+		RedirectUris: client.RedirectURIs,
+		Scopes:       client.Scopes,
+		GrantTypes:   client.GrantTypes,
+		Confidential: client.IsConfidential,
+		Disabled:     client.IsDisabled,
+		CreatedAt:    timestamppb.New(client.CreatedAt),
+		UpdatedAt:    timestamppb.New(client.UpdatedAt),
+	}
+	if client.SecretRotatedAt != nil {
+		out.SecretRotatedAt = timestamppb.New(*client.SecretRotatedAt)
+	}
+	return out
+}
+
+func NewOAuthClientAdminHandler(adminClient *oauthclient.AdminClientUsecase, logger *zap.Logger) authv1.OAuthClientAdminServiceServer {
+	return &OAuthClientAdminHandler{
+		adminClient: adminClient,
+		logger:      logger,
+	}
+}
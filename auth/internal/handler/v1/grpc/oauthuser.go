@@ -40,7 +40,7 @@ func (o *OAuthUserHandler) CreateOAuthUser(ctx context.Context, req *authv1.Crea
 		return nil, status.Errorf(codes.InvalidArgument, "invalid provider: %v", err)
 	}
 
-	createdUser, err := o.userUsecase.CreateOAuthUser(ctx, userID, entProvider, req.AccessToken, req.Code)
+	createdUser, err := o.userUsecase.CreateOAuthUser(ctx, userID, entProvider, req.AccessToken, req.Code, req.IsActive)
 	if err != nil {
 		o.logger.Error("Failed to create OAuth user", zap.Error(err), zap.String("user_id", req.UserId))
 		if appErr, ok := err.(*errors.AppError); ok {
@@ -0,0 +1,129 @@
+package grpchandlerv1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	authv1 "github.com/mandacode-com/accounts-proto/go/auth/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	"mandacode.com/accounts/auth/internal/usecase/usersession"
+)
+
+type SessionAdminHandler struct {
+	authv1.UnimplementedSessionAdminServiceServer
+	session *usersession.SessionUsecase
+	logger  *zap.Logger
+}
+
+// ListUserSessions implements authv1.SessionAdminServiceServer.
+func (h *SessionAdminHandler) ListUserSessions(ctx context.Context, req *authv1.ListUserSessionsRequest) (*authv1.ListUserSessionsResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("ListUserSessions request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Error("Invalid user ID format", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	sessions, err := h.session.ListUserSessions(ctx, userID)
+	if err != nil {
+		h.logger.Error("Failed to list user sessions", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, sessionError(err, "failed to list user sessions")
+	}
+
+	out := make([]*authv1.Session, 0, len(sessions))
+	for _, session := range sessions {
+		out = append(out, newSession(session))
+	}
+	return &authv1.ListUserSessionsResponse{
+		Sessions: out,
+	}, nil
+}
+
+// RevokeSession implements authv1.SessionAdminServiceServer.
+func (h *SessionAdminHandler) RevokeSession(ctx context.Context, req *authv1.RevokeSessionRequest) (*authv1.RevokeSessionResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("RevokeSession request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	sessionID, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		h.logger.Error("Invalid session ID format", zap.Error(err), zap.String("session_id", req.SessionId))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session ID: %v", err)
+	}
+
+	if err := h.session.RevokeSessionByID(ctx, sessionID); err != nil {
+		h.logger.Error("Failed to revoke session", zap.Error(err), zap.String("session_id", req.SessionId))
+		return nil, sessionError(err, "failed to revoke session")
+	}
+
+	return &authv1.RevokeSessionResponse{
+		SessionId: req.SessionId,
+		RevokedAt: timestamppb.Now(),
+	}, nil
+}
+
+// RevokeUserSessions implements authv1.SessionAdminServiceServer.
+func (h *SessionAdminHandler) RevokeUserSessions(ctx context.Context, req *authv1.RevokeUserSessionsRequest) (*authv1.RevokeUserSessionsResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("RevokeUserSessions request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Error("Invalid user ID format", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	output, err := h.session.RevokeAllSessions(ctx, userID)
+	if err != nil {
+		h.logger.Error("Failed to revoke user sessions", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, sessionError(err, "failed to revoke user sessions")
+	}
+
+	return &authv1.RevokeUserSessionsResponse{
+		UserId:    req.UserId,
+		Revoked:   int32(output.Revoked),
+		RevokedAt: timestamppb.Now(),
+	}, nil
+}
+
+// sessionError converts an error of the session usecase to a gRPC status.
+func sessionError(err error, message string) error {
+	if appErr, ok := err.(*errors.AppError); ok {
+		return status.Error(errcode.MapCodeToGRPC(appErr.Code()), appErr.Public())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+// newSession converts a session to its protobuf message.
+func newSession(session *dbmodels.SecureSession) *authv1.Session {
+	return &authv1.Session{
+		SessionId:   session.ID.String(),
+		UserId:      session.UserID.String(),
+		LoginMethod: string(session.LoginMethod),
+		Provider:    session.Provider,
+		UserAgent:   session.UserAgent,
+		IpAddress:   session.IPAddress,
+		CreatedAt:   timestamppb.New(session.CreatedAt),
+		LastSeenAt:  timestamppb.New(session.LastSeenAt),
+	}
+}
+
+func NewSessionAdminHandler(session *usersession.SessionUsecase, logger *zap.Logger) authv1.SessionAdminServiceServer {
+	return &SessionAdminHandler{
+		session: session,
+		logger:  logger,
+	}
+}
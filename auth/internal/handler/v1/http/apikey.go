@@ -0,0 +1,133 @@
+package httphandlerv1
+
+import (
+	stdErrors "errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+
+	handlerv1dto "mandacode.com/accounts/auth/internal/handler/v1/http/dto"
+	httpmiddleware "mandacode.com/accounts/auth/internal/middleware/http"
+	"mandacode.com/accounts/auth/internal/usecase/apikey"
+	apikeydto "mandacode.com/accounts/auth/internal/usecase/apikey/dto"
+)
+
+type APIKeyHandler struct {
+	apiKey    *apikey.APIKeyUsecase
+	logger    *zap.Logger
+	validator *validator.Validate
+}
+
+// NewAPIKeyHandler creates a new APIKeyHandler instance
+func NewAPIKeyHandler(
+	apiKey *apikey.APIKeyUsecase,
+	logger *zap.Logger,
+	validator *validator.Validate,
+) (*APIKeyHandler, error) {
+	if apiKey == nil {
+		return nil, stdErrors.New("apiKey cannot be nil")
+	}
+	if logger == nil {
+		return nil, stdErrors.New("logger cannot be nil")
+	}
+	if validator == nil {
+		return nil, stdErrors.New("validator cannot be nil")
+	}
+
+	return &APIKeyHandler{
+		apiKey:    apiKey,
+		logger:    logger,
+		validator: validator,
+	}, nil
+}
+
+func (h *APIKeyHandler) ValidateRequest(req interface{}) error {
+	if req == nil {
+		return errors.New("request cannot be nil", "InvalidRequest", errcode.ErrInvalidInput)
+	}
+	if err := h.validator.Struct(req); err != nil {
+		joinedErr := errors.Join(err, "validation failed")
+		return errors.Upgrade(joinedErr, "InvalidRequest", errcode.ErrInvalidInput)
+	}
+	return nil
+}
+
+// RegisterRoutes registers the routes for managing the caller's API keys. API keys are credentials, so
+// noImpersonate guards the routes creating and revoking them.
+func (h *APIKeyHandler) RegisterRoutes(rg *gin.RouterGroup, noImpersonate gin.HandlerFunc) {
+	rg.POST("", noImpersonate, h.CreateAPIKey)
+	rg.GET("", h.ListAPIKeys)
+	rg.DELETE("/:keyID", noImpersonate, h.RevokeAPIKey)
+}
+
+// CreateAPIKey handles creating a personal API key
+func (h *APIKeyHandler) CreateAPIKey(c *gin.Context) {
+	userID, err := httpmiddleware.UserIDFromContext(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	var req handlerv1dto.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.New(err.Error(), "InvalidRequest", errcode.ErrInvalidInput))
+		return
+	}
+	if err := h.ValidateRequest(&req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	output, err := h.apiKey.CreateAPIKey(c.Request.Context(), userID, apikeydto.CreateAPIKeyInput{
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, output)
+}
+
+// ListAPIKeys handles listing the caller's API keys
+func (h *APIKeyHandler) ListAPIKeys(c *gin.Context) {
+	userID, err := httpmiddleware.UserIDFromContext(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	apiKeys, err := h.apiKey.ListAPIKeys(c.Request.Context(), userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"api_keys": apiKeys})
+}
+
+// RevokeAPIKey handles revoking one of the caller's API keys
+func (h *APIKeyHandler) RevokeAPIKey(c *gin.Context) {
+	userID, err := httpmiddleware.UserIDFromContext(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	keyID, err := uuid.Parse(c.Param("keyID"))
+	if err != nil {
+		c.Error(errors.New("invalid key ID format", "InvalidKeyID", errcode.ErrInvalidInput))
+		return
+	}
+
+	if err := h.apiKey.RevokeAPIKey(c.Request.Context(), userID, keyID); err != nil {
+		c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,148 @@
+package httphandlerv1
+
+import (
+	stdErrors "errors"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+
+	handlerv1dto "mandacode.com/accounts/auth/internal/handler/v1/http/dto"
+	consentmodels "mandacode.com/accounts/auth/internal/models/consent"
+	"mandacode.com/accounts/auth/internal/usecase/login"
+)
+
+type ConsentHandler struct {
+	consent   *login.ConsentUsecase
+	logger    *zap.Logger
+	validator *validator.Validate
+}
+
+// NewConsentHandler creates a new ConsentHandler instance
+func NewConsentHandler(
+	consent *login.ConsentUsecase,
+	logger *zap.Logger,
+	validator *validator.Validate,
+) (*ConsentHandler, error) {
+	if consent == nil {
+		return nil, stdErrors.New("consent cannot be nil")
+	}
+	if logger == nil {
+		return nil, stdErrors.New("logger cannot be nil")
+	}
+	if validator == nil {
+		return nil, stdErrors.New("validator cannot be nil")
+	}
+
+	return &ConsentHandler{
+		consent:   consent,
+		logger:    logger,
+		validator: validator,
+	}, nil
+}
+
+func (h *ConsentHandler) ValidateRequest(req interface{}) error {
+	if req == nil {
+		return errors.New("request cannot be nil", "InvalidRequest", errcode.ErrInvalidInput)
+	}
+	if err := h.validator.Struct(req); err != nil {
+		joinedErr := errors.Join(err, "validation failed")
+		return errors.Upgrade(joinedErr, "InvalidRequest", errcode.ErrInvalidInput)
+	}
+	return nil
+}
+
+// RegisterRoutes registers the consent routes
+func (h *ConsentHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST("/confirm", h.Confirm)
+}
+
+// Confirm handles the consent of a user whose sign in was held back because they had yet to accept current
+// mandatory documents, and completes the sign in.
+//
+// Like the login endpoints, the refresh token is saved in the cookie session unless response_type=direct.
+func (h *ConsentHandler) Confirm(c *gin.Context) {
+	responseType := c.Query("response_type")
+	if responseType != "" && responseType != "direct" {
+		c.Error(errors.New("invalid response type", "InvalidResponseType", errcode.ErrInvalidInput))
+		return
+	}
+
+	var req handlerv1dto.ConsentConfirmRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.New(err.Error(), "InvalidRequest", errcode.ErrInvalidInput))
+		return
+	}
+	if err := h.ValidateRequest(&req); err != nil {
+		c.Error(err)
+		return
+	}
+	choices := make([]consentmodels.Choice, 0, len(req.Consents))
+	for _, consent := range req.Consents {
+		// The document IDs are validated as UUIDs
+		choices = append(choices, consentmodels.Choice{
+			DocumentID: uuid.MustParse(consent.DocumentID),
+			Accepted:   consent.Accepted,
+		})
+	}
+
+	accessToken, refreshToken, err := h.consent.Confirm(c.Request.Context(), req.ChallengeID, choices, requestInfo(c))
+	if respondStepUpRequired(c, err) || respondDeletionPending(c, err) || respondConsentRequired(c, err) {
+		return
+	}
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	if responseType == "direct" {
+		c.JSON(http.StatusOK, handlerv1dto.TokenResponse{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+		})
+		return
+	}
+
+	session := sessions.Default(c)
+	session.Set("refresh_token", refreshToken)
+	if err := session.Save(); err != nil {
+		c.Error(errors.Upgrade(err, "Failed to save session", errcode.ErrInternalFailure))
+		return
+	}
+	c.JSON(http.StatusOK, handlerv1dto.AccessTokenResponse{
+		AccessToken: accessToken,
+	})
+}
+
+// respondConsentRequired answers a sign in held back because the user has yet to accept current mandatory
+// documents, reporting whether err held it back.
+func respondConsentRequired(c *gin.Context, err error) bool {
+	var consentErr *login.ConsentRequiredError
+	if !stdErrors.As(err, &consentErr) {
+		return false
+	}
+	documents := make([]handlerv1dto.ConsentDocument, 0, len(consentErr.Documents))
+	for _, document := range consentErr.Documents {
+		documents = append(documents, handlerv1dto.ConsentDocument{
+			ID:         document.ID.String(),
+			Kind:       document.Kind,
+			Version:    document.Version,
+			Title:      document.Title,
+			ContentURL: document.ContentURL,
+		})
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusForbidden, handlerv1dto.ConsentRequiredResponse{
+		Error:       "consent_required",
+		ChallengeID: consentErr.ChallengeID,
+		ExpiresIn:   consentErr.ExpiresIn,
+		Documents:   documents,
+	})
+	return true
+}
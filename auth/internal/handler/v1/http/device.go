@@ -0,0 +1,266 @@
+package httphandlerv1
+
+import (
+	stdErrors "errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+
+	handlerv1dto "mandacode.com/accounts/auth/internal/handler/v1/http/dto"
+	httpmiddleware "mandacode.com/accounts/auth/internal/middleware/http"
+	"mandacode.com/accounts/auth/internal/usecase/login"
+	logindto "mandacode.com/accounts/auth/internal/usecase/login/dto"
+)
+
+type DeviceAuthHandler struct {
+	deviceLogin *login.DeviceLoginUsecase
+	logger      *zap.Logger
+	validator   *validator.Validate
+}
+
+// NewDeviceAuthHandler creates a new DeviceAuthHandler instance
+func NewDeviceAuthHandler(
+	deviceLogin *login.DeviceLoginUsecase,
+	logger *zap.Logger,
+	validator *validator.Validate,
+) (*DeviceAuthHandler, error) {
+	if deviceLogin == nil {
+		return nil, stdErrors.New("deviceLogin cannot be nil")
+	}
+	if logger == nil {
+		return nil, stdErrors.New("logger cannot be nil")
+	}
+	if validator == nil {
+		return nil, stdErrors.New("validator cannot be nil")
+	}
+
+	return &DeviceAuthHandler{
+		deviceLogin: deviceLogin,
+		logger:      logger,
+		validator:   validator,
+	}, nil
+}
+
+func (h *DeviceAuthHandler) ValidateRequest(req interface{}) error {
+	if req == nil {
+		return errors.New("request cannot be nil", "InvalidRequest", errcode.ErrInvalidInput)
+	}
+	if err := h.validator.Struct(req); err != nil {
+		joinedErr := errors.Join(err, "validation failed")
+		return errors.Upgrade(joinedErr, "InvalidRequest", errcode.ErrInvalidInput)
+	}
+	return nil
+}
+
+// RegisterRoutes registers the device authorization routes. The routes of the verification page are for signed
+// in users: userAuth authenticates them, and noImpersonate keeps impersonation tokens from signing the user in on
+// a device.
+func (h *DeviceAuthHandler) RegisterRoutes(rg *gin.RouterGroup, userAuth gin.HandlerFunc, noImpersonate gin.HandlerFunc) {
+	// Endpoints used by the device
+	rg.POST("/code", h.RequestCode)
+	rg.POST("/token", h.Token)
+
+	// Endpoints used by the signed in user on the verification page
+	rg.GET("/verify", userAuth, h.GetAuthorization)
+	rg.POST("/approve", userAuth, noImpersonate, h.Approve)
+	rg.POST("/approve/step-up", userAuth, noImpersonate, h.VerifyApproval)
+	rg.POST("/deny", userAuth, noImpersonate, h.Deny)
+}
+
+// RequestCode handles the device authorization request
+func (h *DeviceAuthHandler) RequestCode(c *gin.Context) {
+	var req handlerv1dto.DeviceCodeRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.Error(errors.New(err.Error(), "InvalidRequest", errcode.ErrInvalidInput))
+		return
+	}
+	if err := h.ValidateRequest(&req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	output, err := h.deviceLogin.RequestDeviceCode(c.Request.Context(), req.ClientID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, handlerv1dto.DeviceCodeResponse{
+		DeviceCode:              output.DeviceCode,
+		UserCode:                output.UserCode,
+		VerificationURI:         output.VerificationURI,
+		VerificationURIComplete: output.VerificationURIComplete,
+		ExpiresIn:               output.ExpiresIn,
+		Interval:                output.Interval,
+	})
+}
+
+// Token handles the device access token request polled by the device
+func (h *DeviceAuthHandler) Token(c *gin.Context) {
+	var req handlerv1dto.DeviceTokenRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.Error(errors.New(err.Error(), "invalid_request", errcode.ErrInvalidInput))
+		return
+	}
+	if req.GrantType != "" && req.GrantType != handlerv1dto.DeviceCodeGrantType {
+		c.Error(errors.New("unsupported grant type", "unsupported_grant_type", errcode.ErrInvalidInput))
+		return
+	}
+	// Prefer client_secret_basic over client_secret_post
+	if clientID, clientSecret, ok := c.Request.BasicAuth(); ok {
+		var err error
+		if req.ClientID, err = url.QueryUnescape(clientID); err != nil {
+			c.Error(errors.New(err.Error(), login.DeviceErrInvalidClient, errcode.ErrUnauthorized))
+			return
+		}
+		if req.ClientSecret, err = url.QueryUnescape(clientSecret); err != nil {
+			c.Error(errors.New(err.Error(), login.DeviceErrInvalidClient, errcode.ErrUnauthorized))
+			return
+		}
+	}
+	if err := h.validator.Struct(&req); err != nil {
+		c.Error(errors.New(err.Error(), "invalid_request", errcode.ErrInvalidInput))
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	accessToken, refreshToken, err := h.deviceLogin.PollToken(c.Request.Context(), req.ClientID, req.ClientSecret, req.DeviceCode, requestInfo(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, handlerv1dto.TokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	})
+}
+
+// GetAuthorization returns the pending device authorization for the user code
+func (h *DeviceAuthHandler) GetAuthorization(c *gin.Context) {
+	req := handlerv1dto.DeviceUserCodeRequest{UserCode: c.Query("user_code")}
+	if err := h.ValidateRequest(&req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	info, err := h.deviceLogin.GetDeviceAuthorization(c.Request.Context(), req.UserCode)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, handlerv1dto.DeviceAuthorizationResponse{
+		UserCode:  info.UserCode,
+		ClientID:  info.ClientID,
+		ExpiresAt: info.ExpiresAt.Unix(),
+	})
+}
+
+// Approve handles the signed in user's approval of a device.
+//
+// The approval is refused with consent_required until the user accepts current mandatory documents through the
+// user service, and may have to be confirmed with the step-up code mailed to the user.
+func (h *DeviceAuthHandler) Approve(c *gin.Context) {
+	approver, err := deviceApprover(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	var req handlerv1dto.DeviceUserCodeRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.Error(errors.New(err.Error(), "InvalidRequest", errcode.ErrInvalidInput))
+		return
+	}
+	if err := h.ValidateRequest(&req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	err = h.deviceLogin.ApproveDevice(c.Request.Context(), approver, req.UserCode, requestInfo(c))
+	if respondConsentRequired(c, err) || respondStepUpRequired(c, err) {
+		return
+	}
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// VerifyApproval handles completing a device approval held back by a step-up challenge
+func (h *DeviceAuthHandler) VerifyApproval(c *gin.Context) {
+	approver, err := deviceApprover(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	var req handlerv1dto.StepUpVerifyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.New(err.Error(), "InvalidRequest", errcode.ErrInvalidInput))
+		return
+	}
+	if err := h.ValidateRequest(&req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.deviceLogin.VerifyApproval(c.Request.Context(), approver, req.ChallengeID, req.Code, requestInfo(c)); err != nil {
+		c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// Deny handles the signed in user's denial of a device
+func (h *DeviceAuthHandler) Deny(c *gin.Context) {
+	userID, err := httpmiddleware.UserIDFromContext(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	var req handlerv1dto.DeviceUserCodeRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.Error(errors.New(err.Error(), "InvalidRequest", errcode.ErrInvalidInput))
+		return
+	}
+	if err := h.ValidateRequest(&req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.deviceLogin.DenyDevice(c.Request.Context(), userID, req.UserCode); err != nil {
+		c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// deviceApprover returns the signed in user authenticated by AccessTokenAuth
+func deviceApprover(c *gin.Context) (logindto.DeviceApprover, error) {
+	userID, err := httpmiddleware.UserIDFromContext(c)
+	if err != nil {
+		return logindto.DeviceApprover{}, err
+	}
+	return logindto.DeviceApprover{
+		UserID:         userID,
+		ImpersonatorID: httpmiddleware.ImpersonatorIDFromContext(c),
+	}, nil
+}
+
+// bearerToken extracts the bearer token from the Authorization header
+func bearerToken(c *gin.Context) (string, error) {
+	header := c.GetHeader("Authorization")
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok || strings.TrimSpace(token) == "" {
+		return "", errors.New("missing bearer token", "Unauthorized", errcode.ErrUnauthorized)
+	}
+	return strings.TrimSpace(token), nil
+}
@@ -0,0 +1,26 @@
+package handlerv1dto
+
+type ConsentDocument struct {
+	ID         string `json:"id"`
+	Kind       string `json:"kind"`
+	Version    int    `json:"version"`
+	Title      string `json:"title"`
+	ContentURL string `json:"content_url"`
+}
+
+type ConsentRequiredResponse struct {
+	Error       string            `json:"error"`
+	ChallengeID string            `json:"challenge_id,omitempty"`
+	ExpiresIn   int64             `json:"expires_in,omitempty"`
+	Documents   []ConsentDocument `json:"documents"`
+}
+
+type ConsentChoice struct {
+	DocumentID string `json:"document_id" validate:"required,uuid"`
+	Accepted   bool   `json:"accepted"`
+}
+
+type ConsentConfirmRequest struct {
+	ChallengeID string          `json:"challenge_id" validate:"required,hexadecimal,max=128"`
+	Consents    []ConsentChoice `json:"consents" validate:"required,min=1,max=16,dive"`
+}
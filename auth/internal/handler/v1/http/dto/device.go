@@ -0,0 +1,34 @@
+package handlerv1dto
+
+const DeviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+type DeviceCodeRequest struct {
+	ClientID string `json:"client_id" form:"client_id" validate:"required,max=255"`
+	Scope    string `json:"scope" form:"scope" validate:"omitempty,max=1024"`
+}
+
+type DeviceCodeResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+}
+
+type DeviceTokenRequest struct {
+	GrantType    string `json:"grant_type" form:"grant_type" validate:"required"`
+	DeviceCode   string `json:"device_code" form:"device_code" validate:"required"`
+	ClientID     string `json:"client_id" form:"client_id" validate:"required,max=255"`
+	ClientSecret string `json:"client_secret" form:"client_secret" validate:"omitempty,max=255"`
+}
+
+type DeviceUserCodeRequest struct {
+	UserCode string `json:"user_code" form:"user_code" validate:"required,max=16"`
+}
+
+type DeviceAuthorizationResponse struct {
+	UserCode  string `json:"user_code"`
+	ClientID  string `json:"client_id"`
+	ExpiresAt int64  `json:"expires_at"`
+}
@@ -0,0 +1,5 @@
+package handlerv1dto
+
+type ImpersonateRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
@@ -1,7 +1,13 @@
 package handlerv1dto
 
+// MobileOAuthLoginRequest is the request of a sign in with the access token of a provider.
+//
+// The birth date is required for the first sign in, which signs up the user, along with the email address
+// of the guardian of users under 14.
 type MobileOAuthLoginRequest struct {
-	AccessToken string `json:"access_token" binding:"required"`
+	AccessToken   string  `json:"access_token" binding:"required"`
+	BirthDate     *string `json:"birth_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	GuardianEmail *string `json:"guardian_email,omitempty" validate:"omitempty,email"`
 }
 
 type OAuthCallbackResponse struct {
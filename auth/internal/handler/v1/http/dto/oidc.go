@@ -0,0 +1,24 @@
+package handlerv1dto
+
+type AuthorizeRequest struct {
+	ResponseType        string `form:"response_type"`
+	ClientID            string `form:"client_id" validate:"required,max=255"`
+	RedirectURI         string `form:"redirect_uri" validate:"required,url"`
+	Scope               string `form:"scope"`
+	State               string `form:"state" validate:"omitempty,max=1024"`
+	Nonce               string `form:"nonce" validate:"omitempty,max=1024"`
+	CodeChallenge       string `form:"code_challenge" validate:"omitempty,min=43,max=128"`
+	CodeChallengeMethod string `form:"code_challenge_method"`
+	Prompt              string `form:"prompt"`
+}
+
+type OAuthTokenRequest struct {
+	GrantType    string `form:"grant_type"`
+	Code         string `form:"code"`
+	RedirectURI  string `form:"redirect_uri"`
+	ClientID     string `form:"client_id"`
+	ClientSecret string `form:"client_secret"`
+	CodeVerifier string `form:"code_verifier"`
+	RefreshToken string `form:"refresh_token"`
+	Scope        string `form:"scope"`
+}
@@ -0,0 +1,15 @@
+package handlerv1dto
+
+type DeletionPendingResponse struct {
+	Error       string `json:"error"`
+	ChallengeID string `json:"challenge_id"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+type RestoreConfirmRequest struct {
+	ChallengeID string `json:"challenge_id" validate:"required,hexadecimal,max=128"`
+}
+
+type CancelDeletionRequest struct {
+	Token string `json:"token" validate:"required,hexadecimal,max=128"`
+}
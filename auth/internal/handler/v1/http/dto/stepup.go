@@ -0,0 +1,12 @@
+package handlerv1dto
+
+type StepUpRequiredResponse struct {
+	Error       string `json:"error"`
+	ChallengeID string `json:"challenge_id"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+type StepUpVerifyRequest struct {
+	ChallengeID string `json:"challenge_id" validate:"required,hexadecimal,max=128"`
+	Code        string `json:"code" validate:"required,numeric,max=16"`
+}
@@ -8,3 +8,16 @@ type TokenResponse struct {
 type AccessTokenResponse struct {
 	AccessToken string `json:"access_token"`
 }
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"omitempty,max=4096"`
+	Everywhere   bool   `json:"everywhere"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required,max=4096"`
+}
+
+type SwitchOrganizationRequest struct {
+	OrgID string `json:"org_id" validate:"required,uuid"`
+}
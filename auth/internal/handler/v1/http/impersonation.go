@@ -0,0 +1,96 @@
+package httphandlerv1
+
+import (
+	stdErrors "errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+
+	handlerv1dto "mandacode.com/accounts/auth/internal/handler/v1/http/dto"
+	httpmiddleware "mandacode.com/accounts/auth/internal/middleware/http"
+	"mandacode.com/accounts/auth/internal/usecase/impersonation"
+)
+
+type ImpersonationHandler struct {
+	impersonation *impersonation.ImpersonationUsecase
+	logger        *zap.Logger
+	validator     *validator.Validate
+}
+
+// NewImpersonationHandler creates a new ImpersonationHandler instance
+func NewImpersonationHandler(
+	impersonation *impersonation.ImpersonationUsecase,
+	logger *zap.Logger,
+	validator *validator.Validate,
+) (*ImpersonationHandler, error) {
+	if impersonation == nil {
+		return nil, stdErrors.New("impersonation cannot be nil")
+	}
+	if logger == nil {
+		return nil, stdErrors.New("logger cannot be nil")
+	}
+	if validator == nil {
+		return nil, stdErrors.New("validator cannot be nil")
+	}
+
+	return &ImpersonationHandler{
+		impersonation: impersonation,
+		logger:        logger,
+		validator:     validator,
+	}, nil
+}
+
+func (h *ImpersonationHandler) ValidateRequest(req interface{}) error {
+	if req == nil {
+		return errors.New("request cannot be nil", "InvalidRequest", errcode.ErrInvalidInput)
+	}
+	if err := h.validator.Struct(req); err != nil {
+		joinedErr := errors.Join(err, "validation failed")
+		return errors.Upgrade(joinedErr, "InvalidRequest", errcode.ErrInvalidInput)
+	}
+	return nil
+}
+
+// RegisterAdminRoutes registers the routes for impersonating users. They must follow AdminKeyAuth, to restrict
+// them to admins, and AccessTokenAuth, to identify the admin.
+func (h *ImpersonationHandler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	rg.POST("/users/:userID", h.Impersonate)
+}
+
+// Impersonate handles issuing a token letting the calling admin act as a user
+func (h *ImpersonationHandler) Impersonate(c *gin.Context) {
+	adminID, err := httpmiddleware.UserIDFromContext(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	userID, err := uuid.Parse(c.Param("userID"))
+	if err != nil {
+		c.Error(errors.New("invalid user ID format", "InvalidUserID", errcode.ErrInvalidInput))
+		return
+	}
+
+	var req handlerv1dto.ImpersonateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.New(err.Error(), "InvalidRequest", errcode.ErrInvalidInput))
+		return
+	}
+	if err := h.ValidateRequest(&req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	output, err := h.impersonation.Impersonate(c.Request.Context(), adminID, userID, req.Reason)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, output)
+}
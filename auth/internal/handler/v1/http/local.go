@@ -54,9 +54,11 @@ func (h *LocalAuthHandler) ValidateRequest(req interface{}) error {
 }
 
 // RegisterRoutes registers the local authentication routes
-func (h *LocalAuthHandler) RegisterRoutes(rg *gin.RouterGroup) {
-	rg.POST("/login", h.Login)
-	rg.POST("/login/code", h.LoginCode)
+//
+// captcha guards the routes which check passwords.
+func (h *LocalAuthHandler) RegisterRoutes(rg *gin.RouterGroup, captcha gin.HandlerFunc) {
+	rg.POST("/login", captcha, h.Login)
+	rg.POST("/login/code", captcha, h.LoginCode)
 	rg.GET("/verify/:userID", h.VerifyCode)
 }
 
@@ -82,11 +84,15 @@ func (h *LocalAuthHandler) Login(c *gin.Context) {
 	input := logindto.LocalLoginInput{
 		Email:    req.Email,
 		Password: req.Password,
+		Info:     requestInfo(c),
 	}
 
 	// If responseType is "direct", return access and refresh tokens directly
 	if responseType == "direct" {
 		accessToken, refreshToken, err := h.localLogin.Login(c.Request.Context(), input)
+		if respondStepUpRequired(c, err) || respondDeletionPending(c, err) || respondConsentRequired(c, err) {
+			return
+		}
 		if err != nil {
 			c.Error(err)
 			return
@@ -101,6 +107,9 @@ func (h *LocalAuthHandler) Login(c *gin.Context) {
 
 	// If responseType is not "direct", save the refresh token in the session
 	accessToken, refreshToken, err := h.localLogin.Login(c.Request.Context(), input)
+	if respondStepUpRequired(c, err) || respondDeletionPending(c, err) || respondConsentRequired(c, err) {
+		return
+	}
 	if err != nil {
 		c.Error(err)
 		return
@@ -134,6 +143,7 @@ func (h *LocalAuthHandler) LoginCode(c *gin.Context) {
 	input := logindto.LocalLoginInput{
 		Email:    req.Email,
 		Password: req.Password,
+		Info:     requestInfo(c),
 	}
 
 	code, userID, err := h.localLogin.IssueLoginCode(c.Request.Context(), input)
@@ -175,7 +185,10 @@ func (h *LocalAuthHandler) VerifyCode(c *gin.Context) {
 		return
 	}
 	// Verify the login code
-	accessToken, refreshToken, err := h.localLogin.VerifyLoginCode(c.Request.Context(), userIDParsed, code)
+	accessToken, refreshToken, err := h.localLogin.VerifyLoginCode(c.Request.Context(), userIDParsed, code, requestInfo(c))
+	if respondStepUpRequired(c, err) || respondDeletionPending(c, err) || respondConsentRequired(c, err) {
+		return
+	}
 	if err != nil {
 		c.Error(err)
 		return
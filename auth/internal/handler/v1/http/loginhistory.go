@@ -0,0 +1,67 @@
+package httphandlerv1
+
+import (
+	stdErrors "errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+
+	httpmiddleware "mandacode.com/accounts/auth/internal/middleware/http"
+	"mandacode.com/accounts/auth/internal/usecase/loginhistory"
+)
+
+type LoginHistoryHandler struct {
+	history *loginhistory.LoginHistoryUsecase
+	logger  *zap.Logger
+}
+
+// NewLoginHistoryHandler creates a new LoginHistoryHandler instance
+func NewLoginHistoryHandler(
+	history *loginhistory.LoginHistoryUsecase,
+	logger *zap.Logger,
+) (*LoginHistoryHandler, error) {
+	if history == nil {
+		return nil, stdErrors.New("history cannot be nil")
+	}
+	if logger == nil {
+		return nil, stdErrors.New("logger cannot be nil")
+	}
+
+	return &LoginHistoryHandler{
+		history: history,
+		logger:  logger,
+	}, nil
+}
+
+// RegisterRoutes registers the login history routes
+func (h *LoginHistoryHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("", h.ListLoginHistory)
+}
+
+// ListLoginHistory handles listing the caller's recent login attempts
+func (h *LoginHistoryHandler) ListLoginHistory(c *gin.Context) {
+	userID, err := httpmiddleware.UserIDFromContext(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	limit := 0
+	if rawLimit := c.Query("limit"); rawLimit != "" {
+		limit, err = strconv.Atoi(rawLimit)
+		if err != nil {
+			c.Error(errors.New("invalid limit", "InvalidLimit", errcode.ErrInvalidInput))
+			return
+		}
+	}
+
+	attempts, err := h.history.ListLoginHistory(c.Request.Context(), userID, limit)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"login_history": attempts})
+}
@@ -18,6 +18,12 @@ import (
 	"mandacode.com/accounts/auth/internal/util"
 )
 
+// Keys of the session holding the age of new users between the redirect to the provider and the callback
+const (
+	sessionBirthDateKey     = "oauth_birth_date"
+	sessionGuardianEmailKey = "oauth_guardian_email"
+)
+
 type OAuthHandler struct {
 	oauthLogin *login.OAuthLoginUsecase
 	logger     *zap.Logger
@@ -93,6 +99,22 @@ func (h *OAuthHandler) Login(c *gin.Context) {
 		return
 	}
 
+	// The birth date of new users, which providers do not share, is kept in the session until the callback
+	session := sessions.Default(c)
+	session.Delete(sessionBirthDateKey)
+	session.Delete(sessionGuardianEmailKey)
+	if birthDate := c.Query("birth_date"); birthDate != "" {
+		session.Set(sessionBirthDateKey, birthDate)
+	}
+	if guardianEmail := c.Query("guardian_email"); guardianEmail != "" {
+		session.Set(sessionGuardianEmailKey, guardianEmail)
+	}
+	if err := session.Save(); err != nil {
+		h.LogError(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save session"})
+		return
+	}
+
 	c.Redirect(http.StatusFound, loginURL)
 }
 
@@ -125,12 +147,17 @@ func (h *OAuthHandler) MobileLogin(c *gin.Context) {
 		return
 	}
 	input := logindto.OAuthLoginInput{
-		Provider:    providerEnum,
-		AccessToken: req.AccessToken,
-		Code:        "",
+		Provider:      providerEnum,
+		AccessToken:   req.AccessToken,
+		Code:          "",
+		Info:          requestInfo(c),
+		BirthDate:     req.BirthDate,
+		GuardianEmail: req.GuardianEmail,
 	}
 	accessToken, refreshToken, err := h.oauthLogin.Login(ctx, input)
-
+	if respondAgeRequired(c, err) || respondStepUpRequired(c, err) || respondDeletionPending(c, err) || respondConsentRequired(c, err) {
+		return
+	}
 	if err != nil {
 		h.LogError(err)
 		if appErr, ok := err.(*errors.AppError); ok {
@@ -174,8 +201,26 @@ func (h *OAuthHandler) Callback(c *gin.Context) {
 		Provider:    providerEnum,
 		Code:        code,
 		AccessToken: "",
+		Info:        requestInfo(c),
+	}
+	session := sessions.Default(c)
+	if birthDate, ok := session.Get(sessionBirthDateKey).(string); ok {
+		input.BirthDate = &birthDate
+	}
+	if guardianEmail, ok := session.Get(sessionGuardianEmailKey).(string); ok {
+		input.GuardianEmail = &guardianEmail
+	}
+	session.Delete(sessionBirthDateKey)
+	session.Delete(sessionGuardianEmailKey)
+	if err := session.Save(); err != nil {
+		h.LogError(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save session"})
+		return
 	}
 	code, userID, err := h.oauthLogin.IssueLoginCode(ctx, input)
+	if respondAgeRequired(c, err) {
+		return
+	}
 	if err != nil {
 		h.LogError(err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to login with OAuth"})
@@ -189,6 +234,17 @@ func (h *OAuthHandler) Callback(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// respondAgeRequired answers the first sign in of a user who gave no birth date, reporting whether err held it
+// back. The user signs in again with their birth date, which signs them up.
+func respondAgeRequired(c *gin.Context, err error) bool {
+	var ageErr *login.AgeRequiredError
+	if !stdErrors.As(err, &ageErr) {
+		return false
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": "age_required"})
+	return true
+}
+
 func (h *OAuthHandler) VerifyCode(c *gin.Context) {
 	code := c.Query("code")
 	if code == "" {
@@ -205,7 +261,10 @@ func (h *OAuthHandler) VerifyCode(c *gin.Context) {
 
 	ctx := c.Request.Context()
 
-	accessToken, refreshToken, err := h.oauthLogin.VerifyLoginCode(ctx, userUID, code)
+	accessToken, refreshToken, err := h.oauthLogin.VerifyLoginCode(ctx, userUID, code, requestInfo(c))
+	if respondStepUpRequired(c, err) || respondDeletionPending(c, err) || respondConsentRequired(c, err) {
+		return
+	}
 	if err != nil {
 		h.LogError(err)
 		if appErr, ok := err.(*errors.AppError); ok {
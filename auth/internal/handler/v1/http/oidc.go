@@ -0,0 +1,168 @@
+package httphandlerv1
+
+import (
+	stdErrors "errors"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+
+	handlerv1dto "mandacode.com/accounts/auth/internal/handler/v1/http/dto"
+	"mandacode.com/accounts/auth/internal/usecase/oidc"
+	oidcdto "mandacode.com/accounts/auth/internal/usecase/oidc/dto"
+)
+
+type OIDCHandler struct {
+	provider  *oidc.ProviderUsecase
+	logger    *zap.Logger
+	validator *validator.Validate
+}
+
+// NewOIDCHandler creates a new OIDCHandler instance
+func NewOIDCHandler(
+	provider *oidc.ProviderUsecase,
+	logger *zap.Logger,
+	validator *validator.Validate,
+) (*OIDCHandler, error) {
+	if provider == nil {
+		return nil, stdErrors.New("provider cannot be nil")
+	}
+	if logger == nil {
+		return nil, stdErrors.New("logger cannot be nil")
+	}
+	if validator == nil {
+		return nil, stdErrors.New("validator cannot be nil")
+	}
+
+	return &OIDCHandler{
+		provider:  provider,
+		logger:    logger,
+		validator: validator,
+	}, nil
+}
+
+// RegisterRoutes registers the OpenID Connect provider endpoints
+func (h *OIDCHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/authorize", h.Authorize)
+	rg.POST("/token", h.Token)
+	rg.GET("/userinfo", h.UserInfo)
+	rg.POST("/userinfo", h.UserInfo)
+	rg.GET("/jwks", h.JWKS)
+}
+
+// RegisterWellKnownRoutes registers the discovery endpoint under /.well-known
+func (h *OIDCHandler) RegisterWellKnownRoutes(rg *gin.RouterGroup) {
+	rg.GET("/openid-configuration", h.Discovery)
+}
+
+// Authorize handles the authorization endpoint of the authorization code flow
+func (h *OIDCHandler) Authorize(c *gin.Context) {
+	var req handlerv1dto.AuthorizeRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.Error(errors.New(err.Error(), oidc.ErrInvalidRequest, errcode.ErrInvalidInput))
+		return
+	}
+	if err := h.validator.Struct(&req); err != nil {
+		c.Error(errors.New(err.Error(), oidc.ErrInvalidRequest, errcode.ErrInvalidInput))
+		return
+	}
+
+	session := sessions.Default(c)
+	refreshToken, _ := session.Get("refresh_token").(string)
+
+	output, err := h.provider.Authorize(c.Request.Context(), oidcdto.AuthorizeInput{
+		ResponseType:        req.ResponseType,
+		ClientID:            req.ClientID,
+		RedirectURI:         req.RedirectURI,
+		Scope:               req.Scope,
+		State:               req.State,
+		Nonce:               req.Nonce,
+		CodeChallenge:       req.CodeChallenge,
+		CodeChallengeMethod: req.CodeChallengeMethod,
+		Prompt:              req.Prompt,
+		RequestURI:          c.Request.URL.RequestURI(),
+		RefreshToken:        refreshToken,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Redirect(http.StatusFound, output.RedirectURL)
+}
+
+// Token handles the token endpoint
+func (h *OIDCHandler) Token(c *gin.Context) {
+	var req handlerv1dto.OAuthTokenRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.Error(errors.New(err.Error(), oidc.ErrInvalidRequest, errcode.ErrInvalidInput))
+		return
+	}
+
+	// Prefer client_secret_basic over client_secret_post
+	if clientID, clientSecret, ok := c.Request.BasicAuth(); ok {
+		var err error
+		if req.ClientID, err = url.QueryUnescape(clientID); err != nil {
+			c.Error(errors.New(err.Error(), oidc.ErrInvalidClient, errcode.ErrUnauthorized))
+			return
+		}
+		if req.ClientSecret, err = url.QueryUnescape(clientSecret); err != nil {
+			c.Error(errors.New(err.Error(), oidc.ErrInvalidClient, errcode.ErrUnauthorized))
+			return
+		}
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Header("Pragma", "no-cache")
+	output, err := h.provider.Token(c.Request.Context(), oidcdto.TokenInput{
+		GrantType:    req.GrantType,
+		Code:         req.Code,
+		RedirectURI:  req.RedirectURI,
+		ClientID:     req.ClientID,
+		ClientSecret: req.ClientSecret,
+		CodeVerifier: req.CodeVerifier,
+		RefreshToken: req.RefreshToken,
+		Scope:        req.Scope,
+		Info:         requestInfo(c),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// UserInfo handles the userinfo endpoint
+func (h *OIDCHandler) UserInfo(c *gin.Context) {
+	accessToken, err := bearerToken(c)
+	if err != nil {
+		c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+		c.Error(err)
+		return
+	}
+
+	output, err := h.provider.UserInfo(c.Request.Context(), accessToken)
+	if err != nil {
+		c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// Discovery handles the OpenID Provider configuration document
+func (h *OIDCHandler) Discovery(c *gin.Context) {
+	c.JSON(http.StatusOK, h.provider.Discovery())
+}
+
+// JWKS handles the JSON Web Key Set used to verify ID tokens
+func (h *OIDCHandler) JWKS(c *gin.Context) {
+	c.JSON(http.StatusOK, h.provider.JWKS())
+}
@@ -0,0 +1,97 @@
+package httphandlerv1
+
+import (
+	stdErrors "errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+
+	handlerv1dto "mandacode.com/accounts/auth/internal/handler/v1/http/dto"
+	httpmiddleware "mandacode.com/accounts/auth/internal/middleware/http"
+	"mandacode.com/accounts/auth/internal/usecase/token"
+)
+
+type OrganizationTokenHandler struct {
+	organizationToken *token.OrganizationTokenUsecase
+	logger            *zap.Logger
+	validator         *validator.Validate
+}
+
+// NewOrganizationTokenHandler creates a new OrganizationTokenHandler instance
+func NewOrganizationTokenHandler(
+	organizationToken *token.OrganizationTokenUsecase,
+	logger *zap.Logger,
+	validator *validator.Validate,
+) (*OrganizationTokenHandler, error) {
+	if organizationToken == nil {
+		return nil, stdErrors.New("organizationToken cannot be nil")
+	}
+	if logger == nil {
+		return nil, stdErrors.New("logger cannot be nil")
+	}
+	if validator == nil {
+		return nil, stdErrors.New("validator cannot be nil")
+	}
+
+	return &OrganizationTokenHandler{
+		organizationToken: organizationToken,
+		logger:            logger,
+		validator:         validator,
+	}, nil
+}
+
+func (h *OrganizationTokenHandler) ValidateRequest(req interface{}) error {
+	if req == nil {
+		return errors.New("request cannot be nil", "InvalidRequest", errcode.ErrInvalidInput)
+	}
+	if err := h.validator.Struct(req); err != nil {
+		joinedErr := errors.Join(err, "validation failed")
+		return errors.Upgrade(joinedErr, "InvalidRequest", errcode.ErrInvalidInput)
+	}
+	return nil
+}
+
+// RegisterRoutes registers the routes switching the active organization of the caller
+func (h *OrganizationTokenHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST("", h.SwitchOrganization)
+}
+
+// SwitchOrganization handles issuing an access token scoped to one of the caller's organizations.
+//
+// The refresh token is left untouched, so refreshing returns to a token without an active organization.
+func (h *OrganizationTokenHandler) SwitchOrganization(c *gin.Context) {
+	userID, err := httpmiddleware.UserIDFromContext(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	var req handlerv1dto.SwitchOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.New(err.Error(), "InvalidRequest", errcode.ErrInvalidInput))
+		return
+	}
+	if err := h.ValidateRequest(&req); err != nil {
+		c.Error(err)
+		return
+	}
+	orgID, err := uuid.Parse(req.OrgID)
+	if err != nil {
+		c.Error(errors.New("invalid organization ID format", "InvalidOrganizationID", errcode.ErrInvalidInput))
+		return
+	}
+
+	accessToken, err := h.organizationToken.SwitchOrganization(c.Request.Context(), userID, orgID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, handlerv1dto.AccessTokenResponse{
+		AccessToken: accessToken,
+	})
+}
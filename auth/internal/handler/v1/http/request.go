@@ -0,0 +1,19 @@
+package httphandlerv1
+
+import (
+	"github.com/gin-gonic/gin"
+
+	reqmodels "mandacode.com/accounts/auth/internal/models/request"
+)
+
+// DeviceIDHeader is the header clients may use to identify the device they run on
+const DeviceIDHeader = "X-Device-ID"
+
+// requestInfo collects the information about the client which is recorded with logins
+func requestInfo(c *gin.Context) reqmodels.RequestInfo {
+	return reqmodels.RequestInfo{
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		DeviceID:  c.GetHeader(DeviceIDHeader),
+	}
+}
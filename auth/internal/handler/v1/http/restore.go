@@ -0,0 +1,146 @@
+package httphandlerv1
+
+import (
+	stdErrors "errors"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+
+	handlerv1dto "mandacode.com/accounts/auth/internal/handler/v1/http/dto"
+	"mandacode.com/accounts/auth/internal/usecase/login"
+)
+
+type RestoreHandler struct {
+	restore   *login.RestoreUsecase
+	logger    *zap.Logger
+	validator *validator.Validate
+}
+
+// NewRestoreHandler creates a new RestoreHandler instance
+func NewRestoreHandler(
+	restore *login.RestoreUsecase,
+	logger *zap.Logger,
+	validator *validator.Validate,
+) (*RestoreHandler, error) {
+	if restore == nil {
+		return nil, stdErrors.New("restore cannot be nil")
+	}
+	if logger == nil {
+		return nil, stdErrors.New("logger cannot be nil")
+	}
+	if validator == nil {
+		return nil, stdErrors.New("validator cannot be nil")
+	}
+
+	return &RestoreHandler{
+		restore:   restore,
+		logger:    logger,
+		validator: validator,
+	}, nil
+}
+
+func (h *RestoreHandler) ValidateRequest(req interface{}) error {
+	if req == nil {
+		return errors.New("request cannot be nil", "InvalidRequest", errcode.ErrInvalidInput)
+	}
+	if err := h.validator.Struct(req); err != nil {
+		joinedErr := errors.Join(err, "validation failed")
+		return errors.Upgrade(joinedErr, "InvalidRequest", errcode.ErrInvalidInput)
+	}
+	return nil
+}
+
+// RegisterRoutes registers the account restore routes
+func (h *RestoreHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST("/confirm", h.Confirm)
+	rg.POST("/cancel-deletion", h.CancelDeletion)
+}
+
+// Confirm handles restoring the account of a user whose sign in was held back because it is pending deletion,
+// and completes the sign in.
+//
+// Like the login endpoints, the refresh token is saved in the cookie session unless response_type=direct.
+func (h *RestoreHandler) Confirm(c *gin.Context) {
+	responseType := c.Query("response_type")
+	if responseType != "" && responseType != "direct" {
+		c.Error(errors.New("invalid response type", "InvalidResponseType", errcode.ErrInvalidInput))
+		return
+	}
+
+	var req handlerv1dto.RestoreConfirmRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.New(err.Error(), "InvalidRequest", errcode.ErrInvalidInput))
+		return
+	}
+	if err := h.ValidateRequest(&req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	accessToken, refreshToken, err := h.restore.Confirm(c.Request.Context(), req.ChallengeID, requestInfo(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	if responseType == "direct" {
+		c.JSON(http.StatusOK, handlerv1dto.TokenResponse{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+		})
+		return
+	}
+
+	session := sessions.Default(c)
+	session.Set("refresh_token", refreshToken)
+	if err := session.Save(); err != nil {
+		c.Error(errors.Upgrade(err, "Failed to save session", errcode.ErrInternalFailure))
+		return
+	}
+	c.JSON(http.StatusOK, handlerv1dto.AccessTokenResponse{
+		AccessToken: accessToken,
+	})
+}
+
+// CancelDeletion handles the link mailed to users whose account is scheduled for deletion.
+func (h *RestoreHandler) CancelDeletion(c *gin.Context) {
+	var req handlerv1dto.CancelDeletionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.New(err.Error(), "InvalidRequest", errcode.ErrInvalidInput))
+		return
+	}
+	if err := h.ValidateRequest(&req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.restore.CancelDeletion(c.Request.Context(), req.Token); err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Account deletion cancelled",
+	})
+}
+
+// respondDeletionPending answers a sign in held back because the account is pending deletion, reporting
+// whether err held it back.
+func respondDeletionPending(c *gin.Context, err error) bool {
+	var pendingErr *login.DeletionPendingError
+	if !stdErrors.As(err, &pendingErr) {
+		return false
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusForbidden, handlerv1dto.DeletionPendingResponse{
+		Error:       "deletion_pending",
+		ChallengeID: pendingErr.ChallengeID,
+		ExpiresIn:   pendingErr.ExpiresIn,
+	})
+	return true
+}
@@ -0,0 +1,111 @@
+package httphandlerv1
+
+import (
+	stdErrors "errors"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+
+	httpmiddleware "mandacode.com/accounts/auth/internal/middleware/http"
+	"mandacode.com/accounts/auth/internal/usecase/usersession"
+)
+
+// RefreshTokenHeader is the header clients using direct responses send their refresh token in to identify their session
+const RefreshTokenHeader = "X-Refresh-Token"
+
+type SessionHandler struct {
+	session *usersession.SessionUsecase
+	logger  *zap.Logger
+}
+
+// NewSessionHandler creates a new SessionHandler instance
+func NewSessionHandler(
+	session *usersession.SessionUsecase,
+	logger *zap.Logger,
+) (*SessionHandler, error) {
+	if session == nil {
+		return nil, stdErrors.New("session cannot be nil")
+	}
+	if logger == nil {
+		return nil, stdErrors.New("logger cannot be nil")
+	}
+
+	return &SessionHandler{
+		session: session,
+		logger:  logger,
+	}, nil
+}
+
+// RegisterRoutes registers the routes for managing the caller's sessions. An impersonating admin must not sign
+// the user out, so noImpersonate guards the routes revoking sessions.
+func (h *SessionHandler) RegisterRoutes(rg *gin.RouterGroup, noImpersonate gin.HandlerFunc) {
+	rg.GET("", h.ListSessions)
+	rg.DELETE("/:sessionID", noImpersonate, h.RevokeSession)
+	rg.POST("/revoke-others", noImpersonate, h.RevokeOtherSessions)
+}
+
+// ListSessions handles listing the caller's active sessions
+func (h *SessionHandler) ListSessions(c *gin.Context) {
+	userID, err := httpmiddleware.UserIDFromContext(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	output, err := h.session.ListSessions(c.Request.Context(), userID, currentRefreshToken(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"sessions": output})
+}
+
+// RevokeSession handles revoking one of the caller's sessions
+func (h *SessionHandler) RevokeSession(c *gin.Context) {
+	userID, err := httpmiddleware.UserIDFromContext(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	sessionID, err := uuid.Parse(c.Param("sessionID"))
+	if err != nil {
+		c.Error(errors.New("invalid session ID format", "InvalidSessionID", errcode.ErrInvalidInput))
+		return
+	}
+
+	if err := h.session.RevokeSession(c.Request.Context(), userID, sessionID); err != nil {
+		c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeOtherSessions handles revoking every session of the caller except the current one
+func (h *SessionHandler) RevokeOtherSessions(c *gin.Context) {
+	userID, err := httpmiddleware.UserIDFromContext(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	output, err := h.session.RevokeOtherSessions(c.Request.Context(), userID, currentRefreshToken(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+// currentRefreshToken returns the refresh token of the calling session, read from the cookie session
+// or, for clients using direct responses, from the refresh token header
+func currentRefreshToken(c *gin.Context) string {
+	if refreshToken, ok := sessions.Default(c).Get("refresh_token").(string); ok && refreshToken != "" {
+		return refreshToken
+	}
+	return c.GetHeader(RefreshTokenHeader)
+}
@@ -0,0 +1,125 @@
+package httphandlerv1
+
+import (
+	stdErrors "errors"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+
+	handlerv1dto "mandacode.com/accounts/auth/internal/handler/v1/http/dto"
+	"mandacode.com/accounts/auth/internal/usecase/login"
+)
+
+type StepUpHandler struct {
+	stepUp    *login.StepUpUsecase
+	logger    *zap.Logger
+	validator *validator.Validate
+}
+
+// NewStepUpHandler creates a new StepUpHandler instance
+func NewStepUpHandler(
+	stepUp *login.StepUpUsecase,
+	logger *zap.Logger,
+	validator *validator.Validate,
+) (*StepUpHandler, error) {
+	if stepUp == nil {
+		return nil, stdErrors.New("stepUp cannot be nil")
+	}
+	if logger == nil {
+		return nil, stdErrors.New("logger cannot be nil")
+	}
+	if validator == nil {
+		return nil, stdErrors.New("validator cannot be nil")
+	}
+
+	return &StepUpHandler{
+		stepUp:    stepUp,
+		logger:    logger,
+		validator: validator,
+	}, nil
+}
+
+func (h *StepUpHandler) ValidateRequest(req interface{}) error {
+	if req == nil {
+		return errors.New("request cannot be nil", "InvalidRequest", errcode.ErrInvalidInput)
+	}
+	if err := h.validator.Struct(req); err != nil {
+		joinedErr := errors.Join(err, "validation failed")
+		return errors.Upgrade(joinedErr, "InvalidRequest", errcode.ErrInvalidInput)
+	}
+	return nil
+}
+
+// RegisterRoutes registers the step-up authentication routes
+func (h *StepUpHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST("/verify", h.Verify)
+}
+
+// Verify handles completing a sign in held back by a step-up challenge.
+//
+// Like the login endpoints, the refresh token is saved in the cookie session unless response_type=direct.
+func (h *StepUpHandler) Verify(c *gin.Context) {
+	responseType := c.Query("response_type")
+	if responseType != "" && responseType != "direct" {
+		c.Error(errors.New("invalid response type", "InvalidResponseType", errcode.ErrInvalidInput))
+		return
+	}
+
+	var req handlerv1dto.StepUpVerifyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.New(err.Error(), "InvalidRequest", errcode.ErrInvalidInput))
+		return
+	}
+	if err := h.ValidateRequest(&req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	accessToken, refreshToken, err := h.stepUp.Verify(c.Request.Context(), req.ChallengeID, req.Code, requestInfo(c))
+	if respondDeletionPending(c, err) {
+		return
+	}
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	if responseType == "direct" {
+		c.JSON(http.StatusOK, handlerv1dto.TokenResponse{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+		})
+		return
+	}
+
+	session := sessions.Default(c)
+	session.Set("refresh_token", refreshToken)
+	if err := session.Save(); err != nil {
+		c.Error(errors.Upgrade(err, "Failed to save session", errcode.ErrInternalFailure))
+		return
+	}
+	c.JSON(http.StatusOK, handlerv1dto.AccessTokenResponse{
+		AccessToken: accessToken,
+	})
+}
+
+// respondStepUpRequired answers a sign in which must be confirmed with a step-up, reporting whether err asked for one.
+func respondStepUpRequired(c *gin.Context, err error) bool {
+	var stepUpErr *login.StepUpRequiredError
+	if !stdErrors.As(err, &stepUpErr) {
+		return false
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusUnauthorized, handlerv1dto.StepUpRequiredResponse{
+		Error:       "step_up_required",
+		ChallengeID: stepUpErr.ChallengeID,
+		ExpiresIn:   stepUpErr.ExpiresIn,
+	})
+	return true
+}
@@ -0,0 +1,217 @@
+package httphandlerv1
+
+import (
+	stdErrors "errors"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+
+	handlerv1dto "mandacode.com/accounts/auth/internal/handler/v1/http/dto"
+	"mandacode.com/accounts/auth/internal/usecase/token"
+	"mandacode.com/accounts/auth/internal/usecase/usersession"
+)
+
+type TokenHandler struct {
+	refresh        *token.RefreshUsecase
+	session        *usersession.SessionUsecase
+	trustedOrigins []string
+	logger         *zap.Logger
+	validator      *validator.Validate
+}
+
+// NewTokenHandler creates a new TokenHandler instance
+//
+// trustedOrigins are the origins allowed to use the cookie session, checked against the Origin
+// or Referer header to protect the cookie session from cross-site request forgery.
+func NewTokenHandler(
+	refresh *token.RefreshUsecase,
+	session *usersession.SessionUsecase,
+	trustedOrigins []string,
+	logger *zap.Logger,
+	validator *validator.Validate,
+) (*TokenHandler, error) {
+	if refresh == nil {
+		return nil, stdErrors.New("refresh cannot be nil")
+	}
+	if session == nil {
+		return nil, stdErrors.New("session cannot be nil")
+	}
+	if logger == nil {
+		return nil, stdErrors.New("logger cannot be nil")
+	}
+	if validator == nil {
+		return nil, stdErrors.New("validator cannot be nil")
+	}
+
+	origins := make([]string, 0, len(trustedOrigins))
+	for _, trusted := range trustedOrigins {
+		origin, ok := originOf(trusted)
+		if !ok {
+			return nil, stdErrors.New("invalid trusted origin: " + trusted)
+		}
+		origins = append(origins, origin)
+	}
+
+	return &TokenHandler{
+		refresh:        refresh,
+		session:        session,
+		trustedOrigins: origins,
+		logger:         logger,
+		validator:      validator,
+	}, nil
+}
+
+func (h *TokenHandler) ValidateRequest(req interface{}) error {
+	if req == nil {
+		return errors.New("request cannot be nil", "InvalidRequest", errcode.ErrInvalidInput)
+	}
+	if err := h.validator.Struct(req); err != nil {
+		joinedErr := errors.Join(err, "validation failed")
+		return errors.Upgrade(joinedErr, "InvalidRequest", errcode.ErrInvalidInput)
+	}
+	return nil
+}
+
+// RegisterRoutes registers the routes managing the lifetime of issued tokens
+func (h *TokenHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST("/refresh", h.Refresh)
+	rg.POST("/logout", h.Logout)
+}
+
+// Refresh handles rotating the refresh token and issuing a new access token.
+//
+// By default the refresh token is read from and written back to the cookie session. With
+// response_type=direct it is read from the body and both tokens are returned in the response.
+func (h *TokenHandler) Refresh(c *gin.Context) {
+	responseType := c.Query("response_type")
+	if responseType != "" && responseType != "direct" {
+		c.Error(errors.New("invalid response type", "InvalidResponseType", errcode.ErrInvalidInput))
+		return
+	}
+
+	if responseType == "direct" {
+		var req handlerv1dto.RefreshRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(errors.New(err.Error(), "InvalidRequest", errcode.ErrInvalidInput))
+			return
+		}
+		if err := h.ValidateRequest(&req); err != nil {
+			c.Error(err)
+			return
+		}
+
+		accessToken, refreshToken, err := h.refresh.Refresh(c.Request.Context(), req.RefreshToken, requestInfo(c))
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		c.Header("Cache-Control", "no-store")
+		c.JSON(http.StatusOK, handlerv1dto.TokenResponse{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+		})
+		return
+	}
+
+	if err := h.checkOrigin(c); err != nil {
+		c.Error(err)
+		return
+	}
+	store := sessions.Default(c)
+	refreshToken, _ := store.Get("refresh_token").(string)
+	if refreshToken == "" {
+		c.Error(errors.New("no refresh token in session", "Unauthorized", errcode.ErrUnauthorized))
+		return
+	}
+
+	accessToken, newRefreshToken, err := h.refresh.Refresh(c.Request.Context(), refreshToken, requestInfo(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	store.Set("refresh_token", newRefreshToken)
+	if err := store.Save(); err != nil {
+		c.Error(errors.Upgrade(err, "Failed to save session", errcode.ErrInternalFailure))
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, handlerv1dto.AccessTokenResponse{
+		AccessToken: accessToken,
+	})
+}
+
+// Logout handles ending the caller's session, or every session of the caller.
+//
+// The refresh token is read from the cookie session, which requires a trusted origin, or, for clients
+// using direct responses, from the body.
+func (h *TokenHandler) Logout(c *gin.Context) {
+	var req handlerv1dto.LogoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !stdErrors.Is(err, io.EOF) {
+		c.Error(errors.New(err.Error(), "InvalidRequest", errcode.ErrInvalidInput))
+		return
+	}
+	if err := h.ValidateRequest(&req); err != nil {
+		c.Error(err)
+		return
+	}
+	everywhere := req.Everywhere || c.Query("everywhere") == "true"
+
+	store := sessions.Default(c)
+	refreshToken, _ := store.Get("refresh_token").(string)
+	if refreshToken != "" {
+		if err := h.checkOrigin(c); err != nil {
+			c.Error(err)
+			return
+		}
+	} else {
+		refreshToken = req.RefreshToken
+	}
+
+	if err := h.session.Logout(c.Request.Context(), refreshToken, everywhere); err != nil {
+		c.Error(err)
+		return
+	}
+
+	// Destroy the cookie session, which also removes it from the store
+	store.Clear()
+	store.Options(sessions.Options{Path: "/", MaxAge: -1})
+	if err := store.Save(); err != nil {
+		c.Error(errors.Upgrade(err, "Failed to clear session", errcode.ErrInternalFailure))
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// checkOrigin rejects requests using the cookie session which do not come from a trusted origin.
+//
+// Browsers send the Origin header with cross-origin and POST requests; the Referer header is used
+// when it is missing. Requests carrying neither are rejected, as their origin cannot be established.
+func (h *TokenHandler) checkOrigin(c *gin.Context) error {
+	source := c.GetHeader("Origin")
+	if source == "" || source == "null" {
+		source = c.GetHeader("Referer")
+	}
+	origin, ok := originOf(source)
+	if ok && slices.Contains(h.trustedOrigins, origin) {
+		return nil
+	}
+	return errors.New("request origin is not trusted: "+source, "Untrusted Origin", errcode.ErrForbidden)
+}
+
+// originOf returns the scheme and host of a URL in lower case, the form used by the Origin header
+func originOf(rawURL string) (string, bool) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return "", false
+	}
+	return strings.ToLower(parsed.Scheme + "://" + parsed.Host), true
+}
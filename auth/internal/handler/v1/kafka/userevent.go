@@ -28,6 +28,7 @@ func (u *UserEventHandler) HandleMessage(ctx context.Context, m kafka.Message) e
 	if err != nil {
 		return errors.Upgrade(err, "Invalid User ID in User Event", errcode.ErrInvalidInput)
 	}
+	version := syncVersion(event)
 
 	switch event.EventType {
 	case usereventv1.EventType_USER_DELETED:
@@ -35,12 +36,34 @@ func (u *UserEventHandler) HandleMessage(ctx context.Context, m kafka.Message) e
 			return errors.Upgrade(err, "Failed to handle user deleted event", errcode.ErrInternalFailure)
 		}
 	case usereventv1.EventType_USER_ARCHIVED:
-		return nil
+		if err := u.userEvent.HandleUserArchived(ctx, userUUID, version); err != nil {
+			return errors.Upgrade(err, "Failed to handle user archived event", errcode.ErrInternalFailure)
+		}
 	case usereventv1.EventType_USER_RESTORED:
-		return nil
+		if err := u.userEvent.HandleUserRestored(ctx, userUUID, version); err != nil {
+			return errors.Upgrade(err, "Failed to handle user restored event", errcode.ErrInternalFailure)
+		}
 	case usereventv1.EventType_USER_BLOCKED:
-		return nil
+		if err := u.userEvent.HandleUserBlocked(ctx, userUUID, version); err != nil {
+			return errors.Upgrade(err, "Failed to handle user blocked event", errcode.ErrInternalFailure)
+		}
 	case usereventv1.EventType_USER_UNBLOCKED:
+		if err := u.userEvent.HandleUserUnblocked(ctx, userUUID, version); err != nil {
+			return errors.Upgrade(err, "Failed to handle user unblocked event", errcode.ErrInternalFailure)
+		}
+	case usereventv1.EventType_ROLES_CHANGED:
+		if err := u.userEvent.HandleRolesChanged(ctx, userUUID, event.GetRolesChanged().GetRoles(), version); err != nil {
+			return errors.Upgrade(err, "Failed to handle roles changed event", errcode.ErrInternalFailure)
+		}
+	case usereventv1.EventType_USER_ACTIVE_CHANGED:
+		if err := u.userEvent.HandleUserActiveChanged(ctx, userUUID, event.GetActiveChanged().GetIsActive(), version); err != nil {
+			return errors.Upgrade(err, "Failed to handle user active changed event", errcode.ErrInternalFailure)
+		}
+	case usereventv1.EventType_USER_EMAIL_CHANGED:
+		// The auth service changed the address itself, at the request of the user service
+		return nil
+	case usereventv1.EventType_USER_MINOR_STATUS_CHANGED:
+		// Minors are held back by their active status, which is announced along with their minor status
 		return nil
 	default:
 		return errors.New("unsupported user event type", "User Event Handler Error", errcode.ErrInvalidInput)
@@ -49,6 +72,15 @@ func (u *UserEventHandler) HandleMessage(ctx context.Context, m kafka.Message) e
 	return nil
 }
 
+// syncVersion returns the version of the user carried by the event, if any. Events published before the user
+// service versioned the users carry none.
+func syncVersion(event *usereventv1.UserEvent) *int64 {
+	if event.SyncVersion == 0 {
+		return nil
+	}
+	return &event.SyncVersion
+}
+
 func NewUserEventHandler(userEvent *userevent.UserEventUsecase) kafkaserver.KafkaHandler {
 	return &UserEventHandler{
 		userEvent: userEvent,
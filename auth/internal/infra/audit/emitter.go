@@ -0,0 +1,70 @@
+package auditinfra
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"github.com/segmentio/kafka-go"
+	auditmodels "mandacode.com/accounts/auth/internal/models/audit"
+)
+
+// Emitter publishes the audit events of the auth service to the audit topic.
+type Emitter struct {
+	writer *kafka.Writer
+}
+
+// Emit publishes the audit event of an action applied to a target, with the fields which it changed.
+//
+// Parameters:
+//   - ctx: The context carrying the actor and the request.
+//   - action: The audited action, such as oauth_client.disable.
+//   - targetType: The type of the target.
+//   - targetID: The identifier of the target.
+//   - before: The target before the action, or nil.
+//   - after: The target after the action, or nil.
+func (e *Emitter) Emit(ctx context.Context, action string, targetType string, targetID string, before any, after any) error {
+	changedBefore, changedAfter, err := auditmodels.Diff(before, after)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to diff audited target", errcode.ErrInternalFailure)
+	}
+
+	actor := auditmodels.ActorFromContext(ctx)
+	request := auditmodels.RequestInfoFromContext(ctx)
+	data, err := json.Marshal(auditmodels.Event{
+		ID:         uuid.New(),
+		Service:    auditmodels.ServiceAuth,
+		ActorType:  actor.Type,
+		ActorID:    actor.ID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Before:     changedBefore,
+		After:      changedAfter,
+		RequestID:  request.RequestID,
+		IP:         request.IP,
+		OccurredAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return errors.New(err.Error(), "Failed to marshal audit event", errcode.ErrInternalFailure)
+	}
+
+	message := kafka.Message{
+		Key:   []byte(targetID),
+		Value: data,
+	}
+	if err := e.writer.WriteMessages(ctx, message); err != nil {
+		return errors.New(err.Error(), "Failed to publish audit event", errcode.ErrDependencyFailure)
+	}
+	return nil
+}
+
+// NewEmitter creates a new Emitter publishing with the writer of the audit topic.
+func NewEmitter(writer *kafka.Writer) *Emitter {
+	return &Emitter{
+		writer: writer,
+	}
+}
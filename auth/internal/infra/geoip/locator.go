@@ -0,0 +1,67 @@
+package geoipinfra
+
+import (
+	"net"
+
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"github.com/oschwald/geoip2-golang"
+)
+
+// Location is the coarse location of an IP address.
+type Location struct {
+	Country   string  // ISO 3166-1 alpha-2 country code
+	City      string  // English city name
+	Latitude  float64 // Approximate latitude, zero if unknown
+	Longitude float64 // Approximate longitude, zero if unknown
+	HasCoords bool    // Whether the database provided coordinates
+}
+
+// Locator resolves IP addresses to coarse locations using a local MaxMind-format database,
+// such as GeoLite2-City or GeoIP2-Country.
+type Locator struct {
+	reader *geoip2.Reader
+}
+
+// NewLocator opens the database at path. An empty path yields a Locator which resolves nothing.
+func NewLocator(path string) (*Locator, error) {
+	if path == "" {
+		return &Locator{}, nil
+	}
+	reader, err := geoip2.Open(path)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to open GeoIP database", errcode.ErrInternalFailure)
+	}
+	return &Locator{reader: reader}, nil
+}
+
+// Locate resolves the location of an IP address, returning nil if it is unknown.
+func (l *Locator) Locate(ip string) *Location {
+	if l.reader == nil {
+		return nil
+	}
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return nil
+	}
+	// The City lookup also works on country databases, leaving the city empty
+	record, err := l.reader.City(parsed)
+	if err != nil || record.Country.IsoCode == "" {
+		return nil
+	}
+	return &Location{
+		Country:   record.Country.IsoCode,
+		City:      record.City.Names["en"],
+		Latitude:  record.Location.Latitude,
+		Longitude: record.Location.Longitude,
+		HasCoords: record.Location.AccuracyRadius > 0,
+	}
+}
+
+// Close releases the database.
+func (l *Locator) Close() error {
+	if l.reader == nil {
+		return nil
+	}
+	return l.reader.Close()
+}
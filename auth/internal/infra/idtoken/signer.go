@@ -0,0 +1,126 @@
+package idtokeninfra
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"math/big"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+)
+
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// IDTokenSigner signs OpenID Connect ID tokens with the provider's RSA key.
+type IDTokenSigner struct {
+	privateKey *rsa.PrivateKey
+	keyID      string
+	issuer     string
+	expiresIn  time.Duration
+}
+
+// NewIDTokenSignerByStr creates a new IDTokenSigner using an RSA private key provided as a PEM formatted string
+//
+// Parameters:
+//   - privateKeyStr: the PEM formatted RSA private key string used for signing ID tokens
+//   - issuer: the issuer identifier placed in the iss claim
+//   - expiresIn: the duration after which ID tokens expire
+//
+// Returns:
+//   - *IDTokenSigner: an instance of IDTokenSigner
+//   - error: an error if the private key string is invalid or expiresIn is not greater than zero
+func NewIDTokenSignerByStr(privateKeyStr string, issuer string, expiresIn time.Duration) (*IDTokenSigner, error) {
+	if privateKeyStr == "" {
+		return nil, errors.New("private key string cannot be empty", "Invalid Private Key", errcode.ErrInvalidFormat)
+	}
+	privateKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(privateKeyStr))
+	if err != nil {
+		return nil, errors.New("failed to parse private key: "+err.Error(), "Invalid Private Key", errcode.ErrInvalidFormat)
+	}
+	if expiresIn <= 0 {
+		return nil, errors.New("expiresIn must be greater than zero", "Invalid Expiration Duration", errcode.ErrInvalidFormat)
+	}
+
+	// Derive a stable key ID from the public key so that rotating the key changes the kid
+	der, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Invalid Private Key", errcode.ErrInvalidFormat)
+	}
+	sum := sha256.Sum256(der)
+
+	return &IDTokenSigner{
+		privateKey: privateKey,
+		keyID:      base64.RawURLEncoding.EncodeToString(sum[:16]),
+		issuer:     issuer,
+		expiresIn:  expiresIn,
+	}, nil
+}
+
+// Issuer returns the issuer identifier placed in the iss claim.
+func (s *IDTokenSigner) Issuer() string {
+	return s.issuer
+}
+
+// Sign signs an ID token with the given claims, adding the iss, iat and exp claims.
+//
+// Parameters:
+//   - claims: the claims to include in the token, such as sub, aud and nonce
+//
+// Returns:
+//   - string: the signed ID token
+//   - int64: the expiration time of the token in seconds since epoch
+//   - error: an error if signing fails
+func (s *IDTokenSigner) Sign(claims map[string]any) (string, int64, error) {
+	now := time.Now()
+	expiresAt := now.Add(s.expiresIn)
+
+	tokenClaims := jwt.MapClaims{
+		"iss": s.issuer,
+		"iat": now.Unix(),
+		"exp": expiresAt.Unix(),
+	}
+	for key, value := range claims {
+		tokenClaims[key] = value
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, tokenClaims)
+	token.Header["kid"] = s.keyID
+	signedToken, err := token.SignedString(s.privateKey)
+	if err != nil {
+		return "", 0, errors.New(err.Error(), "Failed to sign ID token", errcode.ErrInternalFailure)
+	}
+
+	return signedToken, expiresAt.Unix(), nil
+}
+
+// JWKS returns the public signing key as a JSON Web Key Set.
+func (s *IDTokenSigner) JWKS() JSONWebKeySet {
+	publicKey := s.privateKey.PublicKey
+	return JSONWebKeySet{
+		Keys: []JSONWebKey{
+			{
+				Kty: "RSA",
+				Use: "sig",
+				Alg: jwt.SigningMethodRS256.Alg(),
+				Kid: s.keyID,
+				N:   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+			},
+		},
+	}
+}
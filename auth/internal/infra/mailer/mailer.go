@@ -2,6 +2,7 @@ package mailer
 
 import (
 	"context"
+	"time"
 
 	mailerv1 "github.com/mandacode-com/accounts-proto/go/mailer/v1"
 	"github.com/mandacode-com/golib/errors"
@@ -11,6 +12,62 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// MailTypeHeader is the Kafka header selecting the mail template. Messages without it are email verification mails.
+const MailTypeHeader = "mail_type"
+
+// MailTypeNewLoginAlert selects the mail warning a user about a sign in from a new device or country, whose
+// payload is a mailerv1.NewLoginAlertEvent.
+const MailTypeNewLoginAlert = "new_login_alert"
+
+// NewLoginAlert describes a sign in the user is warned about.
+type NewLoginAlert struct {
+	Email        string
+	LoginTime    time.Time
+	IPAddress    string
+	Location     string
+	UserAgent    string
+	NewDevice    bool
+	NewCountry   bool
+	SecurityLink string // The page where the user can review and revoke sessions
+}
+
+// MailTypeStepUpCode selects the mail carrying the code confirming a sign in which requires a step-up, whose
+// payload is a mailerv1.StepUpCodeEvent.
+const MailTypeStepUpCode = "step_up_code"
+
+// StepUpCode carries the code confirming a sign in.
+type StepUpCode struct {
+	Email     string
+	Code      string
+	IPAddress string
+	UserAgent string
+	ExpiresAt time.Time
+}
+
+// MailTypeAccountDeletionScheduled selects the mail telling a user their account will be deleted, with a link
+// cancelling the deletion, whose payload is a mailerv1.AccountDeletionScheduledEvent.
+const MailTypeAccountDeletionScheduled = "account_deletion_scheduled"
+
+// AccountDeletionScheduled describes a scheduled account deletion.
+type AccountDeletionScheduled struct {
+	Email       string
+	CancelLink  string
+	DeleteAfter time.Time
+}
+
+// MailTypeImpersonationStarted selects the mail telling a user the support staff started acting as them, whose
+// payload is a mailerv1.ImpersonationStartedEvent.
+const MailTypeImpersonationStarted = "impersonation_started"
+
+// ImpersonationStarted describes an impersonation of the user by the support staff. It does not identify the
+// admin, who is recorded in the audit trail.
+type ImpersonationStarted struct {
+	Email     string
+	Reason    string
+	StartedAt time.Time
+	ExpiresAt time.Time
+}
+
 type Mailer struct {
 	writer *kafka.Writer
 }
@@ -41,6 +98,114 @@ func (m *Mailer) SendEmailVerificationMail(email string, verificationLink string
 	return m.writer.WriteMessages(context.Background(), message)
 }
 
+// SendNewLoginAlertMail sends a mail warning the user about a sign in from a new device or country.
+//
+// Parameters:
+//   - alert: The details of the sign in.
+func (m *Mailer) SendNewLoginAlertMail(alert NewLoginAlert) error {
+	event := &mailerv1.NewLoginAlertEvent{
+		Email:        alert.Email,
+		LoginTime:    timestamppb.New(alert.LoginTime),
+		IpAddress:    alert.IPAddress,
+		Location:     alert.Location,
+		UserAgent:    alert.UserAgent,
+		NewDevice:    alert.NewDevice,
+		NewCountry:   alert.NewCountry,
+		SecurityLink: alert.SecurityLink,
+		EventTime:    timestamppb.Now(),
+	}
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to marshal new login alert", errcode.ErrInternalFailure)
+	}
+
+	message := kafka.Message{
+		Key:     []byte(alert.Email),
+		Value:   data,
+		Headers: []kafka.Header{{Key: MailTypeHeader, Value: []byte(MailTypeNewLoginAlert)}},
+	}
+
+	return m.writer.WriteMessages(context.Background(), message)
+}
+
+// SendStepUpCodeMail sends the code confirming a sign in which requires a step-up.
+//
+// Parameters:
+//   - stepUp: The code and the sign in it confirms.
+func (m *Mailer) SendStepUpCodeMail(stepUp StepUpCode) error {
+	event := &mailerv1.StepUpCodeEvent{
+		Email:     stepUp.Email,
+		Code:      stepUp.Code,
+		IpAddress: stepUp.IPAddress,
+		UserAgent: stepUp.UserAgent,
+		ExpiresAt: timestamppb.New(stepUp.ExpiresAt),
+		EventTime: timestamppb.Now(),
+	}
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to marshal step-up code", errcode.ErrInternalFailure)
+	}
+
+	message := kafka.Message{
+		Key:     []byte(stepUp.Email),
+		Value:   data,
+		Headers: []kafka.Header{{Key: MailTypeHeader, Value: []byte(MailTypeStepUpCode)}},
+	}
+
+	return m.writer.WriteMessages(context.Background(), message)
+}
+
+// SendAccountDeletionScheduledMail sends the mail telling a user their account will be deleted.
+//
+// Parameters:
+//   - deletion: The scheduled deletion and the link cancelling it.
+func (m *Mailer) SendAccountDeletionScheduledMail(deletion AccountDeletionScheduled) error {
+	event := &mailerv1.AccountDeletionScheduledEvent{
+		Email:       deletion.Email,
+		CancelLink:  deletion.CancelLink,
+		DeleteAfter: timestamppb.New(deletion.DeleteAfter),
+		EventTime:   timestamppb.Now(),
+	}
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to marshal account deletion notice", errcode.ErrInternalFailure)
+	}
+
+	message := kafka.Message{
+		Key:     []byte(deletion.Email),
+		Value:   data,
+		Headers: []kafka.Header{{Key: MailTypeHeader, Value: []byte(MailTypeAccountDeletionScheduled)}},
+	}
+
+	return m.writer.WriteMessages(context.Background(), message)
+}
+
+// SendImpersonationStartedMail sends the mail telling a user the support staff started acting as them.
+//
+// Parameters:
+//   - notice: The impersonation the user is told about.
+func (m *Mailer) SendImpersonationStartedMail(notice ImpersonationStarted) error {
+	event := &mailerv1.ImpersonationStartedEvent{
+		Email:     notice.Email,
+		Reason:    notice.Reason,
+		StartedAt: timestamppb.New(notice.StartedAt),
+		ExpiresAt: timestamppb.New(notice.ExpiresAt),
+		EventTime: timestamppb.Now(),
+	}
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to marshal impersonation notice", errcode.ErrInternalFailure)
+	}
+
+	message := kafka.Message{
+		Key:     []byte(notice.Email),
+		Value:   data,
+		Headers: []kafka.Header{{Key: MailTypeHeader, Value: []byte(MailTypeImpersonationStarted)}},
+	}
+
+	return m.writer.WriteMessages(context.Background(), message)
+}
+
 // NewMailer creates a new Mailer instance with the provided Kafka writer.
 func NewMailer(writer *kafka.Writer) *Mailer {
 	return &Mailer{
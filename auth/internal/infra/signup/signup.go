@@ -42,12 +42,24 @@ func NewSignupApi(endpoint string, client *http.Client, validator *validator.Val
 	}, nil
 }
 
+// OAuthSignup signs up a new user with the access token of the provider.
+//
+// Providers do not share the birth date, so it is given by the user, along with the email address of their
+// guardian if they are under 14.
 func (s *SignupAPI) OAuthSignup(
 	provider authaccount.Provider,
 	accessToken string,
+	birthDate string,
+	guardianEmail *string,
 ) (*signupinfradto.OAuthSignupResponse, error) {
-	endpoint := s.endpoint
+	endpoint := *s.endpoint
 	endpoint.Path = path.Join(endpoint.Path, provider.String())
+	query := endpoint.Query()
+	query.Set("birth_date", birthDate)
+	if guardianEmail != nil {
+		query.Set("guardian_email", *guardianEmail)
+	}
+	endpoint.RawQuery = query.Encode()
 	req, err := http.NewRequest("GET", endpoint.String(), nil)
 	if err != nil {
 		return nil, err
@@ -61,6 +73,10 @@ func (s *SignupAPI) OAuthSignup(
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusBadRequest {
+		// The birth date is invalid, or the guardian of a user under 14 is missing
+		return nil, errors.New("OAuth signup rejected the age of the user", "Invalid Birth Date or Guardian Email", errcode.ErrInvalidInput)
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, errors.New("failed to sign up with OAuth provider", "OAuth Signup Error", errcode.ErrInternalFailure)
 	}
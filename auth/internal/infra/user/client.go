@@ -0,0 +1,37 @@
+package userinfra
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	userv1 "github.com/mandacode-com/accounts-proto/go/user/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// NewOrganizationClient connects to the organization service of the user service.
+func NewOrganizationClient(addr string) (userv1.OrganizationServiceClient, *grpc.ClientConn, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, err
+	}
+	if conn == nil {
+		return nil, nil, errors.New("gRPC client connection is nil")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	healthClient := grpc_health_v1.NewHealthClient(conn)
+	healthResp, err := healthClient.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: ""})
+	if err != nil {
+		return nil, nil, err
+	}
+	if healthResp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		return nil, nil, errors.New("user service is not serving")
+	}
+
+	return userv1.NewOrganizationServiceClient(conn), conn, nil
+}
@@ -0,0 +1,25 @@
+package httpmiddleware
+
+import (
+	"crypto/subtle"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	auditmodels "mandacode.com/accounts/auth/internal/models/audit"
+)
+
+// AdminKeyAuth only lets requests through which present the shared admin API key in the given header. The admin
+// API key is recorded as the actor of the request.
+func AdminKeyAuth(headerKey string, apiKey string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		provided := ctx.GetHeader(headerKey)
+		if provided == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(apiKey)) != 1 {
+			ctx.Error(errors.New("invalid admin API key", "Forbidden", errcode.ErrForbidden))
+			ctx.Abort()
+			return
+		}
+		setActor(ctx, auditmodels.Actor{Type: auditmodels.ActorTypeAPIKey})
+		ctx.Next()
+	}
+}
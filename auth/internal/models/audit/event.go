@@ -0,0 +1,100 @@
+package auditmodels
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ServiceAuth identifies the auth service in audit events.
+const ServiceAuth = "auth"
+
+// Types of the actors applying audited actions.
+const (
+	ActorTypeUser   = "user"
+	ActorTypeAPIKey = "api_key" // The admin API key
+	ActorTypeSystem = "system"
+)
+
+// Types of the targets of the actions audited by the auth service.
+const (
+	TargetTypeUser        = "user"
+	TargetTypeSession     = "session"
+	TargetTypeOAuthClient = "oauth_client"
+)
+
+// Actions audited by the auth service.
+const (
+	ActionOAuthClientCreate       = "oauth_client.create"
+	ActionOAuthClientRotateSecret = "oauth_client.rotate_secret"
+	ActionOAuthClientDisable      = "oauth_client.disable"
+	ActionOAuthClientEnable       = "oauth_client.enable"
+	ActionSessionRevoke           = "session.revoke"
+	ActionUserSessionsRevoke      = "user.sessions_revoke"
+	ActionUserImpersonate         = "user.impersonate"
+)
+
+// Event is an audit event, in the JSON format of the audit topic which the user service records and the SIEM
+// consumes.
+type Event struct {
+	ID         uuid.UUID      `json:"id"`
+	Service    string         `json:"service"`
+	ActorType  string         `json:"actor_type"`
+	ActorID    string         `json:"actor_id"`
+	Action     string         `json:"action"`
+	TargetType string         `json:"target_type"`
+	TargetID   string         `json:"target_id"`
+	Before     map[string]any `json:"before,omitempty"`
+	After      map[string]any `json:"after,omitempty"`
+	RequestID  string         `json:"request_id"`
+	IP         string         `json:"ip"`
+	OccurredAt time.Time      `json:"occurred_at"`
+}
+
+// Diff returns the fields of the JSON encodings of before and after which differ, as they were before and after.
+// Either of them can be nil.
+func Diff(before any, after any) (map[string]any, map[string]any, error) {
+	beforeFields, err := fields(before)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterFields, err := fields(after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changedBefore := make(map[string]any)
+	changedAfter := make(map[string]any)
+	for key, value := range beforeFields {
+		if afterValue, ok := afterFields[key]; !ok || !reflect.DeepEqual(value, afterValue) {
+			changedBefore[key] = value
+		}
+	}
+	for key, value := range afterFields {
+		if beforeValue, ok := beforeFields[key]; !ok || !reflect.DeepEqual(value, beforeValue) {
+			changedAfter[key] = value
+		}
+	}
+	if len(changedBefore) == 0 {
+		changedBefore = nil
+	}
+	if len(changedAfter) == 0 {
+		changedAfter = nil
+	}
+	return changedBefore, changedAfter, nil
+}
+
+// fields decodes the JSON encoding of v as an object.
+func fields(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
@@ -0,0 +1,24 @@
+package consentmodels
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Document is a current mandatory document which a user has yet to accept.
+type Document struct {
+	ID          uuid.UUID
+	Kind        string
+	Version     int
+	Title       string
+	ContentURL  string
+	Mandatory   bool
+	PublishedAt time.Time
+}
+
+// Choice is the choice of a user on a document.
+type Choice struct {
+	DocumentID uuid.UUID
+	Accepted   bool
+}
@@ -0,0 +1,53 @@
+package dbmodels
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"mandacode.com/accounts/auth/ent"
+)
+
+type CreateAPIKeyInput struct {
+	UserID    uuid.UUID  `json:"user_id" validate:"required"`
+	Name      string     `json:"name" validate:"required,max=100"`
+	Prefix    string     `json:"prefix" validate:"required"`
+	KeyHash   string     `json:"key_hash" validate:"required"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1"`
+	ExpiresAt *time.Time `json:"expires_at" validate:"omitempty"`
+}
+
+type SecureAPIKey struct {
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	Name        string     `json:"name"`
+	Prefix      string     `json:"prefix"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	IsSuspended bool       `json:"is_suspended"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+func NewSecureAPIKey(apiKey *ent.APIKey) *SecureAPIKey {
+	return &SecureAPIKey{
+		ID:          apiKey.ID,
+		UserID:      apiKey.UserID,
+		Name:        apiKey.Name,
+		Prefix:      apiKey.Prefix,
+		Scopes:      apiKey.Scopes,
+		ExpiresAt:   apiKey.ExpiresAt,
+		LastUsedAt:  apiKey.LastUsedAt,
+		IsSuspended: apiKey.IsSuspended,
+		RevokedAt:   apiKey.RevokedAt,
+		CreatedAt:   apiKey.CreatedAt,
+	}
+}
+
+// IsUsable reports whether the key can currently be used to authenticate.
+func (k *SecureAPIKey) IsUsable(now time.Time) bool {
+	if k.RevokedAt != nil || k.IsSuspended {
+		return false
+	}
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
@@ -0,0 +1,58 @@
+package dbmodels
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"mandacode.com/accounts/auth/ent"
+	"mandacode.com/accounts/auth/ent/loginattempt"
+)
+
+type CreateLoginAttemptInput struct {
+	UserID        *uuid.UUID               `json:"user_id" validate:"omitempty"`
+	LoginMethod   loginattempt.LoginMethod `json:"login_method" validate:"required"`
+	Provider      *string                  `json:"provider" validate:"omitempty"`
+	Success       bool                     `json:"success"`
+	FailureReason *string                  `json:"failure_reason" validate:"omitempty"`
+	IPAddress     string                   `json:"ip_address"`
+	UserAgent     string                   `json:"user_agent"`
+	DeviceID      string                   `json:"device_id"`
+	Country       *string                  `json:"country" validate:"omitempty"`
+	City          *string                  `json:"city" validate:"omitempty"`
+	Latitude      *float64                 `json:"latitude" validate:"omitempty"`
+	Longitude     *float64                 `json:"longitude" validate:"omitempty"`
+}
+
+type SecureLoginAttempt struct {
+	ID            uuid.UUID                `json:"id"`
+	LoginMethod   loginattempt.LoginMethod `json:"login_method"`
+	Provider      *string                  `json:"provider,omitempty"`
+	Success       bool                     `json:"success"`
+	FailureReason *string                  `json:"failure_reason,omitempty"`
+	IPAddress     string                   `json:"ip_address"`
+	UserAgent     string                   `json:"user_agent"`
+	DeviceID      string                   `json:"-"`
+	Country       *string                  `json:"country,omitempty"`
+	City          *string                  `json:"city,omitempty"`
+	Latitude      *float64                 `json:"-"`
+	Longitude     *float64                 `json:"-"`
+	CreatedAt     time.Time                `json:"created_at"`
+}
+
+func NewSecureLoginAttempt(attempt *ent.LoginAttempt) *SecureLoginAttempt {
+	return &SecureLoginAttempt{
+		ID:            attempt.ID,
+		LoginMethod:   attempt.LoginMethod,
+		Provider:      attempt.Provider,
+		Success:       attempt.Success,
+		FailureReason: attempt.FailureReason,
+		IPAddress:     attempt.IPAddress,
+		UserAgent:     attempt.UserAgent,
+		DeviceID:      attempt.DeviceID,
+		Country:       attempt.Country,
+		City:          attempt.City,
+		Latitude:      attempt.Latitude,
+		Longitude:     attempt.Longitude,
+		CreatedAt:     attempt.CreatedAt,
+	}
+}
@@ -0,0 +1,49 @@
+package dbmodels
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"mandacode.com/accounts/auth/ent"
+	"mandacode.com/accounts/auth/ent/session"
+)
+
+type CreateSessionInput struct {
+	UserID           uuid.UUID           `json:"user_id" validate:"required"`
+	RefreshTokenHash string              `json:"refresh_token_hash" validate:"required"`
+	LoginMethod      session.LoginMethod `json:"login_method" validate:"required"`
+	Provider         *string             `json:"provider" validate:"omitempty"`
+	UserAgent        string              `json:"user_agent"`
+	IPAddress        string              `json:"ip_address"`
+}
+
+type SecureSession struct {
+	ID          uuid.UUID           `json:"id"`
+	UserID      uuid.UUID           `json:"user_id"`
+	LoginMethod session.LoginMethod `json:"login_method"`
+	Provider    *string             `json:"provider,omitempty"`
+	UserAgent   string              `json:"user_agent"`
+	IPAddress   string              `json:"ip_address"`
+	CreatedAt   time.Time           `json:"created_at"`
+	LastSeenAt  time.Time           `json:"last_seen_at"`
+	RevokedAt   *time.Time          `json:"revoked_at,omitempty"`
+}
+
+func NewSecureSession(session *ent.Session) *SecureSession {
+	return &SecureSession{
+		ID:          session.ID,
+		UserID:      session.UserID,
+		LoginMethod: session.LoginMethod,
+		Provider:    session.Provider,
+		UserAgent:   session.UserAgent,
+		IPAddress:   session.IPAddress,
+		CreatedAt:   session.CreatedAt,
+		LastSeenAt:  session.LastSeenAt,
+		RevokedAt:   session.RevokedAt,
+	}
+}
+
+// IsActive reports whether the session has not been revoked.
+func (s *SecureSession) IsActive() bool {
+	return s.RevokedAt == nil
+}
@@ -0,0 +1,52 @@
+package dbmodels
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"mandacode.com/accounts/auth/ent"
+)
+
+// UpdateUserStatusInput holds the changes of a user event to the status of a user. Nil fields are left as
+// they are.
+//
+// SyncVersion is the version of the user after the changes. Changes without a version, made by the auth
+// service ahead of an event, are always applied.
+type UpdateUserStatusInput struct {
+	UserID      uuid.UUID `json:"user_id" validate:"required"`
+	IsActive    *bool     `json:"is_active,omitempty"`
+	IsBlocked   *bool     `json:"is_blocked,omitempty"`
+	IsArchived  *bool     `json:"is_archived,omitempty"`
+	Roles       []string  `json:"roles,omitempty"`
+	SyncVersion *int64    `json:"sync_version,omitempty"`
+}
+
+type SecureUserStatus struct {
+	UserID      uuid.UUID `json:"user_id"`
+	IsActive    bool      `json:"is_active"`
+	IsBlocked   bool      `json:"is_blocked"`
+	IsArchived  bool      `json:"is_archived"`
+	Roles       []string  `json:"roles"`
+	SyncVersion int64     `json:"sync_version"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func NewSecureUserStatus(status *ent.UserStatus) *SecureUserStatus {
+	return &SecureUserStatus{
+		UserID:      status.UserID,
+		IsActive:    status.IsActive,
+		IsBlocked:   status.IsBlocked,
+		IsArchived:  status.IsArchived,
+		Roles:       status.Roles,
+		SyncVersion: status.SyncVersion,
+		UpdatedAt:   status.UpdatedAt,
+	}
+}
+
+// DefaultUserStatus returns the status of a user who has no status yet.
+func DefaultUserStatus(userID uuid.UUID) *SecureUserStatus {
+	return &SecureUserStatus{
+		UserID:   userID,
+		IsActive: true,
+	}
+}
@@ -0,0 +1,26 @@
+package devicemodels
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DeviceStatus string
+
+const (
+	DeviceStatusPending  DeviceStatus = "pending"
+	DeviceStatusApproved DeviceStatus = "approved"
+	DeviceStatusDenied   DeviceStatus = "denied"
+)
+
+type DeviceAuthorization struct {
+	DeviceCode   string        `json:"device_code"`
+	UserCode     string        `json:"user_code"`
+	ClientID     string        `json:"client_id"`
+	Status       DeviceStatus  `json:"status"`
+	UserID       uuid.UUID     `json:"user_id"`
+	Interval     time.Duration `json:"interval"`
+	LastPolledAt time.Time     `json:"last_polled_at"`
+	ExpiresAt    time.Time     `json:"expires_at"`
+}
@@ -0,0 +1,20 @@
+package oidcmodels
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuthorizationGrant is the state bound to an authorization code until it is exchanged at the token endpoint.
+type AuthorizationGrant struct {
+	ClientID            string    `json:"client_id"`
+	RedirectURI         string    `json:"redirect_uri"`
+	UserID              uuid.UUID `json:"user_id"`
+	Scopes              []string  `json:"scopes"`
+	Nonce               string    `json:"nonce,omitempty"`
+	CodeChallenge       string    `json:"code_challenge,omitempty"`
+	CodeChallengeMethod string    `json:"code_challenge_method,omitempty"`
+	AuthTime            time.Time `json:"auth_time"` // When the user authenticated the browser session
+	IssuedAt            time.Time `json:"issued_at"`
+}
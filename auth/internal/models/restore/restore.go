@@ -0,0 +1,15 @@
+package restoremodels
+
+import (
+	"github.com/google/uuid"
+	"mandacode.com/accounts/auth/ent/loginattempt"
+)
+
+// Restore is a pending request to restore a user whose account is scheduled for deletion.
+//
+// Requests made while signing in carry the sign in, which completes once the user is restored.
+type Restore struct {
+	UserID      uuid.UUID                `json:"user_id"`
+	LoginMethod loginattempt.LoginMethod `json:"login_method,omitempty"`
+	Provider    *string                  `json:"provider,omitempty"`
+}
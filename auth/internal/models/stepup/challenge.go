@@ -0,0 +1,18 @@
+package stepupmodels
+
+import (
+	"github.com/google/uuid"
+	"mandacode.com/accounts/auth/ent/loginattempt"
+)
+
+// Challenge is a sign in held back until the user confirms it with the code mailed to them.
+//
+// A challenge with a DeviceUserCode holds back the approval of a device by a signed in user instead, and only
+// completes that approval.
+type Challenge struct {
+	UserID         uuid.UUID                `json:"user_id"`
+	LoginMethod    loginattempt.LoginMethod `json:"login_method"`
+	Provider       *string                  `json:"provider,omitempty"`
+	Reasons        []string                 `json:"reasons"`
+	DeviceUserCode *string                  `json:"device_user_code,omitempty"`
+}
@@ -2,6 +2,7 @@ package coderepo
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -76,6 +77,63 @@ func (l *CodeManager) ValidateCode(ctx context.Context, userID uuid.UUID, code s
 	return true, nil // Code is valid and deleted
 }
 
+// IssueCodeWithPayload issues a new single-use code bound to the given payload.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - payload: The value to store with the code, encoded as JSON.
+//
+// Returns:
+//   - A string representing the issued code.
+//   - An error if the code could not be issued.
+func (l *CodeManager) IssueCodeWithPayload(ctx context.Context, payload any) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", errors.New(err.Error(), "Failed to encode code payload", errcode.ErrInternalFailure)
+	}
+
+	code, err := l.codeGen.GenerateSecureRandomCode()
+	if err != nil {
+		return "", err
+	}
+
+	key := l.prefix + code
+
+	err = l.codeStore.Set(ctx, key, data, l.codeTTL).Err()
+	if err != nil {
+		return "", err
+	}
+
+	return code, nil
+}
+
+// ConsumeCodePayload atomically deletes the code and decodes its payload into out.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - code: The code to consume.
+//   - out: A pointer the stored payload is decoded into.
+//
+// Returns:
+//   - A boolean indicating whether the code existed.
+//   - An error if the code could not be consumed.
+func (l *CodeManager) ConsumeCodePayload(ctx context.Context, code string, out any) (bool, error) {
+	key := l.prefix + code
+	data, err := l.codeStore.GetDel(ctx, key).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return false, nil // Code does not exist
+		}
+		return false, errors.New(err.Error(), "Failed to get code from store", errcode.ErrInternalFailure)
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return false, errors.New(err.Error(), "Failed to decode code payload", errcode.ErrInternalFailure)
+	}
+
+	return true, nil
+}
+
 func NewCodeManager(codeGen *util.RandomGenerator, codeTTL time.Duration, codeStore *redis.Client, prefix string) *CodeManager {
 	return &CodeManager{
 		codeGen:   codeGen,
@@ -0,0 +1,104 @@
+package consentrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userv1 "github.com/mandacode-com/accounts-proto/go/user/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	consentmodels "mandacode.com/accounts/auth/internal/models/consent"
+)
+
+type ConsentRepository struct {
+	client userv1.ConsentServiceClient
+}
+
+// ListPendingConsents retrieves the current mandatory documents which the user has not accepted.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The ID of the user.
+//
+// Returns:
+//   - documents: The documents the user has yet to accept, empty if none.
+//   - error: An error if the retrieval fails, otherwise nil.
+func (r *ConsentRepository) ListPendingConsents(ctx context.Context, userID uuid.UUID) ([]consentmodels.Document, error) {
+	resp, err := r.client.ListPendingConsents(ctx, &userv1.ListPendingConsentsRequest{
+		UserId: userID.String(),
+	})
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to get pending consents", errcode.ErrInternalFailure)
+	}
+	if err := resp.ValidateAll(); err != nil {
+		return nil, errors.Upgrade(err, "Invalid response from user service", errcode.ErrInternalFailure)
+	}
+
+	documents := make([]consentmodels.Document, 0, len(resp.Documents))
+	for _, document := range resp.Documents {
+		documentID, err := uuid.Parse(document.DocumentId)
+		if err != nil {
+			return nil, errors.Upgrade(err, "Invalid document ID from user service", errcode.ErrInternalFailure)
+		}
+		documents = append(documents, consentmodels.Document{
+			ID:          documentID,
+			Kind:        document.Kind,
+			Version:     int(document.Version),
+			Title:       document.Title,
+			ContentURL:  document.ContentUrl,
+			Mandatory:   document.Mandatory,
+			PublishedAt: document.PublishedAt.AsTime(),
+		})
+	}
+	return documents, nil
+}
+
+// RecordConsents records the consent choices of a user, made from ip with userAgent. The user is recorded as
+// making the choices.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The ID of the user.
+//   - choices: The choices of the user on the documents.
+//   - ip: The IP address the choices were made from.
+//   - userAgent: The user agent the choices were made with.
+//
+// Returns:
+//   - error: An error with the ErrInvalidInput code if the choices are invalid, otherwise nil unless the
+//     recording fails.
+func (r *ConsentRepository) RecordConsents(ctx context.Context, userID uuid.UUID, choices []consentmodels.Choice, ip string, userAgent string) error {
+	actorID := userID.String()
+	consents := make([]*userv1.ConsentChoice, 0, len(choices))
+	for _, choice := range choices {
+		consents = append(consents, &userv1.ConsentChoice{
+			DocumentId: choice.DocumentID.String(),
+			Accepted:   choice.Accepted,
+		})
+	}
+	resp, err := r.client.RecordConsents(ctx, &userv1.RecordConsentsRequest{
+		UserId:    userID.String(),
+		Consents:  consents,
+		Ip:        ip,
+		UserAgent: userAgent,
+		ActorId:   &actorID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return errors.New("consents are invalid", "Invalid Consent", errcode.ErrInvalidInput)
+		}
+		return errors.Upgrade(err, "Failed to record consents", errcode.ErrInternalFailure)
+	}
+	if err := resp.ValidateAll(); err != nil {
+		return errors.Upgrade(err, "Invalid response from user service", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// NewConsentRepository creates a new ConsentRepository backed by the consent service of the user service.
+func NewConsentRepository(client userv1.ConsentServiceClient) *ConsentRepository {
+	return &ConsentRepository{
+		client: client,
+	}
+}
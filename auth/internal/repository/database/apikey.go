@@ -0,0 +1,159 @@
+package dbrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/auth/ent"
+	"mandacode.com/accounts/auth/ent/apikey"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+)
+
+type APIKeyRepository struct {
+	client *ent.Client
+}
+
+// CreateAPIKey stores a new API key by its hash.
+func (r *APIKeyRepository) CreateAPIKey(ctx context.Context, input *dbmodels.CreateAPIKeyInput) (*dbmodels.SecureAPIKey, error) {
+	create := r.client.APIKey.Create().
+		SetID(uuid.New()).
+		SetUserID(input.UserID).
+		SetName(input.Name).
+		SetPrefix(input.Prefix).
+		SetKeyHash(input.KeyHash).
+		SetScopes(input.Scopes).
+		SetNillableExpiresAt(input.ExpiresAt)
+
+	apiKey, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("APIKey already exists", "API Key Already Exists", errcode.ErrConflict)
+		}
+		return nil, errors.New(err.Error(), "Failed to create APIKey", errcode.ErrInternalFailure)
+	}
+
+	return dbmodels.NewSecureAPIKey(apiKey), nil
+}
+
+// GetAPIKeyByHash retrieves an API key by the hash of the full key.
+func (r *APIKeyRepository) GetAPIKeyByHash(ctx context.Context, keyHash string) (*dbmodels.SecureAPIKey, error) {
+	apiKey, err := r.client.APIKey.Query().
+		Where(apikey.KeyHash(keyHash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("APIKey not found", "API Key Not Found", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to find APIKey by hash", errcode.ErrInternalFailure)
+	}
+
+	return dbmodels.NewSecureAPIKey(apiKey), nil
+}
+
+// ListAPIKeysByUserID retrieves the API keys of a user which have not been revoked, newest first.
+func (r *APIKeyRepository) ListAPIKeysByUserID(ctx context.Context, userID uuid.UUID) ([]*dbmodels.SecureAPIKey, error) {
+	apiKeys, err := r.client.APIKey.Query().
+		Where(
+			apikey.UserID(userID),
+			apikey.RevokedAtIsNil(),
+		).
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to find APIKeys by UserID", errcode.ErrInternalFailure)
+	}
+
+	secureKeys := make([]*dbmodels.SecureAPIKey, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		secureKeys = append(secureKeys, dbmodels.NewSecureAPIKey(apiKey))
+	}
+	return secureKeys, nil
+}
+
+// ListAllAPIKeysByUserID retrieves every API key of a user, including the revoked ones, newest first.
+func (r *APIKeyRepository) ListAllAPIKeysByUserID(ctx context.Context, userID uuid.UUID) ([]*dbmodels.SecureAPIKey, error) {
+	apiKeys, err := r.client.APIKey.Query().
+		Where(apikey.UserID(userID)).
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to find APIKeys by UserID", errcode.ErrInternalFailure)
+	}
+
+	secureKeys := make([]*dbmodels.SecureAPIKey, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		secureKeys = append(secureKeys, dbmodels.NewSecureAPIKey(apiKey))
+	}
+	return secureKeys, nil
+}
+
+// CountActiveAPIKeysByUserID counts the API keys of a user which have not been revoked.
+func (r *APIKeyRepository) CountActiveAPIKeysByUserID(ctx context.Context, userID uuid.UUID) (int, error) {
+	count, err := r.client.APIKey.Query().
+		Where(
+			apikey.UserID(userID),
+			apikey.RevokedAtIsNil(),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, errors.New(err.Error(), "Failed to count APIKeys by UserID", errcode.ErrInternalFailure)
+	}
+	return count, nil
+}
+
+// RevokeAPIKey revokes an API key owned by the user.
+func (r *APIKeyRepository) RevokeAPIKey(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	affected, err := r.client.APIKey.Update().
+		Where(
+			apikey.ID(id),
+			apikey.UserID(userID),
+			apikey.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to revoke APIKey", errcode.ErrInternalFailure)
+	}
+	if affected == 0 {
+		return errors.New("APIKey not found", "API Key Not Found", errcode.ErrNotFound)
+	}
+	return nil
+}
+
+// TouchLastUsed records that the API key was just used.
+func (r *APIKeyRepository) TouchLastUsed(ctx context.Context, id uuid.UUID) error {
+	if err := r.client.APIKey.UpdateOneID(id).SetLastUsedAt(time.Now()).Exec(ctx); err != nil {
+		return errors.New(err.Error(), "Failed to update APIKey", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// SetSuspendedByUserID suspends or resumes every API key of the user.
+func (r *APIKeyRepository) SetSuspendedByUserID(ctx context.Context, userID uuid.UUID, suspended bool) error {
+	_, err := r.client.APIKey.Update().
+		Where(apikey.UserID(userID)).
+		SetIsSuspended(suspended).
+		Save(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to update APIKeys by UserID", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// DeleteAPIKeysByUserID deletes every API key of the user.
+func (r *APIKeyRepository) DeleteAPIKeysByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := r.client.APIKey.Delete().
+		Where(apikey.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to delete APIKeys by UserID", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+func NewAPIKeyRepository(client *ent.Client) *APIKeyRepository {
+	return &APIKeyRepository{client: client}
+}
@@ -99,6 +99,28 @@ func (a *AuthAccountRepository) GetAuthAccountsByUserID(ctx context.Context, use
 	return secureAccounts, nil
 }
 
+// GetContactEmailByUserID retrieves the email to contact the user at: the email of the local account, or
+// of a verified OAuth account. It returns an empty string if the user has neither.
+func (a *AuthAccountRepository) GetContactEmailByUserID(ctx context.Context, userID uuid.UUID) (string, error) {
+	authAccounts, err := a.client.AuthAccount.Query().
+		Where(authaccount.UserID(userID)).
+		All(ctx)
+	if err != nil {
+		return "", errors.New(err.Error(), "Failed to find AuthAccounts by UserID", errcode.ErrInternalFailure)
+	}
+
+	email := ""
+	for _, account := range authAccounts {
+		if account.Provider == authaccount.ProviderLocal {
+			return account.Email, nil
+		}
+		if email == "" && account.IsVerified {
+			email = account.Email
+		}
+	}
+	return email, nil
+}
+
 // GetLocalAuthAccountByUserID retrieves a local authentication account by user ID.
 func (a *AuthAccountRepository) GetLocalAuthAccountByUserID(ctx context.Context, userID uuid.UUID) (*dbmodels.SecureLocalAuthAccount, error) {
 	authAccount, err := a.client.AuthAccount.Query().
@@ -233,7 +255,7 @@ func (a *AuthAccountRepository) ComparePassword(ctx context.Context, email strin
 
 	if err := bcrypt.CompareHashAndPassword([]byte(*localAccount.PasswordHash), []byte(password)); err != nil {
 		if err == bcrypt.ErrMismatchedHashAndPassword {
-			return false, uuid.Nil, nil // Password does not match, return user ID for further processing
+			return false, localAccount.UserID, nil // Password does not match, return user ID for further processing
 		}
 		return false, uuid.Nil, errors.New(err.Error(), "Internal Error", errcode.ErrInternalFailure)
 	}
@@ -339,6 +361,9 @@ func (a *AuthAccountRepository) UpdateEmailByID(ctx context.Context, id uuid.UUI
 		if ent.IsNotFound(err) {
 			return nil, errors.New("AuthAccount not found", "AuthAccount Not Found", errcode.ErrNotFound)
 		}
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("AuthAccount email already in use", "Email Already In Use", errcode.ErrConflict)
+		}
 		return nil, errors.New(err.Error(), "Failed to update AuthAccount email", errcode.ErrInternalFailure)
 	}
 
@@ -0,0 +1,176 @@
+package dbrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/auth/ent"
+	"mandacode.com/accounts/auth/ent/loginattempt"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+)
+
+type LoginAttemptRepository struct {
+	client *ent.Client
+}
+
+// CreateLoginAttempt records an authentication attempt.
+func (r *LoginAttemptRepository) CreateLoginAttempt(ctx context.Context, input *dbmodels.CreateLoginAttemptInput) (*dbmodels.SecureLoginAttempt, error) {
+	create := r.client.LoginAttempt.Create().
+		SetID(uuid.New()).
+		SetNillableUserID(input.UserID).
+		SetLoginMethod(input.LoginMethod).
+		SetNillableProvider(input.Provider).
+		SetSuccess(input.Success).
+		SetNillableFailureReason(input.FailureReason).
+		SetIPAddress(input.IPAddress).
+		SetUserAgent(input.UserAgent).
+		SetDeviceID(input.DeviceID).
+		SetNillableCountry(input.Country).
+		SetNillableCity(input.City).
+		SetNillableLatitude(input.Latitude).
+		SetNillableLongitude(input.Longitude)
+
+	attempt, err := create.Save(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to create LoginAttempt", errcode.ErrInternalFailure)
+	}
+
+	return dbmodels.NewSecureLoginAttempt(attempt), nil
+}
+
+// ListLoginAttemptsByUserID retrieves the most recent login attempts of a user, newest first.
+func (r *LoginAttemptRepository) ListLoginAttemptsByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]*dbmodels.SecureLoginAttempt, error) {
+	attempts, err := r.client.LoginAttempt.Query().
+		Where(loginattempt.UserID(userID)).
+		Order(ent.Desc(loginattempt.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to find LoginAttempts by UserID", errcode.ErrInternalFailure)
+	}
+
+	secureAttempts := make([]*dbmodels.SecureLoginAttempt, 0, len(attempts))
+	for _, attempt := range attempts {
+		secureAttempts = append(secureAttempts, dbmodels.NewSecureLoginAttempt(attempt))
+	}
+	return secureAttempts, nil
+}
+
+// ListAllLoginAttemptsByUserID retrieves every login attempt of a user, newest first.
+func (r *LoginAttemptRepository) ListAllLoginAttemptsByUserID(ctx context.Context, userID uuid.UUID) ([]*dbmodels.SecureLoginAttempt, error) {
+	attempts, err := r.client.LoginAttempt.Query().
+		Where(loginattempt.UserID(userID)).
+		Order(ent.Desc(loginattempt.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to find LoginAttempts by UserID", errcode.ErrInternalFailure)
+	}
+
+	secureAttempts := make([]*dbmodels.SecureLoginAttempt, 0, len(attempts))
+	for _, attempt := range attempts {
+		secureAttempts = append(secureAttempts, dbmodels.NewSecureLoginAttempt(attempt))
+	}
+	return secureAttempts, nil
+}
+
+// GetLastSuccessfulLogin retrieves the most recent successful login of the user, or nil if there is none.
+func (r *LoginAttemptRepository) GetLastSuccessfulLogin(ctx context.Context, userID uuid.UUID) (*dbmodels.SecureLoginAttempt, error) {
+	attempt, err := r.client.LoginAttempt.Query().
+		Where(
+			loginattempt.UserID(userID),
+			loginattempt.Success(true),
+		).
+		Order(ent.Desc(loginattempt.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.New(err.Error(), "Failed to find last successful LoginAttempt", errcode.ErrInternalFailure)
+	}
+	return dbmodels.NewSecureLoginAttempt(attempt), nil
+}
+
+// CountFailedLoginsSince counts the failed login attempts for the user since the given time.
+func (r *LoginAttemptRepository) CountFailedLoginsSince(ctx context.Context, userID uuid.UUID, since time.Time) (int, error) {
+	count, err := r.client.LoginAttempt.Query().
+		Where(
+			loginattempt.UserID(userID),
+			loginattempt.Success(false),
+			loginattempt.CreatedAtGTE(since),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, errors.New(err.Error(), "Failed to count failed LoginAttempts", errcode.ErrInternalFailure)
+	}
+	return count, nil
+}
+
+// HasSuccessfulLogin reports whether the user has signed in successfully before.
+func (r *LoginAttemptRepository) HasSuccessfulLogin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	exists, err := r.client.LoginAttempt.Query().
+		Where(
+			loginattempt.UserID(userID),
+			loginattempt.Success(true),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, errors.New(err.Error(), "Failed to check LoginAttempts", errcode.ErrInternalFailure)
+	}
+	return exists, nil
+}
+
+// HasSuccessfulLoginFromDevice reports whether the user has signed in successfully with the device before.
+//
+// The device is identified by its device ID if it sent one, otherwise by its user agent.
+func (r *LoginAttemptRepository) HasSuccessfulLoginFromDevice(ctx context.Context, userID uuid.UUID, deviceID string, userAgent string) (bool, error) {
+	query := r.client.LoginAttempt.Query().
+		Where(
+			loginattempt.UserID(userID),
+			loginattempt.Success(true),
+		)
+	if deviceID != "" {
+		query = query.Where(loginattempt.DeviceID(deviceID))
+	} else {
+		query = query.Where(loginattempt.UserAgent(userAgent))
+	}
+
+	exists, err := query.Exist(ctx)
+	if err != nil {
+		return false, errors.New(err.Error(), "Failed to check LoginAttempts", errcode.ErrInternalFailure)
+	}
+	return exists, nil
+}
+
+// HasSuccessfulLoginFromCountry reports whether the user has signed in successfully from the country before.
+func (r *LoginAttemptRepository) HasSuccessfulLoginFromCountry(ctx context.Context, userID uuid.UUID, country string) (bool, error) {
+	exists, err := r.client.LoginAttempt.Query().
+		Where(
+			loginattempt.UserID(userID),
+			loginattempt.Success(true),
+			loginattempt.Country(country),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, errors.New(err.Error(), "Failed to check LoginAttempts", errcode.ErrInternalFailure)
+	}
+	return exists, nil
+}
+
+// DeleteLoginAttemptsByUserID deletes the login history of the user.
+func (r *LoginAttemptRepository) DeleteLoginAttemptsByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := r.client.LoginAttempt.Delete().
+		Where(loginattempt.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to delete LoginAttempts by UserID", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+func NewLoginAttemptRepository(client *ent.Client) *LoginAttemptRepository {
+	return &LoginAttemptRepository{client: client}
+}
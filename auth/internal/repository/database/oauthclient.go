@@ -0,0 +1,147 @@
+package dbrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"golang.org/x/crypto/bcrypt"
+	"mandacode.com/accounts/auth/ent"
+	"mandacode.com/accounts/auth/ent/oauthclient"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+)
+
+type OAuthClientRepository struct {
+	client *ent.Client
+}
+
+// CreateClient registers a new OAuth client, hashing its secret if it is a confidential client.
+func (r *OAuthClientRepository) CreateClient(ctx context.Context, input *dbmodels.CreateOAuthClientInput) (*dbmodels.SecureOAuthClient, error) {
+	create := r.client.OAuthClient.Create().
+		SetID(uuid.New()).
+		SetClientID(input.ClientID).
+		SetName(input.Name).
+		SetRedirectUris(input.RedirectURIs).
+		SetScopes(input.Scopes).
+		SetGrantTypes(input.GrantTypes)
+
+	if input.Secret != nil {
+		secretHash, err := bcrypt.GenerateFromPassword([]byte(*input.Secret), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, errors.New(err.Error(), "Failed to generate secret hash", errcode.ErrInternalFailure)
+		}
+		create.SetSecretHash(string(secretHash))
+	}
+
+	client, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("OAuthClient already exists", "OAuth Client Already Exists", errcode.ErrConflict)
+		}
+		return nil, errors.New(err.Error(), "Failed to create OAuthClient", errcode.ErrInternalFailure)
+	}
+
+	return dbmodels.NewSecureOAuthClient(client), nil
+}
+
+// GetClientByClientID retrieves a registered OAuth client by its client ID.
+func (r *OAuthClientRepository) GetClientByClientID(ctx context.Context, clientID string) (*dbmodels.SecureOAuthClient, error) {
+	client, err := r.client.OAuthClient.Query().
+		Where(oauthclient.ClientID(clientID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("OAuthClient not found", "OAuth Client Not Found", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to find OAuthClient by ClientID", errcode.ErrInternalFailure)
+	}
+
+	return dbmodels.NewSecureOAuthClient(client), nil
+}
+
+// CompareSecret compares the provided secret with the stored secret hash of the client.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - clientID: The client ID of the OAuth client.
+//   - secret: The plain text client secret to compare.
+//
+// Returns:
+//   - bool: true if the client exists, is confidential and the secret matches, false otherwise.
+//   - error: An error if the operation fails, nil otherwise.
+func (r *OAuthClientRepository) CompareSecret(ctx context.Context, clientID string, secret string) (bool, error) {
+	client, err := r.client.OAuthClient.Query().
+		Where(oauthclient.ClientID(clientID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.New(err.Error(), "Internal Error", errcode.ErrInternalFailure)
+	}
+	if client.SecretHash == nil {
+		return false, nil // Public clients have no secret
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(*client.SecretHash), []byte(secret)); err != nil {
+		if err == bcrypt.ErrMismatchedHashAndPassword {
+			return false, nil
+		}
+		return false, errors.New(err.Error(), "Internal Error", errcode.ErrInternalFailure)
+	}
+
+	return true, nil
+}
+
+// RotateSecret replaces the secret of the client, invalidating the previous one.
+func (r *OAuthClientRepository) RotateSecret(ctx context.Context, clientID string, secret string) (*dbmodels.SecureOAuthClient, error) {
+	secretHash, err := bcrypt.GenerateFromPassword([]byte(secret), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to generate secret hash", errcode.ErrInternalFailure)
+	}
+
+	client, err := r.updateByClientID(ctx, clientID, func(update *ent.OAuthClientUpdateOne) {
+		update.SetSecretHash(string(secretHash)).
+			SetSecretRotatedAt(time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// SetDisabled enables or disables the client.
+func (r *OAuthClientRepository) SetDisabled(ctx context.Context, clientID string, disabled bool) (*dbmodels.SecureOAuthClient, error) {
+	return r.updateByClientID(ctx, clientID, func(update *ent.OAuthClientUpdateOne) {
+		update.SetIsDisabled(disabled)
+	})
+}
+
+// updateByClientID applies the mutation to the client identified by its client ID.
+func (r *OAuthClientRepository) updateByClientID(ctx context.Context, clientID string, mutate func(update *ent.OAuthClientUpdateOne)) (*dbmodels.SecureOAuthClient, error) {
+	id, err := r.client.OAuthClient.Query().
+		Where(oauthclient.ClientID(clientID)).
+		OnlyID(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("OAuthClient not found", "OAuth Client Not Found", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to find OAuthClient by ClientID", errcode.ErrInternalFailure)
+	}
+
+	update := r.client.OAuthClient.UpdateOneID(id)
+	mutate(update)
+	client, err := update.Save(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to update OAuthClient", errcode.ErrInternalFailure)
+	}
+
+	return dbmodels.NewSecureOAuthClient(client), nil
+}
+
+func NewOAuthClientRepository(client *ent.Client) *OAuthClientRepository {
+	return &OAuthClientRepository{client: client}
+}
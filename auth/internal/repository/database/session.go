@@ -0,0 +1,191 @@
+package dbrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/auth/ent"
+	"mandacode.com/accounts/auth/ent/predicate"
+	"mandacode.com/accounts/auth/ent/session"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+)
+
+type SessionRepository struct {
+	client *ent.Client
+}
+
+// CreateSession stores a new session linked to the hash of its refresh token.
+func (r *SessionRepository) CreateSession(ctx context.Context, input *dbmodels.CreateSessionInput) (*dbmodels.SecureSession, error) {
+	create := r.client.Session.Create().
+		SetID(uuid.New()).
+		SetUserID(input.UserID).
+		SetRefreshTokenHash(input.RefreshTokenHash).
+		SetLoginMethod(input.LoginMethod).
+		SetNillableProvider(input.Provider).
+		SetUserAgent(input.UserAgent).
+		SetIPAddress(input.IPAddress)
+
+	s, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("Session already exists", "Session Already Exists", errcode.ErrConflict)
+		}
+		return nil, errors.New(err.Error(), "Failed to create Session", errcode.ErrInternalFailure)
+	}
+
+	return dbmodels.NewSecureSession(s), nil
+}
+
+// GetSessionByRefreshTokenHash retrieves a session by the hash of its latest refresh token.
+func (r *SessionRepository) GetSessionByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (*dbmodels.SecureSession, error) {
+	s, err := r.client.Session.Query().
+		Where(session.RefreshTokenHash(refreshTokenHash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("Session not found", "Session Not Found", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to find Session by refresh token", errcode.ErrInternalFailure)
+	}
+
+	return dbmodels.NewSecureSession(s), nil
+}
+
+// ListActiveSessionsByUserID retrieves the sessions of a user which have not been revoked, most recently seen first.
+func (r *SessionRepository) ListActiveSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]*dbmodels.SecureSession, error) {
+	sessions, err := r.client.Session.Query().
+		Where(
+			session.UserID(userID),
+			session.RevokedAtIsNil(),
+		).
+		Order(ent.Desc(session.FieldLastSeenAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to find Sessions by UserID", errcode.ErrInternalFailure)
+	}
+
+	secureSessions := make([]*dbmodels.SecureSession, 0, len(sessions))
+	for _, s := range sessions {
+		secureSessions = append(secureSessions, dbmodels.NewSecureSession(s))
+	}
+	return secureSessions, nil
+}
+
+// ListAllSessionsByUserID retrieves every session of a user, including the revoked ones, newest first.
+func (r *SessionRepository) ListAllSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]*dbmodels.SecureSession, error) {
+	sessions, err := r.client.Session.Query().
+		Where(session.UserID(userID)).
+		Order(ent.Desc(session.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to find Sessions by UserID", errcode.ErrInternalFailure)
+	}
+
+	secureSessions := make([]*dbmodels.SecureSession, 0, len(sessions))
+	for _, s := range sessions {
+		secureSessions = append(secureSessions, dbmodels.NewSecureSession(s))
+	}
+	return secureSessions, nil
+}
+
+// TouchSession records that the session was just used from the given IP address.
+func (r *SessionRepository) TouchSession(ctx context.Context, id uuid.UUID, ipAddress string) error {
+	update := r.client.Session.UpdateOneID(id).SetLastSeenAt(time.Now())
+	if ipAddress != "" {
+		update = update.SetIPAddress(ipAddress)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return errors.New(err.Error(), "Failed to update Session", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// RotateRefreshToken replaces the refresh token of an active session, provided it still holds the expected one.
+//
+// Returns an unauthorized error if the session was revoked or its refresh token was already rotated.
+func (r *SessionRepository) RotateRefreshToken(ctx context.Context, id uuid.UUID, oldHash string, newHash string, ipAddress string) error {
+	update := r.client.Session.Update().
+		Where(
+			session.ID(id),
+			session.RefreshTokenHash(oldHash),
+			session.RevokedAtIsNil(),
+		).
+		SetRefreshTokenHash(newHash).
+		SetLastSeenAt(time.Now())
+	if ipAddress != "" {
+		update = update.SetIPAddress(ipAddress)
+	}
+	affected, err := update.Save(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to rotate Session refresh token", errcode.ErrInternalFailure)
+	}
+	if affected == 0 {
+		return errors.New("Session was revoked or refreshed concurrently", "Unauthorized", errcode.ErrUnauthorized)
+	}
+	return nil
+}
+
+// RevokeSession revokes a session owned by the user.
+func (r *SessionRepository) RevokeSession(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	affected, err := r.revoke(ctx, session.ID(id), session.UserID(userID))
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.New("Session not found", "Session Not Found", errcode.ErrNotFound)
+	}
+	return nil
+}
+
+// RevokeSessionByID revokes a session regardless of its owner.
+func (r *SessionRepository) RevokeSessionByID(ctx context.Context, id uuid.UUID) error {
+	affected, err := r.revoke(ctx, session.ID(id))
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.New("Session not found", "Session Not Found", errcode.ErrNotFound)
+	}
+	return nil
+}
+
+// RevokeSessionsByUserID revokes every session of the user except the one with exceptID, if set.
+//
+// Returns the number of revoked sessions.
+func (r *SessionRepository) RevokeSessionsByUserID(ctx context.Context, userID uuid.UUID, exceptID *uuid.UUID) (int, error) {
+	predicates := []predicate.Session{session.UserID(userID)}
+	if exceptID != nil {
+		predicates = append(predicates, session.IDNEQ(*exceptID))
+	}
+	return r.revoke(ctx, predicates...)
+}
+
+// DeleteSessionsByUserID deletes every session of the user.
+func (r *SessionRepository) DeleteSessionsByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := r.client.Session.Delete().
+		Where(session.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to delete Sessions by UserID", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// revoke marks the active sessions matching the predicates as revoked.
+func (r *SessionRepository) revoke(ctx context.Context, predicates ...predicate.Session) (int, error) {
+	affected, err := r.client.Session.Update().
+		Where(append(predicates, session.RevokedAtIsNil())...).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return 0, errors.New(err.Error(), "Failed to revoke Session", errcode.ErrInternalFailure)
+	}
+	return affected, nil
+}
+
+func NewSessionRepository(client *ent.Client) *SessionRepository {
+	return &SessionRepository{client: client}
+}
@@ -0,0 +1,122 @@
+package dbrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/auth/ent"
+	"mandacode.com/accounts/auth/ent/userstatus"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+)
+
+type UserStatusRepository struct {
+	client *ent.Client
+}
+
+// GetUserStatus returns the status of the user, which is the default status if the user has none.
+func (r *UserStatusRepository) GetUserStatus(ctx context.Context, userID uuid.UUID) (*dbmodels.SecureUserStatus, error) {
+	status, err := r.client.UserStatus.Query().
+		Where(userstatus.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return dbmodels.DefaultUserStatus(userID), nil
+		}
+		return nil, errors.New(err.Error(), "Failed to find UserStatus by UserID", errcode.ErrInternalFailure)
+	}
+	return dbmodels.NewSecureUserStatus(status), nil
+}
+
+// UpdateUserStatus applies the changes of a user event to the status of the user, creating the status if
+// the user has none.
+//
+// The events of a user may be redelivered, or delivered after a later bootstrap sync, so changes whose
+// version is not above the one of the status are stale, and are skipped. The version is compared in the
+// update itself, so that concurrent events cannot apply out of order.
+//
+// Returns:
+//   - Whether the changes were applied.
+//   - An error if the status could not be updated.
+func (r *UserStatusRepository) UpdateUserStatus(ctx context.Context, input *dbmodels.UpdateUserStatusInput) (bool, error) {
+	update := r.client.UserStatus.Update().
+		Where(userstatus.UserID(input.UserID)).
+		SetNillableIsActive(input.IsActive).
+		SetNillableIsBlocked(input.IsBlocked).
+		SetNillableIsArchived(input.IsArchived)
+	if input.Roles != nil {
+		update.SetRoles(input.Roles)
+	}
+	if input.SyncVersion != nil {
+		update.Where(userstatus.SyncVersionLT(*input.SyncVersion)).
+			SetSyncVersion(*input.SyncVersion)
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return false, errors.New(err.Error(), "Failed to update UserStatus", errcode.ErrInternalFailure)
+	}
+	if updated > 0 {
+		return true, nil
+	}
+
+	exists, err := r.client.UserStatus.Query().
+		Where(userstatus.UserID(input.UserID)).
+		Exist(ctx)
+	if err != nil {
+		return false, errors.New(err.Error(), "Failed to find UserStatus by UserID", errcode.ErrInternalFailure)
+	}
+	if exists {
+		return false, nil // Stale changes
+	}
+
+	create := r.client.UserStatus.Create().
+		SetUserID(input.UserID).
+		SetNillableIsActive(input.IsActive).
+		SetNillableIsBlocked(input.IsBlocked).
+		SetNillableIsArchived(input.IsArchived).
+		SetNillableSyncVersion(input.SyncVersion)
+	if input.Roles != nil {
+		create.SetRoles(input.Roles)
+	}
+	if _, err := create.Save(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			// Created concurrently, update it instead
+			return r.UpdateUserStatus(ctx, input)
+		}
+		return false, errors.New(err.Error(), "Failed to create UserStatus", errcode.ErrInternalFailure)
+	}
+	return true, nil
+}
+
+// CreateUserStatus records the status of a new user ahead of the user events, so that a user created inactive
+// cannot sign in before the events arrive. A status which the events already recorded is more recent, and is
+// kept.
+func (r *UserStatusRepository) CreateUserStatus(ctx context.Context, userID uuid.UUID, isActive bool) error {
+	_, err := r.client.UserStatus.Create().
+		SetUserID(userID).
+		SetIsActive(isActive).
+		Save(ctx)
+	if err != nil && !ent.IsConstraintError(err) {
+		return errors.New(err.Error(), "Failed to create UserStatus", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// DeleteUserStatus deletes the status of the user, if any.
+func (r *UserStatusRepository) DeleteUserStatus(ctx context.Context, userID uuid.UUID) error {
+	_, err := r.client.UserStatus.Delete().
+		Where(userstatus.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to delete UserStatus by UserID", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// NewUserStatusRepository creates a new UserStatusRepository.
+func NewUserStatusRepository(client *ent.Client) *UserStatusRepository {
+	return &UserStatusRepository{
+		client: client,
+	}
+}
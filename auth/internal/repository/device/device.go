@@ -0,0 +1,284 @@
+package devicerepo
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"github.com/redis/go-redis/v9"
+	devicemodels "mandacode.com/accounts/auth/internal/models/device"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+const (
+	deviceKeyPrefix   = "device:"
+	userCodeKeyPrefix = "user:"
+
+	fieldUserCode     = "user_code"
+	fieldClientID     = "client_id"
+	fieldStatus       = "status"
+	fieldUserID       = "user_id"
+	fieldInterval     = "interval"
+	fieldLastPolledAt = "last_polled_at"
+	fieldExpiresAt    = "expires_at"
+
+	// maxUserCodeAttempts bounds the retries on user code collisions.
+	maxUserCodeAttempts = 5
+)
+
+// updateScript sets the fields of a device authorization and re-applies its expiry, unless it expired or was
+// consumed since it was read, which HSET would otherwise recreate without expiry. KEYS[1] is the device key,
+// ARGV[1] the expiry in Unix milliseconds, and the remaining ARGV the fields and their values. It returns whether
+// the authorization was updated.
+var updateScript = redis.NewScript(`
+local key = KEYS[1]
+if redis.call("EXISTS", key) == 0 then
+	return 0
+end
+redis.call("HSET", key, unpack(ARGV, 2))
+redis.call("PEXPIREAT", key, ARGV[1])
+return 1
+`)
+
+type DeviceCodeManager struct {
+	deviceCodeGen *util.RandomGenerator
+	userCodeGen   *util.UserCodeGenerator
+	codeTTL       time.Duration
+	interval      time.Duration
+	codeStore     *redis.Client
+	prefix        string
+}
+
+func (d *DeviceCodeManager) deviceKey(deviceCode string) string {
+	return d.prefix + deviceKeyPrefix + deviceCode
+}
+
+func (d *DeviceCodeManager) userCodeKey(userCode string) string {
+	return d.prefix + userCodeKeyPrefix + userCode
+}
+
+// IssueDeviceCode issues a new pending device authorization for the given client.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - clientID: The identifier of the client requesting authorization.
+//
+// Returns:
+//   - The issued device authorization.
+//   - An error if the authorization could not be issued.
+func (d *DeviceCodeManager) IssueDeviceCode(ctx context.Context, clientID string) (*devicemodels.DeviceAuthorization, error) {
+	deviceCode, err := d.deviceCodeGen.GenerateSecureRandomCode()
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to generate device code", errcode.ErrInternalFailure)
+	}
+
+	// Reserve a user code which is not currently in use
+	var userCode string
+	for attempt := 0; attempt < maxUserCodeAttempts; attempt++ {
+		candidate, err := d.userCodeGen.GenerateUserCode()
+		if err != nil {
+			return nil, errors.New(err.Error(), "Failed to generate user code", errcode.ErrInternalFailure)
+		}
+		ok, err := d.codeStore.SetNX(ctx, d.userCodeKey(candidate), deviceCode, d.codeTTL).Result()
+		if err != nil {
+			return nil, errors.New(err.Error(), "Failed to store user code", errcode.ErrInternalFailure)
+		}
+		if ok {
+			userCode = candidate
+			break
+		}
+	}
+	if userCode == "" {
+		return nil, errors.New("failed to reserve a unique user code", "Failed to generate user code", errcode.ErrInternalFailure)
+	}
+
+	expiresAt := time.Now().Add(d.codeTTL)
+	key := d.deviceKey(deviceCode)
+	_, err = d.codeStore.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key,
+			fieldUserCode, userCode,
+			fieldClientID, clientID,
+			fieldStatus, string(devicemodels.DeviceStatusPending),
+			fieldInterval, d.interval.Milliseconds(),
+			fieldLastPolledAt, 0,
+			fieldExpiresAt, expiresAt.UnixMilli(),
+		)
+		pipe.PExpireAt(ctx, key, expiresAt)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to store device code", errcode.ErrInternalFailure)
+	}
+
+	return &devicemodels.DeviceAuthorization{
+		DeviceCode: deviceCode,
+		UserCode:   userCode,
+		ClientID:   clientID,
+		Status:     devicemodels.DeviceStatusPending,
+		Interval:   d.interval,
+		ExpiresAt:  expiresAt,
+	}, nil
+}
+
+// GetByDeviceCode returns the device authorization identified by the device code.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - deviceCode: The device code issued to the client.
+//
+// Returns:
+//   - The device authorization, or nil if it does not exist or has expired.
+//   - An error if the lookup fails.
+func (d *DeviceCodeManager) GetByDeviceCode(ctx context.Context, deviceCode string) (*devicemodels.DeviceAuthorization, error) {
+	fields, err := d.codeStore.HGetAll(ctx, d.deviceKey(deviceCode)).Result()
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to get device code from store", errcode.ErrInternalFailure)
+	}
+	if len(fields) == 0 {
+		return nil, nil // Device code does not exist
+	}
+	return parseAuthorization(deviceCode, fields)
+}
+
+// GetByUserCode returns the device authorization identified by the user code.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userCode: The normalized user code entered by the user.
+//
+// Returns:
+//   - The device authorization, or nil if it does not exist or has expired.
+//   - An error if the lookup fails.
+func (d *DeviceCodeManager) GetByUserCode(ctx context.Context, userCode string) (*devicemodels.DeviceAuthorization, error) {
+	deviceCode, err := d.codeStore.Get(ctx, d.userCodeKey(userCode)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil // User code does not exist
+		}
+		return nil, errors.New(err.Error(), "Failed to get user code from store", errcode.ErrInternalFailure)
+	}
+	return d.GetByDeviceCode(ctx, deviceCode)
+}
+
+// UpdatePolling records a poll of the token endpoint and the interval the client must respect afterwards.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - authorization: The device authorization being polled.
+//   - polledAt: The time of the poll.
+//   - interval: The minimum interval before the next poll.
+//
+// Returns:
+//   - A boolean indicating whether the authorization still existed and was updated.
+//   - An error if the update fails.
+func (d *DeviceCodeManager) UpdatePolling(ctx context.Context, authorization *devicemodels.DeviceAuthorization, polledAt time.Time, interval time.Duration) (bool, error) {
+	return d.update(ctx, authorization,
+		fieldLastPolledAt, polledAt.UnixMilli(),
+		fieldInterval, interval.Milliseconds(),
+	)
+}
+
+// SetDecision records the user's decision on a pending device authorization.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - authorization: The pending device authorization.
+//   - status: The decision, either approved or denied.
+//   - userID: The unique identifier of the user who made the decision.
+//
+// Returns:
+//   - A boolean indicating whether the authorization still existed and was updated.
+//   - An error if the update fails.
+func (d *DeviceCodeManager) SetDecision(ctx context.Context, authorization *devicemodels.DeviceAuthorization, status devicemodels.DeviceStatus, userID uuid.UUID) (bool, error) {
+	return d.update(ctx, authorization,
+		fieldStatus, string(status),
+		fieldUserID, userID.String(),
+	)
+}
+
+// update sets the fields of the device authorization if it still exists.
+func (d *DeviceCodeManager) update(ctx context.Context, authorization *devicemodels.DeviceAuthorization, fieldValues ...any) (bool, error) {
+	args := append([]any{authorization.ExpiresAt.UnixMilli()}, fieldValues...)
+	updated, err := updateScript.Run(ctx, d.codeStore, []string{d.deviceKey(authorization.DeviceCode)}, args...).Int()
+	if err != nil {
+		return false, errors.New(err.Error(), "Failed to update device code", errcode.ErrInternalFailure)
+	}
+	return updated == 1, nil
+}
+
+// Consume deletes the device authorization so that it can be redeemed only once.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - authorization: The device authorization to consume.
+//
+// Returns:
+//   - A boolean indicating whether this call consumed the authorization.
+//   - An error if the deletion fails.
+func (d *DeviceCodeManager) Consume(ctx context.Context, authorization *devicemodels.DeviceAuthorization) (bool, error) {
+	deleted, err := d.codeStore.Del(ctx, d.deviceKey(authorization.DeviceCode)).Result()
+	if err != nil {
+		return false, errors.New(err.Error(), "Failed to delete device code from store", errcode.ErrInternalFailure)
+	}
+	if err := d.codeStore.Del(ctx, d.userCodeKey(authorization.UserCode)).Err(); err != nil {
+		return false, errors.New(err.Error(), "Failed to delete user code from store", errcode.ErrInternalFailure)
+	}
+	return deleted == 1, nil
+}
+
+// parseAuthorization converts the stored hash fields into a device authorization.
+func parseAuthorization(deviceCode string, fields map[string]string) (*devicemodels.DeviceAuthorization, error) {
+	interval, err := strconv.ParseInt(fields[fieldInterval], 10, 64)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Invalid device code record", errcode.ErrInternalFailure)
+	}
+	lastPolledAt, err := strconv.ParseInt(fields[fieldLastPolledAt], 10, 64)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Invalid device code record", errcode.ErrInternalFailure)
+	}
+	expiresAt, err := strconv.ParseInt(fields[fieldExpiresAt], 10, 64)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Invalid device code record", errcode.ErrInternalFailure)
+	}
+
+	authorization := &devicemodels.DeviceAuthorization{
+		DeviceCode: deviceCode,
+		UserCode:   fields[fieldUserCode],
+		ClientID:   fields[fieldClientID],
+		Status:     devicemodels.DeviceStatus(fields[fieldStatus]),
+		Interval:   time.Duration(interval) * time.Millisecond,
+		ExpiresAt:  time.UnixMilli(expiresAt),
+	}
+	if lastPolledAt > 0 {
+		authorization.LastPolledAt = time.UnixMilli(lastPolledAt)
+	}
+	if rawUserID, ok := fields[fieldUserID]; ok && rawUserID != "" {
+		userID, err := uuid.Parse(rawUserID)
+		if err != nil {
+			return nil, errors.New(err.Error(), "Invalid device code record", errcode.ErrInternalFailure)
+		}
+		authorization.UserID = userID
+	}
+	return authorization, nil
+}
+
+func NewDeviceCodeManager(
+	deviceCodeGen *util.RandomGenerator,
+	userCodeGen *util.UserCodeGenerator,
+	codeTTL time.Duration,
+	interval time.Duration,
+	codeStore *redis.Client,
+	prefix string,
+) *DeviceCodeManager {
+	return &DeviceCodeManager{
+		deviceCodeGen: deviceCodeGen,
+		userCodeGen:   userCodeGen,
+		codeTTL:       codeTTL,
+		interval:      interval,
+		codeStore:     codeStore,
+		prefix:        prefix,
+	}
+}
@@ -0,0 +1,52 @@
+package orgrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userv1 "github.com/mandacode-com/accounts-proto/go/user/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type OrganizationRepository struct {
+	client userv1.OrganizationServiceClient
+}
+
+// GetMembershipRole retrieves the role of the user in an organization from the user service.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The ID of the user.
+//   - orgID: The ID of the organization.
+//
+// Returns:
+//   - role: The role of the user in the organization.
+//   - error: An error with the ErrForbidden code if the user is not a member of the organization, otherwise nil
+//     unless the lookup fails.
+func (r *OrganizationRepository) GetMembershipRole(ctx context.Context, userID uuid.UUID, orgID uuid.UUID) (string, error) {
+	resp, err := r.client.GetMembership(ctx, &userv1.GetMembershipRequest{
+		OrgId:  orgID.String(),
+		UserId: userID.String(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", errors.New("user is not a member of the organization", "Forbidden", errcode.ErrForbidden)
+		}
+		return "", errors.Upgrade(err, "Failed to get organization membership", errcode.ErrInternalFailure)
+	}
+	if err := resp.ValidateAll(); err != nil {
+		return "", errors.Upgrade(err, "Invalid response from user service", errcode.ErrInternalFailure)
+	}
+	return resp.Membership.Role, nil
+}
+
+// NewOrganizationRepository creates a new OrganizationRepository backed by the organization service of the user
+// service.
+func NewOrganizationRepository(client userv1.OrganizationServiceClient) *OrganizationRepository {
+	return &OrganizationRepository{
+		client: client,
+	}
+}
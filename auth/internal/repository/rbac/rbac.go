@@ -0,0 +1,64 @@
+package rbacrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userv1 "github.com/mandacode-com/accounts-proto/go/user/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+)
+
+type RBACRepository struct {
+	client userv1.RBACServiceClient
+}
+
+// HasPermission reports whether the roles of the user grant the permission, as checked by the user service.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The ID of the user.
+//   - permission: The permission to check.
+//
+// Returns:
+//   - allowed: Whether the roles of the user grant the permission.
+//   - error: An error if the check fails, otherwise nil.
+func (r *RBACRepository) HasPermission(ctx context.Context, userID uuid.UUID, permission string) (bool, error) {
+	resp, err := r.client.CheckPermission(ctx, &userv1.CheckPermissionRequest{
+		UserId:     userID.String(),
+		Permission: permission,
+	})
+	if err != nil {
+		return false, errors.Upgrade(err, "Failed to check permission", errcode.ErrInternalFailure)
+	}
+	return resp.Allowed, nil
+}
+
+// GrantsAnyPermission reports whether the roles of the user grant any permission, which makes the user an admin.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The ID of the user.
+//
+// Returns:
+//   - granted: Whether the roles of the user grant at least one permission.
+//   - error: An error if the roles could not be read, otherwise nil.
+func (r *RBACRepository) GrantsAnyPermission(ctx context.Context, userID uuid.UUID) (bool, error) {
+	resp, err := r.client.GetUserRoles(ctx, &userv1.GetUserRolesRequest{
+		UserId: userID.String(),
+	})
+	if err != nil {
+		return false, errors.Upgrade(err, "Failed to get user roles", errcode.ErrInternalFailure)
+	}
+	if err := resp.ValidateAll(); err != nil {
+		return false, errors.Upgrade(err, "Invalid response from RBAC service", errcode.ErrInternalFailure)
+	}
+	return len(resp.Roles.Permissions) > 0, nil
+}
+
+// NewRBACRepository creates a new RBACRepository backed by the RBAC service of the user service.
+func NewRBACRepository(client userv1.RBACServiceClient) *RBACRepository {
+	return &RBACRepository{
+		client: client,
+	}
+}
@@ -0,0 +1,93 @@
+package restorerepo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"github.com/redis/go-redis/v9"
+	restoremodels "mandacode.com/accounts/auth/internal/models/restore"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+// TokenManager stores restore requests under single use tokens.
+type TokenManager struct {
+	tokenGen   *util.RandomGenerator
+	tokenTTL   time.Duration
+	tokenStore *redis.Client
+	prefix     string
+}
+
+func (m *TokenManager) tokenKey(token string) string {
+	return m.prefix + util.HashToken(token)
+}
+
+// IssueToken stores a restore request.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - restore: The restore request.
+//
+// Returns:
+//   - The token redeeming the request.
+//   - An error if the request could not be stored.
+func (m *TokenManager) IssueToken(ctx context.Context, restore *restoremodels.Restore) (string, error) {
+	data, err := json.Marshal(restore)
+	if err != nil {
+		return "", errors.New(err.Error(), "Failed to encode restore request", errcode.ErrInternalFailure)
+	}
+	token, err := m.tokenGen.GenerateSecureRandomCode()
+	if err != nil {
+		return "", errors.New(err.Error(), "Failed to generate restore token", errcode.ErrInternalFailure)
+	}
+	if err := m.tokenStore.Set(ctx, m.tokenKey(token), data, m.tokenTTL).Err(); err != nil {
+		return "", errors.New(err.Error(), "Failed to store restore request", errcode.ErrInternalFailure)
+	}
+	return token, nil
+}
+
+// ConsumeToken redeems a token, so that only one caller may complete its restore request.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - token: The token of the request.
+//
+// Returns:
+//   - The restore request, or nil if the token does not exist, expired or was used.
+//   - An error if the request could not be retrieved.
+func (m *TokenManager) ConsumeToken(ctx context.Context, token string) (*restoremodels.Restore, error) {
+	data, err := m.tokenStore.GetDel(ctx, m.tokenKey(token)).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, errors.New(err.Error(), "Failed to get restore request from store", errcode.ErrInternalFailure)
+	}
+
+	var restore restoremodels.Restore
+	if err := json.Unmarshal(data, &restore); err != nil {
+		return nil, errors.New(err.Error(), "Invalid restore request record", errcode.ErrInternalFailure)
+	}
+	return &restore, nil
+}
+
+// TokenTTL returns how long an issued token remains valid.
+func (m *TokenManager) TokenTTL() time.Duration {
+	return m.tokenTTL
+}
+
+func NewTokenManager(
+	tokenGen *util.RandomGenerator,
+	tokenTTL time.Duration,
+	tokenStore *redis.Client,
+	prefix string,
+) *TokenManager {
+	return &TokenManager{
+		tokenGen:   tokenGen,
+		tokenTTL:   tokenTTL,
+		tokenStore: tokenStore,
+		prefix:     prefix,
+	}
+}
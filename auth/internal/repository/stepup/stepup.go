@@ -0,0 +1,149 @@
+package stepuprepo
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"time"
+
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"github.com/redis/go-redis/v9"
+	stepupmodels "mandacode.com/accounts/auth/internal/models/stepup"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+const (
+	fieldChallenge = "challenge"
+	fieldCodeHash  = "code_hash"
+	fieldAttempts  = "attempts"
+)
+
+type ChallengeManager struct {
+	challengeIDGen *util.RandomGenerator
+	codeGen        *util.NumericCodeGenerator
+	challengeTTL   time.Duration
+	maxAttempts    int
+	codeStore      *redis.Client
+	prefix         string
+}
+
+func (m *ChallengeManager) challengeKey(challengeID string) string {
+	return m.prefix + challengeID
+}
+
+// IssueChallenge stores a step-up challenge and the code confirming it.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - challenge: The sign in held back by the challenge.
+//
+// Returns:
+//   - The identifier of the challenge, given to the client.
+//   - The code confirming the challenge, sent to the user.
+//   - An error if the challenge could not be stored.
+func (m *ChallengeManager) IssueChallenge(ctx context.Context, challenge *stepupmodels.Challenge) (challengeID string, code string, err error) {
+	data, err := json.Marshal(challenge)
+	if err != nil {
+		return "", "", errors.New(err.Error(), "Failed to encode step-up challenge", errcode.ErrInternalFailure)
+	}
+	challengeID, err = m.challengeIDGen.GenerateSecureRandomCode()
+	if err != nil {
+		return "", "", errors.New(err.Error(), "Failed to generate challenge ID", errcode.ErrInternalFailure)
+	}
+	code, err = m.codeGen.GenerateNumericCode()
+	if err != nil {
+		return "", "", errors.New(err.Error(), "Failed to generate step-up code", errcode.ErrInternalFailure)
+	}
+
+	key := m.challengeKey(challengeID)
+	_, err = m.codeStore.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key,
+			fieldChallenge, data,
+			fieldCodeHash, util.HashToken(code),
+			fieldAttempts, 0,
+		)
+		pipe.Expire(ctx, key, m.challengeTTL)
+		return nil
+	})
+	if err != nil {
+		return "", "", errors.New(err.Error(), "Failed to store step-up challenge", errcode.ErrInternalFailure)
+	}
+	return challengeID, code, nil
+}
+
+// VerifyChallenge checks the code of a step-up challenge and consumes the challenge if it matches.
+//
+// Every check counts as an attempt; the challenge is discarded once the attempts are exhausted.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - challengeID: The identifier of the challenge.
+//   - code: The code entered by the user.
+//
+// Returns:
+//   - The challenge if the code matched, or nil if it did not, or the challenge does not exist.
+//   - An error if the verification fails.
+func (m *ChallengeManager) VerifyChallenge(ctx context.Context, challengeID string, code string) (*stepupmodels.Challenge, error) {
+	key := m.challengeKey(challengeID)
+	fields, err := m.codeStore.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to get step-up challenge from store", errcode.ErrInternalFailure)
+	}
+	if len(fields) == 0 {
+		return nil, nil // Challenge does not exist
+	}
+
+	// Count the attempt before comparing, so concurrent guesses cannot exceed the limit
+	attempts, err := m.codeStore.HIncrBy(ctx, key, fieldAttempts, 1).Result()
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to update step-up challenge", errcode.ErrInternalFailure)
+	}
+	if attempts > int64(m.maxAttempts) {
+		if err := m.codeStore.Del(ctx, key).Err(); err != nil {
+			return nil, errors.New(err.Error(), "Failed to delete step-up challenge from store", errcode.ErrInternalFailure)
+		}
+		return nil, nil
+	}
+	if subtle.ConstantTimeCompare([]byte(fields[fieldCodeHash]), []byte(util.HashToken(code))) != 1 {
+		return nil, nil
+	}
+
+	// Consume the challenge; only the caller which deletes it may complete the sign in
+	deleted, err := m.codeStore.Del(ctx, key).Result()
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to delete step-up challenge from store", errcode.ErrInternalFailure)
+	}
+	if deleted != 1 {
+		return nil, nil
+	}
+
+	var challenge stepupmodels.Challenge
+	if err := json.Unmarshal([]byte(fields[fieldChallenge]), &challenge); err != nil {
+		return nil, errors.New(err.Error(), "Invalid step-up challenge record", errcode.ErrInternalFailure)
+	}
+	return &challenge, nil
+}
+
+// ChallengeTTL returns how long an issued challenge remains valid.
+func (m *ChallengeManager) ChallengeTTL() time.Duration {
+	return m.challengeTTL
+}
+
+func NewChallengeManager(
+	challengeIDGen *util.RandomGenerator,
+	codeGen *util.NumericCodeGenerator,
+	challengeTTL time.Duration,
+	maxAttempts int,
+	codeStore *redis.Client,
+	prefix string,
+) *ChallengeManager {
+	return &ChallengeManager{
+		challengeIDGen: challengeIDGen,
+		codeGen:        codeGen,
+		challengeTTL:   challengeTTL,
+		maxAttempts:    maxAttempts,
+		codeStore:      codeStore,
+		prefix:         prefix,
+	}
+}
@@ -8,13 +8,17 @@ import (
 	"github.com/mandacode-com/golib/errors"
 	"github.com/mandacode-com/golib/errors/errcode"
 	tokenmodels "mandacode.com/accounts/auth/internal/models/token"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
 )
 
 type TokenRepository struct {
-	client tokenv1.TokenServiceClient
+	client              tokenv1.TokenServiceClient
+	impersonationClient tokenv1.ImpersonationServiceClient
+	userStatus          *dbrepo.UserStatusRepository
 }
 
-// GenerateAccessToken creates a new access token for the user.
+// GenerateAccessToken creates a new access token for the user, carrying the roles of the user projected from the
+// user events.
 //
 // Parameters:
 //   - ctx: The context for the operation.
@@ -25,7 +29,14 @@ type TokenRepository struct {
 //   - expiresAt: The expiration time of the token in Unix timestamp format.
 //   - error: An error if the token generation fails, otherwise nil.
 func (t *TokenRepository) GenerateAccessToken(ctx context.Context, userID uuid.UUID) (string, int64, error) {
-	resp, err := t.client.GenerateAccessToken(ctx, &tokenv1.GenerateAccessTokenRequest{UserId: userID.String()})
+	status, err := t.userStatus.GetUserStatus(ctx, userID)
+	if err != nil {
+		return "", 0, err
+	}
+	resp, err := t.client.GenerateAccessToken(ctx, &tokenv1.GenerateAccessTokenRequest{
+		UserId: userID.String(),
+		Roles:  status.Roles,
+	})
 	if err != nil {
 		return "", 0, errors.Upgrade(err, "Failed to generate access token", errcode.ErrInternalFailure)
 	}
@@ -35,6 +46,92 @@ func (t *TokenRepository) GenerateAccessToken(ctx context.Context, userID uuid.U
 	return resp.Token, resp.ExpiresAt, nil
 }
 
+// GenerateOrgAccessToken creates a new access token for the user acting within one of their organizations. Like
+// GenerateAccessToken, the token carries the roles of the user, along with the organization and the role of the
+// user in it.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The ID of the user for whom the access token is generated.
+//   - orgID: The ID of the active organization of the user.
+//   - orgRole: The role of the user in the organization.
+//
+// Returns:
+//   - token: The generated access token.
+//   - expiresAt: The expiration time of the token in Unix timestamp format.
+//   - error: An error if the token generation fails, otherwise nil.
+func (t *TokenRepository) GenerateOrgAccessToken(ctx context.Context, userID uuid.UUID, orgID uuid.UUID, orgRole string) (string, int64, error) {
+	status, err := t.userStatus.GetUserStatus(ctx, userID)
+	if err != nil {
+		return "", 0, err
+	}
+	resp, err := t.client.GenerateOrgAccessToken(ctx, &tokenv1.GenerateOrgAccessTokenRequest{
+		UserId:  userID.String(),
+		Roles:   status.Roles,
+		OrgId:   orgID.String(),
+		OrgRole: orgRole,
+	})
+	if err != nil {
+		return "", 0, errors.Upgrade(err, "Failed to generate access token", errcode.ErrInternalFailure)
+	}
+	if err := resp.ValidateAll(); err != nil {
+		return "", 0, errors.Upgrade(err, "Invalid response from token service", errcode.ErrInternalFailure)
+	}
+	return resp.Token, resp.ExpiresAt, nil
+}
+
+// GenerateClientAccessToken creates a new access token whose subject is an OAuth client. The token carries the
+// client ID and the granted scopes, and is rejected wherever a user access token is expected.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - clientID: The client ID of the client for which the access token is generated.
+//   - scopes: The scopes granted to the client.
+//
+// Returns:
+//   - token: The generated access token.
+//   - expiresAt: The expiration time of the token in Unix timestamp format.
+//   - error: An error if the token generation fails, otherwise nil.
+func (t *TokenRepository) GenerateClientAccessToken(ctx context.Context, clientID string, scopes []string) (string, int64, error) {
+	resp, err := t.client.GenerateClientAccessToken(ctx, &tokenv1.GenerateClientAccessTokenRequest{
+		ClientId: clientID,
+		Scopes:   scopes,
+	})
+	if err != nil {
+		return "", 0, errors.Upgrade(err, "Failed to generate client access token", errcode.ErrInternalFailure)
+	}
+	if err := resp.ValidateAll(); err != nil {
+		return "", 0, errors.Upgrade(err, "Invalid response from token service", errcode.ErrInternalFailure)
+	}
+	return resp.Token, resp.ExpiresAt, nil
+}
+
+// GenerateImpersonationToken creates a short-lived access token letting an admin act as the user. The admin is
+// carried in the act claim of the token.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The ID of the impersonated user.
+//   - actorID: The ID of the admin impersonating the user.
+//
+// Returns:
+//   - token: The generated access token.
+//   - expiresAt: The expiration time of the token in Unix timestamp format.
+//   - error: An error if the token generation fails, otherwise nil.
+func (t *TokenRepository) GenerateImpersonationToken(ctx context.Context, userID uuid.UUID, actorID uuid.UUID) (string, int64, error) {
+	resp, err := t.impersonationClient.GenerateImpersonationToken(ctx, &tokenv1.GenerateImpersonationTokenRequest{
+		UserId:  userID.String(),
+		ActorId: actorID.String(),
+	})
+	if err != nil {
+		return "", 0, errors.Upgrade(err, "Failed to generate impersonation token", errcode.ErrInternalFailure)
+	}
+	if err := resp.ValidateAll(); err != nil {
+		return "", 0, errors.Upgrade(err, "Invalid response from token service", errcode.ErrInternalFailure)
+	}
+	return resp.Token, resp.ExpiresAt, nil
+}
+
 // GenerateEmailVerificationToken creates a new email verification token for the user.
 //
 // Parameters:
@@ -97,6 +194,29 @@ func (t *TokenRepository) VerifyAccessToken(ctx context.Context, token string) (
 	return resp.Valid, resp.UserId, nil
 }
 
+// VerifyAccessTokenActor checks if the provided access token is valid, and returns the admin acting as the user
+// for impersonation tokens.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - token: The access token to verify.
+//
+// Returns:
+//   - valid: A boolean indicating whether the token is valid.
+//   - userID: The ID of the user associated with the token if valid, otherwise nil.
+//   - actorID: The ID of the admin impersonating the user, or nil if the token is not an impersonation token.
+//   - error: An error if the verification fails, otherwise nil.
+func (t *TokenRepository) VerifyAccessTokenActor(ctx context.Context, token string) (bool, *string, *string, error) {
+	resp, err := t.impersonationClient.VerifyAccessTokenActor(ctx, &tokenv1.VerifyAccessTokenActorRequest{Token: token})
+	if err != nil {
+		return false, nil, nil, errors.Upgrade(err, "Failed to verify access token", errcode.ErrInternalFailure)
+	}
+	if err := resp.ValidateAll(); err != nil {
+		return false, nil, nil, errors.Upgrade(err, "Invalid response from token service", errcode.ErrInternalFailure)
+	}
+	return resp.Valid, resp.UserId, resp.ActorId, nil
+}
+
 // VerifyEmailVerificationToken checks if the provided email verification token is valid.
 //
 // Parameters:
@@ -149,6 +269,6 @@ func (t *TokenRepository) VerifyRefreshToken(ctx context.Context, token string)
 	return resp.Valid, resp.UserId, nil
 }
 
-func NewTokenRepository(client tokenv1.TokenServiceClient) *TokenRepository {
-	return &TokenRepository{client: client}
+func NewTokenRepository(client tokenv1.TokenServiceClient, impersonationClient tokenv1.ImpersonationServiceClient, userStatus *dbrepo.UserStatusRepository) *TokenRepository {
+	return &TokenRepository{client: client, impersonationClient: impersonationClient, userStatus: userStatus}
 }
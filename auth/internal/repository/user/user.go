@@ -0,0 +1,51 @@
+package userrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userv1 "github.com/mandacode-com/accounts-proto/go/user/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UserRepository struct {
+	client userv1.UserManagementServiceClient
+}
+
+// RestoreUser restores an archived user in the user service, which emits USER_RESTORED. The user is recorded as
+// restoring their own account.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The ID of the user to restore.
+//
+// Returns:
+//   - error: An error with the ErrNotFound code if the user does not exist, otherwise nil unless the restore
+//     fails.
+func (r *UserRepository) RestoreUser(ctx context.Context, userID uuid.UUID) error {
+	actorID := userID.String()
+	resp, err := r.client.RestoreUser(ctx, &userv1.RestoreUserRequest{
+		UserId:  userID.String(),
+		ActorId: &actorID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return errors.New("user not found", "User Not Found", errcode.ErrNotFound)
+		}
+		return errors.Upgrade(err, "Failed to restore user", errcode.ErrInternalFailure)
+	}
+	if err := resp.ValidateAll(); err != nil {
+		return errors.Upgrade(err, "Invalid response from user service", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// NewUserRepository creates a new UserRepository backed by the user management service of the user service.
+func NewUserRepository(client userv1.UserManagementServiceClient) *UserRepository {
+	return &UserRepository{
+		client: client,
+	}
+}
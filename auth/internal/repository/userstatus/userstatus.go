@@ -0,0 +1,81 @@
+package userstatusrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userv1 "github.com/mandacode-com/accounts-proto/go/user/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+)
+
+type UserStatusRepository struct {
+	client userv1.UserStatusServiceClient
+}
+
+// ListUserStatuses retrieves a page of the statuses of the users, ordered by ID, from the user service.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - after: The ID of the last user of the previous page, or nil for the first page.
+//   - pageSize: The maximum number of statuses to retrieve.
+//
+// Returns:
+//   - statuses: The statuses of the page, as changes to apply along with their version.
+//   - next: The ID of the last user of the page, or nil on the last page.
+//   - error: An error if the retrieval fails, otherwise nil.
+func (r *UserStatusRepository) ListUserStatuses(ctx context.Context, after *uuid.UUID, pageSize int) ([]*dbmodels.UpdateUserStatusInput, *uuid.UUID, error) {
+	req := &userv1.ListUserStatusesRequest{
+		PageSize: int32(pageSize),
+	}
+	if after != nil {
+		afterID := after.String()
+		req.AfterUserId = &afterID
+	}
+	resp, err := r.client.ListUserStatuses(ctx, req)
+	if err != nil {
+		return nil, nil, errors.Upgrade(err, "Failed to list user statuses", errcode.ErrInternalFailure)
+	}
+	if err := resp.ValidateAll(); err != nil {
+		return nil, nil, errors.Upgrade(err, "Invalid user statuses", errcode.ErrInternalFailure)
+	}
+
+	statuses := make([]*dbmodels.UpdateUserStatusInput, 0, len(resp.Statuses))
+	for _, status := range resp.Statuses {
+		userID, err := uuid.Parse(status.UserId)
+		if err != nil {
+			return nil, nil, errors.Upgrade(err, "Invalid user ID in user status", errcode.ErrInternalFailure)
+		}
+		roles := status.Roles
+		if roles == nil {
+			roles = []string{}
+		}
+		statuses = append(statuses, &dbmodels.UpdateUserStatusInput{
+			UserID:      userID,
+			IsActive:    &status.IsActive,
+			IsBlocked:   &status.IsBlocked,
+			IsArchived:  &status.IsArchived,
+			Roles:       roles,
+			SyncVersion: &status.SyncVersion,
+		})
+	}
+
+	var next *uuid.UUID
+	if resp.NextUserId != nil {
+		nextID, err := uuid.Parse(resp.GetNextUserId())
+		if err != nil {
+			return nil, nil, errors.Upgrade(err, "Invalid next user ID", errcode.ErrInternalFailure)
+		}
+		next = &nextID
+	}
+	return statuses, next, nil
+}
+
+// NewUserStatusRepository creates a new UserStatusRepository backed by the user status service of the user
+// service.
+func NewUserStatusRepository(client userv1.UserStatusServiceClient) *UserStatusRepository {
+	return &UserStatusRepository{
+		client: client,
+	}
+}
@@ -0,0 +1,156 @@
+package apikey
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	apikeydto "mandacode.com/accounts/auth/internal/usecase/apikey/dto"
+	"mandacode.com/accounts/auth/internal/usecase/userstatus"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+// keyPrefix marks personal API keys so that they are recognizable, e.g. by secret scanners.
+const keyPrefix = "mak_"
+
+type APIKeyUsecase struct {
+	apiKey        *dbrepo.APIKeyRepository
+	userStatus    *userstatus.UserStatusUsecase
+	prefixGen     *util.RandomGenerator
+	secretGen     *util.RandomGenerator
+	allowedScopes []string
+	maxPerUser    int
+}
+
+// CreateAPIKey creates a new personal API key for the user.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The unique identifier of the user who owns the key.
+//   - input: The name, scopes and optional expiry of the key.
+//
+// Returns:
+//   - output: The stored key and the full key, which cannot be retrieved again.
+//   - err: An error if the input is invalid, the user has too many keys, or creation fails.
+func (a *APIKeyUsecase) CreateAPIKey(ctx context.Context, userID uuid.UUID, input apikeydto.CreateAPIKeyInput) (*apikeydto.CreatedAPIKey, error) {
+	for _, scope := range input.Scopes {
+		if !slices.Contains(a.allowedScopes, scope) {
+			return nil, errors.New("scope is not allowed for API keys: "+scope, "Invalid Scope", errcode.ErrInvalidInput)
+		}
+	}
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(time.Now()) {
+		return nil, errors.New("expiry must be in the future", "Invalid Expiry", errcode.ErrInvalidInput)
+	}
+
+	count, err := a.apiKey.CountActiveAPIKeysByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= a.maxPerUser {
+		return nil, errors.New("API key limit reached", "Too Many API Keys", errcode.ErrConflict)
+	}
+
+	prefix, err := a.prefixGen.GenerateSecureRandomCode()
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to generate API key", errcode.ErrInternalFailure)
+	}
+	secret, err := a.secretGen.GenerateSecureRandomCode()
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to generate API key", errcode.ErrInternalFailure)
+	}
+	displayPrefix := keyPrefix + prefix
+	key := displayPrefix + "_" + secret
+
+	apiKey, err := a.apiKey.CreateAPIKey(ctx, &dbmodels.CreateAPIKeyInput{
+		UserID:    userID,
+		Name:      input.Name,
+		Prefix:    displayPrefix,
+		KeyHash:   util.HashToken(key),
+		Scopes:    input.Scopes,
+		ExpiresAt: input.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apikeydto.CreatedAPIKey{APIKey: apiKey, Key: key}, nil
+}
+
+// ListAPIKeys lists the API keys of the user which have not been revoked.
+func (a *APIKeyUsecase) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]*dbmodels.SecureAPIKey, error) {
+	return a.apiKey.ListAPIKeysByUserID(ctx, userID)
+}
+
+// RevokeAPIKey revokes an API key of the user.
+func (a *APIKeyUsecase) RevokeAPIKey(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	return a.apiKey.RevokeAPIKey(ctx, userID, id)
+}
+
+// VerifyAPIKey resolves the owner and scopes of an API key presented to a service.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - key: The full API key.
+//
+// Returns:
+//   - output: The user ID of the owner and the scopes of the key.
+//   - err: An unauthorized error if the key is unknown, revoked, suspended or expired, or if its owner is
+//     blocked, inactive or pending deletion.
+func (a *APIKeyUsecase) VerifyAPIKey(ctx context.Context, key string) (*apikeydto.VerifyAPIKeyOutput, error) {
+	if !strings.HasPrefix(key, keyPrefix) {
+		return nil, errors.New("malformed API key", "Invalid API Key", errcode.ErrUnauthorized)
+	}
+
+	apiKey, err := a.apiKey.GetAPIKeyByHash(ctx, util.HashToken(key))
+	if err != nil {
+		if errors.Is(err, errcode.ErrNotFound) {
+			return nil, errors.New("unknown API key", "Invalid API Key", errcode.ErrUnauthorized)
+		}
+		return nil, err
+	}
+	if !apiKey.IsUsable(time.Now()) {
+		return nil, errors.New("API key is revoked, suspended or expired", "Invalid API Key", errcode.ErrUnauthorized)
+	}
+	// Keys are only suspended by the block and delete events, so the owner's status is checked as well to
+	// cover inactive and archived users, and events which have not been consumed yet
+	if err := a.userStatus.Check(ctx, apiKey.UserID); err != nil {
+		if errors.Is(err, errcode.ErrAccountDisabled) {
+			return nil, errors.New(err.Error(), "Invalid API Key", errcode.ErrUnauthorized)
+		}
+		return nil, err
+	}
+
+	if err := a.apiKey.TouchLastUsed(ctx, apiKey.ID); err != nil {
+		return nil, err
+	}
+
+	return &apikeydto.VerifyAPIKeyOutput{
+		UserID: apiKey.UserID,
+		Scopes: apiKey.Scopes,
+	}, nil
+}
+
+func NewAPIKeyUsecase(
+	apiKey *dbrepo.APIKeyRepository,
+	userStatus *userstatus.UserStatusUsecase,
+	prefixGen *util.RandomGenerator,
+	secretGen *util.RandomGenerator,
+	allowedScopes []string,
+	maxPerUser int,
+) *APIKeyUsecase {
+	return &APIKeyUsecase{
+		apiKey:        apiKey,
+		userStatus:    userStatus,
+		prefixGen:     prefixGen,
+		secretGen:     secretGen,
+		allowedScopes: allowedScopes,
+		maxPerUser:    maxPerUser,
+	}
+}
@@ -0,0 +1,24 @@
+package apikeydto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+)
+
+type CreateAPIKeyInput struct {
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type CreatedAPIKey struct {
+	APIKey *dbmodels.SecureAPIKey `json:"api_key"`
+	Key    string                 `json:"key"` // The full key, only returned once on creation
+}
+
+type VerifyAPIKeyOutput struct {
+	UserID uuid.UUID `json:"user_id"`
+	Scopes []string  `json:"scopes"`
+}
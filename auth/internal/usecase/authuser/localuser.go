@@ -11,18 +11,28 @@ import (
 )
 
 type LocalUserUsecase interface {
-	CreateLocalAuthUser(ctx context.Context, userID uuid.UUID, email string, password string) (*dbmodels.SecureLocalAuthAccount, error)
+	CreateLocalAuthUser(ctx context.Context, userID uuid.UUID, email string, password string, isActive *bool) (*dbmodels.SecureLocalAuthAccount, error)
 	DeleteAuthUser(ctx context.Context, userID uuid.UUID) error
 	UpdateAuthUserEmail(ctx context.Context, userID uuid.UUID, newEmail string) (*dbmodels.SecureAuthAccount, error)
 	UpdateLocalEmailVerificationStatus(ctx context.Context, userID uuid.UUID, isVerified bool) error
+	IsEmailAvailable(ctx context.Context, email string) (bool, error)
 }
 
 type localUserUsecase struct {
 	authAccountRepo *dbrepo.AuthAccountRepository
+	userStatusRepo  *dbrepo.UserStatusRepository
 }
 
 // CreateLocalAuthUser implements IAuthUserUsecase.
-func (a *localUserUsecase) CreateLocalAuthUser(ctx context.Context, userID uuid.UUID, email string, password string) (*dbmodels.SecureLocalAuthAccount, error) {
+//
+// The status of the user is recorded before their account if the user service gave it, so that the account
+// of a user created inactive is never usable.
+func (a *localUserUsecase) CreateLocalAuthUser(ctx context.Context, userID uuid.UUID, email string, password string, isActive *bool) (*dbmodels.SecureLocalAuthAccount, error) {
+	if isActive != nil {
+		if err := a.userStatusRepo.CreateUserStatus(ctx, userID, *isActive); err != nil {
+			return nil, err
+		}
+	}
 	account, err := a.authAccountRepo.CreateLocalAuthAccount(
 		ctx,
 		&dbmodels.CreateLocalAuthAccountInput{
@@ -54,6 +64,9 @@ func (a *localUserUsecase) UpdateAuthUserEmail(ctx context.Context, userID uuid.
 	}
 	updatedAccount, err := a.authAccountRepo.UpdateEmailByID(ctx, account.ID, newEmail)
 	if err != nil {
+		if errors.Is(err, errcode.ErrConflict) {
+			return nil, err
+		}
 		return nil, errors.Upgrade(err, "Failed to update local auth account email", errcode.ErrInternalFailure)
 	}
 
@@ -69,8 +82,20 @@ func (a *localUserUsecase) UpdateLocalEmailVerificationStatus(ctx context.Contex
 	return nil
 }
 
-func NewLocalUserUsecase(authAccountRepo *dbrepo.AuthAccountRepository) LocalUserUsecase {
+// IsEmailAvailable implements IAuthUserUsecase.
+func (a *localUserUsecase) IsEmailAvailable(ctx context.Context, email string) (bool, error) {
+	if _, err := a.authAccountRepo.GetLocalAuthAccountByEmail(ctx, email); err != nil {
+		if errors.Is(err, errcode.ErrNotFound) {
+			return true, nil
+		}
+		return false, errors.Upgrade(err, "Failed to check email availability", errcode.ErrInternalFailure)
+	}
+	return false, nil
+}
+
+func NewLocalUserUsecase(authAccountRepo *dbrepo.AuthAccountRepository, userStatusRepo *dbrepo.UserStatusRepository) LocalUserUsecase {
 	return &localUserUsecase{
 		authAccountRepo: authAccountRepo,
+		userStatusRepo:  userStatusRepo,
 	}
 }
@@ -13,18 +13,21 @@ import (
 )
 
 type OAuthUserUsecase interface {
-	CreateOAuthUser(ctx context.Context, userID uuid.UUID, provider authaccount.Provider, accessToken *string, code *string) (*dbmodels.SecureOAuthAuthAccount, error)
+	CreateOAuthUser(ctx context.Context, userID uuid.UUID, provider authaccount.Provider, accessToken *string, code *string, isActive *bool) (*dbmodels.SecureOAuthAuthAccount, error)
 	DeleteOAuthUser(ctx context.Context, userID uuid.UUID) error
 	SyncOAuthUser(ctx context.Context, userID uuid.UUID, provider authaccount.Provider, accessToken *string, code *string) (*dbmodels.SecureOAuthAuthAccount, error)
 }
 
 type oauthUserUsecase struct {
 	authAccountRepo *dbrepo.AuthAccountRepository
+	userStatusRepo  *dbrepo.UserStatusRepository
 	oauthApiMap     map[authaccount.Provider]oauthapi.OAuthAPI
 }
 
 // CreateOAuthUser implements IAuthUserUsecase.
-func (a *oauthUserUsecase) CreateOAuthUser(ctx context.Context, userID uuid.UUID, provider authaccount.Provider, accessToken *string, code *string) (*dbmodels.SecureOAuthAuthAccount, error) {
+//
+// As for local users, the status given by the user service is recorded before the account.
+func (a *oauthUserUsecase) CreateOAuthUser(ctx context.Context, userID uuid.UUID, provider authaccount.Provider, accessToken *string, code *string, isActive *bool) (*dbmodels.SecureOAuthAuthAccount, error) {
 	api, ok := a.oauthApiMap[provider]
 	if !ok {
 		return nil, errors.New("unsupported provider: "+string(provider), "UnsupportedProvider", errcode.ErrInvalidInput)
@@ -48,6 +51,11 @@ func (a *oauthUserUsecase) CreateOAuthUser(ctx context.Context, userID uuid.UUID
 		return nil, errors.Upgrade(err, "Failed to get user info from OAuth provider", errcode.ErrUnauthorized)
 	}
 
+	if isActive != nil {
+		if err := a.userStatusRepo.CreateUserStatus(ctx, userID, *isActive); err != nil {
+			return nil, err
+		}
+	}
 	account, err := a.authAccountRepo.CreateOAuthAuthAccount(
 		ctx,
 		&dbmodels.CreateOAuthAuthAccountInput{
@@ -118,9 +126,10 @@ func (a *oauthUserUsecase) SyncOAuthUser(ctx context.Context, userID uuid.UUID,
 	return account, nil
 }
 
-func NewOAuthUserUsecase(authAccountRepo *dbrepo.AuthAccountRepository, oauthApis map[authaccount.Provider]oauthapi.OAuthAPI) OAuthUserUsecase {
+func NewOAuthUserUsecase(authAccountRepo *dbrepo.AuthAccountRepository, userStatusRepo *dbrepo.UserStatusRepository, oauthApis map[authaccount.Provider]oauthapi.OAuthAPI) OAuthUserUsecase {
 	return &oauthUserUsecase{
 		authAccountRepo: authAccountRepo,
+		userStatusRepo:  userStatusRepo,
 		oauthApiMap:     oauthApis,
 	}
 }
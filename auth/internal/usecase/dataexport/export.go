@@ -0,0 +1,93 @@
+package dataexport
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+)
+
+// ExportedLoginAttempt is a login attempt as written to a data export. Unlike SecureLoginAttempt, it holds the
+// device and the coordinates the attempt was located at.
+type ExportedLoginAttempt struct {
+	*dbmodels.SecureLoginAttempt
+	DeviceID  string   `json:"device_id,omitempty"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+}
+
+// UserData is everything the auth service holds about a user, without secrets: password hashes, refresh token
+// hashes and API key hashes are left out.
+type UserData struct {
+	UserID       uuid.UUID                     `json:"user_id"`
+	Status       *dbmodels.SecureUserStatus    `json:"status"`
+	Accounts     []*dbmodels.SecureAuthAccount `json:"accounts"`
+	Sessions     []*dbmodels.SecureSession     `json:"sessions"`
+	APIKeys      []*dbmodels.SecureAPIKey      `json:"api_keys"`
+	LoginHistory []*ExportedLoginAttempt       `json:"login_history"`
+	ExportedAt   time.Time                     `json:"exported_at"`
+}
+
+type ExportUsecase struct {
+	authAccount  *dbrepo.AuthAccountRepository
+	session      *dbrepo.SessionRepository
+	apiKey       *dbrepo.APIKeyRepository
+	loginAttempt *dbrepo.LoginAttemptRepository
+	userStatus   *dbrepo.UserStatusRepository
+}
+
+// NewExportUsecase creates a new ExportUsecase.
+func NewExportUsecase(
+	authAccount *dbrepo.AuthAccountRepository,
+	session *dbrepo.SessionRepository,
+	apiKey *dbrepo.APIKeyRepository,
+	loginAttempt *dbrepo.LoginAttemptRepository,
+	userStatus *dbrepo.UserStatusRepository,
+) *ExportUsecase {
+	return &ExportUsecase{
+		authAccount:  authAccount,
+		session:      session,
+		apiKey:       apiKey,
+		loginAttempt: loginAttempt,
+		userStatus:   userStatus,
+	}
+}
+
+// ExportUserData collects the data of a user for their data export.
+func (u *ExportUsecase) ExportUserData(ctx context.Context, userID uuid.UUID) (*UserData, error) {
+	data := &UserData{
+		UserID:     userID,
+		ExportedAt: time.Now(),
+	}
+
+	var err error
+	if data.Status, err = u.userStatus.GetUserStatus(ctx, userID); err != nil {
+		return nil, err
+	}
+	if data.Accounts, err = u.authAccount.GetAuthAccountsByUserID(ctx, userID); err != nil {
+		return nil, err
+	}
+	if data.Sessions, err = u.session.ListAllSessionsByUserID(ctx, userID); err != nil {
+		return nil, err
+	}
+	if data.APIKeys, err = u.apiKey.ListAllAPIKeysByUserID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	attempts, err := u.loginAttempt.ListAllLoginAttemptsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	data.LoginHistory = make([]*ExportedLoginAttempt, 0, len(attempts))
+	for _, attempt := range attempts {
+		data.LoginHistory = append(data.LoginHistory, &ExportedLoginAttempt{
+			SecureLoginAttempt: attempt,
+			DeviceID:           attempt.DeviceID,
+			Latitude:           attempt.Latitude,
+			Longitude:          attempt.Longitude,
+		})
+	}
+	return data, nil
+}
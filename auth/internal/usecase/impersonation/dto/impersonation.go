@@ -0,0 +1,8 @@
+package impersonationdto
+
+import "time"
+
+type ImpersonateOutput struct {
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
@@ -0,0 +1,157 @@
+package impersonation
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+
+	auditinfra "mandacode.com/accounts/auth/internal/infra/audit"
+	"mandacode.com/accounts/auth/internal/infra/mailer"
+	auditmodels "mandacode.com/accounts/auth/internal/models/audit"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	rbacrepo "mandacode.com/accounts/auth/internal/repository/rbac"
+	tokenrepo "mandacode.com/accounts/auth/internal/repository/token"
+	impersonationdto "mandacode.com/accounts/auth/internal/usecase/impersonation/dto"
+	"mandacode.com/accounts/auth/internal/usecase/userstatus"
+)
+
+// maxReasonLength bounds the reason of an impersonation, which is mailed to the user.
+const maxReasonLength = 500
+
+// PermissionImpersonate is the permission the roles of an admin must grant to impersonate users.
+const PermissionImpersonate = "users.impersonate"
+
+type ImpersonationUsecase struct {
+	token       *tokenrepo.TokenRepository
+	authAccount *dbrepo.AuthAccountRepository
+	rbac        *rbacrepo.RBACRepository
+	userStatus  *userstatus.UserStatusUsecase
+	mailer      *mailer.Mailer
+	audit       *auditinfra.Emitter
+	logger      *zap.Logger
+}
+
+// Impersonate issues a short-lived access token letting an admin see the product as the user. It is intended
+// for the support staff.
+//
+// The roles of the admin must grant PermissionImpersonate, and the roles of the user must grant no permission,
+// as the admin would otherwise act with the permissions of another admin. Neither the admin nor the user may be
+// blocked, inactive or archived, as for any other token. The impersonation is recorded in the audit trail and
+// the user is notified by mail before the token is returned, so that no impersonation goes unnoticed.
+//
+// Parameters:
+//   - ctx: The context for the operation, carrying the admin as the actor.
+//   - adminID: The user ID of the admin impersonating the user.
+//   - userID: The ID of the impersonated user.
+//   - reason: Why the user is impersonated, such as the ticket being investigated. It is shown to the user.
+//
+// Returns:
+//   - output: The impersonation token and its expiration time.
+//   - err: An error with the ErrForbidden code if the admin may not impersonate users or the user is an admin,
+//     an error with the ErrAccountDisabled code if the admin or the user is disabled, or an error if the user
+//     cannot be impersonated or the impersonation could not be recorded.
+func (i *ImpersonationUsecase) Impersonate(ctx context.Context, adminID uuid.UUID, userID uuid.UUID, reason string) (*impersonationdto.ImpersonateOutput, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" || len(reason) > maxReasonLength {
+		return nil, errors.New("impersonation reason is missing or too long", "Invalid Impersonation", errcode.ErrInvalidInput)
+	}
+	if adminID == userID {
+		return nil, errors.New("admin cannot impersonate themselves", "Invalid Impersonation", errcode.ErrInvalidInput)
+	}
+	allowed, err := i.rbac.HasPermission(ctx, adminID, PermissionImpersonate)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, errors.New("admin lacks the impersonation permission", "Forbidden", errcode.ErrForbidden)
+	}
+	privileged, err := i.rbac.GrantsAnyPermission(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if privileged {
+		return nil, errors.New("admins cannot be impersonated", "Forbidden", errcode.ErrForbidden)
+	}
+	if err := i.userStatus.Check(ctx, adminID); err != nil {
+		return nil, err
+	}
+	if err := i.userStatus.Check(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	accounts, err := i.authAccount.GetAuthAccountsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(accounts) == 0 {
+		return nil, errors.New("user has no auth account", "User Not Found", errcode.ErrNotFound)
+	}
+	email, err := i.authAccount.GetContactEmailByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if email == "" {
+		// The user could not be told about the impersonation
+		return nil, errors.New("user has no email to notify", "User Cannot Be Notified", errcode.ErrConflict)
+	}
+
+	startedAt := time.Now()
+	token, expiresAt, err := i.token.GenerateImpersonationToken(ctx, userID, adminID)
+	if err != nil {
+		return nil, err
+	}
+	output := &impersonationdto.ImpersonateOutput{
+		AccessToken: token,
+		ExpiresAt:   time.Unix(expiresAt, 0).UTC(),
+	}
+
+	// The token itself is left out of the audit trail
+	audited := struct {
+		Reason    string    `json:"reason"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}{
+		Reason:    reason,
+		ExpiresAt: output.ExpiresAt,
+	}
+	if err := i.audit.Emit(ctx, auditmodels.ActionUserImpersonate, auditmodels.TargetTypeUser, userID.String(), nil, audited); err != nil {
+		return nil, errors.Join(err, "Failed to audit impersonation")
+	}
+
+	if err := i.mailer.SendImpersonationStartedMail(mailer.ImpersonationStarted{
+		Email:     email,
+		Reason:    reason,
+		StartedAt: startedAt,
+		ExpiresAt: output.ExpiresAt,
+	}); err != nil {
+		i.logger.Error("failed to send impersonation notice", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, errors.New(err.Error(), "Failed to notify user", errcode.ErrDependencyFailure)
+	}
+
+	return output, nil
+}
+
+// NewImpersonationUsecase creates a new instance of ImpersonationUsecase.
+func NewImpersonationUsecase(
+	token *tokenrepo.TokenRepository,
+	authAccount *dbrepo.AuthAccountRepository,
+	rbac *rbacrepo.RBACRepository,
+	userStatus *userstatus.UserStatusUsecase,
+	mailer *mailer.Mailer,
+	audit *auditinfra.Emitter,
+	logger *zap.Logger,
+) *ImpersonationUsecase {
+	return &ImpersonationUsecase{
+		token:       token,
+		authAccount: authAccount,
+		rbac:        rbac,
+		userStatus:  userStatus,
+		mailer:      mailer,
+		audit:       audit,
+		logger:      logger,
+	}
+}
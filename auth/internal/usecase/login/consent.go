@@ -0,0 +1,186 @@
+package login
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+
+	"mandacode.com/accounts/auth/ent/loginattempt"
+	"mandacode.com/accounts/auth/ent/session"
+	consentmodels "mandacode.com/accounts/auth/internal/models/consent"
+	reqmodels "mandacode.com/accounts/auth/internal/models/request"
+	restoremodels "mandacode.com/accounts/auth/internal/models/restore"
+	consentrepo "mandacode.com/accounts/auth/internal/repository/consent"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	restorerepo "mandacode.com/accounts/auth/internal/repository/restore"
+	tokenrepo "mandacode.com/accounts/auth/internal/repository/token"
+	"mandacode.com/accounts/auth/internal/usecase/loginhistory"
+	"mandacode.com/accounts/auth/internal/usecase/userstatus"
+)
+
+// ConsentRequiredError is returned by sign ins of users who have yet to accept current mandatory documents,
+// such as a new version of the terms of service. The sign in completes once the user accepts them.
+//
+// Actions of signed in users, such as approving a device, are refused with no challenge instead.
+type ConsentRequiredError struct {
+	ChallengeID string // Empty if the user must accept the documents through the user service
+	ExpiresIn   int64  // Seconds until the challenge expires
+	Documents   []consentmodels.Document
+}
+
+func (e *ConsentRequiredError) Error() string {
+	return "consent to current documents is required"
+}
+
+type ConsentUsecase struct {
+	userStatus *userstatus.UserStatusUsecase
+	challenges *restorerepo.TokenManager
+	consents   *consentrepo.ConsentRepository
+	stepUp     *StepUpUsecase
+	restore    *RestoreUsecase
+	token      *tokenrepo.TokenRepository
+	session    *dbrepo.SessionRepository
+	history    *loginhistory.LoginHistoryUsecase
+	logger     *zap.Logger
+}
+
+// check holds back the sign in of a user who has yet to accept current mandatory documents, before tokens are
+// issued. The documents are recorded by the user service, so the sign in is refused if it cannot be reached.
+//
+// Returns:
+//   - nil if the user accepted every current mandatory document.
+//   - A *ConsentRequiredError listing the documents to accept otherwise.
+//   - An error if the pending documents could not be retrieved.
+func (c *ConsentUsecase) check(ctx context.Context, userID uuid.UUID, method loginattempt.LoginMethod, provider *string) error {
+	documents, err := c.pending(ctx, userID)
+	if err != nil || len(documents) == 0 {
+		return err
+	}
+
+	challengeID, err := c.challenges.IssueToken(ctx, &restoremodels.Restore{
+		UserID:      userID,
+		LoginMethod: method,
+		Provider:    provider,
+	})
+	if err != nil {
+		return errors.Upgrade(err, "Failed to start consent", errcode.ErrInternalFailure)
+	}
+	return &ConsentRequiredError{
+		ChallengeID: challengeID,
+		ExpiresIn:   int64(c.challenges.TokenTTL().Seconds()),
+		Documents:   documents,
+	}
+}
+
+// Require refuses an action of a signed in user who has yet to accept current mandatory documents, such as
+// approving a device or authorizing an OpenID Connect client. No challenge is issued: the user accepts the
+// documents through the user service, and tries again.
+//
+// Returns:
+//   - nil if the user accepted every current mandatory document.
+//   - A *ConsentRequiredError without a challenge, listing the documents to accept otherwise.
+//   - An error if the pending documents could not be retrieved.
+func (c *ConsentUsecase) Require(ctx context.Context, userID uuid.UUID) error {
+	documents, err := c.pending(ctx, userID)
+	if err != nil || len(documents) == 0 {
+		return err
+	}
+	return &ConsentRequiredError{Documents: documents}
+}
+
+// pending retrieves the current mandatory documents the user has yet to accept.
+func (c *ConsentUsecase) pending(ctx context.Context, userID uuid.UUID) ([]consentmodels.Document, error) {
+	documents, err := c.consents.ListPendingConsents(ctx, userID)
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to check consents", errcode.ErrInternalFailure)
+	}
+	return documents, nil
+}
+
+// Confirm records the consent of a user whose sign in was held back because they had yet to accept current
+// mandatory documents, and completes the sign in.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - challengeID: The identifier of the challenge returned with the ConsentRequiredError.
+//   - choices: The choices of the user on the documents.
+//   - info: The request information of the client.
+//
+// Returns:
+//   - The access and refresh tokens of the new session.
+//   - A *ConsentRequiredError if mandatory documents are still not accepted, such as a version published
+//     meanwhile.
+//   - A *StepUpRequiredError if the sign in must still be confirmed with a step-up.
+//   - A *DeletionPendingError if the account must be restored first.
+//   - An error if the challenge expired or was used, or the choices could not be recorded.
+func (c *ConsentUsecase) Confirm(ctx context.Context, challengeID string, choices []consentmodels.Choice, info reqmodels.RequestInfo) (accessToken string, refreshToken string, err error) {
+	consent, err := c.challenges.ConsumeToken(ctx, challengeID)
+	if err != nil {
+		return "", "", errors.Upgrade(err, "Failed to verify consent challenge", errcode.ErrInternalFailure)
+	}
+	if consent == nil {
+		return "", "", errors.New("consent challenge is invalid or expired", "Invalid Consent Challenge", errcode.ErrUnauthorized)
+	}
+	// The user may have been blocked since the challenge was issued
+	if _, err := c.userStatus.CheckSignIn(ctx, consent.UserID); err != nil {
+		return "", "", err
+	}
+	if err := c.consents.RecordConsents(ctx, consent.UserID, choices, info.IP, info.UserAgent); err != nil {
+		return "", "", errors.Join(err, "Failed to record consents")
+	}
+	c.logger.Info("consents recorded at sign in", zap.String("user_id", consent.UserID.String()))
+
+	if err := c.check(ctx, consent.UserID, consent.LoginMethod, consent.Provider); err != nil {
+		return "", "", err
+	}
+	if err := c.stepUp.assess(ctx, consent.UserID, consent.LoginMethod, consent.Provider, info); err != nil {
+		return "", "", err
+	}
+	if err := c.restore.check(ctx, consent.UserID, consent.LoginMethod, consent.Provider); err != nil {
+		return "", "", err
+	}
+
+	accessToken, _, err = c.token.GenerateAccessToken(ctx, consent.UserID)
+	if err != nil {
+		return "", "", errors.Upgrade(err, "Failed to generate token", errcode.ErrInternalFailure)
+	}
+	refreshToken, _, err = c.token.GenerateRefreshToken(ctx, consent.UserID)
+	if err != nil {
+		return "", "", errors.Upgrade(err, "Failed to generate token", errcode.ErrInternalFailure)
+	}
+	// Login methods which can be held back share their values with the session login methods
+	method := session.LoginMethod(consent.LoginMethod)
+	if err := startSession(ctx, c.session, consent.UserID, refreshToken, method, consent.Provider, info); err != nil {
+		return "", "", err
+	}
+	c.history.RecordSuccess(ctx, consent.UserID, consent.LoginMethod, consent.Provider, info)
+	return accessToken, refreshToken, nil
+}
+
+// NewConsentUsecase creates a new instance of ConsentUsecase.
+func NewConsentUsecase(
+	userStatus *userstatus.UserStatusUsecase,
+	challenges *restorerepo.TokenManager,
+	consents *consentrepo.ConsentRepository,
+	stepUp *StepUpUsecase,
+	restore *RestoreUsecase,
+	token *tokenrepo.TokenRepository,
+	session *dbrepo.SessionRepository,
+	history *loginhistory.LoginHistoryUsecase,
+	logger *zap.Logger,
+) *ConsentUsecase {
+	return &ConsentUsecase{
+		userStatus: userStatus,
+		challenges: challenges,
+		consents:   consents,
+		stepUp:     stepUp,
+		restore:    restore,
+		token:      token,
+		session:    session,
+		history:    history,
+		logger:     logger,
+	}
+}
@@ -0,0 +1,378 @@
+package login
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+
+	"mandacode.com/accounts/auth/ent/loginattempt"
+	"mandacode.com/accounts/auth/ent/session"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	devicemodels "mandacode.com/accounts/auth/internal/models/device"
+	reqmodels "mandacode.com/accounts/auth/internal/models/request"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	devicerepo "mandacode.com/accounts/auth/internal/repository/device"
+	tokenrepo "mandacode.com/accounts/auth/internal/repository/token"
+	logindto "mandacode.com/accounts/auth/internal/usecase/login/dto"
+	"mandacode.com/accounts/auth/internal/usecase/loginhistory"
+	"mandacode.com/accounts/auth/internal/usecase/userstatus"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+// Error codes returned by the device access token endpoint (RFC 8628 section 3.5).
+const (
+	DeviceErrAuthorizationPending = "authorization_pending"
+	DeviceErrSlowDown             = "slow_down"
+	DeviceErrAccessDenied         = "access_denied"
+	DeviceErrExpiredToken         = "expired_token"
+	DeviceErrInvalidClient        = "invalid_client"
+	DeviceErrUnauthorizedClient   = "unauthorized_client"
+)
+
+// deviceSlowDownStep is added to the polling interval each time a client polls too fast.
+const deviceSlowDownStep = 5 * time.Second
+
+type DeviceLoginUsecase struct {
+	oauthClient       *dbrepo.OAuthClientRepository
+	token             *tokenrepo.TokenRepository
+	deviceCodeManager *devicerepo.DeviceCodeManager
+	userCodeGen       *util.UserCodeGenerator
+	verificationURI   string
+	session           *dbrepo.SessionRepository
+	history           *loginhistory.LoginHistoryUsecase
+	userStatus        *userstatus.UserStatusUsecase
+	consent           *ConsentUsecase
+	stepUp            *StepUpUsecase
+}
+
+// RequestDeviceCode starts a device authorization for the given client.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - clientID: The identifier of the client requesting authorization.
+//
+// Returns:
+//   - The device code, user code and verification URI to present to the user.
+//   - An error if the authorization could not be started.
+func (d *DeviceLoginUsecase) RequestDeviceCode(ctx context.Context, clientID string) (*logindto.DeviceCodeOutput, error) {
+	client, err := d.oauthClient.GetClientByClientID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, errcode.ErrNotFound) {
+			return nil, errors.New("unknown client", DeviceErrInvalidClient, errcode.ErrUnauthorized)
+		}
+		return nil, errors.Upgrade(err, "Failed to get client", errcode.ErrInternalFailure)
+	}
+	if client.IsDisabled {
+		return nil, errors.New("client is disabled", DeviceErrInvalidClient, errcode.ErrUnauthorized)
+	}
+	if !client.AllowsGrantType(dbmodels.GrantTypeDeviceCode) {
+		return nil, errors.New("device grant is not allowed for the client", DeviceErrUnauthorizedClient, errcode.ErrInvalidInput)
+	}
+
+	authorization, err := d.deviceCodeManager.IssueDeviceCode(ctx, clientID)
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to issue device code", errcode.ErrInternalFailure)
+	}
+
+	complete, err := url.Parse(d.verificationURI)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Invalid verification URI", errcode.ErrInternalFailure)
+	}
+	query := complete.Query()
+	query.Set("user_code", authorization.UserCode)
+	complete.RawQuery = query.Encode()
+
+	return &logindto.DeviceCodeOutput{
+		DeviceCode:              authorization.DeviceCode,
+		UserCode:                authorization.UserCode,
+		VerificationURI:         d.verificationURI,
+		VerificationURIComplete: complete.String(),
+		ExpiresIn:               int64(time.Until(authorization.ExpiresAt).Seconds()),
+		Interval:                int64(authorization.Interval.Seconds()),
+	}, nil
+}
+
+// GetDeviceAuthorization returns the pending authorization for the user code so the signed in user can review it.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userCode: The user code displayed on the device.
+//
+// Returns:
+//   - The pending device authorization.
+//   - An error if the code is invalid.
+func (d *DeviceLoginUsecase) GetDeviceAuthorization(ctx context.Context, userCode string) (*logindto.DeviceAuthorizationInfo, error) {
+	authorization, err := d.getPending(ctx, userCode)
+	if err != nil {
+		return nil, err
+	}
+	return &logindto.DeviceAuthorizationInfo{
+		UserCode:  authorization.UserCode,
+		ClientID:  authorization.ClientID,
+		ExpiresAt: authorization.ExpiresAt,
+	}, nil
+}
+
+// ApproveDevice approves the device authorization on behalf of the signed in user.
+//
+// Approving a device signs the user in on it, so the approval passes the gates of a sign in: the user must be
+// able to sign in, have accepted current mandatory documents, and pass the risk policy.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - approver: The signed in user approving the device.
+//   - userCode: The user code displayed on the device.
+//   - info: The request information of the approving user.
+//
+// Returns:
+//   - nil if the device is approved.
+//   - A *ConsentRequiredError without a challenge if the user must accept current mandatory documents first.
+//   - A *StepUpRequiredError if the approval must be confirmed with VerifyApproval.
+//   - An error if the approver is impersonated, the user cannot sign in, or the code is invalid.
+func (d *DeviceLoginUsecase) ApproveDevice(ctx context.Context, approver logindto.DeviceApprover, userCode string, info reqmodels.RequestInfo) error {
+	if err := checkApprover(approver); err != nil {
+		return err
+	}
+	authorization, err := d.getPending(ctx, userCode)
+	if err != nil {
+		return err
+	}
+	if err := d.userStatus.Check(ctx, approver.UserID); err != nil {
+		return err
+	}
+	if err := d.consent.Require(ctx, approver.UserID); err != nil {
+		return err
+	}
+	if err := d.stepUp.assessApproval(ctx, approver.UserID, authorization.ClientID, authorization.UserCode, info); err != nil {
+		return err
+	}
+	return d.decide(ctx, authorization, devicemodels.DeviceStatusApproved, approver.UserID)
+}
+
+// VerifyApproval completes a device approval held back by a step-up challenge.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - approver: The signed in user approving the device, who must be the one the challenge was issued to.
+//   - challengeID: The identifier of the challenge returned with the StepUpRequiredError.
+//   - code: The code mailed to the user.
+//   - info: The request information of the approving user.
+//
+// Returns:
+//   - An error if the code is invalid, the challenge does not hold back an approval of the user, or the user can
+//     no longer sign in.
+func (d *DeviceLoginUsecase) VerifyApproval(ctx context.Context, approver logindto.DeviceApprover, challengeID string, code string, info reqmodels.RequestInfo) error {
+	if err := checkApprover(approver); err != nil {
+		return err
+	}
+	challenge, err := d.stepUp.verifyChallenge(ctx, challengeID, code, info)
+	if err != nil {
+		return err
+	}
+	if challenge.DeviceUserCode == nil || challenge.UserID != approver.UserID {
+		return errors.New("step-up challenge does not hold back a device approval of the user", "Invalid Step-Up Code", errcode.ErrUnauthorized)
+	}
+	authorization, err := d.getPending(ctx, *challenge.DeviceUserCode)
+	if err != nil {
+		return err
+	}
+	// The user may have been blocked since the challenge was issued
+	if err := d.userStatus.Check(ctx, approver.UserID); err != nil {
+		return err
+	}
+	return d.decide(ctx, authorization, devicemodels.DeviceStatusApproved, approver.UserID)
+}
+
+// DenyDevice denies the device authorization on behalf of the signed in user.
+func (d *DeviceLoginUsecase) DenyDevice(ctx context.Context, userID uuid.UUID, userCode string) error {
+	authorization, err := d.getPending(ctx, userCode)
+	if err != nil {
+		return err
+	}
+	return d.decide(ctx, authorization, devicemodels.DeviceStatusDenied, userID)
+}
+
+// PollToken exchanges an approved device code for tokens.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - clientID: The identifier of the polling client, which must match the one the code was issued to.
+//   - clientSecret: The secret of the client, required if the client is confidential.
+//   - deviceCode: The device code issued to the client.
+//   - info: The request information of the polling device, recorded on the session.
+//
+// Returns:
+//   - accessToken: The issued access token.
+//   - refreshToken: The issued refresh token.
+//   - err: An error whose public message is the RFC 8628 error code while the authorization is not redeemable.
+func (d *DeviceLoginUsecase) PollToken(ctx context.Context, clientID string, clientSecret string, deviceCode string, info reqmodels.RequestInfo) (accessToken string, refreshToken string, err error) {
+	if err := d.authenticateClient(ctx, clientID, clientSecret); err != nil {
+		return "", "", err
+	}
+	authorization, err := d.deviceCodeManager.GetByDeviceCode(ctx, deviceCode)
+	if err != nil {
+		return "", "", errors.Upgrade(err, "Failed to get device code", errcode.ErrInternalFailure)
+	}
+	if authorization == nil {
+		return "", "", errors.New("device code is invalid or expired", DeviceErrExpiredToken, errcode.ErrInvalidInput)
+	}
+	if authorization.ClientID != clientID {
+		return "", "", errors.New("device code was issued to another client", "invalid_grant", errcode.ErrInvalidInput)
+	}
+
+	// Enforce the polling interval, increasing it for clients which poll too fast
+	now := time.Now()
+	interval := authorization.Interval
+	slowDown := !authorization.LastPolledAt.IsZero() && now.Sub(authorization.LastPolledAt) < interval
+	if slowDown {
+		interval += deviceSlowDownStep
+	}
+	updated, err := d.deviceCodeManager.UpdatePolling(ctx, authorization, now, interval)
+	if err != nil {
+		return "", "", errors.Upgrade(err, "Failed to update device code", errcode.ErrInternalFailure)
+	}
+	if !updated {
+		return "", "", errors.New("device code expired while polling", DeviceErrExpiredToken, errcode.ErrInvalidInput)
+	}
+	if slowDown {
+		return "", "", errors.New("device polled before the interval elapsed", DeviceErrSlowDown, errcode.ErrInvalidInput)
+	}
+
+	switch authorization.Status {
+	case devicemodels.DeviceStatusPending:
+		return "", "", errors.New("device authorization is pending", DeviceErrAuthorizationPending, errcode.ErrInvalidInput)
+	case devicemodels.DeviceStatusDenied:
+		if _, err := d.deviceCodeManager.Consume(ctx, authorization); err != nil {
+			return "", "", errors.Upgrade(err, "Failed to consume device code", errcode.ErrInternalFailure)
+		}
+		return "", "", errors.New("device authorization was denied", DeviceErrAccessDenied, errcode.ErrInvalidInput)
+	case devicemodels.DeviceStatusApproved:
+		consumed, err := d.deviceCodeManager.Consume(ctx, authorization)
+		if err != nil {
+			return "", "", errors.Upgrade(err, "Failed to consume device code", errcode.ErrInternalFailure)
+		}
+		if !consumed {
+			return "", "", errors.New("device code was already redeemed", DeviceErrExpiredToken, errcode.ErrInvalidInput)
+		}
+		// The user may have been blocked or archived since approving the device
+		if err := d.userStatus.Check(ctx, authorization.UserID); err != nil {
+			if errors.Is(err, errcode.ErrAccountDisabled) {
+				return "", "", errors.New(err.Error(), DeviceErrAccessDenied, errcode.ErrInvalidInput)
+			}
+			return "", "", err
+		}
+		return d.issueToken(ctx, authorization.UserID, clientID, info)
+	default:
+		return "", "", errors.New("unknown device authorization status", "Internal Error", errcode.ErrInternalFailure)
+	}
+}
+
+// authenticateClient verifies the credentials of the polling client. Public clients only present their identifier,
+// while confidential clients must present their secret, so that a leaked device code cannot be redeemed without it.
+func (d *DeviceLoginUsecase) authenticateClient(ctx context.Context, clientID string, clientSecret string) error {
+	client, err := d.oauthClient.GetClientByClientID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, errcode.ErrNotFound) {
+			return errors.New("unknown client", DeviceErrInvalidClient, errcode.ErrUnauthorized)
+		}
+		return errors.Upgrade(err, "Failed to get client", errcode.ErrInternalFailure)
+	}
+	if client.IsDisabled {
+		return errors.New("client is disabled", DeviceErrInvalidClient, errcode.ErrUnauthorized)
+	}
+	if !client.IsConfidential {
+		return nil
+	}
+
+	valid, err := d.oauthClient.CompareSecret(ctx, clientID, clientSecret)
+	if err != nil {
+		return errors.Upgrade(err, "Failed to verify client secret", errcode.ErrInternalFailure)
+	}
+	if !valid {
+		return errors.New("invalid client secret", DeviceErrInvalidClient, errcode.ErrUnauthorized)
+	}
+	return nil
+}
+
+// decide records the signed in user's decision on a pending device authorization.
+func (d *DeviceLoginUsecase) decide(ctx context.Context, authorization *devicemodels.DeviceAuthorization, status devicemodels.DeviceStatus, userID uuid.UUID) error {
+	updated, err := d.deviceCodeManager.SetDecision(ctx, authorization, status, userID)
+	if err != nil {
+		return errors.Upgrade(err, "Failed to update device authorization", errcode.ErrInternalFailure)
+	}
+	if !updated {
+		return errors.New("device authorization expired before the decision", "Invalid or expired user code", errcode.ErrNotFound)
+	}
+	return nil
+}
+
+// getPending looks up a device authorization by user code and ensures it is still pending.
+func (d *DeviceLoginUsecase) getPending(ctx context.Context, userCode string) (*devicemodels.DeviceAuthorization, error) {
+	authorization, err := d.deviceCodeManager.GetByUserCode(ctx, d.userCodeGen.NormalizeUserCode(userCode))
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to get device authorization", errcode.ErrInternalFailure)
+	}
+	if authorization == nil {
+		return nil, errors.New("user code is invalid or expired", "Invalid or expired user code", errcode.ErrNotFound)
+	}
+	if authorization.Status != devicemodels.DeviceStatusPending {
+		return nil, errors.New("device authorization is already decided", "Device authorization already decided", errcode.ErrConflict)
+	}
+	return authorization, nil
+}
+
+// checkApprover refuses approvals by an admin impersonating the user: an impersonation token must not sign the
+// user in anywhere else.
+func checkApprover(approver logindto.DeviceApprover) error {
+	if approver.ImpersonatorID != nil {
+		return errors.New("impersonation tokens cannot approve devices", "Forbidden", errcode.ErrForbidden)
+	}
+	return nil
+}
+
+// issueToken issues a new access token and refresh token for the user and records the session.
+func (d *DeviceLoginUsecase) issueToken(ctx context.Context, userID uuid.UUID, clientID string, info reqmodels.RequestInfo) (accessToken string, refreshToken string, err error) {
+	accessToken, _, err = d.token.GenerateAccessToken(ctx, userID)
+	if err != nil {
+		return "", "", errors.Upgrade(err, "Failed to generate token", errcode.ErrInternalFailure)
+	}
+	refreshToken, _, err = d.token.GenerateRefreshToken(ctx, userID)
+	if err != nil {
+		return "", "", errors.Upgrade(err, "Failed to generate token", errcode.ErrInternalFailure)
+	}
+	if err := startSession(ctx, d.session, userID, refreshToken, session.LoginMethodDevice, &clientID, info); err != nil {
+		return "", "", err
+	}
+	d.history.RecordSuccess(ctx, userID, loginattempt.LoginMethodDevice, &clientID, info)
+	return accessToken, refreshToken, nil
+}
+
+func NewDeviceLoginUsecase(
+	oauthClient *dbrepo.OAuthClientRepository,
+	token *tokenrepo.TokenRepository,
+	deviceCodeManager *devicerepo.DeviceCodeManager,
+	userCodeGen *util.UserCodeGenerator,
+	verificationURI string,
+	session *dbrepo.SessionRepository,
+	history *loginhistory.LoginHistoryUsecase,
+	userStatus *userstatus.UserStatusUsecase,
+	consent *ConsentUsecase,
+	stepUp *StepUpUsecase,
+) *DeviceLoginUsecase {
+	return &DeviceLoginUsecase{
+		oauthClient:       oauthClient,
+		token:             token,
+		deviceCodeManager: deviceCodeManager,
+		userCodeGen:       userCodeGen,
+		verificationURI:   verificationURI,
+		session:           session,
+		history:           history,
+		userStatus:        userStatus,
+		consent:           consent,
+		stepUp:            stepUp,
+	}
+}
@@ -0,0 +1,28 @@
+package logindto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DeviceCodeOutput struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+}
+
+type DeviceAuthorizationInfo struct {
+	UserCode  string    `json:"user_code"`
+	ClientID  string    `json:"client_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// DeviceApprover is the signed in user deciding on a device authorization.
+type DeviceApprover struct {
+	UserID         uuid.UUID
+	ImpersonatorID *uuid.UUID // Set if the access token is an impersonation token
+}
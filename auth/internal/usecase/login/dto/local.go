@@ -1,7 +1,9 @@
 package logindto
 
+import reqmodels "mandacode.com/accounts/auth/internal/models/request"
+
 type LocalLoginInput struct {
-	Email    string             `json:"email"`
-	Password string             `json:"password"`
-	// Info     models.RequestInfo `json:"info"`
+	Email    string                `json:"email"`
+	Password string                `json:"password"`
+	Info     reqmodels.RequestInfo `json:"info"`
 }
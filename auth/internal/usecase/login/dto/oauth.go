@@ -1,10 +1,17 @@
 package logindto 
 
-import "mandacode.com/accounts/auth/ent/authaccount"
+import (
+	"mandacode.com/accounts/auth/ent/authaccount"
+	reqmodels "mandacode.com/accounts/auth/internal/models/request"
+)
 
 type OAuthLoginInput struct {
-	Provider    authaccount.Provider `json:"provider"`
-	AccessToken string               `json:"access_token,omitempty"` // Optional, used for OAuth providers that require an access token
-	Code        string               `json:"code,omitempty"`         // Optional, used for OAuth providers that require a code exchange
-	// Info        models.RequestInfo `json:"info"`
+	Provider    authaccount.Provider  `json:"provider"`
+	AccessToken string                `json:"access_token,omitempty"` // Optional, used for OAuth providers that require an access token
+	Code        string                `json:"code,omitempty"`         // Optional, used for OAuth providers that require a code exchange
+	Info        reqmodels.RequestInfo `json:"info"`
+
+	// Required to sign up a new user, as providers do not share them
+	BirthDate     *string `json:"birth_date,omitempty"`
+	GuardianEmail *string `json:"guardian_email,omitempty"` // Required for users under 14
 }
@@ -7,16 +7,27 @@ import (
 	"github.com/mandacode-com/golib/errors"
 	"github.com/mandacode-com/golib/errors/errcode"
 
+	"mandacode.com/accounts/auth/ent/loginattempt"
+	"mandacode.com/accounts/auth/ent/session"
+	reqmodels "mandacode.com/accounts/auth/internal/models/request"
 	coderepo "mandacode.com/accounts/auth/internal/repository/code"
 	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
 	tokenrepo "mandacode.com/accounts/auth/internal/repository/token"
 	logindto "mandacode.com/accounts/auth/internal/usecase/login/dto"
+	"mandacode.com/accounts/auth/internal/usecase/loginhistory"
+	"mandacode.com/accounts/auth/internal/usecase/userstatus"
 )
 
 type LocalLoginUsecase struct {
 	authAccount      *dbrepo.AuthAccountRepository
 	token            *tokenrepo.TokenRepository
 	loginCodeManager *coderepo.CodeManager
+	session          *dbrepo.SessionRepository
+	history          *loginhistory.LoginHistoryUsecase
+	userStatus       *userstatus.UserStatusUsecase
+	stepUp           *StepUpUsecase
+	restore          *RestoreUsecase
+	consent          *ConsentUsecase
 }
 
 func (l *LocalLoginUsecase) checkUserVerified(ctx context.Context, input logindto.LocalLoginInput) (uuid.UUID, error) {
@@ -25,6 +36,7 @@ func (l *LocalLoginUsecase) checkUserVerified(ctx context.Context, input logindt
 		return uuid.Nil, err
 	}
 	if !verified {
+		l.history.RecordFailure(ctx, userID, loginattempt.LoginMethodLocal, nil, loginhistory.FailureInvalidCredentials, input.Info)
 		return uuid.Nil, errors.New("invalid email or password", "Unauthorized", errcode.ErrUnauthorized)
 	}
 
@@ -33,6 +45,7 @@ func (l *LocalLoginUsecase) checkUserVerified(ctx context.Context, input logindt
 		return uuid.Nil, errors.Upgrade(err, "Failed to get auth account", errcode.ErrInternalFailure)
 	}
 	if !authAccount.IsVerified {
+		l.history.RecordFailure(ctx, userID, loginattempt.LoginMethodLocal, nil, loginhistory.FailureUnverified, input.Info)
 		return uuid.Nil, errors.New("user is not verified", "User Email Not Verified", errcode.ErrUnauthorized)
 	}
 
@@ -56,17 +69,30 @@ func (l *LocalLoginUsecase) IssueLoginCode(ctx context.Context, input logindto.L
 }
 
 // VerifyLoginCode implements localauthdomain.LocalLoginUsecase.
-func (l *LocalLoginUsecase) VerifyLoginCode(ctx context.Context, userID uuid.UUID, code string) (accessToken string, refreshToken string, err error) {
+func (l *LocalLoginUsecase) VerifyLoginCode(ctx context.Context, userID uuid.UUID, code string, info reqmodels.RequestInfo) (accessToken string, refreshToken string, err error) {
 	valid, err := l.loginCodeManager.ValidateCode(ctx, userID, code)
 	if err != nil {
 		return "", "", errors.Upgrade(err, "Failed to validate login code", errcode.ErrInternalFailure)
 	}
 	if !valid {
+		l.history.RecordFailure(ctx, userID, loginattempt.LoginMethodLocal, nil, loginhistory.FailureInvalidCode, info)
 		return "", "", errors.New("login code is invalid or expired", "Failed to validate login code", errcode.ErrUnauthorized)
 	}
+	if _, err := l.userStatus.CheckSignIn(ctx, userID); err != nil {
+		return "", "", err
+	}
+	if err := l.consent.check(ctx, userID, loginattempt.LoginMethodLocal, nil); err != nil {
+		return "", "", err
+	}
+	if err := l.stepUp.assess(ctx, userID, loginattempt.LoginMethodLocal, nil, info); err != nil {
+		return "", "", err
+	}
+	if err := l.restore.check(ctx, userID, loginattempt.LoginMethodLocal, nil); err != nil {
+		return "", "", err
+	}
 
 	// Generate access and refresh tokens
-	return l.issueToken(ctx, userID)
+	return l.issueToken(ctx, userID, info)
 }
 
 // Login implements localauthdomain.LocalLoginUsecase.
@@ -75,13 +101,25 @@ func (l *LocalLoginUsecase) Login(ctx context.Context, input logindto.LocalLogin
 	if err != nil {
 		return "", "", err
 	}
+	if _, err := l.userStatus.CheckSignIn(ctx, userID); err != nil {
+		return "", "", err
+	}
+	if err := l.consent.check(ctx, userID, loginattempt.LoginMethodLocal, nil); err != nil {
+		return "", "", err
+	}
+	if err := l.stepUp.assess(ctx, userID, loginattempt.LoginMethodLocal, nil, input.Info); err != nil {
+		return "", "", err
+	}
+	if err := l.restore.check(ctx, userID, loginattempt.LoginMethodLocal, nil); err != nil {
+		return "", "", err
+	}
 
 	// Generate access and refresh tokens
-	return l.issueToken(ctx, userID)
+	return l.issueToken(ctx, userID, input.Info)
 }
 
-// issueToken issues a new access token and refresh token for the user.
-func (l *LocalLoginUsecase) issueToken(ctx context.Context, userID uuid.UUID) (accessToken string, refreshToken string, err error) {
+// issueToken issues a new access token and refresh token for the user and records the session.
+func (l *LocalLoginUsecase) issueToken(ctx context.Context, userID uuid.UUID, info reqmodels.RequestInfo) (accessToken string, refreshToken string, err error) {
 	accessToken, _, err = l.token.GenerateAccessToken(ctx, userID)
 	if err != nil {
 		return "", "", errors.Upgrade(err, "Failed to generate token", errcode.ErrInternalFailure)
@@ -90,6 +128,10 @@ func (l *LocalLoginUsecase) issueToken(ctx context.Context, userID uuid.UUID) (a
 	if err != nil {
 		return "", "", errors.Upgrade(err, "Failed to generate token", errcode.ErrInternalFailure)
 	}
+	if err := startSession(ctx, l.session, userID, refreshToken, session.LoginMethodLocal, nil, info); err != nil {
+		return "", "", err
+	}
+	l.history.RecordSuccess(ctx, userID, loginattempt.LoginMethodLocal, nil, info)
 	return accessToken, refreshToken, nil
 }
 
@@ -97,10 +139,22 @@ func NewLocalLoginUsecase(
 	authAccount *dbrepo.AuthAccountRepository,
 	token *tokenrepo.TokenRepository,
 	loginCodeManager *coderepo.CodeManager,
+	session *dbrepo.SessionRepository,
+	history *loginhistory.LoginHistoryUsecase,
+	userStatus *userstatus.UserStatusUsecase,
+	stepUp *StepUpUsecase,
+	restore *RestoreUsecase,
+	consent *ConsentUsecase,
 ) *LocalLoginUsecase {
 	return &LocalLoginUsecase{
 		authAccount:      authAccount,
 		token:            token,
 		loginCodeManager: loginCodeManager,
+		session:          session,
+		history:          history,
+		userStatus:       userStatus,
+		stepUp:           stepUp,
+		restore:          restore,
+		consent:          consent,
 	}
 }
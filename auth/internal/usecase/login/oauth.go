@@ -8,13 +8,18 @@ import (
 	"github.com/mandacode-com/golib/errors"
 	"github.com/mandacode-com/golib/errors/errcode"
 	"mandacode.com/accounts/auth/ent/authaccount"
+	"mandacode.com/accounts/auth/ent/loginattempt"
+	"mandacode.com/accounts/auth/ent/session"
 
 	"mandacode.com/accounts/auth/internal/infra/oauthapi"
 	signupinfra "mandacode.com/accounts/auth/internal/infra/signup"
+	reqmodels "mandacode.com/accounts/auth/internal/models/request"
 	coderepo "mandacode.com/accounts/auth/internal/repository/code"
 	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
 	tokenrepo "mandacode.com/accounts/auth/internal/repository/token"
 	logindto "mandacode.com/accounts/auth/internal/usecase/login/dto"
+	"mandacode.com/accounts/auth/internal/usecase/loginhistory"
+	"mandacode.com/accounts/auth/internal/usecase/userstatus"
 )
 
 type OAuthLoginUsecase struct {
@@ -23,6 +28,21 @@ type OAuthLoginUsecase struct {
 	loginCodeManager *coderepo.CodeManager
 	signupApi        *signupinfra.SignupAPI
 	oauthApiMap      map[authaccount.Provider]oauthapi.OAuthAPI
+	session          *dbrepo.SessionRepository
+	history          *loginhistory.LoginHistoryUsecase
+	userStatus       *userstatus.UserStatusUsecase
+	stepUp           *StepUpUsecase
+	restore          *RestoreUsecase
+	consent          *ConsentUsecase
+}
+
+// AgeRequiredError is returned by the first sign in of a user with a provider when the user gave no birth date.
+// The sign in completes once the user gives it, along with the email address of their guardian if they are
+// under 14.
+type AgeRequiredError struct{}
+
+func (e *AgeRequiredError) Error() string {
+	return "the birth date is required to sign up"
 }
 
 // getAccessToken retrieves the access token from the OAuth API.
@@ -65,19 +85,23 @@ func (l *OAuthLoginUsecase) getOrCreateVerifiedUser(ctx context.Context, input l
 	var userID uuid.UUID
 	oauth, err := l.authAccount.GetOAuthAccountByProviderAndProviderID(ctx, input.Provider, userInfo.ProviderID)
 	if err != nil {
-		if errors.Is(err, errcode.ErrNotFound) { // If the OAuth account does not exist, create a new one
-			signupResponse, err := l.signupApi.OAuthSignup(input.Provider, oauthAccessToken) // Request signup API to create a new user
-			if err != nil {
-				return uuid.Nil, err
-			}
-			userUID, err := uuid.Parse(signupResponse.UserID)
-			if err != nil {
-				return uuid.Nil, errors.Upgrade(err, "Failed to parse user ID from signup response", errcode.ErrInternalFailure)
-			}
-			userID = userUID
-			verified = signupResponse.IsVerified
+		if !errors.Is(err, errcode.ErrNotFound) {
+			return uuid.Nil, errors.Upgrade(err, "Failed to get OAuth account", errcode.ErrInternalFailure)
+		}
+		// The OAuth account does not exist, so sign up a new user, whose age the provider does not share
+		if input.BirthDate == nil || *input.BirthDate == "" {
+			return uuid.Nil, &AgeRequiredError{}
+		}
+		signupResponse, err := l.signupApi.OAuthSignup(input.Provider, oauthAccessToken, *input.BirthDate, input.GuardianEmail)
+		if err != nil {
+			return uuid.Nil, err
 		}
-		return uuid.Nil, errors.Upgrade(err, "Failed to get OAuth account", errcode.ErrInternalFailure)
+		userUID, err := uuid.Parse(signupResponse.UserID)
+		if err != nil {
+			return uuid.Nil, errors.Upgrade(err, "Failed to parse user ID from signup response", errcode.ErrInternalFailure)
+		}
+		userID = userUID
+		verified = signupResponse.IsVerified
 	} else {
 		userID = oauth.UserID
 		verified = oauth.IsVerified
@@ -104,7 +128,11 @@ func (l *OAuthLoginUsecase) GetLoginURL(ctx context.Context, provider string) (l
 func (l *OAuthLoginUsecase) IssueLoginCode(ctx context.Context, input logindto.OAuthLoginInput) (code string, userID uuid.UUID, err error) {
 	// Get or create verified user
 	userID, err = l.getOrCreateVerifiedUser(ctx, input)
+	if _, ok := err.(*AgeRequiredError); ok {
+		return "", uuid.Nil, err
+	}
 	if err != nil {
+		l.recordProviderFailure(ctx, input)
 		return "", uuid.Nil, errors.Upgrade(err, "Failed to get or create verified user", errcode.ErrUnauthorized)
 	}
 
@@ -121,31 +149,62 @@ func (l *OAuthLoginUsecase) IssueLoginCode(ctx context.Context, input logindto.O
 func (l *OAuthLoginUsecase) Login(ctx context.Context, input logindto.OAuthLoginInput) (accessToken string, refreshToken string, err error) {
 	// Get or create verified user
 	userID, err := l.getOrCreateVerifiedUser(ctx, input)
+	if _, ok := err.(*AgeRequiredError); ok {
+		return "", "", err
+	}
 	if err != nil {
+		l.recordProviderFailure(ctx, input)
 		return "", "", errors.Upgrade(err, "Failed to get or create verified user", errcode.ErrUnauthorized)
 	}
 
+	provider := string(input.Provider)
+	if _, err := l.userStatus.CheckSignIn(ctx, userID); err != nil {
+		return "", "", err
+	}
+	if err := l.consent.check(ctx, userID, loginattempt.LoginMethodOauth, &provider); err != nil {
+		return "", "", err
+	}
+	if err := l.stepUp.assess(ctx, userID, loginattempt.LoginMethodOauth, &provider, input.Info); err != nil {
+		return "", "", err
+	}
+	if err := l.restore.check(ctx, userID, loginattempt.LoginMethodOauth, &provider); err != nil {
+		return "", "", err
+	}
+
 	// Generate access and refresh tokens
-	return l.issueToken(ctx, userID)
+	return l.issueToken(ctx, userID, &provider, input.Info)
 }
 
 // VerifyLoginCode implements oauthdomain.OAuthLoginUsecase.
-func (l *OAuthLoginUsecase) VerifyLoginCode(ctx context.Context, userID uuid.UUID, code string) (accessToken string, refreshToken string, err error) {
+func (l *OAuthLoginUsecase) VerifyLoginCode(ctx context.Context, userID uuid.UUID, code string, info reqmodels.RequestInfo) (accessToken string, refreshToken string, err error) {
 	// Validate code
 	valid, err := l.loginCodeManager.ValidateCode(ctx, userID, code)
 	if err != nil {
 		return "", "", errors.Upgrade(err, "Failed to validate login code", errcode.ErrInternalFailure)
 	}
 	if !valid {
+		l.history.RecordFailure(ctx, userID, loginattempt.LoginMethodOauth, nil, loginhistory.FailureInvalidCode, info)
 		return "", "", errors.New("login code is invalid or expired", "Failed to validate login code", errcode.ErrUnauthorized)
 	}
+	if _, err := l.userStatus.CheckSignIn(ctx, userID); err != nil {
+		return "", "", err
+	}
+	if err := l.consent.check(ctx, userID, loginattempt.LoginMethodOauth, nil); err != nil {
+		return "", "", err
+	}
+	if err := l.stepUp.assess(ctx, userID, loginattempt.LoginMethodOauth, nil, info); err != nil {
+		return "", "", err
+	}
+	if err := l.restore.check(ctx, userID, loginattempt.LoginMethodOauth, nil); err != nil {
+		return "", "", err
+	}
 
-	// Generate access and refresh tokens
-	return l.issueToken(ctx, userID)
+	// Generate access and refresh tokens. The login code does not carry the provider it was issued for.
+	return l.issueToken(ctx, userID, nil, info)
 }
 
-// issueToken generates access and refresh tokens for the user.
-func (l *OAuthLoginUsecase) issueToken(ctx context.Context, userID uuid.UUID) (accessToken string, refreshToken string, err error) {
+// issueToken generates access and refresh tokens for the user and records the session.
+func (l *OAuthLoginUsecase) issueToken(ctx context.Context, userID uuid.UUID, provider *string, info reqmodels.RequestInfo) (accessToken string, refreshToken string, err error) {
 	// Generate access token
 	accessToken, _, err = l.token.GenerateAccessToken(ctx, userID)
 	if err != nil {
@@ -158,9 +217,21 @@ func (l *OAuthLoginUsecase) issueToken(ctx context.Context, userID uuid.UUID) (a
 		return "", "", errors.Upgrade(err, "Failed to generate refresh token", errcode.ErrInternalFailure)
 	}
 
+	// Record the session
+	if err := startSession(ctx, l.session, userID, refreshToken, session.LoginMethodOauth, provider, info); err != nil {
+		return "", "", err
+	}
+	l.history.RecordSuccess(ctx, userID, loginattempt.LoginMethodOauth, provider, info)
+
 	return accessToken, refreshToken, nil
 }
 
+// recordProviderFailure records a sign in which failed at the OAuth provider or while resolving its user.
+func (l *OAuthLoginUsecase) recordProviderFailure(ctx context.Context, input logindto.OAuthLoginInput) {
+	provider := string(input.Provider)
+	l.history.RecordFailure(ctx, uuid.Nil, loginattempt.LoginMethodOauth, &provider, loginhistory.FailureProviderError, input.Info)
+}
+
 // NewOAuthLoginUsecase creates a new instance of LoginUsecase.
 func NewOAuthLoginUsecase(
 	authAccount *dbrepo.AuthAccountRepository,
@@ -168,6 +239,12 @@ func NewOAuthLoginUsecase(
 	loginCodeManager *coderepo.CodeManager,
 	signupApi *signupinfra.SignupAPI,
 	oauthApiMap map[authaccount.Provider]oauthapi.OAuthAPI,
+	session *dbrepo.SessionRepository,
+	history *loginhistory.LoginHistoryUsecase,
+	userStatus *userstatus.UserStatusUsecase,
+	stepUp *StepUpUsecase,
+	restore *RestoreUsecase,
+	consent *ConsentUsecase,
 ) *OAuthLoginUsecase {
 	return &OAuthLoginUsecase{
 		authAccount:      authAccount,
@@ -175,5 +252,11 @@ func NewOAuthLoginUsecase(
 		loginCodeManager: loginCodeManager,
 		signupApi:        signupApi,
 		oauthApiMap:      oauthApiMap,
+		session:          session,
+		history:          history,
+		userStatus:       userStatus,
+		stepUp:           stepUp,
+		restore:          restore,
+		consent:          consent,
 	}
 }
@@ -0,0 +1,168 @@
+package login
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+
+	"mandacode.com/accounts/auth/ent/loginattempt"
+	"mandacode.com/accounts/auth/ent/session"
+	reqmodels "mandacode.com/accounts/auth/internal/models/request"
+	restoremodels "mandacode.com/accounts/auth/internal/models/restore"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	restorerepo "mandacode.com/accounts/auth/internal/repository/restore"
+	tokenrepo "mandacode.com/accounts/auth/internal/repository/token"
+	userrepo "mandacode.com/accounts/auth/internal/repository/user"
+	"mandacode.com/accounts/auth/internal/usecase/loginhistory"
+	"mandacode.com/accounts/auth/internal/usecase/userstatus"
+)
+
+// DeletionPendingError is returned by sign ins of users whose account is scheduled for deletion. The sign in
+// completes if the user chooses to restore their account.
+type DeletionPendingError struct {
+	ChallengeID string
+	ExpiresIn   int64 // Seconds until the challenge expires
+}
+
+func (e *DeletionPendingError) Error() string {
+	return "account is pending deletion"
+}
+
+type RestoreUsecase struct {
+	userStatus  *userstatus.UserStatusUsecase
+	challenges  *restorerepo.TokenManager
+	cancelLinks *restorerepo.TokenManager
+	user        *userrepo.UserRepository
+	token       *tokenrepo.TokenRepository
+	session     *dbrepo.SessionRepository
+	history     *loginhistory.LoginHistoryUsecase
+	logger      *zap.Logger
+}
+
+// check refuses the sign in of a user who is blocked or inactive, and holds back the sign in of a user whose
+// account is pending deletion, before tokens are issued. It is the last gate of a sign in, so that the account is
+// only restored once the consent gate and the risk policy let the sign in through.
+//
+// Returns:
+//   - nil if the user can sign in.
+//   - A *DeletionPendingError offering to restore the account if it is pending deletion.
+//   - An error if the user is blocked or inactive.
+func (r *RestoreUsecase) check(ctx context.Context, userID uuid.UUID, method loginattempt.LoginMethod, provider *string) error {
+	pending, err := r.userStatus.CheckSignIn(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !pending {
+		return nil
+	}
+
+	challengeID, err := r.challenges.IssueToken(ctx, &restoremodels.Restore{
+		UserID:      userID,
+		LoginMethod: method,
+		Provider:    provider,
+	})
+	if err != nil {
+		return errors.Upgrade(err, "Failed to start restore", errcode.ErrInternalFailure)
+	}
+	return &DeletionPendingError{
+		ChallengeID: challengeID,
+		ExpiresIn:   int64(r.challenges.TokenTTL().Seconds()),
+	}
+}
+
+// Confirm restores the account of a user whose sign in was held back because it was pending deletion, and
+// completes the sign in.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - challengeID: The identifier of the challenge returned with the DeletionPendingError.
+//   - info: The request information of the client.
+//
+// Returns:
+//   - The access and refresh tokens of the new session.
+//   - An error if the challenge expired or was used, or the account could not be restored.
+func (r *RestoreUsecase) Confirm(ctx context.Context, challengeID string, info reqmodels.RequestInfo) (accessToken string, refreshToken string, err error) {
+	restore, err := r.challenges.ConsumeToken(ctx, challengeID)
+	if err != nil {
+		return "", "", errors.Upgrade(err, "Failed to verify restore challenge", errcode.ErrInternalFailure)
+	}
+	if restore == nil {
+		return "", "", errors.New("restore challenge is invalid or expired", "Invalid Restore Challenge", errcode.ErrUnauthorized)
+	}
+	// The user may have been blocked since the challenge was issued
+	if _, err := r.userStatus.CheckSignIn(ctx, restore.UserID); err != nil {
+		return "", "", err
+	}
+	// The consent gate and the risk policy let the sign in through before the challenge was issued
+	if err := r.restore(ctx, restore.UserID); err != nil {
+		return "", "", err
+	}
+
+	accessToken, _, err = r.token.GenerateAccessToken(ctx, restore.UserID)
+	if err != nil {
+		return "", "", errors.Upgrade(err, "Failed to generate token", errcode.ErrInternalFailure)
+	}
+	refreshToken, _, err = r.token.GenerateRefreshToken(ctx, restore.UserID)
+	if err != nil {
+		return "", "", errors.Upgrade(err, "Failed to generate token", errcode.ErrInternalFailure)
+	}
+	// Login methods which can be held back share their values with the session login methods
+	method := session.LoginMethod(restore.LoginMethod)
+	if err := startSession(ctx, r.session, restore.UserID, refreshToken, method, restore.Provider, info); err != nil {
+		return "", "", err
+	}
+	r.history.RecordSuccess(ctx, restore.UserID, restore.LoginMethod, restore.Provider, info)
+	return accessToken, refreshToken, nil
+}
+
+// CancelDeletion restores the account of a user with the token of the link mailed to them when the account
+// was scheduled for deletion.
+func (r *RestoreUsecase) CancelDeletion(ctx context.Context, token string) error {
+	restore, err := r.cancelLinks.ConsumeToken(ctx, token)
+	if err != nil {
+		return errors.Upgrade(err, "Failed to verify cancel link", errcode.ErrInternalFailure)
+	}
+	if restore == nil {
+		return errors.New("cancel link is invalid or expired", "Invalid Cancel Link", errcode.ErrUnauthorized)
+	}
+	return r.restore(ctx, restore.UserID)
+}
+
+// restore restores the user in the user service. The user status is updated right away rather than when
+// USER_RESTORED arrives, so that the user can sign in immediately.
+func (r *RestoreUsecase) restore(ctx context.Context, userID uuid.UUID) error {
+	if err := r.user.RestoreUser(ctx, userID); err != nil {
+		return errors.Upgrade(err, "Failed to restore user", errcode.ErrInternalFailure)
+	}
+	if err := r.userStatus.ClearArchived(ctx, userID); err != nil {
+		return err
+	}
+	r.logger.Info("user restored", zap.String("user_id", userID.String()))
+	return nil
+}
+
+// NewRestoreUsecase creates a new instance of RestoreUsecase.
+func NewRestoreUsecase(
+	userStatus *userstatus.UserStatusUsecase,
+	challenges *restorerepo.TokenManager,
+	cancelLinks *restorerepo.TokenManager,
+	user *userrepo.UserRepository,
+	token *tokenrepo.TokenRepository,
+	session *dbrepo.SessionRepository,
+	history *loginhistory.LoginHistoryUsecase,
+	logger *zap.Logger,
+) *RestoreUsecase {
+	return &RestoreUsecase{
+		userStatus:  userStatus,
+		challenges:  challenges,
+		cancelLinks: cancelLinks,
+		user:        user,
+		token:       token,
+		session:     session,
+		history:     history,
+		logger:      logger,
+	}
+}
@@ -0,0 +1,39 @@
+package login
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+
+	"mandacode.com/accounts/auth/ent/session"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	reqmodels "mandacode.com/accounts/auth/internal/models/request"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+// startSession records the session a refresh token was issued for at sign in.
+func startSession(
+	ctx context.Context,
+	sessions *dbrepo.SessionRepository,
+	userID uuid.UUID,
+	refreshToken string,
+	method session.LoginMethod,
+	provider *string,
+	info reqmodels.RequestInfo,
+) error {
+	_, err := sessions.CreateSession(ctx, &dbmodels.CreateSessionInput{
+		UserID:           userID,
+		RefreshTokenHash: util.HashToken(refreshToken),
+		LoginMethod:      method,
+		Provider:         provider,
+		UserAgent:        info.UserAgent,
+		IPAddress:        info.IP,
+	})
+	if err != nil {
+		return errors.Upgrade(err, "Failed to create session", errcode.ErrInternalFailure)
+	}
+	return nil
+}
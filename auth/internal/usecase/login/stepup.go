@@ -0,0 +1,217 @@
+package login
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+
+	"mandacode.com/accounts/auth/ent/loginattempt"
+	"mandacode.com/accounts/auth/ent/session"
+	"mandacode.com/accounts/auth/internal/infra/mailer"
+	reqmodels "mandacode.com/accounts/auth/internal/models/request"
+	stepupmodels "mandacode.com/accounts/auth/internal/models/stepup"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	stepuprepo "mandacode.com/accounts/auth/internal/repository/stepup"
+	tokenrepo "mandacode.com/accounts/auth/internal/repository/token"
+	"mandacode.com/accounts/auth/internal/usecase/loginhistory"
+	"mandacode.com/accounts/auth/internal/usecase/risk"
+	"mandacode.com/accounts/auth/internal/usecase/userstatus"
+)
+
+// StepUpRequiredError is returned by sign ins which must be confirmed with the code mailed to the user
+// before tokens are issued.
+type StepUpRequiredError struct {
+	ChallengeID string
+	ExpiresIn   int64 // Seconds until the challenge expires
+}
+
+func (e *StepUpRequiredError) Error() string {
+	return "step-up authentication required"
+}
+
+type StepUpUsecase struct {
+	evaluator   *risk.Evaluator
+	challenges  *stepuprepo.ChallengeManager
+	authAccount *dbrepo.AuthAccountRepository
+	token       *tokenrepo.TokenRepository
+	session     *dbrepo.SessionRepository
+	history     *loginhistory.LoginHistoryUsecase
+	userStatus  *userstatus.UserStatusUsecase
+	restore     *RestoreUsecase
+	mailer      *mailer.Mailer
+	logger      *zap.Logger
+}
+
+// assess evaluates the risk of a sign in whose credentials have been verified, before tokens are issued.
+//
+// Returns:
+//   - nil if the sign in may proceed.
+//   - A *StepUpRequiredError if the sign in must be confirmed first.
+//   - An error if the sign in is denied or the step-up could not be started.
+func (s *StepUpUsecase) assess(ctx context.Context, userID uuid.UUID, method loginattempt.LoginMethod, provider *string, info reqmodels.RequestInfo) error {
+	return s.evaluate(ctx, &stepupmodels.Challenge{
+		UserID:      userID,
+		LoginMethod: method,
+		Provider:    provider,
+	}, info)
+}
+
+// assessApproval evaluates the risk of a signed in user approving a device, before the device is approved. The
+// request information is the one of the approving user, not of the device.
+//
+// Returns:
+//   - nil if the approval may proceed.
+//   - A *StepUpRequiredError if the approval must be confirmed first.
+//   - An error if the approval is denied or the step-up could not be started.
+func (s *StepUpUsecase) assessApproval(ctx context.Context, userID uuid.UUID, clientID string, userCode string, info reqmodels.RequestInfo) error {
+	return s.evaluate(ctx, &stepupmodels.Challenge{
+		UserID:         userID,
+		LoginMethod:    loginattempt.LoginMethodDevice,
+		Provider:       &clientID,
+		DeviceUserCode: &userCode,
+	}, info)
+}
+
+// evaluate evaluates the risk of the action held back by challenge, and issues the challenge if it must be
+// confirmed with a step-up.
+func (s *StepUpUsecase) evaluate(ctx context.Context, challenge *stepupmodels.Challenge, info reqmodels.RequestInfo) error {
+	assessment := s.evaluator.Evaluate(ctx, challenge.UserID, challenge.LoginMethod, info)
+	switch assessment.Decision {
+	case risk.DecisionDeny:
+		s.history.RecordFailure(ctx, challenge.UserID, challenge.LoginMethod, challenge.Provider, loginhistory.FailureRiskDenied, info)
+		return errors.New("login denied by risk policy", "Login Denied", errcode.ErrForbidden)
+	case risk.DecisionStepUp:
+		challenge.Reasons = assessment.Reasons
+		return s.challenge(ctx, challenge, info)
+	default:
+		return nil
+	}
+}
+
+// challenge holds back the sign in and mails the user the code confirming it.
+func (s *StepUpUsecase) challenge(ctx context.Context, challenge *stepupmodels.Challenge, info reqmodels.RequestInfo) error {
+	email, err := s.authAccount.GetContactEmailByUserID(ctx, challenge.UserID)
+	if err != nil {
+		return errors.Upgrade(err, "Failed to start step-up", errcode.ErrInternalFailure)
+	}
+	if email == "" {
+		// The code cannot be delivered, so the sign in cannot be confirmed
+		s.history.RecordFailure(ctx, challenge.UserID, challenge.LoginMethod, challenge.Provider, loginhistory.FailureRiskDenied, info)
+		return errors.New("no email to send step-up code to", "Login Denied", errcode.ErrForbidden)
+	}
+
+	challengeID, code, err := s.challenges.IssueChallenge(ctx, challenge)
+	if err != nil {
+		return err
+	}
+	ttl := s.challenges.ChallengeTTL()
+	err = s.mailer.SendStepUpCodeMail(mailer.StepUpCode{
+		Email:     email,
+		Code:      code,
+		IPAddress: info.IP,
+		UserAgent: info.UserAgent,
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return errors.Upgrade(err, "Failed to send step-up code", errcode.ErrInternalFailure)
+	}
+
+	return &StepUpRequiredError{
+		ChallengeID: challengeID,
+		ExpiresIn:   int64(ttl.Seconds()),
+	}
+}
+
+// Verify completes a sign in held back by a step-up challenge.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - challengeID: The identifier of the challenge returned with the StepUpRequiredError.
+//   - code: The code mailed to the user.
+//   - info: The request information of the client.
+//
+// Returns:
+//   - The access and refresh tokens of the new session.
+//   - A *DeletionPendingError if the account must be restored first.
+//   - An error if the code is invalid, the challenge expired or was used, or the user may no longer sign in.
+func (s *StepUpUsecase) Verify(ctx context.Context, challengeID string, code string, info reqmodels.RequestInfo) (accessToken string, refreshToken string, err error) {
+	challenge, err := s.verifyChallenge(ctx, challengeID, code, info)
+	if err != nil {
+		return "", "", err
+	}
+	if challenge.DeviceUserCode != nil {
+		return "", "", errors.New("step-up challenge holds back a device approval", "Invalid Step-Up Code", errcode.ErrUnauthorized)
+	}
+	// The user may have been blocked while the code was in the mail
+	if _, err := s.userStatus.CheckSignIn(ctx, challenge.UserID); err != nil {
+		return "", "", err
+	}
+	if err := s.restore.check(ctx, challenge.UserID, challenge.LoginMethod, challenge.Provider); err != nil {
+		return "", "", err
+	}
+
+	accessToken, _, err = s.token.GenerateAccessToken(ctx, challenge.UserID)
+	if err != nil {
+		return "", "", errors.Upgrade(err, "Failed to generate token", errcode.ErrInternalFailure)
+	}
+	refreshToken, _, err = s.token.GenerateRefreshToken(ctx, challenge.UserID)
+	if err != nil {
+		return "", "", errors.Upgrade(err, "Failed to generate token", errcode.ErrInternalFailure)
+	}
+	// Login methods which can be stepped up share their values with the session login methods
+	method := session.LoginMethod(challenge.LoginMethod)
+	if err := startSession(ctx, s.session, challenge.UserID, refreshToken, method, challenge.Provider, info); err != nil {
+		return "", "", err
+	}
+	s.history.RecordSuccess(ctx, challenge.UserID, challenge.LoginMethod, challenge.Provider, info)
+	s.logger.Info("step-up verified",
+		zap.String("user_id", challenge.UserID.String()),
+		zap.String("ip", info.IP),
+		zap.Strings("reasons", challenge.Reasons),
+	)
+	return accessToken, refreshToken, nil
+}
+
+// verifyChallenge checks the code of a step-up challenge, and returns the challenge if it matches.
+func (s *StepUpUsecase) verifyChallenge(ctx context.Context, challengeID string, code string, info reqmodels.RequestInfo) (*stepupmodels.Challenge, error) {
+	challenge, err := s.challenges.VerifyChallenge(ctx, challengeID, code)
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to verify step-up code", errcode.ErrInternalFailure)
+	}
+	if challenge == nil {
+		s.logger.Info("step-up verification failed", zap.String("ip", info.IP))
+		return nil, errors.New("step-up code is invalid or expired", "Invalid Step-Up Code", errcode.ErrUnauthorized)
+	}
+	return challenge, nil
+}
+
+// NewStepUpUsecase creates a new instance of StepUpUsecase.
+func NewStepUpUsecase(
+	evaluator *risk.Evaluator,
+	challenges *stepuprepo.ChallengeManager,
+	authAccount *dbrepo.AuthAccountRepository,
+	token *tokenrepo.TokenRepository,
+	session *dbrepo.SessionRepository,
+	history *loginhistory.LoginHistoryUsecase,
+	userStatus *userstatus.UserStatusUsecase,
+	restore *RestoreUsecase,
+	mailer *mailer.Mailer,
+	logger *zap.Logger,
+) *StepUpUsecase {
+	return &StepUpUsecase{
+		evaluator:   evaluator,
+		challenges:  challenges,
+		authAccount: authAccount,
+		token:       token,
+		session:     session,
+		history:     history,
+		userStatus:  userStatus,
+		restore:     restore,
+		mailer:      mailer,
+		logger:      logger,
+	}
+}
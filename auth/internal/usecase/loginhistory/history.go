@@ -0,0 +1,224 @@
+package loginhistory
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"mandacode.com/accounts/auth/ent/loginattempt"
+	geoipinfra "mandacode.com/accounts/auth/internal/infra/geoip"
+	"mandacode.com/accounts/auth/internal/infra/mailer"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	reqmodels "mandacode.com/accounts/auth/internal/models/request"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+)
+
+// Reasons recorded for failed login attempts.
+const (
+	FailureInvalidCredentials = "invalid_credentials"
+	FailureUnverified         = "unverified"
+	FailureInvalidCode        = "invalid_code"
+	FailureProviderError      = "provider_error"
+	FailureRiskDenied         = "risk_denied"
+)
+
+const (
+	defaultHistoryLimit = 20
+	maxHistoryLimit     = 100
+)
+
+type LoginHistoryUsecase struct {
+	loginAttempt *dbrepo.LoginAttemptRepository
+	authAccount  *dbrepo.AuthAccountRepository
+	locator      *geoipinfra.Locator
+	mailer       *mailer.Mailer
+	securityURL  string
+	logger       *zap.Logger
+}
+
+// RecordSuccess records a successful sign in and warns the user if it came from a new device or country.
+//
+// Recording never fails the sign in; errors are logged.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The unique identifier of the user who signed in.
+//   - method: The method used to sign in.
+//   - provider: The OAuth provider or device client used to sign in, if any.
+//   - info: The request information of the client.
+func (h *LoginHistoryUsecase) RecordSuccess(ctx context.Context, userID uuid.UUID, method loginattempt.LoginMethod, provider *string, info reqmodels.RequestInfo) {
+	location := h.locator.Locate(info.IP)
+
+	// Compare against the history before this attempt is added to it
+	newDevice, newCountry := h.detectNewSignIn(ctx, userID, info, location)
+
+	if err := h.record(ctx, &userID, method, provider, true, nil, info, location); err != nil {
+		h.logger.Error("failed to record login attempt", zap.Error(err), zap.String("user_id", userID.String()))
+	}
+
+	if newDevice || newCountry {
+		h.sendAlert(ctx, userID, info, location, newDevice, newCountry)
+	}
+}
+
+// RecordFailure records a failed sign in.
+//
+// Recording never fails the sign in; errors are logged.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The unique identifier of the user the attempt was made for, or uuid.Nil if unknown.
+//   - method: The method used to sign in.
+//   - provider: The OAuth provider or device client used to sign in, if any.
+//   - reason: The reason the attempt failed.
+//   - info: The request information of the client.
+func (h *LoginHistoryUsecase) RecordFailure(ctx context.Context, userID uuid.UUID, method loginattempt.LoginMethod, provider *string, reason string, info reqmodels.RequestInfo) {
+	var attemptUserID *uuid.UUID
+	if userID != uuid.Nil {
+		attemptUserID = &userID
+	}
+	if err := h.record(ctx, attemptUserID, method, provider, false, &reason, info, h.locator.Locate(info.IP)); err != nil {
+		h.logger.Error("failed to record login attempt", zap.Error(err), zap.String("user_id", userID.String()))
+	}
+}
+
+// ListLoginHistory lists the most recent login attempts of the user, newest first.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The unique identifier of the user.
+//   - limit: The maximum number of attempts to return. Non-positive values select the default.
+func (h *LoginHistoryUsecase) ListLoginHistory(ctx context.Context, userID uuid.UUID, limit int) ([]*dbmodels.SecureLoginAttempt, error) {
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+	return h.loginAttempt.ListLoginAttemptsByUserID(ctx, userID, min(limit, maxHistoryLimit))
+}
+
+// record stores a login attempt.
+func (h *LoginHistoryUsecase) record(
+	ctx context.Context,
+	userID *uuid.UUID,
+	method loginattempt.LoginMethod,
+	provider *string,
+	success bool,
+	reason *string,
+	info reqmodels.RequestInfo,
+	location *geoipinfra.Location,
+) error {
+	input := &dbmodels.CreateLoginAttemptInput{
+		UserID:        userID,
+		LoginMethod:   method,
+		Provider:      provider,
+		Success:       success,
+		FailureReason: reason,
+		IPAddress:     info.IP,
+		UserAgent:     info.UserAgent,
+		DeviceID:      info.DeviceID,
+	}
+	if location != nil {
+		input.Country = &location.Country
+		if location.City != "" {
+			input.City = &location.City
+		}
+		if location.HasCoords {
+			input.Latitude = &location.Latitude
+			input.Longitude = &location.Longitude
+		}
+	}
+	_, err := h.loginAttempt.CreateLoginAttempt(ctx, input)
+	return err
+}
+
+// detectNewSignIn reports whether a sign in comes from a device or country the user has not signed in from before.
+//
+// The first sign in of a user is never reported.
+func (h *LoginHistoryUsecase) detectNewSignIn(ctx context.Context, userID uuid.UUID, info reqmodels.RequestInfo, location *geoipinfra.Location) (newDevice bool, newCountry bool) {
+	hasHistory, err := h.loginAttempt.HasSuccessfulLogin(ctx, userID)
+	if err != nil {
+		h.logger.Error("failed to check login history", zap.Error(err), zap.String("user_id", userID.String()))
+		return false, false
+	}
+	if !hasHistory {
+		return false, false
+	}
+
+	knownDevice, err := h.loginAttempt.HasSuccessfulLoginFromDevice(ctx, userID, info.DeviceID, info.UserAgent)
+	if err != nil {
+		h.logger.Error("failed to check login history", zap.Error(err), zap.String("user_id", userID.String()))
+		return false, false
+	}
+	newDevice = !knownDevice
+
+	if location != nil {
+		knownCountry, err := h.loginAttempt.HasSuccessfulLoginFromCountry(ctx, userID, location.Country)
+		if err != nil {
+			h.logger.Error("failed to check login history", zap.Error(err), zap.String("user_id", userID.String()))
+			return newDevice, false
+		}
+		newCountry = !knownCountry
+	}
+	return newDevice, newCountry
+}
+
+// sendAlert mails the user about a sign in from a new device or country.
+func (h *LoginHistoryUsecase) sendAlert(ctx context.Context, userID uuid.UUID, info reqmodels.RequestInfo, location *geoipinfra.Location, newDevice bool, newCountry bool) {
+	email, err := h.authAccount.GetContactEmailByUserID(ctx, userID)
+	if err != nil {
+		h.logger.Error("failed to get contact email", zap.Error(err), zap.String("user_id", userID.String()))
+		return
+	}
+	if email == "" {
+		h.logger.Warn("no email to send login alert to", zap.String("user_id", userID.String()))
+		return
+	}
+
+	alert := mailer.NewLoginAlert{
+		Email:        email,
+		LoginTime:    time.Now(),
+		IPAddress:    info.IP,
+		Location:     formatLocation(location),
+		UserAgent:    info.UserAgent,
+		NewDevice:    newDevice,
+		NewCountry:   newCountry,
+		SecurityLink: h.securityURL,
+	}
+	// Publishing may wait for the Kafka batch to flush, which must not delay the sign in
+	go func() {
+		if err := h.mailer.SendNewLoginAlertMail(alert); err != nil {
+			h.logger.Error("failed to send login alert", zap.Error(err), zap.String("user_id", userID.String()))
+		}
+	}()
+}
+
+// formatLocation renders a location as "City, CC", or "Unknown" if it could not be resolved.
+func formatLocation(location *geoipinfra.Location) string {
+	if location == nil {
+		return "Unknown"
+	}
+	return strings.TrimPrefix(location.City+", "+location.Country, ", ")
+}
+
+// NewLoginHistoryUsecase creates a new instance of LoginHistoryUsecase.
+//
+// securityURL is the page linked from login alerts, where users can review and revoke their sessions.
+func NewLoginHistoryUsecase(
+	loginAttempt *dbrepo.LoginAttemptRepository,
+	authAccount *dbrepo.AuthAccountRepository,
+	locator *geoipinfra.Locator,
+	mailer *mailer.Mailer,
+	securityURL string,
+	logger *zap.Logger,
+) *LoginHistoryUsecase {
+	return &LoginHistoryUsecase{
+		loginAttempt: loginAttempt,
+		authAccount:  authAccount,
+		locator:      locator,
+		mailer:       mailer,
+		securityURL:  securityURL,
+		logger:       logger,
+	}
+}
@@ -0,0 +1,148 @@
+package oauthclient
+
+import (
+	"context"
+	"slices"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+
+	auditinfra "mandacode.com/accounts/auth/internal/infra/audit"
+	auditmodels "mandacode.com/accounts/auth/internal/models/audit"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	oauthclientdto "mandacode.com/accounts/auth/internal/usecase/oauthclient/dto"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+type AdminClientUsecase struct {
+	oauthClient *dbrepo.OAuthClientRepository
+	secretGen   *util.RandomGenerator
+	validator   *validator.Validate
+	audit       *auditinfra.Emitter
+}
+
+// CreateClient registers a new OAuth client with a generated client ID.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - input: The client registration.
+//
+// Returns:
+//   - output: The registered client and, for confidential clients, the plain text secret which is not stored.
+//   - err: An error if the registration is invalid or fails.
+func (a *AdminClientUsecase) CreateClient(ctx context.Context, input oauthclientdto.CreateClientInput) (*oauthclientdto.ClientWithSecret, error) {
+	if slices.Contains(input.GrantTypes, dbmodels.GrantTypeAuthorizationCode) && len(input.RedirectURIs) == 0 {
+		return nil, errors.New("authorization code clients need a redirect URI", "Redirect URI Required", errcode.ErrInvalidInput)
+	}
+	if slices.Contains(input.GrantTypes, dbmodels.GrantTypeClientCredentials) && !input.Confidential {
+		return nil, errors.New("client credentials clients must be confidential", "Confidential Client Required", errcode.ErrInvalidInput)
+	}
+
+	create := &dbmodels.CreateOAuthClientInput{
+		ClientID:     uuid.New().String(),
+		Name:         input.Name,
+		RedirectURIs: input.RedirectURIs,
+		Scopes:       input.Scopes,
+		GrantTypes:   input.GrantTypes,
+	}
+	if input.Confidential {
+		secret, err := a.secretGen.GenerateSecureRandomCode()
+		if err != nil {
+			return nil, errors.New(err.Error(), "Failed to generate client secret", errcode.ErrInternalFailure)
+		}
+		create.Secret = &secret
+	}
+	if err := a.validator.Struct(create); err != nil {
+		joinedErr := errors.Join(err, "validation failed")
+		return nil, errors.Upgrade(joinedErr, "Invalid Client", errcode.ErrInvalidInput)
+	}
+
+	client, err := a.oauthClient.CreateClient(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.audit.Emit(ctx, auditmodels.ActionOAuthClientCreate, auditmodels.TargetTypeOAuthClient, client.ClientID, nil, client); err != nil {
+		return nil, errors.Join(err, "Failed to audit client creation")
+	}
+	return &oauthclientdto.ClientWithSecret{Client: client, Secret: create.Secret}, nil
+}
+
+// GetClient returns the registered client.
+func (a *AdminClientUsecase) GetClient(ctx context.Context, clientID string) (*dbmodels.SecureOAuthClient, error) {
+	return a.oauthClient.GetClientByClientID(ctx, clientID)
+}
+
+// RotateSecret generates a new secret for a confidential client, invalidating the previous one.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - clientID: The client ID of the client.
+//
+// Returns:
+//   - output: The updated client and its new plain text secret.
+//   - err: An error if the client does not exist, is public, or the update fails.
+func (a *AdminClientUsecase) RotateSecret(ctx context.Context, clientID string) (*oauthclientdto.ClientWithSecret, error) {
+	client, err := a.oauthClient.GetClientByClientID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !client.IsConfidential {
+		return nil, errors.New("public clients have no secret", "Public Client Has No Secret", errcode.ErrConflict)
+	}
+
+	secret, err := a.secretGen.GenerateSecureRandomCode()
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to generate client secret", errcode.ErrInternalFailure)
+	}
+	rotated, err := a.oauthClient.RotateSecret(ctx, clientID, secret)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.audit.Emit(ctx, auditmodels.ActionOAuthClientRotateSecret, auditmodels.TargetTypeOAuthClient, clientID, client, rotated); err != nil {
+		return nil, errors.Join(err, "Failed to audit secret rotation")
+	}
+	return &oauthclientdto.ClientWithSecret{Client: rotated, Secret: &secret}, nil
+}
+
+// DisableClient disables the client so that it can no longer obtain tokens.
+func (a *AdminClientUsecase) DisableClient(ctx context.Context, clientID string) (*dbmodels.SecureOAuthClient, error) {
+	return a.setDisabled(ctx, auditmodels.ActionOAuthClientDisable, clientID, true)
+}
+
+// EnableClient re-enables a disabled client.
+func (a *AdminClientUsecase) EnableClient(ctx context.Context, clientID string) (*dbmodels.SecureOAuthClient, error) {
+	return a.setDisabled(ctx, auditmodels.ActionOAuthClientEnable, clientID, false)
+}
+
+// setDisabled disables or enables the client and audits the change.
+func (a *AdminClientUsecase) setDisabled(ctx context.Context, action string, clientID string, disabled bool) (*dbmodels.SecureOAuthClient, error) {
+	before, err := a.oauthClient.GetClientByClientID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := a.oauthClient.SetDisabled(ctx, clientID, disabled)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.audit.Emit(ctx, action, auditmodels.TargetTypeOAuthClient, clientID, before, client); err != nil {
+		return nil, errors.Join(err, "Failed to audit client change")
+	}
+	return client, nil
+}
+
+func NewAdminClientUsecase(
+	oauthClient *dbrepo.OAuthClientRepository,
+	secretGen *util.RandomGenerator,
+	validator *validator.Validate,
+	audit *auditinfra.Emitter,
+) *AdminClientUsecase {
+	return &AdminClientUsecase{
+		oauthClient: oauthClient,
+		secretGen:   secretGen,
+		validator:   validator,
+		audit:       audit,
+	}
+}
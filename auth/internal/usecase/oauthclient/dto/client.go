@@ -0,0 +1,16 @@
+package oauthclientdto
+
+import dbmodels "mandacode.com/accounts/auth/internal/models/database"
+
+type CreateClientInput struct {
+	Name         string   `json:"name"`
+	RedirectURIs []string `json:"redirect_uris"`
+	Scopes       []string `json:"scopes"`
+	GrantTypes   []string `json:"grant_types"`
+	Confidential bool     `json:"confidential"`
+}
+
+type ClientWithSecret struct {
+	Client *dbmodels.SecureOAuthClient `json:"client"`
+	Secret *string                     `json:"secret,omitempty"` // Only returned when the secret is created or rotated
+}
@@ -0,0 +1,64 @@
+package oidcdto
+
+import reqmodels "mandacode.com/accounts/auth/internal/models/request"
+
+type AuthorizeInput struct {
+	ResponseType        string `json:"response_type"`
+	ClientID            string `json:"client_id"`
+	RedirectURI         string `json:"redirect_uri"`
+	Scope               string `json:"scope"`
+	State               string `json:"state"`
+	Nonce               string `json:"nonce"`
+	CodeChallenge       string `json:"code_challenge"`
+	CodeChallengeMethod string `json:"code_challenge_method"`
+	Prompt              string `json:"prompt"`
+	RequestURI          string `json:"request_uri"`   // The original authorization request, used to resume it after login
+	RefreshToken        string `json:"refresh_token"` // The refresh token of the browser session, if logged in
+}
+
+type AuthorizeOutput struct {
+	RedirectURL string `json:"redirect_url"`
+}
+
+type TokenInput struct {
+	GrantType    string                `json:"grant_type"`
+	Code         string                `json:"code"`
+	RedirectURI  string                `json:"redirect_uri"`
+	ClientID     string                `json:"client_id"`
+	ClientSecret string                `json:"client_secret"`
+	CodeVerifier string                `json:"code_verifier"`
+	RefreshToken string                `json:"refresh_token"`
+	Scope        string                `json:"scope"`
+	Info         reqmodels.RequestInfo `json:"info"` // The request information of the client, recorded on offline sessions
+}
+
+type TokenOutput struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope,omitempty"` // Omitted on refresh, where the scope of the original grant is kept
+}
+
+type UserInfoOutput struct {
+	Sub           string `json:"sub"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
+}
+
+type DiscoveryDocument struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+}
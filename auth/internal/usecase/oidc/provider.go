@@ -0,0 +1,606 @@
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	stdErrors "errors"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+
+	"mandacode.com/accounts/auth/ent/authaccount"
+	"mandacode.com/accounts/auth/ent/session"
+	idtokeninfra "mandacode.com/accounts/auth/internal/infra/idtoken"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	oidcmodels "mandacode.com/accounts/auth/internal/models/oidc"
+	coderepo "mandacode.com/accounts/auth/internal/repository/code"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	tokenrepo "mandacode.com/accounts/auth/internal/repository/token"
+	"mandacode.com/accounts/auth/internal/usecase/login"
+	oidcdto "mandacode.com/accounts/auth/internal/usecase/oidc/dto"
+	"mandacode.com/accounts/auth/internal/usecase/userstatus"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+// OAuth 2.0 and OpenID Connect error codes returned to clients.
+const (
+	ErrInvalidRequest          = "invalid_request"
+	ErrInvalidClient           = "invalid_client"
+	ErrInvalidGrant            = "invalid_grant"
+	ErrInvalidScope            = "invalid_scope"
+	ErrUnsupportedGrantType    = "unsupported_grant_type"
+	ErrUnsupportedResponseType = "unsupported_response_type"
+	ErrUnauthorizedClient      = "unauthorized_client"
+	ErrLoginRequired           = "login_required"
+	ErrConsentRequired         = "consent_required"
+	ErrAccessDenied            = "access_denied"
+)
+
+const (
+	ScopeOpenID        = "openid"
+	ScopeEmail         = "email"
+	ScopeOfflineAccess = "offline_access"
+
+	GrantTypeAuthorizationCode = dbmodels.GrantTypeAuthorizationCode
+	GrantTypeClientCredentials = dbmodels.GrantTypeClientCredentials
+	GrantTypeRefreshToken      = dbmodels.GrantTypeRefreshToken
+	ResponseTypeCode           = "code"
+	CodeChallengeMethodS256    = "S256"
+)
+
+// userScopes are the OpenID Connect scopes which only make sense when a user is involved.
+var userScopes = []string{ScopeOpenID, ScopeEmail, ScopeOfflineAccess}
+
+type ProviderUsecase struct {
+	authAccount   *dbrepo.AuthAccountRepository
+	oauthClient   *dbrepo.OAuthClientRepository
+	token         *tokenrepo.TokenRepository
+	authzCodes    *coderepo.CodeManager
+	idTokenSigner *idtokeninfra.IDTokenSigner
+	loginURL      string
+	session       *dbrepo.SessionRepository
+	userStatus    *userstatus.UserStatusUsecase
+	consent       *login.ConsentUsecase
+}
+
+// Authorize handles an authorization request of the authorization code flow.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - input: The authorization request and the refresh token of the browser session.
+//
+// Returns:
+//   - output: The URL the user agent must be redirected to, which is either the client's redirect URI or the login page.
+//   - err: An error if the client or redirect URI is invalid, in which case the user agent must not be redirected.
+func (p *ProviderUsecase) Authorize(ctx context.Context, input oidcdto.AuthorizeInput) (*oidcdto.AuthorizeOutput, error) {
+	// The client and redirect URI must be validated before any redirect is made
+	client, err := p.oauthClient.GetClientByClientID(ctx, input.ClientID)
+	if err != nil {
+		if errors.Is(err, errcode.ErrNotFound) {
+			return nil, errors.New("unknown client", ErrInvalidClient, errcode.ErrInvalidInput)
+		}
+		return nil, errors.Upgrade(err, "Failed to get client", errcode.ErrInternalFailure)
+	}
+	if client.IsDisabled {
+		return nil, errors.New("client is disabled", ErrInvalidClient, errcode.ErrInvalidInput)
+	}
+	if !client.HasRedirectURI(input.RedirectURI) {
+		return nil, errors.New("redirect_uri is not registered for the client", ErrInvalidRequest, errcode.ErrInvalidInput)
+	}
+
+	if input.ResponseType != ResponseTypeCode {
+		return errorRedirect(input.RedirectURI, input.State, ErrUnsupportedResponseType, "only the code response type is supported")
+	}
+	if !client.AllowsGrantType(GrantTypeAuthorizationCode) {
+		return errorRedirect(input.RedirectURI, input.State, ErrUnauthorizedClient, "the client may not use the authorization code grant")
+	}
+	scopes := strings.Fields(input.Scope)
+	if !slices.Contains(scopes, ScopeOpenID) {
+		return errorRedirect(input.RedirectURI, input.State, ErrInvalidScope, "the openid scope is required")
+	}
+	if !client.AllowsScopes(scopes) {
+		return errorRedirect(input.RedirectURI, input.State, ErrInvalidScope, "the client may not request the scope")
+	}
+	if input.CodeChallenge == "" && !client.IsConfidential {
+		return errorRedirect(input.RedirectURI, input.State, ErrInvalidRequest, "public clients must use PKCE")
+	}
+	if input.CodeChallenge != "" && input.CodeChallengeMethod != CodeChallengeMethodS256 {
+		return errorRedirect(input.RedirectURI, input.State, ErrInvalidRequest, "code_challenge_method must be S256")
+	}
+
+	// Resolve the logged-in user from the browser session
+	s, err := p.browserSession(ctx, input.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		if input.Prompt == "none" {
+			return errorRedirect(input.RedirectURI, input.State, ErrLoginRequired, "the user is not logged in")
+		}
+		return p.loginRedirect(input.RequestURI)
+	}
+
+	// The user may have been blocked or have documents to accept since the browser session signed in
+	if err := p.userStatus.Check(ctx, s.UserID); err != nil {
+		if errors.Is(err, errcode.ErrAccountDisabled) {
+			return errorRedirect(input.RedirectURI, input.State, ErrAccessDenied, "the user may not sign in")
+		}
+		return nil, err
+	}
+	if err := p.consent.Require(ctx, s.UserID); err != nil {
+		var consentErr *login.ConsentRequiredError
+		if stdErrors.As(err, &consentErr) {
+			return errorRedirect(input.RedirectURI, input.State, ErrConsentRequired, "the user has documents to accept")
+		}
+		return nil, err
+	}
+
+	grant := oidcmodels.AuthorizationGrant{
+		ClientID:            client.ClientID,
+		RedirectURI:         input.RedirectURI,
+		UserID:              s.UserID,
+		Scopes:              scopes,
+		Nonce:               input.Nonce,
+		CodeChallenge:       input.CodeChallenge,
+		CodeChallengeMethod: input.CodeChallengeMethod,
+		AuthTime:            s.CreatedAt,
+		IssuedAt:            time.Now(),
+	}
+	code, err := p.authzCodes.IssueCodeWithPayload(ctx, grant)
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to issue authorization code", errcode.ErrInternalFailure)
+	}
+
+	redirectURL, err := url.Parse(input.RedirectURI)
+	if err != nil {
+		return nil, errors.New(err.Error(), ErrInvalidRequest, errcode.ErrInvalidInput)
+	}
+	query := redirectURL.Query()
+	query.Set("code", code)
+	if input.State != "" {
+		query.Set("state", input.State)
+	}
+	redirectURL.RawQuery = query.Encode()
+
+	return &oidcdto.AuthorizeOutput{RedirectURL: redirectURL.String()}, nil
+}
+
+// Token handles a token request at the token endpoint.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - input: The token request including the client credentials.
+//
+// Returns:
+//   - output: The issued access token, ID token and, for the offline_access scope, refresh token.
+//   - err: An error whose public message is the OAuth error code if the request is rejected.
+func (p *ProviderUsecase) Token(ctx context.Context, input oidcdto.TokenInput) (*oidcdto.TokenOutput, error) {
+	// Refresh tokens are only issued by the authorization code grant, so its clients may use them
+	allowedGrantType := input.GrantType
+	switch input.GrantType {
+	case GrantTypeAuthorizationCode, GrantTypeClientCredentials:
+	case GrantTypeRefreshToken:
+		allowedGrantType = GrantTypeAuthorizationCode
+	default:
+		return nil, errors.New("unsupported grant type", ErrUnsupportedGrantType, errcode.ErrInvalidInput)
+	}
+
+	client, err := p.authenticateClient(ctx, input.ClientID, input.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	if !client.AllowsGrantType(allowedGrantType) {
+		return nil, errors.New("grant type is not allowed for the client", ErrUnauthorizedClient, errcode.ErrInvalidInput)
+	}
+
+	switch input.GrantType {
+	case GrantTypeClientCredentials:
+		return p.issueClientCredentials(ctx, client, input)
+	case GrantTypeRefreshToken:
+		return p.refreshTokens(ctx, client, input)
+	default:
+		return p.exchangeAuthorizationCode(ctx, input)
+	}
+}
+
+// UserInfo returns the claims about the user the access token was issued to. Impersonation tokens are refused, so
+// that an administrator cannot sign in to clients as the user.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - accessToken: The bearer access token.
+//
+// Returns:
+//   - output: The user claims.
+//   - err: An error if the access token is invalid.
+func (p *ProviderUsecase) UserInfo(ctx context.Context, accessToken string) (*oidcdto.UserInfoOutput, error) {
+	valid, userID, actorID, err := p.token.VerifyAccessTokenActor(ctx, accessToken)
+	if err != nil {
+		joinedErr := errors.Join(err, "failed to verify access token")
+		return nil, errors.Upgrade(joinedErr, "invalid_token", errcode.ErrInvalidToken)
+	}
+	if !valid || userID == nil {
+		return nil, errors.New("invalid access token", "invalid_token", errcode.ErrInvalidToken)
+	}
+	if actorID != nil {
+		return nil, errors.New("impersonation tokens are not accepted", "invalid_token", errcode.ErrInvalidToken)
+	}
+	userUID, err := uuid.Parse(*userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID in access token", "invalid_token", errcode.ErrInvalidToken)
+	}
+
+	output := &oidcdto.UserInfoOutput{Sub: userUID.String()}
+	account, err := p.primaryAccount(ctx, userUID)
+	if err != nil {
+		return nil, err
+	}
+	if account != nil {
+		output.Email = account.Email
+		output.EmailVerified = &account.IsVerified
+	}
+	return output, nil
+}
+
+// Discovery returns the OpenID Provider metadata document. It only advertises the grant types of the token
+// endpoint; the device authorization grant has its own endpoints under /v1/device.
+func (p *ProviderUsecase) Discovery() *oidcdto.DiscoveryDocument {
+	issuer := strings.TrimSuffix(p.idTokenSigner.Issuer(), "/")
+	return &oidcdto.DiscoveryDocument{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + "/v1/oidc/authorize",
+		TokenEndpoint:                     issuer + "/v1/oidc/token",
+		UserInfoEndpoint:                  issuer + "/v1/oidc/userinfo",
+		JWKSURI:                           issuer + "/v1/oidc/jwks",
+		ResponseTypesSupported:            []string{ResponseTypeCode},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{"RS256"},
+		ScopesSupported:                   userScopes,
+		ClaimsSupported:                   []string{"sub", "iss", "aud", "exp", "iat", "auth_time", "nonce", "email", "email_verified"},
+		GrantTypesSupported:               []string{GrantTypeAuthorizationCode, GrantTypeClientCredentials, GrantTypeRefreshToken},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{CodeChallengeMethodS256},
+	}
+}
+
+// JWKS returns the public keys used to verify ID tokens.
+func (p *ProviderUsecase) JWKS() idtokeninfra.JSONWebKeySet {
+	return p.idTokenSigner.JWKS()
+}
+
+// exchangeAuthorizationCode redeems an authorization code for tokens.
+func (p *ProviderUsecase) exchangeAuthorizationCode(ctx context.Context, input oidcdto.TokenInput) (*oidcdto.TokenOutput, error) {
+	if input.Code == "" || input.RedirectURI == "" {
+		return nil, errors.New("code and redirect_uri are required", ErrInvalidRequest, errcode.ErrInvalidInput)
+	}
+	var grant oidcmodels.AuthorizationGrant
+	found, err := p.authzCodes.ConsumeCodePayload(ctx, input.Code, &grant)
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to consume authorization code", errcode.ErrInternalFailure)
+	}
+	if !found {
+		return nil, errors.New("authorization code is invalid or expired", ErrInvalidGrant, errcode.ErrInvalidInput)
+	}
+	if grant.ClientID != input.ClientID || grant.RedirectURI != input.RedirectURI {
+		return nil, errors.New("authorization code was issued to another client or redirect URI", ErrInvalidGrant, errcode.ErrInvalidInput)
+	}
+	if grant.CodeChallenge != "" && !verifyCodeChallenge(grant.CodeChallenge, input.CodeVerifier) {
+		return nil, errors.New("code_verifier does not match the code challenge", ErrInvalidGrant, errcode.ErrInvalidInput)
+	}
+	if err := p.checkUser(ctx, grant.UserID); err != nil {
+		return nil, err
+	}
+
+	accessToken, expiresAt, err := p.token.GenerateAccessToken(ctx, grant.UserID)
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to generate token", errcode.ErrInternalFailure)
+	}
+	output := &oidcdto.TokenOutput{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   max(expiresAt-time.Now().Unix(), 0),
+		Scope:       strings.Join(grant.Scopes, " "),
+	}
+	if slices.Contains(grant.Scopes, ScopeOfflineAccess) {
+		output.RefreshToken, _, err = p.token.GenerateRefreshToken(ctx, grant.UserID)
+		if err != nil {
+			return nil, errors.Upgrade(err, "Failed to generate token", errcode.ErrInternalFailure)
+		}
+		_, err = p.session.CreateSession(ctx, &dbmodels.CreateSessionInput{
+			UserID:           grant.UserID,
+			RefreshTokenHash: util.HashToken(output.RefreshToken),
+			LoginMethod:      session.LoginMethodOidc,
+			Provider:         &grant.ClientID,
+			UserAgent:        input.Info.UserAgent,
+			IPAddress:        input.Info.IP,
+		})
+		if err != nil {
+			return nil, errors.Upgrade(err, "Failed to create session", errcode.ErrInternalFailure)
+		}
+	}
+
+	output.IDToken, err = p.issueIDToken(ctx, grant)
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// refreshTokens exchanges a refresh token issued to the client for the offline_access scope. The refresh token of
+// the session is rotated, so that a stolen token is only usable until the client refreshes again.
+func (p *ProviderUsecase) refreshTokens(ctx context.Context, client *dbmodels.SecureOAuthClient, input oidcdto.TokenInput) (*oidcdto.TokenOutput, error) {
+	if input.RefreshToken == "" {
+		return nil, errors.New("refresh_token is required", ErrInvalidRequest, errcode.ErrInvalidInput)
+	}
+	valid, userID, err := p.token.VerifyRefreshToken(ctx, input.RefreshToken)
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to verify refresh token", errcode.ErrInternalFailure)
+	}
+	if !valid || userID == nil {
+		return nil, errors.New("refresh token is invalid or expired", ErrInvalidGrant, errcode.ErrInvalidInput)
+	}
+	userUID, err := uuid.Parse(*userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID in refresh token", ErrInvalidGrant, errcode.ErrInvalidInput)
+	}
+
+	// The session must have been created by this client, and must not have been revoked
+	oldHash := util.HashToken(input.RefreshToken)
+	s, err := p.session.GetSessionByRefreshTokenHash(ctx, oldHash)
+	if err != nil {
+		if errors.Is(err, errcode.ErrNotFound) {
+			return nil, errors.New("refresh token has no session", ErrInvalidGrant, errcode.ErrInvalidInput)
+		}
+		return nil, errors.Upgrade(err, "Failed to get session", errcode.ErrInternalFailure)
+	}
+	if !s.IsActive() || s.UserID != userUID {
+		return nil, errors.New("session is revoked", ErrInvalidGrant, errcode.ErrInvalidInput)
+	}
+	if s.LoginMethod != session.LoginMethodOidc || s.Provider == nil || *s.Provider != client.ClientID {
+		return nil, errors.New("refresh token was issued to another client", ErrInvalidGrant, errcode.ErrInvalidInput)
+	}
+	if err := p.checkUser(ctx, userUID); err != nil {
+		return nil, err
+	}
+
+	accessToken, expiresAt, err := p.token.GenerateAccessToken(ctx, userUID)
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to generate token", errcode.ErrInternalFailure)
+	}
+	refreshToken, _, err := p.token.GenerateRefreshToken(ctx, userUID)
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to generate token", errcode.ErrInternalFailure)
+	}
+	if err := p.session.RotateRefreshToken(ctx, s.ID, oldHash, util.HashToken(refreshToken), input.Info.IP); err != nil {
+		return nil, err
+	}
+
+	return &oidcdto.TokenOutput{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    max(expiresAt-time.Now().Unix(), 0),
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// checkUser refuses to issue tokens to a user who was blocked, deactivated or archived since the grant.
+func (p *ProviderUsecase) checkUser(ctx context.Context, userID uuid.UUID) error {
+	if err := p.userStatus.Check(ctx, userID); err != nil {
+		if errors.Is(err, errcode.ErrAccountDisabled) {
+			return errors.Upgrade(err, ErrInvalidGrant, errcode.ErrInvalidInput)
+		}
+		return err
+	}
+	return nil
+}
+
+// issueClientCredentials issues an access token to a confidential client acting on its own behalf.
+func (p *ProviderUsecase) issueClientCredentials(ctx context.Context, client *dbmodels.SecureOAuthClient, input oidcdto.TokenInput) (*oidcdto.TokenOutput, error) {
+	if !client.IsConfidential {
+		return nil, errors.New("public clients cannot use the client credentials grant", ErrUnauthorizedClient, errcode.ErrInvalidInput)
+	}
+
+	// Default to every allowed scope which does not require a user
+	scopes := strings.Fields(input.Scope)
+	if len(scopes) == 0 {
+		for _, scope := range client.Scopes {
+			if !slices.Contains(userScopes, scope) {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	for _, scope := range scopes {
+		if slices.Contains(userScopes, scope) {
+			return nil, errors.New("user scopes cannot be granted to a client", ErrInvalidScope, errcode.ErrInvalidInput)
+		}
+	}
+	if !client.AllowsScopes(scopes) {
+		return nil, errors.New("the client may not request the scope", ErrInvalidScope, errcode.ErrInvalidInput)
+	}
+
+	accessToken, expiresAt, err := p.token.GenerateClientAccessToken(ctx, client.ClientID, scopes)
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to generate token", errcode.ErrInternalFailure)
+	}
+	return &oidcdto.TokenOutput{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   max(expiresAt-time.Now().Unix(), 0),
+		Scope:       strings.Join(scopes, " "),
+	}, nil
+}
+
+// issueIDToken signs the ID token for the authorization grant.
+func (p *ProviderUsecase) issueIDToken(ctx context.Context, grant oidcmodels.AuthorizationGrant) (string, error) {
+	claims := map[string]any{
+		"sub":       grant.UserID.String(),
+		"aud":       grant.ClientID,
+		"auth_time": grant.AuthTime.Unix(),
+	}
+	if grant.Nonce != "" {
+		claims["nonce"] = grant.Nonce
+	}
+	if slices.Contains(grant.Scopes, ScopeEmail) {
+		account, err := p.primaryAccount(ctx, grant.UserID)
+		if err != nil {
+			return "", err
+		}
+		if account != nil {
+			claims["email"] = account.Email
+			claims["email_verified"] = account.IsVerified
+		}
+	}
+
+	idToken, _, err := p.idTokenSigner.Sign(claims)
+	if err != nil {
+		return "", errors.Upgrade(err, "Failed to sign ID token", errcode.ErrInternalFailure)
+	}
+	return idToken, nil
+}
+
+// authenticateClient verifies the client credentials presented at the token endpoint.
+func (p *ProviderUsecase) authenticateClient(ctx context.Context, clientID string, clientSecret string) (*dbmodels.SecureOAuthClient, error) {
+	if clientID == "" {
+		return nil, errors.New("client_id is required", ErrInvalidClient, errcode.ErrUnauthorized)
+	}
+	client, err := p.oauthClient.GetClientByClientID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, errcode.ErrNotFound) {
+			return nil, errors.New("unknown client", ErrInvalidClient, errcode.ErrUnauthorized)
+		}
+		return nil, errors.Upgrade(err, "Failed to get client", errcode.ErrInternalFailure)
+	}
+	if client.IsDisabled {
+		return nil, errors.New("client is disabled", ErrInvalidClient, errcode.ErrUnauthorized)
+	}
+	if !client.IsConfidential {
+		return client, nil
+	}
+
+	valid, err := p.oauthClient.CompareSecret(ctx, clientID, clientSecret)
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to verify client secret", errcode.ErrInternalFailure)
+	}
+	if !valid {
+		return nil, errors.New("invalid client secret", ErrInvalidClient, errcode.ErrUnauthorized)
+	}
+	return client, nil
+}
+
+// browserSession resolves the session of the browser from its refresh token, returning nil if not logged in or if
+// the session has been revoked. Its creation time is when the user authenticated, as rotations keep the session.
+func (p *ProviderUsecase) browserSession(ctx context.Context, refreshToken string) (*dbmodels.SecureSession, error) {
+	if refreshToken == "" {
+		return nil, nil
+	}
+	valid, userID, err := p.token.VerifyRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to verify session", errcode.ErrInternalFailure)
+	}
+	if !valid || userID == nil {
+		return nil, nil
+	}
+	userUID, err := uuid.Parse(*userID)
+	if err != nil {
+		return nil, nil
+	}
+
+	s, err := p.session.GetSessionByRefreshTokenHash(ctx, util.HashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, errcode.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Upgrade(err, "Failed to get session", errcode.ErrInternalFailure)
+	}
+	if !s.IsActive() || s.UserID != userUID {
+		return nil, nil
+	}
+	return s, nil
+}
+
+// primaryAccount returns the account whose email represents the user, preferring the local account.
+func (p *ProviderUsecase) primaryAccount(ctx context.Context, userID uuid.UUID) (*dbmodels.SecureAuthAccount, error) {
+	accounts, err := p.authAccount.GetAuthAccountsByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to get auth accounts", errcode.ErrInternalFailure)
+	}
+	var primary *dbmodels.SecureAuthAccount
+	for _, account := range accounts {
+		if account.Provider == authaccount.ProviderLocal {
+			return account, nil
+		}
+		if primary == nil || (!primary.IsVerified && account.IsVerified) {
+			primary = account
+		}
+	}
+	return primary, nil
+}
+
+// loginRedirect sends the user agent to the login page, which resumes the authorization request afterwards.
+func (p *ProviderUsecase) loginRedirect(requestURI string) (*oidcdto.AuthorizeOutput, error) {
+	loginURL, err := url.Parse(p.loginURL)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Invalid login URL", errcode.ErrInternalFailure)
+	}
+	query := loginURL.Query()
+	query.Set("return_to", strings.TrimSuffix(p.idTokenSigner.Issuer(), "/")+requestURI)
+	loginURL.RawQuery = query.Encode()
+	return &oidcdto.AuthorizeOutput{RedirectURL: loginURL.String()}, nil
+}
+
+// errorRedirect returns an authorization error to the client through its redirect URI.
+func errorRedirect(redirectURI string, state string, code string, description string) (*oidcdto.AuthorizeOutput, error) {
+	redirectURL, err := url.Parse(redirectURI)
+	if err != nil {
+		return nil, errors.New(err.Error(), ErrInvalidRequest, errcode.ErrInvalidInput)
+	}
+	query := redirectURL.Query()
+	query.Set("error", code)
+	query.Set("error_description", description)
+	if state != "" {
+		query.Set("state", state)
+	}
+	redirectURL.RawQuery = query.Encode()
+	return &oidcdto.AuthorizeOutput{RedirectURL: redirectURL.String()}, nil
+}
+
+// verifyCodeChallenge checks the PKCE code verifier against the S256 code challenge.
+func verifyCodeChallenge(challenge string, verifier string) bool {
+	if verifier == "" {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	computed := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(challenge)) == 1
+}
+
+func NewProviderUsecase(
+	authAccount *dbrepo.AuthAccountRepository,
+	oauthClient *dbrepo.OAuthClientRepository,
+	token *tokenrepo.TokenRepository,
+	authzCodes *coderepo.CodeManager,
+	idTokenSigner *idtokeninfra.IDTokenSigner,
+	loginURL string,
+	session *dbrepo.SessionRepository,
+	userStatus *userstatus.UserStatusUsecase,
+	consent *login.ConsentUsecase,
+) *ProviderUsecase {
+	return &ProviderUsecase{
+		authAccount:   authAccount,
+		oauthClient:   oauthClient,
+		token:         token,
+		authzCodes:    authzCodes,
+		idTokenSigner: idTokenSigner,
+		loginURL:      loginURL,
+		session:       session,
+		userStatus:    userStatus,
+		consent:       consent,
+	}
+}
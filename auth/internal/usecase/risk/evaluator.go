@@ -0,0 +1,259 @@
+package risk
+
+import (
+	"context"
+	"math"
+	"net/netip"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+
+	"mandacode.com/accounts/auth/ent/loginattempt"
+	geoipinfra "mandacode.com/accounts/auth/internal/infra/geoip"
+	reqmodels "mandacode.com/accounts/auth/internal/models/request"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+)
+
+// Decision is the outcome of a risk evaluation, ordered by severity.
+type Decision string
+
+const (
+	DecisionAllow  Decision = "allow"
+	DecisionStepUp Decision = "step_up"
+	DecisionDeny   Decision = "deny"
+)
+
+// Reasons reported for a decision other than allow.
+const (
+	ReasonDeniedNetwork    = "denied_network"
+	ReasonStepUpNetwork    = "step_up_network"
+	ReasonFailedAttempts   = "failed_attempts"
+	ReasonImpossibleTravel = "impossible_travel"
+	ReasonNewDevice        = "new_device"
+)
+
+const (
+	earthRadiusKM = 6371.0
+
+	// minTravelDistanceKM ignores distances within the accuracy of IP geolocation.
+	minTravelDistanceKM = 100.0
+)
+
+// severity orders decisions so that signals can be combined by taking the most severe one.
+var severity = map[Decision]int{
+	DecisionAllow:  0,
+	DecisionStepUp: 1,
+	DecisionDeny:   2,
+}
+
+// Rules configure the signals evaluated at sign in and the decision each of them leads to.
+type Rules struct {
+	Enabled                  bool
+	DenyCIDRs                []string      // Networks whose sign ins are denied
+	StepUpCIDRs              []string      // Networks whose sign ins require a step-up
+	FailedAttemptsWindow     time.Duration // The window failed attempts are counted in
+	FailedAttemptsStepUp     int           // Failed attempts in the window requiring a step-up, 0 disables the rule
+	FailedAttemptsDeny       int           // Failed attempts in the window denying the sign in, 0 disables the rule
+	ImpossibleTravelSpeedKMH float64       // Travel speed since the last sign in considered impossible, 0 disables the rule
+	ImpossibleTravelAction   Decision
+	NewDeviceAction          Decision
+}
+
+// Assessment is the decision reached for a sign in and the signals which led to it.
+type Assessment struct {
+	Decision Decision
+	Reasons  []string
+}
+
+// raise records a signal and escalates the decision if the signal's decision is more severe.
+func (a *Assessment) raise(decision Decision, reason string) {
+	if decision == DecisionAllow {
+		return
+	}
+	a.Reasons = append(a.Reasons, reason)
+	if severity[decision] > severity[a.Decision] {
+		a.Decision = decision
+	}
+}
+
+type Evaluator struct {
+	loginAttempt *dbrepo.LoginAttemptRepository
+	locator      *geoipinfra.Locator
+	rules        Rules
+	denyNets     []netip.Prefix
+	stepUpNets   []netip.Prefix
+	logger       *zap.Logger
+}
+
+// Evaluate assesses the risk of a sign in whose credentials have been verified.
+//
+// Signals whose lookups fail are skipped and logged, so an unavailable history never blocks sign ins.
+// Every decision is logged.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The unique identifier of the user signing in.
+//   - method: The method used to sign in.
+//   - info: The request information of the client.
+func (e *Evaluator) Evaluate(ctx context.Context, userID uuid.UUID, method loginattempt.LoginMethod, info reqmodels.RequestInfo) Assessment {
+	assessment := Assessment{Decision: DecisionAllow}
+	if !e.rules.Enabled {
+		return assessment
+	}
+
+	if addr, err := netip.ParseAddr(info.IP); err == nil {
+		addr = addr.Unmap()
+		if containsAddr(e.denyNets, addr) {
+			assessment.raise(DecisionDeny, ReasonDeniedNetwork)
+		}
+		if containsAddr(e.stepUpNets, addr) {
+			assessment.raise(DecisionStepUp, ReasonStepUpNetwork)
+		}
+	}
+
+	e.evaluateFailedAttempts(ctx, userID, &assessment)
+	e.evaluateImpossibleTravel(ctx, userID, info, &assessment)
+	e.evaluateNewDevice(ctx, userID, info, &assessment)
+
+	e.logger.Info("login risk evaluated",
+		zap.String("user_id", userID.String()),
+		zap.String("ip", info.IP),
+		zap.String("method", string(method)),
+		zap.String("decision", string(assessment.Decision)),
+		zap.Strings("reasons", assessment.Reasons),
+	)
+	return assessment
+}
+
+// evaluateFailedAttempts raises the failed attempts signal if the user failed to sign in too often recently.
+func (e *Evaluator) evaluateFailedAttempts(ctx context.Context, userID uuid.UUID, assessment *Assessment) {
+	if e.rules.FailedAttemptsStepUp <= 0 && e.rules.FailedAttemptsDeny <= 0 {
+		return
+	}
+	failed, err := e.loginAttempt.CountFailedLoginsSince(ctx, userID, time.Now().Add(-e.rules.FailedAttemptsWindow))
+	if err != nil {
+		e.logger.Error("failed to count failed login attempts", zap.Error(err), zap.String("user_id", userID.String()))
+		return
+	}
+	switch {
+	case e.rules.FailedAttemptsDeny > 0 && failed >= e.rules.FailedAttemptsDeny:
+		assessment.raise(DecisionDeny, ReasonFailedAttempts)
+	case e.rules.FailedAttemptsStepUp > 0 && failed >= e.rules.FailedAttemptsStepUp:
+		assessment.raise(DecisionStepUp, ReasonFailedAttempts)
+	}
+}
+
+// evaluateImpossibleTravel raises the impossible travel signal if reaching the client's location from the
+// location of the last sign in would have required travelling faster than the configured speed.
+func (e *Evaluator) evaluateImpossibleTravel(ctx context.Context, userID uuid.UUID, info reqmodels.RequestInfo, assessment *Assessment) {
+	if e.rules.ImpossibleTravelSpeedKMH <= 0 || e.rules.ImpossibleTravelAction == DecisionAllow {
+		return
+	}
+	location := e.locator.Locate(info.IP)
+	if location == nil || !location.HasCoords {
+		return
+	}
+	last, err := e.loginAttempt.GetLastSuccessfulLogin(ctx, userID)
+	if err != nil {
+		e.logger.Error("failed to get last successful login", zap.Error(err), zap.String("user_id", userID.String()))
+		return
+	}
+	if last == nil || last.Latitude == nil || last.Longitude == nil {
+		return
+	}
+
+	distance := haversineKM(*last.Latitude, *last.Longitude, location.Latitude, location.Longitude)
+	if distance < minTravelDistanceKM {
+		return
+	}
+	// Sign ins moments apart are compared as if a minute apart, to avoid dividing by zero
+	hours := max(time.Since(last.CreatedAt), time.Minute).Hours()
+	if distance/hours > e.rules.ImpossibleTravelSpeedKMH {
+		assessment.raise(e.rules.ImpossibleTravelAction, ReasonImpossibleTravel)
+	}
+}
+
+// evaluateNewDevice raises the new device signal if the user has signed in before, but never from this device.
+func (e *Evaluator) evaluateNewDevice(ctx context.Context, userID uuid.UUID, info reqmodels.RequestInfo, assessment *Assessment) {
+	if e.rules.NewDeviceAction == DecisionAllow {
+		return
+	}
+	hasHistory, err := e.loginAttempt.HasSuccessfulLogin(ctx, userID)
+	if err != nil {
+		e.logger.Error("failed to check login history", zap.Error(err), zap.String("user_id", userID.String()))
+		return
+	}
+	if !hasHistory {
+		return
+	}
+	knownDevice, err := e.loginAttempt.HasSuccessfulLoginFromDevice(ctx, userID, info.DeviceID, info.UserAgent)
+	if err != nil {
+		e.logger.Error("failed to check login history", zap.Error(err), zap.String("user_id", userID.String()))
+		return
+	}
+	if !knownDevice {
+		assessment.raise(e.rules.NewDeviceAction, ReasonNewDevice)
+	}
+}
+
+// containsAddr reports whether any of the networks contains the address.
+func containsAddr(networks []netip.Prefix, addr netip.Addr) bool {
+	for _, network := range networks {
+		if network.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// haversineKM returns the great-circle distance in kilometres between two coordinates.
+func haversineKM(lat1, lon1, lat2, lon2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLon := toRad(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKM * math.Asin(math.Sqrt(a))
+}
+
+// parseCIDRs parses a list of networks in CIDR notation.
+func parseCIDRs(cidrs []string) ([]netip.Prefix, error) {
+	networks := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		network, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, errors.New(err.Error(), "Invalid network in risk rules", errcode.ErrInvalidInput)
+		}
+		networks = append(networks, network.Masked())
+	}
+	return networks, nil
+}
+
+// NewEvaluator creates a new instance of Evaluator.
+func NewEvaluator(
+	loginAttempt *dbrepo.LoginAttemptRepository,
+	locator *geoipinfra.Locator,
+	rules Rules,
+	logger *zap.Logger,
+) (*Evaluator, error) {
+	denyNets, err := parseCIDRs(rules.DenyCIDRs)
+	if err != nil {
+		return nil, err
+	}
+	stepUpNets, err := parseCIDRs(rules.StepUpCIDRs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Evaluator{
+		loginAttempt: loginAttempt,
+		locator:      locator,
+		rules:        rules,
+		denyNets:     denyNets,
+		stepUpNets:   stepUpNets,
+		logger:       logger,
+	}, nil
+}
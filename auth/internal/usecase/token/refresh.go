@@ -6,23 +6,36 @@ import (
 	"github.com/google/uuid"
 	"github.com/mandacode-com/golib/errors"
 	"github.com/mandacode-com/golib/errors/errcode"
+	entsession "mandacode.com/accounts/auth/ent/session"
+	reqmodels "mandacode.com/accounts/auth/internal/models/request"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
 	tokenrepo "mandacode.com/accounts/auth/internal/repository/token"
+	"mandacode.com/accounts/auth/internal/usecase/userstatus"
+	"mandacode.com/accounts/auth/internal/util"
 )
 
 type RefreshUsecase struct {
-	token *tokenrepo.TokenRepository
+	token      *tokenrepo.TokenRepository
+	session    *dbrepo.SessionRepository
+	userStatus *userstatus.UserStatusUsecase
 }
 
 // Refresh generates new access and refresh tokens based on a valid refresh token.
 //
+// The refresh token must belong to an active session, whose refresh token is rotated to the new one, and to a
+// user who is not blocked, inactive or archived. The sessions of OIDC clients are refused, as their clients must
+// refresh them at the OIDC token endpoint, which authenticates the client and keeps its scopes.
+//
 // Parameters:
 //   - ctx: The context for the operation.
+//   - refreshToken: The refresh token to exchange.
+//   - info: The request information of the client, recorded on the session.
 //
 // Returns:
 //   - newAccessToken: The newly generated access token.
 //   - newRefreshToken: The newly generated refresh token.
 //   - err: An error if the operation fails, or nil if successful.
-func (r *RefreshUsecase) Refresh(ctx context.Context, refreshToken string) (newAccessToken string, newRefreshToken string, err error) {
+func (r *RefreshUsecase) Refresh(ctx context.Context, refreshToken string, info reqmodels.RequestInfo) (newAccessToken string, newRefreshToken string, err error) {
 	// Validate the refresh token
 	valid, userID, err := r.token.VerifyRefreshToken(ctx, refreshToken)
 	if err != nil {
@@ -31,12 +44,31 @@ func (r *RefreshUsecase) Refresh(ctx context.Context, refreshToken string) (newA
 	if !valid || userID == nil {
 		return "", "", errors.New("invalid refresh token", "Unauthorized", errcode.ErrUnauthorized)
 	}
-
-	// Generate new access and refresh tokens
 	userUID, err := uuid.Parse(*userID)
 	if err != nil {
 		return "", "", errors.New("invalid user ID in refresh token", "Invalid User ID", errcode.ErrUnauthorized)
 	}
+
+	// Check that the session of the refresh token has not been revoked
+	oldHash := util.HashToken(refreshToken)
+	session, err := r.session.GetSessionByRefreshTokenHash(ctx, oldHash)
+	if err != nil {
+		if errors.Is(err, errcode.ErrNotFound) {
+			return "", "", errors.New("refresh token has no session", "Unauthorized", errcode.ErrUnauthorized)
+		}
+		return "", "", errors.Join(err, "failed to get session")
+	}
+	if !session.IsActive() || session.UserID != userUID {
+		return "", "", errors.New("session is revoked", "Unauthorized", errcode.ErrUnauthorized)
+	}
+	if session.LoginMethod == entsession.LoginMethodOidc {
+		return "", "", errors.New("refresh token belongs to an OIDC client", "Unauthorized", errcode.ErrUnauthorized)
+	}
+	if err := r.userStatus.Check(ctx, userUID); err != nil {
+		return "", "", err
+	}
+
+	// Generate new access and refresh tokens
 	newAccessToken, _, err = r.token.GenerateAccessToken(ctx, userUID)
 	if err != nil {
 		return "", "", errors.Join(err, "failed to generate new access token")
@@ -46,12 +78,19 @@ func (r *RefreshUsecase) Refresh(ctx context.Context, refreshToken string) (newA
 		return "", "", errors.Join(err, "failed to generate new refresh token")
 	}
 
+	// Rotate the refresh token of the session, invalidating the old one
+	if err := r.session.RotateRefreshToken(ctx, session.ID, oldHash, util.HashToken(newRefreshToken), info.IP); err != nil {
+		return "", "", err
+	}
+
 	return newAccessToken, newRefreshToken, nil
 }
 
-// NewRefreshUsecase creates a new instance of RefreshUsecase with the provided token repository.
-func NewRefreshUsecase(token *tokenrepo.TokenRepository) *RefreshUsecase {
+// NewRefreshUsecase creates a new instance of RefreshUsecase with the provided token and session repositories.
+func NewRefreshUsecase(token *tokenrepo.TokenRepository, session *dbrepo.SessionRepository, userStatus *userstatus.UserStatusUsecase) *RefreshUsecase {
 	return &RefreshUsecase{
-		token: token,
+		token:      token,
+		session:    session,
+		userStatus: userStatus,
 	}
 }
@@ -34,6 +34,30 @@ func (v *VerifyUsecase) Verify(ctx context.Context, token string) (valid bool, u
 	return true, userID, nil
 }
 
+// VerifyActor verifies the access token like Verify, and also returns the ID of the admin acting as the user
+// if the token is an impersonation token.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - token: The access token to be verified.
+//
+// Returns:
+//   - valid: A boolean indicating whether the token is valid.
+//   - userID: The user ID associated with the token if valid, or nil if invalid.
+//   - actorID: The ID of the impersonating admin, or nil if the token is not an impersonation token.
+//   - err: An error if the verification fails, or nil if successful.
+func (v *VerifyUsecase) VerifyActor(ctx context.Context, token string) (valid bool, userID *string, actorID *string, err error) {
+	valid, userID, actorID, err = v.token.VerifyAccessTokenActor(ctx, token)
+	if err != nil {
+		joinedErr := errors.Join(err, "failed to verify access token")
+		return false, nil, nil, errors.Upgrade(joinedErr, "Unauthorized", errcode.ErrUnauthorized)
+	}
+	if !valid || userID == nil {
+		return false, nil, nil, nil // Token is invalid or user ID is not present
+	}
+	return true, userID, actorID, nil
+}
+
 // VerifyRefresh verifies the refresh token and returns whether it is valid, the user ID if valid, or an error if verification fails.
 //
 // Parameters:
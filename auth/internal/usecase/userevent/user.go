@@ -2,24 +2,161 @@ package userevent
 
 import (
 	"context"
+	"net/url"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/auth/internal/infra/mailer"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	restoremodels "mandacode.com/accounts/auth/internal/models/restore"
 	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	restorerepo "mandacode.com/accounts/auth/internal/repository/restore"
 )
 
 type UserEventUsecase struct {
 	authAccountRepo *dbrepo.AuthAccountRepository
+	apiKeyRepo      *dbrepo.APIKeyRepository
+	sessionRepo     *dbrepo.SessionRepository
+	loginAttempt    *dbrepo.LoginAttemptRepository
+	userStatus      *dbrepo.UserStatusRepository
+	cancelLinks     *restorerepo.TokenManager
+	mailer          *mailer.Mailer
+	cancelLinkURL   string
 }
 
 func (u *UserEventUsecase) HandleUserDeleted(ctx context.Context, userID uuid.UUID) error {
 	if err := u.authAccountRepo.DeleteAuthAccountByUserID(ctx, userID); err != nil {
 		return err
 	}
+	if err := u.apiKeyRepo.DeleteAPIKeysByUserID(ctx, userID); err != nil {
+		return err
+	}
+	if err := u.sessionRepo.DeleteSessionsByUserID(ctx, userID); err != nil {
+		return err
+	}
+	if err := u.loginAttempt.DeleteLoginAttemptsByUserID(ctx, userID); err != nil {
+		return err
+	}
+	if err := u.userStatus.DeleteUserStatus(ctx, userID); err != nil {
+		return err
+	}
 	return nil
 }
 
-func NewUserEventUsecase(authAccountRepo *dbrepo.AuthAccountRepository) *UserEventUsecase {
+// HandleUserArchived marks a user who asked to delete their account as pending deletion, signs them out, and
+// mails them a link cancelling the deletion.
+func (u *UserEventUsecase) HandleUserArchived(ctx context.Context, userID uuid.UUID, syncVersion *int64) error {
+	applied, err := u.userStatus.UpdateUserStatus(ctx, &dbmodels.UpdateUserStatusInput{UserID: userID, IsArchived: boolPtr(true), SyncVersion: syncVersion})
+	if err != nil || !applied {
+		return err
+	}
+	if _, err := u.sessionRepo.RevokeSessionsByUserID(ctx, userID, nil); err != nil {
+		return err
+	}
+
+	email, err := u.authAccountRepo.GetContactEmailByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if email == "" {
+		return nil // The user can still restore their account by signing in
+	}
+	token, err := u.cancelLinks.IssueToken(ctx, &restoremodels.Restore{UserID: userID})
+	if err != nil {
+		return err
+	}
+	link, err := url.Parse(u.cancelLinkURL)
+	if err != nil {
+		return errors.New(err.Error(), "Invalid cancel link", errcode.ErrInternalFailure)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	// The cancel link expires with the grace period of the user service
+	return u.mailer.SendAccountDeletionScheduledMail(mailer.AccountDeletionScheduled{
+		Email:       email,
+		CancelLink:  link.String(),
+		DeleteAfter: time.Now().Add(u.cancelLinks.TokenTTL()),
+	})
+}
+
+// HandleUserRestored clears the pending deletion of a restored user.
+func (u *UserEventUsecase) HandleUserRestored(ctx context.Context, userID uuid.UUID, syncVersion *int64) error {
+	_, err := u.userStatus.UpdateUserStatus(ctx, &dbmodels.UpdateUserStatusInput{UserID: userID, IsArchived: boolPtr(false), SyncVersion: syncVersion})
+	return err
+}
+
+// HandleUserBlocked suspends the API keys and revokes the sessions of a blocked user.
+func (u *UserEventUsecase) HandleUserBlocked(ctx context.Context, userID uuid.UUID, syncVersion *int64) error {
+	applied, err := u.userStatus.UpdateUserStatus(ctx, &dbmodels.UpdateUserStatusInput{UserID: userID, IsBlocked: boolPtr(true), SyncVersion: syncVersion})
+	if err != nil || !applied {
+		return err
+	}
+	if err := u.apiKeyRepo.SetSuspendedByUserID(ctx, userID, true); err != nil {
+		return err
+	}
+	if _, err := u.sessionRepo.RevokeSessionsByUserID(ctx, userID, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// HandleUserUnblocked resumes the API keys of an unblocked user.
+func (u *UserEventUsecase) HandleUserUnblocked(ctx context.Context, userID uuid.UUID, syncVersion *int64) error {
+	applied, err := u.userStatus.UpdateUserStatus(ctx, &dbmodels.UpdateUserStatusInput{UserID: userID, IsBlocked: boolPtr(false), SyncVersion: syncVersion})
+	if err != nil || !applied {
+		return err
+	}
+	return u.apiKeyRepo.SetSuspendedByUserID(ctx, userID, false)
+}
+
+// HandleUserActiveChanged records whether a user is active, and signs out a deactivated user.
+func (u *UserEventUsecase) HandleUserActiveChanged(ctx context.Context, userID uuid.UUID, isActive bool, syncVersion *int64) error {
+	applied, err := u.userStatus.UpdateUserStatus(ctx, &dbmodels.UpdateUserStatusInput{UserID: userID, IsActive: &isActive, SyncVersion: syncVersion})
+	if err != nil || !applied || isActive {
+		return err
+	}
+	if _, err := u.sessionRepo.RevokeSessionsByUserID(ctx, userID, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// HandleRolesChanged records the roles of a user, which the access tokens issued from then on carry. Tokens
+// issued before keep their roles until they expire.
+func (u *UserEventUsecase) HandleRolesChanged(ctx context.Context, userID uuid.UUID, roles []string, syncVersion *int64) error {
+	if roles == nil {
+		roles = []string{}
+	}
+	_, err := u.userStatus.UpdateUserStatus(ctx, &dbmodels.UpdateUserStatusInput{UserID: userID, Roles: roles, SyncVersion: syncVersion})
+	return err
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func NewUserEventUsecase(
+	authAccountRepo *dbrepo.AuthAccountRepository,
+	apiKeyRepo *dbrepo.APIKeyRepository,
+	sessionRepo *dbrepo.SessionRepository,
+	loginAttempt *dbrepo.LoginAttemptRepository,
+	userStatus *dbrepo.UserStatusRepository,
+	cancelLinks *restorerepo.TokenManager,
+	mailer *mailer.Mailer,
+	cancelLinkURL string,
+) *UserEventUsecase {
 	return &UserEventUsecase{
 		authAccountRepo: authAccountRepo,
+		apiKeyRepo:      apiKeyRepo,
+		sessionRepo:     sessionRepo,
+		loginAttempt:    loginAttempt,
+		userStatus:      userStatus,
+		cancelLinks:     cancelLinks,
+		mailer:          mailer,
+		cancelLinkURL:   cancelLinkURL,
 	}
 }
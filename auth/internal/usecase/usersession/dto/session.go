@@ -0,0 +1,12 @@
+package usersessiondto
+
+import dbmodels "mandacode.com/accounts/auth/internal/models/database"
+
+type SessionOutput struct {
+	*dbmodels.SecureSession
+	Current bool `json:"current"` // Whether this is the session the request was made from
+}
+
+type RevokeSessionsOutput struct {
+	Revoked int `json:"revoked"`
+}
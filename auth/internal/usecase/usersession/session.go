@@ -0,0 +1,171 @@
+package usersession
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+
+	auditinfra "mandacode.com/accounts/auth/internal/infra/audit"
+	auditmodels "mandacode.com/accounts/auth/internal/models/audit"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	usersessiondto "mandacode.com/accounts/auth/internal/usecase/usersession/dto"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+type SessionUsecase struct {
+	session *dbrepo.SessionRepository
+	audit   *auditinfra.Emitter
+}
+
+// ListSessions lists the active sessions of the user.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The unique identifier of the user.
+//   - currentRefreshToken: The refresh token of the calling session, used to mark it as current. May be empty.
+//
+// Returns:
+//   - sessions: The active sessions, most recently seen first.
+//   - err: An error if the sessions could not be retrieved.
+func (s *SessionUsecase) ListSessions(ctx context.Context, userID uuid.UUID, currentRefreshToken string) ([]*usersessiondto.SessionOutput, error) {
+	sessions, err := s.session.ListActiveSessionsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentID := uuid.Nil
+	if current, err := s.currentSession(ctx, userID, currentRefreshToken); err == nil {
+		currentID = current.ID
+	}
+
+	outputs := make([]*usersessiondto.SessionOutput, 0, len(sessions))
+	for _, session := range sessions {
+		outputs = append(outputs, &usersessiondto.SessionOutput{
+			SecureSession: session,
+			Current:       session.ID == currentID,
+		})
+	}
+	return outputs, nil
+}
+
+// RevokeSession revokes a session of the user.
+func (s *SessionUsecase) RevokeSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) error {
+	return s.session.RevokeSession(ctx, userID, sessionID)
+}
+
+// RevokeOtherSessions revokes every session of the user except the calling one.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - userID: The unique identifier of the user.
+//   - currentRefreshToken: The refresh token of the calling session, which is kept.
+//
+// Returns:
+//   - output: The number of revoked sessions.
+//   - err: An error if the calling session cannot be identified or the sessions could not be revoked.
+func (s *SessionUsecase) RevokeOtherSessions(ctx context.Context, userID uuid.UUID, currentRefreshToken string) (*usersessiondto.RevokeSessionsOutput, error) {
+	current, err := s.currentSession(ctx, userID, currentRefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := s.session.RevokeSessionsByUserID(ctx, userID, &current.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &usersessiondto.RevokeSessionsOutput{Revoked: revoked}, nil
+}
+
+// Logout revokes the session the refresh token belongs to, or every session of its user.
+//
+// Logging out is idempotent: a refresh token without an active session is ignored.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - refreshToken: The refresh token of the session to end.
+//   - everywhere: Whether to revoke every session of the user instead of only this one.
+//
+// Returns:
+//   - err: An error if the sessions could not be revoked.
+func (s *SessionUsecase) Logout(ctx context.Context, refreshToken string, everywhere bool) error {
+	if refreshToken == "" {
+		return nil
+	}
+	session, err := s.session.GetSessionByRefreshTokenHash(ctx, util.HashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, errcode.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if !session.IsActive() {
+		return nil
+	}
+
+	if everywhere {
+		_, err = s.session.RevokeSessionsByUserID(ctx, session.UserID, nil)
+		return err
+	}
+	if err := s.session.RevokeSessionByID(ctx, session.ID); err != nil && !errors.Is(err, errcode.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+// ListUserSessions lists the active sessions of any user. It is intended for administrators.
+func (s *SessionUsecase) ListUserSessions(ctx context.Context, userID uuid.UUID) ([]*dbmodels.SecureSession, error) {
+	return s.session.ListActiveSessionsByUserID(ctx, userID)
+}
+
+// RevokeSessionByID revokes any session. It is intended for administrators, and is audited.
+func (s *SessionUsecase) RevokeSessionByID(ctx context.Context, sessionID uuid.UUID) error {
+	if err := s.session.RevokeSessionByID(ctx, sessionID); err != nil {
+		return err
+	}
+	if err := s.audit.Emit(ctx, auditmodels.ActionSessionRevoke, auditmodels.TargetTypeSession, sessionID.String(), nil, nil); err != nil {
+		return errors.Join(err, "Failed to audit session revocation")
+	}
+	return nil
+}
+
+// RevokeAllSessions revokes every session of any user. It is intended for administrators, and is audited.
+func (s *SessionUsecase) RevokeAllSessions(ctx context.Context, userID uuid.UUID) (*usersessiondto.RevokeSessionsOutput, error) {
+	revoked, err := s.session.RevokeSessionsByUserID(ctx, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+	output := &usersessiondto.RevokeSessionsOutput{Revoked: revoked}
+	if err := s.audit.Emit(ctx, auditmodels.ActionUserSessionsRevoke, auditmodels.TargetTypeUser, userID.String(), nil, output); err != nil {
+		return nil, errors.Join(err, "Failed to audit session revocation")
+	}
+	return output, nil
+}
+
+// currentSession resolves the active session of the user which the refresh token belongs to.
+func (s *SessionUsecase) currentSession(ctx context.Context, userID uuid.UUID, refreshToken string) (*dbmodels.SecureSession, error) {
+	if refreshToken == "" {
+		return nil, errors.New("refresh token of the current session is required", "Current Session Unknown", errcode.ErrInvalidInput)
+	}
+	session, err := s.session.GetSessionByRefreshTokenHash(ctx, util.HashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, errcode.ErrNotFound) {
+			return nil, errors.New("current session not found", "Current Session Unknown", errcode.ErrInvalidInput)
+		}
+		return nil, err
+	}
+	if session.UserID != userID || !session.IsActive() {
+		return nil, errors.New("current session is not active", "Current Session Unknown", errcode.ErrInvalidInput)
+	}
+	return session, nil
+}
+
+// NewSessionUsecase creates a new instance of SessionUsecase.
+func NewSessionUsecase(session *dbrepo.SessionRepository, audit *auditinfra.Emitter) *SessionUsecase {
+	return &SessionUsecase{
+		session: session,
+		audit:   audit,
+	}
+}
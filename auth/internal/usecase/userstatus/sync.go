@@ -0,0 +1,61 @@
+package userstatus
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	userstatusrepo "mandacode.com/accounts/auth/internal/repository/userstatus"
+)
+
+// SyncResult counts the statuses of a sync.
+type SyncResult struct {
+	Applied int // Statuses newer than the projection
+	Skipped int // Statuses the projection already had, from the user events
+}
+
+type SyncUsecase struct {
+	userStatus *dbrepo.UserStatusRepository
+	source     *userstatusrepo.UserStatusRepository
+	pageSize   int
+}
+
+// Sync copies the statuses of all the users from the user service into the projection fed by the user events,
+// which lacks the users which changed before the auth service consumed the events.
+//
+// The statuses carry the version of the users, so the statuses older than the projection are skipped, and the
+// events consumed concurrently apply in order with the sync.
+func (u *SyncUsecase) Sync(ctx context.Context) (*SyncResult, error) {
+	result := &SyncResult{}
+	var after *uuid.UUID
+	for {
+		statuses, next, err := u.source.ListUserStatuses(ctx, after, u.pageSize)
+		if err != nil {
+			return result, err
+		}
+		for _, status := range statuses {
+			applied, err := u.userStatus.UpdateUserStatus(ctx, status)
+			if err != nil {
+				return result, err
+			}
+			if applied {
+				result.Applied++
+			} else {
+				result.Skipped++
+			}
+		}
+		if next == nil {
+			return result, nil
+		}
+		after = next
+	}
+}
+
+// NewSyncUsecase creates a new SyncUsecase reading pages of pageSize statuses.
+func NewSyncUsecase(userStatus *dbrepo.UserStatusRepository, source *userstatusrepo.UserStatusRepository, pageSize int) *SyncUsecase {
+	return &SyncUsecase{
+		userStatus: userStatus,
+		source:     source,
+		pageSize:   pageSize,
+	}
+}
@@ -0,0 +1,88 @@
+package userstatus
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+)
+
+type UserStatusUsecase struct {
+	userStatus *dbrepo.UserStatusRepository
+}
+
+// CheckSignIn refuses the sign in of blocked and inactive users, each with a distinct error.
+//
+// Archived users are not refused, as their sign in offers to restore their account instead.
+//
+// Returns:
+//   - Whether the user is archived.
+//   - An error if the user is blocked or inactive, or their status could not be read.
+func (u *UserStatusUsecase) CheckSignIn(ctx context.Context, userID uuid.UUID) (archived bool, err error) {
+	status, err := u.getStatus(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if err := checkActive(status); err != nil {
+		return false, err
+	}
+	return status.IsArchived, nil
+}
+
+// Check refuses blocked, inactive and archived users, each with a distinct error.
+func (u *UserStatusUsecase) Check(ctx context.Context, userID uuid.UUID) error {
+	status, err := u.getStatus(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := checkActive(status); err != nil {
+		return err
+	}
+	if status.IsArchived {
+		return errors.New("user is pending deletion", "User Pending Deletion", errcode.ErrAccountDisabled)
+	}
+	return nil
+}
+
+// ClearArchived marks a user restored by the auth service as no longer archived, ahead of the USER_RESTORED
+// event, so that they can sign in right away.
+func (u *UserStatusUsecase) ClearArchived(ctx context.Context, userID uuid.UUID) error {
+	archived := false
+	if _, err := u.userStatus.UpdateUserStatus(ctx, &dbmodels.UpdateUserStatusInput{
+		UserID:     userID,
+		IsArchived: &archived,
+	}); err != nil {
+		return errors.Upgrade(err, "Failed to update user status", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+func (u *UserStatusUsecase) getStatus(ctx context.Context, userID uuid.UUID) (*dbmodels.SecureUserStatus, error) {
+	status, err := u.userStatus.GetUserStatus(ctx, userID)
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to get user status", errcode.ErrInternalFailure)
+	}
+	return status, nil
+}
+
+// checkActive refuses blocked and inactive users.
+func checkActive(status *dbmodels.SecureUserStatus) error {
+	if status.IsBlocked {
+		return errors.New("user is blocked", "User Blocked", errcode.ErrAccountDisabled)
+	}
+	if !status.IsActive {
+		return errors.New("user is inactive", "User Inactive", errcode.ErrAccountDisabled)
+	}
+	return nil
+}
+
+// NewUserStatusUsecase creates a new instance of UserStatusUsecase.
+func NewUserStatusUsecase(userStatus *dbrepo.UserStatusRepository) *UserStatusUsecase {
+	return &UserStatusUsecase{
+		userStatus: userStatus,
+	}
+}
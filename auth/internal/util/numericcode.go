@@ -0,0 +1,31 @@
+package util
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+)
+
+type NumericCodeGenerator struct {
+	CodeLength int
+}
+
+func NewNumericCodeGenerator(codeLength int) *NumericCodeGenerator {
+	return &NumericCodeGenerator{
+		CodeLength: codeLength,
+	}
+}
+
+// GenerateNumericCode generates a random code of decimal digits, short enough to be typed from an email.
+func (g *NumericCodeGenerator) GenerateNumericCode() (string, error) {
+	ten := big.NewInt(10)
+	var sb strings.Builder
+	for i := 0; i < g.CodeLength; i++ {
+		n, err := rand.Int(rand.Reader, ten)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(byte('0' + n.Int64()))
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,53 @@
+package util
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+)
+
+// userCodeCharset excludes vowels and ambiguous characters as recommended by RFC 8628 section 6.1.
+const userCodeCharset = "BCDFGHJKLMNPQRSTVWXZ"
+
+type UserCodeGenerator struct {
+	CodeLength int
+}
+
+func NewUserCodeGenerator(codeLength int) *UserCodeGenerator {
+	return &UserCodeGenerator{
+		CodeLength: codeLength,
+	}
+}
+
+// GenerateUserCode generates a human-typeable code split into two dash separated halves (e.g. WDJB-MJHT).
+func (g *UserCodeGenerator) GenerateUserCode() (string, error) {
+	max := big.NewInt(int64(len(userCodeCharset)))
+	var sb strings.Builder
+	for i := 0; i < g.CodeLength; i++ {
+		if i > 0 && i == g.CodeLength/2 {
+			sb.WriteByte('-')
+		}
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(userCodeCharset[n.Int64()])
+	}
+	return sb.String(), nil
+}
+
+// NormalizeUserCode upper-cases the code entered by a user and restores the dash separator,
+// so that "wdjbmjht" and "WDJB-MJHT" resolve to the same code.
+func (g *UserCodeGenerator) NormalizeUserCode(code string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToUpper(code) {
+		if r >= 'A' && r <= 'Z' {
+			sb.WriteRune(r)
+		}
+	}
+	raw := sb.String()
+	if len(raw) != g.CodeLength {
+		return raw
+	}
+	return raw[:g.CodeLength/2] + "-" + raw[g.CodeLength/2:]
+}
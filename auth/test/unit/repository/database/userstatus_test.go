@@ -0,0 +1,145 @@
+package dbrepo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"mandacode.com/accounts/auth/ent/enttest"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+)
+
+func newUserStatusRepository(t *testing.T) *dbrepo.UserStatusRepository {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return dbrepo.NewUserStatusRepository(client)
+}
+
+func versioned(userID uuid.UUID, version int64, blocked bool) *dbmodels.UpdateUserStatusInput {
+	return &dbmodels.UpdateUserStatusInput{
+		UserID:      userID,
+		IsBlocked:   &blocked,
+		SyncVersion: &version,
+	}
+}
+
+func updateUserStatus(t *testing.T, repo *dbrepo.UserStatusRepository, input *dbmodels.UpdateUserStatusInput) bool {
+	t.Helper()
+	applied, err := repo.UpdateUserStatus(context.Background(), input)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return applied
+}
+
+func getUserStatus(t *testing.T, repo *dbrepo.UserStatusRepository, userID uuid.UUID) *dbmodels.SecureUserStatus {
+	t.Helper()
+	status, err := repo.GetUserStatus(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return status
+}
+
+func TestUserStatusRepository_UpdateUserStatus(t *testing.T) {
+	t.Run("UpdateUserStatus_CreatesStatus", func(t *testing.T) {
+		repo := newUserStatusRepository(t)
+		userID := uuid.New()
+
+		if !updateUserStatus(t, repo, versioned(userID, 2, true)) {
+			t.Fatal("expected the first event to apply")
+		}
+		status := getUserStatus(t, repo, userID)
+		if !status.IsBlocked || status.SyncVersion != 2 {
+			t.Errorf("expected a blocked status at version 2, got blocked=%v version=%d", status.IsBlocked, status.SyncVersion)
+		}
+	})
+
+	t.Run("UpdateUserStatus_SkipsStaleVersions", func(t *testing.T) {
+		repo := newUserStatusRepository(t)
+		userID := uuid.New()
+
+		updateUserStatus(t, repo, versioned(userID, 3, false))
+		if updateUserStatus(t, repo, versioned(userID, 2, true)) {
+			t.Error("expected an older event to be skipped")
+		}
+		if updateUserStatus(t, repo, versioned(userID, 3, true)) {
+			t.Error("expected a redelivered event to be skipped")
+		}
+		status := getUserStatus(t, repo, userID)
+		if status.IsBlocked || status.SyncVersion != 3 {
+			t.Errorf("expected the status of version 3, got blocked=%v version=%d", status.IsBlocked, status.SyncVersion)
+		}
+	})
+
+	t.Run("UpdateUserStatus_AppliesNewerVersions", func(t *testing.T) {
+		repo := newUserStatusRepository(t)
+		userID := uuid.New()
+
+		updateUserStatus(t, repo, versioned(userID, 1, false))
+		if !updateUserStatus(t, repo, versioned(userID, 5, true)) {
+			t.Error("expected a newer event to apply")
+		}
+		status := getUserStatus(t, repo, userID)
+		if !status.IsBlocked || status.SyncVersion != 5 {
+			t.Errorf("expected a blocked status at version 5, got blocked=%v version=%d", status.IsBlocked, status.SyncVersion)
+		}
+	})
+
+	t.Run("UpdateUserStatus_UnversionedChangesKeepVersion", func(t *testing.T) {
+		repo := newUserStatusRepository(t)
+		userID := uuid.New()
+		archived := false
+
+		updateUserStatus(t, repo, versioned(userID, 4, false))
+		if !updateUserStatus(t, repo, &dbmodels.UpdateUserStatusInput{UserID: userID, IsArchived: &archived}) {
+			t.Error("expected an unversioned change to apply")
+		}
+		if status := getUserStatus(t, repo, userID); status.SyncVersion != 4 {
+			t.Errorf("expected the version to stay 4, got %d", status.SyncVersion)
+		}
+	})
+}
+
+func TestUserStatusRepository_CreateUserStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("CreateUserStatus_Inactive", func(t *testing.T) {
+		repo := newUserStatusRepository(t)
+		userID := uuid.New()
+
+		if err := repo.CreateUserStatus(ctx, userID, false); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if status := getUserStatus(t, repo, userID); status.IsActive {
+			t.Errorf("expected the new user to be inactive")
+		}
+
+		// The event announcing the status applies over it
+		active := true
+		version := int64(1)
+		if !updateUserStatus(t, repo, &dbmodels.UpdateUserStatusInput{UserID: userID, IsActive: &active, SyncVersion: &version}) {
+			t.Fatal("expected the event to apply")
+		}
+		if status := getUserStatus(t, repo, userID); !status.IsActive {
+			t.Errorf("expected the user to be active")
+		}
+	})
+
+	t.Run("CreateUserStatus_KeepsEvents", func(t *testing.T) {
+		repo := newUserStatusRepository(t)
+		userID := uuid.New()
+		updateUserStatus(t, repo, versioned(userID, 3, true))
+
+		if err := repo.CreateUserStatus(ctx, userID, false); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		status := getUserStatus(t, repo, userID)
+		if !status.IsActive || !status.IsBlocked || status.SyncVersion != 3 {
+			t.Errorf("expected the status of the events to be kept, got %+v", status)
+		}
+	})
+}
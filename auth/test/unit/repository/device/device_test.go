@@ -0,0 +1,137 @@
+package devicerepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	devicemodels "mandacode.com/accounts/auth/internal/models/device"
+	devicerepo "mandacode.com/accounts/auth/internal/repository/device"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+type MockDeviceCodeManager struct {
+	store   *miniredis.Miniredis
+	manager *devicerepo.DeviceCodeManager
+}
+
+func (m *MockDeviceCodeManager) Setup(t *testing.T) {
+	t.Helper()
+	m.store = miniredis.RunT(t)
+	codeStore := redis.NewClient(&redis.Options{Addr: m.store.Addr()})
+	t.Cleanup(func() { codeStore.Close() })
+	m.manager = devicerepo.NewDeviceCodeManager(util.NewRandomGenerator(32), util.NewUserCodeGenerator(8), 10*time.Minute, 5*time.Second, codeStore, "device:")
+}
+
+func (m *MockDeviceCodeManager) issue(t *testing.T) *devicemodels.DeviceAuthorization {
+	t.Helper()
+	authorization, err := m.manager.IssueDeviceCode(context.Background(), "tv-app")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return authorization
+}
+
+func TestDeviceCodeManager_Update(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("SetDecision_Pending", func(t *testing.T) {
+		mock := &MockDeviceCodeManager{}
+		mock.Setup(t)
+		authorization := mock.issue(t)
+		userID := uuid.New()
+
+		updated, err := mock.manager.SetDecision(ctx, authorization, devicemodels.DeviceStatusApproved, userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !updated {
+			t.Fatalf("expected the authorization to be updated")
+		}
+		decided, err := mock.manager.GetByDeviceCode(ctx, authorization.DeviceCode)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if decided.Status != devicemodels.DeviceStatusApproved || decided.UserID != userID {
+			t.Errorf("expected the approval to be recorded, got %+v", decided)
+		}
+	})
+
+	t.Run("UpdatePolling_Pending", func(t *testing.T) {
+		mock := &MockDeviceCodeManager{}
+		mock.Setup(t)
+		authorization := mock.issue(t)
+		polledAt := time.Now()
+
+		updated, err := mock.manager.UpdatePolling(ctx, authorization, polledAt, 10*time.Second)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !updated {
+			t.Fatalf("expected the authorization to be updated")
+		}
+		polled, err := mock.manager.GetByDeviceCode(ctx, authorization.DeviceCode)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if polled.Interval != 10*time.Second || polled.LastPolledAt.UnixMilli() != polledAt.UnixMilli() {
+			t.Errorf("expected the poll to be recorded, got %+v", polled)
+		}
+		if ttl := mock.store.TTL("device:device:" + authorization.DeviceCode); ttl <= 0 || ttl > 10*time.Minute {
+			t.Errorf("expected the authorization to keep its expiry, got %s", ttl)
+		}
+	})
+
+	t.Run("Update_Expired", func(t *testing.T) {
+		mock := &MockDeviceCodeManager{}
+		mock.Setup(t)
+		authorization := mock.issue(t)
+		mock.store.FastForward(10 * time.Minute)
+
+		// The updates must not recreate the authorization, which would never expire
+		updated, err := mock.manager.SetDecision(ctx, authorization, devicemodels.DeviceStatusApproved, uuid.New())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if updated {
+			t.Errorf("expected the expired authorization not to be decided")
+		}
+		updated, err = mock.manager.UpdatePolling(ctx, authorization, time.Now(), 5*time.Second)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if updated {
+			t.Errorf("expected the expired authorization not to be polled")
+		}
+		if mock.store.Exists("device:device:" + authorization.DeviceCode) {
+			t.Errorf("expected the expired authorization not to be recreated")
+		}
+	})
+
+	t.Run("Update_Consumed", func(t *testing.T) {
+		mock := &MockDeviceCodeManager{}
+		mock.Setup(t)
+		authorization := mock.issue(t)
+		if _, err := mock.manager.Consume(ctx, authorization); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		updated, err := mock.manager.UpdatePolling(ctx, authorization, time.Now(), 5*time.Second)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if updated {
+			t.Errorf("expected the consumed authorization not to be polled")
+		}
+		authorization, err = mock.manager.GetByDeviceCode(ctx, authorization.DeviceCode)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if authorization != nil {
+			t.Errorf("expected the consumed authorization not to be recreated, got %+v", authorization)
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package apikey_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	_ "github.com/mattn/go-sqlite3"
+	"mandacode.com/accounts/auth/ent/enttest"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	"mandacode.com/accounts/auth/internal/usecase/apikey"
+	apikeydto "mandacode.com/accounts/auth/internal/usecase/apikey/dto"
+	"mandacode.com/accounts/auth/internal/usecase/userevent"
+	"mandacode.com/accounts/auth/internal/usecase/userstatus"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+type MockAPIKeyUsecase struct {
+	apiKey    *apikey.APIKeyUsecase
+	userEvent *userevent.UserEventUsecase
+}
+
+// Setup builds the API keys, allowing two keys per user, along with the user events which suspend them. The events
+// reaching the mailer are not covered.
+func (m *MockAPIKeyUsecase) Setup(t *testing.T) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	apiKeyRepo := dbrepo.NewAPIKeyRepository(client)
+	userStatus := dbrepo.NewUserStatusRepository(client)
+	m.apiKey = apikey.NewAPIKeyUsecase(apiKeyRepo, userstatus.NewUserStatusUsecase(userStatus), util.NewRandomGenerator(8), util.NewRandomGenerator(32), []string{"profile:read", "profile:write"}, 2)
+	m.userEvent = userevent.NewUserEventUsecase(dbrepo.NewAuthAccountRepository(client), apiKeyRepo, dbrepo.NewSessionRepository(client), dbrepo.NewLoginAttemptRepository(client), userStatus, nil, nil, "")
+}
+
+func (m *MockAPIKeyUsecase) createAPIKey(t *testing.T, userID uuid.UUID) *apikeydto.CreatedAPIKey {
+	t.Helper()
+	created, err := m.apiKey.CreateAPIKey(context.Background(), userID, apikeydto.CreateAPIKeyInput{Name: "scripts", Scopes: []string{"profile:read"}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return created
+}
+
+// expectRejected checks that the key no longer authenticates its owner.
+func (m *MockAPIKeyUsecase) expectRejected(t *testing.T, key string) {
+	t.Helper()
+	if _, err := m.apiKey.VerifyAPIKey(context.Background(), key); !errors.Is(err, errcode.ErrUnauthorized) {
+		t.Errorf("expected an unauthorized error, got %v", err)
+	}
+}
+
+func TestAPIKeyUsecase_CreateAPIKey(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("CreateAPIKey_Success", func(t *testing.T) {
+		mock := &MockAPIKeyUsecase{}
+		mock.Setup(t)
+		userID := uuid.New()
+
+		created := mock.createAPIKey(t, userID)
+		if !strings.HasPrefix(created.Key, created.APIKey.Prefix+"_") || !strings.HasPrefix(created.APIKey.Prefix, "mak_") {
+			t.Errorf("expected the key to start with its displayed prefix, got %q and %q", created.Key, created.APIKey.Prefix)
+		}
+		keys, err := mock.apiKey.ListAPIKeys(ctx, userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(keys) != 1 || keys[0].ID != created.APIKey.ID {
+			t.Errorf("expected the key to be listed, got %+v", keys)
+		}
+	})
+
+	t.Run("CreateAPIKey_InvalidInput", func(t *testing.T) {
+		mock := &MockAPIKeyUsecase{}
+		mock.Setup(t)
+		past := time.Now().Add(-time.Hour)
+
+		for _, input := range []apikeydto.CreateAPIKeyInput{
+			{Name: "admin", Scopes: []string{"roles:manage"}},
+			{Name: "expired", Scopes: []string{"profile:read"}, ExpiresAt: &past},
+		} {
+			if _, err := mock.apiKey.CreateAPIKey(ctx, uuid.New(), input); !errors.Is(err, errcode.ErrInvalidInput) {
+				t.Errorf("expected an invalid input error for %s, got %v", input.Name, err)
+			}
+		}
+	})
+
+	t.Run("CreateAPIKey_LimitReached", func(t *testing.T) {
+		mock := &MockAPIKeyUsecase{}
+		mock.Setup(t)
+		userID := uuid.New()
+		mock.createAPIKey(t, userID)
+		revoked := mock.createAPIKey(t, userID)
+
+		_, err := mock.apiKey.CreateAPIKey(ctx, userID, apikeydto.CreateAPIKeyInput{Name: "third", Scopes: []string{"profile:read"}})
+		if !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected a conflict error, got %v", err)
+		}
+		// Revoked keys leave room for new ones
+		if err := mock.apiKey.RevokeAPIKey(ctx, userID, revoked.APIKey.ID); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		mock.createAPIKey(t, userID)
+	})
+}
+
+func TestAPIKeyUsecase_VerifyAPIKey(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("VerifyAPIKey_Success", func(t *testing.T) {
+		mock := &MockAPIKeyUsecase{}
+		mock.Setup(t)
+		userID := uuid.New()
+		created := mock.createAPIKey(t, userID)
+
+		output, err := mock.apiKey.VerifyAPIKey(ctx, created.Key)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if output.UserID != userID || len(output.Scopes) != 1 || output.Scopes[0] != "profile:read" {
+			t.Errorf("expected the owner and scopes of the key, got %+v", output)
+		}
+	})
+
+	t.Run("VerifyAPIKey_UnknownKey", func(t *testing.T) {
+		mock := &MockAPIKeyUsecase{}
+		mock.Setup(t)
+		created := mock.createAPIKey(t, uuid.New())
+
+		mock.expectRejected(t, "not-a-key")
+		mock.expectRejected(t, created.Key+"x")
+	})
+
+	t.Run("VerifyAPIKey_Revoked", func(t *testing.T) {
+		mock := &MockAPIKeyUsecase{}
+		mock.Setup(t)
+		userID := uuid.New()
+		created := mock.createAPIKey(t, userID)
+
+		// Other users cannot revoke the key
+		if err := mock.apiKey.RevokeAPIKey(ctx, uuid.New(), created.APIKey.ID); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+		if err := mock.apiKey.RevokeAPIKey(ctx, userID, created.APIKey.ID); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		mock.expectRejected(t, created.Key)
+	})
+
+	t.Run("VerifyAPIKey_BlockedUser", func(t *testing.T) {
+		mock := &MockAPIKeyUsecase{}
+		mock.Setup(t)
+		userID := uuid.New()
+		created := mock.createAPIKey(t, userID)
+
+		if err := mock.userEvent.HandleUserBlocked(ctx, userID, nil); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		mock.expectRejected(t, created.Key)
+
+		if err := mock.userEvent.HandleUserUnblocked(ctx, userID, nil); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := mock.apiKey.VerifyAPIKey(ctx, created.Key); err != nil {
+			t.Errorf("expected the key to work again once the user is unblocked, got %v", err)
+		}
+	})
+
+	t.Run("VerifyAPIKey_DeletedUser", func(t *testing.T) {
+		mock := &MockAPIKeyUsecase{}
+		mock.Setup(t)
+		userID := uuid.New()
+		created := mock.createAPIKey(t, userID)
+
+		if err := mock.userEvent.HandleUserDeleted(ctx, userID); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		mock.expectRejected(t, created.Key)
+	})
+}
@@ -0,0 +1,145 @@
+package dataexport_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"mandacode.com/accounts/auth/ent/enttest"
+	"mandacode.com/accounts/auth/ent/loginattempt"
+	"mandacode.com/accounts/auth/ent/session"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	"mandacode.com/accounts/auth/internal/usecase/dataexport"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+const (
+	refreshToken = "refresh-token"
+	apiKeyHash   = "api-key-hash"
+)
+
+type MockExportUsecase struct {
+	authAccount  *dbrepo.AuthAccountRepository
+	session      *dbrepo.SessionRepository
+	apiKey       *dbrepo.APIKeyRepository
+	loginAttempt *dbrepo.LoginAttemptRepository
+	export       *dataexport.ExportUsecase
+}
+
+func (m *MockExportUsecase) Setup(t *testing.T) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	m.authAccount = dbrepo.NewAuthAccountRepository(client)
+	m.session = dbrepo.NewSessionRepository(client)
+	m.apiKey = dbrepo.NewAPIKeyRepository(client)
+	m.loginAttempt = dbrepo.NewLoginAttemptRepository(client)
+	m.export = dataexport.NewExportUsecase(m.authAccount, m.session, m.apiKey, m.loginAttempt, dbrepo.NewUserStatusRepository(client))
+}
+
+// createUser creates a user with a local account, a session, an API key and a sign in.
+func (m *MockExportUsecase) createUser(t *testing.T, email string) uuid.UUID {
+	t.Helper()
+	ctx := context.Background()
+	userID := uuid.New()
+	if _, err := m.authAccount.CreateLocalAuthAccount(ctx, &dbmodels.CreateLocalAuthAccountInput{
+		UserID:   userID,
+		Email:    email,
+		Password: "password",
+	}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := m.session.CreateSession(ctx, &dbmodels.CreateSessionInput{
+		UserID:           userID,
+		RefreshTokenHash: util.HashToken(refreshToken + email),
+		LoginMethod:      session.LoginMethodLocal,
+	}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := m.apiKey.CreateAPIKey(ctx, &dbmodels.CreateAPIKeyInput{
+		UserID:  userID,
+		Name:    "scripts",
+		Prefix:  "mak_" + email[:4],
+		KeyHash: apiKeyHash + email,
+		Scopes:  []string{"profile:read"},
+	}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	latitude := 37.5
+	if _, err := m.loginAttempt.CreateLoginAttempt(ctx, &dbmodels.CreateLoginAttemptInput{
+		UserID:      &userID,
+		LoginMethod: loginattempt.LoginMethodLocal,
+		Success:     true,
+		IPAddress:   "198.51.100.1",
+		UserAgent:   "Firefox",
+		DeviceID:    "laptop",
+		Latitude:    &latitude,
+	}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return userID
+}
+
+func TestExportUsecase_ExportUserData(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ExportUserData_Success", func(t *testing.T) {
+		mock := &MockExportUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t, "user@example.com")
+		mock.createUser(t, "other@example.com")
+
+		data, err := mock.export.ExportUserData(ctx, userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if data.UserID != userID || data.Status == nil {
+			t.Errorf("expected the status of the user, got %+v", data)
+		}
+		if len(data.Accounts) != 1 || len(data.Sessions) != 1 || len(data.APIKeys) != 1 || len(data.LoginHistory) != 1 {
+			t.Fatalf("expected only the data of the user, got %+v", data)
+		}
+		// The export holds the device and location the user signed in from, which the history hides
+		attempt := data.LoginHistory[0]
+		if attempt.DeviceID != "laptop" || attempt.Latitude == nil || *attempt.Latitude != 37.5 {
+			t.Errorf("expected the device and location of the sign in, got %+v", attempt)
+		}
+	})
+
+	t.Run("ExportUserData_NoSecrets", func(t *testing.T) {
+		mock := &MockExportUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t, "user@example.com")
+
+		data, err := mock.export.ExportUserData(ctx, userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		encoded, err := json.Marshal(data)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		for _, secret := range []string{"$2a$", util.HashToken(refreshToken + "user@example.com"), apiKeyHash} {
+			if strings.Contains(string(encoded), secret) {
+				t.Errorf("expected the export not to hold %q, got %s", secret, encoded)
+			}
+		}
+	})
+
+	t.Run("ExportUserData_UnknownUser", func(t *testing.T) {
+		mock := &MockExportUsecase{}
+		mock.Setup(t)
+
+		data, err := mock.export.ExportUserData(ctx, uuid.New())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(data.Accounts) != 0 || len(data.Sessions) != 0 || len(data.APIKeys) != 0 || len(data.LoginHistory) != 0 {
+			t.Errorf("expected an empty export, got %+v", data)
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package impersonation_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	userv1 "github.com/mandacode-com/accounts-proto/go/user/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	_ "github.com/mattn/go-sqlite3"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"mandacode.com/accounts/auth/ent/authaccount"
+	"mandacode.com/accounts/auth/ent/enttest"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	rbacrepo "mandacode.com/accounts/auth/internal/repository/rbac"
+	"mandacode.com/accounts/auth/internal/usecase/impersonation"
+	"mandacode.com/accounts/auth/internal/usecase/userstatus"
+)
+
+// stubRBACClient grants the impersonation permission to the admins only, who may have further permissions.
+type stubRBACClient struct {
+	userv1.RBACServiceClient
+	admins      map[string]bool
+	permissions map[string][]string
+}
+
+func (s *stubRBACClient) CheckPermission(ctx context.Context, in *userv1.CheckPermissionRequest, opts ...grpc.CallOption) (*userv1.CheckPermissionResponse, error) {
+	return &userv1.CheckPermissionResponse{
+		Allowed: in.Permission == impersonation.PermissionImpersonate && s.admins[in.UserId],
+	}, nil
+}
+
+func (s *stubRBACClient) GetUserRoles(ctx context.Context, in *userv1.GetUserRolesRequest, opts ...grpc.CallOption) (*userv1.GetUserRolesResponse, error) {
+	return &userv1.GetUserRolesResponse{
+		Roles: &userv1.UserRoles{UserId: in.UserId, Permissions: s.permissions[in.UserId]},
+	}, nil
+}
+
+type MockImpersonationUsecase struct {
+	rbac          *stubRBACClient
+	authAccount   *dbrepo.AuthAccountRepository
+	userStatus    *dbrepo.UserStatusRepository
+	adminID       uuid.UUID
+	impersonation *impersonation.ImpersonationUsecase
+}
+
+// Setup builds the impersonation up to the checks of the admin and the user. Issuing the token needs the token
+// service, so the impersonations which pass the checks are not covered.
+func (m *MockImpersonationUsecase) Setup(t *testing.T) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	m.authAccount = dbrepo.NewAuthAccountRepository(client)
+	m.userStatus = dbrepo.NewUserStatusRepository(client)
+	m.adminID = uuid.New()
+	m.rbac = &stubRBACClient{
+		admins:      map[string]bool{m.adminID.String(): true},
+		permissions: map[string][]string{m.adminID.String(): {impersonation.PermissionImpersonate}},
+	}
+	m.impersonation = impersonation.NewImpersonationUsecase(nil, m.authAccount, rbacrepo.NewRBACRepository(m.rbac), userstatus.NewUserStatusUsecase(m.userStatus), nil, nil, zap.NewNop())
+}
+
+// oauthUser creates a user signed up with an OAuth provider, whose email is only a contact once verified.
+func (m *MockImpersonationUsecase) oauthUser(t *testing.T, verified bool) uuid.UUID {
+	t.Helper()
+	userID := uuid.New()
+	_, err := m.authAccount.CreateOAuthAuthAccount(context.Background(), &dbmodels.CreateOAuthAuthAccountInput{
+		UserID:     userID,
+		Provider:   authaccount.ProviderGoogle,
+		ProviderID: userID.String(),
+		Email:      userID.String() + "@example.com",
+		IsVerified: verified,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return userID
+}
+
+// block blocks the user, as the user events would.
+func (m *MockImpersonationUsecase) block(t *testing.T, userID uuid.UUID) {
+	t.Helper()
+	blocked := true
+	if _, err := m.userStatus.UpdateUserStatus(context.Background(), &dbmodels.UpdateUserStatusInput{UserID: userID, IsBlocked: &blocked}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestImpersonationUsecase_Impersonate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Impersonate_InvalidReason", func(t *testing.T) {
+		mock := &MockImpersonationUsecase{}
+		mock.Setup(t)
+		userID := mock.oauthUser(t, true)
+
+		for _, reason := range []string{"", "   ", strings.Repeat("a", 501)} {
+			_, err := mock.impersonation.Impersonate(ctx, mock.adminID, userID, reason)
+			if !errors.Is(err, errcode.ErrInvalidInput) {
+				t.Errorf("expected an invalid input error, got %v", err)
+			}
+		}
+	})
+
+	t.Run("Impersonate_Self", func(t *testing.T) {
+		mock := &MockImpersonationUsecase{}
+		mock.Setup(t)
+
+		_, err := mock.impersonation.Impersonate(ctx, mock.adminID, mock.adminID, "ticket 42")
+		if !errors.Is(err, errcode.ErrInvalidInput) {
+			t.Errorf("expected an invalid input error, got %v", err)
+		}
+	})
+
+	t.Run("Impersonate_WithoutPermission", func(t *testing.T) {
+		mock := &MockImpersonationUsecase{}
+		mock.Setup(t)
+		userID := mock.oauthUser(t, true)
+
+		_, err := mock.impersonation.Impersonate(ctx, uuid.New(), userID, "ticket 42")
+		if !errors.Is(err, errcode.ErrForbidden) {
+			t.Errorf("expected a forbidden error, got %v", err)
+		}
+	})
+
+	t.Run("Impersonate_Admin", func(t *testing.T) {
+		mock := &MockImpersonationUsecase{}
+		mock.Setup(t)
+		userID := mock.oauthUser(t, true)
+		mock.rbac.permissions[userID.String()] = []string{"roles.manage"}
+
+		// The admin would act with the permissions of the other admin
+		_, err := mock.impersonation.Impersonate(ctx, mock.adminID, userID, "ticket 42")
+		if !errors.Is(err, errcode.ErrForbidden) {
+			t.Errorf("expected a forbidden error, got %v", err)
+		}
+	})
+
+	t.Run("Impersonate_DisabledUsers", func(t *testing.T) {
+		mock := &MockImpersonationUsecase{}
+		mock.Setup(t)
+		userID := mock.oauthUser(t, true)
+		mock.block(t, userID)
+
+		_, err := mock.impersonation.Impersonate(ctx, mock.adminID, userID, "ticket 42")
+		if !errors.Is(err, errcode.ErrAccountDisabled) {
+			t.Errorf("expected an account disabled error for a blocked user, got %v", err)
+		}
+
+		// Nor may a blocked admin keep impersonating
+		otherID := mock.oauthUser(t, true)
+		mock.block(t, mock.adminID)
+		_, err = mock.impersonation.Impersonate(ctx, mock.adminID, otherID, "ticket 42")
+		if !errors.Is(err, errcode.ErrAccountDisabled) {
+			t.Errorf("expected an account disabled error for a blocked admin, got %v", err)
+		}
+	})
+
+	t.Run("Impersonate_UnknownUser", func(t *testing.T) {
+		mock := &MockImpersonationUsecase{}
+		mock.Setup(t)
+
+		_, err := mock.impersonation.Impersonate(ctx, mock.adminID, uuid.New(), "ticket 42")
+		if !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+	})
+
+	t.Run("Impersonate_UserCannotBeNotified", func(t *testing.T) {
+		mock := &MockImpersonationUsecase{}
+		mock.Setup(t)
+		userID := mock.oauthUser(t, false)
+
+		// An unverified email is no contact, and no impersonation may go unnoticed
+		_, err := mock.impersonation.Impersonate(ctx, mock.adminID, userID, "ticket 42")
+		if !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected a conflict error, got %v", err)
+		}
+	})
+}
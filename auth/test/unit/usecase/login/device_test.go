@@ -0,0 +1,265 @@
+package login_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"mandacode.com/accounts/auth/ent/enttest"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	reqmodels "mandacode.com/accounts/auth/internal/models/request"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	devicerepo "mandacode.com/accounts/auth/internal/repository/device"
+	"mandacode.com/accounts/auth/internal/usecase/login"
+	logindto "mandacode.com/accounts/auth/internal/usecase/login/dto"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+const (
+	deviceClientID       = "tv-app"
+	confidentialClientID = "console-app"
+	clientSecret         = "client-secret"
+	verificationURI      = "https://accounts.example.com/device"
+)
+
+type MockDeviceLoginUsecase struct {
+	store  *miniredis.Miniredis
+	device *login.DeviceLoginUsecase
+}
+
+// Setup builds the device flow up to the decisions and the polling, with a public and a confidential device client.
+// Issuing tokens needs the token and user services, so approved codes are not redeemed.
+func (m *MockDeviceLoginUsecase) Setup(t *testing.T) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	m.store = miniredis.RunT(t)
+	codeStore := redis.NewClient(&redis.Options{Addr: m.store.Addr()})
+	t.Cleanup(func() { codeStore.Close() })
+
+	oauthClient := dbrepo.NewOAuthClientRepository(client)
+	secret := clientSecret
+	for _, input := range []*dbmodels.CreateOAuthClientInput{
+		{ClientID: deviceClientID, GrantTypes: []string{dbmodels.GrantTypeDeviceCode}},
+		{ClientID: confidentialClientID, GrantTypes: []string{dbmodels.GrantTypeDeviceCode}, Secret: &secret},
+		{ClientID: "backend", GrantTypes: []string{dbmodels.GrantTypeClientCredentials}},
+	} {
+		input.Name = input.ClientID
+		input.Scopes = []string{"openid"}
+		if _, err := oauthClient.CreateClient(context.Background(), input); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	userCodeGen := util.NewUserCodeGenerator(8)
+	deviceCodeManager := devicerepo.NewDeviceCodeManager(util.NewRandomGenerator(32), userCodeGen, 10*time.Minute, 5*time.Second, codeStore, "device:")
+	m.device = login.NewDeviceLoginUsecase(oauthClient, nil, deviceCodeManager, userCodeGen, verificationURI, nil, nil, nil, nil, nil)
+}
+
+func (m *MockDeviceLoginUsecase) requestDeviceCode(t *testing.T) *logindto.DeviceCodeOutput {
+	t.Helper()
+	return m.requestClientDeviceCode(t, deviceClientID)
+}
+
+func (m *MockDeviceLoginUsecase) requestClientDeviceCode(t *testing.T, clientID string) *logindto.DeviceCodeOutput {
+	t.Helper()
+	output, err := m.device.RequestDeviceCode(context.Background(), clientID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return output
+}
+
+func (m *MockDeviceLoginUsecase) poll(clientID string, deviceCode string) error {
+	return m.pollWithSecret(clientID, "", deviceCode)
+}
+
+func (m *MockDeviceLoginUsecase) pollWithSecret(clientID string, clientSecret string, deviceCode string) error {
+	_, _, err := m.device.PollToken(context.Background(), clientID, clientSecret, deviceCode, reqmodels.RequestInfo{})
+	return err
+}
+
+// expectDeviceError checks that err carries the RFC 8628 error code as its public message.
+func expectDeviceError(t *testing.T, err error, code string) {
+	t.Helper()
+	appErr, ok := err.(*errors.AppError)
+	if !ok {
+		t.Fatalf("expected an AppError with %s, got %v", code, err)
+	}
+	if appErr.Public() != code {
+		t.Errorf("expected %s, got %s", code, appErr.Public())
+	}
+}
+
+func TestDeviceLoginUsecase_RequestDeviceCode(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("RequestDeviceCode_Success", func(t *testing.T) {
+		mock := &MockDeviceLoginUsecase{}
+		mock.Setup(t)
+
+		output := mock.requestDeviceCode(t)
+		if output.DeviceCode == "" || output.UserCode == "" || output.Interval != 5 || output.ExpiresIn <= 0 {
+			t.Errorf("expected a pending authorization, got %+v", output)
+		}
+		complete, err := url.Parse(output.VerificationURIComplete)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if complete.Query().Get("user_code") != output.UserCode || output.VerificationURI != verificationURI {
+			t.Errorf("expected the verification URI to carry the user code, got %s", output.VerificationURIComplete)
+		}
+	})
+
+	t.Run("RequestDeviceCode_UnknownClient", func(t *testing.T) {
+		mock := &MockDeviceLoginUsecase{}
+		mock.Setup(t)
+
+		_, err := mock.device.RequestDeviceCode(ctx, "unknown")
+		expectDeviceError(t, err, login.DeviceErrInvalidClient)
+	})
+
+	t.Run("RequestDeviceCode_GrantNotAllowed", func(t *testing.T) {
+		mock := &MockDeviceLoginUsecase{}
+		mock.Setup(t)
+
+		_, err := mock.device.RequestDeviceCode(ctx, "backend")
+		expectDeviceError(t, err, login.DeviceErrUnauthorizedClient)
+	})
+}
+
+func TestDeviceLoginUsecase_Decide(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("GetDeviceAuthorization_NormalizesUserCode", func(t *testing.T) {
+		mock := &MockDeviceLoginUsecase{}
+		mock.Setup(t)
+		output := mock.requestDeviceCode(t)
+
+		// Users may type the code in lower case, without the dash
+		typed := ""
+		for _, r := range output.UserCode {
+			if r != '-' {
+				typed += string(r + 'a' - 'A')
+			}
+		}
+		info, err := mock.device.GetDeviceAuthorization(ctx, typed)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if info.UserCode != output.UserCode || info.ClientID != deviceClientID {
+			t.Errorf("expected the authorization of the code, got %+v", info)
+		}
+	})
+
+	t.Run("ApproveDevice_RefusesImpersonation", func(t *testing.T) {
+		mock := &MockDeviceLoginUsecase{}
+		mock.Setup(t)
+		output := mock.requestDeviceCode(t)
+		impersonatorID := uuid.New()
+
+		err := mock.device.ApproveDevice(ctx, logindto.DeviceApprover{UserID: uuid.New(), ImpersonatorID: &impersonatorID}, output.UserCode, reqmodels.RequestInfo{})
+		if !errors.Is(err, errcode.ErrForbidden) {
+			t.Errorf("expected a forbidden error, got %v", err)
+		}
+		expectDeviceError(t, mock.poll(deviceClientID, output.DeviceCode), login.DeviceErrAuthorizationPending)
+	})
+
+	t.Run("DenyDevice_OnlyOnce", func(t *testing.T) {
+		mock := &MockDeviceLoginUsecase{}
+		mock.Setup(t)
+		output := mock.requestDeviceCode(t)
+
+		if err := mock.device.DenyDevice(ctx, uuid.New(), output.UserCode); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if err := mock.device.DenyDevice(ctx, uuid.New(), output.UserCode); !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected a conflict error, got %v", err)
+		}
+	})
+}
+
+func TestDeviceLoginUsecase_PollToken(t *testing.T) {
+	t.Run("PollToken_Pending", func(t *testing.T) {
+		mock := &MockDeviceLoginUsecase{}
+		mock.Setup(t)
+		output := mock.requestDeviceCode(t)
+
+		expectDeviceError(t, mock.poll(deviceClientID, output.DeviceCode), login.DeviceErrAuthorizationPending)
+	})
+
+	t.Run("PollToken_SlowDown", func(t *testing.T) {
+		mock := &MockDeviceLoginUsecase{}
+		mock.Setup(t)
+		output := mock.requestDeviceCode(t)
+
+		expectDeviceError(t, mock.poll(deviceClientID, output.DeviceCode), login.DeviceErrAuthorizationPending)
+		expectDeviceError(t, mock.poll(deviceClientID, output.DeviceCode), login.DeviceErrSlowDown)
+	})
+
+	t.Run("PollToken_OtherClient", func(t *testing.T) {
+		mock := &MockDeviceLoginUsecase{}
+		mock.Setup(t)
+		output := mock.requestDeviceCode(t)
+
+		expectDeviceError(t, mock.poll("backend", output.DeviceCode), "invalid_grant")
+	})
+
+	t.Run("PollToken_ConfidentialClient", func(t *testing.T) {
+		mock := &MockDeviceLoginUsecase{}
+		mock.Setup(t)
+		output := mock.requestClientDeviceCode(t, confidentialClientID)
+
+		// A leaked device code is useless without the secret of the client
+		expectDeviceError(t, mock.pollWithSecret(confidentialClientID, "", output.DeviceCode), login.DeviceErrInvalidClient)
+		expectDeviceError(t, mock.pollWithSecret(confidentialClientID, "wrong", output.DeviceCode), login.DeviceErrInvalidClient)
+		expectDeviceError(t, mock.pollWithSecret(confidentialClientID, clientSecret, output.DeviceCode), login.DeviceErrAuthorizationPending)
+	})
+
+	t.Run("PollToken_UnknownClient", func(t *testing.T) {
+		mock := &MockDeviceLoginUsecase{}
+		mock.Setup(t)
+		output := mock.requestDeviceCode(t)
+
+		expectDeviceError(t, mock.poll("unknown", output.DeviceCode), login.DeviceErrInvalidClient)
+	})
+
+	t.Run("PollToken_Expired", func(t *testing.T) {
+		mock := &MockDeviceLoginUsecase{}
+		mock.Setup(t)
+		output := mock.requestDeviceCode(t)
+		mock.store.FastForward(10 * time.Minute)
+
+		expectDeviceError(t, mock.poll(deviceClientID, output.DeviceCode), login.DeviceErrExpiredToken)
+		if err := mock.device.DenyDevice(context.Background(), uuid.New(), output.UserCode); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+	})
+
+	t.Run("PollToken_Denied", func(t *testing.T) {
+		mock := &MockDeviceLoginUsecase{}
+		mock.Setup(t)
+		output := mock.requestDeviceCode(t)
+		if err := mock.device.DenyDevice(context.Background(), uuid.New(), output.UserCode); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		expectDeviceError(t, mock.poll(deviceClientID, output.DeviceCode), login.DeviceErrAccessDenied)
+		// The denied code is consumed
+		expectDeviceError(t, mock.poll(deviceClientID, output.DeviceCode), login.DeviceErrExpiredToken)
+	})
+
+	t.Run("PollToken_UnknownCode", func(t *testing.T) {
+		mock := &MockDeviceLoginUsecase{}
+		mock.Setup(t)
+
+		expectDeviceError(t, mock.poll(deviceClientID, "unknown"), login.DeviceErrExpiredToken)
+	})
+}
@@ -0,0 +1,125 @@
+package login_test
+
+import (
+	"context"
+	"encoding/json"
+	stdErrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"mandacode.com/accounts/auth/ent/authaccount"
+	"mandacode.com/accounts/auth/ent/enttest"
+	"mandacode.com/accounts/auth/internal/infra/oauthapi"
+	signupinfra "mandacode.com/accounts/auth/internal/infra/signup"
+	oauthmodels "mandacode.com/accounts/auth/internal/models/oauth"
+	coderepo "mandacode.com/accounts/auth/internal/repository/code"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	"mandacode.com/accounts/auth/internal/usecase/login"
+	logindto "mandacode.com/accounts/auth/internal/usecase/login/dto"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+// stubOAuthAPI stands in for a provider, whose only user is a new one.
+type stubOAuthAPI struct{}
+
+func (stubOAuthAPI) GetAccessToken(code string) (string, error) {
+	return "provider-token", nil
+}
+
+func (stubOAuthAPI) GetLoginURL() string {
+	return "https://provider.example.com/authorize"
+}
+
+func (stubOAuthAPI) GetUserInfo(accessToken string) (*oauthmodels.UserInfo, error) {
+	return oauthmodels.NewUserInfo("provider-user", "new@example.com", "New User", true), nil
+}
+
+type MockOAuthLoginUsecase struct {
+	signups []url.Values // Queries of the signup requests
+	userID  uuid.UUID    // User ID of the signed up user
+	oauth   *login.OAuthLoginUsecase
+}
+
+// Setup builds the OAuth sign in up to the login code, signing up new users with a stub of the signup API.
+func (m *MockOAuthLoginUsecase) Setup(t *testing.T) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	store := miniredis.RunT(t)
+	codeStore := redis.NewClient(&redis.Options{Addr: store.Addr()})
+	t.Cleanup(func() { codeStore.Close() })
+
+	m.userID = uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.signups = append(m.signups, r.URL.Query())
+		json.NewEncoder(w).Encode(map[string]any{
+			"user_id":     m.userID.String(),
+			"provider":    "google",
+			"provider_id": "provider-user",
+			"email":       "new@example.com",
+			"is_verified": true,
+			"created_at":  time.Now().Format(time.RFC3339),
+		})
+	}))
+	t.Cleanup(server.Close)
+	signupAPI, err := signupinfra.NewSignupApi(server.URL+"/v1/signup/o", server.Client(), validator.New())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	loginCodeManager := coderepo.NewCodeManager(util.NewRandomGenerator(32), time.Minute, codeStore, "login:")
+	apis := map[authaccount.Provider]oauthapi.OAuthAPI{authaccount.ProviderGoogle: stubOAuthAPI{}}
+	m.oauth = login.NewOAuthLoginUsecase(dbrepo.NewAuthAccountRepository(client), nil, loginCodeManager, signupAPI, apis, nil, nil, nil, nil, nil, nil)
+}
+
+func TestOAuthLoginUsecase_IssueLoginCode(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("IssueLoginCode_AgeRequired", func(t *testing.T) {
+		mock := &MockOAuthLoginUsecase{}
+		mock.Setup(t)
+
+		_, _, err := mock.oauth.IssueLoginCode(ctx, logindto.OAuthLoginInput{Provider: authaccount.ProviderGoogle, Code: "code"})
+		var ageErr *login.AgeRequiredError
+		if !stdErrors.As(err, &ageErr) {
+			t.Errorf("expected an age required error, got %v", err)
+		}
+		if len(mock.signups) != 0 {
+			t.Errorf("expected no signup without a birth date, got %d", len(mock.signups))
+		}
+	})
+
+	t.Run("IssueLoginCode_SignsUpWithAge", func(t *testing.T) {
+		mock := &MockOAuthLoginUsecase{}
+		mock.Setup(t)
+		birthDate := time.Now().AddDate(-10, 0, 0).Format("2006-01-02")
+		guardianEmail := "guardian@example.com"
+
+		code, userID, err := mock.oauth.IssueLoginCode(ctx, logindto.OAuthLoginInput{
+			Provider:      authaccount.ProviderGoogle,
+			Code:          "code",
+			BirthDate:     &birthDate,
+			GuardianEmail: &guardianEmail,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if code == "" || userID != mock.userID {
+			t.Errorf("expected a login code for the new user, got %q for %s", code, userID)
+		}
+		if len(mock.signups) != 1 {
+			t.Fatalf("expected 1 signup, got %d", len(mock.signups))
+		}
+		if query := mock.signups[0]; query.Get("birth_date") != birthDate || query.Get("guardian_email") != guardianEmail {
+			t.Errorf("expected the age to be forwarded, got %v", query)
+		}
+	})
+}
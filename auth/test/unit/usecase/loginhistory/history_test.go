@@ -0,0 +1,266 @@
+package loginhistory_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	mailerv1 "github.com/mandacode-com/accounts-proto/go/mailer/v1"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/protocol"
+	metadataapi "github.com/segmentio/kafka-go/protocol/metadata"
+	produceapi "github.com/segmentio/kafka-go/protocol/produce"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"mandacode.com/accounts/auth/ent/enttest"
+	"mandacode.com/accounts/auth/ent/loginattempt"
+	geoipinfra "mandacode.com/accounts/auth/internal/infra/geoip"
+	"mandacode.com/accounts/auth/internal/infra/mailer"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	reqmodels "mandacode.com/accounts/auth/internal/models/request"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	"mandacode.com/accounts/auth/internal/usecase/loginhistory"
+)
+
+// fakeBroker serves a single partition, and forwards the login alerts produced to it.
+type fakeBroker struct {
+	alerts chan *mailerv1.NewLoginAlertEvent
+}
+
+func (b *fakeBroker) RoundTrip(ctx context.Context, addr net.Addr, req kafka.Request) (kafka.Response, error) {
+	switch req := req.(type) {
+	case *metadataapi.Request:
+		topics := make([]metadataapi.ResponseTopic, len(req.TopicNames))
+		for i, name := range req.TopicNames {
+			topics[i] = metadataapi.ResponseTopic{Name: name, Partitions: []metadataapi.ResponsePartition{{PartitionIndex: 0}}}
+		}
+		return &metadataapi.Response{Topics: topics}, nil
+	case *produceapi.Request:
+		for _, topic := range req.Topics {
+			for _, partition := range topic.Partitions {
+				if err := b.forward(partition.RecordSet.Records); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return &produceapi.Response{Topics: []produceapi.ResponseTopic{{
+			Topic:      req.Topics[0].Topic,
+			Partitions: []produceapi.ResponsePartition{{Partition: req.Topics[0].Partitions[0].Partition}},
+		}}}, nil
+	}
+	return nil, io.ErrUnexpectedEOF
+}
+
+func (b *fakeBroker) forward(records protocol.RecordReader) error {
+	for {
+		record, err := records.ReadRecord()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		value, err := protocol.ReadAll(record.Value)
+		if err != nil {
+			return err
+		}
+		event := &mailerv1.NewLoginAlertEvent{}
+		if err := proto.Unmarshal(value, event); err != nil {
+			return err
+		}
+		b.alerts <- event
+	}
+}
+
+type MockLoginHistoryUsecase struct {
+	broker       *fakeBroker
+	loginAttempt *dbrepo.LoginAttemptRepository
+	authAccount  *dbrepo.AuthAccountRepository
+	history      *loginhistory.LoginHistoryUsecase
+}
+
+// Setup builds the login history without a GeoIP database, so sign ins from new countries are not covered.
+func (m *MockLoginHistoryUsecase) Setup(t *testing.T) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	m.broker = &fakeBroker{alerts: make(chan *mailerv1.NewLoginAlertEvent, 10)}
+	writer := &kafka.Writer{
+		Addr:         kafka.TCP("kafka:9092"),
+		Topic:        "mail",
+		Transport:    m.broker,
+		BatchTimeout: time.Millisecond,
+	}
+	t.Cleanup(func() { writer.Close() })
+	locator, err := geoipinfra.NewLocator("")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	m.loginAttempt = dbrepo.NewLoginAttemptRepository(client)
+	m.authAccount = dbrepo.NewAuthAccountRepository(client)
+	m.history = loginhistory.NewLoginHistoryUsecase(m.loginAttempt, m.authAccount, locator, mailer.NewMailer(writer), "https://accounts.example.com/security", zap.NewNop())
+}
+
+// signUp creates a local account for a new user.
+func (m *MockLoginHistoryUsecase) signUp(t *testing.T, email string) uuid.UUID {
+	t.Helper()
+	userID := uuid.New()
+	if _, err := m.authAccount.CreateLocalAuthAccount(context.Background(), &dbmodels.CreateLocalAuthAccountInput{
+		UserID:     userID,
+		Email:      email,
+		Password:   "password",
+		IsVerified: true,
+	}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return userID
+}
+
+// expectAlert waits for the next login alert, which is sent in the background.
+func (m *MockLoginHistoryUsecase) expectAlert(t *testing.T) *mailerv1.NewLoginAlertEvent {
+	t.Helper()
+	select {
+	case alert := <-m.broker.alerts:
+		return alert
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected a login alert")
+		return nil
+	}
+}
+
+// expectNoAlert checks that no login alert was sent.
+func (m *MockLoginHistoryUsecase) expectNoAlert(t *testing.T) {
+	t.Helper()
+	select {
+	case alert := <-m.broker.alerts:
+		t.Errorf("expected no login alert, got %+v", alert)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestLoginHistoryUsecase_RecordSuccess(t *testing.T) {
+	ctx := context.Background()
+	laptop := reqmodels.RequestInfo{IP: "198.51.100.1", UserAgent: "Firefox", DeviceID: "laptop"}
+	phone := reqmodels.RequestInfo{IP: "198.51.100.2", UserAgent: "Safari", DeviceID: "phone"}
+
+	t.Run("RecordSuccess_FirstSignIn", func(t *testing.T) {
+		mock := &MockLoginHistoryUsecase{}
+		mock.Setup(t)
+		userID := mock.signUp(t, "user@example.com")
+
+		mock.history.RecordSuccess(ctx, userID, loginattempt.LoginMethodLocal, nil, laptop)
+		mock.expectNoAlert(t)
+
+		history, err := mock.history.ListLoginHistory(ctx, userID, 0)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(history) != 1 || !history[0].Success || history[0].IPAddress != laptop.IP {
+			t.Errorf("expected the sign in to be recorded, got %+v", history)
+		}
+	})
+
+	t.Run("RecordSuccess_KnownDevice", func(t *testing.T) {
+		mock := &MockLoginHistoryUsecase{}
+		mock.Setup(t)
+		userID := mock.signUp(t, "user@example.com")
+		mock.history.RecordSuccess(ctx, userID, loginattempt.LoginMethodLocal, nil, laptop)
+
+		mock.history.RecordSuccess(ctx, userID, loginattempt.LoginMethodLocal, nil, laptop)
+		mock.expectNoAlert(t)
+	})
+
+	t.Run("RecordSuccess_NewDevice", func(t *testing.T) {
+		mock := &MockLoginHistoryUsecase{}
+		mock.Setup(t)
+		userID := mock.signUp(t, "user@example.com")
+		mock.history.RecordSuccess(ctx, userID, loginattempt.LoginMethodLocal, nil, laptop)
+
+		mock.history.RecordSuccess(ctx, userID, loginattempt.LoginMethodLocal, nil, phone)
+		alert := mock.expectAlert(t)
+		if alert.Email != "user@example.com" || alert.IpAddress != phone.IP || !alert.NewDevice || alert.NewCountry {
+			t.Errorf("expected an alert about the new device, got %+v", alert)
+		}
+		if alert.Location != "Unknown" || alert.SecurityLink != "https://accounts.example.com/security" {
+			t.Errorf("expected the location and security link of the alert, got %+v", alert)
+		}
+
+		// The device is known once it signed in
+		mock.history.RecordSuccess(ctx, userID, loginattempt.LoginMethodLocal, nil, phone)
+		mock.expectNoAlert(t)
+	})
+
+	t.Run("RecordSuccess_FailedAttemptsDoNotCount", func(t *testing.T) {
+		mock := &MockLoginHistoryUsecase{}
+		mock.Setup(t)
+		userID := mock.signUp(t, "user@example.com")
+		mock.history.RecordSuccess(ctx, userID, loginattempt.LoginMethodLocal, nil, laptop)
+		mock.history.RecordFailure(ctx, userID, loginattempt.LoginMethodLocal, nil, loginhistory.FailureInvalidCredentials, phone)
+
+		mock.history.RecordSuccess(ctx, userID, loginattempt.LoginMethodLocal, nil, phone)
+		if alert := mock.expectAlert(t); !alert.NewDevice {
+			t.Errorf("expected an alert about the new device, got %+v", alert)
+		}
+	})
+}
+
+func TestLoginHistoryUsecase_RecordFailure(t *testing.T) {
+	ctx := context.Background()
+	info := reqmodels.RequestInfo{IP: "198.51.100.1", UserAgent: "Firefox", DeviceID: "laptop"}
+
+	t.Run("RecordFailure_KnownUser", func(t *testing.T) {
+		mock := &MockLoginHistoryUsecase{}
+		mock.Setup(t)
+		userID := mock.signUp(t, "user@example.com")
+
+		mock.history.RecordFailure(ctx, userID, loginattempt.LoginMethodLocal, nil, loginhistory.FailureInvalidCredentials, info)
+		history, err := mock.history.ListLoginHistory(ctx, userID, 0)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(history) != 1 || history[0].Success || history[0].FailureReason == nil || *history[0].FailureReason != loginhistory.FailureInvalidCredentials {
+			t.Errorf("expected the failure to be recorded with its reason, got %+v", history)
+		}
+		mock.expectNoAlert(t)
+	})
+
+	t.Run("RecordFailure_UnknownUser", func(t *testing.T) {
+		mock := &MockLoginHistoryUsecase{}
+		mock.Setup(t)
+
+		mock.history.RecordFailure(ctx, uuid.Nil, loginattempt.LoginMethodLocal, nil, loginhistory.FailureInvalidCredentials, info)
+		history, err := mock.history.ListLoginHistory(ctx, uuid.Nil, 0)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(history) != 0 {
+			t.Errorf("expected the attempt not to belong to any user, got %+v", history)
+		}
+	})
+}
+
+func TestLoginHistoryUsecase_ListLoginHistory(t *testing.T) {
+	ctx := context.Background()
+	mock := &MockLoginHistoryUsecase{}
+	mock.Setup(t)
+	userID := mock.signUp(t, "user@example.com")
+	info := reqmodels.RequestInfo{IP: "198.51.100.1", UserAgent: "Firefox", DeviceID: "laptop"}
+	for range 3 {
+		mock.history.RecordFailure(ctx, userID, loginattempt.LoginMethodLocal, nil, loginhistory.FailureInvalidCredentials, info)
+	}
+	mock.history.RecordSuccess(ctx, userID, loginattempt.LoginMethodLocal, nil, info)
+
+	history, err := mock.history.ListLoginHistory(ctx, userID, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(history) != 2 || !history[0].Success {
+		t.Errorf("expected the 2 newest attempts, newest first, got %+v", history)
+	}
+}
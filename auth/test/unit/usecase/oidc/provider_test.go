@@ -0,0 +1,307 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"mandacode.com/accounts/auth/ent/enttest"
+	idtokeninfra "mandacode.com/accounts/auth/internal/infra/idtoken"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	oidcmodels "mandacode.com/accounts/auth/internal/models/oidc"
+	coderepo "mandacode.com/accounts/auth/internal/repository/code"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	"mandacode.com/accounts/auth/internal/usecase/oidc"
+	oidcdto "mandacode.com/accounts/auth/internal/usecase/oidc/dto"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+const (
+	issuer       = "https://accounts.example.com"
+	loginURL     = "https://accounts.example.com/login"
+	redirectURI  = "https://app.example.com/callback"
+	clientSecret = "client-secret"
+)
+
+type MockProviderUsecase struct {
+	authzCodes *coderepo.CodeManager
+	provider   *oidc.ProviderUsecase
+}
+
+// Setup builds the provider with a public web client, a confidential web client and a confidential backend client.
+// Issuing tokens needs the token and user services, so only the requests rejected before are covered.
+func (m *MockProviderUsecase) Setup(t *testing.T) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	store := miniredis.RunT(t)
+	codeStore := redis.NewClient(&redis.Options{Addr: store.Addr()})
+	t.Cleanup(func() { codeStore.Close() })
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	idTokenSigner, err := idtokeninfra.NewIDTokenSignerByStr(string(privateKeyPEM), issuer+"/", time.Hour)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	oauthClient := dbrepo.NewOAuthClientRepository(client)
+	secret := clientSecret
+	for _, input := range []*dbmodels.CreateOAuthClientInput{
+		{ClientID: "spa", RedirectURIs: []string{redirectURI}, Scopes: []string{"openid", "email"}, GrantTypes: []string{oidc.GrantTypeAuthorizationCode}},
+		{ClientID: "web", RedirectURIs: []string{redirectURI}, Scopes: []string{"openid"}, GrantTypes: []string{oidc.GrantTypeAuthorizationCode}, Secret: &secret},
+		{ClientID: "backend", Scopes: []string{"openid", "reports:read"}, GrantTypes: []string{oidc.GrantTypeClientCredentials}, Secret: &secret},
+	} {
+		input.Name = input.ClientID
+		if _, err := oauthClient.CreateClient(context.Background(), input); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	m.authzCodes = coderepo.NewCodeManager(util.NewRandomGenerator(32), time.Minute, codeStore, "authorization:")
+	m.provider = oidc.NewProviderUsecase(dbrepo.NewAuthAccountRepository(client), oauthClient, nil, m.authzCodes, idTokenSigner, loginURL, dbrepo.NewSessionRepository(client), nil, nil)
+}
+
+// issueCode issues an authorization code for the grant, as if the user had approved it.
+func (m *MockProviderUsecase) issueCode(t *testing.T, grant oidcmodels.AuthorizationGrant) string {
+	t.Helper()
+	code, err := m.authzCodes.IssueCodeWithPayload(context.Background(), grant)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return code
+}
+
+// expectOAuthError checks that err carries the OAuth error code as its public message.
+func expectOAuthError(t *testing.T, err error, code string) {
+	t.Helper()
+	appErr, ok := err.(*errors.AppError)
+	if !ok {
+		t.Fatalf("expected an AppError with %s, got %v", code, err)
+	}
+	if appErr.Public() != code {
+		t.Errorf("expected %s, got %s", code, appErr.Public())
+	}
+}
+
+// expectRedirect checks that the authorization response redirects to the URL with the query parameters.
+func expectRedirect(t *testing.T, output *oidcdto.AuthorizeOutput, err error, to string, params map[string]string) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	redirectURL, err := url.Parse(output.RedirectURL)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	query := redirectURL.Query()
+	redirectURL.RawQuery = ""
+	if redirectURL.String() != to {
+		t.Errorf("expected a redirect to %s, got %s", to, output.RedirectURL)
+	}
+	for key, value := range params {
+		if query.Get(key) != value {
+			t.Errorf("expected %s to be %q, got %q", key, value, query.Get(key))
+		}
+	}
+}
+
+func authorizeInput(clientID string) oidcdto.AuthorizeInput {
+	return oidcdto.AuthorizeInput{
+		ResponseType:        oidc.ResponseTypeCode,
+		ClientID:            clientID,
+		RedirectURI:         redirectURI,
+		Scope:               "openid email",
+		State:               "state",
+		CodeChallenge:       "challenge",
+		CodeChallengeMethod: oidc.CodeChallengeMethodS256,
+		RequestURI:          "/v1/oidc/authorize?client_id=" + clientID,
+	}
+}
+
+func TestProviderUsecase_Authorize(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Authorize_UnknownClient", func(t *testing.T) {
+		mock := &MockProviderUsecase{}
+		mock.Setup(t)
+
+		_, err := mock.provider.Authorize(ctx, authorizeInput("unknown"))
+		expectOAuthError(t, err, oidc.ErrInvalidClient)
+	})
+
+	t.Run("Authorize_UnregisteredRedirectURI", func(t *testing.T) {
+		mock := &MockProviderUsecase{}
+		mock.Setup(t)
+		input := authorizeInput("spa")
+		input.RedirectURI = "https://attacker.example.com/callback"
+
+		// The user agent must not be sent to an unregistered redirect URI
+		_, err := mock.provider.Authorize(ctx, input)
+		expectOAuthError(t, err, oidc.ErrInvalidRequest)
+	})
+
+	t.Run("Authorize_InvalidRequest", func(t *testing.T) {
+		mock := &MockProviderUsecase{}
+		mock.Setup(t)
+
+		cases := []struct {
+			name   string
+			modify func(input *oidcdto.AuthorizeInput)
+			code   string
+		}{
+			{"token response type", func(input *oidcdto.AuthorizeInput) { input.ResponseType = "token" }, oidc.ErrUnsupportedResponseType},
+			{"no openid scope", func(input *oidcdto.AuthorizeInput) { input.Scope = "email" }, oidc.ErrInvalidScope},
+			{"scope not allowed", func(input *oidcdto.AuthorizeInput) { input.Scope = "openid offline_access" }, oidc.ErrInvalidScope},
+			{"public client without PKCE", func(input *oidcdto.AuthorizeInput) { input.CodeChallenge = "" }, oidc.ErrInvalidRequest},
+			{"plain code challenge", func(input *oidcdto.AuthorizeInput) { input.CodeChallengeMethod = "plain" }, oidc.ErrInvalidRequest},
+		}
+		for _, c := range cases {
+			input := authorizeInput("spa")
+			c.modify(&input)
+			output, err := mock.provider.Authorize(ctx, input)
+			expectRedirect(t, output, err, redirectURI, map[string]string{"error": c.code, "state": "state"})
+		}
+	})
+
+	t.Run("Authorize_NotLoggedIn", func(t *testing.T) {
+		mock := &MockProviderUsecase{}
+		mock.Setup(t)
+		input := authorizeInput("spa")
+
+		output, err := mock.provider.Authorize(ctx, input)
+		expectRedirect(t, output, err, loginURL, map[string]string{"return_to": issuer + input.RequestURI})
+	})
+
+	t.Run("Authorize_PromptNone", func(t *testing.T) {
+		mock := &MockProviderUsecase{}
+		mock.Setup(t)
+		input := authorizeInput("spa")
+		input.Prompt = "none"
+
+		// The login page must not be shown, so the client is told instead
+		output, err := mock.provider.Authorize(ctx, input)
+		expectRedirect(t, output, err, redirectURI, map[string]string{"error": oidc.ErrLoginRequired, "state": "state"})
+	})
+}
+
+func TestProviderUsecase_Token(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Token_UnsupportedGrantType", func(t *testing.T) {
+		mock := &MockProviderUsecase{}
+		mock.Setup(t)
+
+		_, err := mock.provider.Token(ctx, oidcdto.TokenInput{GrantType: "password", ClientID: "spa"})
+		expectOAuthError(t, err, oidc.ErrUnsupportedGrantType)
+	})
+
+	t.Run("Token_ClientAuthentication", func(t *testing.T) {
+		mock := &MockProviderUsecase{}
+		mock.Setup(t)
+
+		for _, input := range []oidcdto.TokenInput{
+			{GrantType: oidc.GrantTypeAuthorizationCode},
+			{GrantType: oidc.GrantTypeAuthorizationCode, ClientID: "unknown"},
+			{GrantType: oidc.GrantTypeAuthorizationCode, ClientID: "web", ClientSecret: "wrong"},
+		} {
+			_, err := mock.provider.Token(ctx, input)
+			expectOAuthError(t, err, oidc.ErrInvalidClient)
+		}
+	})
+
+	t.Run("Token_GrantTypeNotAllowed", func(t *testing.T) {
+		mock := &MockProviderUsecase{}
+		mock.Setup(t)
+
+		_, err := mock.provider.Token(ctx, oidcdto.TokenInput{GrantType: oidc.GrantTypeClientCredentials, ClientID: "web", ClientSecret: clientSecret})
+		expectOAuthError(t, err, oidc.ErrUnauthorizedClient)
+		// Refresh tokens follow the authorization code grant
+		_, err = mock.provider.Token(ctx, oidcdto.TokenInput{GrantType: oidc.GrantTypeRefreshToken, ClientID: "backend", ClientSecret: clientSecret})
+		expectOAuthError(t, err, oidc.ErrUnauthorizedClient)
+	})
+
+	t.Run("Token_ClientCredentialsUserScope", func(t *testing.T) {
+		mock := &MockProviderUsecase{}
+		mock.Setup(t)
+
+		_, err := mock.provider.Token(ctx, oidcdto.TokenInput{GrantType: oidc.GrantTypeClientCredentials, ClientID: "backend", ClientSecret: clientSecret, Scope: "openid"})
+		expectOAuthError(t, err, oidc.ErrInvalidScope)
+	})
+
+	t.Run("Token_InvalidCode", func(t *testing.T) {
+		mock := &MockProviderUsecase{}
+		mock.Setup(t)
+
+		_, err := mock.provider.Token(ctx, oidcdto.TokenInput{GrantType: oidc.GrantTypeAuthorizationCode, ClientID: "spa", RedirectURI: redirectURI})
+		expectOAuthError(t, err, oidc.ErrInvalidRequest)
+		_, err = mock.provider.Token(ctx, oidcdto.TokenInput{GrantType: oidc.GrantTypeAuthorizationCode, ClientID: "spa", RedirectURI: redirectURI, Code: "unknown"})
+		expectOAuthError(t, err, oidc.ErrInvalidGrant)
+	})
+
+	t.Run("Token_CodeOfAnotherClient", func(t *testing.T) {
+		mock := &MockProviderUsecase{}
+		mock.Setup(t)
+		code := mock.issueCode(t, oidcmodels.AuthorizationGrant{ClientID: "web", RedirectURI: redirectURI, UserID: uuid.New(), Scopes: []string{"openid"}})
+
+		_, err := mock.provider.Token(ctx, oidcdto.TokenInput{GrantType: oidc.GrantTypeAuthorizationCode, ClientID: "spa", RedirectURI: redirectURI, Code: code})
+		expectOAuthError(t, err, oidc.ErrInvalidGrant)
+	})
+
+	t.Run("Token_CodeVerifierMismatch", func(t *testing.T) {
+		mock := &MockProviderUsecase{}
+		mock.Setup(t)
+		sum := sha256.Sum256([]byte("verifier"))
+		grant := oidcmodels.AuthorizationGrant{
+			ClientID:            "spa",
+			RedirectURI:         redirectURI,
+			UserID:              uuid.New(),
+			Scopes:              []string{"openid"},
+			CodeChallenge:       base64.RawURLEncoding.EncodeToString(sum[:]),
+			CodeChallengeMethod: oidc.CodeChallengeMethodS256,
+		}
+		code := mock.issueCode(t, grant)
+
+		_, err := mock.provider.Token(ctx, oidcdto.TokenInput{GrantType: oidc.GrantTypeAuthorizationCode, ClientID: "spa", RedirectURI: redirectURI, Code: code, CodeVerifier: "other"})
+		expectOAuthError(t, err, oidc.ErrInvalidGrant)
+		// The code is consumed by the failed attempt
+		_, err = mock.provider.Token(ctx, oidcdto.TokenInput{GrantType: oidc.GrantTypeAuthorizationCode, ClientID: "spa", RedirectURI: redirectURI, Code: code, CodeVerifier: "verifier"})
+		expectOAuthError(t, err, oidc.ErrInvalidGrant)
+	})
+}
+
+func TestProviderUsecase_Discovery(t *testing.T) {
+	ctx := context.Background()
+	mock := &MockProviderUsecase{}
+	mock.Setup(t)
+
+	document := mock.provider.Discovery()
+	if document.Issuer != issuer || document.TokenEndpoint != issuer+"/v1/oidc/token" || document.JWKSURI != issuer+"/v1/oidc/jwks" {
+		t.Errorf("expected the endpoints under the issuer, got %+v", document)
+	}
+	for _, grantType := range document.GrantTypesSupported {
+		// Advertised grant types must reach the grant, whose input is missing here
+		_, err := mock.provider.Token(ctx, oidcdto.TokenInput{GrantType: grantType, ClientID: "spa"})
+		if appErr, ok := err.(*errors.AppError); ok && appErr.Public() == oidc.ErrUnsupportedGrantType {
+			t.Errorf("expected the token endpoint to support the advertised %s grant", grantType)
+		}
+	}
+	if len(mock.provider.JWKS().Keys) != 1 {
+		t.Errorf("expected the signing key to be published, got %+v", mock.provider.JWKS())
+	}
+}
@@ -0,0 +1,175 @@
+package risk_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"go.uber.org/zap"
+	"mandacode.com/accounts/auth/ent/enttest"
+	"mandacode.com/accounts/auth/ent/loginattempt"
+	geoipinfra "mandacode.com/accounts/auth/internal/infra/geoip"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	reqmodels "mandacode.com/accounts/auth/internal/models/request"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	"mandacode.com/accounts/auth/internal/usecase/risk"
+)
+
+type MockEvaluator struct {
+	loginAttempt *dbrepo.LoginAttemptRepository
+	locator      *geoipinfra.Locator
+}
+
+func (m *MockEvaluator) Setup(t *testing.T) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	m.loginAttempt = dbrepo.NewLoginAttemptRepository(client)
+	// Without a database, locations are unknown and the impossible travel rule is skipped
+	locator, err := geoipinfra.NewLocator("")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	m.locator = locator
+}
+
+func (m *MockEvaluator) evaluator(t *testing.T, rules risk.Rules) *risk.Evaluator {
+	t.Helper()
+	evaluator, err := risk.NewEvaluator(m.loginAttempt, m.locator, rules, zap.NewNop())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return evaluator
+}
+
+// attempt records a sign in attempt of the user from the device.
+func (m *MockEvaluator) attempt(t *testing.T, userID uuid.UUID, success bool, deviceID string) {
+	t.Helper()
+	_, err := m.loginAttempt.CreateLoginAttempt(context.Background(), &dbmodels.CreateLoginAttemptInput{
+		UserID:      &userID,
+		LoginMethod: loginattempt.LoginMethodLocal,
+		Success:     success,
+		IPAddress:   "198.51.100.1",
+		UserAgent:   "test-agent",
+		DeviceID:    deviceID,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func evaluate(evaluator *risk.Evaluator, userID uuid.UUID, ip string, deviceID string) risk.Assessment {
+	return evaluator.Evaluate(context.Background(), userID, loginattempt.LoginMethodLocal, reqmodels.RequestInfo{
+		IP:        ip,
+		UserAgent: "test-agent",
+		DeviceID:  deviceID,
+	})
+}
+
+func TestEvaluator_Evaluate(t *testing.T) {
+	t.Run("Evaluate_Disabled", func(t *testing.T) {
+		mock := &MockEvaluator{}
+		mock.Setup(t)
+		evaluator := mock.evaluator(t, risk.Rules{
+			Enabled:   false,
+			DenyCIDRs: []string{"203.0.113.0/24"},
+		})
+
+		assessment := evaluate(evaluator, uuid.New(), "203.0.113.7", "device")
+		if assessment.Decision != risk.DecisionAllow {
+			t.Errorf("expected allow, got %s", assessment.Decision)
+		}
+	})
+
+	t.Run("Evaluate_Networks", func(t *testing.T) {
+		mock := &MockEvaluator{}
+		mock.Setup(t)
+		evaluator := mock.evaluator(t, risk.Rules{
+			Enabled:     true,
+			DenyCIDRs:   []string{"203.0.113.0/24"},
+			StepUpCIDRs: []string{"198.51.100.0/24", "203.0.113.0/24"},
+		})
+		userID := uuid.New()
+
+		if assessment := evaluate(evaluator, userID, "192.0.2.1", "device"); assessment.Decision != risk.DecisionAllow {
+			t.Errorf("expected allow outside the networks, got %s", assessment.Decision)
+		}
+		if assessment := evaluate(evaluator, userID, "198.51.100.9", "device"); assessment.Decision != risk.DecisionStepUp {
+			t.Errorf("expected step_up, got %s", assessment.Decision)
+		}
+		// The most severe decision wins, and every signal is reported
+		assessment := evaluate(evaluator, userID, "::ffff:203.0.113.7", "device")
+		if assessment.Decision != risk.DecisionDeny {
+			t.Errorf("expected deny for an IPv4-mapped address, got %s", assessment.Decision)
+		}
+		if !slices.Contains(assessment.Reasons, risk.ReasonDeniedNetwork) || !slices.Contains(assessment.Reasons, risk.ReasonStepUpNetwork) {
+			t.Errorf("expected both network reasons, got %v", assessment.Reasons)
+		}
+	})
+
+	t.Run("Evaluate_FailedAttempts", func(t *testing.T) {
+		mock := &MockEvaluator{}
+		mock.Setup(t)
+		evaluator := mock.evaluator(t, risk.Rules{
+			Enabled:              true,
+			FailedAttemptsWindow: time.Hour,
+			FailedAttemptsStepUp: 2,
+			FailedAttemptsDeny:   4,
+		})
+		userID := uuid.New()
+
+		mock.attempt(t, userID, false, "device")
+		if assessment := evaluate(evaluator, userID, "192.0.2.1", "device"); assessment.Decision != risk.DecisionAllow {
+			t.Errorf("expected allow below the thresholds, got %s", assessment.Decision)
+		}
+		mock.attempt(t, userID, false, "device")
+		if assessment := evaluate(evaluator, userID, "192.0.2.1", "device"); assessment.Decision != risk.DecisionStepUp {
+			t.Errorf("expected step_up, got %s", assessment.Decision)
+		}
+		mock.attempt(t, userID, false, "device")
+		mock.attempt(t, userID, false, "device")
+		assessment := evaluate(evaluator, userID, "192.0.2.1", "device")
+		if assessment.Decision != risk.DecisionDeny || !slices.Equal(assessment.Reasons, []string{risk.ReasonFailedAttempts}) {
+			t.Errorf("expected deny for failed attempts, got %+v", assessment)
+		}
+	})
+
+	t.Run("Evaluate_NewDevice", func(t *testing.T) {
+		mock := &MockEvaluator{}
+		mock.Setup(t)
+		evaluator := mock.evaluator(t, risk.Rules{
+			Enabled:         true,
+			NewDeviceAction: risk.DecisionStepUp,
+		})
+		userID := uuid.New()
+
+		// The first sign in of a user has no device to compare to
+		if assessment := evaluate(evaluator, userID, "192.0.2.1", "known"); assessment.Decision != risk.DecisionAllow {
+			t.Errorf("expected allow without history, got %s", assessment.Decision)
+		}
+		mock.attempt(t, userID, true, "known")
+		if assessment := evaluate(evaluator, userID, "192.0.2.1", "known"); assessment.Decision != risk.DecisionAllow {
+			t.Errorf("expected allow from a known device, got %s", assessment.Decision)
+		}
+		assessment := evaluate(evaluator, userID, "192.0.2.1", "unknown")
+		if assessment.Decision != risk.DecisionStepUp || !slices.Equal(assessment.Reasons, []string{risk.ReasonNewDevice}) {
+			t.Errorf("expected step_up for a new device, got %+v", assessment)
+		}
+	})
+}
+
+func TestNewEvaluator_InvalidNetwork(t *testing.T) {
+	mock := &MockEvaluator{}
+	mock.Setup(t)
+
+	_, err := risk.NewEvaluator(mock.loginAttempt, mock.locator, risk.Rules{
+		Enabled:   true,
+		DenyCIDRs: []string{"not-a-network"},
+	}, zap.NewNop())
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
@@ -0,0 +1,124 @@
+package token_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	tokenv1 "github.com/mandacode-com/accounts-proto/go/token/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/grpc"
+	"mandacode.com/accounts/auth/ent/enttest"
+	"mandacode.com/accounts/auth/ent/session"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	reqmodels "mandacode.com/accounts/auth/internal/models/request"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	tokenrepo "mandacode.com/accounts/auth/internal/repository/token"
+	"mandacode.com/accounts/auth/internal/usecase/token"
+	"mandacode.com/accounts/auth/internal/usecase/userstatus"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+// stubTokenClient issues unique tokens, and verifies the refresh tokens it issued.
+type stubTokenClient struct {
+	tokenv1.TokenServiceClient
+	refreshTokens map[string]string // User IDs of the issued refresh tokens
+}
+
+func (s *stubTokenClient) GenerateAccessToken(ctx context.Context, in *tokenv1.GenerateAccessTokenRequest, opts ...grpc.CallOption) (*tokenv1.GenerateAccessTokenResponse, error) {
+	return &tokenv1.GenerateAccessTokenResponse{Token: uuid.NewString(), ExpiresAt: time.Now().Add(time.Hour).Unix()}, nil
+}
+
+func (s *stubTokenClient) GenerateRefreshToken(ctx context.Context, in *tokenv1.GenerateRefreshTokenRequest, opts ...grpc.CallOption) (*tokenv1.GenerateRefreshTokenResponse, error) {
+	refreshToken := uuid.NewString()
+	s.refreshTokens[refreshToken] = in.UserId
+	return &tokenv1.GenerateRefreshTokenResponse{Token: refreshToken, ExpiresAt: time.Now().Add(time.Hour).Unix()}, nil
+}
+
+func (s *stubTokenClient) VerifyRefreshToken(ctx context.Context, in *tokenv1.VerifyRefreshTokenRequest, opts ...grpc.CallOption) (*tokenv1.VerifyRefreshTokenResponse, error) {
+	userID, ok := s.refreshTokens[in.Token]
+	if !ok {
+		return &tokenv1.VerifyRefreshTokenResponse{Valid: false}, nil
+	}
+	return &tokenv1.VerifyRefreshTokenResponse{Valid: true, UserId: &userID}, nil
+}
+
+type MockRefreshUsecase struct {
+	tokenClient *stubTokenClient
+	session     *dbrepo.SessionRepository
+	refresh     *token.RefreshUsecase
+}
+
+func (m *MockRefreshUsecase) Setup(t *testing.T) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	userStatus := dbrepo.NewUserStatusRepository(client)
+	m.tokenClient = &stubTokenClient{refreshTokens: map[string]string{}}
+	m.session = dbrepo.NewSessionRepository(client)
+	m.refresh = token.NewRefreshUsecase(tokenrepo.NewTokenRepository(m.tokenClient, nil, userStatus), m.session, userstatus.NewUserStatusUsecase(userStatus))
+}
+
+// signIn issues a refresh token for a new session of the user, signed in with the method.
+func (m *MockRefreshUsecase) signIn(t *testing.T, userID uuid.UUID, method session.LoginMethod) string {
+	t.Helper()
+	ctx := context.Background()
+	response, err := m.tokenClient.GenerateRefreshToken(ctx, &tokenv1.GenerateRefreshTokenRequest{UserId: userID.String()})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := m.session.CreateSession(ctx, &dbmodels.CreateSessionInput{
+		UserID:           userID,
+		RefreshTokenHash: util.HashToken(response.Token),
+		LoginMethod:      method,
+	}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return response.Token
+}
+
+func TestRefreshUsecase_Refresh(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Refresh_RotatesToken", func(t *testing.T) {
+		mock := &MockRefreshUsecase{}
+		mock.Setup(t)
+		refreshToken := mock.signIn(t, uuid.New(), session.LoginMethodLocal)
+
+		accessToken, newRefreshToken, err := mock.refresh.Refresh(ctx, refreshToken, reqmodels.RequestInfo{})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if accessToken == "" || newRefreshToken == "" || newRefreshToken == refreshToken {
+			t.Errorf("expected new tokens, got %q and %q", accessToken, newRefreshToken)
+		}
+		// The old refresh token is no longer the one of the session
+		if _, _, err := mock.refresh.Refresh(ctx, refreshToken, reqmodels.RequestInfo{}); !errors.Is(err, errcode.ErrUnauthorized) {
+			t.Errorf("expected an unauthorized error for the rotated token, got %v", err)
+		}
+	})
+
+	t.Run("Refresh_OIDCSession", func(t *testing.T) {
+		mock := &MockRefreshUsecase{}
+		mock.Setup(t)
+		refreshToken := mock.signIn(t, uuid.New(), session.LoginMethodOidc)
+
+		// The client would escape the checks of the OIDC token endpoint
+		if _, _, err := mock.refresh.Refresh(ctx, refreshToken, reqmodels.RequestInfo{}); !errors.Is(err, errcode.ErrUnauthorized) {
+			t.Errorf("expected an unauthorized error, got %v", err)
+		}
+	})
+
+	t.Run("Refresh_UnknownToken", func(t *testing.T) {
+		mock := &MockRefreshUsecase{}
+		mock.Setup(t)
+
+		if _, _, err := mock.refresh.Refresh(ctx, "unknown", reqmodels.RequestInfo{}); !errors.Is(err, errcode.ErrUnauthorized) {
+			t.Errorf("expected an unauthorized error, got %v", err)
+		}
+	})
+}
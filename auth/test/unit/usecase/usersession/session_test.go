@@ -0,0 +1,175 @@
+package usersession_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	_ "github.com/mattn/go-sqlite3"
+	"mandacode.com/accounts/auth/ent/enttest"
+	"mandacode.com/accounts/auth/ent/session"
+	dbmodels "mandacode.com/accounts/auth/internal/models/database"
+	dbrepo "mandacode.com/accounts/auth/internal/repository/database"
+	"mandacode.com/accounts/auth/internal/usecase/usersession"
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+type MockSessionUsecase struct {
+	session *dbrepo.SessionRepository
+	usecase *usersession.SessionUsecase
+}
+
+// Setup builds the sessions of the users. The administrator revocations, which are audited, are not covered.
+func (m *MockSessionUsecase) Setup(t *testing.T) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	m.session = dbrepo.NewSessionRepository(client)
+	m.usecase = usersession.NewSessionUsecase(m.session, nil)
+}
+
+// signIn starts a session of the user, returning its refresh token.
+func (m *MockSessionUsecase) signIn(t *testing.T, userID uuid.UUID) string {
+	t.Helper()
+	refreshToken := uuid.NewString()
+	if _, err := m.session.CreateSession(context.Background(), &dbmodels.CreateSessionInput{
+		UserID:           userID,
+		RefreshTokenHash: util.HashToken(refreshToken),
+		LoginMethod:      session.LoginMethodLocal,
+	}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return refreshToken
+}
+
+// activeSessions returns the number of active sessions of the user.
+func (m *MockSessionUsecase) activeSessions(t *testing.T, userID uuid.UUID) int {
+	t.Helper()
+	sessions, err := m.session.ListActiveSessionsByUserID(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return len(sessions)
+}
+
+func TestSessionUsecase_Logout(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Logout_CurrentSession", func(t *testing.T) {
+		mock := &MockSessionUsecase{}
+		mock.Setup(t)
+		userID := uuid.New()
+		refreshToken := mock.signIn(t, userID)
+		mock.signIn(t, userID)
+
+		if err := mock.usecase.Logout(ctx, refreshToken, false); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if count := mock.activeSessions(t, userID); count != 1 {
+			t.Errorf("expected the other session to stay active, got %d active sessions", count)
+		}
+		current, err := mock.session.GetSessionByRefreshTokenHash(ctx, util.HashToken(refreshToken))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if current.IsActive() {
+			t.Errorf("expected the session of the refresh token to be revoked")
+		}
+	})
+
+	t.Run("Logout_Everywhere", func(t *testing.T) {
+		mock := &MockSessionUsecase{}
+		mock.Setup(t)
+		userID := uuid.New()
+		otherID := uuid.New()
+		refreshToken := mock.signIn(t, userID)
+		mock.signIn(t, userID)
+		mock.signIn(t, otherID)
+
+		if err := mock.usecase.Logout(ctx, refreshToken, true); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if count := mock.activeSessions(t, userID); count != 0 {
+			t.Errorf("expected every session of the user to be revoked, got %d active sessions", count)
+		}
+		if count := mock.activeSessions(t, otherID); count != 1 {
+			t.Errorf("expected the sessions of other users to stay active, got %d active sessions", count)
+		}
+	})
+
+	t.Run("Logout_Idempotent", func(t *testing.T) {
+		mock := &MockSessionUsecase{}
+		mock.Setup(t)
+		refreshToken := mock.signIn(t, uuid.New())
+
+		for _, token := range []string{"", "unknown", refreshToken, refreshToken} {
+			if err := mock.usecase.Logout(ctx, token, false); err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		}
+	})
+}
+
+func TestSessionUsecase_RevokeSessions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("RevokeOtherSessions_KeepsCurrent", func(t *testing.T) {
+		mock := &MockSessionUsecase{}
+		mock.Setup(t)
+		userID := uuid.New()
+		refreshToken := mock.signIn(t, userID)
+		mock.signIn(t, userID)
+		mock.signIn(t, userID)
+
+		output, err := mock.usecase.RevokeOtherSessions(ctx, userID, refreshToken)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if output.Revoked != 2 {
+			t.Errorf("expected 2 revoked sessions, got %d", output.Revoked)
+		}
+		sessions, err := mock.usecase.ListSessions(ctx, userID, refreshToken)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(sessions) != 1 || !sessions[0].Current {
+			t.Errorf("expected only the current session to stay active, got %+v", sessions)
+		}
+	})
+
+	t.Run("RevokeOtherSessions_ForeignToken", func(t *testing.T) {
+		mock := &MockSessionUsecase{}
+		mock.Setup(t)
+		userID := uuid.New()
+		mock.signIn(t, userID)
+		otherToken := mock.signIn(t, uuid.New())
+
+		// The refresh token of another user does not identify the current session
+		if _, err := mock.usecase.RevokeOtherSessions(ctx, userID, otherToken); !errors.Is(err, errcode.ErrInvalidInput) {
+			t.Errorf("expected an invalid input error, got %v", err)
+		}
+		if count := mock.activeSessions(t, userID); count != 1 {
+			t.Errorf("expected the session to stay active, got %d active sessions", count)
+		}
+	})
+
+	t.Run("RevokeSession_OtherUser", func(t *testing.T) {
+		mock := &MockSessionUsecase{}
+		mock.Setup(t)
+		userID := uuid.New()
+		mock.signIn(t, userID)
+		sessions, err := mock.usecase.ListSessions(ctx, userID, "")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if err := mock.usecase.RevokeSession(ctx, uuid.New(), sessions[0].ID); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+		if count := mock.activeSessions(t, userID); count != 1 {
+			t.Errorf("expected the session to stay active, got %d active sessions", count)
+		}
+	})
+}
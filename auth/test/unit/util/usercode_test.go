@@ -0,0 +1,41 @@
+package util_test
+
+import (
+	"strings"
+	"testing"
+
+	"mandacode.com/accounts/auth/internal/util"
+)
+
+func TestUserCodeGenerator_GenerateUserCode(t *testing.T) {
+	gen := util.NewUserCodeGenerator(8)
+
+	t.Run("GenerateUserCode_Format", func(t *testing.T) {
+		code, err := gen.GenerateUserCode()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(code) != 9 || code[4] != '-' {
+			t.Errorf("expected code in XXXX-XXXX format, got %q", code)
+		}
+		if strings.ContainsAny(code, "AEIOUY0123456789") {
+			t.Errorf("expected code without vowels or digits, got %q", code)
+		}
+	})
+}
+
+func TestUserCodeGenerator_NormalizeUserCode(t *testing.T) {
+	gen := util.NewUserCodeGenerator(8)
+
+	cases := map[string]string{
+		"WDJB-MJHT":  "WDJB-MJHT",
+		"wdjbmjht":   "WDJB-MJHT",
+		" wdjb mjht": "WDJB-MJHT",
+		"WDJ":        "WDJ",
+	}
+	for input, expected := range cases {
+		if got := gen.NormalizeUserCode(input); got != expected {
+			t.Errorf("NormalizeUserCode(%q) = %q, expected %q", input, got, expected)
+		}
+	}
+}
@@ -5,7 +5,7 @@ go 1.24.4
 require (
 	github.com/go-playground/validator/v10 v10.27.0
 	github.com/joho/godotenv v1.5.1
-	github.com/mandacode-com/accounts-proto v0.1.1
+	github.com/mandacode-com/accounts-proto v0.1.17
 	github.com/mandacode-com/golib v0.1.15
 	github.com/segmentio/kafka-go v0.4.48
 	go.uber.org/zap v1.27.0
@@ -29,3 +29,5 @@ require (
 	google.golang.org/grpc v1.73.0 // indirect
 	gopkg.in/alexcesaro/quotedprintable.v3 v3.0.0-20150716171945-2caba252f4dc // indirect
 )
+
+replace github.com/mandacode-com/accounts-proto => ../accounts-proto
@@ -4,13 +4,46 @@ import (
 	"context"
 
 	"github.com/go-playground/validator/v10"
-	mailerv1 "github.com/mandacode-com/accounts-proto/mailer/v1"
+	mailerv1 "github.com/mandacode-com/accounts-proto/go/mailer/v1"
 	kafka "github.com/segmentio/kafka-go"
 	"google.golang.org/protobuf/proto"
 	kafkaserver "mandacode.com/accounts/mailer/cmd/server/kafka"
 	"mandacode.com/accounts/mailer/internal/usecase/mail"
 )
 
+// MailTypeHeader is the Kafka header selecting the mail to send. Messages without it are email verification mails.
+const MailTypeHeader = "mail_type"
+
+// MailTypeNewLoginAlert selects the new sign-in warning, whose payload is a mailerv1.NewLoginAlertEvent.
+const MailTypeNewLoginAlert = "new_login_alert"
+
+// MailTypeStepUpCode selects the sign-in confirmation code, whose payload is a mailerv1.StepUpCodeEvent.
+const MailTypeStepUpCode = "step_up_code"
+
+// MailTypeOrganizationInvitation selects the invitation to an organization, whose payload is a
+// mailerv1.OrganizationInvitationEvent.
+const MailTypeOrganizationInvitation = "organization_invitation"
+
+// MailTypeAccountDeletionScheduled selects the notice of a scheduled account deletion, whose payload is a
+// mailerv1.AccountDeletionScheduledEvent.
+const MailTypeAccountDeletionScheduled = "account_deletion_scheduled"
+
+// MailTypeEmailChanged selects the notice of an email change sent to the old address, whose payload is a
+// mailerv1.EmailChangedEvent.
+const MailTypeEmailChanged = "email_changed"
+
+// MailTypeDataExportReady selects the download link of a data export, whose payload is a
+// mailerv1.DataExportReadyEvent.
+const MailTypeDataExportReady = "data_export_ready"
+
+// MailTypeImpersonationStarted selects the notice of a support impersonation of the user, whose payload is a
+// mailerv1.ImpersonationStartedEvent.
+const MailTypeImpersonationStarted = "impersonation_started"
+
+// MailTypeGuardianConsentRequest selects the request for the consent of the guardian of a user under 14, whose
+// payload is a mailerv1.GuardianConsentRequestEvent.
+const MailTypeGuardianConsentRequest = "guardian_consent_request"
+
 type MailHandler struct {
 	MailApp   *mail.MailUsecase
 	validator *validator.Validate
@@ -18,6 +51,30 @@ type MailHandler struct {
 
 // HandleMessage implements kafkaserver.KafkaHandler.
 func (h *MailHandler) HandleMessage(ctx context.Context, m kafka.Message) error {
+	switch mailType(m) {
+	case MailTypeNewLoginAlert:
+		return h.handleNewLoginAlert(m)
+	case MailTypeStepUpCode:
+		return h.handleStepUpCode(m)
+	case MailTypeOrganizationInvitation:
+		return h.handleOrganizationInvitation(m)
+	case MailTypeAccountDeletionScheduled:
+		return h.handleAccountDeletionScheduled(m)
+	case MailTypeEmailChanged:
+		return h.handleEmailChanged(m)
+	case MailTypeDataExportReady:
+		return h.handleDataExportReady(m)
+	case MailTypeImpersonationStarted:
+		return h.handleImpersonationStarted(m)
+	case MailTypeGuardianConsentRequest:
+		return h.handleGuardianConsentRequest(m)
+	default:
+		return h.handleEmailVerification(m)
+	}
+}
+
+// handleEmailVerification sends the mail of an EmailVerificationEvent.
+func (h *MailHandler) handleEmailVerification(m kafka.Message) error {
 	event := &mailerv1.EmailVerificationEvent{}
 	if err := proto.Unmarshal(m.Value, event); err != nil {
 		return err
@@ -28,6 +85,156 @@ func (h *MailHandler) HandleMessage(ctx context.Context, m kafka.Message) error
 	return nil
 }
 
+// handleNewLoginAlert sends the mail of a NewLoginAlertEvent.
+func (h *MailHandler) handleNewLoginAlert(m kafka.Message) error {
+	event := &mailerv1.NewLoginAlertEvent{}
+	if err := proto.Unmarshal(m.Value, event); err != nil {
+		return err
+	}
+	if err := event.ValidateAll(); err != nil {
+		return err
+	}
+	return h.MailApp.SendNewLoginAlertMail(mail.NewLoginAlert{
+		Email:        event.Email,
+		LoginTime:    event.LoginTime.AsTime(),
+		IPAddress:    event.IpAddress,
+		Location:     event.Location,
+		UserAgent:    event.UserAgent,
+		NewDevice:    event.NewDevice,
+		NewCountry:   event.NewCountry,
+		SecurityLink: event.SecurityLink,
+	})
+}
+
+// handleStepUpCode sends the mail of a StepUpCodeEvent.
+func (h *MailHandler) handleStepUpCode(m kafka.Message) error {
+	event := &mailerv1.StepUpCodeEvent{}
+	if err := proto.Unmarshal(m.Value, event); err != nil {
+		return err
+	}
+	if err := event.ValidateAll(); err != nil {
+		return err
+	}
+	return h.MailApp.SendStepUpCodeMail(mail.StepUpCode{
+		Email:     event.Email,
+		Code:      event.Code,
+		IPAddress: event.IpAddress,
+		UserAgent: event.UserAgent,
+		ExpiresAt: event.ExpiresAt.AsTime(),
+	})
+}
+
+// handleOrganizationInvitation sends the mail of an OrganizationInvitationEvent.
+func (h *MailHandler) handleOrganizationInvitation(m kafka.Message) error {
+	event := &mailerv1.OrganizationInvitationEvent{}
+	if err := proto.Unmarshal(m.Value, event); err != nil {
+		return err
+	}
+	if err := event.ValidateAll(); err != nil {
+		return err
+	}
+	return h.MailApp.SendOrganizationInvitationMail(mail.OrganizationInvitation{
+		Email:            event.Email,
+		OrganizationName: event.OrganizationName,
+		Role:             event.Role,
+		InvitationLink:   event.InvitationLink,
+		ExpiresAt:        event.ExpiresAt.AsTime(),
+	})
+}
+
+// handleAccountDeletionScheduled sends the mail of an AccountDeletionScheduledEvent.
+func (h *MailHandler) handleAccountDeletionScheduled(m kafka.Message) error {
+	event := &mailerv1.AccountDeletionScheduledEvent{}
+	if err := proto.Unmarshal(m.Value, event); err != nil {
+		return err
+	}
+	if err := event.ValidateAll(); err != nil {
+		return err
+	}
+	return h.MailApp.SendAccountDeletionScheduledMail(mail.AccountDeletionScheduled{
+		Email:       event.Email,
+		CancelLink:  event.CancelLink,
+		DeleteAfter: event.DeleteAfter.AsTime(),
+	})
+}
+
+// handleEmailChanged sends the mail of an EmailChangedEvent.
+func (h *MailHandler) handleEmailChanged(m kafka.Message) error {
+	event := &mailerv1.EmailChangedEvent{}
+	if err := proto.Unmarshal(m.Value, event); err != nil {
+		return err
+	}
+	if err := event.ValidateAll(); err != nil {
+		return err
+	}
+	return h.MailApp.SendEmailChangedMail(mail.EmailChanged{
+		Email:         event.Email,
+		NewEmail:      event.NewEmail,
+		UndoLink:      event.UndoLink,
+		UndoExpiresAt: event.UndoExpiresAt.AsTime(),
+	})
+}
+
+// handleDataExportReady sends the mail of a DataExportReadyEvent.
+func (h *MailHandler) handleDataExportReady(m kafka.Message) error {
+	event := &mailerv1.DataExportReadyEvent{}
+	if err := proto.Unmarshal(m.Value, event); err != nil {
+		return err
+	}
+	if err := event.ValidateAll(); err != nil {
+		return err
+	}
+	return h.MailApp.SendDataExportReadyMail(mail.DataExportReady{
+		Email:        event.Email,
+		DownloadLink: event.DownloadLink,
+		ExpiresAt:    event.ExpiresAt.AsTime(),
+	})
+}
+
+// handleImpersonationStarted sends the mail of an ImpersonationStartedEvent.
+func (h *MailHandler) handleImpersonationStarted(m kafka.Message) error {
+	event := &mailerv1.ImpersonationStartedEvent{}
+	if err := proto.Unmarshal(m.Value, event); err != nil {
+		return err
+	}
+	if err := event.ValidateAll(); err != nil {
+		return err
+	}
+	return h.MailApp.SendImpersonationStartedMail(mail.ImpersonationStarted{
+		Email:     event.Email,
+		Reason:    event.Reason,
+		StartedAt: event.StartedAt.AsTime(),
+		ExpiresAt: event.ExpiresAt.AsTime(),
+	})
+}
+
+// handleGuardianConsentRequest sends the mail of a GuardianConsentRequestEvent.
+func (h *MailHandler) handleGuardianConsentRequest(m kafka.Message) error {
+	event := &mailerv1.GuardianConsentRequestEvent{}
+	if err := proto.Unmarshal(m.Value, event); err != nil {
+		return err
+	}
+	if err := event.ValidateAll(); err != nil {
+		return err
+	}
+	return h.MailApp.SendGuardianConsentRequestMail(mail.GuardianConsentRequest{
+		Email:       event.Email,
+		MinorEmail:  event.MinorEmail,
+		ConsentLink: event.ConsentLink,
+		ExpiresAt:   event.ExpiresAt.AsTime(),
+	})
+}
+
+// mailType returns the value of the mail type header of the message, if any.
+func mailType(m kafka.Message) string {
+	for _, header := range m.Headers {
+		if header.Key == MailTypeHeader {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
 func NewMailHandler(mail *mail.MailUsecase, validator *validator.Validate) kafkaserver.KafkaHandler {
 	return &MailHandler{
 		MailApp:   mail,
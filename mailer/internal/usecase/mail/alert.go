@@ -0,0 +1,74 @@
+package mail
+
+import "time"
+
+// NewLoginAlert describes a sign in from a new device or country, as published by the auth service.
+type NewLoginAlert struct {
+	Email        string
+	LoginTime    time.Time
+	IPAddress    string
+	Location     string
+	UserAgent    string
+	NewDevice    bool
+	NewCountry   bool
+	SecurityLink string
+}
+
+// StepUpCode carries the code confirming a sign in which requires a step-up, as published by the auth service.
+type StepUpCode struct {
+	Email     string
+	Code      string
+	IPAddress string
+	UserAgent string
+	ExpiresAt time.Time
+}
+
+// OrganizationInvitation invites a user to join an organization, as published by the user service.
+type OrganizationInvitation struct {
+	Email            string
+	OrganizationName string
+	Role             string
+	InvitationLink   string
+	ExpiresAt        time.Time
+}
+
+// AccountDeletionScheduled tells a user their account will be deleted and how to keep it, as published by
+// the auth service.
+type AccountDeletionScheduled struct {
+	Email       string
+	CancelLink  string
+	DeleteAfter time.Time
+}
+
+// EmailChanged tells a user their email address changed and how to undo the change, as published by the user
+// service to the old address.
+type EmailChanged struct {
+	Email         string
+	NewEmail      string
+	UndoLink      string
+	UndoExpiresAt time.Time
+}
+
+// DataExportReady tells a user the export of their data can be downloaded, as published by the user service.
+type DataExportReady struct {
+	Email        string
+	DownloadLink string
+	ExpiresAt    time.Time
+}
+
+// ImpersonationStarted tells a user the support staff started acting as them, as published by the auth service.
+type ImpersonationStarted struct {
+	Email     string
+	Reason    string
+	StartedAt time.Time
+	ExpiresAt time.Time
+}
+
+// GuardianConsentRequest asks the guardian of a user under 14 to consent to their account, as published by the
+// user service.
+type GuardianConsentRequest struct {
+	Email       string
+	MinorEmail  string
+	ConsentLink string
+	ExpiresAt   time.Time
+}
@@ -11,11 +11,19 @@ import (
 )
 
 type MailUsecase struct {
-	dialer              *gomail.Dialer
-	verifyEmailTemplate *template.Template
-	logger              *zap.Logger
-	senderName          string
-	senderEmail         string
+	dialer               *gomail.Dialer
+	verifyEmailTemplate  *template.Template
+	loginAlertTemplate   *template.Template
+	stepUpCodeTemplate   *template.Template
+	invitationTemplate   *template.Template
+	deletionTemplate     *template.Template
+	emailChangedTemplate *template.Template
+	dataExportTemplate   *template.Template
+	impersonatedTemplate *template.Template
+	guardianTemplate     *template.Template
+	logger               *zap.Logger
+	senderName           string
+	senderEmail          string
 }
 
 // SendEmailVerificationMail sends an email verification mail to the user.
@@ -47,6 +55,279 @@ func (m *MailUsecase) SendEmailVerificationMail(email string, link string) error
 	return nil
 }
 
+// SendNewLoginAlertMail sends a mail warning the user about a sign in from a new device or country.
+func (m *MailUsecase) SendNewLoginAlertMail(alert NewLoginAlert) error {
+	data := struct {
+		Time       string
+		Location   string
+		IPAddress  string
+		UserAgent  string
+		NewDevice  bool
+		NewCountry bool
+		Link       string
+	}{
+		Time:       alert.LoginTime.UTC().Format("2006-01-02 15:04 MST"),
+		Location:   alert.Location,
+		IPAddress:  alert.IPAddress,
+		UserAgent:  alert.UserAgent,
+		NewDevice:  alert.NewDevice,
+		NewCountry: alert.NewCountry,
+		Link:       alert.SecurityLink,
+	}
+
+	var body bytes.Buffer
+	if err := m.loginAlertTemplate.Execute(&body, data); err != nil {
+		m.logger.Error("failed to execute email template", zap.Error(err), zap.String("to", alert.Email))
+		return err
+	}
+
+	msg := gomail.NewMessage()
+	msg.SetAddressHeader("From", m.senderEmail, m.senderName)
+	msg.SetHeader("To", alert.Email)
+	msg.SetHeader("Subject", "[Mandacode] New Sign-in to Your Account")
+	msg.SetBody("text/html", body.String())
+
+	if err := m.dialer.DialAndSend(msg); err != nil {
+		m.logger.Error("failed to send email", zap.Error(err), zap.String("to", alert.Email))
+		return err
+	}
+
+	m.logger.Info("email sent successfully", zap.String("to", alert.Email))
+	return nil
+}
+
+// SendStepUpCodeMail sends the code confirming a sign in which requires a step-up.
+func (m *MailUsecase) SendStepUpCodeMail(stepUp StepUpCode) error {
+	data := struct {
+		Code      string
+		IPAddress string
+		UserAgent string
+		ExpiresAt string
+	}{
+		Code:      stepUp.Code,
+		IPAddress: stepUp.IPAddress,
+		UserAgent: stepUp.UserAgent,
+		ExpiresAt: stepUp.ExpiresAt.UTC().Format("2006-01-02 15:04 MST"),
+	}
+
+	var body bytes.Buffer
+	if err := m.stepUpCodeTemplate.Execute(&body, data); err != nil {
+		m.logger.Error("failed to execute email template", zap.Error(err), zap.String("to", stepUp.Email))
+		return err
+	}
+
+	msg := gomail.NewMessage()
+	msg.SetAddressHeader("From", m.senderEmail, m.senderName)
+	msg.SetHeader("To", stepUp.Email)
+	msg.SetHeader("Subject", "[Mandacode] Confirm Your Sign-in")
+	msg.SetBody("text/html", body.String())
+
+	if err := m.dialer.DialAndSend(msg); err != nil {
+		m.logger.Error("failed to send email", zap.Error(err), zap.String("to", stepUp.Email))
+		return err
+	}
+
+	m.logger.Info("email sent successfully", zap.String("to", stepUp.Email))
+	return nil
+}
+
+// SendOrganizationInvitationMail sends an invitation to join an organization.
+func (m *MailUsecase) SendOrganizationInvitationMail(invitation OrganizationInvitation) error {
+	data := struct {
+		OrganizationName string
+		Role             string
+		InvitationLink   string
+		ExpiresAt        string
+	}{
+		OrganizationName: invitation.OrganizationName,
+		Role:             invitation.Role,
+		InvitationLink:   invitation.InvitationLink,
+		ExpiresAt:        invitation.ExpiresAt.UTC().Format("2006-01-02 15:04 MST"),
+	}
+
+	var body bytes.Buffer
+	if err := m.invitationTemplate.Execute(&body, data); err != nil {
+		m.logger.Error("failed to execute email template", zap.Error(err), zap.String("to", invitation.Email))
+		return err
+	}
+
+	msg := gomail.NewMessage()
+	msg.SetAddressHeader("From", m.senderEmail, m.senderName)
+	msg.SetHeader("To", invitation.Email)
+	msg.SetHeader("Subject", "[Mandacode] You Are Invited to "+invitation.OrganizationName)
+	msg.SetBody("text/html", body.String())
+
+	if err := m.dialer.DialAndSend(msg); err != nil {
+		m.logger.Error("failed to send email", zap.Error(err), zap.String("to", invitation.Email))
+		return err
+	}
+
+	m.logger.Info("email sent successfully", zap.String("to", invitation.Email))
+	return nil
+}
+
+// SendAccountDeletionScheduledMail tells a user their account will be deleted, with a link cancelling the deletion.
+func (m *MailUsecase) SendAccountDeletionScheduledMail(deletion AccountDeletionScheduled) error {
+	data := struct {
+		CancelLink  string
+		DeleteAfter string
+	}{
+		CancelLink:  deletion.CancelLink,
+		DeleteAfter: deletion.DeleteAfter.UTC().Format("2006-01-02 15:04 MST"),
+	}
+
+	var body bytes.Buffer
+	if err := m.deletionTemplate.Execute(&body, data); err != nil {
+		m.logger.Error("failed to execute email template", zap.Error(err), zap.String("to", deletion.Email))
+		return err
+	}
+
+	msg := gomail.NewMessage()
+	msg.SetAddressHeader("From", m.senderEmail, m.senderName)
+	msg.SetHeader("To", deletion.Email)
+	msg.SetHeader("Subject", "[Mandacode] Your Account Is Scheduled for Deletion")
+	msg.SetBody("text/html", body.String())
+
+	if err := m.dialer.DialAndSend(msg); err != nil {
+		m.logger.Error("failed to send email", zap.Error(err), zap.String("to", deletion.Email))
+		return err
+	}
+
+	m.logger.Info("email sent successfully", zap.String("to", deletion.Email))
+	return nil
+}
+
+// SendEmailChangedMail tells a user their email address changed, with a link undoing the change. It is sent to
+// the old address.
+func (m *MailUsecase) SendEmailChangedMail(notice EmailChanged) error {
+	data := struct {
+		NewEmail      string
+		UndoLink      string
+		UndoExpiresAt string
+	}{
+		NewEmail:      notice.NewEmail,
+		UndoLink:      notice.UndoLink,
+		UndoExpiresAt: notice.UndoExpiresAt.UTC().Format("2006-01-02 15:04 MST"),
+	}
+
+	var body bytes.Buffer
+	if err := m.emailChangedTemplate.Execute(&body, data); err != nil {
+		m.logger.Error("failed to execute email template", zap.Error(err), zap.String("to", notice.Email))
+		return err
+	}
+
+	msg := gomail.NewMessage()
+	msg.SetAddressHeader("From", m.senderEmail, m.senderName)
+	msg.SetHeader("To", notice.Email)
+	msg.SetHeader("Subject", "[Mandacode] Your Email Address Was Changed")
+	msg.SetBody("text/html", body.String())
+
+	if err := m.dialer.DialAndSend(msg); err != nil {
+		m.logger.Error("failed to send email", zap.Error(err), zap.String("to", notice.Email))
+		return err
+	}
+
+	m.logger.Info("email sent successfully", zap.String("to", notice.Email))
+	return nil
+}
+
+// SendDataExportReadyMail sends a user the link downloading the export of their data.
+func (m *MailUsecase) SendDataExportReadyMail(ready DataExportReady) error {
+	data := struct {
+		DownloadLink string
+		ExpiresAt    string
+	}{
+		DownloadLink: ready.DownloadLink,
+		ExpiresAt:    ready.ExpiresAt.UTC().Format("2006-01-02 15:04 MST"),
+	}
+
+	var body bytes.Buffer
+	if err := m.dataExportTemplate.Execute(&body, data); err != nil {
+		m.logger.Error("failed to execute email template", zap.Error(err), zap.String("to", ready.Email))
+		return err
+	}
+
+	msg := gomail.NewMessage()
+	msg.SetAddressHeader("From", m.senderEmail, m.senderName)
+	msg.SetHeader("To", ready.Email)
+	msg.SetHeader("Subject", "[Mandacode] Your Data Export Is Ready")
+	msg.SetBody("text/html", body.String())
+
+	if err := m.dialer.DialAndSend(msg); err != nil {
+		m.logger.Error("failed to send email", zap.Error(err), zap.String("to", ready.Email))
+		return err
+	}
+
+	m.logger.Info("email sent successfully", zap.String("to", ready.Email))
+	return nil
+}
+
+// SendImpersonationStartedMail tells a user the support staff started acting as them, and why.
+func (m *MailUsecase) SendImpersonationStartedMail(notice ImpersonationStarted) error {
+	data := struct {
+		Reason    string
+		StartedAt string
+		ExpiresAt string
+	}{
+		Reason:    notice.Reason,
+		StartedAt: notice.StartedAt.UTC().Format("2006-01-02 15:04 MST"),
+		ExpiresAt: notice.ExpiresAt.UTC().Format("2006-01-02 15:04 MST"),
+	}
+
+	var body bytes.Buffer
+	if err := m.impersonatedTemplate.Execute(&body, data); err != nil {
+		m.logger.Error("failed to execute email template", zap.Error(err), zap.String("to", notice.Email))
+		return err
+	}
+
+	msg := gomail.NewMessage()
+	msg.SetAddressHeader("From", m.senderEmail, m.senderName)
+	msg.SetHeader("To", notice.Email)
+	msg.SetHeader("Subject", "[Mandacode] Our Support Team Accessed Your Account")
+	msg.SetBody("text/html", body.String())
+
+	if err := m.dialer.DialAndSend(msg); err != nil {
+		m.logger.Error("failed to send email", zap.Error(err), zap.String("to", notice.Email))
+		return err
+	}
+
+	m.logger.Info("email sent successfully", zap.String("to", notice.Email))
+	return nil
+}
+
+// SendGuardianConsentRequestMail asks the guardian of a user under 14 to consent to the account of the user.
+func (m *MailUsecase) SendGuardianConsentRequestMail(request GuardianConsentRequest) error {
+	data := struct {
+		MinorEmail  string
+		ConsentLink string
+		ExpiresAt   string
+	}{
+		MinorEmail:  request.MinorEmail,
+		ConsentLink: request.ConsentLink,
+		ExpiresAt:   request.ExpiresAt.UTC().Format("2006-01-02 15:04 MST"),
+	}
+
+	var body bytes.Buffer
+	if err := m.guardianTemplate.Execute(&body, data); err != nil {
+		m.logger.Error("failed to execute email template", zap.Error(err), zap.String("to", request.Email))
+		return err
+	}
+
+	msg := gomail.NewMessage()
+	msg.SetAddressHeader("From", m.senderEmail, m.senderName)
+	msg.SetHeader("To", request.Email)
+	msg.SetHeader("Subject", "[Mandacode] Guardian Consent Required for a Child's Account")
+	msg.SetBody("text/html", body.String())
+
+	if err := m.dialer.DialAndSend(msg); err != nil {
+		m.logger.Error("failed to send email", zap.Error(err), zap.String("to", request.Email))
+		return err
+	}
+
+	m.logger.Info("email sent successfully", zap.String("to", request.Email))
+	return nil
+}
+
 // NewMailUsecase creates a new instance of MailApp with the provided SMTP configuration.
 func NewMailUsecase(host string, port int, senderName string, senderEmail string, dialer *gomail.Dialer, logger *zap.Logger) (*MailUsecase, error) {
 	cwd, err := os.Getwd()
@@ -56,12 +337,60 @@ func NewMailUsecase(host string, port int, senderName string, senderEmail string
 		logger.Error("failed to parse email template", zap.Error(err))
 		return nil, err
 	}
+	loginAlertTmpl, err := template.ParseFiles(filepath.Join(cwd, "template", "new_login_alert.html"))
+	if err != nil {
+		logger.Error("failed to parse email template", zap.Error(err))
+		return nil, err
+	}
+	stepUpCodeTmpl, err := template.ParseFiles(filepath.Join(cwd, "template", "step_up_code.html"))
+	if err != nil {
+		logger.Error("failed to parse email template", zap.Error(err))
+		return nil, err
+	}
+	invitationTmpl, err := template.ParseFiles(filepath.Join(cwd, "template", "organization_invitation.html"))
+	if err != nil {
+		logger.Error("failed to parse email template", zap.Error(err))
+		return nil, err
+	}
+	deletionTmpl, err := template.ParseFiles(filepath.Join(cwd, "template", "account_deletion_scheduled.html"))
+	if err != nil {
+		logger.Error("failed to parse email template", zap.Error(err))
+		return nil, err
+	}
+	emailChangedTmpl, err := template.ParseFiles(filepath.Join(cwd, "template", "email_changed.html"))
+	if err != nil {
+		logger.Error("failed to parse email template", zap.Error(err))
+		return nil, err
+	}
+	dataExportTmpl, err := template.ParseFiles(filepath.Join(cwd, "template", "data_export_ready.html"))
+	if err != nil {
+		logger.Error("failed to parse email template", zap.Error(err))
+		return nil, err
+	}
+	impersonationTmpl, err := template.ParseFiles(filepath.Join(cwd, "template", "impersonation_started.html"))
+	if err != nil {
+		logger.Error("failed to parse email template", zap.Error(err))
+		return nil, err
+	}
+	guardianTmpl, err := template.ParseFiles(filepath.Join(cwd, "template", "guardian_consent_request.html"))
+	if err != nil {
+		logger.Error("failed to parse email template", zap.Error(err))
+		return nil, err
+	}
 
 	return &MailUsecase{
-		dialer:              dialer,
-		verifyEmailTemplate: tmpl,
-		logger:              logger,
-		senderName:          senderName,
-		senderEmail:         senderEmail,
+		dialer:               dialer,
+		verifyEmailTemplate:  tmpl,
+		loginAlertTemplate:   loginAlertTmpl,
+		stepUpCodeTemplate:   stepUpCodeTmpl,
+		invitationTemplate:   invitationTmpl,
+		deletionTemplate:     deletionTmpl,
+		emailChangedTemplate: emailChangedTmpl,
+		dataExportTemplate:   dataExportTmpl,
+		impersonatedTemplate: impersonationTmpl,
+		guardianTemplate:     guardianTmpl,
+		logger:               logger,
+		senderName:           senderName,
+		senderEmail:          senderEmail,
 	}, nil
 }
@@ -17,11 +17,12 @@ import (
 type GRPCServer struct {
 	server *grpc.Server
 	profileHandler profilev1.ProfileServiceServer
+	exportHandler  profilev1.DataExportServiceServer
 	logger      *zap.Logger
 	port        int
 }
 
-func NewGRPCServer(port int, logger *zap.Logger, profileHandler profilev1.ProfileServiceServer, servingServices []string) (server.Server, error) {
+func NewGRPCServer(port int, logger *zap.Logger, profileHandler profilev1.ProfileServiceServer, exportHandler profilev1.DataExportServiceServer, servingServices []string) (server.Server, error) {
 	server := grpc.NewServer()
 
 	// Register health check service
@@ -36,10 +37,12 @@ func NewGRPCServer(port int, logger *zap.Logger, profileHandler profilev1.Profil
 
 	// Register the token handler
 	profilev1.RegisterProfileServiceServer(server, profileHandler)
+	profilev1.RegisterDataExportServiceServer(server, exportHandler)
 
 	return &GRPCServer{
 		server:      server,
 		profileHandler: profileHandler,
+		exportHandler:  exportHandler,
 		logger:      logger,
 		port:        port,
 	}, nil
@@ -13,24 +13,38 @@ import (
 	httpmiddleware "mandacode.com/accounts/profile/internal/middleware/http"
 )
 
+// RateLimits are the rate limiting middlewares of the route groups.
+type RateLimits struct {
+	User  gin.HandlerFunc // Routes of signed in users, by user
+	Admin gin.HandlerFunc // Admin API, by route
+}
+
 type Server struct {
-	http         *http.Server
-	engine       *gin.Engine
-	logger       *zap.Logger
-	userHandler  *httphandlerv1.UserProfileHandler
-	adminHandler *httphandlerv1.AdminProfileHandler
-	port         int
+	http           *http.Server
+	engine         *gin.Engine
+	logger         *zap.Logger
+	userHandler    *httphandlerv1.UserProfileHandler
+	adminHandler   *httphandlerv1.AdminProfileHandler
+	requestInfo    gin.HandlerFunc
+	rateLimits     RateLimits
+	port           int
+	trustedProxies []string
 }
 
 // Start implements server.Server.
 func (s *Server) Start(ctx context.Context) error {
+	// Without trusted proxies, the client IP is the peer address and X-Forwarded-For is ignored
+	if err := s.engine.SetTrustedProxies(s.trustedProxies); err != nil {
+		return err
+	}
 	s.engine.Use(gin.Recovery())
 	s.engine.Use(httpmiddleware.ErrorHandler(s.logger))
+	s.engine.Use(s.requestInfo)
 
-	userGroup := s.engine.Group("/v1/user")
+	userGroup := s.engine.Group("/v1/user", s.rateLimits.User)
 	s.userHandler.RegisterRoutes(userGroup)
 
-	adminGroup := s.engine.Group("/v1/admin")
+	adminGroup := s.engine.Group("/v1/admin", s.rateLimits.Admin)
 	s.adminHandler.RegisterRoutes(adminGroup)
 
 	s.logger.Info("starting HTTP server", zap.Int("port", s.port))
@@ -52,7 +66,7 @@ func (s *Server) Stop(ctx context.Context) error {
 	return nil
 }
 
-func NewServer(port int, logger *zap.Logger, userHandler *httphandlerv1.UserProfileHandler, adminHandler *httphandlerv1.AdminProfileHandler) (server.Server, error) {
+func NewServer(port int, logger *zap.Logger, userHandler *httphandlerv1.UserProfileHandler, adminHandler *httphandlerv1.AdminProfileHandler, requestInfo gin.HandlerFunc, rateLimits RateLimits, trustedProxies []string) (server.Server, error) {
 	engine := gin.Default()
 	httpServer := &http.Server{
 		Addr:    ":" + strconv.Itoa(port),
@@ -60,11 +74,14 @@ func NewServer(port int, logger *zap.Logger, userHandler *httphandlerv1.UserProf
 	}
 
 	return &Server{
-		http:         httpServer,
-		engine:       engine,
-		logger:       logger,
-		userHandler:  userHandler,
-		adminHandler: adminHandler,
-		port:         port,
+		http:           httpServer,
+		engine:         engine,
+		logger:         logger,
+		userHandler:    userHandler,
+		adminHandler:   adminHandler,
+		requestInfo:    requestInfo,
+		rateLimits:     rateLimits,
+		port:           port,
+		trustedProxies: trustedProxies,
 	}, nil
 }
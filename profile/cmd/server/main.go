@@ -5,8 +5,11 @@ import (
 	"os"
 	"os/signal"
 
+	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	profilev1 "github.com/mandacode-com/accounts-proto/go/profile/v1"
 	"github.com/mandacode-com/golib/server"
+	"github.com/redis/go-redis/v9"
 	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
 	grpcserver "mandacode.com/accounts/profile/cmd/server/grpc"
@@ -17,10 +20,13 @@ import (
 	httphandlerv1 "mandacode.com/accounts/profile/internal/handler/v1/http"
 	kafkahandlerv1 "mandacode.com/accounts/profile/internal/handler/v1/kafka"
 	dbinfra "mandacode.com/accounts/profile/internal/infra/database"
+	httpmiddleware "mandacode.com/accounts/profile/internal/middleware/http"
+	auditeventrepo "mandacode.com/accounts/profile/internal/repository/auditevent"
 	dbrepo "mandacode.com/accounts/profile/internal/repository/database"
 	"mandacode.com/accounts/profile/internal/usecase/admin"
 	"mandacode.com/accounts/profile/internal/usecase/system"
 	"mandacode.com/accounts/profile/internal/usecase/user"
+	"mandacode.com/accounts/shared/ratelimit"
 )
 
 func main() {
@@ -48,37 +54,67 @@ func main() {
 		Topic:   cfg.UserEventReader.Topic,
 		GroupID: cfg.UserEventReader.GroupID,
 	})
+	// Initialize Kafka writer for audit events
+	auditEventWriter := &kafka.Writer{
+		Addr:                   kafka.TCP(cfg.AuditEventWriter.Address),
+		Topic:                  cfg.AuditEventWriter.Topic,
+		Balancer:               &kafka.Hash{},
+		AllowAutoTopicCreation: true,
+	}
+	// Initialize Redis client for rate limiting
+	rateLimitStore := redis.NewClient(&redis.Options{
+		Addr:     cfg.RateLimitStore.Address,
+		Password: cfg.RateLimitStore.Password,
+		DB:       cfg.RateLimitStore.DB,
+	})
 
 	// Initialize repositories
 	profileRepo := dbrepo.NewProfileRepository(dbClient)
+	permissionRepo := dbrepo.NewPermissionRepository(dbClient)
+	auditEventRepo := auditeventrepo.NewAuditEventEmitter(auditEventWriter)
 
 	// Initialize use cases
-	adminProfileUsecase := admin.NewProfileUsecase(profileRepo)
+	adminProfileUsecase := admin.NewProfileUsecase(profileRepo, auditEventRepo)
 	userProfileUsecase := user.NewProfileUsecase(profileRepo)
 	systemProfileUsecase := system.NewProfileUsecase(profileRepo)
+	permissionUsecase := system.NewPermissionUsecase(permissionRepo)
+	exportUsecase := system.NewExportUsecase(profileRepo, permissionRepo)
 
 	// Initialize HTTP handlers
 	userHandler, err := httphandlerv1.NewUserProfileHandler(userProfileUsecase, cfg.HTTPServer.UIDHeader)
 	if err != nil {
 		logger.Fatal("failed to create user profile handler", zap.Error(err))
 	}
-	adminHandler, err := httphandlerv1.NewAdminProfileHandler(adminProfileUsecase, cfg.HTTPServer.UIDHeader)
+	adminHandler, err := httphandlerv1.NewAdminProfileHandler(adminProfileUsecase, permissionUsecase, cfg.HTTPServer.UIDHeader)
 	if err != nil {
 		logger.Fatal("failed to create admin profile handler", zap.Error(err))
 	}
 
 	// Initialize GRPC handlers
 	grpcHandler := grpchandlerv1.NewProfileHandler(systemProfileUsecase, logger)
+	grpcExportHandler := grpchandlerv1.NewDataExportHandler(exportUsecase, logger)
 
 	// Initialize Kafka handlers
-	userEventHandler := kafkahandlerv1.NewUserEventHandler(systemProfileUsecase)
+	userEventHandler := kafkahandlerv1.NewUserEventHandler(systemProfileUsecase, permissionUsecase)
+
+	// Initialize rate limits
+	var rateLimiter *ratelimit.Limiter
+	if cfg.RateLimit.Enabled {
+		rateLimiter = ratelimit.NewLimiter(rateLimitStore, cfg.RateLimitStore.Prefix, func(c *gin.Context) string {
+			return c.GetHeader(cfg.HTTPServer.UIDHeader)
+		}, logger)
+	}
+	rateLimits := httpserver.RateLimits{
+		User:  rateLimiter.Limit(rateLimitRule("user", cfg.RateLimit.User, ratelimit.ByUser)),
+		Admin: rateLimiter.Limit(rateLimitRule("admin", cfg.RateLimit.Admin, ratelimit.ByRoute)),
+	}
 
 	// Server initialization
-	httpServer, err := httpserver.NewServer(cfg.HTTPServer.Port, logger, userHandler, adminHandler)
+	httpServer, err := httpserver.NewServer(cfg.HTTPServer.Port, logger, userHandler, adminHandler, httpmiddleware.RequestInfo(cfg.HTTPServer.RequestIDHeader), rateLimits, cfg.HTTPServer.TrustedProxies)
 	if err != nil {
 		logger.Fatal("failed to create HTTP server", zap.Error(err))
 	}
-	grpcServer, err := grpcserver.NewGRPCServer(cfg.GRPCServer.Port, logger, grpcHandler, []string{"profile.v1.ProfileService"})
+	grpcServer, err := grpcserver.NewGRPCServer(cfg.GRPCServer.Port, logger, grpcHandler, grpcExportHandler, []string{"profile.v1.ProfileService", profilev1.DataExportService_ServiceDesc.ServiceName})
 	if err != nil {
 		logger.Fatal("failed to create gRPC server", zap.Error(err))
 	}
@@ -109,3 +145,13 @@ func main() {
 		logger.Fatal("failed to start server", zap.Error(err))
 	}
 }
+
+// rateLimitRule converts a configured rate limit into the rule of a route group
+func rateLimitRule(name string, cfg config.RateLimitRuleConfig, key ratelimit.Key) ratelimit.Rule {
+	return ratelimit.Rule{
+		Name:   name,
+		Limit:  cfg.Limit,
+		Window: cfg.Window,
+		Key:    key,
+	}
+}
@@ -34,20 +34,36 @@ type RedisStoreConfig struct {
 }
 
 type HTTPServerConfig struct {
-	Port      int    `validate:"required,min=1,max=65535"`
-	UIDHeader string `validate:"required"`
+	Port            int      `validate:"required,min=1,max=65535"`
+	UIDHeader       string   `validate:"required"`
+	RequestIDHeader string   `validate:"required"`
+	TrustedProxies  []string `validate:"dive,cidr|ip"` // Proxies whose X-Forwarded-For is trusted for the client IP, none by default
 }
 
 type GRPCServerConfig struct {
 	Port int `validate:"required,min=1,max=65535"`
 }
 
+type RateLimitRuleConfig struct {
+	Limit  int           `validate:"min=0"`
+	Window time.Duration `validate:"required,min=1"`
+}
+
+type RateLimitConfig struct {
+	Enabled bool
+	User    RateLimitRuleConfig `validate:"required"`
+	Admin   RateLimitRuleConfig `validate:"required"`
+}
+
 type Config struct {
-	Env             string            `validate:"required,oneof=dev prod"`
-	DatabaseURL     string            `validate:"required"`
-	HTTPServer      HTTPServerConfig  `validate:"required"`
-	GRPCServer      GRPCServerConfig  `validate:"required"`
-	UserEventReader KafkaReaderConfig `validate:"required"`
+	Env              string            `validate:"required,oneof=dev prod"`
+	DatabaseURL      string            `validate:"required"`
+	HTTPServer       HTTPServerConfig  `validate:"required"`
+	GRPCServer       GRPCServerConfig  `validate:"required"`
+	UserEventReader  KafkaReaderConfig `validate:"required"`
+	AuditEventWriter KafkaWriterConfig `validate:"required"`
+	RateLimitStore   RedisStoreConfig  `validate:"required"`
+	RateLimit        RateLimitConfig   `validate:"required"`
 }
 
 // LoadConfig loads env vars from .env (if exists) and returns structured config
@@ -64,12 +80,30 @@ func LoadConfig(validator *validator.Validate) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	rateLimitStoreDB, err := strconv.Atoi(getEnv("RATE_LIMIT_STORE_DB", "0"))
+	if err != nil {
+		return nil, err
+	}
+	rateLimitEnabled, err := strconv.ParseBool(getEnv("RATE_LIMIT_ENABLED", "true"))
+	if err != nil {
+		return nil, errors.New("Invalid RATE_LIMIT_ENABLED format", "Failed to parse rate limit enabled flag", errcode.ErrInvalidInput)
+	}
+	userRateLimit, err := parseRateLimit("RATE_LIMIT_USER", "60/1m")
+	if err != nil {
+		return nil, err
+	}
+	adminRateLimit, err := parseRateLimit("RATE_LIMIT_ADMIN", "600/1m")
+	if err != nil {
+		return nil, err
+	}
 
 	config := &Config{
 		Env: getEnv("ENV", "dev"),
 		HTTPServer: HTTPServerConfig{
-			Port:      httpPort,
-			UIDHeader: getEnv("UID_HEADER_KEY", "X-User-ID"),
+			Port:            httpPort,
+			UIDHeader:       getEnv("UID_HEADER_KEY", "X-User-ID"),
+			RequestIDHeader: getEnv("REQUEST_ID_HEADER_KEY", "X-Request-ID"),
+			TrustedProxies:  splitList(getEnv("TRUSTED_PROXIES", "")),
 		},
 		GRPCServer: GRPCServerConfig{
 			Port: grpcPort,
@@ -80,6 +114,22 @@ func LoadConfig(validator *validator.Validate) (*Config, error) {
 			Topic:   getEnv("USER_EVENT_READER_TOPIC", "user_event"),
 			GroupID: getEnv("USER_EVENT_READER_GROUP_ID", "user_event_group"),
 		},
+		AuditEventWriter: KafkaWriterConfig{
+			Address: getEnv("AUDIT_EVENT_WRITER_ADDRESS", ""),
+			Topic:   getEnv("AUDIT_EVENT_WRITER_TOPIC", "audit_event"),
+		},
+		RateLimitStore: RedisStoreConfig{
+			Address:  getEnv("RATE_LIMIT_STORE_ADDRESS", ""),
+			Password: getEnv("RATE_LIMIT_STORE_PASSWORD", ""),
+			DB:       rateLimitStoreDB,
+			Prefix:   getEnv("RATE_LIMIT_STORE_PREFIX", "profile_ratelimit:"),
+			HashKey:  getEnv("RATE_LIMIT_STORE_HASH_KEY", "default_rate_limit_hash_key"),
+		},
+		RateLimit: RateLimitConfig{
+			Enabled: rateLimitEnabled,
+			User:    userRateLimit,
+			Admin:   adminRateLimit,
+		},
 	}
 
 	if err := validator.Struct(config); err != nil {
@@ -96,3 +146,32 @@ func getEnv(key, fallback string) string {
 	}
 	return val
 }
+
+// splitList splits a comma separated env value, dropping empty entries so that an unset value yields an empty list
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseRateLimit parses a rate limit env value of the form "<limit>/<window>", such as "20/1m"
+func parseRateLimit(key, fallback string) (RateLimitRuleConfig, error) {
+	value := getEnv(key, fallback)
+	rawLimit, rawWindow, ok := strings.Cut(value, "/")
+	if !ok {
+		return RateLimitRuleConfig{}, errors.New("Invalid "+key+" format", "Failed to parse rate limit", errcode.ErrInvalidInput)
+	}
+	limit, err := strconv.Atoi(rawLimit)
+	if err != nil {
+		return RateLimitRuleConfig{}, errors.New("Invalid "+key+" format", "Failed to parse rate limit", errcode.ErrInvalidInput)
+	}
+	window, err := time.ParseDuration(rawWindow)
+	if err != nil {
+		return RateLimitRuleConfig{}, errors.New("Invalid "+key+" format", "Failed to parse rate limit", errcode.ErrInvalidInput)
+	}
+	return RateLimitRuleConfig{Limit: limit, Window: window}, nil
+}
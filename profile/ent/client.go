@@ -15,6 +15,7 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"mandacode.com/accounts/profile/ent/profile"
+	"mandacode.com/accounts/profile/ent/userpermission"
 )
 
 // Client is the client that holds all ent builders.
@@ -24,6 +25,8 @@ type Client struct {
 	Schema *migrate.Schema
 	// Profile is the client for interacting with the Profile builders.
 	Profile *ProfileClient
+	// UserPermission is the client for interacting with the UserPermission builders.
+	UserPermission *UserPermissionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -36,6 +39,7 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Profile = NewProfileClient(c.config)
+	c.UserPermission = NewUserPermissionClient(c.config)
 }
 
 type (
@@ -126,9 +130,10 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Profile: NewProfileClient(cfg),
+		ctx:            ctx,
+		config:         cfg,
+		Profile:        NewProfileClient(cfg),
+		UserPermission: NewUserPermissionClient(cfg),
 	}, nil
 }
 
@@ -146,9 +151,10 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Profile: NewProfileClient(cfg),
+		ctx:            ctx,
+		config:         cfg,
+		Profile:        NewProfileClient(cfg),
+		UserPermission: NewUserPermissionClient(cfg),
 	}, nil
 }
 
@@ -178,12 +184,14 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	c.Profile.Use(hooks...)
+	c.UserPermission.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	c.Profile.Intercept(interceptors...)
+	c.UserPermission.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -191,6 +199,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *ProfileMutation:
 		return c.Profile.mutate(ctx, m)
+	case *UserPermissionMutation:
+		return c.UserPermission.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -329,12 +339,145 @@ func (c *ProfileClient) mutate(ctx context.Context, m *ProfileMutation) (Value,
 	}
 }
 
+// UserPermissionClient is a client for the UserPermission schema.
+type UserPermissionClient struct {
+	config
+}
+
+// NewUserPermissionClient returns a client for the UserPermission from the given config.
+func NewUserPermissionClient(c config) *UserPermissionClient {
+	return &UserPermissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userpermission.Hooks(f(g(h())))`.
+func (c *UserPermissionClient) Use(hooks ...Hook) {
+	c.hooks.UserPermission = append(c.hooks.UserPermission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userpermission.Intercept(f(g(h())))`.
+func (c *UserPermissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserPermission = append(c.inters.UserPermission, interceptors...)
+}
+
+// Create returns a builder for creating a UserPermission entity.
+func (c *UserPermissionClient) Create() *UserPermissionCreate {
+	mutation := newUserPermissionMutation(c.config, OpCreate)
+	return &UserPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserPermission entities.
+func (c *UserPermissionClient) CreateBulk(builders ...*UserPermissionCreate) *UserPermissionCreateBulk {
+	return &UserPermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserPermissionClient) MapCreateBulk(slice any, setFunc func(*UserPermissionCreate, int)) *UserPermissionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserPermissionCreateBulk{err: fmt.Errorf("calling to UserPermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserPermissionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserPermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserPermission.
+func (c *UserPermissionClient) Update() *UserPermissionUpdate {
+	mutation := newUserPermissionMutation(c.config, OpUpdate)
+	return &UserPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserPermissionClient) UpdateOne(up *UserPermission) *UserPermissionUpdateOne {
+	mutation := newUserPermissionMutation(c.config, OpUpdateOne, withUserPermission(up))
+	return &UserPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserPermissionClient) UpdateOneID(id int) *UserPermissionUpdateOne {
+	mutation := newUserPermissionMutation(c.config, OpUpdateOne, withUserPermissionID(id))
+	return &UserPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserPermission.
+func (c *UserPermissionClient) Delete() *UserPermissionDelete {
+	mutation := newUserPermissionMutation(c.config, OpDelete)
+	return &UserPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserPermissionClient) DeleteOne(up *UserPermission) *UserPermissionDeleteOne {
+	return c.DeleteOneID(up.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserPermissionClient) DeleteOneID(id int) *UserPermissionDeleteOne {
+	builder := c.Delete().Where(userpermission.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserPermissionDeleteOne{builder}
+}
+
+// Query returns a query builder for UserPermission.
+func (c *UserPermissionClient) Query() *UserPermissionQuery {
+	return &UserPermissionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserPermission},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserPermission entity by its id.
+func (c *UserPermissionClient) Get(ctx context.Context, id int) (*UserPermission, error) {
+	return c.Query().Where(userpermission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserPermissionClient) GetX(ctx context.Context, id int) *UserPermission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserPermissionClient) Hooks() []Hook {
+	return c.hooks.UserPermission
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserPermissionClient) Interceptors() []Interceptor {
+	return c.inters.UserPermission
+}
+
+func (c *UserPermissionClient) mutate(ctx context.Context, m *UserPermissionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserPermission mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Profile []ent.Hook
+		Profile, UserPermission []ent.Hook
 	}
 	inters struct {
-		Profile []ent.Interceptor
+		Profile, UserPermission []ent.Interceptor
 	}
 )
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"mandacode.com/accounts/profile/ent/profile"
+	"mandacode.com/accounts/profile/ent/userpermission"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +74,8 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			profile.Table: profile.ValidColumn,
+			profile.Table:        profile.ValidColumn,
+			userpermission.Table: userpermission.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,10 @@
+-- Create "user_permissions" table
+CREATE TABLE "public"."user_permissions" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "user_id" uuid NOT NULL,
+  "permissions" jsonb NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "user_permissions_user_id_key" to table: "user_permissions"
+CREATE UNIQUE INDEX "user_permissions_user_id_key" ON "public"."user_permissions" ("user_id");
@@ -8,3 +8,6 @@ import (
 
 // Profile is the predicate function for profile builders.
 type Profile func(*sql.Selector)
+
+// UserPermission is the predicate function for userpermission builders.
+type UserPermission func(*sql.Selector)
@@ -7,6 +7,7 @@ import (
 
 	"mandacode.com/accounts/profile/ent/profile"
 	"mandacode.com/accounts/profile/ent/schema"
+	"mandacode.com/accounts/profile/ent/userpermission"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -25,4 +26,12 @@ func init() {
 	profile.DefaultUpdatedAt = profileDescUpdatedAt.Default.(func() time.Time)
 	// profile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	profile.UpdateDefaultUpdatedAt = profileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	userpermissionFields := schema.UserPermission{}.Fields()
+	_ = userpermissionFields
+	// userpermissionDescUpdatedAt is the schema descriptor for updated_at field.
+	userpermissionDescUpdatedAt := userpermissionFields[2].Descriptor()
+	// userpermission.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userpermission.DefaultUpdatedAt = userpermissionDescUpdatedAt.Default.(func() time.Time)
+	// userpermission.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userpermission.UpdateDefaultUpdatedAt = userpermissionDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UserPermission holds the schema definition for the UserPermission entity.
+type UserPermission struct {
+	ent.Schema
+}
+
+// Fields of the UserPermission.
+func (UserPermission) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable().
+			Unique().
+			Comment("The ID of the user the permissions belong to. This is immutable and should not change."),
+		field.Strings("permissions").
+			Comment("The permissions granted by the user's roles, as published by the user service on role changes."),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("The timestamp when the permissions were last updated. This is automatically set to the current time on update."),
+	}
+}
+
+// Edges of the UserPermission.
+func (UserPermission) Edges() []ent.Edge {
+	return nil
+}
@@ -14,6 +14,8 @@ type Tx struct {
 	config
 	// Profile is the client for interacting with the Profile builders.
 	Profile *ProfileClient
+	// UserPermission is the client for interacting with the UserPermission builders.
+	UserPermission *UserPermissionClient
 
 	// lazily loaded.
 	client     *Client
@@ -146,6 +148,7 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Profile = NewProfileClient(tx.config)
+	tx.UserPermission = NewUserPermissionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/profile/ent/userpermission"
+)
+
+// UserPermission is the model entity for the UserPermission schema.
+type UserPermission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// The ID of the user the permissions belong to. This is immutable and should not change.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// The permissions granted by the user's roles, as published by the user service on role changes.
+	Permissions []string `json:"permissions,omitempty"`
+	// The timestamp when the permissions were last updated. This is automatically set to the current time on update.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserPermission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userpermission.FieldPermissions:
+			values[i] = new([]byte)
+		case userpermission.FieldID:
+			values[i] = new(sql.NullInt64)
+		case userpermission.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case userpermission.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserPermission fields.
+func (up *UserPermission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userpermission.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			up.ID = int(value.Int64)
+		case userpermission.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				up.UserID = *value
+			}
+		case userpermission.FieldPermissions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field permissions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &up.Permissions); err != nil {
+					return fmt.Errorf("unmarshal field permissions: %w", err)
+				}
+			}
+		case userpermission.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				up.UpdatedAt = value.Time
+			}
+		default:
+			up.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserPermission.
+// This includes values selected through modifiers, order, etc.
+func (up *UserPermission) Value(name string) (ent.Value, error) {
+	return up.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserPermission.
+// Note that you need to call UserPermission.Unwrap() before calling this method if this UserPermission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (up *UserPermission) Update() *UserPermissionUpdateOne {
+	return NewUserPermissionClient(up.config).UpdateOne(up)
+}
+
+// Unwrap unwraps the UserPermission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (up *UserPermission) Unwrap() *UserPermission {
+	_tx, ok := up.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserPermission is not a transactional entity")
+	}
+	up.config.driver = _tx.drv
+	return up
+}
+
+// String implements the fmt.Stringer.
+func (up *UserPermission) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserPermission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", up.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", up.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("permissions=")
+	builder.WriteString(fmt.Sprintf("%v", up.Permissions))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(up.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserPermissions is a parsable slice of UserPermission.
+type UserPermissions []*UserPermission
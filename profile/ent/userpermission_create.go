@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/profile/ent/userpermission"
+)
+
+// UserPermissionCreate is the builder for creating a UserPermission entity.
+type UserPermissionCreate struct {
+	config
+	mutation *UserPermissionMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (upc *UserPermissionCreate) SetUserID(u uuid.UUID) *UserPermissionCreate {
+	upc.mutation.SetUserID(u)
+	return upc
+}
+
+// SetPermissions sets the "permissions" field.
+func (upc *UserPermissionCreate) SetPermissions(s []string) *UserPermissionCreate {
+	upc.mutation.SetPermissions(s)
+	return upc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (upc *UserPermissionCreate) SetUpdatedAt(t time.Time) *UserPermissionCreate {
+	upc.mutation.SetUpdatedAt(t)
+	return upc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (upc *UserPermissionCreate) SetNillableUpdatedAt(t *time.Time) *UserPermissionCreate {
+	if t != nil {
+		upc.SetUpdatedAt(*t)
+	}
+	return upc
+}
+
+// Mutation returns the UserPermissionMutation object of the builder.
+func (upc *UserPermissionCreate) Mutation() *UserPermissionMutation {
+	return upc.mutation
+}
+
+// Save creates the UserPermission in the database.
+func (upc *UserPermissionCreate) Save(ctx context.Context) (*UserPermission, error) {
+	upc.defaults()
+	return withHooks(ctx, upc.sqlSave, upc.mutation, upc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (upc *UserPermissionCreate) SaveX(ctx context.Context) *UserPermission {
+	v, err := upc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (upc *UserPermissionCreate) Exec(ctx context.Context) error {
+	_, err := upc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (upc *UserPermissionCreate) ExecX(ctx context.Context) {
+	if err := upc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (upc *UserPermissionCreate) defaults() {
+	if _, ok := upc.mutation.UpdatedAt(); !ok {
+		v := userpermission.DefaultUpdatedAt()
+		upc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (upc *UserPermissionCreate) check() error {
+	if _, ok := upc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserPermission.user_id"`)}
+	}
+	if _, ok := upc.mutation.Permissions(); !ok {
+		return &ValidationError{Name: "permissions", err: errors.New(`ent: missing required field "UserPermission.permissions"`)}
+	}
+	if _, ok := upc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserPermission.updated_at"`)}
+	}
+	return nil
+}
+
+func (upc *UserPermissionCreate) sqlSave(ctx context.Context) (*UserPermission, error) {
+	if err := upc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := upc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, upc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	upc.mutation.id = &_node.ID
+	upc.mutation.done = true
+	return _node, nil
+}
+
+func (upc *UserPermissionCreate) createSpec() (*UserPermission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserPermission{config: upc.config}
+		_spec = sqlgraph.NewCreateSpec(userpermission.Table, sqlgraph.NewFieldSpec(userpermission.FieldID, field.TypeInt))
+	)
+	if value, ok := upc.mutation.UserID(); ok {
+		_spec.SetField(userpermission.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := upc.mutation.Permissions(); ok {
+		_spec.SetField(userpermission.FieldPermissions, field.TypeJSON, value)
+		_node.Permissions = value
+	}
+	if value, ok := upc.mutation.UpdatedAt(); ok {
+		_spec.SetField(userpermission.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// UserPermissionCreateBulk is the builder for creating many UserPermission entities in bulk.
+type UserPermissionCreateBulk struct {
+	config
+	err      error
+	builders []*UserPermissionCreate
+}
+
+// Save creates the UserPermission entities in the database.
+func (upcb *UserPermissionCreateBulk) Save(ctx context.Context) ([]*UserPermission, error) {
+	if upcb.err != nil {
+		return nil, upcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(upcb.builders))
+	nodes := make([]*UserPermission, len(upcb.builders))
+	mutators := make([]Mutator, len(upcb.builders))
+	for i := range upcb.builders {
+		func(i int, root context.Context) {
+			builder := upcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserPermissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, upcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, upcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, upcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (upcb *UserPermissionCreateBulk) SaveX(ctx context.Context) []*UserPermission {
+	v, err := upcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (upcb *UserPermissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := upcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (upcb *UserPermissionCreateBulk) ExecX(ctx context.Context) {
+	if err := upcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
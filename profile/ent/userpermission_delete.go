@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"mandacode.com/accounts/profile/ent/predicate"
+	"mandacode.com/accounts/profile/ent/userpermission"
+)
+
+// UserPermissionDelete is the builder for deleting a UserPermission entity.
+type UserPermissionDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserPermissionMutation
+}
+
+// Where appends a list predicates to the UserPermissionDelete builder.
+func (upd *UserPermissionDelete) Where(ps ...predicate.UserPermission) *UserPermissionDelete {
+	upd.mutation.Where(ps...)
+	return upd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (upd *UserPermissionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, upd.sqlExec, upd.mutation, upd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (upd *UserPermissionDelete) ExecX(ctx context.Context) int {
+	n, err := upd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (upd *UserPermissionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(userpermission.Table, sqlgraph.NewFieldSpec(userpermission.FieldID, field.TypeInt))
+	if ps := upd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, upd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	upd.mutation.done = true
+	return affected, err
+}
+
+// UserPermissionDeleteOne is the builder for deleting a single UserPermission entity.
+type UserPermissionDeleteOne struct {
+	upd *UserPermissionDelete
+}
+
+// Where appends a list predicates to the UserPermissionDelete builder.
+func (updo *UserPermissionDeleteOne) Where(ps ...predicate.UserPermission) *UserPermissionDeleteOne {
+	updo.upd.mutation.Where(ps...)
+	return updo
+}
+
+// Exec executes the deletion query.
+func (updo *UserPermissionDeleteOne) Exec(ctx context.Context) error {
+	n, err := updo.upd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{userpermission.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (updo *UserPermissionDeleteOne) ExecX(ctx context.Context) {
+	if err := updo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
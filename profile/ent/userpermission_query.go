@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"mandacode.com/accounts/profile/ent/predicate"
+	"mandacode.com/accounts/profile/ent/userpermission"
+)
+
+// UserPermissionQuery is the builder for querying UserPermission entities.
+type UserPermissionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []userpermission.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UserPermission
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UserPermissionQuery builder.
+func (upq *UserPermissionQuery) Where(ps ...predicate.UserPermission) *UserPermissionQuery {
+	upq.predicates = append(upq.predicates, ps...)
+	return upq
+}
+
+// Limit the number of records to be returned by this query.
+func (upq *UserPermissionQuery) Limit(limit int) *UserPermissionQuery {
+	upq.ctx.Limit = &limit
+	return upq
+}
+
+// Offset to start from.
+func (upq *UserPermissionQuery) Offset(offset int) *UserPermissionQuery {
+	upq.ctx.Offset = &offset
+	return upq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (upq *UserPermissionQuery) Unique(unique bool) *UserPermissionQuery {
+	upq.ctx.Unique = &unique
+	return upq
+}
+
+// Order specifies how the records should be ordered.
+func (upq *UserPermissionQuery) Order(o ...userpermission.OrderOption) *UserPermissionQuery {
+	upq.order = append(upq.order, o...)
+	return upq
+}
+
+// First returns the first UserPermission entity from the query.
+// Returns a *NotFoundError when no UserPermission was found.
+func (upq *UserPermissionQuery) First(ctx context.Context) (*UserPermission, error) {
+	nodes, err := upq.Limit(1).All(setContextOp(ctx, upq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{userpermission.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (upq *UserPermissionQuery) FirstX(ctx context.Context) *UserPermission {
+	node, err := upq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UserPermission ID from the query.
+// Returns a *NotFoundError when no UserPermission ID was found.
+func (upq *UserPermissionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = upq.Limit(1).IDs(setContextOp(ctx, upq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{userpermission.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (upq *UserPermissionQuery) FirstIDX(ctx context.Context) int {
+	id, err := upq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UserPermission entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UserPermission entity is found.
+// Returns a *NotFoundError when no UserPermission entities are found.
+func (upq *UserPermissionQuery) Only(ctx context.Context) (*UserPermission, error) {
+	nodes, err := upq.Limit(2).All(setContextOp(ctx, upq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{userpermission.Label}
+	default:
+		return nil, &NotSingularError{userpermission.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (upq *UserPermissionQuery) OnlyX(ctx context.Context) *UserPermission {
+	node, err := upq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UserPermission ID in the query.
+// Returns a *NotSingularError when more than one UserPermission ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (upq *UserPermissionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = upq.Limit(2).IDs(setContextOp(ctx, upq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{userpermission.Label}
+	default:
+		err = &NotSingularError{userpermission.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (upq *UserPermissionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := upq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UserPermissions.
+func (upq *UserPermissionQuery) All(ctx context.Context) ([]*UserPermission, error) {
+	ctx = setContextOp(ctx, upq.ctx, ent.OpQueryAll)
+	if err := upq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UserPermission, *UserPermissionQuery]()
+	return withInterceptors[[]*UserPermission](ctx, upq, qr, upq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (upq *UserPermissionQuery) AllX(ctx context.Context) []*UserPermission {
+	nodes, err := upq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UserPermission IDs.
+func (upq *UserPermissionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if upq.ctx.Unique == nil && upq.path != nil {
+		upq.Unique(true)
+	}
+	ctx = setContextOp(ctx, upq.ctx, ent.OpQueryIDs)
+	if err = upq.Select(userpermission.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (upq *UserPermissionQuery) IDsX(ctx context.Context) []int {
+	ids, err := upq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (upq *UserPermissionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, upq.ctx, ent.OpQueryCount)
+	if err := upq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, upq, querierCount[*UserPermissionQuery](), upq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (upq *UserPermissionQuery) CountX(ctx context.Context) int {
+	count, err := upq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (upq *UserPermissionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, upq.ctx, ent.OpQueryExist)
+	switch _, err := upq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (upq *UserPermissionQuery) ExistX(ctx context.Context) bool {
+	exist, err := upq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserPermissionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (upq *UserPermissionQuery) Clone() *UserPermissionQuery {
+	if upq == nil {
+		return nil
+	}
+	return &UserPermissionQuery{
+		config:     upq.config,
+		ctx:        upq.ctx.Clone(),
+		order:      append([]userpermission.OrderOption{}, upq.order...),
+		inters:     append([]Interceptor{}, upq.inters...),
+		predicates: append([]predicate.UserPermission{}, upq.predicates...),
+		// clone intermediate query.
+		sql:  upq.sql.Clone(),
+		path: upq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UserPermission.Query().
+//		GroupBy(userpermission.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (upq *UserPermissionQuery) GroupBy(field string, fields ...string) *UserPermissionGroupBy {
+	upq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserPermissionGroupBy{build: upq}
+	grbuild.flds = &upq.ctx.Fields
+	grbuild.label = userpermission.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//	}
+//
+//	client.UserPermission.Query().
+//		Select(userpermission.FieldUserID).
+//		Scan(ctx, &v)
+func (upq *UserPermissionQuery) Select(fields ...string) *UserPermissionSelect {
+	upq.ctx.Fields = append(upq.ctx.Fields, fields...)
+	sbuild := &UserPermissionSelect{UserPermissionQuery: upq}
+	sbuild.label = userpermission.Label
+	sbuild.flds, sbuild.scan = &upq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UserPermissionSelect configured with the given aggregations.
+func (upq *UserPermissionQuery) Aggregate(fns ...AggregateFunc) *UserPermissionSelect {
+	return upq.Select().Aggregate(fns...)
+}
+
+func (upq *UserPermissionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range upq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, upq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range upq.ctx.Fields {
+		if !userpermission.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if upq.path != nil {
+		prev, err := upq.path(ctx)
+		if err != nil {
+			return err
+		}
+		upq.sql = prev
+	}
+	return nil
+}
+
+func (upq *UserPermissionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserPermission, error) {
+	var (
+		nodes = []*UserPermission{}
+		_spec = upq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UserPermission).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UserPermission{config: upq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, upq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (upq *UserPermissionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := upq.querySpec()
+	_spec.Node.Columns = upq.ctx.Fields
+	if len(upq.ctx.Fields) > 0 {
+		_spec.Unique = upq.ctx.Unique != nil && *upq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, upq.driver, _spec)
+}
+
+func (upq *UserPermissionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(userpermission.Table, userpermission.Columns, sqlgraph.NewFieldSpec(userpermission.FieldID, field.TypeInt))
+	_spec.From = upq.sql
+	if unique := upq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if upq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := upq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userpermission.FieldID)
+		for i := range fields {
+			if fields[i] != userpermission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := upq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := upq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := upq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := upq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (upq *UserPermissionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(upq.driver.Dialect())
+	t1 := builder.Table(userpermission.Table)
+	columns := upq.ctx.Fields
+	if len(columns) == 0 {
+		columns = userpermission.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if upq.sql != nil {
+		selector = upq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if upq.ctx.Unique != nil && *upq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range upq.predicates {
+		p(selector)
+	}
+	for _, p := range upq.order {
+		p(selector)
+	}
+	if offset := upq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := upq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// UserPermissionGroupBy is the group-by builder for UserPermission entities.
+type UserPermissionGroupBy struct {
+	selector
+	build *UserPermissionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (upgb *UserPermissionGroupBy) Aggregate(fns ...AggregateFunc) *UserPermissionGroupBy {
+	upgb.fns = append(upgb.fns, fns...)
+	return upgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (upgb *UserPermissionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, upgb.build.ctx, ent.OpQueryGroupBy)
+	if err := upgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserPermissionQuery, *UserPermissionGroupBy](ctx, upgb.build, upgb, upgb.build.inters, v)
+}
+
+func (upgb *UserPermissionGroupBy) sqlScan(ctx context.Context, root *UserPermissionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(upgb.fns))
+	for _, fn := range upgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*upgb.flds)+len(upgb.fns))
+		for _, f := range *upgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*upgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := upgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UserPermissionSelect is the builder for selecting fields of UserPermission entities.
+type UserPermissionSelect struct {
+	*UserPermissionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ups *UserPermissionSelect) Aggregate(fns ...AggregateFunc) *UserPermissionSelect {
+	ups.fns = append(ups.fns, fns...)
+	return ups
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ups *UserPermissionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ups.ctx, ent.OpQuerySelect)
+	if err := ups.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserPermissionQuery, *UserPermissionSelect](ctx, ups.UserPermissionQuery, ups, ups.inters, v)
+}
+
+func (ups *UserPermissionSelect) sqlScan(ctx context.Context, root *UserPermissionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ups.fns))
+	for _, fn := range ups.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ups.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ups.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
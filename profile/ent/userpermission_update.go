@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"mandacode.com/accounts/profile/ent/predicate"
+	"mandacode.com/accounts/profile/ent/userpermission"
+)
+
+// UserPermissionUpdate is the builder for updating UserPermission entities.
+type UserPermissionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserPermissionMutation
+}
+
+// Where appends a list predicates to the UserPermissionUpdate builder.
+func (upu *UserPermissionUpdate) Where(ps ...predicate.UserPermission) *UserPermissionUpdate {
+	upu.mutation.Where(ps...)
+	return upu
+}
+
+// SetPermissions sets the "permissions" field.
+func (upu *UserPermissionUpdate) SetPermissions(s []string) *UserPermissionUpdate {
+	upu.mutation.SetPermissions(s)
+	return upu
+}
+
+// AppendPermissions appends s to the "permissions" field.
+func (upu *UserPermissionUpdate) AppendPermissions(s []string) *UserPermissionUpdate {
+	upu.mutation.AppendPermissions(s)
+	return upu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (upu *UserPermissionUpdate) SetUpdatedAt(t time.Time) *UserPermissionUpdate {
+	upu.mutation.SetUpdatedAt(t)
+	return upu
+}
+
+// Mutation returns the UserPermissionMutation object of the builder.
+func (upu *UserPermissionUpdate) Mutation() *UserPermissionMutation {
+	return upu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (upu *UserPermissionUpdate) Save(ctx context.Context) (int, error) {
+	upu.defaults()
+	return withHooks(ctx, upu.sqlSave, upu.mutation, upu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (upu *UserPermissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := upu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (upu *UserPermissionUpdate) Exec(ctx context.Context) error {
+	_, err := upu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (upu *UserPermissionUpdate) ExecX(ctx context.Context) {
+	if err := upu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (upu *UserPermissionUpdate) defaults() {
+	if _, ok := upu.mutation.UpdatedAt(); !ok {
+		v := userpermission.UpdateDefaultUpdatedAt()
+		upu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (upu *UserPermissionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(userpermission.Table, userpermission.Columns, sqlgraph.NewFieldSpec(userpermission.FieldID, field.TypeInt))
+	if ps := upu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := upu.mutation.Permissions(); ok {
+		_spec.SetField(userpermission.FieldPermissions, field.TypeJSON, value)
+	}
+	if value, ok := upu.mutation.AppendedPermissions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, userpermission.FieldPermissions, value)
+		})
+	}
+	if value, ok := upu.mutation.UpdatedAt(); ok {
+		_spec.SetField(userpermission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, upu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userpermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	upu.mutation.done = true
+	return n, nil
+}
+
+// UserPermissionUpdateOne is the builder for updating a single UserPermission entity.
+type UserPermissionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserPermissionMutation
+}
+
+// SetPermissions sets the "permissions" field.
+func (upuo *UserPermissionUpdateOne) SetPermissions(s []string) *UserPermissionUpdateOne {
+	upuo.mutation.SetPermissions(s)
+	return upuo
+}
+
+// AppendPermissions appends s to the "permissions" field.
+func (upuo *UserPermissionUpdateOne) AppendPermissions(s []string) *UserPermissionUpdateOne {
+	upuo.mutation.AppendPermissions(s)
+	return upuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (upuo *UserPermissionUpdateOne) SetUpdatedAt(t time.Time) *UserPermissionUpdateOne {
+	upuo.mutation.SetUpdatedAt(t)
+	return upuo
+}
+
+// Mutation returns the UserPermissionMutation object of the builder.
+func (upuo *UserPermissionUpdateOne) Mutation() *UserPermissionMutation {
+	return upuo.mutation
+}
+
+// Where appends a list predicates to the UserPermissionUpdate builder.
+func (upuo *UserPermissionUpdateOne) Where(ps ...predicate.UserPermission) *UserPermissionUpdateOne {
+	upuo.mutation.Where(ps...)
+	return upuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (upuo *UserPermissionUpdateOne) Select(field string, fields ...string) *UserPermissionUpdateOne {
+	upuo.fields = append([]string{field}, fields...)
+	return upuo
+}
+
+// Save executes the query and returns the updated UserPermission entity.
+func (upuo *UserPermissionUpdateOne) Save(ctx context.Context) (*UserPermission, error) {
+	upuo.defaults()
+	return withHooks(ctx, upuo.sqlSave, upuo.mutation, upuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (upuo *UserPermissionUpdateOne) SaveX(ctx context.Context) *UserPermission {
+	node, err := upuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (upuo *UserPermissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := upuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (upuo *UserPermissionUpdateOne) ExecX(ctx context.Context) {
+	if err := upuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (upuo *UserPermissionUpdateOne) defaults() {
+	if _, ok := upuo.mutation.UpdatedAt(); !ok {
+		v := userpermission.UpdateDefaultUpdatedAt()
+		upuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (upuo *UserPermissionUpdateOne) sqlSave(ctx context.Context) (_node *UserPermission, err error) {
+	_spec := sqlgraph.NewUpdateSpec(userpermission.Table, userpermission.Columns, sqlgraph.NewFieldSpec(userpermission.FieldID, field.TypeInt))
+	id, ok := upuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserPermission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := upuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userpermission.FieldID)
+		for _, f := range fields {
+			if !userpermission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userpermission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := upuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := upuo.mutation.Permissions(); ok {
+		_spec.SetField(userpermission.FieldPermissions, field.TypeJSON, value)
+	}
+	if value, ok := upuo.mutation.AppendedPermissions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, userpermission.FieldPermissions, value)
+		})
+	}
+	if value, ok := upuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(userpermission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &UserPermission{config: upuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, upuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userpermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	upuo.mutation.done = true
+	return _node, nil
+}
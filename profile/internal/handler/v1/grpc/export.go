@@ -0,0 +1,59 @@
+package grpchandlerv1
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	profilev1 "github.com/mandacode-com/accounts-proto/go/profile/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"mandacode.com/accounts/profile/internal/usecase/system"
+)
+
+type DataExportHandler struct {
+	profilev1.UnimplementedDataExportServiceServer
+	export *system.ExportUsecase
+	logger *zap.Logger
+}
+
+// ExportUserData implements profilev1.DataExportServiceServer. The data is the JSON encoded system.UserData.
+func (h *DataExportHandler) ExportUserData(ctx context.Context, req *profilev1.ExportUserDataRequest) (*profilev1.ExportUserDataResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("ExportUserData request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Error("Invalid user ID format", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	data, err := h.export.ExportUserData(ctx, userID)
+	if err != nil {
+		h.logger.Error("Failed to export user data", zap.Error(err), zap.String("user_id", req.UserId))
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, status.Error(errcode.MapCodeToGRPC(appErr.Code()), appErr.Public())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to export user data: %v", err)
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode user data: %v", err)
+	}
+	return &profilev1.ExportUserDataResponse{
+		Data: encoded,
+	}, nil
+}
+
+func NewDataExportHandler(export *system.ExportUsecase, logger *zap.Logger) profilev1.DataExportServiceServer {
+	return &DataExportHandler{
+		export: export,
+		logger: logger,
+	}
+}
@@ -7,29 +7,34 @@ import (
 	"github.com/google/uuid"
 	"github.com/mandacode-com/golib/errors"
 	"github.com/mandacode-com/golib/errors/errcode"
+	httpmiddleware "mandacode.com/accounts/profile/internal/middleware/http"
+	auditmodels "mandacode.com/accounts/profile/internal/models/audit"
 	"mandacode.com/accounts/profile/internal/usecase/admin"
 	"mandacode.com/accounts/profile/internal/usecase/dto"
+	"mandacode.com/accounts/profile/internal/usecase/system"
 )
 
 type AdminProfileHandler struct {
-	profile   *admin.ProfileUsecase
-	uidHeader string
+	profile     *admin.ProfileUsecase
+	permissions httpmiddleware.PermissionChecker
+	uidHeader   string
 }
 
-func NewAdminProfileHandler(profile *admin.ProfileUsecase, uidHeader string) (*AdminProfileHandler, error) {
+func NewAdminProfileHandler(profile *admin.ProfileUsecase, permissions httpmiddleware.PermissionChecker, uidHeader string) (*AdminProfileHandler, error) {
+	if permissions == nil {
+		return nil, errors.New("permission checker cannot be nil", "Invalid Permission Checker", errcode.ErrInvalidInput)
+	}
 	return &AdminProfileHandler{
-		profile:   profile,
-		uidHeader: uidHeader,
+		profile:     profile,
+		permissions: permissions,
+		uidHeader:   uidHeader,
 	}, nil
 }
 
+// RegisterRoutes registers the admin routes, which require the permissions granted by the roles of the user service.
 func (h *AdminProfileHandler) RegisterRoutes(router *gin.RouterGroup) {
-	router.GET("/:user_id", h.GetProfile)
-	router.PUT("/:user_id", h.UpdateProfile)
-}
-
-func (h *AdminProfileHandler) isAdmin(c *gin.Context) bool {
-	return false
+	router.GET("/:user_id", httpmiddleware.RequirePermission(h.permissions, h.uidHeader, system.PermissionProfilesRead), h.GetProfile)
+	router.PUT("/:user_id", httpmiddleware.RequirePermission(h.permissions, h.uidHeader, system.PermissionProfilesManage), h.UpdateProfile)
 }
 
 func (h *AdminProfileHandler) GetProfile(c *gin.Context) {
@@ -86,7 +91,9 @@ func (h *AdminProfileHandler) UpdateProfile(c *gin.Context) {
 
 	updateData.UserID = userUID
 
-	profile, err := h.profile.UpdateProfile(c.Request.Context(), &updateData)
+	// The admin was authorized by the user ID in the header
+	ctx := auditmodels.WithActor(c.Request.Context(), auditmodels.Actor{Type: auditmodels.ActorTypeUser, ID: c.GetHeader(h.uidHeader)})
+	profile, err := h.profile.UpdateProfile(ctx, &updateData)
 	if err != nil {
 		if errors.Is(err, errcode.ErrNotFound) {
 			err = errors.Join(err, "Update profile handler failed")
@@ -15,7 +15,8 @@ import (
 
 type UserEventHandler struct {
 	// userEvent *userevent.UserEventUsecase
-	profile *system.ProfileUsecase
+	profile     *system.ProfileUsecase
+	permissions *system.PermissionUsecase
 }
 
 // HandleMessage implements kafkaserver.KafkaHandler.
@@ -35,6 +36,9 @@ func (u *UserEventHandler) HandleMessage(ctx context.Context, m kafka.Message) e
 		if err := u.profile.DeleteProfile(ctx, userUUID); err != nil {
 			return errors.Upgrade(err, "Failed to handle user deleted event", errcode.ErrInternalFailure)
 		}
+		if err := u.permissions.DeletePermissions(ctx, userUUID); err != nil {
+			return errors.Upgrade(err, "Failed to handle user deleted event", errcode.ErrInternalFailure)
+		}
 	case usereventv1.EventType_USER_ARCHIVED:
 		if err := u.profile.ArchiveProfile(ctx, userUUID); err != nil {
 			return errors.Upgrade(err, "Failed to handle user archived event", errcode.ErrInternalFailure)
@@ -47,6 +51,16 @@ func (u *UserEventHandler) HandleMessage(ctx context.Context, m kafka.Message) e
 		return nil
 	case usereventv1.EventType_USER_UNBLOCKED:
 		return nil
+	case usereventv1.EventType_USER_ACTIVE_CHANGED:
+		return nil
+	case usereventv1.EventType_USER_EMAIL_CHANGED:
+		return nil
+	case usereventv1.EventType_USER_MINOR_STATUS_CHANGED:
+		return nil
+	case usereventv1.EventType_ROLES_CHANGED:
+		if err := u.permissions.SetPermissions(ctx, userUUID, event.GetRolesChanged().GetPermissions()); err != nil {
+			return errors.Upgrade(err, "Failed to handle roles changed event", errcode.ErrInternalFailure)
+		}
 	default:
 		return errors.New("unsupported user event type", "User Event Handler Error", errcode.ErrInvalidInput)
 	}
@@ -54,8 +68,9 @@ func (u *UserEventHandler) HandleMessage(ctx context.Context, m kafka.Message) e
 	return nil
 }
 
-func NewUserEventHandler(profile *system.ProfileUsecase) kafkaserver.KafkaHandler {
+func NewUserEventHandler(profile *system.ProfileUsecase, permissions *system.PermissionUsecase) kafkaserver.KafkaHandler {
 	return &UserEventHandler{
-		profile: profile,
+		profile:     profile,
+		permissions: permissions,
 	}
 }
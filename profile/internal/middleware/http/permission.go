@@ -0,0 +1,41 @@
+package httpmiddleware
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+)
+
+// PermissionChecker reports whether users hold permissions granted by their roles.
+type PermissionChecker interface {
+	HasPermission(ctx context.Context, userID uuid.UUID, permission string) (bool, error)
+}
+
+// RequirePermission only lets requests through whose user, identified by the user ID in uidHeader,
+// holds the permission.
+func RequirePermission(checker PermissionChecker, uidHeader string, permission string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		userID, err := uuid.Parse(ctx.GetHeader(uidHeader))
+		if err != nil {
+			ctx.Error(errors.New("user ID is missing or invalid", "Unauthorized", errcode.ErrUnauthorized))
+			ctx.Abort()
+			return
+		}
+
+		allowed, err := checker.HasPermission(ctx.Request.Context(), userID, permission)
+		if err != nil {
+			ctx.Error(errors.Join(err, "Failed to check permission"))
+			ctx.Abort()
+			return
+		}
+		if !allowed {
+			ctx.Error(errors.New("missing permission: "+permission, "Forbidden", errcode.ErrForbidden))
+			ctx.Abort()
+			return
+		}
+		ctx.Next()
+	}
+}
@@ -0,0 +1,28 @@
+package httpmiddleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	auditmodels "mandacode.com/accounts/profile/internal/models/audit"
+)
+
+// maxRequestIDLength bounds the request IDs accepted from clients and proxies.
+const maxRequestIDLength = 128
+
+// RequestInfo puts the ID and client IP of the request in the request context, for the audit trail. The request
+// ID is read from requestIDHeader, and generated if it is missing.
+func RequestInfo(requestIDHeader string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestID := ctx.GetHeader(requestIDHeader)
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = uuid.NewString()
+		}
+		ctx.Header(requestIDHeader, requestID)
+
+		ctx.Request = ctx.Request.WithContext(auditmodels.WithRequestInfo(ctx.Request.Context(), auditmodels.RequestInfo{
+			RequestID: requestID,
+			IP:        ctx.ClientIP(),
+		}))
+		ctx.Next()
+	}
+}
@@ -0,0 +1,65 @@
+package auditeventrepo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"github.com/segmentio/kafka-go"
+	auditmodels "mandacode.com/accounts/profile/internal/models/audit"
+)
+
+// AuditEventEmitter publishes the audit events of the profile service to the audit topic.
+type AuditEventEmitter struct {
+	writer *kafka.Writer
+}
+
+// NewAuditEventEmitter creates a new AuditEventEmitter publishing with the writer of the audit topic.
+func NewAuditEventEmitter(writer *kafka.Writer) *AuditEventEmitter {
+	return &AuditEventEmitter{
+		writer: writer,
+	}
+}
+
+// Emit publishes the audit event of an action applied to a target, with the fields which it changed.
+//
+// before and after are the states of the target before and after the action. The actor and request are read
+// from ctx.
+func (e *AuditEventEmitter) Emit(ctx context.Context, action string, targetType string, targetID string, before any, after any) error {
+	changedBefore, changedAfter, err := auditmodels.Diff(before, after)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to diff audited target", errcode.ErrInternalFailure)
+	}
+
+	actor := auditmodels.ActorFromContext(ctx)
+	request := auditmodels.RequestInfoFromContext(ctx)
+	data, err := json.Marshal(auditmodels.Event{
+		ID:         uuid.New(),
+		Service:    auditmodels.ServiceProfile,
+		ActorType:  actor.Type,
+		ActorID:    actor.ID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Before:     changedBefore,
+		After:      changedAfter,
+		RequestID:  request.RequestID,
+		IP:         request.IP,
+		OccurredAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return errors.New(err.Error(), "Failed to marshal audit event", errcode.ErrInternalFailure)
+	}
+
+	message := kafka.Message{
+		Key:   []byte(targetID),
+		Value: data,
+	}
+	if err := e.writer.WriteMessages(ctx, message); err != nil {
+		return errors.New(err.Error(), "Failed to publish audit event", errcode.ErrDependencyFailure)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package dbrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/profile/ent"
+	"mandacode.com/accounts/profile/ent/userpermission"
+)
+
+type PermissionRepository struct {
+	client *ent.Client
+}
+
+func NewPermissionRepository(client *ent.Client) *PermissionRepository {
+	return &PermissionRepository{
+		client: client,
+	}
+}
+
+// GetPermissions returns the permissions of the user, which are empty if none were published.
+func (r *PermissionRepository) GetPermissions(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	perm, err := r.client.UserPermission.Query().Where(userpermission.UserID(userID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return []string{}, nil
+		}
+		return nil, errors.Upgrade(err, "Failed to get permissions", errcode.ErrInternalFailure)
+	}
+	return perm.Permissions, nil
+}
+
+// SetPermissions replaces the permissions of the user.
+func (r *PermissionRepository) SetPermissions(ctx context.Context, userID uuid.UUID, permissions []string) error {
+	updated, err := r.client.UserPermission.Update().
+		Where(userpermission.UserID(userID)).
+		SetPermissions(permissions).
+		Save(ctx)
+	if err != nil {
+		return errors.Upgrade(err, "Failed to set permissions", errcode.ErrInternalFailure)
+	}
+	if updated > 0 {
+		return nil
+	}
+
+	_, err = r.client.UserPermission.Create().
+		SetUserID(userID).
+		SetPermissions(permissions).
+		Save(ctx)
+	if err != nil {
+		return errors.Upgrade(err, "Failed to set permissions", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// DeletePermissions deletes the permissions of the user.
+func (r *PermissionRepository) DeletePermissions(ctx context.Context, userID uuid.UUID) error {
+	_, err := r.client.UserPermission.Delete().Where(userpermission.UserID(userID)).Exec(ctx)
+	if err != nil {
+		return errors.Upgrade(err, "Failed to delete permissions", errcode.ErrInternalFailure)
+	}
+	return nil
+}
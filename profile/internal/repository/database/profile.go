@@ -31,6 +31,10 @@ func (r *ProfileRepository) GetProfile(ctx context.Context, id uuid.UUID) (*ent.
 	return prof, nil
 }
 
+// CreateProfile creates the profile of a user.
+//
+// The user ID is the idempotency key of the creation: creating the profile of a user who already has one, as
+// the user service does when retrying a signup, returns the existing profile.
 func (r *ProfileRepository) CreateProfile(ctx context.Context, data *CreateProfileModel) (*ent.Profile, error) {
 	prof, err := r.client.Profile.Create().
 		SetUserID(data.UserID).
@@ -38,6 +42,12 @@ func (r *ProfileRepository) CreateProfile(ctx context.Context, data *CreateProfi
 		SetNickname(data.Nickname).
 		Save(ctx)
 	if err != nil {
+		if ent.IsConstraintError(err) {
+			existing, getErr := r.client.Profile.Query().Where(profile.UserID(data.UserID)).Only(ctx)
+			if getErr == nil {
+				return existing, nil
+			}
+		}
 		return nil, errors.Upgrade(err, "Failed to create profile", errcode.ErrInternalFailure)
 	}
 
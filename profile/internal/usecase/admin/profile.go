@@ -4,18 +4,23 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	auditmodels "mandacode.com/accounts/profile/internal/models/audit"
 	dbmodels "mandacode.com/accounts/profile/internal/models/database"
+	auditeventrepo "mandacode.com/accounts/profile/internal/repository/auditevent"
 	dbrepo "mandacode.com/accounts/profile/internal/repository/database"
 	"mandacode.com/accounts/profile/internal/usecase/dto"
 )
 
 type ProfileUsecase struct {
-	repo *dbrepo.ProfileRepository
+	repo         *dbrepo.ProfileRepository
+	auditEmitter *auditeventrepo.AuditEventEmitter
 }
 
-func NewProfileUsecase(repo *dbrepo.ProfileRepository) *ProfileUsecase {
+func NewProfileUsecase(repo *dbrepo.ProfileRepository, auditEmitter *auditeventrepo.AuditEventEmitter) *ProfileUsecase {
 	return &ProfileUsecase{
-		repo: repo,
+		repo:         repo,
+		auditEmitter: auditEmitter,
 	}
 }
 
@@ -28,11 +33,23 @@ func (u *ProfileUsecase) GetProfile(ctx context.Context, userID uuid.UUID) (*dbm
 	return dbmodels.NewSecureProfile(prof), nil
 }
 
+// UpdateProfile updates the profile of a user and publishes the audit event of the update.
+//
+// The update is kept if the audit event cannot be published, but the failure is returned so that the admin
+// retries, which audits the update again.
 func (u *ProfileUsecase) UpdateProfile(ctx context.Context, data *dto.UpdateProfileData) (*dbmodels.SecureProfile, error) {
+	before, err := u.repo.GetProfile(ctx, data.UserID)
+	if err != nil {
+		return nil, err
+	}
 	prof, err := u.repo.UpdateProfile(ctx, data.ToRepoModel())
 	if err != nil {
 		return nil, err
 	}
 
-	return dbmodels.NewSecureProfile(prof), nil
+	after := dbmodels.NewSecureProfile(prof)
+	if err := u.auditEmitter.Emit(ctx, auditmodels.ActionProfileUpdate, auditmodels.TargetTypeProfile, data.UserID.String(), dbmodels.NewSecureProfile(before), after); err != nil {
+		return nil, errors.Join(err, "Failed to audit profile update")
+	}
+	return after, nil
 }
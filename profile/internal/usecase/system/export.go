@@ -0,0 +1,76 @@
+package system
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	dbrepo "mandacode.com/accounts/profile/internal/repository/database"
+)
+
+// ExportedProfile is the profile of a user as written to their data export. Unlike SecureProfile, it holds
+// every field of the profile.
+type ExportedProfile struct {
+	Email      string     `json:"email"`
+	Nickname   string     `json:"nickname"`
+	Avatar     string     `json:"avatar"`
+	Bio        string     `json:"bio"`
+	Location   string     `json:"location"`
+	IsArchived bool       `json:"is_archived"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// UserData is everything the profile service holds about a user.
+type UserData struct {
+	UserID      uuid.UUID        `json:"user_id"`
+	Profile     *ExportedProfile `json:"profile"`
+	Permissions []string         `json:"permissions"`
+	ExportedAt  time.Time        `json:"exported_at"`
+}
+
+type ExportUsecase struct {
+	profileRepo    *dbrepo.ProfileRepository
+	permissionRepo *dbrepo.PermissionRepository
+}
+
+func NewExportUsecase(profileRepo *dbrepo.ProfileRepository, permissionRepo *dbrepo.PermissionRepository) *ExportUsecase {
+	return &ExportUsecase{
+		profileRepo:    profileRepo,
+		permissionRepo: permissionRepo,
+	}
+}
+
+// ExportUserData collects the data of a user for their data export. A user without a profile has a nil one.
+func (u *ExportUsecase) ExportUserData(ctx context.Context, userID uuid.UUID) (*UserData, error) {
+	data := &UserData{
+		UserID:     userID,
+		ExportedAt: time.Now(),
+	}
+
+	prof, err := u.profileRepo.GetProfile(ctx, userID)
+	if err != nil && !errors.Is(err, errcode.ErrNotFound) {
+		return nil, err
+	}
+	if prof != nil {
+		data.Profile = &ExportedProfile{
+			Email:      prof.Email,
+			Nickname:   prof.Nickname,
+			Avatar:     prof.Avatar,
+			Bio:        prof.Bio,
+			Location:   prof.Location,
+			IsArchived: prof.IsArchived,
+			ArchivedAt: prof.ArchivedAt,
+			CreatedAt:  prof.CreatedAt,
+			UpdatedAt:  prof.UpdatedAt,
+		}
+	}
+
+	if data.Permissions, err = u.permissionRepo.GetPermissions(ctx, userID); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
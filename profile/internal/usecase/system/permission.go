@@ -0,0 +1,44 @@
+package system
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	dbrepo "mandacode.com/accounts/profile/internal/repository/database"
+)
+
+// Permissions granted by the roles of the user service which the profile service checks.
+const (
+	PermissionAll            = "*"
+	PermissionProfilesRead   = "profiles.read"
+	PermissionProfilesManage = "profiles.manage"
+)
+
+// PermissionUsecase keeps the permissions of users in sync with their roles in the user service.
+type PermissionUsecase struct {
+	repo *dbrepo.PermissionRepository
+}
+
+func NewPermissionUsecase(repo *dbrepo.PermissionRepository) *PermissionUsecase {
+	return &PermissionUsecase{
+		repo: repo,
+	}
+}
+
+func (u *PermissionUsecase) SetPermissions(ctx context.Context, userID uuid.UUID, permissions []string) error {
+	return u.repo.SetPermissions(ctx, userID, permissions)
+}
+
+func (u *PermissionUsecase) DeletePermissions(ctx context.Context, userID uuid.UUID) error {
+	return u.repo.DeletePermissions(ctx, userID)
+}
+
+// HasPermission reports whether the roles of the user grant the permission.
+func (u *PermissionUsecase) HasPermission(ctx context.Context, userID uuid.UUID, permission string) (bool, error) {
+	permissions, err := u.repo.GetPermissions(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(permissions, PermissionAll) || slices.Contains(permissions, permission), nil
+}
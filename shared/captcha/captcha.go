@@ -0,0 +1,162 @@
+// Package captcha requires CAPTCHAs from the clients of HTTP routes, once their requests keep failing.
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"github.com/redis/go-redis/v9"
+)
+
+// Supported CAPTCHA providers.
+const (
+	ProviderHCaptcha  = "hcaptcha"
+	ProviderTurnstile = "turnstile"
+	ProviderReCaptcha = "recaptcha"
+	ProviderFake      = "fake" // Accepts every token, for tests and local development
+)
+
+// siteVerifyURLs are the token verification endpoints of the providers, which share the same protocol.
+var siteVerifyURLs = map[string]string{
+	ProviderHCaptcha:  "https://api.hcaptcha.com/siteverify",
+	ProviderTurnstile: "https://challenges.cloudflare.com/turnstile/v0/siteverify",
+	ProviderReCaptcha: "https://www.google.com/recaptcha/api/siteverify",
+}
+
+// Verifier checks CAPTCHA tokens solved by clients.
+type Verifier interface {
+	// Verify reports whether the token was solved for this site, optionally by the client at remoteIP.
+	Verify(ctx context.Context, token string, remoteIP string) (bool, error)
+}
+
+// SiteVerifier verifies tokens against a provider's siteverify endpoint.
+type SiteVerifier struct {
+	endpoint string
+	secret   string
+	client   *http.Client
+}
+
+type siteVerifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+// Verify implements Verifier.
+func (v *SiteVerifier) Verify(ctx context.Context, token string, remoteIP string) (bool, error) {
+	form := url.Values{}
+	form.Set("secret", v.secret)
+	form.Set("response", token)
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, errors.New(err.Error(), "Failed to create CAPTCHA request", errcode.ErrInternalFailure)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return false, errors.New(err.Error(), "Failed to verify CAPTCHA", errcode.ErrInternalFailure)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, errors.New("unexpected CAPTCHA verification status: "+resp.Status, "Failed to verify CAPTCHA", errcode.ErrInternalFailure)
+	}
+
+	var result siteVerifyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return false, errors.New(err.Error(), "Failed to decode CAPTCHA response", errcode.ErrInternalFailure)
+	}
+	return result.Success, nil
+}
+
+// FakeVerifier accepts every non-empty token.
+type FakeVerifier struct{}
+
+// Verify implements Verifier.
+func (FakeVerifier) Verify(ctx context.Context, token string, remoteIP string) (bool, error) {
+	return token != "", nil
+}
+
+// NewVerifier creates the Verifier of the provider. An empty provider disables CAPTCHA verification
+// and yields a nil Verifier.
+func NewVerifier(provider string, secret string, client *http.Client) (Verifier, error) {
+	switch provider {
+	case "":
+		return nil, nil
+	case ProviderFake:
+		return FakeVerifier{}, nil
+	}
+
+	endpoint, ok := siteVerifyURLs[provider]
+	if !ok {
+		return nil, errors.New("unsupported CAPTCHA provider: "+provider, "Unsupported CAPTCHA Provider", errcode.ErrInvalidInput)
+	}
+	if secret == "" {
+		return nil, errors.New("CAPTCHA secret cannot be empty", "Invalid CAPTCHA Secret", errcode.ErrInvalidInput)
+	}
+	if client == nil {
+		return nil, errors.New("HTTP client cannot be nil", "InvalidClient", errcode.ErrInvalidInput)
+	}
+	return &SiteVerifier{
+		endpoint: endpoint,
+		secret:   secret,
+		client:   client,
+	}, nil
+}
+
+// FailureTracker counts the failed requests of client IPs in a fixed window, the signal which makes
+// CAPTCHAs mandatory.
+type FailureTracker struct {
+	store  *redis.Client
+	prefix string
+	window time.Duration
+}
+
+func (t *FailureTracker) key(ip string) string {
+	return t.prefix + ip
+}
+
+// Count returns the failures of the IP in the current window.
+func (t *FailureTracker) Count(ctx context.Context, ip string) (int64, error) {
+	count, err := t.store.Get(ctx, t.key(ip)).Int64()
+	if err != nil {
+		if err == redis.Nil {
+			return 0, nil
+		}
+		return 0, errors.New(err.Error(), "Failed to get CAPTCHA failure count", errcode.ErrInternalFailure)
+	}
+	return count, nil
+}
+
+// Record counts a failure of the IP. The window starts at the first failure.
+func (t *FailureTracker) Record(ctx context.Context, ip string) error {
+	key := t.key(ip)
+	count, err := t.store.Incr(ctx, key).Result()
+	if err != nil {
+		return errors.New(err.Error(), "Failed to record CAPTCHA failure", errcode.ErrInternalFailure)
+	}
+	if count == 1 {
+		if err := t.store.Expire(ctx, key, t.window).Err(); err != nil {
+			return errors.New(err.Error(), "Failed to record CAPTCHA failure", errcode.ErrInternalFailure)
+		}
+	}
+	return nil
+}
+
+// NewFailureTracker creates a new FailureTracker instance.
+func NewFailureTracker(store *redis.Client, prefix string, window time.Duration) *FailureTracker {
+	return &FailureTracker{
+		store:  store,
+		prefix: prefix,
+		window: window,
+	}
+}
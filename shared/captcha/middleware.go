@@ -0,0 +1,80 @@
+package captcha
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+)
+
+// TokenHeader is the header carrying the CAPTCHA token solved by the client.
+const TokenHeader = "X-Captcha-Token"
+
+// Middleware requires a solved CAPTCHA from clients whose requests to the guarded routes failed at least
+// threshold times recently; a threshold of 0 requires it from every client. Failed requests, answered
+// with a 4xx status, are counted per client IP.
+//
+// A nil verifier disables the middleware. The middleware fails open if the failure count is unavailable.
+func Middleware(verifier Verifier, failures *FailureTracker, threshold int64, logger *zap.Logger) gin.HandlerFunc {
+	if verifier == nil {
+		return func(ctx *gin.Context) {
+			ctx.Next()
+		}
+	}
+
+	return func(ctx *gin.Context) {
+		ip := ctx.ClientIP()
+
+		required := threshold == 0
+		if !required {
+			count, err := failures.Count(ctx.Request.Context(), ip)
+			if err != nil {
+				logger.Error("failed to get CAPTCHA failure count", zap.Error(err), zap.String("ip", ip))
+			}
+			required = count >= threshold
+		}
+
+		if required {
+			token := ctx.GetHeader(TokenHeader)
+			if token == "" {
+				ctx.Error(errors.New("CAPTCHA token is required", "Captcha Required", errcode.ErrForbidden))
+				ctx.Abort()
+				return
+			}
+			valid, err := verifier.Verify(ctx.Request.Context(), token, ip)
+			if err != nil {
+				ctx.Error(err)
+				ctx.Abort()
+				return
+			}
+			if !valid {
+				ctx.Error(errors.New("invalid CAPTCHA token", "Captcha Invalid", errcode.ErrForbidden))
+				ctx.Abort()
+				return
+			}
+		}
+
+		ctx.Next()
+
+		if clientFailed(ctx) {
+			if err := failures.Record(ctx.Request.Context(), ip); err != nil {
+				logger.Error("failed to record CAPTCHA failure", zap.Error(err), zap.String("ip", ip))
+			}
+		}
+	}
+}
+
+// clientFailed reports whether the request failed with a client error, including errors which are
+// still to be rendered by the error handler of the service.
+func clientFailed(ctx *gin.Context) bool {
+	status := ctx.Writer.Status()
+	if !ctx.Writer.Written() && len(ctx.Errors) > 0 {
+		status = http.StatusInternalServerError
+		if appErr, ok := ctx.Errors.Last().Err.(*errors.AppError); ok {
+			status = errcode.MapCodeToHTTP(appErr.Code())
+		}
+	}
+	return status >= http.StatusBadRequest && status < http.StatusInternalServerError
+}
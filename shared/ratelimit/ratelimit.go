@@ -0,0 +1,150 @@
+// Package ratelimit limits the requests of HTTP routes, sharing the counters of every instance in Redis.
+package ratelimit
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// Key selects what the requests of a rate limit are counted by.
+type Key string
+
+const (
+	ByIP    Key = "ip"    // Each client IP has its own limit
+	ByUser  Key = "user"  // Each user has its own limit, falling back to the IP for anonymous requests
+	ByRoute Key = "route" // All clients share the limit of each route
+
+	// Each user named by the user_id path parameter has their own limit, whoever sends the requests, falling
+	// back to the IP for routes without it
+	ByUserParam Key = "user_param"
+)
+
+// Rule limits the requests of a route group to Limit per sliding Window.
+type Rule struct {
+	Name   string // Separates the counters of rules sharing a store
+	Limit  int    // Non-positive values disable the rule
+	Window time.Duration
+	Key    Key
+}
+
+// slidingWindowScript atomically drops the requests which left the window, and records the request
+// if the limit allows it. It returns whether the request is allowed, the requests in the window and
+// the time in milliseconds of the oldest request in the window.
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+local member = ARGV[4]
+
+redis.call("ZREMRANGEBYSCORE", key, "-inf", now - window)
+local count = redis.call("ZCARD", key)
+local allowed = 0
+if count < limit then
+	redis.call("ZADD", key, now, member)
+	count = count + 1
+	allowed = 1
+end
+redis.call("PEXPIRE", key, window)
+
+local oldest = redis.call("ZRANGE", key, 0, 0, "WITHSCORES")
+local oldestAt = now
+if oldest[2] then
+	oldestAt = tonumber(oldest[2])
+end
+return {allowed, count, oldestAt}
+`)
+
+// Limiter limits requests with sliding windows stored in Redis, so that limits hold across instances.
+type Limiter struct {
+	store        *redis.Client
+	prefix       string
+	identifyUser func(ctx *gin.Context) string
+	logger       *zap.Logger
+}
+
+// Limit returns a middleware enforcing the rule. Limited requests are answered with ErrTooManyRequests.
+//
+// Every response carries the RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers; limited
+// responses also carry Retry-After. The middleware fails open if the store is unavailable.
+func (l *Limiter) Limit(rule Rule) gin.HandlerFunc {
+	if l == nil || rule.Limit <= 0 {
+		return func(ctx *gin.Context) {
+			ctx.Next()
+		}
+	}
+
+	return func(ctx *gin.Context) {
+		key := l.prefix + rule.Name + ":" + l.subject(ctx, rule.Key)
+		now := time.Now().UnixMilli()
+		window := rule.Window.Milliseconds()
+
+		result, err := slidingWindowScript.Run(ctx.Request.Context(), l.store, []string{key},
+			now, window, rule.Limit, requestMember(now),
+		).Int64Slice()
+		if err != nil || len(result) != 3 {
+			l.logger.Error("failed to apply rate limit", zap.Error(err), zap.String("rule", rule.Name))
+			ctx.Next()
+			return
+		}
+		allowed, count, oldestAt := result[0] == 1, result[1], result[2]
+
+		resetSeconds := int64(math.Ceil(float64(oldestAt+window-now) / 1000))
+		ctx.Header("RateLimit-Limit", strconv.Itoa(rule.Limit))
+		ctx.Header("RateLimit-Remaining", strconv.FormatInt(max(int64(rule.Limit)-count, 0), 10))
+		ctx.Header("RateLimit-Reset", strconv.FormatInt(max(resetSeconds, 0), 10))
+
+		if !allowed {
+			ctx.Header("Retry-After", strconv.FormatInt(max(resetSeconds, 1), 10))
+			ctx.Error(errors.New("rate limit exceeded: "+rule.Name, "Too Many Requests", errcode.ErrTooManyRequests))
+			ctx.Abort()
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// subject returns the identifier of the requests counted together.
+func (l *Limiter) subject(ctx *gin.Context, key Key) string {
+	switch key {
+	case ByRoute:
+		return "route:" + ctx.Request.Method + ":" + ctx.FullPath()
+	case ByUser:
+		if userID := l.identifyUser(ctx); userID != "" {
+			return "user:" + userID
+		}
+	case ByUserParam:
+		if userID := ctx.Param("user_id"); userID != "" {
+			return "user_param:" + userID
+		}
+	}
+	return "ip:" + ctx.ClientIP()
+}
+
+// requestMember returns a unique sorted set member for a request, as concurrent requests share timestamps.
+func requestMember(now int64) string {
+	suffix := make([]byte, 8)
+	_, _ = rand.Read(suffix)
+	return strconv.FormatInt(now, 10) + "-" + hex.EncodeToString(suffix)
+}
+
+// NewLimiter creates a new Limiter instance.
+//
+// identifyUser returns the user ID of a request, or an empty string for anonymous requests.
+func NewLimiter(store *redis.Client, prefix string, identifyUser func(ctx *gin.Context) string, logger *zap.Logger) *Limiter {
+	return &Limiter{
+		store:        store,
+		prefix:       prefix,
+		identifyUser: identifyUser,
+		logger:       logger,
+	}
+}
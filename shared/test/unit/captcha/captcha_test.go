@@ -0,0 +1,156 @@
+package captcha_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"mandacode.com/accounts/shared/captcha"
+)
+
+// stubVerifier accepts a single token.
+type stubVerifier struct {
+	token string
+}
+
+func (v stubVerifier) Verify(ctx context.Context, token string, remoteIP string) (bool, error) {
+	return token == v.token, nil
+}
+
+type MockCaptcha struct {
+	store    *miniredis.Miniredis
+	failures *captcha.FailureTracker
+}
+
+func (m *MockCaptcha) Setup(t *testing.T) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	m.store = miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: m.store.Addr()})
+	t.Cleanup(func() { client.Close() })
+	m.failures = captcha.NewFailureTracker(client, "captcha:", time.Minute)
+}
+
+// engine returns a router guarded by the middleware, whose route fails with the status of the fail query.
+// Errors are answered with their status, as the error handlers of the services would.
+func (m *MockCaptcha) engine(verifier captcha.Verifier, threshold int64) *gin.Engine {
+	engine := gin.New()
+	engine.Use(func(ctx *gin.Context) {
+		ctx.Next()
+		if len(ctx.Errors) == 0 {
+			return
+		}
+		if appErr, ok := ctx.Errors.Last().Err.(*errors.AppError); ok {
+			ctx.Status(errcode.MapCodeToHTTP(appErr.Code()))
+		}
+	})
+	engine.POST("/guarded", captcha.Middleware(verifier, m.failures, threshold, zap.NewNop()), func(ctx *gin.Context) {
+		if ctx.Query("fail") != "" {
+			ctx.Error(errors.New("invalid credentials", "Unauthorized", errcode.ErrUnauthorized))
+			return
+		}
+		ctx.Status(http.StatusNoContent)
+	})
+	return engine
+}
+
+func request(engine *gin.Engine, path string, remoteAddr string, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, nil)
+	req.RemoteAddr = remoteAddr
+	if token != "" {
+		req.Header.Set(captcha.TokenHeader, token)
+	}
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestCaptcha_Middleware(t *testing.T) {
+	t.Run("Middleware_RequiredFromEveryClient", func(t *testing.T) {
+		mock := &MockCaptcha{}
+		mock.Setup(t)
+		engine := mock.engine(stubVerifier{token: "solved"}, 0)
+
+		if rec := request(engine, "/guarded", "198.51.100.1:1234", ""); rec.Code != http.StatusForbidden {
+			t.Errorf("expected a request without a token to be rejected, got %d", rec.Code)
+		}
+		if rec := request(engine, "/guarded", "198.51.100.1:1234", "forged"); rec.Code != http.StatusForbidden {
+			t.Errorf("expected a request with an invalid token to be rejected, got %d", rec.Code)
+		}
+		if rec := request(engine, "/guarded", "198.51.100.1:1234", "solved"); rec.Code != http.StatusNoContent {
+			t.Errorf("expected a request with a solved token to be allowed, got %d", rec.Code)
+		}
+	})
+
+	t.Run("Middleware_RequiredAfterFailures", func(t *testing.T) {
+		mock := &MockCaptcha{}
+		mock.Setup(t)
+		engine := mock.engine(stubVerifier{token: "solved"}, 2)
+
+		for i := range 2 {
+			if rec := request(engine, "/guarded?fail=1", "198.51.100.1:1234", ""); rec.Code != http.StatusUnauthorized {
+				t.Fatalf("expected failure %d to reach the route, got %d", i, rec.Code)
+			}
+		}
+		if rec := request(engine, "/guarded", "198.51.100.1:1234", ""); rec.Code != http.StatusForbidden {
+			t.Errorf("expected the failing client to need a CAPTCHA, got %d", rec.Code)
+		}
+		if rec := request(engine, "/guarded", "198.51.100.1:1234", "solved"); rec.Code != http.StatusNoContent {
+			t.Errorf("expected the failing client to pass with a solved token, got %d", rec.Code)
+		}
+		if rec := request(engine, "/guarded", "198.51.100.2:1234", ""); rec.Code != http.StatusNoContent {
+			t.Errorf("expected another client not to need a CAPTCHA, got %d", rec.Code)
+		}
+
+		// The failures are forgotten once the window passed
+		mock.store.FastForward(time.Minute)
+		if rec := request(engine, "/guarded", "198.51.100.1:1234", ""); rec.Code != http.StatusNoContent {
+			t.Errorf("expected the client not to need a CAPTCHA after the window, got %d", rec.Code)
+		}
+	})
+
+	t.Run("Middleware_StoreUnavailable", func(t *testing.T) {
+		mock := &MockCaptcha{}
+		mock.Setup(t)
+		engine := mock.engine(stubVerifier{token: "solved"}, 1)
+		mock.store.Close()
+
+		if rec := request(engine, "/guarded", "198.51.100.1:1234", ""); rec.Code != http.StatusNoContent {
+			t.Errorf("expected the request to be allowed while the store is down, got %d", rec.Code)
+		}
+	})
+
+	t.Run("Middleware_Disabled", func(t *testing.T) {
+		mock := &MockCaptcha{}
+		mock.Setup(t)
+		verifier, err := captcha.NewVerifier("", "", nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		engine := mock.engine(verifier, 0)
+
+		if rec := request(engine, "/guarded", "198.51.100.1:1234", ""); rec.Code != http.StatusNoContent {
+			t.Errorf("expected the request to be allowed without a provider, got %d", rec.Code)
+		}
+	})
+}
+
+func TestCaptcha_NewVerifier(t *testing.T) {
+	if _, err := captcha.NewVerifier("unknown", "secret", http.DefaultClient); !errors.Is(err, errcode.ErrInvalidInput) {
+		t.Errorf("expected an invalid input error for an unknown provider, got %v", err)
+	}
+	if _, err := captcha.NewVerifier(captcha.ProviderTurnstile, "", http.DefaultClient); !errors.Is(err, errcode.ErrInvalidInput) {
+		t.Errorf("expected an invalid input error without a secret, got %v", err)
+	}
+	if _, err := captcha.NewVerifier(captcha.ProviderTurnstile, "secret", http.DefaultClient); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
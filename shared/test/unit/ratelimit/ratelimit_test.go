@@ -0,0 +1,173 @@
+package ratelimit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"mandacode.com/accounts/shared/ratelimit"
+)
+
+type MockRateLimiter struct {
+	store   *miniredis.Miniredis
+	limiter *ratelimit.Limiter
+}
+
+func (m *MockRateLimiter) Setup(t *testing.T) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	m.store = miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: m.store.Addr()})
+	t.Cleanup(func() { client.Close() })
+	m.limiter = ratelimit.NewLimiter(client, "rate_limit:", func(ctx *gin.Context) string {
+		return ctx.GetHeader("X-Test-User")
+	}, zap.NewNop())
+}
+
+// engine returns a router limited by the rule, trusting the X-Forwarded-For header of the trusted proxies. Errors
+// are answered with their status, as the error handlers of the services would.
+func (m *MockRateLimiter) engine(t *testing.T, rule ratelimit.Rule, trustedProxies []string) *gin.Engine {
+	t.Helper()
+	engine := gin.New()
+	if err := engine.SetTrustedProxies(trustedProxies); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	engine.Use(func(ctx *gin.Context) {
+		ctx.Next()
+		if len(ctx.Errors) == 0 {
+			return
+		}
+		if appErr, ok := ctx.Errors.Last().Err.(*errors.AppError); ok {
+			ctx.Status(errcode.MapCodeToHTTP(appErr.Code()))
+		}
+	})
+	noContent := func(ctx *gin.Context) {
+		ctx.Status(http.StatusNoContent)
+	}
+	engine.GET("/limited", m.limiter.Limit(rule), noContent)
+	engine.GET("/users/:user_id/limited", m.limiter.Limit(rule), noContent)
+	return engine
+}
+
+func request(engine *gin.Engine, path string, remoteAddr string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.RemoteAddr = remoteAddr
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRateLimiter_Limit(t *testing.T) {
+	mock := &MockRateLimiter{}
+	mock.Setup(t)
+
+	t.Run("Limit_ExceedsLimit", func(t *testing.T) {
+		rule := ratelimit.Rule{Name: "exceed", Limit: 2, Window: time.Minute, Key: ratelimit.ByIP}
+		engine := mock.engine(t, rule, nil)
+
+		for i := range 2 {
+			rec := request(engine, "/limited", "198.51.100.1:1234", nil)
+			if rec.Code != http.StatusNoContent {
+				t.Fatalf("expected request %d to be allowed, got %d", i, rec.Code)
+			}
+		}
+		if remaining := request(engine, "/limited", "198.51.100.2:1234", nil).Header().Get("RateLimit-Remaining"); remaining != "1" {
+			t.Errorf("expected another IP to have its own limit, got remaining %q", remaining)
+		}
+
+		rec := request(engine, "/limited", "198.51.100.1:1234", nil)
+		if rec.Code != http.StatusTooManyRequests {
+			t.Fatalf("expected the request to be limited, got %d", rec.Code)
+		}
+		if rec.Header().Get("Retry-After") == "" {
+			t.Errorf("expected a Retry-After header")
+		}
+	})
+
+	t.Run("Limit_SpoofedForwardedFor", func(t *testing.T) {
+		rule := ratelimit.Rule{Name: "spoofed", Limit: 1, Window: time.Minute, Key: ratelimit.ByIP}
+		engine := mock.engine(t, rule, nil)
+
+		if rec := request(engine, "/limited", "198.51.100.1:1234", map[string]string{"X-Forwarded-For": "203.0.113.1"}); rec.Code != http.StatusNoContent {
+			t.Fatalf("expected the first request to be allowed, got %d", rec.Code)
+		}
+		rec := request(engine, "/limited", "198.51.100.1:1234", map[string]string{"X-Forwarded-For": "203.0.113.2"})
+		if rec.Code != http.StatusTooManyRequests {
+			t.Errorf("expected a spoofed X-Forwarded-For not to change the limit key, got %d", rec.Code)
+		}
+		if !mock.store.Exists("rate_limit:spoofed:ip:198.51.100.1") {
+			t.Errorf("expected the limit to be counted by the peer address")
+		}
+	})
+
+	t.Run("Limit_TrustedProxy", func(t *testing.T) {
+		rule := ratelimit.Rule{Name: "proxied", Limit: 1, Window: time.Minute, Key: ratelimit.ByIP}
+		engine := mock.engine(t, rule, []string{"10.0.0.0/8"})
+
+		if rec := request(engine, "/limited", "10.0.0.1:1234", map[string]string{"X-Forwarded-For": "203.0.113.1"}); rec.Code != http.StatusNoContent {
+			t.Fatalf("expected the first client to be allowed, got %d", rec.Code)
+		}
+		if rec := request(engine, "/limited", "10.0.0.1:1234", map[string]string{"X-Forwarded-For": "203.0.113.2"}); rec.Code != http.StatusNoContent {
+			t.Errorf("expected clients behind a trusted proxy to have their own limit, got %d", rec.Code)
+		}
+	})
+
+	t.Run("Limit_ByUser", func(t *testing.T) {
+		rule := ratelimit.Rule{Name: "user", Limit: 1, Window: time.Minute, Key: ratelimit.ByUser}
+		engine := mock.engine(t, rule, nil)
+
+		if rec := request(engine, "/limited", "198.51.100.1:1234", map[string]string{"X-Test-User": "alice"}); rec.Code != http.StatusNoContent {
+			t.Fatalf("expected the first request to be allowed, got %d", rec.Code)
+		}
+		if rec := request(engine, "/limited", "198.51.100.1:1234", map[string]string{"X-Test-User": "bob"}); rec.Code != http.StatusNoContent {
+			t.Errorf("expected another user on the same IP to have their own limit, got %d", rec.Code)
+		}
+		if rec := request(engine, "/limited", "198.51.100.9:1234", map[string]string{"X-Test-User": "alice"}); rec.Code != http.StatusTooManyRequests {
+			t.Errorf("expected the user to be limited from another IP, got %d", rec.Code)
+		}
+	})
+
+	t.Run("Limit_ByUserParam", func(t *testing.T) {
+		rule := ratelimit.Rule{Name: "user_param", Limit: 1, Window: time.Minute, Key: ratelimit.ByUserParam}
+		engine := mock.engine(t, rule, nil)
+
+		if rec := request(engine, "/users/alice/limited", "198.51.100.1:1234", nil); rec.Code != http.StatusNoContent {
+			t.Fatalf("expected the first request to be allowed, got %d", rec.Code)
+		}
+		if rec := request(engine, "/users/bob/limited", "198.51.100.1:1234", nil); rec.Code != http.StatusNoContent {
+			t.Errorf("expected another user to have their own limit, got %d", rec.Code)
+		}
+		// Changing IP or signing in does not reset the limit of the user in the path
+		if rec := request(engine, "/users/alice/limited", "198.51.100.9:1234", map[string]string{"X-Test-User": "mallory"}); rec.Code != http.StatusTooManyRequests {
+			t.Errorf("expected the user to be limited from another IP, got %d", rec.Code)
+		}
+		if rec := request(engine, "/limited", "198.51.100.2:1234", nil); rec.Code != http.StatusNoContent {
+			t.Errorf("expected routes without the user to be limited by IP, got %d", rec.Code)
+		}
+		if !mock.store.Exists("rate_limit:user_param:ip:198.51.100.2") {
+			t.Errorf("expected the limit to be counted by the IP")
+		}
+	})
+
+	t.Run("Limit_StoreUnavailable", func(t *testing.T) {
+		rule := ratelimit.Rule{Name: "down", Limit: 1, Window: time.Minute, Key: ratelimit.ByIP}
+		engine := mock.engine(t, rule, nil)
+		mock.store.Close()
+
+		for i := range 2 {
+			if rec := request(engine, "/limited", "198.51.100.1:1234", nil); rec.Code != http.StatusNoContent {
+				t.Errorf("expected request %d to be allowed while the store is down, got %d", i, rec.Code)
+			}
+		}
+	})
+}
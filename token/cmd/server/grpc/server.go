@@ -5,7 +5,7 @@ import (
 	"net"
 	"strconv"
 
-	tokenv1 "github.com/mandacode-com/accounts-proto/token/v1"
+	tokenv1 "github.com/mandacode-com/accounts-proto/go/token/v1"
 	"github.com/mandacode-com/golib/server"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -15,13 +15,14 @@ import (
 )
 
 type GRPCServer struct {
-	server       *grpc.Server
-	tokenHandler tokenv1.TokenServiceServer
-	logger       *zap.Logger
-	port         int
+	server               *grpc.Server
+	tokenHandler         tokenv1.TokenServiceServer
+	impersonationHandler tokenv1.ImpersonationServiceServer
+	logger               *zap.Logger
+	port                 int
 }
 
-func NewGRPCServer(port int, logger *zap.Logger, tokenHandler tokenv1.TokenServiceServer, servingServices []string) (server.Server, error) {
+func NewGRPCServer(port int, logger *zap.Logger, tokenHandler tokenv1.TokenServiceServer, impersonationHandler tokenv1.ImpersonationServiceServer, servingServices []string) (server.Server, error) {
 	server := grpc.NewServer()
 
 	// Register health check service
@@ -36,12 +37,14 @@ func NewGRPCServer(port int, logger *zap.Logger, tokenHandler tokenv1.TokenServi
 
 	// Register the token handler
 	tokenv1.RegisterTokenServiceServer(server, tokenHandler)
+	tokenv1.RegisterImpersonationServiceServer(server, impersonationHandler)
 
 	return &GRPCServer{
-		server:       server,
-		tokenHandler: tokenHandler,
-		logger:       logger,
-		port:         port,
+		server:               server,
+		tokenHandler:         tokenHandler,
+		impersonationHandler: impersonationHandler,
+		logger:               logger,
+		port:                 port,
 	}, nil
 }
 
@@ -50,25 +50,41 @@ func main() {
 		logger.Fatal("failed to create email verification token generator", zap.Error(err))
 	}
 
+	// Impersonation tokens are access tokens with a shorter lifetime, so they are signed with the access key
+	impersonationTokenGen, err := tokengen.NewTokenGeneratorByStr(
+		cfg.AccessPrivateKey,
+		cfg.ImpersonationTokenDuration,
+	)
+	if err != nil {
+		logger.Fatal("failed to create impersonation token generator", zap.Error(err))
+	}
+
 	tokenUsecase := token.NewTokenUsecase(
 		accesTokenGen,
 		refreshTokenGen,
 		emailVerificationTokenGen,
+		impersonationTokenGen,
 	)
 
 	tokenHandler, err := handlerv1.NewTokenHandler(tokenUsecase, logger)
 	if err != nil {
 		logger.Fatal("failed to create token handler", zap.Error(err))
 	}
+	impersonationHandler, err := handlerv1.NewImpersonationHandler(tokenUsecase, logger)
+	if err != nil {
+		logger.Fatal("failed to create impersonation handler", zap.Error(err))
+	}
 
 	// Create the gRPC server
 	servingStatus := []string{
 		"token.v1.TokenService",
+		"token.v1.ImpersonationService",
 	}
 	grpcServer, err := grpcserver.NewGRPCServer(
 		cfg.Port,
 		logger,
 		tokenHandler,
+		impersonationHandler,
 		servingStatus,
 	)
 
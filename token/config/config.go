@@ -17,6 +17,7 @@ type Config struct {
 	RefreshTokenDuration           time.Duration
 	EmailVerificationPrivateKey    string
 	EmailVerificationTokenDuration time.Duration
+	ImpersonationTokenDuration     time.Duration
 }
 
 // LoadConfig loads env vars from .env (if exists) and returns structured config
@@ -38,6 +39,11 @@ func LoadConfig() (*Config, error) {
 		emailVerificationTokenDuration = 168 * time.Hour // default to 7 days
 	}
 
+	impersonationTokenDuration, err := time.ParseDuration(getEnv("IMPERSONATION_TOKEN_DURATION", "10m"))
+	if err != nil {
+		impersonationTokenDuration = 10 * time.Minute // default to 10 minutes
+	}
+
 	port, err := strconv.Atoi(getEnv("PORT", "50051"))
 
 	return &Config{
@@ -49,6 +55,7 @@ func LoadConfig() (*Config, error) {
 		RefreshTokenDuration:           refreshTokenDuration,
 		EmailVerificationPrivateKey:    getEnv("EMAIL_VERIFICATION_PRIVATE_KEY", ""),
 		EmailVerificationTokenDuration: emailVerificationTokenDuration,
+		ImpersonationTokenDuration:     impersonationTokenDuration,
 	}, nil
 }
 
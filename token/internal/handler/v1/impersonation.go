@@ -0,0 +1,83 @@
+package handlerv1
+
+import (
+	"context"
+
+	tokenv1 "github.com/mandacode-com/accounts-proto/go/token/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	"mandacode.com/accounts/token/internal/usecase/token"
+	"mandacode.com/accounts/token/internal/util"
+)
+
+type ImpersonationHandler struct {
+	tokenv1.UnimplementedImpersonationServiceServer
+	token  *token.TokenUsecase
+	logger *zap.Logger
+}
+
+func NewImpersonationHandler(
+	token *token.TokenUsecase,
+	logger *zap.Logger,
+) (tokenv1.ImpersonationServiceServer, error) {
+	if token == nil {
+		return nil, errors.New("token usecase cannot be nil", "Impersonation Handler Error", errcode.ErrDependencyFailure)
+	}
+	if logger == nil {
+		return nil, errors.New("logger cannot be nil", "Impersonation Handler Error", errcode.ErrDependencyFailure)
+	}
+	return &ImpersonationHandler{
+		token:  token,
+		logger: logger,
+	}, nil
+}
+
+func (h *ImpersonationHandler) logError(err error) {
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			h.logger.Error("application error", zap.String("message", appErr.Error()), zap.String("code", appErr.Code()), zap.String("trace", errors.Trace(err)))
+		} else {
+			h.logger.Error("unexpected error", zap.Error(err))
+		}
+	}
+}
+
+func (h *ImpersonationHandler) GenerateImpersonationToken(ctx context.Context, req *tokenv1.GenerateImpersonationTokenRequest) (*tokenv1.GenerateImpersonationTokenResponse, error) {
+	if err := req.Validate(); err != nil {
+		err = errors.Upgrade(err, "Invalid Impersonation Token Request", errcode.ErrInvalidInput)
+		h.logError(err)
+		return nil, util.NewGRPCError(err)
+	}
+
+	token, expiresAt, err := h.token.GenerateImpersonationToken(req.UserId, req.ActorId)
+	if err != nil {
+		h.logError(err)
+		return nil, util.NewGRPCError(err)
+	}
+
+	return &tokenv1.GenerateImpersonationTokenResponse{
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (h *ImpersonationHandler) VerifyAccessTokenActor(ctx context.Context, req *tokenv1.VerifyAccessTokenActorRequest) (*tokenv1.VerifyAccessTokenActorResponse, error) {
+	if err := req.Validate(); err != nil {
+		err = errors.Upgrade(err, "Invalid Input", errcode.ErrInvalidInput)
+		h.logError(err)
+		return nil, util.NewGRPCError(err)
+	}
+
+	userID, actorID, err := h.token.VerifyAccessTokenActor(req.Token)
+	if err != nil {
+		h.logError(err)
+		return nil, util.NewGRPCError(err)
+	}
+
+	return &tokenv1.VerifyAccessTokenActorResponse{
+		Valid:   true,
+		UserId:  userID,
+		ActorId: actorID,
+	}, nil
+}
@@ -3,7 +3,7 @@ package handlerv1
 import (
 	"context"
 
-	tokenv1 "github.com/mandacode-com/accounts-proto/token/v1"
+	tokenv1 "github.com/mandacode-com/accounts-proto/go/token/v1"
 	"github.com/mandacode-com/golib/errors"
 	"github.com/mandacode-com/golib/errors/errcode"
 	"go.uber.org/zap"
@@ -50,7 +50,7 @@ func (h *TokenHandler) GenerateAccessToken(ctx context.Context, req *tokenv1.Gen
 		return nil, util.NewGRPCError(err)
 	}
 
-	token, expiresAt, err := h.token.GenerateAccessToken(req.UserId)
+	token, expiresAt, err := h.token.GenerateAccessToken(req.UserId, req.Roles)
 	if err != nil {
 		h.logError(err)
 		return nil, util.NewGRPCError(err)
@@ -62,6 +62,25 @@ func (h *TokenHandler) GenerateAccessToken(ctx context.Context, req *tokenv1.Gen
 	}, nil
 }
 
+func (h *TokenHandler) GenerateOrgAccessToken(ctx context.Context, req *tokenv1.GenerateOrgAccessTokenRequest) (*tokenv1.GenerateOrgAccessTokenResponse, error) {
+	if err := req.Validate(); err != nil {
+		err = errors.Upgrade(err, "Invalid Access Token Request", errcode.ErrInvalidInput)
+		h.logError(err)
+		return nil, util.NewGRPCError(err)
+	}
+
+	token, expiresAt, err := h.token.GenerateOrgAccessToken(req.UserId, req.Roles, req.OrgId, req.OrgRole)
+	if err != nil {
+		h.logError(err)
+		return nil, util.NewGRPCError(err)
+	}
+
+	return &tokenv1.GenerateOrgAccessTokenResponse{
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
 func (h *TokenHandler) VerifyAccessToken(ctx context.Context, req *tokenv1.VerifyAccessTokenRequest) (*tokenv1.VerifyAccessTokenResponse, error) {
 	if err := req.Validate(); err != nil {
 		err = errors.Upgrade(err, "Invalid Input", errcode.ErrInvalidInput)
@@ -69,7 +88,7 @@ func (h *TokenHandler) VerifyAccessToken(ctx context.Context, req *tokenv1.Verif
 		return nil, util.NewGRPCError(err)
 	}
 
-	userId, err := h.token.VerifyAccessToken(req.Token)
+	userId, roles, err := h.token.VerifyAccessToken(req.Token)
 	if err != nil {
 		h.logError(err)
 		return nil, util.NewGRPCError(err)
@@ -78,6 +97,46 @@ func (h *TokenHandler) VerifyAccessToken(ctx context.Context, req *tokenv1.Verif
 	return &tokenv1.VerifyAccessTokenResponse{
 		Valid:  true,
 		UserId: userId,
+		Roles:  roles,
+	}, nil
+}
+
+func (h *TokenHandler) GenerateClientAccessToken(ctx context.Context, req *tokenv1.GenerateClientAccessTokenRequest) (*tokenv1.GenerateClientAccessTokenResponse, error) {
+	if err := req.Validate(); err != nil {
+		err = errors.Upgrade(err, "Invalid Client Access Token Request", errcode.ErrInvalidInput)
+		h.logError(err)
+		return nil, util.NewGRPCError(err)
+	}
+
+	token, expiresAt, err := h.token.GenerateClientAccessToken(req.ClientId, req.Scopes)
+	if err != nil {
+		h.logError(err)
+		return nil, util.NewGRPCError(err)
+	}
+
+	return &tokenv1.GenerateClientAccessTokenResponse{
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (h *TokenHandler) VerifyClientAccessToken(ctx context.Context, req *tokenv1.VerifyClientAccessTokenRequest) (*tokenv1.VerifyClientAccessTokenResponse, error) {
+	if err := req.Validate(); err != nil {
+		err = errors.Upgrade(err, "Invalid Client Access Token Request", errcode.ErrInvalidInput)
+		h.logError(err)
+		return nil, util.NewGRPCError(err)
+	}
+
+	clientID, scopes, err := h.token.VerifyClientAccessToken(req.Token, req.RequiredScopes)
+	if err != nil {
+		h.logError(err)
+		return nil, util.NewGRPCError(err)
+	}
+
+	return &tokenv1.VerifyClientAccessTokenResponse{
+		Valid:    true,
+		ClientId: &clientID,
+		Scopes:   scopes,
 	}, nil
 }
 
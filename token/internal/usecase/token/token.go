@@ -1,6 +1,10 @@
 package token
 
 import (
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
 	"github.com/mandacode-com/golib/errors"
 	"github.com/mandacode-com/golib/errors/errcode"
 	tokengen "mandacode.com/accounts/token/internal/infra/token"
@@ -10,24 +14,106 @@ type TokenUsecase struct {
 	accessTokenGenerator            *tokengen.TokenGenerator
 	refreshTokenGenerator           *tokengen.TokenGenerator
 	emailVerificationTokenGenerator *tokengen.TokenGenerator
+	impersonationTokenGenerator     *tokengen.TokenGenerator
 }
 
+// tokenUseClient is the "token_use" claim of client access tokens, which user access tokens do not carry.
+const tokenUseClient = "client"
+
+// rolesClaim is the claim carrying the roles of the user, separated by spaces like the "scope" claim.
+const rolesClaim = "roles"
+
 // GenerateAccessToken generates an access token for a user.
 //
 // Parameters:
 //   - userID: The unique identifier of the user for whom the access token is generated.
+//   - roles: The roles of the user, carried in the roles claim if any.
 //
 // Returns:
 //   - string: The generated JWT access token.
 //   - int64: The expiration time of the token in seconds since epoch.
 //   - error: An error if the token generation fails.
-func (t *TokenUsecase) GenerateAccessToken(userID string) (string, int64, error) {
+func (t *TokenUsecase) GenerateAccessToken(userID string, roles []string) (string, int64, error) {
 	claims := map[string]string{
 		"sub": userID, // Use "sub" claim for user ID
 	}
+	if len(roles) > 0 {
+		claims[rolesClaim] = strings.Join(roles, " ")
+	}
+	return t.accessTokenGenerator.GenerateToken(claims)
+}
+
+// GenerateOrgAccessToken generates an access token for a user acting within one of their organizations.
+//
+// Parameters:
+//   - userID: The unique identifier of the user for whom the access token is generated.
+//   - roles: The roles of the user, carried in the roles claim if any.
+//   - orgID: The unique identifier of the active organization of the user.
+//   - orgRole: The role of the user in the organization.
+//
+// Returns:
+//   - string: The generated JWT access token.
+//   - int64: The expiration time of the token in seconds since epoch.
+//   - error: An error if the token generation fails.
+func (t *TokenUsecase) GenerateOrgAccessToken(userID string, roles []string, orgID string, orgRole string) (string, int64, error) {
+	claims := map[string]string{
+		"sub":      userID, // Use "sub" claim for user ID
+		"org_id":   orgID,
+		"org_role": orgRole,
+	}
+	if len(roles) > 0 {
+		claims[rolesClaim] = strings.Join(roles, " ")
+	}
+	return t.accessTokenGenerator.GenerateToken(claims)
+}
+
+// GenerateClientAccessToken generates an access token for an OAuth client acting on its own behalf (client credentials grant).
+//
+// Parameters:
+//   - clientID: The unique identifier of the client, used as the subject of the token.
+//   - scopes: The scopes granted to the client.
+//
+// Returns:
+//   - string: The generated JWT access token.
+//   - int64: The expiration time of the token in seconds since epoch.
+//   - error: An error if the token generation fails.
+func (t *TokenUsecase) GenerateClientAccessToken(clientID string, scopes []string) (string, int64, error) {
+	claims := map[string]string{
+		"sub":       clientID, // The client is the subject of its own token
+		"client_id": clientID,
+		"scope":     strings.Join(scopes, " "),
+		"token_use": tokenUseClient,
+	}
 	return t.accessTokenGenerator.GenerateToken(claims)
 }
 
+// GenerateImpersonationToken generates a short-lived access token letting an admin act as a user.
+//
+// The token is signed with the access token key, so it is accepted wherever access tokens are, and carries the
+// admin in the "act" (actor) claim so that impersonated requests can be told apart.
+//
+// Parameters:
+//   - userID: The unique identifier of the impersonated user, used as the subject of the token.
+//   - actorID: The unique identifier of the admin impersonating the user.
+//
+// Returns:
+//   - string: The generated JWT access token.
+//   - int64: The expiration time of the token in seconds since epoch.
+//   - error: An error if the actor is missing or the token generation fails.
+func (t *TokenUsecase) GenerateImpersonationToken(userID string, actorID string) (string, int64, error) {
+	if actorID == "" {
+		return "", 0, errors.New("impersonation token requires an actor", "Invalid Impersonation", errcode.ErrInvalidInput)
+	}
+	if actorID == userID {
+		return "", 0, errors.New("user cannot impersonate themselves", "Invalid Impersonation", errcode.ErrInvalidInput)
+	}
+	claims := map[string]string{
+		"sub": userID, // Use "sub" claim for user ID
+		"act": actorID,
+	}
+	return t.impersonationTokenGenerator.GenerateToken(claims)
+}
+
 // GenerateEmailVerificationToken generates an email verification token for a user.
 //
 // Parameters:
@@ -48,7 +134,8 @@ func (t *TokenUsecase) GenerateEmailVerificationToken(userID string, email strin
 	return t.emailVerificationTokenGenerator.GenerateToken(claims)
 }
 
-// GenerateRefreshToken generates a refresh token for a user.
+// GenerateRefreshToken generates a refresh token for a user. Each token carries a random "jti" claim, so that
+// the tokens issued to a user within the same second differ, as sessions are looked up by their token.
 //
 // Parameters:
 //   - userID: The unique identifier of the user for whom the refresh token is generated.
@@ -60,31 +147,104 @@ func (t *TokenUsecase) GenerateEmailVerificationToken(userID string, email strin
 func (t *TokenUsecase) GenerateRefreshToken(userID string) (string, int64, error) {
 	claims := map[string]string{
 		"sub": userID, // Use "sub" claim for user ID
+		"jti": uuid.NewString(),
 	}
 	return t.refreshTokenGenerator.GenerateToken(claims)
 }
 
-// VerifyAccessToken verifies the provided access token and returns the user ID if valid.
+// VerifyAccessToken verifies the provided access token and returns the user ID and roles if valid. Client access
+// tokens are rejected, as their subject is not a user.
 //
 // Parameters:
 //   - token: The JWT access token to be verified.
 //
 // Returns:
 //   - *string: The user ID extracted from the token claims if verification is successful.
+//   - []string: The roles of the user carried by the token, if any.
 //   - error: An error if the token verification fails or if the user ID claim is missing.
-func (t *TokenUsecase) VerifyAccessToken(token string) (*string, error) {
+func (t *TokenUsecase) VerifyAccessToken(token string) (*string, []string, error) {
 	claims, err := t.accessTokenGenerator.VerifyToken(token)
 	if err != nil {
 		joinedErr := errors.Join(err, "failed to verify access token")
-		return nil, errors.Upgrade(joinedErr, errcode.ErrInvalidToken, "Token Verification Error")
+		return nil, nil, errors.Upgrade(joinedErr, errcode.ErrInvalidToken, "Token Verification Error")
 	}
 
+	if claims["token_use"] == tokenUseClient {
+		return nil, nil, errors.New("access token belongs to a client", "Token Verification Error", errcode.ErrInvalidToken)
+	}
 	userID, ok := claims["sub"]
 	if !ok {
-		return nil, errors.New("access token does not contain user ID claim", "Token Verification Error", errcode.ErrInvalidToken)
+		return nil, nil, errors.New("access token does not contain user ID claim", "Token Verification Error", errcode.ErrInvalidToken)
 	}
 
-	return &userID, nil
+	return &userID, strings.Fields(claims[rolesClaim]), nil
+}
+
+// VerifyClientAccessToken verifies the provided client access token and returns the client ID and its scopes
+// if valid.
+//
+// Parameters:
+//   - token: The JWT access token to be verified.
+//   - requiredScopes: The scopes the token must grant.
+//
+// Returns:
+//   - string: The client ID extracted from the token claims if verification is successful.
+//   - []string: The scopes granted to the client.
+//   - error: An error if the token verification fails, the token is not a client access token, or it lacks a
+//     required scope.
+func (t *TokenUsecase) VerifyClientAccessToken(token string, requiredScopes []string) (string, []string, error) {
+	claims, err := t.accessTokenGenerator.VerifyToken(token)
+	if err != nil {
+		joinedErr := errors.Join(err, "failed to verify client access token")
+		return "", nil, errors.Upgrade(joinedErr, "Token Verification Error", errcode.ErrInvalidToken)
+	}
+	if claims["token_use"] != tokenUseClient {
+		return "", nil, errors.New("access token does not belong to a client", "Token Verification Error", errcode.ErrInvalidToken)
+	}
+	clientID, ok := claims["client_id"]
+	if !ok || clientID == "" {
+		return "", nil, errors.New("client access token does not contain client ID claim", "Token Verification Error", errcode.ErrInvalidToken)
+	}
+
+	scopes := strings.Fields(claims["scope"])
+	for _, scope := range requiredScopes {
+		if !slices.Contains(scopes, scope) {
+			return "", nil, errors.New("client access token lacks scope "+scope, "Insufficient Scope", errcode.ErrForbidden)
+		}
+	}
+	return clientID, scopes, nil
+}
+
+// VerifyAccessTokenActor verifies the provided access token and returns the user ID and, for impersonation
+// tokens, the ID of the admin acting as the user.
+//
+// Parameters:
+//   - token: The JWT access token to be verified.
+//
+// Returns:
+//   - *string: The user ID extracted from the token claims if verification is successful.
+//   - *string: The actor ID extracted from the token claims, or nil if the token is not an impersonation token.
+//   - error: An error if the token verification fails or if the user ID claim is missing.
+func (t *TokenUsecase) VerifyAccessTokenActor(token string) (*string, *string, error) {
+	claims, err := t.accessTokenGenerator.VerifyToken(token)
+	if err != nil {
+		joinedErr := errors.Join(err, "failed to verify access token")
+		return nil, nil, errors.Upgrade(joinedErr, "Token Verification Error", errcode.ErrInvalidToken)
+	}
+
+	if claims["token_use"] == tokenUseClient {
+		return nil, nil, errors.New("access token belongs to a client", "Token Verification Error", errcode.ErrInvalidToken)
+	}
+	userID, ok := claims["sub"]
+	if !ok {
+		return nil, nil, errors.New("access token does not contain user ID claim", "Token Verification Error", errcode.ErrInvalidToken)
+	}
+
+	actorID, ok := claims["act"]
+	if !ok {
+		return &userID, nil, nil
+	}
+	return &userID, &actorID, nil
 }
 
 // VerifyEmailVerificationToken verifies the provided email verification token and returns the user ID, email, and code if valid.
@@ -145,14 +305,18 @@ func (t *TokenUsecase) VerifyRefreshToken(token string) (*string, error) {
 }
 
 // NewTokenUsecase creates a new instance of tokenUsecase with the provided TokenGenerators.
+//
+// The impersonation token generator must sign with the key of the access token generator.
 func NewTokenUsecase(
 	accessTokenGenerator *tokengen.TokenGenerator,
 	refreshTokenGenerator *tokengen.TokenGenerator,
 	emailVerificationTokenGenerator *tokengen.TokenGenerator,
+	impersonationTokenGenerator *tokengen.TokenGenerator,
 ) *TokenUsecase {
 	return &TokenUsecase{
 		accessTokenGenerator:            accessTokenGenerator,
 		refreshTokenGenerator:           refreshTokenGenerator,
 		emailVerificationTokenGenerator: emailVerificationTokenGenerator,
+		impersonationTokenGenerator:     impersonationTokenGenerator,
 	}
 }
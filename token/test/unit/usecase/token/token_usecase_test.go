@@ -0,0 +1,270 @@
+package usecase_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	tokengen "mandacode.com/accounts/token/internal/infra/token"
+	token "mandacode.com/accounts/token/internal/usecase/token"
+)
+
+type MockTokenUsecase struct {
+	accessGen *tokengen.TokenGenerator
+	svc       *token.TokenUsecase
+}
+
+func (m *MockTokenUsecase) Setup(t *testing.T) {
+	t.Helper()
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	m.accessGen, err = tokengen.NewTokenGenerator(priv, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to create token generator: %v", err)
+	}
+	m.svc = token.NewTokenUsecase(m.accessGen, m.accessGen, m.accessGen, m.accessGen)
+}
+
+func (m *MockTokenUsecase) Teardown() {
+	m.accessGen = nil
+	m.svc = nil
+}
+
+func TestTokenUsecase_GenerateClientAccessToken(t *testing.T) {
+	mockUsecase := &MockTokenUsecase{}
+	mockUsecase.Setup(t)
+	defer mockUsecase.Teardown()
+
+	clientID := uuid.New().String()
+
+	t.Run("GenerateClientAccessToken_Claims", func(t *testing.T) {
+		signed, _, err := mockUsecase.svc.GenerateClientAccessToken(clientID, []string{"users:read", "users:write"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		claims, err := mockUsecase.accessGen.VerifyToken(signed)
+		if err != nil {
+			t.Fatalf("expected token to verify, got %v", err)
+		}
+		if claims["sub"] != clientID {
+			t.Errorf("expected sub %q, got %q", clientID, claims["sub"])
+		}
+		if claims["scope"] != "users:read users:write" {
+			t.Errorf("expected space separated scopes, got %q", claims["scope"])
+		}
+		if claims["token_use"] != "client" {
+			t.Errorf("expected token_use client, got %q", claims["token_use"])
+		}
+	})
+}
+
+func TestTokenUsecase_VerifyClientAccessToken(t *testing.T) {
+	mockUsecase := &MockTokenUsecase{}
+	mockUsecase.Setup(t)
+	defer mockUsecase.Teardown()
+
+	clientID := uuid.New().String()
+	clientToken, _, err := mockUsecase.svc.GenerateClientAccessToken(clientID, []string{"users:read"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	t.Run("VerifyClientAccessToken_Valid", func(t *testing.T) {
+		gotClientID, scopes, err := mockUsecase.svc.VerifyClientAccessToken(clientToken, []string{"users:read"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if gotClientID != clientID {
+			t.Errorf("expected client ID %q, got %q", clientID, gotClientID)
+		}
+		if len(scopes) != 1 || scopes[0] != "users:read" {
+			t.Errorf("expected scopes [users:read], got %v", scopes)
+		}
+	})
+
+	t.Run("VerifyClientAccessToken_MissingScope", func(t *testing.T) {
+		if _, _, err := mockUsecase.svc.VerifyClientAccessToken(clientToken, []string{"users:write"}); err == nil {
+			t.Errorf("expected a token without the required scope to be rejected")
+		}
+	})
+
+	t.Run("VerifyClientAccessToken_UserToken", func(t *testing.T) {
+		userToken, _, err := mockUsecase.svc.GenerateAccessToken(uuid.New().String(), nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, _, err := mockUsecase.svc.VerifyClientAccessToken(userToken, nil); err == nil {
+			t.Errorf("expected a user token to be rejected as a client token")
+		}
+	})
+
+	t.Run("VerifyAccessToken_ClientToken", func(t *testing.T) {
+		if _, _, err := mockUsecase.svc.VerifyAccessToken(clientToken); err == nil {
+			t.Errorf("expected a client token to be rejected as a user token")
+		}
+		if _, _, err := mockUsecase.svc.VerifyAccessTokenActor(clientToken); err == nil {
+			t.Errorf("expected a client token to be rejected as a user token")
+		}
+	})
+}
+
+func TestTokenUsecase_GenerateOrgAccessToken(t *testing.T) {
+	mockUsecase := &MockTokenUsecase{}
+	mockUsecase.Setup(t)
+	defer mockUsecase.Teardown()
+
+	userID := uuid.New().String()
+	orgID := uuid.New().String()
+
+	t.Run("GenerateOrgAccessToken_Claims", func(t *testing.T) {
+		signed, _, err := mockUsecase.svc.GenerateOrgAccessToken(userID, []string{"user", "support"}, orgID, "admin")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		claims, err := mockUsecase.accessGen.VerifyToken(signed)
+		if err != nil {
+			t.Fatalf("expected token to verify, got %v", err)
+		}
+		if claims["sub"] != userID {
+			t.Errorf("expected sub %q, got %q", userID, claims["sub"])
+		}
+		if claims["org_id"] != orgID {
+			t.Errorf("expected org_id %q, got %q", orgID, claims["org_id"])
+		}
+		if claims["org_role"] != "admin" {
+			t.Errorf("expected org_role admin, got %q", claims["org_role"])
+		}
+		if claims["roles"] != "user support" {
+			t.Errorf("expected roles %q, got %q", "user support", claims["roles"])
+		}
+	})
+}
+
+func TestTokenUsecase_GenerateImpersonationToken(t *testing.T) {
+	mockUsecase := &MockTokenUsecase{}
+	mockUsecase.Setup(t)
+	defer mockUsecase.Teardown()
+
+	userID := uuid.New().String()
+	actorID := uuid.New().String()
+
+	t.Run("GenerateImpersonationToken_ActorClaim", func(t *testing.T) {
+		signed, _, err := mockUsecase.svc.GenerateImpersonationToken(userID, actorID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		gotUserID, gotActorID, err := mockUsecase.svc.VerifyAccessTokenActor(signed)
+		if err != nil {
+			t.Fatalf("expected token to verify, got %v", err)
+		}
+		if gotUserID == nil || *gotUserID != userID {
+			t.Errorf("expected user ID %q, got %v", userID, gotUserID)
+		}
+		if gotActorID == nil || *gotActorID != actorID {
+			t.Errorf("expected actor ID %q, got %v", actorID, gotActorID)
+		}
+	})
+
+	t.Run("VerifyAccessTokenActor_NotImpersonated", func(t *testing.T) {
+		signed, _, err := mockUsecase.svc.GenerateAccessToken(userID, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		_, gotActorID, err := mockUsecase.svc.VerifyAccessTokenActor(signed)
+		if err != nil {
+			t.Fatalf("expected token to verify, got %v", err)
+		}
+		if gotActorID != nil {
+			t.Errorf("expected no actor, got %q", *gotActorID)
+		}
+	})
+
+	t.Run("GenerateImpersonationToken_RequiresOtherActor", func(t *testing.T) {
+		if _, _, err := mockUsecase.svc.GenerateImpersonationToken(userID, ""); err == nil {
+			t.Error("expected an error without an actor")
+		}
+		if _, _, err := mockUsecase.svc.GenerateImpersonationToken(userID, userID); err == nil {
+			t.Error("expected an error when the actor is the user")
+		}
+	})
+}
+
+func TestTokenUsecase_AccessTokenRoles(t *testing.T) {
+	mockUsecase := &MockTokenUsecase{}
+	mockUsecase.Setup(t)
+	defer mockUsecase.Teardown()
+
+	userID := uuid.New().String()
+
+	t.Run("VerifyAccessToken_Roles", func(t *testing.T) {
+		signed, _, err := mockUsecase.svc.GenerateAccessToken(userID, []string{"user", "support"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		gotUserID, roles, err := mockUsecase.svc.VerifyAccessToken(signed)
+		if err != nil {
+			t.Fatalf("expected token to verify, got %v", err)
+		}
+		if gotUserID == nil || *gotUserID != userID {
+			t.Errorf("expected user ID %q, got %v", userID, gotUserID)
+		}
+		if len(roles) != 2 || roles[0] != "user" || roles[1] != "support" {
+			t.Errorf("expected roles [user support], got %v", roles)
+		}
+	})
+
+	t.Run("VerifyAccessToken_NoRoles", func(t *testing.T) {
+		signed, _, err := mockUsecase.svc.GenerateAccessToken(userID, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		claims, err := mockUsecase.accessGen.VerifyToken(signed)
+		if err != nil {
+			t.Fatalf("expected token to verify, got %v", err)
+		}
+		if _, ok := claims["roles"]; ok {
+			t.Errorf("expected no roles claim, got %q", claims["roles"])
+		}
+	})
+}
+
+func TestTokenUsecase_GenerateRefreshToken(t *testing.T) {
+	mockUsecase := &MockTokenUsecase{}
+	mockUsecase.Setup(t)
+	defer mockUsecase.Teardown()
+
+	userID := uuid.New().String()
+
+	t.Run("GenerateRefreshToken_Unique", func(t *testing.T) {
+		// Tokens issued within the same second must differ, as sessions are looked up by their token
+		first, _, err := mockUsecase.svc.GenerateRefreshToken(userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		second, _, err := mockUsecase.svc.GenerateRefreshToken(userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if first == second {
+			t.Error("expected distinct refresh tokens")
+		}
+
+		gotUserID, err := mockUsecase.svc.VerifyRefreshToken(second)
+		if err != nil {
+			t.Fatalf("expected token to verify, got %v", err)
+		}
+		if gotUserID == nil || *gotUserID != userID {
+			t.Errorf("expected user ID %q, got %v", userID, gotUserID)
+		}
+	})
+}
@@ -0,0 +1,124 @@
+package dataexportserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/mandacode-com/golib/server"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	lockinfra "mandacode.com/accounts/user/internal/infra/lock"
+	"mandacode.com/accounts/user/internal/usecase/dataexport"
+)
+
+var (
+	exportRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_data_export_runs_total",
+		Help: "Data export worker runs, by result (success, error or skipped when another replica holds the lock).",
+	}, []string{"result"})
+	processedExports = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_data_exports_total",
+		Help: "Data exports processed, by outcome (completed, retried, failed or expired).",
+	}, []string{"outcome"})
+)
+
+// Server periodically builds the requested data exports and deletes the expired ones.
+//
+// Every replica runs the server, but only the replica holding the lock processes exports in each run.
+type Server struct {
+	exportUsecase *dataexport.ExportUsecase
+	lock          *lockinfra.RedisLock
+	interval      time.Duration
+	runTimeout    time.Duration
+	logger        *zap.Logger
+	stop          chan struct{}
+	done          chan struct{}
+}
+
+// Start implements server.Server.
+func (s *Server) Start(ctx context.Context) error {
+	defer close(s.done)
+	s.logger.Info("starting data export worker", zap.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		s.run(ctx)
+		select {
+		case <-ticker.C:
+		case <-s.stop:
+			s.logger.Info("data export worker stopped")
+			return nil
+		case <-ctx.Done():
+			s.logger.Info("data export worker stopped")
+			return nil
+		}
+	}
+}
+
+// Stop implements server.Server.
+//
+// It waits for the current run, which is bounded by the run timeout, to finish.
+func (s *Server) Stop(ctx context.Context) error {
+	close(s.stop)
+	<-s.done
+	return nil
+}
+
+// run processes the exports if no other replica is processing them.
+func (s *Server) run(ctx context.Context) {
+	acquired, err := s.lock.TryAcquire(ctx)
+	if err != nil {
+		exportRuns.WithLabelValues("error").Inc()
+		s.logger.Error("failed to acquire data export lock", zap.Error(err))
+		return
+	}
+	if !acquired {
+		exportRuns.WithLabelValues("skipped").Inc()
+		return
+	}
+	defer func() {
+		if err := s.lock.Release(context.Background()); err != nil {
+			s.logger.Error("failed to release data export lock", zap.Error(err))
+		}
+	}()
+
+	runCtx, cancel := context.WithTimeout(ctx, s.runTimeout)
+	defer cancel()
+	result, err := s.exportUsecase.ProcessExports(runCtx)
+	processedExports.WithLabelValues("completed").Add(float64(result.Completed))
+	processedExports.WithLabelValues("retried").Add(float64(result.Retried))
+	processedExports.WithLabelValues("failed").Add(float64(result.Failed))
+	processedExports.WithLabelValues("expired").Add(float64(result.Expired))
+	if err != nil {
+		exportRuns.WithLabelValues("error").Inc()
+		s.logger.Error("failed to process data exports", zap.Error(err))
+		return
+	}
+	exportRuns.WithLabelValues("success").Inc()
+	if result.Completed > 0 || result.Retried > 0 || result.Failed > 0 || result.Expired > 0 {
+		s.logger.Info("processed data exports",
+			zap.Int("completed", result.Completed),
+			zap.Int("retried", result.Retried),
+			zap.Int("failed", result.Failed),
+			zap.Int("expired", result.Expired),
+		)
+	}
+}
+
+// NewServer creates a data export worker running every interval.
+//
+// The run timeout must be shorter than the lock TTL, so that no other replica takes over the lock while a run
+// is still building exports.
+func NewServer(exportUsecase *dataexport.ExportUsecase, lock *lockinfra.RedisLock, interval time.Duration, runTimeout time.Duration, logger *zap.Logger) server.Server {
+	return &Server{
+		exportUsecase: exportUsecase,
+		lock:          lock,
+		interval:      interval,
+		runTimeout:    runTimeout,
+		logger:        logger,
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+}
@@ -7,34 +7,73 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/mandacode-com/golib/server"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 	httphandlerv1 "mandacode.com/accounts/user/internal/handler/v1/http"
 	httpmiddleware "mandacode.com/accounts/user/internal/middleware/http"
 )
 
+// RateLimits are the rate limiting middlewares of the route groups.
+type RateLimits struct {
+	Admin  gin.HandlerFunc // Admin API, by route
+	User   gin.HandlerFunc // Routes of signed in users, by user
+	Signup gin.HandlerFunc // Signup, email verification, email change and data export links, and consent documents, by IP
+
+	GuardianConsentResend gin.HandlerFunc // Resending the consent link to the guardian of a minor, by minor
+}
+
 type Server struct {
-	http          *http.Server
-	engine        *gin.Engine
-	logger        *zap.Logger
-	adminHandler  *httphandlerv1.AdminHandler
-	userHandler   *httphandlerv1.UserHandler
-	signupHandler *httphandlerv1.SignupHandler
-	port          int
+	http           *http.Server
+	engine         *gin.Engine
+	logger         *zap.Logger
+	adminHandler   *httphandlerv1.AdminHandler
+	userHandler    *httphandlerv1.UserHandler
+	orgHandler     *httphandlerv1.OrganizationHandler
+	signupHandler  *httphandlerv1.SignupHandler
+	emailHandler   *httphandlerv1.EmailChangeHandler
+	exportHandler  *httphandlerv1.DataExportHandler
+	consentHandler *httphandlerv1.ConsentHandler
+	captcha        gin.HandlerFunc
+	requestInfo    gin.HandlerFunc
+	noImpersonate  gin.HandlerFunc
+	rateLimits     RateLimits
+	port           int
+	trustedProxies []string
 }
 
 // Start implements server.Server.
 func (s *Server) Start(ctx context.Context) error {
+	// Without trusted proxies, the client IP is the peer address and X-Forwarded-For is ignored
+	if err := s.engine.SetTrustedProxies(s.trustedProxies); err != nil {
+		return err
+	}
 	s.engine.Use(gin.Recovery())
 	s.engine.Use(httpmiddleware.ErrorHandler(s.logger))
+	s.engine.Use(s.requestInfo)
 
-	adminGroup := s.engine.Group("/v1/admin")
+	s.engine.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	adminGroup := s.engine.Group("/v1/admin", s.rateLimits.Admin)
 	s.adminHandler.RegisterRoutes(adminGroup)
 
-	userGroup := s.engine.Group("/v1/user")
-	s.userHandler.RegisterRoutes(userGroup)
+	userGroup := s.engine.Group("/v1/user", s.rateLimits.User)
+	s.userHandler.RegisterRoutes(userGroup, s.noImpersonate)
+	s.orgHandler.RegisterRoutes(userGroup)
+	s.emailHandler.RegisterRoutes(userGroup, s.noImpersonate)
+	s.exportHandler.RegisterRoutes(userGroup)
+	s.consentHandler.RegisterRoutes(userGroup, s.noImpersonate)
+
+	signupGroup := s.engine.Group("/v1/signup", s.rateLimits.Signup)
+	s.signupHandler.RegisterRoutes(signupGroup, s.captcha, s.rateLimits.GuardianConsentResend)
+
+	emailChangeGroup := s.engine.Group("/v1/email-change", s.rateLimits.Signup)
+	s.emailHandler.RegisterPublicRoutes(emailChangeGroup)
+
+	dataExportGroup := s.engine.Group("/v1/data-export", s.rateLimits.Signup)
+	s.exportHandler.RegisterPublicRoutes(dataExportGroup)
 
-	signupGroup := s.engine.Group("/v1/signup")
-	s.signupHandler.RegisterRoutes(signupGroup)
+	consentDocumentGroup := s.engine.Group("/v1/consent-documents", s.rateLimits.Signup)
+	s.consentHandler.RegisterPublicRoutes(consentDocumentGroup)
 
 	s.logger.Info("starting HTTP server", zap.Int("port", s.port))
 	if err := s.http.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -60,16 +99,34 @@ func NewServer(
 	logger *zap.Logger,
 	adminHandler *httphandlerv1.AdminHandler,
 	userHandler *httphandlerv1.UserHandler,
+	orgHandler *httphandlerv1.OrganizationHandler,
 	signupHandler *httphandlerv1.SignupHandler,
+	emailHandler *httphandlerv1.EmailChangeHandler,
+	exportHandler *httphandlerv1.DataExportHandler,
+	consentHandler *httphandlerv1.ConsentHandler,
+	captcha gin.HandlerFunc,
+	requestInfo gin.HandlerFunc,
+	noImpersonate gin.HandlerFunc,
+	rateLimits RateLimits,
+	trustedProxies []string,
 ) server.Server {
 	engine := gin.Default()
 	return &Server{
-		http:          &http.Server{Addr: ":" + strconv.Itoa(port), Handler: engine},
-		engine:        engine,
-		logger:        logger,
-		port:          port,
-		adminHandler:  adminHandler,
-		userHandler:   userHandler,
-		signupHandler: signupHandler,
+		http:           &http.Server{Addr: ":" + strconv.Itoa(port), Handler: engine},
+		engine:         engine,
+		logger:         logger,
+		port:           port,
+		adminHandler:   adminHandler,
+		userHandler:    userHandler,
+		orgHandler:     orgHandler,
+		signupHandler:  signupHandler,
+		emailHandler:   emailHandler,
+		exportHandler:  exportHandler,
+		consentHandler: consentHandler,
+		captcha:        captcha,
+		requestInfo:    requestInfo,
+		noImpersonate:  noImpersonate,
+		rateLimits:     rateLimits,
+		trustedProxies: trustedProxies,
 	}
 }
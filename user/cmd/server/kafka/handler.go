@@ -0,0 +1,18 @@
+package kafkaserver
+
+import (
+	"context"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type KafkaHandler interface {
+	// HandleMessage processes a Kafka message.
+	//
+	// Parameters:
+	//   - m: The Kafka message to process.
+	// Returns:
+	//   - error: An error if the message processing fails, nil otherwise. Messages failing with
+	//     errcode.ErrInvalidInput are skipped, and other failures are retried.
+	HandleMessage(ctx context.Context, m kafka.Message) error
+}
@@ -0,0 +1,123 @@
+package kafkaserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"github.com/mandacode-com/golib/server"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// retryDelay is the delay before a message whose handling failed is handled again.
+const retryDelay = 5 * time.Second
+
+type ReaderHandler struct {
+	Reader  *kafka.Reader
+	Handler KafkaHandler
+}
+
+type kafkaServer struct {
+	readerHandlers []*ReaderHandler
+	logger         *zap.Logger
+	wg             *sync.WaitGroup
+}
+
+// Start implements server.Server.
+func (k *kafkaServer) Start(ctx context.Context) error {
+	k.logger.Info("Starting Kafka server")
+
+	for _, readerHandler := range k.readerHandlers {
+		k.wg.Add(1)
+		go k.runReader(ctx, readerHandler)
+	}
+
+	k.wg.Wait()
+	return nil
+}
+
+// Stop implements server.Server.
+func (k *kafkaServer) Stop(ctx context.Context) error {
+	k.logger.Info("Stopping Kafka server")
+	k.wg.Wait() // Wait for all readers to finish
+	k.logger.Info("Kafka server stopped")
+	return nil
+}
+
+// runReader handles the messages of the reader, committing each message once it is handled, so that the messages
+// which were not handled are read again after a restart.
+func (k *kafkaServer) runReader(ctx context.Context, rh *ReaderHandler) {
+	topic := rh.Reader.Config().Topic
+	defer func() {
+		k.wg.Done()
+		if err := rh.Reader.Close(); err != nil {
+			k.logger.Error("Failed to close reader", zap.Error(err), zap.String("topic", topic))
+		} else {
+			k.logger.Info("Reader closed", zap.String("topic", topic))
+		}
+	}()
+
+	k.logger.Info("Reader started", zap.String("topic", topic))
+
+	for {
+		m, err := rh.Reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				k.logger.Info("Context cancelled", zap.String("topic", topic))
+				return
+			}
+			k.logger.Error("Failed to read message", zap.Error(err))
+			continue
+		}
+		if !k.handle(ctx, rh.Handler, m) {
+			k.logger.Info("Context cancelled", zap.String("topic", topic))
+			return
+		}
+		if err := rh.Reader.CommitMessages(ctx, m); err != nil && ctx.Err() == nil {
+			k.logger.Error("Failed to commit message", zap.Error(err), zap.String("topic", topic))
+		}
+	}
+}
+
+// handle handles the message until it succeeds or is invalid. Returns false if ctx is cancelled first.
+func (k *kafkaServer) handle(ctx context.Context, handler KafkaHandler, m kafka.Message) bool {
+	for {
+		err := handler.HandleMessage(ctx, m)
+		if err == nil {
+			return true
+		}
+		if errors.Is(err, errcode.ErrInvalidInput) {
+			k.logger.Error("Skipping invalid message", zap.Error(err), zap.String("topic", m.Topic), zap.Int64("offset", m.Offset))
+			return true
+		}
+		k.logger.Error("Failed to handle message, retrying", zap.Error(err), zap.String("topic", m.Topic), zap.Int64("offset", m.Offset))
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(retryDelay):
+		}
+	}
+}
+
+func NewKafkaServer(logger *zap.Logger, readerHandlers []*ReaderHandler) server.Server {
+	for _, rh := range readerHandlers {
+		reader := rh.Reader
+		dialer := reader.Config().Dialer
+
+		for _, broker := range reader.Config().Brokers {
+			if _, err := dialer.DialContext(context.Background(), "tcp", broker); err != nil {
+				logger.Fatal("Failed to connect to Kafka broker", zap.Error(err), zap.String("broker", broker))
+			}
+		}
+		logger.Info("Connected to Kafka broker", zap.Strings("brokers", reader.Config().Brokers))
+	}
+	return &kafkaServer{
+		readerHandlers: readerHandlers,
+		logger:         logger,
+		wg:             &sync.WaitGroup{},
+	}
+}
@@ -2,32 +2,60 @@ package main
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"os/signal"
 
+	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	userv1 "github.com/mandacode-com/accounts-proto/go/user/v1"
 	"github.com/mandacode-com/golib/server"
 	"github.com/redis/go-redis/v9"
 	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
+	"mandacode.com/accounts/shared/captcha"
+	"mandacode.com/accounts/shared/ratelimit"
+	dataexportserver "mandacode.com/accounts/user/cmd/server/dataexport"
+	grpcserver "mandacode.com/accounts/user/cmd/server/grpc"
 	httpserver "mandacode.com/accounts/user/cmd/server/http"
+	kafkaserver "mandacode.com/accounts/user/cmd/server/kafka"
+	outboxserver "mandacode.com/accounts/user/cmd/server/outbox"
+	purgeserver "mandacode.com/accounts/user/cmd/server/purge"
+	signupserver "mandacode.com/accounts/user/cmd/server/signup"
 	"mandacode.com/accounts/user/config"
 
+	grpchandlerv1 "mandacode.com/accounts/user/internal/handler/v1/grpc"
 	httphandlerv1 "mandacode.com/accounts/user/internal/handler/v1/http"
+	kafkahandlerv1 "mandacode.com/accounts/user/internal/handler/v1/kafka"
 	authinfra "mandacode.com/accounts/user/internal/infra/auth"
+	blobinfra "mandacode.com/accounts/user/internal/infra/blob"
 	dbinfra "mandacode.com/accounts/user/internal/infra/database"
+	lockinfra "mandacode.com/accounts/user/internal/infra/lock"
 	profileinfra "mandacode.com/accounts/user/internal/infra/profile"
 	tokeninfra "mandacode.com/accounts/user/internal/infra/token"
+	httpmiddleware "mandacode.com/accounts/user/internal/middleware/http"
+	auditeventrepo "mandacode.com/accounts/user/internal/repository/auditevent"
 	authrepo "mandacode.com/accounts/user/internal/repository/auth"
 	coderepo "mandacode.com/accounts/user/internal/repository/code"
 	dbrepo "mandacode.com/accounts/user/internal/repository/database"
 	maileventrepo "mandacode.com/accounts/user/internal/repository/mailevent"
+	outboxrepo "mandacode.com/accounts/user/internal/repository/outbox"
 	profilerepo "mandacode.com/accounts/user/internal/repository/profile"
 	tokenrepo "mandacode.com/accounts/user/internal/repository/token"
 	usereventrepo "mandacode.com/accounts/user/internal/repository/userevent"
 	"mandacode.com/accounts/user/internal/usecase/admin"
+	"mandacode.com/accounts/user/internal/usecase/audit"
+	"mandacode.com/accounts/user/internal/usecase/consent"
+	"mandacode.com/accounts/user/internal/usecase/dataexport"
+	"mandacode.com/accounts/user/internal/usecase/emailchange"
 	manage "mandacode.com/accounts/user/internal/usecase/management"
+	"mandacode.com/accounts/user/internal/usecase/organization"
+	"mandacode.com/accounts/user/internal/usecase/outbox"
+	"mandacode.com/accounts/user/internal/usecase/purge"
+	"mandacode.com/accounts/user/internal/usecase/rbac"
 	"mandacode.com/accounts/user/internal/usecase/signup"
+	"mandacode.com/accounts/user/internal/usecase/userstatus"
 	"mandacode.com/accounts/user/internal/util"
 )
 
@@ -55,6 +83,15 @@ func main() {
 		DB:       cfg.EmailCodeStore.DB,
 	})
 
+	// Initialize CAPTCHA verification
+	captchaVerifier, err := captcha.NewVerifier(cfg.Captcha.Provider, cfg.Captcha.Secret, &http.Client{
+		Timeout: cfg.Captcha.Timeout,
+	})
+	if err != nil {
+		logger.Fatal("failed to create CAPTCHA verifier", zap.Error(err))
+	}
+	captchaFailures := captcha.NewFailureTracker(emailCodeStore, cfg.EmailCodeStore.Prefix+"captcha:", cfg.Captcha.FailureWindow)
+
 	// Initialize Kafka writers
 	userEventWriter := &kafka.Writer{
 		Addr:                   kafka.TCP(cfg.UserEventWriter.Address...),
@@ -68,6 +105,19 @@ func main() {
 		Balancer:               &kafka.Hash{},
 		AllowAutoTopicCreation: true,
 	}
+	auditEventWriter := &kafka.Writer{
+		Addr:                   kafka.TCP(cfg.AuditEventWriter.Address...),
+		Topic:                  cfg.AuditEventWriter.Topic,
+		Balancer:               &kafka.Hash{},
+		AllowAutoTopicCreation: true,
+	}
+
+	// Initialize Kafka reader for the audit events of all services
+	auditEventReader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: cfg.AuditEventReader.Brokers,
+		Topic:   cfg.AuditEventReader.Topic,
+		GroupID: cfg.AuditEventReader.GroupID,
+	})
 
 	// Initialize Client
 	dbClient, err := dbinfra.NewEntClient(cfg.DatabaseURL)
@@ -82,46 +132,175 @@ func main() {
 	if err != nil {
 		logger.Fatal("failed to create OAuth user client", zap.Error(err))
 	}
+	authExportClient, _, err := authinfra.NewDataExportClient(cfg.AuthClient.Address)
+	if err != nil {
+		logger.Fatal("failed to create auth data export client", zap.Error(err))
+	}
 	profileClient, _, err := profileinfra.NewProfileClient(cfg.ProfileClient.Address)
 	if err != nil {
 		logger.Fatal("failed to create profile client", zap.Error(err))
 	}
+	profileExportClient, _, err := profileinfra.NewDataExportClient(cfg.ProfileClient.Address)
+	if err != nil {
+		logger.Fatal("failed to create profile data export client", zap.Error(err))
+	}
 	tokenClient, _, err := tokeninfra.NewTokenClient(cfg.TokenClient.Address)
 	if err != nil {
 		logger.Fatal("failed to create token client", zap.Error(err))
 	}
 
+	var exportStore blobinfra.Store
+	switch cfg.DataExport.Store {
+	case "local":
+		exportStore, err = blobinfra.NewLocalStore(cfg.DataExport.Dir)
+	}
+	if err != nil {
+		logger.Fatal("failed to create data export store", zap.Error(err))
+	}
+
 	syncCodeGenerator := util.NewRandomStringGenerator(16)
 
 	// Initialize repository
 	userRepo := dbrepo.NewUserRepository(dbClient, syncCodeGenerator)
 	sentEmailRepo := dbrepo.NewSentEmailRepository(dbClient)
-	userEventRepo := usereventrepo.NewUserEventEmitter(userEventWriter)
-	authRepo := authrepo.NewAuthRepository(localUserClient, oauthUserClient)
-	profileRepo := profilerepo.NewProfileRepository(profileClient)
+	roleRepo := dbrepo.NewRoleRepository(dbClient)
+	orgRepo := dbrepo.NewOrganizationRepository(dbClient)
+	outboxRepo := dbrepo.NewOutboxRepository(dbClient)
+	signupSagaRepo := dbrepo.NewSignupSagaRepository(dbClient)
+	emailChangeRepo := dbrepo.NewEmailChangeRepository(dbClient)
+	dataExportRepo := dbrepo.NewDataExportRepository(dbClient)
+	auditRepo := dbrepo.NewAuditRepository(dbClient)
+	consentRepo := dbrepo.NewConsentRepository(dbClient)
+	txManager := dbrepo.NewTxManager(dbClient)
+	userEventRepo := usereventrepo.NewUserEventEmitter(outboxRepo, cfg.UserEventWriter.Topic)
+	auditEventRepo := auditeventrepo.NewAuditEventEmitter(outboxRepo, cfg.AuditEventWriter.Topic)
+	authRepo := authrepo.NewAuthRepository(localUserClient, oauthUserClient, authExportClient)
+	profileRepo := profilerepo.NewProfileRepository(profileClient, profileExportClient)
 	mailTokenRepo := tokenrepo.NewTokenRepository(tokenClient)
-	mailEventRepo := maileventrepo.NewMailEventEmitter(mailEventWriter)
+	mailEventRepo := maileventrepo.NewMailEventEmitter(outboxRepo, cfg.EmailEventWriter.Topic)
 	mailCodeManager := coderepo.NewCodeManager(mailCodeGenerator, cfg.EmailCodeStore.Timeout, emailCodeStore, cfg.EmailCodeStore.Prefix)
+	emailChangeCodeManager := coderepo.NewCodeManager(mailCodeGenerator, cfg.EmailCodeStore.Timeout, emailCodeStore, cfg.EmailCodeStore.Prefix+"email_change:")
+	guardianCodeManager := coderepo.NewCodeManager(mailCodeGenerator, cfg.EmailCodeStore.Timeout, emailCodeStore, cfg.EmailCodeStore.Prefix+"guardian_consent:")
 
 	// Initialize use cases
-	adminUsecase := admin.NewAdminUsecase()
-	adminManageUsecase := manage.NewAdminManageUsecase(userRepo, userEventRepo)
-	selfManageUsecase := manage.NewSelfManageUsecase(userRepo, userEventRepo)
-	signupUsecase := signup.NewSignupUsecase(authRepo, profileRepo, userRepo, userEventRepo)
-	verifyEmailUsecase := signup.NewVerifyEmailUsecase(sentEmailRepo, authRepo, mailTokenRepo, mailEventRepo, mailCodeManager, cfg.EmailVerificationLink, cfg.MaxSentEmails, cfg.MaxSentEmailsDuration)
+	adminIDs := make([]uuid.UUID, 0, len(cfg.AdminAPI.UserIDs))
+	for _, id := range cfg.AdminAPI.UserIDs {
+		adminIDs = append(adminIDs, uuid.MustParse(id))
+	}
+	adminUsecase := admin.NewAdminUsecase(cfg.AdminAPI.APIKey, adminIDs)
+	adminManageUsecase := manage.NewAdminManageUsecase(userRepo, orgRepo, txManager, userEventRepo, auditEventRepo)
+	rbacUsecase := rbac.NewRBACUsecase(roleRepo, userRepo, txManager, userEventRepo, auditEventRepo)
+	userStatusUsecase := userstatus.NewUserStatusUsecase(userRepo, rbacUsecase)
+	selfManageUsecase := manage.NewSelfManageUsecase(userRepo, txManager, userEventRepo)
+	orgUsecase := organization.NewOrganizationUsecase(orgRepo, mailEventRepo, cfg.Organization.InvitationLink, cfg.Organization.InvitationTTL)
+	consentUsecase := consent.NewConsentUsecase(consentRepo, txManager, auditEventRepo)
+	signupUsecase := signup.NewSignupUsecase(authRepo, profileRepo, userRepo, signupSagaRepo, txManager, userEventRepo, consentUsecase, cfg.SignupRecovery.LeaseTTL, logger)
+	verifyEmailUsecase := signup.NewVerifyEmailUsecase(sentEmailRepo, authRepo, mailTokenRepo, mailEventRepo, mailCodeManager, cfg.EmailVerificationLink, emailChangeCodeManager, cfg.EmailChange.Link, guardianCodeManager, cfg.GuardianConsent.Link, cfg.MaxSentEmails, cfg.MaxSentEmailsDuration)
+	guardianConsentUsecase := signup.NewGuardianConsentUsecase(userRepo, verifyEmailUsecase, txManager, userEventRepo, auditEventRepo)
+	emailChangeUsecase := emailchange.NewEmailChangeUsecase(userRepo, emailChangeRepo, authRepo, profileRepo, verifyEmailUsecase, txManager, userEventRepo, mailEventRepo, cfg.EmailChange.UndoLink, cfg.EmailChange.UndoTTL)
+	auditUsecase := audit.NewAuditUsecase(auditRepo)
+	exportUsecase := dataexport.NewExportUsecase(dataExportRepo, userRepo, sentEmailRepo, consentRepo, authRepo, profileRepo, exportStore, txManager, mailEventRepo, cfg.DataExport.DownloadLink, cfg.DataExport.LinkTTL, cfg.DataExport.BatchSize, cfg.DataExport.MaxAttempts, logger)
 
 	// Initialize HTTP handlers
-	httpUserHandler := httphandlerv1.NewUserHandler(selfManageUsecase, cfg.UserIDHeaderKey, logger)
-	httpAdminHandler := httphandlerv1.NewAdminHandler(adminUsecase, adminManageUsecase)
-	httpSignupHandler := httphandlerv1.NewSignupHandler(signupUsecase, verifyEmailUsecase, validator, logger)
+	httpUserHandler := httphandlerv1.NewUserHandler(selfManageUsecase, rbacUsecase, cfg.UserIDHeaderKey, logger)
+	httpAdminHandler := httphandlerv1.NewAdminHandler(adminUsecase, adminManageUsecase, rbacUsecase, auditUsecase, consentUsecase, cfg.UserIDHeaderKey, cfg.ImpersonatorHeaderKey, cfg.AdminAPI.HeaderKey, logger)
+	httpOrganizationHandler := httphandlerv1.NewOrganizationHandler(orgUsecase, cfg.UserIDHeaderKey, logger)
+	httpSignupHandler := httphandlerv1.NewSignupHandler(signupUsecase, verifyEmailUsecase, guardianConsentUsecase, validator, logger)
+	httpEmailChangeHandler := httphandlerv1.NewEmailChangeHandler(emailChangeUsecase, cfg.UserIDHeaderKey, logger)
+	httpDataExportHandler := httphandlerv1.NewDataExportHandler(exportUsecase, cfg.UserIDHeaderKey, logger)
+	httpConsentHandler := httphandlerv1.NewConsentHandler(consentUsecase, cfg.UserIDHeaderKey, logger)
+
+	captchaMiddleware := captcha.Middleware(captchaVerifier, captchaFailures, cfg.Captcha.FailureThreshold, logger)
+	requestInfoMiddleware := httpmiddleware.RequestInfo(cfg.RequestIDHeaderKey)
+	var rateLimiter *ratelimit.Limiter
+	if cfg.RateLimit.Enabled {
+		rateLimiter = ratelimit.NewLimiter(emailCodeStore, cfg.EmailCodeStore.Prefix+"ratelimit:", func(c *gin.Context) string {
+			return c.GetHeader(cfg.UserIDHeaderKey)
+		}, logger)
+	}
+	rateLimits := httpserver.RateLimits{
+		Admin:  rateLimiter.Limit(rateLimitRule("admin", cfg.RateLimit.Admin, ratelimit.ByRoute)),
+		User:   rateLimiter.Limit(rateLimitRule("user", cfg.RateLimit.User, ratelimit.ByUser)),
+		Signup: rateLimiter.Limit(rateLimitRule("signup", cfg.RateLimit.Signup, ratelimit.ByIP)),
+
+		GuardianConsentResend: rateLimiter.Limit(rateLimitRule("guardian_consent_resend", cfg.RateLimit.GuardianConsentResend, ratelimit.ByUserParam)),
+	}
 
 	// Initialize HTTP server
-	httpServer := httpserver.NewServer(cfg.HTTPServer.Port, logger, httpAdminHandler, httpUserHandler, httpSignupHandler)
+	httpServer := httpserver.NewServer(cfg.HTTPServer.Port, logger, httpAdminHandler, httpUserHandler, httpOrganizationHandler, httpSignupHandler, httpEmailChangeHandler, httpDataExportHandler, httpConsentHandler, captchaMiddleware, requestInfoMiddleware, httpmiddleware.DenyImpersonation(cfg.ImpersonatorHeaderKey), rateLimits, cfg.HTTPServer.TrustedProxies)
 
-	serverManager := server.NewServerManager([]server.Server{
-		httpServer,
+	// Initialize gRPC server
+	rbacHandler := grpchandlerv1.NewRBACHandler(rbacUsecase, logger)
+	organizationHandler := grpchandlerv1.NewOrganizationHandler(orgUsecase, logger)
+	userStatusHandler := grpchandlerv1.NewUserStatusHandler(userStatusUsecase, logger)
+	userManagementHandler := grpchandlerv1.NewUserManagementHandler(adminManageUsecase, logger)
+	consentHandler := grpchandlerv1.NewConsentHandler(consentUsecase, logger)
+	grpcServer, err := grpcserver.NewGRPCServer(
+		cfg.GRPCServer.Port,
+		logger,
+		rbacHandler,
+		organizationHandler,
+		userStatusHandler,
+		userManagementHandler,
+		consentHandler,
+		[]string{
+			userv1.RBACService_ServiceDesc.ServiceName,
+			userv1.OrganizationService_ServiceDesc.ServiceName,
+			userv1.UserStatusService_ServiceDesc.ServiceName,
+			userv1.UserManagementService_ServiceDesc.ServiceName,
+			userv1.ConsentService_ServiceDesc.ServiceName,
+		},
+	)
+	if err != nil {
+		logger.Fatal("failed to create gRPC server", zap.Error(err))
+	}
+
+	// Initialize outbox relay, which every replica runs but only the lock holder publishes in each run
+	outboxPublisher := outboxrepo.NewPublisher(map[string]outboxrepo.Writer{
+		userEventWriter.Topic:  userEventWriter,
+		mailEventWriter.Topic:  mailEventWriter,
+		auditEventWriter.Topic: auditEventWriter,
+	})
+	relayUsecase := outbox.NewRelayUsecase(outboxRepo, txManager, outboxPublisher, cfg.Outbox.BatchSize, cfg.Outbox.MaxAttempts, logger)
+	outboxLock := lockinfra.NewRedisLock(emailCodeStore, cfg.EmailCodeStore.Prefix+"outbox:lock", cfg.Outbox.LockTTL)
+	outboxServer := outboxserver.NewServer(relayUsecase, outboxLock, cfg.Outbox.Interval, cfg.Outbox.LockTTL/2, logger)
+
+	// Initialize signup recovery worker, which every replica runs but only the lock holder recovers in each run
+	recoveryUsecase := signup.NewRecoveryUsecase(signupUsecase, signupSagaRepo, cfg.SignupRecovery.Retention, cfg.SignupRecovery.BatchSize, logger)
+	recoveryLock := lockinfra.NewRedisLock(emailCodeStore, cfg.EmailCodeStore.Prefix+"signup:lock", cfg.SignupRecovery.LockTTL)
+	recoveryServer := signupserver.NewServer(recoveryUsecase, recoveryLock, cfg.SignupRecovery.Interval, logger)
+
+	// Initialize data export worker, which every replica runs but only the lock holder builds exports in each run
+	exportLock := lockinfra.NewRedisLock(emailCodeStore, cfg.EmailCodeStore.Prefix+"dataexport:lock", cfg.DataExport.LockTTL)
+	exportServer := dataexportserver.NewServer(exportUsecase, exportLock, cfg.DataExport.Interval, cfg.DataExport.LockTTL/2, logger)
+
+	// Initialize audit trail consumer, recording the audit events of all services
+	auditEventHandler := kafkahandlerv1.NewAuditEventHandler(auditUsecase)
+	kafkaServer := kafkaserver.NewKafkaServer(logger, []*kafkaserver.ReaderHandler{
+		{
+			Reader:  auditEventReader,
+			Handler: auditEventHandler,
+		},
 	})
 
+	servers := []server.Server{
+		httpServer,
+		grpcServer,
+		outboxServer,
+		recoveryServer,
+		exportServer,
+		kafkaServer,
+	}
+
+	// Initialize purge worker, which every replica runs but only the lock holder purges in each run
+	if cfg.Purge.Enabled {
+		purgeUsecase := purge.NewPurgeUsecase(userRepo, orgRepo, txManager, userEventRepo, cfg.Purge.BatchSize, logger)
+		purgeLock := lockinfra.NewRedisLock(emailCodeStore, cfg.EmailCodeStore.Prefix+"purge:lock", cfg.Purge.LockTTL)
+		servers = append(servers, purgeserver.NewServer(purgeUsecase, purgeLock, cfg.Purge.Interval, cfg.Purge.LockTTL/2, logger))
+	}
+
+	serverManager := server.NewServerManager(servers)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -137,3 +316,13 @@ func main() {
 		logger.Fatal("failed to start server", zap.Error(err))
 	}
 }
+
+// rateLimitRule converts a configured rate limit into the rule of a route group
+func rateLimitRule(name string, cfg config.RateLimitRuleConfig, key ratelimit.Key) ratelimit.Rule {
+	return ratelimit.Rule{
+		Name:   name,
+		Limit:  cfg.Limit,
+		Window: cfg.Window,
+		Key:    key,
+	}
+}
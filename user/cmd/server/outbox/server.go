@@ -0,0 +1,124 @@
+package outboxserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/mandacode-com/golib/server"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	lockinfra "mandacode.com/accounts/user/internal/infra/lock"
+	"mandacode.com/accounts/user/internal/usecase/outbox"
+)
+
+var (
+	relayRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_outbox_relay_runs_total",
+		Help: "Outbox relay runs, by result (success, error or skipped when another replica holds the lock).",
+	}, []string{"result"})
+	relayPublished = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "user_outbox_published_total",
+		Help: "Outbox messages published to Kafka.",
+	})
+	relayFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "user_outbox_publish_failures_total",
+		Help: "Failed attempts to publish an outbox message, which is retried later.",
+	})
+	relayDeadLettered = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "user_outbox_dead_lettered_total",
+		Help: "Outbox messages moved to the dead letters after their last attempt failed.",
+	})
+)
+
+// Server periodically publishes the messages of the outbox to Kafka.
+//
+// Every replica runs the server, but only the replica holding the lock publishes in each run, which keeps the
+// messages of each user in order.
+type Server struct {
+	relayUsecase *outbox.RelayUsecase
+	lock         *lockinfra.RedisLock
+	interval     time.Duration
+	runTimeout   time.Duration
+	logger       *zap.Logger
+	stop         chan struct{}
+	done         chan struct{}
+}
+
+// Start implements server.Server.
+func (s *Server) Start(ctx context.Context) error {
+	defer close(s.done)
+	s.logger.Info("starting outbox relay", zap.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		s.run(ctx)
+		select {
+		case <-ticker.C:
+		case <-s.stop:
+			s.logger.Info("outbox relay stopped")
+			return nil
+		case <-ctx.Done():
+			s.logger.Info("outbox relay stopped")
+			return nil
+		}
+	}
+}
+
+// Stop implements server.Server.
+//
+// It waits for the current run, which is bounded by the run timeout, to finish.
+func (s *Server) Stop(ctx context.Context) error {
+	close(s.stop)
+	<-s.done
+	return nil
+}
+
+// run publishes the pending messages if no other replica is publishing.
+func (s *Server) run(ctx context.Context) {
+	acquired, err := s.lock.TryAcquire(ctx)
+	if err != nil {
+		relayRuns.WithLabelValues("error").Inc()
+		s.logger.Error("failed to acquire outbox lock", zap.Error(err))
+		return
+	}
+	if !acquired {
+		relayRuns.WithLabelValues("skipped").Inc()
+		return
+	}
+	defer func() {
+		if err := s.lock.Release(context.Background()); err != nil {
+			s.logger.Error("failed to release outbox lock", zap.Error(err))
+		}
+	}()
+
+	runCtx, cancel := context.WithTimeout(ctx, s.runTimeout)
+	defer cancel()
+	result, err := s.relayUsecase.RelayPending(runCtx)
+	relayPublished.Add(float64(result.Published))
+	relayFailures.Add(float64(result.Failed))
+	relayDeadLettered.Add(float64(result.DeadLettered))
+	if err != nil {
+		relayRuns.WithLabelValues("error").Inc()
+		s.logger.Error("failed to relay outbox messages", zap.Error(err))
+		return
+	}
+	relayRuns.WithLabelValues("success").Inc()
+}
+
+// NewServer creates an outbox relay running every interval.
+//
+// The run timeout must be shorter than the lock TTL, so that no other replica takes over the lock while a run
+// is still publishing.
+func NewServer(relayUsecase *outbox.RelayUsecase, lock *lockinfra.RedisLock, interval time.Duration, runTimeout time.Duration, logger *zap.Logger) server.Server {
+	return &Server{
+		relayUsecase: relayUsecase,
+		lock:         lock,
+		interval:     interval,
+		runTimeout:   runTimeout,
+		logger:       logger,
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+}
@@ -0,0 +1,138 @@
+package purgeserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/mandacode-com/golib/server"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	lockinfra "mandacode.com/accounts/user/internal/infra/lock"
+	"mandacode.com/accounts/user/internal/usecase/purge"
+)
+
+var (
+	purgeRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_purge_runs_total",
+		Help: "Purge runs, by result (success, error or skipped when another replica holds the lock).",
+	}, []string{"result"})
+	purgedUsers = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "user_purged_users_total",
+		Help: "Archived users deleted after their deletion delay.",
+	})
+	purgeFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "user_purge_failures_total",
+		Help: "Users which could not be purged.",
+	})
+	purgeDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "user_purge_duration_seconds",
+		Help:    "Duration of the purge runs which held the lock.",
+		Buckets: prometheus.DefBuckets,
+	})
+	purgeLastSuccess = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "user_purge_last_success_timestamp_seconds",
+		Help: "Time of the last successful purge run on this replica.",
+	})
+)
+
+// Server periodically purges the archived users whose deletion delay passed.
+//
+// Every replica runs the server, but only the replica holding the lock purges in each run.
+type Server struct {
+	purgeUsecase *purge.PurgeUsecase
+	lock         *lockinfra.RedisLock
+	interval     time.Duration
+	runTimeout   time.Duration
+	logger       *zap.Logger
+	stop         chan struct{}
+	done         chan struct{}
+}
+
+// Start implements server.Server.
+func (s *Server) Start(ctx context.Context) error {
+	defer close(s.done)
+	s.logger.Info("starting purge worker", zap.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		s.run(ctx)
+		select {
+		case <-ticker.C:
+		case <-s.stop:
+			s.logger.Info("purge worker stopped")
+			return nil
+		case <-ctx.Done():
+			s.logger.Info("purge worker stopped")
+			return nil
+		}
+	}
+}
+
+// Stop implements server.Server.
+//
+// It waits for the current run, which is bounded by the run timeout, to finish.
+func (s *Server) Stop(ctx context.Context) error {
+	close(s.stop)
+	<-s.done
+	return nil
+}
+
+// run purges the expired users if no other replica is purging.
+func (s *Server) run(ctx context.Context) {
+	acquired, err := s.lock.TryAcquire(ctx)
+	if err != nil {
+		purgeRuns.WithLabelValues("error").Inc()
+		s.logger.Error("failed to acquire purge lock", zap.Error(err))
+		return
+	}
+	if !acquired {
+		purgeRuns.WithLabelValues("skipped").Inc()
+		return
+	}
+	defer func() {
+		if err := s.lock.Release(context.Background()); err != nil {
+			s.logger.Error("failed to release purge lock", zap.Error(err))
+		}
+	}()
+
+	runCtx, cancel := context.WithTimeout(ctx, s.runTimeout)
+	defer cancel()
+	start := time.Now()
+	result, err := s.purgeUsecase.PurgeExpiredUsers(runCtx)
+	purgeDuration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		purgeRuns.WithLabelValues("error").Inc()
+		s.logger.Error("failed to purge expired users", zap.Error(err))
+		return
+	}
+
+	purgeRuns.WithLabelValues("success").Inc()
+	purgedUsers.Add(float64(result.Purged))
+	purgeFailures.Add(float64(result.Failed))
+	purgeLastSuccess.SetToCurrentTime()
+	if result.Purged > 0 || result.Failed > 0 {
+		s.logger.Info("purged expired users",
+			zap.Int("purged", result.Purged),
+			zap.Int("failed", result.Failed),
+		)
+	}
+}
+
+// NewServer creates a purge worker running every interval.
+//
+// The lock should expire before the next run starts, so that a replica dying in the middle of a run does not
+// stop the others for long. The run timeout must be shorter than the lock TTL, so that no other replica takes
+// over the lock while a run is still purging.
+func NewServer(purgeUsecase *purge.PurgeUsecase, lock *lockinfra.RedisLock, interval time.Duration, runTimeout time.Duration, logger *zap.Logger) server.Server {
+	return &Server{
+		purgeUsecase: purgeUsecase,
+		lock:         lock,
+		interval:     interval,
+		runTimeout:   runTimeout,
+		logger:       logger,
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+}
@@ -0,0 +1,119 @@
+package signupserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/mandacode-com/golib/server"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	lockinfra "mandacode.com/accounts/user/internal/infra/lock"
+	"mandacode.com/accounts/user/internal/usecase/signup"
+)
+
+var (
+	recoveryRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_signup_recovery_runs_total",
+		Help: "Signup recovery runs, by result (success, error or skipped when another replica holds the lock).",
+	}, []string{"result"})
+	recoveredSignups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_signup_recovered_total",
+		Help: "Signups which stopped halfway, by outcome (completed, compensated or failed).",
+	}, []string{"outcome"})
+)
+
+// Server periodically resumes or compensates the signups which stopped halfway.
+//
+// Every replica runs the server, but only the replica holding the lock recovers signups in each run.
+type Server struct {
+	recoveryUsecase *signup.RecoveryUsecase
+	lock            *lockinfra.RedisLock
+	interval        time.Duration
+	logger          *zap.Logger
+	stop            chan struct{}
+	done            chan struct{}
+}
+
+// Start implements server.Server.
+func (s *Server) Start(ctx context.Context) error {
+	defer close(s.done)
+	s.logger.Info("starting signup recovery worker", zap.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		s.run(ctx)
+		select {
+		case <-ticker.C:
+		case <-s.stop:
+			s.logger.Info("signup recovery worker stopped")
+			return nil
+		case <-ctx.Done():
+			s.logger.Info("signup recovery worker stopped")
+			return nil
+		}
+	}
+}
+
+// Stop implements server.Server.
+//
+// It waits for the current run, which is bounded by the batch size, to finish.
+func (s *Server) Stop(ctx context.Context) error {
+	close(s.stop)
+	<-s.done
+	return nil
+}
+
+// run recovers the stale signups if no other replica is recovering them.
+func (s *Server) run(ctx context.Context) {
+	acquired, err := s.lock.TryAcquire(ctx)
+	if err != nil {
+		recoveryRuns.WithLabelValues("error").Inc()
+		s.logger.Error("failed to acquire signup recovery lock", zap.Error(err))
+		return
+	}
+	if !acquired {
+		recoveryRuns.WithLabelValues("skipped").Inc()
+		return
+	}
+	defer func() {
+		if err := s.lock.Release(context.Background()); err != nil {
+			s.logger.Error("failed to release signup recovery lock", zap.Error(err))
+		}
+	}()
+
+	result, err := s.recoveryUsecase.RecoverStaleSignups(ctx)
+	if err != nil {
+		recoveryRuns.WithLabelValues("error").Inc()
+		s.logger.Error("failed to recover stale signups", zap.Error(err))
+		return
+	}
+
+	recoveryRuns.WithLabelValues("success").Inc()
+	recoveredSignups.WithLabelValues("completed").Add(float64(result.Completed))
+	recoveredSignups.WithLabelValues("compensated").Add(float64(result.Compensated))
+	recoveredSignups.WithLabelValues("failed").Add(float64(result.Failed))
+	if result.Completed > 0 || result.Compensated > 0 || result.Failed > 0 {
+		s.logger.Info("recovered stale signups",
+			zap.Int("completed", result.Completed),
+			zap.Int("compensated", result.Compensated),
+			zap.Int("failed", result.Failed),
+		)
+	}
+}
+
+// NewServer creates a signup recovery worker running every interval.
+//
+// The lock should expire well after a run completes, but before the next one starts, so that a replica
+// dying in the middle of a run does not stop the others for long.
+func NewServer(recoveryUsecase *signup.RecoveryUsecase, lock *lockinfra.RedisLock, interval time.Duration, logger *zap.Logger) server.Server {
+	return &Server{
+		recoveryUsecase: recoveryUsecase,
+		lock:            lock,
+		interval:        interval,
+		logger:          logger,
+		stop:            make(chan struct{}),
+		done:            make(chan struct{}),
+	}
+}
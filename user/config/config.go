@@ -17,7 +17,18 @@ type KafkaWriterConfig struct {
 	Topic   string   `validate:"required"`
 }
 
+type KafkaReaderConfig struct {
+	Brokers []string `validate:"required"`
+	Topic   string   `validate:"required"`
+	GroupID string   `validate:"required"`
+}
+
 type HTTPServerConfig struct {
+	Port           int      `validate:"required,min=1,max=65535"`
+	TrustedProxies []string `validate:"dive,cidr|ip"` // Proxies whose X-Forwarded-For is trusted for the client IP, none by default
+}
+
+type GRPCServerConfig struct {
 	Port int `validate:"required,min=1,max=65535"`
 }
 
@@ -34,20 +45,111 @@ type GRPCClientConfig struct {
 	Address string `validate:"required"`
 }
 
+type CaptchaConfig struct {
+	Provider         string        `validate:"omitempty,oneof=hcaptcha turnstile recaptcha fake"`
+	Secret           string        `validate:"required_if=Provider hcaptcha,required_if=Provider turnstile,required_if=Provider recaptcha"`
+	FailureThreshold int64         `validate:"min=0"`
+	FailureWindow    time.Duration `validate:"required,min=1"`
+	Timeout          time.Duration `validate:"required,min=1"`
+}
+
+type RateLimitRuleConfig struct {
+	Limit  int           `validate:"min=0"`
+	Window time.Duration `validate:"required,min=1"`
+}
+
+type RateLimitConfig struct {
+	Enabled bool
+	Admin   RateLimitRuleConfig `validate:"required"`
+	User    RateLimitRuleConfig `validate:"required"`
+	Signup  RateLimitRuleConfig `validate:"required"`
+
+	GuardianConsentResend RateLimitRuleConfig `validate:"required"` // Per minor, as anyone may ask to resend the link
+}
+
+type AdminAPIConfig struct {
+	HeaderKey string   `validate:"required"`
+	APIKey    string   `validate:"omitempty,min=32"`
+	UserIDs   []string `validate:"dive,uuid"`
+}
+
+type OrganizationConfig struct {
+	InvitationLink string        `validate:"required,url"`
+	InvitationTTL  time.Duration `validate:"required,min=1"`
+}
+
+type EmailChangeConfig struct {
+	Link     string        `validate:"required,url"`
+	UndoLink string        `validate:"required,url"`
+	UndoTTL  time.Duration `validate:"required,min=1"`
+}
+
+type GuardianConsentConfig struct {
+	Link string `validate:"required,url"`
+}
+
+type PurgeConfig struct {
+	Enabled   bool
+	Interval  time.Duration `validate:"required,min=1"`
+	BatchSize int           `validate:"required,min=1"`
+	LockTTL   time.Duration `validate:"required,min=1"`
+}
+
+type OutboxConfig struct {
+	Interval    time.Duration `validate:"required,min=1"`
+	BatchSize   int           `validate:"required,min=1"`
+	MaxAttempts int           `validate:"required,min=1"`
+	LockTTL     time.Duration `validate:"required,min=1"`
+}
+
+type SignupRecoveryConfig struct {
+	Interval  time.Duration `validate:"required,min=1"`
+	LeaseTTL  time.Duration `validate:"required,min=1"`
+	Retention time.Duration `validate:"required,min=1"`
+	BatchSize int           `validate:"required,min=1"`
+	LockTTL   time.Duration `validate:"required,min=1"`
+}
+
+type DataExportConfig struct {
+	Store        string        `validate:"required,oneof=local"`
+	Dir          string        `validate:"required_if=Store local"`
+	DownloadLink string        `validate:"required,url"`
+	LinkTTL      time.Duration `validate:"required,min=1"`
+	Interval     time.Duration `validate:"required,min=1"`
+	BatchSize    int           `validate:"required,min=1"`
+	MaxAttempts  int           `validate:"required,min=1"`
+	LockTTL      time.Duration `validate:"required,min=1"`
+}
+
 type Config struct {
-	Env                   string            `validate:"required,oneof=dev prod"`
-	DatabaseURL           string            `validate:"required"`
-	HTTPServer            HTTPServerConfig  `validate:"required"`
-	UserEventWriter       KafkaWriterConfig `validate:"required"`
-	EmailEventWriter       KafkaWriterConfig `validate:"required"`
-	EmailCodeStore        RedisStoreConfig  `validate:"required"`
-	AuthClient            GRPCClientConfig  `validate:"required"`
-	ProfileClient         GRPCClientConfig  `validate:"required"`
-	TokenClient           GRPCClientConfig  `validate:"required"`
-	EmailVerificationLink string            `validate:"required,url"`
-	UserIDHeaderKey       string            `validate:"required"`
-	MaxSentEmails         int               `validate:"required,min=1"`
-	MaxSentEmailsDuration time.Duration     `validate:"required,min=1"`
+	Env                   string                `validate:"required,oneof=dev prod"`
+	DatabaseURL           string                `validate:"required"`
+	HTTPServer            HTTPServerConfig      `validate:"required"`
+	GRPCServer            GRPCServerConfig      `validate:"required"`
+	UserEventWriter       KafkaWriterConfig     `validate:"required"`
+	EmailEventWriter      KafkaWriterConfig     `validate:"required"`
+	AuditEventWriter      KafkaWriterConfig     `validate:"required"`
+	AuditEventReader      KafkaReaderConfig     `validate:"required"`
+	EmailCodeStore        RedisStoreConfig      `validate:"required"`
+	AuthClient            GRPCClientConfig      `validate:"required"`
+	ProfileClient         GRPCClientConfig      `validate:"required"`
+	TokenClient           GRPCClientConfig      `validate:"required"`
+	EmailVerificationLink string                `validate:"required,url"`
+	UserIDHeaderKey       string                `validate:"required"`
+	ImpersonatorHeaderKey string                `validate:"required"`
+	RequestIDHeaderKey    string                `validate:"required"`
+	MaxSentEmails         int                   `validate:"required,min=1"`
+	MaxSentEmailsDuration time.Duration         `validate:"required,min=1"`
+	Captcha               CaptchaConfig         `validate:"required"`
+	RateLimit             RateLimitConfig       `validate:"required"`
+	AdminAPI              AdminAPIConfig        `validate:"required"`
+	Organization          OrganizationConfig    `validate:"required"`
+	EmailChange           EmailChangeConfig     `validate:"required"`
+	Purge                 PurgeConfig           `validate:"required"`
+	Outbox                OutboxConfig          `validate:"required"`
+	SignupRecovery        SignupRecoveryConfig  `validate:"required"`
+	DataExport            DataExportConfig      `validate:"required"`
+	GuardianConsent       GuardianConsentConfig `validate:"required"`
 }
 
 // LoadConfig loads env vars from .env (if exists) and returns structured config
@@ -60,6 +162,10 @@ func LoadConfig(validator *validator.Validate) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	grpcPort, err := strconv.Atoi(getEnv("GRPC_PORT", "50051"))
+	if err != nil {
+		return nil, err
+	}
 
 	emailCodeStoreDB, err := strconv.Atoi(getEnv("EMAIL_CODE_STORE_DB", "0"))
 	if err != nil {
@@ -77,6 +183,118 @@ func LoadConfig(validator *validator.Validate) (*Config, error) {
 	if err != nil {
 		return nil, errors.New("Invalid MAX_SENT_EMAILS_DURATION format", "Failed to parse max sent emails duration", errcode.ErrInvalidInput)
 	}
+	captchaFailureThreshold, err := strconv.ParseInt(getEnv("CAPTCHA_FAILURE_THRESHOLD", "3"), 10, 64)
+	if err != nil {
+		return nil, errors.New("Invalid CAPTCHA_FAILURE_THRESHOLD format", "Failed to parse CAPTCHA failure threshold", errcode.ErrInvalidInput)
+	}
+	captchaFailureWindow, err := time.ParseDuration(getEnv("CAPTCHA_FAILURE_WINDOW", "15m"))
+	if err != nil {
+		return nil, errors.New("Invalid CAPTCHA_FAILURE_WINDOW format", "Failed to parse CAPTCHA failure window", errcode.ErrInvalidInput)
+	}
+	captchaTimeout, err := time.ParseDuration(getEnv("CAPTCHA_TIMEOUT", "5s"))
+	if err != nil {
+		return nil, errors.New("Invalid CAPTCHA_TIMEOUT format", "Failed to parse CAPTCHA timeout", errcode.ErrInvalidInput)
+	}
+	rateLimitEnabled, err := strconv.ParseBool(getEnv("RATE_LIMIT_ENABLED", "true"))
+	if err != nil {
+		return nil, errors.New("Invalid RATE_LIMIT_ENABLED format", "Failed to parse rate limit enabled flag", errcode.ErrInvalidInput)
+	}
+	adminRateLimit, err := parseRateLimit("RATE_LIMIT_ADMIN", "600/1m")
+	if err != nil {
+		return nil, err
+	}
+	userRateLimit, err := parseRateLimit("RATE_LIMIT_USER", "60/1m")
+	if err != nil {
+		return nil, err
+	}
+	signupRateLimit, err := parseRateLimit("RATE_LIMIT_SIGNUP", "10/1m")
+	if err != nil {
+		return nil, err
+	}
+	guardianConsentResendRateLimit, err := parseRateLimit("RATE_LIMIT_GUARDIAN_CONSENT_RESEND", "3/1h")
+	if err != nil {
+		return nil, err
+	}
+	invitationTTL, err := time.ParseDuration(getEnv("ORGANIZATION_INVITATION_TTL", "168h"))
+	if err != nil {
+		return nil, errors.New("Invalid ORGANIZATION_INVITATION_TTL format", "Failed to parse organization invitation TTL", errcode.ErrInvalidInput)
+	}
+	emailChangeUndoTTL, err := time.ParseDuration(getEnv("EMAIL_CHANGE_UNDO_TTL", "168h"))
+	if err != nil {
+		return nil, errors.New("Invalid EMAIL_CHANGE_UNDO_TTL format", "Failed to parse email change undo TTL", errcode.ErrInvalidInput)
+	}
+	purgeEnabled, err := strconv.ParseBool(getEnv("PURGE_ENABLED", "true"))
+	if err != nil {
+		return nil, errors.New("Invalid PURGE_ENABLED format", "Failed to parse purge enabled flag", errcode.ErrInvalidInput)
+	}
+	purgeInterval, err := time.ParseDuration(getEnv("PURGE_INTERVAL", "5m"))
+	if err != nil {
+		return nil, errors.New("Invalid PURGE_INTERVAL format", "Failed to parse purge interval", errcode.ErrInvalidInput)
+	}
+	purgeBatchSize, err := strconv.Atoi(getEnv("PURGE_BATCH_SIZE", "100"))
+	if err != nil {
+		return nil, errors.New("Invalid PURGE_BATCH_SIZE format", "Failed to parse purge batch size", errcode.ErrInvalidInput)
+	}
+	purgeLockTTL, err := time.ParseDuration(getEnv("PURGE_LOCK_TTL", "4m"))
+	if err != nil {
+		return nil, errors.New("Invalid PURGE_LOCK_TTL format", "Failed to parse purge lock TTL", errcode.ErrInvalidInput)
+	}
+	outboxInterval, err := time.ParseDuration(getEnv("OUTBOX_RELAY_INTERVAL", "1s"))
+	if err != nil {
+		return nil, errors.New("Invalid OUTBOX_RELAY_INTERVAL format", "Failed to parse outbox relay interval", errcode.ErrInvalidInput)
+	}
+	outboxBatchSize, err := strconv.Atoi(getEnv("OUTBOX_BATCH_SIZE", "100"))
+	if err != nil {
+		return nil, errors.New("Invalid OUTBOX_BATCH_SIZE format", "Failed to parse outbox batch size", errcode.ErrInvalidInput)
+	}
+	outboxMaxAttempts, err := strconv.Atoi(getEnv("OUTBOX_MAX_ATTEMPTS", "20"))
+	if err != nil {
+		return nil, errors.New("Invalid OUTBOX_MAX_ATTEMPTS format", "Failed to parse outbox max attempts", errcode.ErrInvalidInput)
+	}
+	outboxLockTTL, err := time.ParseDuration(getEnv("OUTBOX_LOCK_TTL", "2m"))
+	if err != nil {
+		return nil, errors.New("Invalid OUTBOX_LOCK_TTL format", "Failed to parse outbox lock TTL", errcode.ErrInvalidInput)
+	}
+	signupRecoveryInterval, err := time.ParseDuration(getEnv("SIGNUP_RECOVERY_INTERVAL", "1m"))
+	if err != nil {
+		return nil, errors.New("Invalid SIGNUP_RECOVERY_INTERVAL format", "Failed to parse signup recovery interval", errcode.ErrInvalidInput)
+	}
+	signupLeaseTTL, err := time.ParseDuration(getEnv("SIGNUP_LEASE_TTL", "5m"))
+	if err != nil {
+		return nil, errors.New("Invalid SIGNUP_LEASE_TTL format", "Failed to parse signup lease TTL", errcode.ErrInvalidInput)
+	}
+	signupRetention, err := time.ParseDuration(getEnv("SIGNUP_RETENTION", "168h"))
+	if err != nil {
+		return nil, errors.New("Invalid SIGNUP_RETENTION format", "Failed to parse signup retention", errcode.ErrInvalidInput)
+	}
+	signupRecoveryBatchSize, err := strconv.Atoi(getEnv("SIGNUP_RECOVERY_BATCH_SIZE", "100"))
+	if err != nil {
+		return nil, errors.New("Invalid SIGNUP_RECOVERY_BATCH_SIZE format", "Failed to parse signup recovery batch size", errcode.ErrInvalidInput)
+	}
+	signupRecoveryLockTTL, err := time.ParseDuration(getEnv("SIGNUP_RECOVERY_LOCK_TTL", "50s"))
+	if err != nil {
+		return nil, errors.New("Invalid SIGNUP_RECOVERY_LOCK_TTL format", "Failed to parse signup recovery lock TTL", errcode.ErrInvalidInput)
+	}
+	dataExportLinkTTL, err := time.ParseDuration(getEnv("DATA_EXPORT_LINK_TTL", "72h"))
+	if err != nil {
+		return nil, errors.New("Invalid DATA_EXPORT_LINK_TTL format", "Failed to parse data export link TTL", errcode.ErrInvalidInput)
+	}
+	dataExportInterval, err := time.ParseDuration(getEnv("DATA_EXPORT_INTERVAL", "30s"))
+	if err != nil {
+		return nil, errors.New("Invalid DATA_EXPORT_INTERVAL format", "Failed to parse data export interval", errcode.ErrInvalidInput)
+	}
+	dataExportBatchSize, err := strconv.Atoi(getEnv("DATA_EXPORT_BATCH_SIZE", "10"))
+	if err != nil {
+		return nil, errors.New("Invalid DATA_EXPORT_BATCH_SIZE format", "Failed to parse data export batch size", errcode.ErrInvalidInput)
+	}
+	dataExportMaxAttempts, err := strconv.Atoi(getEnv("DATA_EXPORT_MAX_ATTEMPTS", "5"))
+	if err != nil {
+		return nil, errors.New("Invalid DATA_EXPORT_MAX_ATTEMPTS format", "Failed to parse data export max attempts", errcode.ErrInvalidInput)
+	}
+	dataExportLockTTL, err := time.ParseDuration(getEnv("DATA_EXPORT_LOCK_TTL", "5m"))
+	if err != nil {
+		return nil, errors.New("Invalid DATA_EXPORT_LOCK_TTL format", "Failed to parse data export lock TTL", errcode.ErrInvalidInput)
+	}
 
 	config := &Config{
 		Env:         getEnv("ENV", "dev"),
@@ -89,8 +307,21 @@ func LoadConfig(validator *validator.Validate) (*Config, error) {
 			Address: strings.Split(getEnv("EMAIL_EVENT_WRITER_ADDRESS", ""), ","),
 			Topic:   getEnv("EMAIL_EVENT_WRITER_TOPIC", ""),
 		},
+		AuditEventWriter: KafkaWriterConfig{
+			Address: strings.Split(getEnv("AUDIT_EVENT_WRITER_ADDRESS", ""), ","),
+			Topic:   getEnv("AUDIT_EVENT_WRITER_TOPIC", "audit_event"),
+		},
+		AuditEventReader: KafkaReaderConfig{
+			Brokers: strings.Split(getEnv("AUDIT_EVENT_READER_BROKERS", ""), ","),
+			Topic:   getEnv("AUDIT_EVENT_READER_TOPIC", "audit_event"),
+			GroupID: getEnv("AUDIT_EVENT_READER_GROUP_ID", "user_audit_group"),
+		},
 		HTTPServer: HTTPServerConfig{
-			Port: httpPort,
+			Port:           httpPort,
+			TrustedProxies: splitList(getEnv("TRUSTED_PROXIES", "")),
+		},
+		GRPCServer: GRPCServerConfig{
+			Port: grpcPort,
 		},
 		EmailCodeStore: RedisStoreConfig{
 			Address:  getEnv("EMAIL_CODE_STORE_ADDRESS", ""),
@@ -111,8 +342,71 @@ func LoadConfig(validator *validator.Validate) (*Config, error) {
 		},
 		EmailVerificationLink: getEnv("EMAIL_VERIFICATION_LINK", ""),
 		UserIDHeaderKey:       getEnv("USER_ID_HEADER_KEY", "X-User-ID"),
+		ImpersonatorHeaderKey: getEnv("IMPERSONATOR_ID_HEADER_KEY", "X-Impersonator-ID"),
+		RequestIDHeaderKey:    getEnv("REQUEST_ID_HEADER_KEY", "X-Request-ID"),
 		MaxSentEmails:         maxSentEmails,
 		MaxSentEmailsDuration: maxSentEmailsDuration,
+		Captcha: CaptchaConfig{
+			Provider:         getEnv("CAPTCHA_PROVIDER", ""),
+			Secret:           getEnv("CAPTCHA_SECRET", ""),
+			FailureThreshold: captchaFailureThreshold,
+			FailureWindow:    captchaFailureWindow,
+			Timeout:          captchaTimeout,
+		},
+		RateLimit: RateLimitConfig{
+			Enabled: rateLimitEnabled,
+			Admin:   adminRateLimit,
+			User:    userRateLimit,
+			Signup:  signupRateLimit,
+
+			GuardianConsentResend: guardianConsentResendRateLimit,
+		},
+		AdminAPI: AdminAPIConfig{
+			HeaderKey: getEnv("ADMIN_API_HEADER_KEY", "X-Admin-Key"),
+			APIKey:    getEnv("ADMIN_API_KEY", ""),
+			UserIDs:   splitList(getEnv("ADMIN_USER_IDS", "")),
+		},
+		Organization: OrganizationConfig{
+			InvitationLink: getEnv("ORGANIZATION_INVITATION_LINK", ""),
+			InvitationTTL:  invitationTTL,
+		},
+		EmailChange: EmailChangeConfig{
+			Link:     getEnv("EMAIL_CHANGE_LINK", ""),
+			UndoLink: getEnv("EMAIL_CHANGE_UNDO_LINK", ""),
+			UndoTTL:  emailChangeUndoTTL,
+		},
+		Purge: PurgeConfig{
+			Enabled:   purgeEnabled,
+			Interval:  purgeInterval,
+			BatchSize: purgeBatchSize,
+			LockTTL:   purgeLockTTL,
+		},
+		Outbox: OutboxConfig{
+			Interval:    outboxInterval,
+			BatchSize:   outboxBatchSize,
+			MaxAttempts: outboxMaxAttempts,
+			LockTTL:     outboxLockTTL,
+		},
+		SignupRecovery: SignupRecoveryConfig{
+			Interval:  signupRecoveryInterval,
+			LeaseTTL:  signupLeaseTTL,
+			Retention: signupRetention,
+			BatchSize: signupRecoveryBatchSize,
+			LockTTL:   signupRecoveryLockTTL,
+		},
+		DataExport: DataExportConfig{
+			Store:        getEnv("DATA_EXPORT_STORE", "local"),
+			Dir:          getEnv("DATA_EXPORT_DIR", "data/exports"),
+			DownloadLink: getEnv("DATA_EXPORT_DOWNLOAD_LINK", ""),
+			LinkTTL:      dataExportLinkTTL,
+			Interval:     dataExportInterval,
+			BatchSize:    dataExportBatchSize,
+			MaxAttempts:  dataExportMaxAttempts,
+			LockTTL:      dataExportLockTTL,
+		},
+		GuardianConsent: GuardianConsentConfig{
+			Link: getEnv("GUARDIAN_CONSENT_LINK", ""),
+		},
 	}
 
 	if err := validator.Struct(config); err != nil {
@@ -129,3 +423,32 @@ func getEnv(key, fallback string) string {
 	}
 	return val
 }
+
+// splitList splits a comma separated env value, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseRateLimit parses a rate limit env value of the form "<limit>/<window>", such as "20/1m"
+func parseRateLimit(key, fallback string) (RateLimitRuleConfig, error) {
+	value := getEnv(key, fallback)
+	rawLimit, rawWindow, ok := strings.Cut(value, "/")
+	if !ok {
+		return RateLimitRuleConfig{}, errors.New("Invalid "+key+" format", "Failed to parse rate limit", errcode.ErrInvalidInput)
+	}
+	limit, err := strconv.Atoi(rawLimit)
+	if err != nil {
+		return RateLimitRuleConfig{}, errors.New("Invalid "+key+" format", "Failed to parse rate limit", errcode.ErrInvalidInput)
+	}
+	window, err := time.ParseDuration(rawWindow)
+	if err != nil {
+		return RateLimitRuleConfig{}, errors.New("Invalid "+key+" format", "Failed to parse rate limit", errcode.ErrInvalidInput)
+	}
+	return RateLimitRuleConfig{Limit: limit, Window: window}, nil
+}
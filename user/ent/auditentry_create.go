@@ -0,0 +1,426 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/auditentry"
+)
+
+// AuditEntryCreate is the builder for creating a AuditEntry entity.
+type AuditEntryCreate struct {
+	config
+	mutation *AuditEntryMutation
+	hooks    []Hook
+}
+
+// SetSeq sets the "seq" field.
+func (aec *AuditEntryCreate) SetSeq(i int64) *AuditEntryCreate {
+	aec.mutation.SetSeq(i)
+	return aec
+}
+
+// SetService sets the "service" field.
+func (aec *AuditEntryCreate) SetService(s string) *AuditEntryCreate {
+	aec.mutation.SetService(s)
+	return aec
+}
+
+// SetActorType sets the "actor_type" field.
+func (aec *AuditEntryCreate) SetActorType(s string) *AuditEntryCreate {
+	aec.mutation.SetActorType(s)
+	return aec
+}
+
+// SetActorID sets the "actor_id" field.
+func (aec *AuditEntryCreate) SetActorID(s string) *AuditEntryCreate {
+	aec.mutation.SetActorID(s)
+	return aec
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableActorID(s *string) *AuditEntryCreate {
+	if s != nil {
+		aec.SetActorID(*s)
+	}
+	return aec
+}
+
+// SetAction sets the "action" field.
+func (aec *AuditEntryCreate) SetAction(s string) *AuditEntryCreate {
+	aec.mutation.SetAction(s)
+	return aec
+}
+
+// SetTargetType sets the "target_type" field.
+func (aec *AuditEntryCreate) SetTargetType(s string) *AuditEntryCreate {
+	aec.mutation.SetTargetType(s)
+	return aec
+}
+
+// SetTargetID sets the "target_id" field.
+func (aec *AuditEntryCreate) SetTargetID(s string) *AuditEntryCreate {
+	aec.mutation.SetTargetID(s)
+	return aec
+}
+
+// SetBefore sets the "before" field.
+func (aec *AuditEntryCreate) SetBefore(m map[string]interface{}) *AuditEntryCreate {
+	aec.mutation.SetBefore(m)
+	return aec
+}
+
+// SetAfter sets the "after" field.
+func (aec *AuditEntryCreate) SetAfter(m map[string]interface{}) *AuditEntryCreate {
+	aec.mutation.SetAfter(m)
+	return aec
+}
+
+// SetRequestID sets the "request_id" field.
+func (aec *AuditEntryCreate) SetRequestID(s string) *AuditEntryCreate {
+	aec.mutation.SetRequestID(s)
+	return aec
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableRequestID(s *string) *AuditEntryCreate {
+	if s != nil {
+		aec.SetRequestID(*s)
+	}
+	return aec
+}
+
+// SetIP sets the "ip" field.
+func (aec *AuditEntryCreate) SetIP(s string) *AuditEntryCreate {
+	aec.mutation.SetIP(s)
+	return aec
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableIP(s *string) *AuditEntryCreate {
+	if s != nil {
+		aec.SetIP(*s)
+	}
+	return aec
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (aec *AuditEntryCreate) SetOccurredAt(t time.Time) *AuditEntryCreate {
+	aec.mutation.SetOccurredAt(t)
+	return aec
+}
+
+// SetRecordedAt sets the "recorded_at" field.
+func (aec *AuditEntryCreate) SetRecordedAt(t time.Time) *AuditEntryCreate {
+	aec.mutation.SetRecordedAt(t)
+	return aec
+}
+
+// SetNillableRecordedAt sets the "recorded_at" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableRecordedAt(t *time.Time) *AuditEntryCreate {
+	if t != nil {
+		aec.SetRecordedAt(*t)
+	}
+	return aec
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (aec *AuditEntryCreate) SetPrevHash(s string) *AuditEntryCreate {
+	aec.mutation.SetPrevHash(s)
+	return aec
+}
+
+// SetHash sets the "hash" field.
+func (aec *AuditEntryCreate) SetHash(s string) *AuditEntryCreate {
+	aec.mutation.SetHash(s)
+	return aec
+}
+
+// SetID sets the "id" field.
+func (aec *AuditEntryCreate) SetID(u uuid.UUID) *AuditEntryCreate {
+	aec.mutation.SetID(u)
+	return aec
+}
+
+// Mutation returns the AuditEntryMutation object of the builder.
+func (aec *AuditEntryCreate) Mutation() *AuditEntryMutation {
+	return aec.mutation
+}
+
+// Save creates the AuditEntry in the database.
+func (aec *AuditEntryCreate) Save(ctx context.Context) (*AuditEntry, error) {
+	aec.defaults()
+	return withHooks(ctx, aec.sqlSave, aec.mutation, aec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AuditEntryCreate) SaveX(ctx context.Context) *AuditEntry {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AuditEntryCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AuditEntryCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *AuditEntryCreate) defaults() {
+	if _, ok := aec.mutation.ActorID(); !ok {
+		v := auditentry.DefaultActorID
+		aec.mutation.SetActorID(v)
+	}
+	if _, ok := aec.mutation.RequestID(); !ok {
+		v := auditentry.DefaultRequestID
+		aec.mutation.SetRequestID(v)
+	}
+	if _, ok := aec.mutation.IP(); !ok {
+		v := auditentry.DefaultIP
+		aec.mutation.SetIP(v)
+	}
+	if _, ok := aec.mutation.RecordedAt(); !ok {
+		v := auditentry.DefaultRecordedAt()
+		aec.mutation.SetRecordedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AuditEntryCreate) check() error {
+	if _, ok := aec.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "AuditEntry.seq"`)}
+	}
+	if _, ok := aec.mutation.Service(); !ok {
+		return &ValidationError{Name: "service", err: errors.New(`ent: missing required field "AuditEntry.service"`)}
+	}
+	if _, ok := aec.mutation.ActorType(); !ok {
+		return &ValidationError{Name: "actor_type", err: errors.New(`ent: missing required field "AuditEntry.actor_type"`)}
+	}
+	if _, ok := aec.mutation.ActorID(); !ok {
+		return &ValidationError{Name: "actor_id", err: errors.New(`ent: missing required field "AuditEntry.actor_id"`)}
+	}
+	if _, ok := aec.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AuditEntry.action"`)}
+	}
+	if _, ok := aec.mutation.TargetType(); !ok {
+		return &ValidationError{Name: "target_type", err: errors.New(`ent: missing required field "AuditEntry.target_type"`)}
+	}
+	if _, ok := aec.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "AuditEntry.target_id"`)}
+	}
+	if _, ok := aec.mutation.RequestID(); !ok {
+		return &ValidationError{Name: "request_id", err: errors.New(`ent: missing required field "AuditEntry.request_id"`)}
+	}
+	if _, ok := aec.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "AuditEntry.ip"`)}
+	}
+	if _, ok := aec.mutation.OccurredAt(); !ok {
+		return &ValidationError{Name: "occurred_at", err: errors.New(`ent: missing required field "AuditEntry.occurred_at"`)}
+	}
+	if _, ok := aec.mutation.RecordedAt(); !ok {
+		return &ValidationError{Name: "recorded_at", err: errors.New(`ent: missing required field "AuditEntry.recorded_at"`)}
+	}
+	if _, ok := aec.mutation.PrevHash(); !ok {
+		return &ValidationError{Name: "prev_hash", err: errors.New(`ent: missing required field "AuditEntry.prev_hash"`)}
+	}
+	if _, ok := aec.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "AuditEntry.hash"`)}
+	}
+	return nil
+}
+
+func (aec *AuditEntryCreate) sqlSave(ctx context.Context) (*AuditEntry, error) {
+	if err := aec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	aec.mutation.id = &_node.ID
+	aec.mutation.done = true
+	return _node, nil
+}
+
+func (aec *AuditEntryCreate) createSpec() (*AuditEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditEntry{config: aec.config}
+		_spec = sqlgraph.NewCreateSpec(auditentry.Table, sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID))
+	)
+	if id, ok := aec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := aec.mutation.Seq(); ok {
+		_spec.SetField(auditentry.FieldSeq, field.TypeInt64, value)
+		_node.Seq = value
+	}
+	if value, ok := aec.mutation.Service(); ok {
+		_spec.SetField(auditentry.FieldService, field.TypeString, value)
+		_node.Service = value
+	}
+	if value, ok := aec.mutation.ActorType(); ok {
+		_spec.SetField(auditentry.FieldActorType, field.TypeString, value)
+		_node.ActorType = value
+	}
+	if value, ok := aec.mutation.ActorID(); ok {
+		_spec.SetField(auditentry.FieldActorID, field.TypeString, value)
+		_node.ActorID = value
+	}
+	if value, ok := aec.mutation.Action(); ok {
+		_spec.SetField(auditentry.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := aec.mutation.TargetType(); ok {
+		_spec.SetField(auditentry.FieldTargetType, field.TypeString, value)
+		_node.TargetType = value
+	}
+	if value, ok := aec.mutation.TargetID(); ok {
+		_spec.SetField(auditentry.FieldTargetID, field.TypeString, value)
+		_node.TargetID = value
+	}
+	if value, ok := aec.mutation.Before(); ok {
+		_spec.SetField(auditentry.FieldBefore, field.TypeJSON, value)
+		_node.Before = value
+	}
+	if value, ok := aec.mutation.After(); ok {
+		_spec.SetField(auditentry.FieldAfter, field.TypeJSON, value)
+		_node.After = value
+	}
+	if value, ok := aec.mutation.RequestID(); ok {
+		_spec.SetField(auditentry.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := aec.mutation.IP(); ok {
+		_spec.SetField(auditentry.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := aec.mutation.OccurredAt(); ok {
+		_spec.SetField(auditentry.FieldOccurredAt, field.TypeTime, value)
+		_node.OccurredAt = value
+	}
+	if value, ok := aec.mutation.RecordedAt(); ok {
+		_spec.SetField(auditentry.FieldRecordedAt, field.TypeTime, value)
+		_node.RecordedAt = value
+	}
+	if value, ok := aec.mutation.PrevHash(); ok {
+		_spec.SetField(auditentry.FieldPrevHash, field.TypeString, value)
+		_node.PrevHash = value
+	}
+	if value, ok := aec.mutation.Hash(); ok {
+		_spec.SetField(auditentry.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	return _node, _spec
+}
+
+// AuditEntryCreateBulk is the builder for creating many AuditEntry entities in bulk.
+type AuditEntryCreateBulk struct {
+	config
+	err      error
+	builders []*AuditEntryCreate
+}
+
+// Save creates the AuditEntry entities in the database.
+func (aecb *AuditEntryCreateBulk) Save(ctx context.Context) ([]*AuditEntry, error) {
+	if aecb.err != nil {
+		return nil, aecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AuditEntry, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AuditEntryCreateBulk) SaveX(ctx context.Context) []*AuditEntry {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AuditEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AuditEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/consentdocument"
+)
+
+// ConsentDocument is the model entity for the ConsentDocument schema.
+type ConsentDocument struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique identifier for the document. This is a UUID that is generated when the document is published.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Kind of the document. The latest version of each kind is the current one.
+	Kind consentdocument.Kind `json:"kind,omitempty"`
+	// Version of the document, increasing with each version of the same kind.
+	Version int `json:"version,omitempty"`
+	// Title of the document, as shown to the user.
+	Title string `json:"title,omitempty"`
+	// URL of the full text of the document.
+	ContentURL string `json:"content_url,omitempty"`
+	// Whether users must accept the document to use the service. Optional documents, such as marketing consent, can be declined.
+	Mandatory bool `json:"mandatory,omitempty"`
+	// Timestamp when the document was published.
+	PublishedAt time.Time `json:"published_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ConsentDocumentQuery when eager-loading is set.
+	Edges        ConsentDocumentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ConsentDocumentEdges holds the relations/edges for other nodes in the graph.
+type ConsentDocumentEdges struct {
+	// Consents holds the value of the consents edge.
+	Consents []*UserConsent `json:"consents,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ConsentsOrErr returns the Consents value or an error if the edge
+// was not loaded in eager-loading.
+func (e ConsentDocumentEdges) ConsentsOrErr() ([]*UserConsent, error) {
+	if e.loadedTypes[0] {
+		return e.Consents, nil
+	}
+	return nil, &NotLoadedError{edge: "consents"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConsentDocument) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case consentdocument.FieldMandatory:
+			values[i] = new(sql.NullBool)
+		case consentdocument.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case consentdocument.FieldKind, consentdocument.FieldTitle, consentdocument.FieldContentURL:
+			values[i] = new(sql.NullString)
+		case consentdocument.FieldPublishedAt:
+			values[i] = new(sql.NullTime)
+		case consentdocument.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConsentDocument fields.
+func (cd *ConsentDocument) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case consentdocument.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cd.ID = *value
+			}
+		case consentdocument.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				cd.Kind = consentdocument.Kind(value.String)
+			}
+		case consentdocument.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				cd.Version = int(value.Int64)
+			}
+		case consentdocument.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				cd.Title = value.String
+			}
+		case consentdocument.FieldContentURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_url", values[i])
+			} else if value.Valid {
+				cd.ContentURL = value.String
+			}
+		case consentdocument.FieldMandatory:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mandatory", values[i])
+			} else if value.Valid {
+				cd.Mandatory = value.Bool
+			}
+		case consentdocument.FieldPublishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_at", values[i])
+			} else if value.Valid {
+				cd.PublishedAt = value.Time
+			}
+		default:
+			cd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConsentDocument.
+// This includes values selected through modifiers, order, etc.
+func (cd *ConsentDocument) Value(name string) (ent.Value, error) {
+	return cd.selectValues.Get(name)
+}
+
+// QueryConsents queries the "consents" edge of the ConsentDocument entity.
+func (cd *ConsentDocument) QueryConsents() *UserConsentQuery {
+	return NewConsentDocumentClient(cd.config).QueryConsents(cd)
+}
+
+// Update returns a builder for updating this ConsentDocument.
+// Note that you need to call ConsentDocument.Unwrap() before calling this method if this ConsentDocument
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cd *ConsentDocument) Update() *ConsentDocumentUpdateOne {
+	return NewConsentDocumentClient(cd.config).UpdateOne(cd)
+}
+
+// Unwrap unwraps the ConsentDocument entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cd *ConsentDocument) Unwrap() *ConsentDocument {
+	_tx, ok := cd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConsentDocument is not a transactional entity")
+	}
+	cd.config.driver = _tx.drv
+	return cd
+}
+
+// String implements the fmt.Stringer.
+func (cd *ConsentDocument) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConsentDocument(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cd.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", cd.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", cd.Version))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(cd.Title)
+	builder.WriteString(", ")
+	builder.WriteString("content_url=")
+	builder.WriteString(cd.ContentURL)
+	builder.WriteString(", ")
+	builder.WriteString("mandatory=")
+	builder.WriteString(fmt.Sprintf("%v", cd.Mandatory))
+	builder.WriteString(", ")
+	builder.WriteString("published_at=")
+	builder.WriteString(cd.PublishedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConsentDocuments is a parsable slice of ConsentDocument.
+type ConsentDocuments []*ConsentDocument
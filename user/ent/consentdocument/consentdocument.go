@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package consentdocument
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the consentdocument type in the database.
+	Label = "consent_document"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldContentURL holds the string denoting the content_url field in the database.
+	FieldContentURL = "content_url"
+	// FieldMandatory holds the string denoting the mandatory field in the database.
+	FieldMandatory = "mandatory"
+	// FieldPublishedAt holds the string denoting the published_at field in the database.
+	FieldPublishedAt = "published_at"
+	// EdgeConsents holds the string denoting the consents edge name in mutations.
+	EdgeConsents = "consents"
+	// Table holds the table name of the consentdocument in the database.
+	Table = "consent_documents"
+	// ConsentsTable is the table that holds the consents relation/edge.
+	ConsentsTable = "user_consents"
+	// ConsentsInverseTable is the table name for the UserConsent entity.
+	// It exists in this package in order to avoid circular dependency with the "userconsent" package.
+	ConsentsInverseTable = "user_consents"
+	// ConsentsColumn is the table column denoting the consents relation/edge.
+	ConsentsColumn = "document_id"
+)
+
+// Columns holds all SQL columns for consentdocument fields.
+var Columns = []string{
+	FieldID,
+	FieldKind,
+	FieldVersion,
+	FieldTitle,
+	FieldContentURL,
+	FieldMandatory,
+	FieldPublishedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	VersionValidator func(int) error
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// ContentURLValidator is a validator for the "content_url" field. It is called by the builders before save.
+	ContentURLValidator func(string) error
+	// DefaultPublishedAt holds the default value on creation for the "published_at" field.
+	DefaultPublishedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindTermsOfService Kind = "terms_of_service"
+	KindPrivacyPolicy  Kind = "privacy_policy"
+	KindMarketing      Kind = "marketing"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindTermsOfService, KindPrivacyPolicy, KindMarketing:
+		return nil
+	default:
+		return fmt.Errorf("consentdocument: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the ConsentDocument queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByContentURL orders the results by the content_url field.
+func ByContentURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentURL, opts...).ToFunc()
+}
+
+// ByMandatory orders the results by the mandatory field.
+func ByMandatory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMandatory, opts...).ToFunc()
+}
+
+// ByPublishedAt orders the results by the published_at field.
+func ByPublishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedAt, opts...).ToFunc()
+}
+
+// ByConsentsCount orders the results by consents count.
+func ByConsentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newConsentsStep(), opts...)
+	}
+}
+
+// ByConsents orders the results by consents terms.
+func ByConsents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConsentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newConsentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConsentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ConsentsTable, ConsentsColumn),
+	)
+}
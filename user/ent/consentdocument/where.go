@@ -0,0 +1,360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package consentdocument
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldLTE(FieldID, id))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldEQ(FieldVersion, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldEQ(FieldTitle, v))
+}
+
+// ContentURL applies equality check predicate on the "content_url" field. It's identical to ContentURLEQ.
+func ContentURL(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldEQ(FieldContentURL, v))
+}
+
+// Mandatory applies equality check predicate on the "mandatory" field. It's identical to MandatoryEQ.
+func Mandatory(v bool) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldEQ(FieldMandatory, v))
+}
+
+// PublishedAt applies equality check predicate on the "published_at" field. It's identical to PublishedAtEQ.
+func PublishedAt(v time.Time) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldEQ(FieldPublishedAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldLTE(FieldVersion, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// ContentURLEQ applies the EQ predicate on the "content_url" field.
+func ContentURLEQ(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldEQ(FieldContentURL, v))
+}
+
+// ContentURLNEQ applies the NEQ predicate on the "content_url" field.
+func ContentURLNEQ(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldNEQ(FieldContentURL, v))
+}
+
+// ContentURLIn applies the In predicate on the "content_url" field.
+func ContentURLIn(vs ...string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldIn(FieldContentURL, vs...))
+}
+
+// ContentURLNotIn applies the NotIn predicate on the "content_url" field.
+func ContentURLNotIn(vs ...string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldNotIn(FieldContentURL, vs...))
+}
+
+// ContentURLGT applies the GT predicate on the "content_url" field.
+func ContentURLGT(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldGT(FieldContentURL, v))
+}
+
+// ContentURLGTE applies the GTE predicate on the "content_url" field.
+func ContentURLGTE(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldGTE(FieldContentURL, v))
+}
+
+// ContentURLLT applies the LT predicate on the "content_url" field.
+func ContentURLLT(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldLT(FieldContentURL, v))
+}
+
+// ContentURLLTE applies the LTE predicate on the "content_url" field.
+func ContentURLLTE(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldLTE(FieldContentURL, v))
+}
+
+// ContentURLContains applies the Contains predicate on the "content_url" field.
+func ContentURLContains(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldContains(FieldContentURL, v))
+}
+
+// ContentURLHasPrefix applies the HasPrefix predicate on the "content_url" field.
+func ContentURLHasPrefix(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldHasPrefix(FieldContentURL, v))
+}
+
+// ContentURLHasSuffix applies the HasSuffix predicate on the "content_url" field.
+func ContentURLHasSuffix(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldHasSuffix(FieldContentURL, v))
+}
+
+// ContentURLEqualFold applies the EqualFold predicate on the "content_url" field.
+func ContentURLEqualFold(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldEqualFold(FieldContentURL, v))
+}
+
+// ContentURLContainsFold applies the ContainsFold predicate on the "content_url" field.
+func ContentURLContainsFold(v string) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldContainsFold(FieldContentURL, v))
+}
+
+// MandatoryEQ applies the EQ predicate on the "mandatory" field.
+func MandatoryEQ(v bool) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldEQ(FieldMandatory, v))
+}
+
+// MandatoryNEQ applies the NEQ predicate on the "mandatory" field.
+func MandatoryNEQ(v bool) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldNEQ(FieldMandatory, v))
+}
+
+// PublishedAtEQ applies the EQ predicate on the "published_at" field.
+func PublishedAtEQ(v time.Time) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldEQ(FieldPublishedAt, v))
+}
+
+// PublishedAtNEQ applies the NEQ predicate on the "published_at" field.
+func PublishedAtNEQ(v time.Time) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldNEQ(FieldPublishedAt, v))
+}
+
+// PublishedAtIn applies the In predicate on the "published_at" field.
+func PublishedAtIn(vs ...time.Time) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldIn(FieldPublishedAt, vs...))
+}
+
+// PublishedAtNotIn applies the NotIn predicate on the "published_at" field.
+func PublishedAtNotIn(vs ...time.Time) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldNotIn(FieldPublishedAt, vs...))
+}
+
+// PublishedAtGT applies the GT predicate on the "published_at" field.
+func PublishedAtGT(v time.Time) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldGT(FieldPublishedAt, v))
+}
+
+// PublishedAtGTE applies the GTE predicate on the "published_at" field.
+func PublishedAtGTE(v time.Time) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldGTE(FieldPublishedAt, v))
+}
+
+// PublishedAtLT applies the LT predicate on the "published_at" field.
+func PublishedAtLT(v time.Time) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldLT(FieldPublishedAt, v))
+}
+
+// PublishedAtLTE applies the LTE predicate on the "published_at" field.
+func PublishedAtLTE(v time.Time) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.FieldLTE(FieldPublishedAt, v))
+}
+
+// HasConsents applies the HasEdge predicate on the "consents" edge.
+func HasConsents() predicate.ConsentDocument {
+	return predicate.ConsentDocument(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ConsentsTable, ConsentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConsentsWith applies the HasEdge predicate on the "consents" edge with a given conditions (other predicates).
+func HasConsentsWith(preds ...predicate.UserConsent) predicate.ConsentDocument {
+	return predicate.ConsentDocument(func(s *sql.Selector) {
+		step := newConsentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConsentDocument) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConsentDocument) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConsentDocument) predicate.ConsentDocument {
+	return predicate.ConsentDocument(sql.NotPredicates(p))
+}
@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/consentdocument"
+	"mandacode.com/accounts/user/ent/userconsent"
+)
+
+// ConsentDocumentCreate is the builder for creating a ConsentDocument entity.
+type ConsentDocumentCreate struct {
+	config
+	mutation *ConsentDocumentMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (cdc *ConsentDocumentCreate) SetKind(c consentdocument.Kind) *ConsentDocumentCreate {
+	cdc.mutation.SetKind(c)
+	return cdc
+}
+
+// SetVersion sets the "version" field.
+func (cdc *ConsentDocumentCreate) SetVersion(i int) *ConsentDocumentCreate {
+	cdc.mutation.SetVersion(i)
+	return cdc
+}
+
+// SetTitle sets the "title" field.
+func (cdc *ConsentDocumentCreate) SetTitle(s string) *ConsentDocumentCreate {
+	cdc.mutation.SetTitle(s)
+	return cdc
+}
+
+// SetContentURL sets the "content_url" field.
+func (cdc *ConsentDocumentCreate) SetContentURL(s string) *ConsentDocumentCreate {
+	cdc.mutation.SetContentURL(s)
+	return cdc
+}
+
+// SetMandatory sets the "mandatory" field.
+func (cdc *ConsentDocumentCreate) SetMandatory(b bool) *ConsentDocumentCreate {
+	cdc.mutation.SetMandatory(b)
+	return cdc
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (cdc *ConsentDocumentCreate) SetPublishedAt(t time.Time) *ConsentDocumentCreate {
+	cdc.mutation.SetPublishedAt(t)
+	return cdc
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (cdc *ConsentDocumentCreate) SetNillablePublishedAt(t *time.Time) *ConsentDocumentCreate {
+	if t != nil {
+		cdc.SetPublishedAt(*t)
+	}
+	return cdc
+}
+
+// SetID sets the "id" field.
+func (cdc *ConsentDocumentCreate) SetID(u uuid.UUID) *ConsentDocumentCreate {
+	cdc.mutation.SetID(u)
+	return cdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cdc *ConsentDocumentCreate) SetNillableID(u *uuid.UUID) *ConsentDocumentCreate {
+	if u != nil {
+		cdc.SetID(*u)
+	}
+	return cdc
+}
+
+// AddConsentIDs adds the "consents" edge to the UserConsent entity by IDs.
+func (cdc *ConsentDocumentCreate) AddConsentIDs(ids ...uuid.UUID) *ConsentDocumentCreate {
+	cdc.mutation.AddConsentIDs(ids...)
+	return cdc
+}
+
+// AddConsents adds the "consents" edges to the UserConsent entity.
+func (cdc *ConsentDocumentCreate) AddConsents(u ...*UserConsent) *ConsentDocumentCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cdc.AddConsentIDs(ids...)
+}
+
+// Mutation returns the ConsentDocumentMutation object of the builder.
+func (cdc *ConsentDocumentCreate) Mutation() *ConsentDocumentMutation {
+	return cdc.mutation
+}
+
+// Save creates the ConsentDocument in the database.
+func (cdc *ConsentDocumentCreate) Save(ctx context.Context) (*ConsentDocument, error) {
+	cdc.defaults()
+	return withHooks(ctx, cdc.sqlSave, cdc.mutation, cdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cdc *ConsentDocumentCreate) SaveX(ctx context.Context) *ConsentDocument {
+	v, err := cdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cdc *ConsentDocumentCreate) Exec(ctx context.Context) error {
+	_, err := cdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdc *ConsentDocumentCreate) ExecX(ctx context.Context) {
+	if err := cdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cdc *ConsentDocumentCreate) defaults() {
+	if _, ok := cdc.mutation.PublishedAt(); !ok {
+		v := consentdocument.DefaultPublishedAt()
+		cdc.mutation.SetPublishedAt(v)
+	}
+	if _, ok := cdc.mutation.ID(); !ok {
+		v := consentdocument.DefaultID()
+		cdc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdc *ConsentDocumentCreate) check() error {
+	if _, ok := cdc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ConsentDocument.kind"`)}
+	}
+	if v, ok := cdc.mutation.Kind(); ok {
+		if err := consentdocument.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ConsentDocument.kind": %w`, err)}
+		}
+	}
+	if _, ok := cdc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "ConsentDocument.version"`)}
+	}
+	if v, ok := cdc.mutation.Version(); ok {
+		if err := consentdocument.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "ConsentDocument.version": %w`, err)}
+		}
+	}
+	if _, ok := cdc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "ConsentDocument.title"`)}
+	}
+	if v, ok := cdc.mutation.Title(); ok {
+		if err := consentdocument.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "ConsentDocument.title": %w`, err)}
+		}
+	}
+	if _, ok := cdc.mutation.ContentURL(); !ok {
+		return &ValidationError{Name: "content_url", err: errors.New(`ent: missing required field "ConsentDocument.content_url"`)}
+	}
+	if v, ok := cdc.mutation.ContentURL(); ok {
+		if err := consentdocument.ContentURLValidator(v); err != nil {
+			return &ValidationError{Name: "content_url", err: fmt.Errorf(`ent: validator failed for field "ConsentDocument.content_url": %w`, err)}
+		}
+	}
+	if _, ok := cdc.mutation.Mandatory(); !ok {
+		return &ValidationError{Name: "mandatory", err: errors.New(`ent: missing required field "ConsentDocument.mandatory"`)}
+	}
+	if _, ok := cdc.mutation.PublishedAt(); !ok {
+		return &ValidationError{Name: "published_at", err: errors.New(`ent: missing required field "ConsentDocument.published_at"`)}
+	}
+	return nil
+}
+
+func (cdc *ConsentDocumentCreate) sqlSave(ctx context.Context) (*ConsentDocument, error) {
+	if err := cdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cdc.mutation.id = &_node.ID
+	cdc.mutation.done = true
+	return _node, nil
+}
+
+func (cdc *ConsentDocumentCreate) createSpec() (*ConsentDocument, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConsentDocument{config: cdc.config}
+		_spec = sqlgraph.NewCreateSpec(consentdocument.Table, sqlgraph.NewFieldSpec(consentdocument.FieldID, field.TypeUUID))
+	)
+	if id, ok := cdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cdc.mutation.Kind(); ok {
+		_spec.SetField(consentdocument.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := cdc.mutation.Version(); ok {
+		_spec.SetField(consentdocument.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := cdc.mutation.Title(); ok {
+		_spec.SetField(consentdocument.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := cdc.mutation.ContentURL(); ok {
+		_spec.SetField(consentdocument.FieldContentURL, field.TypeString, value)
+		_node.ContentURL = value
+	}
+	if value, ok := cdc.mutation.Mandatory(); ok {
+		_spec.SetField(consentdocument.FieldMandatory, field.TypeBool, value)
+		_node.Mandatory = value
+	}
+	if value, ok := cdc.mutation.PublishedAt(); ok {
+		_spec.SetField(consentdocument.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = value
+	}
+	if nodes := cdc.mutation.ConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   consentdocument.ConsentsTable,
+			Columns: []string{consentdocument.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userconsent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ConsentDocumentCreateBulk is the builder for creating many ConsentDocument entities in bulk.
+type ConsentDocumentCreateBulk struct {
+	config
+	err      error
+	builders []*ConsentDocumentCreate
+}
+
+// Save creates the ConsentDocument entities in the database.
+func (cdcb *ConsentDocumentCreateBulk) Save(ctx context.Context) ([]*ConsentDocument, error) {
+	if cdcb.err != nil {
+		return nil, cdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cdcb.builders))
+	nodes := make([]*ConsentDocument, len(cdcb.builders))
+	mutators := make([]Mutator, len(cdcb.builders))
+	for i := range cdcb.builders {
+		func(i int, root context.Context) {
+			builder := cdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConsentDocumentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdcb *ConsentDocumentCreateBulk) SaveX(ctx context.Context) []*ConsentDocument {
+	v, err := cdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cdcb *ConsentDocumentCreateBulk) Exec(ctx context.Context) error {
+	_, err := cdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdcb *ConsentDocumentCreateBulk) ExecX(ctx context.Context) {
+	if err := cdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"mandacode.com/accounts/user/ent/consentdocument"
+	"mandacode.com/accounts/user/ent/predicate"
+)
+
+// ConsentDocumentDelete is the builder for deleting a ConsentDocument entity.
+type ConsentDocumentDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConsentDocumentMutation
+}
+
+// Where appends a list predicates to the ConsentDocumentDelete builder.
+func (cdd *ConsentDocumentDelete) Where(ps ...predicate.ConsentDocument) *ConsentDocumentDelete {
+	cdd.mutation.Where(ps...)
+	return cdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cdd *ConsentDocumentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cdd.sqlExec, cdd.mutation, cdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdd *ConsentDocumentDelete) ExecX(ctx context.Context) int {
+	n, err := cdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cdd *ConsentDocumentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(consentdocument.Table, sqlgraph.NewFieldSpec(consentdocument.FieldID, field.TypeUUID))
+	if ps := cdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cdd.mutation.done = true
+	return affected, err
+}
+
+// ConsentDocumentDeleteOne is the builder for deleting a single ConsentDocument entity.
+type ConsentDocumentDeleteOne struct {
+	cdd *ConsentDocumentDelete
+}
+
+// Where appends a list predicates to the ConsentDocumentDelete builder.
+func (cddo *ConsentDocumentDeleteOne) Where(ps ...predicate.ConsentDocument) *ConsentDocumentDeleteOne {
+	cddo.cdd.mutation.Where(ps...)
+	return cddo
+}
+
+// Exec executes the deletion query.
+func (cddo *ConsentDocumentDeleteOne) Exec(ctx context.Context) error {
+	n, err := cddo.cdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{consentdocument.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cddo *ConsentDocumentDeleteOne) ExecX(ctx context.Context) {
+	if err := cddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,607 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/consentdocument"
+	"mandacode.com/accounts/user/ent/predicate"
+	"mandacode.com/accounts/user/ent/userconsent"
+)
+
+// ConsentDocumentQuery is the builder for querying ConsentDocument entities.
+type ConsentDocumentQuery struct {
+	config
+	ctx          *QueryContext
+	order        []consentdocument.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.ConsentDocument
+	withConsents *UserConsentQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ConsentDocumentQuery builder.
+func (cdq *ConsentDocumentQuery) Where(ps ...predicate.ConsentDocument) *ConsentDocumentQuery {
+	cdq.predicates = append(cdq.predicates, ps...)
+	return cdq
+}
+
+// Limit the number of records to be returned by this query.
+func (cdq *ConsentDocumentQuery) Limit(limit int) *ConsentDocumentQuery {
+	cdq.ctx.Limit = &limit
+	return cdq
+}
+
+// Offset to start from.
+func (cdq *ConsentDocumentQuery) Offset(offset int) *ConsentDocumentQuery {
+	cdq.ctx.Offset = &offset
+	return cdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cdq *ConsentDocumentQuery) Unique(unique bool) *ConsentDocumentQuery {
+	cdq.ctx.Unique = &unique
+	return cdq
+}
+
+// Order specifies how the records should be ordered.
+func (cdq *ConsentDocumentQuery) Order(o ...consentdocument.OrderOption) *ConsentDocumentQuery {
+	cdq.order = append(cdq.order, o...)
+	return cdq
+}
+
+// QueryConsents chains the current query on the "consents" edge.
+func (cdq *ConsentDocumentQuery) QueryConsents() *UserConsentQuery {
+	query := (&UserConsentClient{config: cdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(consentdocument.Table, consentdocument.FieldID, selector),
+			sqlgraph.To(userconsent.Table, userconsent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, consentdocument.ConsentsTable, consentdocument.ConsentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ConsentDocument entity from the query.
+// Returns a *NotFoundError when no ConsentDocument was found.
+func (cdq *ConsentDocumentQuery) First(ctx context.Context) (*ConsentDocument, error) {
+	nodes, err := cdq.Limit(1).All(setContextOp(ctx, cdq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{consentdocument.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cdq *ConsentDocumentQuery) FirstX(ctx context.Context) *ConsentDocument {
+	node, err := cdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ConsentDocument ID from the query.
+// Returns a *NotFoundError when no ConsentDocument ID was found.
+func (cdq *ConsentDocumentQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cdq.Limit(1).IDs(setContextOp(ctx, cdq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{consentdocument.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cdq *ConsentDocumentQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := cdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ConsentDocument entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ConsentDocument entity is found.
+// Returns a *NotFoundError when no ConsentDocument entities are found.
+func (cdq *ConsentDocumentQuery) Only(ctx context.Context) (*ConsentDocument, error) {
+	nodes, err := cdq.Limit(2).All(setContextOp(ctx, cdq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{consentdocument.Label}
+	default:
+		return nil, &NotSingularError{consentdocument.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cdq *ConsentDocumentQuery) OnlyX(ctx context.Context) *ConsentDocument {
+	node, err := cdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ConsentDocument ID in the query.
+// Returns a *NotSingularError when more than one ConsentDocument ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cdq *ConsentDocumentQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cdq.Limit(2).IDs(setContextOp(ctx, cdq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{consentdocument.Label}
+	default:
+		err = &NotSingularError{consentdocument.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cdq *ConsentDocumentQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := cdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ConsentDocuments.
+func (cdq *ConsentDocumentQuery) All(ctx context.Context) ([]*ConsentDocument, error) {
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryAll)
+	if err := cdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ConsentDocument, *ConsentDocumentQuery]()
+	return withInterceptors[[]*ConsentDocument](ctx, cdq, qr, cdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cdq *ConsentDocumentQuery) AllX(ctx context.Context) []*ConsentDocument {
+	nodes, err := cdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ConsentDocument IDs.
+func (cdq *ConsentDocumentQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if cdq.ctx.Unique == nil && cdq.path != nil {
+		cdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryIDs)
+	if err = cdq.Select(consentdocument.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cdq *ConsentDocumentQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := cdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cdq *ConsentDocumentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryCount)
+	if err := cdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cdq, querierCount[*ConsentDocumentQuery](), cdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cdq *ConsentDocumentQuery) CountX(ctx context.Context) int {
+	count, err := cdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cdq *ConsentDocumentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryExist)
+	switch _, err := cdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cdq *ConsentDocumentQuery) ExistX(ctx context.Context) bool {
+	exist, err := cdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ConsentDocumentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cdq *ConsentDocumentQuery) Clone() *ConsentDocumentQuery {
+	if cdq == nil {
+		return nil
+	}
+	return &ConsentDocumentQuery{
+		config:       cdq.config,
+		ctx:          cdq.ctx.Clone(),
+		order:        append([]consentdocument.OrderOption{}, cdq.order...),
+		inters:       append([]Interceptor{}, cdq.inters...),
+		predicates:   append([]predicate.ConsentDocument{}, cdq.predicates...),
+		withConsents: cdq.withConsents.Clone(),
+		// clone intermediate query.
+		sql:  cdq.sql.Clone(),
+		path: cdq.path,
+	}
+}
+
+// WithConsents tells the query-builder to eager-load the nodes that are connected to
+// the "consents" edge. The optional arguments are used to configure the query builder of the edge.
+func (cdq *ConsentDocumentQuery) WithConsents(opts ...func(*UserConsentQuery)) *ConsentDocumentQuery {
+	query := (&UserConsentClient{config: cdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cdq.withConsents = query
+	return cdq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Kind consentdocument.Kind `json:"kind,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ConsentDocument.Query().
+//		GroupBy(consentdocument.FieldKind).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cdq *ConsentDocumentQuery) GroupBy(field string, fields ...string) *ConsentDocumentGroupBy {
+	cdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ConsentDocumentGroupBy{build: cdq}
+	grbuild.flds = &cdq.ctx.Fields
+	grbuild.label = consentdocument.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Kind consentdocument.Kind `json:"kind,omitempty"`
+//	}
+//
+//	client.ConsentDocument.Query().
+//		Select(consentdocument.FieldKind).
+//		Scan(ctx, &v)
+func (cdq *ConsentDocumentQuery) Select(fields ...string) *ConsentDocumentSelect {
+	cdq.ctx.Fields = append(cdq.ctx.Fields, fields...)
+	sbuild := &ConsentDocumentSelect{ConsentDocumentQuery: cdq}
+	sbuild.label = consentdocument.Label
+	sbuild.flds, sbuild.scan = &cdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ConsentDocumentSelect configured with the given aggregations.
+func (cdq *ConsentDocumentQuery) Aggregate(fns ...AggregateFunc) *ConsentDocumentSelect {
+	return cdq.Select().Aggregate(fns...)
+}
+
+func (cdq *ConsentDocumentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cdq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cdq.ctx.Fields {
+		if !consentdocument.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cdq.path != nil {
+		prev, err := cdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cdq.sql = prev
+	}
+	return nil
+}
+
+func (cdq *ConsentDocumentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ConsentDocument, error) {
+	var (
+		nodes       = []*ConsentDocument{}
+		_spec       = cdq.querySpec()
+		loadedTypes = [1]bool{
+			cdq.withConsents != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ConsentDocument).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ConsentDocument{config: cdq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cdq.withConsents; query != nil {
+		if err := cdq.loadConsents(ctx, query, nodes,
+			func(n *ConsentDocument) { n.Edges.Consents = []*UserConsent{} },
+			func(n *ConsentDocument, e *UserConsent) { n.Edges.Consents = append(n.Edges.Consents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cdq *ConsentDocumentQuery) loadConsents(ctx context.Context, query *UserConsentQuery, nodes []*ConsentDocument, init func(*ConsentDocument), assign func(*ConsentDocument, *UserConsent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ConsentDocument)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(userconsent.FieldDocumentID)
+	}
+	query.Where(predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(consentdocument.ConsentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DocumentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "document_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (cdq *ConsentDocumentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cdq.querySpec()
+	_spec.Node.Columns = cdq.ctx.Fields
+	if len(cdq.ctx.Fields) > 0 {
+		_spec.Unique = cdq.ctx.Unique != nil && *cdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cdq.driver, _spec)
+}
+
+func (cdq *ConsentDocumentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(consentdocument.Table, consentdocument.Columns, sqlgraph.NewFieldSpec(consentdocument.FieldID, field.TypeUUID))
+	_spec.From = cdq.sql
+	if unique := cdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consentdocument.FieldID)
+		for i := range fields {
+			if fields[i] != consentdocument.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cdq *ConsentDocumentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cdq.driver.Dialect())
+	t1 := builder.Table(consentdocument.Table)
+	columns := cdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = consentdocument.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cdq.sql != nil {
+		selector = cdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cdq.ctx.Unique != nil && *cdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cdq.predicates {
+		p(selector)
+	}
+	for _, p := range cdq.order {
+		p(selector)
+	}
+	if offset := cdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ConsentDocumentGroupBy is the group-by builder for ConsentDocument entities.
+type ConsentDocumentGroupBy struct {
+	selector
+	build *ConsentDocumentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cdgb *ConsentDocumentGroupBy) Aggregate(fns ...AggregateFunc) *ConsentDocumentGroupBy {
+	cdgb.fns = append(cdgb.fns, fns...)
+	return cdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cdgb *ConsentDocumentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cdgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConsentDocumentQuery, *ConsentDocumentGroupBy](ctx, cdgb.build, cdgb, cdgb.build.inters, v)
+}
+
+func (cdgb *ConsentDocumentGroupBy) sqlScan(ctx context.Context, root *ConsentDocumentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cdgb.fns))
+	for _, fn := range cdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cdgb.flds)+len(cdgb.fns))
+		for _, f := range *cdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ConsentDocumentSelect is the builder for selecting fields of ConsentDocument entities.
+type ConsentDocumentSelect struct {
+	*ConsentDocumentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cds *ConsentDocumentSelect) Aggregate(fns ...AggregateFunc) *ConsentDocumentSelect {
+	cds.fns = append(cds.fns, fns...)
+	return cds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cds *ConsentDocumentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cds.ctx, ent.OpQuerySelect)
+	if err := cds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConsentDocumentQuery, *ConsentDocumentSelect](ctx, cds.ConsentDocumentQuery, cds, cds.inters, v)
+}
+
+func (cds *ConsentDocumentSelect) sqlScan(ctx context.Context, root *ConsentDocumentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cds.fns))
+	for _, fn := range cds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
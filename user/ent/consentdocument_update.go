@@ -0,0 +1,339 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/consentdocument"
+	"mandacode.com/accounts/user/ent/predicate"
+	"mandacode.com/accounts/user/ent/userconsent"
+)
+
+// ConsentDocumentUpdate is the builder for updating ConsentDocument entities.
+type ConsentDocumentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConsentDocumentMutation
+}
+
+// Where appends a list predicates to the ConsentDocumentUpdate builder.
+func (cdu *ConsentDocumentUpdate) Where(ps ...predicate.ConsentDocument) *ConsentDocumentUpdate {
+	cdu.mutation.Where(ps...)
+	return cdu
+}
+
+// AddConsentIDs adds the "consents" edge to the UserConsent entity by IDs.
+func (cdu *ConsentDocumentUpdate) AddConsentIDs(ids ...uuid.UUID) *ConsentDocumentUpdate {
+	cdu.mutation.AddConsentIDs(ids...)
+	return cdu
+}
+
+// AddConsents adds the "consents" edges to the UserConsent entity.
+func (cdu *ConsentDocumentUpdate) AddConsents(u ...*UserConsent) *ConsentDocumentUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cdu.AddConsentIDs(ids...)
+}
+
+// Mutation returns the ConsentDocumentMutation object of the builder.
+func (cdu *ConsentDocumentUpdate) Mutation() *ConsentDocumentMutation {
+	return cdu.mutation
+}
+
+// ClearConsents clears all "consents" edges to the UserConsent entity.
+func (cdu *ConsentDocumentUpdate) ClearConsents() *ConsentDocumentUpdate {
+	cdu.mutation.ClearConsents()
+	return cdu
+}
+
+// RemoveConsentIDs removes the "consents" edge to UserConsent entities by IDs.
+func (cdu *ConsentDocumentUpdate) RemoveConsentIDs(ids ...uuid.UUID) *ConsentDocumentUpdate {
+	cdu.mutation.RemoveConsentIDs(ids...)
+	return cdu
+}
+
+// RemoveConsents removes "consents" edges to UserConsent entities.
+func (cdu *ConsentDocumentUpdate) RemoveConsents(u ...*UserConsent) *ConsentDocumentUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cdu.RemoveConsentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cdu *ConsentDocumentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cdu.sqlSave, cdu.mutation, cdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdu *ConsentDocumentUpdate) SaveX(ctx context.Context) int {
+	affected, err := cdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cdu *ConsentDocumentUpdate) Exec(ctx context.Context) error {
+	_, err := cdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdu *ConsentDocumentUpdate) ExecX(ctx context.Context) {
+	if err := cdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cdu *ConsentDocumentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(consentdocument.Table, consentdocument.Columns, sqlgraph.NewFieldSpec(consentdocument.FieldID, field.TypeUUID))
+	if ps := cdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if cdu.mutation.ConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   consentdocument.ConsentsTable,
+			Columns: []string{consentdocument.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userconsent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cdu.mutation.RemovedConsentsIDs(); len(nodes) > 0 && !cdu.mutation.ConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   consentdocument.ConsentsTable,
+			Columns: []string{consentdocument.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userconsent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cdu.mutation.ConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   consentdocument.ConsentsTable,
+			Columns: []string{consentdocument.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userconsent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consentdocument.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cdu.mutation.done = true
+	return n, nil
+}
+
+// ConsentDocumentUpdateOne is the builder for updating a single ConsentDocument entity.
+type ConsentDocumentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConsentDocumentMutation
+}
+
+// AddConsentIDs adds the "consents" edge to the UserConsent entity by IDs.
+func (cduo *ConsentDocumentUpdateOne) AddConsentIDs(ids ...uuid.UUID) *ConsentDocumentUpdateOne {
+	cduo.mutation.AddConsentIDs(ids...)
+	return cduo
+}
+
+// AddConsents adds the "consents" edges to the UserConsent entity.
+func (cduo *ConsentDocumentUpdateOne) AddConsents(u ...*UserConsent) *ConsentDocumentUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cduo.AddConsentIDs(ids...)
+}
+
+// Mutation returns the ConsentDocumentMutation object of the builder.
+func (cduo *ConsentDocumentUpdateOne) Mutation() *ConsentDocumentMutation {
+	return cduo.mutation
+}
+
+// ClearConsents clears all "consents" edges to the UserConsent entity.
+func (cduo *ConsentDocumentUpdateOne) ClearConsents() *ConsentDocumentUpdateOne {
+	cduo.mutation.ClearConsents()
+	return cduo
+}
+
+// RemoveConsentIDs removes the "consents" edge to UserConsent entities by IDs.
+func (cduo *ConsentDocumentUpdateOne) RemoveConsentIDs(ids ...uuid.UUID) *ConsentDocumentUpdateOne {
+	cduo.mutation.RemoveConsentIDs(ids...)
+	return cduo
+}
+
+// RemoveConsents removes "consents" edges to UserConsent entities.
+func (cduo *ConsentDocumentUpdateOne) RemoveConsents(u ...*UserConsent) *ConsentDocumentUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cduo.RemoveConsentIDs(ids...)
+}
+
+// Where appends a list predicates to the ConsentDocumentUpdate builder.
+func (cduo *ConsentDocumentUpdateOne) Where(ps ...predicate.ConsentDocument) *ConsentDocumentUpdateOne {
+	cduo.mutation.Where(ps...)
+	return cduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cduo *ConsentDocumentUpdateOne) Select(field string, fields ...string) *ConsentDocumentUpdateOne {
+	cduo.fields = append([]string{field}, fields...)
+	return cduo
+}
+
+// Save executes the query and returns the updated ConsentDocument entity.
+func (cduo *ConsentDocumentUpdateOne) Save(ctx context.Context) (*ConsentDocument, error) {
+	return withHooks(ctx, cduo.sqlSave, cduo.mutation, cduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cduo *ConsentDocumentUpdateOne) SaveX(ctx context.Context) *ConsentDocument {
+	node, err := cduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cduo *ConsentDocumentUpdateOne) Exec(ctx context.Context) error {
+	_, err := cduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cduo *ConsentDocumentUpdateOne) ExecX(ctx context.Context) {
+	if err := cduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cduo *ConsentDocumentUpdateOne) sqlSave(ctx context.Context) (_node *ConsentDocument, err error) {
+	_spec := sqlgraph.NewUpdateSpec(consentdocument.Table, consentdocument.Columns, sqlgraph.NewFieldSpec(consentdocument.FieldID, field.TypeUUID))
+	id, ok := cduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConsentDocument.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consentdocument.FieldID)
+		for _, f := range fields {
+			if !consentdocument.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != consentdocument.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if cduo.mutation.ConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   consentdocument.ConsentsTable,
+			Columns: []string{consentdocument.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userconsent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cduo.mutation.RemovedConsentsIDs(); len(nodes) > 0 && !cduo.mutation.ConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   consentdocument.ConsentsTable,
+			Columns: []string{consentdocument.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userconsent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cduo.mutation.ConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   consentdocument.ConsentsTable,
+			Columns: []string{consentdocument.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userconsent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ConsentDocument{config: cduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consentdocument.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/dataexport"
+)
+
+// DataExportCreate is the builder for creating a DataExport entity.
+type DataExportCreate struct {
+	config
+	mutation *DataExportMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (dec *DataExportCreate) SetUserID(u uuid.UUID) *DataExportCreate {
+	dec.mutation.SetUserID(u)
+	return dec
+}
+
+// SetStatus sets the "status" field.
+func (dec *DataExportCreate) SetStatus(d dataexport.Status) *DataExportCreate {
+	dec.mutation.SetStatus(d)
+	return dec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableStatus(d *dataexport.Status) *DataExportCreate {
+	if d != nil {
+		dec.SetStatus(*d)
+	}
+	return dec
+}
+
+// SetObjectKey sets the "object_key" field.
+func (dec *DataExportCreate) SetObjectKey(s string) *DataExportCreate {
+	dec.mutation.SetObjectKey(s)
+	return dec
+}
+
+// SetNillableObjectKey sets the "object_key" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableObjectKey(s *string) *DataExportCreate {
+	if s != nil {
+		dec.SetObjectKey(*s)
+	}
+	return dec
+}
+
+// SetDownloadTokenHash sets the "download_token_hash" field.
+func (dec *DataExportCreate) SetDownloadTokenHash(s string) *DataExportCreate {
+	dec.mutation.SetDownloadTokenHash(s)
+	return dec
+}
+
+// SetNillableDownloadTokenHash sets the "download_token_hash" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableDownloadTokenHash(s *string) *DataExportCreate {
+	if s != nil {
+		dec.SetDownloadTokenHash(*s)
+	}
+	return dec
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (dec *DataExportCreate) SetExpiresAt(t time.Time) *DataExportCreate {
+	dec.mutation.SetExpiresAt(t)
+	return dec
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableExpiresAt(t *time.Time) *DataExportCreate {
+	if t != nil {
+		dec.SetExpiresAt(*t)
+	}
+	return dec
+}
+
+// SetAttempts sets the "attempts" field.
+func (dec *DataExportCreate) SetAttempts(i int) *DataExportCreate {
+	dec.mutation.SetAttempts(i)
+	return dec
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableAttempts(i *int) *DataExportCreate {
+	if i != nil {
+		dec.SetAttempts(*i)
+	}
+	return dec
+}
+
+// SetLastError sets the "last_error" field.
+func (dec *DataExportCreate) SetLastError(s string) *DataExportCreate {
+	dec.mutation.SetLastError(s)
+	return dec
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableLastError(s *string) *DataExportCreate {
+	if s != nil {
+		dec.SetLastError(*s)
+	}
+	return dec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dec *DataExportCreate) SetCreatedAt(t time.Time) *DataExportCreate {
+	dec.mutation.SetCreatedAt(t)
+	return dec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableCreatedAt(t *time.Time) *DataExportCreate {
+	if t != nil {
+		dec.SetCreatedAt(*t)
+	}
+	return dec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dec *DataExportCreate) SetUpdatedAt(t time.Time) *DataExportCreate {
+	dec.mutation.SetUpdatedAt(t)
+	return dec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableUpdatedAt(t *time.Time) *DataExportCreate {
+	if t != nil {
+		dec.SetUpdatedAt(*t)
+	}
+	return dec
+}
+
+// SetID sets the "id" field.
+func (dec *DataExportCreate) SetID(u uuid.UUID) *DataExportCreate {
+	dec.mutation.SetID(u)
+	return dec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableID(u *uuid.UUID) *DataExportCreate {
+	if u != nil {
+		dec.SetID(*u)
+	}
+	return dec
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (dec *DataExportCreate) Mutation() *DataExportMutation {
+	return dec.mutation
+}
+
+// Save creates the DataExport in the database.
+func (dec *DataExportCreate) Save(ctx context.Context) (*DataExport, error) {
+	dec.defaults()
+	return withHooks(ctx, dec.sqlSave, dec.mutation, dec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dec *DataExportCreate) SaveX(ctx context.Context) *DataExport {
+	v, err := dec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dec *DataExportCreate) Exec(ctx context.Context) error {
+	_, err := dec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dec *DataExportCreate) ExecX(ctx context.Context) {
+	if err := dec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dec *DataExportCreate) defaults() {
+	if _, ok := dec.mutation.Status(); !ok {
+		v := dataexport.DefaultStatus
+		dec.mutation.SetStatus(v)
+	}
+	if _, ok := dec.mutation.Attempts(); !ok {
+		v := dataexport.DefaultAttempts
+		dec.mutation.SetAttempts(v)
+	}
+	if _, ok := dec.mutation.CreatedAt(); !ok {
+		v := dataexport.DefaultCreatedAt()
+		dec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dec.mutation.UpdatedAt(); !ok {
+		v := dataexport.DefaultUpdatedAt()
+		dec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dec.mutation.ID(); !ok {
+		v := dataexport.DefaultID()
+		dec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dec *DataExportCreate) check() error {
+	if _, ok := dec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "DataExport.user_id"`)}
+	}
+	if _, ok := dec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DataExport.status"`)}
+	}
+	if v, ok := dec.mutation.Status(); ok {
+		if err := dataexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DataExport.status": %w`, err)}
+		}
+	}
+	if _, ok := dec.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "DataExport.attempts"`)}
+	}
+	if _, ok := dec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DataExport.created_at"`)}
+	}
+	if _, ok := dec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DataExport.updated_at"`)}
+	}
+	return nil
+}
+
+func (dec *DataExportCreate) sqlSave(ctx context.Context) (*DataExport, error) {
+	if err := dec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dec.mutation.id = &_node.ID
+	dec.mutation.done = true
+	return _node, nil
+}
+
+func (dec *DataExportCreate) createSpec() (*DataExport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DataExport{config: dec.config}
+		_spec = sqlgraph.NewCreateSpec(dataexport.Table, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeUUID))
+	)
+	if id, ok := dec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dec.mutation.UserID(); ok {
+		_spec.SetField(dataexport.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := dec.mutation.Status(); ok {
+		_spec.SetField(dataexport.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := dec.mutation.ObjectKey(); ok {
+		_spec.SetField(dataexport.FieldObjectKey, field.TypeString, value)
+		_node.ObjectKey = &value
+	}
+	if value, ok := dec.mutation.DownloadTokenHash(); ok {
+		_spec.SetField(dataexport.FieldDownloadTokenHash, field.TypeString, value)
+		_node.DownloadTokenHash = &value
+	}
+	if value, ok := dec.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := dec.mutation.Attempts(); ok {
+		_spec.SetField(dataexport.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := dec.mutation.LastError(); ok {
+		_spec.SetField(dataexport.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := dec.mutation.CreatedAt(); ok {
+		_spec.SetField(dataexport.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dec.mutation.UpdatedAt(); ok {
+		_spec.SetField(dataexport.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// DataExportCreateBulk is the builder for creating many DataExport entities in bulk.
+type DataExportCreateBulk struct {
+	config
+	err      error
+	builders []*DataExportCreate
+}
+
+// Save creates the DataExport entities in the database.
+func (decb *DataExportCreateBulk) Save(ctx context.Context) ([]*DataExport, error) {
+	if decb.err != nil {
+		return nil, decb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(decb.builders))
+	nodes := make([]*DataExport, len(decb.builders))
+	mutators := make([]Mutator, len(decb.builders))
+	for i := range decb.builders {
+		func(i int, root context.Context) {
+			builder := decb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DataExportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, decb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, decb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, decb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (decb *DataExportCreateBulk) SaveX(ctx context.Context) []*DataExport {
+	v, err := decb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (decb *DataExportCreateBulk) Exec(ctx context.Context) error {
+	_, err := decb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (decb *DataExportCreateBulk) ExecX(ctx context.Context) {
+	if err := decb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/emailchange"
+	"mandacode.com/accounts/user/ent/user"
+)
+
+// EmailChange is the model entity for the EmailChange schema.
+type EmailChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique identifier for the email change. This is a UUID that is generated when the change is requested.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Unique identifier for the user changing their email address.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Email address of the user when the change was requested. It is notified of the change, with a link undoing it.
+	OldEmail string `json:"old_email,omitempty"`
+	// Email address the user changes to. It receives the link confirming the change.
+	NewEmail string `json:"new_email,omitempty"`
+	// Status of the change.
+	Status emailchange.Status `json:"status,omitempty"`
+	// SHA-256 hash of the token in the undo link, set when the change is confirmed. The token itself is only sent by email.
+	UndoTokenHash *string `json:"-"`
+	// Timestamp after which the change can no longer be undone.
+	UndoExpiresAt *time.Time `json:"undo_expires_at,omitempty"`
+	// Timestamp when the change was requested.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Timestamp when the change was last updated.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EmailChangeQuery when eager-loading is set.
+	Edges        EmailChangeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EmailChangeEdges holds the relations/edges for other nodes in the graph.
+type EmailChangeEdges struct {
+	// Edge to the User entity changing their email address.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmailChangeEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailchange.FieldOldEmail, emailchange.FieldNewEmail, emailchange.FieldStatus, emailchange.FieldUndoTokenHash:
+			values[i] = new(sql.NullString)
+		case emailchange.FieldUndoExpiresAt, emailchange.FieldCreatedAt, emailchange.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case emailchange.FieldID, emailchange.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailChange fields.
+func (ec *EmailChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailchange.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ec.ID = *value
+			}
+		case emailchange.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				ec.UserID = *value
+			}
+		case emailchange.FieldOldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field old_email", values[i])
+			} else if value.Valid {
+				ec.OldEmail = value.String
+			}
+		case emailchange.FieldNewEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_email", values[i])
+			} else if value.Valid {
+				ec.NewEmail = value.String
+			}
+		case emailchange.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ec.Status = emailchange.Status(value.String)
+			}
+		case emailchange.FieldUndoTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field undo_token_hash", values[i])
+			} else if value.Valid {
+				ec.UndoTokenHash = new(string)
+				*ec.UndoTokenHash = value.String
+			}
+		case emailchange.FieldUndoExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field undo_expires_at", values[i])
+			} else if value.Valid {
+				ec.UndoExpiresAt = new(time.Time)
+				*ec.UndoExpiresAt = value.Time
+			}
+		case emailchange.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ec.CreatedAt = value.Time
+			}
+		case emailchange.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ec.UpdatedAt = value.Time
+			}
+		default:
+			ec.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmailChange.
+// This includes values selected through modifiers, order, etc.
+func (ec *EmailChange) Value(name string) (ent.Value, error) {
+	return ec.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the EmailChange entity.
+func (ec *EmailChange) QueryUser() *UserQuery {
+	return NewEmailChangeClient(ec.config).QueryUser(ec)
+}
+
+// Update returns a builder for updating this EmailChange.
+// Note that you need to call EmailChange.Unwrap() before calling this method if this EmailChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ec *EmailChange) Update() *EmailChangeUpdateOne {
+	return NewEmailChangeClient(ec.config).UpdateOne(ec)
+}
+
+// Unwrap unwraps the EmailChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ec *EmailChange) Unwrap() *EmailChange {
+	_tx, ok := ec.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmailChange is not a transactional entity")
+	}
+	ec.config.driver = _tx.drv
+	return ec
+}
+
+// String implements the fmt.Stringer.
+func (ec *EmailChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ec.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ec.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("old_email=")
+	builder.WriteString(ec.OldEmail)
+	builder.WriteString(", ")
+	builder.WriteString("new_email=")
+	builder.WriteString(ec.NewEmail)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ec.Status))
+	builder.WriteString(", ")
+	builder.WriteString("undo_token_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := ec.UndoExpiresAt; v != nil {
+		builder.WriteString("undo_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ec.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ec.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailChanges is a parsable slice of EmailChange.
+type EmailChanges []*EmailChange
@@ -0,0 +1,505 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emailchange
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldUserID, v))
+}
+
+// OldEmail applies equality check predicate on the "old_email" field. It's identical to OldEmailEQ.
+func OldEmail(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldOldEmail, v))
+}
+
+// NewEmail applies equality check predicate on the "new_email" field. It's identical to NewEmailEQ.
+func NewEmail(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldNewEmail, v))
+}
+
+// UndoTokenHash applies equality check predicate on the "undo_token_hash" field. It's identical to UndoTokenHashEQ.
+func UndoTokenHash(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldUndoTokenHash, v))
+}
+
+// UndoExpiresAt applies equality check predicate on the "undo_expires_at" field. It's identical to UndoExpiresAtEQ.
+func UndoExpiresAt(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldUndoExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// OldEmailEQ applies the EQ predicate on the "old_email" field.
+func OldEmailEQ(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldOldEmail, v))
+}
+
+// OldEmailNEQ applies the NEQ predicate on the "old_email" field.
+func OldEmailNEQ(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNEQ(FieldOldEmail, v))
+}
+
+// OldEmailIn applies the In predicate on the "old_email" field.
+func OldEmailIn(vs ...string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldIn(FieldOldEmail, vs...))
+}
+
+// OldEmailNotIn applies the NotIn predicate on the "old_email" field.
+func OldEmailNotIn(vs ...string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNotIn(FieldOldEmail, vs...))
+}
+
+// OldEmailGT applies the GT predicate on the "old_email" field.
+func OldEmailGT(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldGT(FieldOldEmail, v))
+}
+
+// OldEmailGTE applies the GTE predicate on the "old_email" field.
+func OldEmailGTE(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldGTE(FieldOldEmail, v))
+}
+
+// OldEmailLT applies the LT predicate on the "old_email" field.
+func OldEmailLT(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldLT(FieldOldEmail, v))
+}
+
+// OldEmailLTE applies the LTE predicate on the "old_email" field.
+func OldEmailLTE(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldLTE(FieldOldEmail, v))
+}
+
+// OldEmailContains applies the Contains predicate on the "old_email" field.
+func OldEmailContains(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldContains(FieldOldEmail, v))
+}
+
+// OldEmailHasPrefix applies the HasPrefix predicate on the "old_email" field.
+func OldEmailHasPrefix(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldHasPrefix(FieldOldEmail, v))
+}
+
+// OldEmailHasSuffix applies the HasSuffix predicate on the "old_email" field.
+func OldEmailHasSuffix(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldHasSuffix(FieldOldEmail, v))
+}
+
+// OldEmailEqualFold applies the EqualFold predicate on the "old_email" field.
+func OldEmailEqualFold(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEqualFold(FieldOldEmail, v))
+}
+
+// OldEmailContainsFold applies the ContainsFold predicate on the "old_email" field.
+func OldEmailContainsFold(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldContainsFold(FieldOldEmail, v))
+}
+
+// NewEmailEQ applies the EQ predicate on the "new_email" field.
+func NewEmailEQ(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldNewEmail, v))
+}
+
+// NewEmailNEQ applies the NEQ predicate on the "new_email" field.
+func NewEmailNEQ(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNEQ(FieldNewEmail, v))
+}
+
+// NewEmailIn applies the In predicate on the "new_email" field.
+func NewEmailIn(vs ...string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldIn(FieldNewEmail, vs...))
+}
+
+// NewEmailNotIn applies the NotIn predicate on the "new_email" field.
+func NewEmailNotIn(vs ...string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNotIn(FieldNewEmail, vs...))
+}
+
+// NewEmailGT applies the GT predicate on the "new_email" field.
+func NewEmailGT(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldGT(FieldNewEmail, v))
+}
+
+// NewEmailGTE applies the GTE predicate on the "new_email" field.
+func NewEmailGTE(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldGTE(FieldNewEmail, v))
+}
+
+// NewEmailLT applies the LT predicate on the "new_email" field.
+func NewEmailLT(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldLT(FieldNewEmail, v))
+}
+
+// NewEmailLTE applies the LTE predicate on the "new_email" field.
+func NewEmailLTE(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldLTE(FieldNewEmail, v))
+}
+
+// NewEmailContains applies the Contains predicate on the "new_email" field.
+func NewEmailContains(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldContains(FieldNewEmail, v))
+}
+
+// NewEmailHasPrefix applies the HasPrefix predicate on the "new_email" field.
+func NewEmailHasPrefix(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldHasPrefix(FieldNewEmail, v))
+}
+
+// NewEmailHasSuffix applies the HasSuffix predicate on the "new_email" field.
+func NewEmailHasSuffix(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldHasSuffix(FieldNewEmail, v))
+}
+
+// NewEmailEqualFold applies the EqualFold predicate on the "new_email" field.
+func NewEmailEqualFold(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEqualFold(FieldNewEmail, v))
+}
+
+// NewEmailContainsFold applies the ContainsFold predicate on the "new_email" field.
+func NewEmailContainsFold(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldContainsFold(FieldNewEmail, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// UndoTokenHashEQ applies the EQ predicate on the "undo_token_hash" field.
+func UndoTokenHashEQ(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldUndoTokenHash, v))
+}
+
+// UndoTokenHashNEQ applies the NEQ predicate on the "undo_token_hash" field.
+func UndoTokenHashNEQ(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNEQ(FieldUndoTokenHash, v))
+}
+
+// UndoTokenHashIn applies the In predicate on the "undo_token_hash" field.
+func UndoTokenHashIn(vs ...string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldIn(FieldUndoTokenHash, vs...))
+}
+
+// UndoTokenHashNotIn applies the NotIn predicate on the "undo_token_hash" field.
+func UndoTokenHashNotIn(vs ...string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNotIn(FieldUndoTokenHash, vs...))
+}
+
+// UndoTokenHashGT applies the GT predicate on the "undo_token_hash" field.
+func UndoTokenHashGT(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldGT(FieldUndoTokenHash, v))
+}
+
+// UndoTokenHashGTE applies the GTE predicate on the "undo_token_hash" field.
+func UndoTokenHashGTE(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldGTE(FieldUndoTokenHash, v))
+}
+
+// UndoTokenHashLT applies the LT predicate on the "undo_token_hash" field.
+func UndoTokenHashLT(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldLT(FieldUndoTokenHash, v))
+}
+
+// UndoTokenHashLTE applies the LTE predicate on the "undo_token_hash" field.
+func UndoTokenHashLTE(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldLTE(FieldUndoTokenHash, v))
+}
+
+// UndoTokenHashContains applies the Contains predicate on the "undo_token_hash" field.
+func UndoTokenHashContains(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldContains(FieldUndoTokenHash, v))
+}
+
+// UndoTokenHashHasPrefix applies the HasPrefix predicate on the "undo_token_hash" field.
+func UndoTokenHashHasPrefix(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldHasPrefix(FieldUndoTokenHash, v))
+}
+
+// UndoTokenHashHasSuffix applies the HasSuffix predicate on the "undo_token_hash" field.
+func UndoTokenHashHasSuffix(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldHasSuffix(FieldUndoTokenHash, v))
+}
+
+// UndoTokenHashIsNil applies the IsNil predicate on the "undo_token_hash" field.
+func UndoTokenHashIsNil() predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldIsNull(FieldUndoTokenHash))
+}
+
+// UndoTokenHashNotNil applies the NotNil predicate on the "undo_token_hash" field.
+func UndoTokenHashNotNil() predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNotNull(FieldUndoTokenHash))
+}
+
+// UndoTokenHashEqualFold applies the EqualFold predicate on the "undo_token_hash" field.
+func UndoTokenHashEqualFold(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEqualFold(FieldUndoTokenHash, v))
+}
+
+// UndoTokenHashContainsFold applies the ContainsFold predicate on the "undo_token_hash" field.
+func UndoTokenHashContainsFold(v string) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldContainsFold(FieldUndoTokenHash, v))
+}
+
+// UndoExpiresAtEQ applies the EQ predicate on the "undo_expires_at" field.
+func UndoExpiresAtEQ(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldUndoExpiresAt, v))
+}
+
+// UndoExpiresAtNEQ applies the NEQ predicate on the "undo_expires_at" field.
+func UndoExpiresAtNEQ(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNEQ(FieldUndoExpiresAt, v))
+}
+
+// UndoExpiresAtIn applies the In predicate on the "undo_expires_at" field.
+func UndoExpiresAtIn(vs ...time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldIn(FieldUndoExpiresAt, vs...))
+}
+
+// UndoExpiresAtNotIn applies the NotIn predicate on the "undo_expires_at" field.
+func UndoExpiresAtNotIn(vs ...time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNotIn(FieldUndoExpiresAt, vs...))
+}
+
+// UndoExpiresAtGT applies the GT predicate on the "undo_expires_at" field.
+func UndoExpiresAtGT(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldGT(FieldUndoExpiresAt, v))
+}
+
+// UndoExpiresAtGTE applies the GTE predicate on the "undo_expires_at" field.
+func UndoExpiresAtGTE(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldGTE(FieldUndoExpiresAt, v))
+}
+
+// UndoExpiresAtLT applies the LT predicate on the "undo_expires_at" field.
+func UndoExpiresAtLT(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldLT(FieldUndoExpiresAt, v))
+}
+
+// UndoExpiresAtLTE applies the LTE predicate on the "undo_expires_at" field.
+func UndoExpiresAtLTE(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldLTE(FieldUndoExpiresAt, v))
+}
+
+// UndoExpiresAtIsNil applies the IsNil predicate on the "undo_expires_at" field.
+func UndoExpiresAtIsNil() predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldIsNull(FieldUndoExpiresAt))
+}
+
+// UndoExpiresAtNotNil applies the NotNil predicate on the "undo_expires_at" field.
+func UndoExpiresAtNotNil() predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNotNull(FieldUndoExpiresAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EmailChange {
+	return predicate.EmailChange(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.EmailChange {
+	return predicate.EmailChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.EmailChange {
+	return predicate.EmailChange(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmailChange) predicate.EmailChange {
+	return predicate.EmailChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmailChange) predicate.EmailChange {
+	return predicate.EmailChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmailChange) predicate.EmailChange {
+	return predicate.EmailChange(sql.NotPredicates(p))
+}
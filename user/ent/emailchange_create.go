@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/emailchange"
+	"mandacode.com/accounts/user/ent/user"
+)
+
+// EmailChangeCreate is the builder for creating a EmailChange entity.
+type EmailChangeCreate struct {
+	config
+	mutation *EmailChangeMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (ecc *EmailChangeCreate) SetUserID(u uuid.UUID) *EmailChangeCreate {
+	ecc.mutation.SetUserID(u)
+	return ecc
+}
+
+// SetOldEmail sets the "old_email" field.
+func (ecc *EmailChangeCreate) SetOldEmail(s string) *EmailChangeCreate {
+	ecc.mutation.SetOldEmail(s)
+	return ecc
+}
+
+// SetNewEmail sets the "new_email" field.
+func (ecc *EmailChangeCreate) SetNewEmail(s string) *EmailChangeCreate {
+	ecc.mutation.SetNewEmail(s)
+	return ecc
+}
+
+// SetStatus sets the "status" field.
+func (ecc *EmailChangeCreate) SetStatus(e emailchange.Status) *EmailChangeCreate {
+	ecc.mutation.SetStatus(e)
+	return ecc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ecc *EmailChangeCreate) SetNillableStatus(e *emailchange.Status) *EmailChangeCreate {
+	if e != nil {
+		ecc.SetStatus(*e)
+	}
+	return ecc
+}
+
+// SetUndoTokenHash sets the "undo_token_hash" field.
+func (ecc *EmailChangeCreate) SetUndoTokenHash(s string) *EmailChangeCreate {
+	ecc.mutation.SetUndoTokenHash(s)
+	return ecc
+}
+
+// SetNillableUndoTokenHash sets the "undo_token_hash" field if the given value is not nil.
+func (ecc *EmailChangeCreate) SetNillableUndoTokenHash(s *string) *EmailChangeCreate {
+	if s != nil {
+		ecc.SetUndoTokenHash(*s)
+	}
+	return ecc
+}
+
+// SetUndoExpiresAt sets the "undo_expires_at" field.
+func (ecc *EmailChangeCreate) SetUndoExpiresAt(t time.Time) *EmailChangeCreate {
+	ecc.mutation.SetUndoExpiresAt(t)
+	return ecc
+}
+
+// SetNillableUndoExpiresAt sets the "undo_expires_at" field if the given value is not nil.
+func (ecc *EmailChangeCreate) SetNillableUndoExpiresAt(t *time.Time) *EmailChangeCreate {
+	if t != nil {
+		ecc.SetUndoExpiresAt(*t)
+	}
+	return ecc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ecc *EmailChangeCreate) SetCreatedAt(t time.Time) *EmailChangeCreate {
+	ecc.mutation.SetCreatedAt(t)
+	return ecc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ecc *EmailChangeCreate) SetNillableCreatedAt(t *time.Time) *EmailChangeCreate {
+	if t != nil {
+		ecc.SetCreatedAt(*t)
+	}
+	return ecc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ecc *EmailChangeCreate) SetUpdatedAt(t time.Time) *EmailChangeCreate {
+	ecc.mutation.SetUpdatedAt(t)
+	return ecc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ecc *EmailChangeCreate) SetNillableUpdatedAt(t *time.Time) *EmailChangeCreate {
+	if t != nil {
+		ecc.SetUpdatedAt(*t)
+	}
+	return ecc
+}
+
+// SetID sets the "id" field.
+func (ecc *EmailChangeCreate) SetID(u uuid.UUID) *EmailChangeCreate {
+	ecc.mutation.SetID(u)
+	return ecc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ecc *EmailChangeCreate) SetNillableID(u *uuid.UUID) *EmailChangeCreate {
+	if u != nil {
+		ecc.SetID(*u)
+	}
+	return ecc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ecc *EmailChangeCreate) SetUser(u *User) *EmailChangeCreate {
+	return ecc.SetUserID(u.ID)
+}
+
+// Mutation returns the EmailChangeMutation object of the builder.
+func (ecc *EmailChangeCreate) Mutation() *EmailChangeMutation {
+	return ecc.mutation
+}
+
+// Save creates the EmailChange in the database.
+func (ecc *EmailChangeCreate) Save(ctx context.Context) (*EmailChange, error) {
+	ecc.defaults()
+	return withHooks(ctx, ecc.sqlSave, ecc.mutation, ecc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ecc *EmailChangeCreate) SaveX(ctx context.Context) *EmailChange {
+	v, err := ecc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ecc *EmailChangeCreate) Exec(ctx context.Context) error {
+	_, err := ecc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecc *EmailChangeCreate) ExecX(ctx context.Context) {
+	if err := ecc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ecc *EmailChangeCreate) defaults() {
+	if _, ok := ecc.mutation.Status(); !ok {
+		v := emailchange.DefaultStatus
+		ecc.mutation.SetStatus(v)
+	}
+	if _, ok := ecc.mutation.CreatedAt(); !ok {
+		v := emailchange.DefaultCreatedAt()
+		ecc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ecc.mutation.UpdatedAt(); !ok {
+		v := emailchange.DefaultUpdatedAt()
+		ecc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ecc.mutation.ID(); !ok {
+		v := emailchange.DefaultID()
+		ecc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ecc *EmailChangeCreate) check() error {
+	if _, ok := ecc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "EmailChange.user_id"`)}
+	}
+	if _, ok := ecc.mutation.OldEmail(); !ok {
+		return &ValidationError{Name: "old_email", err: errors.New(`ent: missing required field "EmailChange.old_email"`)}
+	}
+	if v, ok := ecc.mutation.OldEmail(); ok {
+		if err := emailchange.OldEmailValidator(v); err != nil {
+			return &ValidationError{Name: "old_email", err: fmt.Errorf(`ent: validator failed for field "EmailChange.old_email": %w`, err)}
+		}
+	}
+	if _, ok := ecc.mutation.NewEmail(); !ok {
+		return &ValidationError{Name: "new_email", err: errors.New(`ent: missing required field "EmailChange.new_email"`)}
+	}
+	if v, ok := ecc.mutation.NewEmail(); ok {
+		if err := emailchange.NewEmailValidator(v); err != nil {
+			return &ValidationError{Name: "new_email", err: fmt.Errorf(`ent: validator failed for field "EmailChange.new_email": %w`, err)}
+		}
+	}
+	if _, ok := ecc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "EmailChange.status"`)}
+	}
+	if v, ok := ecc.mutation.Status(); ok {
+		if err := emailchange.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailChange.status": %w`, err)}
+		}
+	}
+	if _, ok := ecc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmailChange.created_at"`)}
+	}
+	if _, ok := ecc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EmailChange.updated_at"`)}
+	}
+	if len(ecc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "EmailChange.user"`)}
+	}
+	return nil
+}
+
+func (ecc *EmailChangeCreate) sqlSave(ctx context.Context) (*EmailChange, error) {
+	if err := ecc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ecc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ecc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ecc.mutation.id = &_node.ID
+	ecc.mutation.done = true
+	return _node, nil
+}
+
+func (ecc *EmailChangeCreate) createSpec() (*EmailChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailChange{config: ecc.config}
+		_spec = sqlgraph.NewCreateSpec(emailchange.Table, sqlgraph.NewFieldSpec(emailchange.FieldID, field.TypeUUID))
+	)
+	if id, ok := ecc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ecc.mutation.OldEmail(); ok {
+		_spec.SetField(emailchange.FieldOldEmail, field.TypeString, value)
+		_node.OldEmail = value
+	}
+	if value, ok := ecc.mutation.NewEmail(); ok {
+		_spec.SetField(emailchange.FieldNewEmail, field.TypeString, value)
+		_node.NewEmail = value
+	}
+	if value, ok := ecc.mutation.Status(); ok {
+		_spec.SetField(emailchange.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ecc.mutation.UndoTokenHash(); ok {
+		_spec.SetField(emailchange.FieldUndoTokenHash, field.TypeString, value)
+		_node.UndoTokenHash = &value
+	}
+	if value, ok := ecc.mutation.UndoExpiresAt(); ok {
+		_spec.SetField(emailchange.FieldUndoExpiresAt, field.TypeTime, value)
+		_node.UndoExpiresAt = &value
+	}
+	if value, ok := ecc.mutation.CreatedAt(); ok {
+		_spec.SetField(emailchange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ecc.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailchange.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := ecc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailchange.UserTable,
+			Columns: []string{emailchange.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EmailChangeCreateBulk is the builder for creating many EmailChange entities in bulk.
+type EmailChangeCreateBulk struct {
+	config
+	err      error
+	builders []*EmailChangeCreate
+}
+
+// Save creates the EmailChange entities in the database.
+func (eccb *EmailChangeCreateBulk) Save(ctx context.Context) ([]*EmailChange, error) {
+	if eccb.err != nil {
+		return nil, eccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(eccb.builders))
+	nodes := make([]*EmailChange, len(eccb.builders))
+	mutators := make([]Mutator, len(eccb.builders))
+	for i := range eccb.builders {
+		func(i int, root context.Context) {
+			builder := eccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, eccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, eccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, eccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eccb *EmailChangeCreateBulk) SaveX(ctx context.Context) []*EmailChange {
+	v, err := eccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eccb *EmailChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := eccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eccb *EmailChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := eccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
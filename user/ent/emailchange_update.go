@@ -0,0 +1,382 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"mandacode.com/accounts/user/ent/emailchange"
+	"mandacode.com/accounts/user/ent/predicate"
+)
+
+// EmailChangeUpdate is the builder for updating EmailChange entities.
+type EmailChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailChangeMutation
+}
+
+// Where appends a list predicates to the EmailChangeUpdate builder.
+func (ecu *EmailChangeUpdate) Where(ps ...predicate.EmailChange) *EmailChangeUpdate {
+	ecu.mutation.Where(ps...)
+	return ecu
+}
+
+// SetStatus sets the "status" field.
+func (ecu *EmailChangeUpdate) SetStatus(e emailchange.Status) *EmailChangeUpdate {
+	ecu.mutation.SetStatus(e)
+	return ecu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ecu *EmailChangeUpdate) SetNillableStatus(e *emailchange.Status) *EmailChangeUpdate {
+	if e != nil {
+		ecu.SetStatus(*e)
+	}
+	return ecu
+}
+
+// SetUndoTokenHash sets the "undo_token_hash" field.
+func (ecu *EmailChangeUpdate) SetUndoTokenHash(s string) *EmailChangeUpdate {
+	ecu.mutation.SetUndoTokenHash(s)
+	return ecu
+}
+
+// SetNillableUndoTokenHash sets the "undo_token_hash" field if the given value is not nil.
+func (ecu *EmailChangeUpdate) SetNillableUndoTokenHash(s *string) *EmailChangeUpdate {
+	if s != nil {
+		ecu.SetUndoTokenHash(*s)
+	}
+	return ecu
+}
+
+// ClearUndoTokenHash clears the value of the "undo_token_hash" field.
+func (ecu *EmailChangeUpdate) ClearUndoTokenHash() *EmailChangeUpdate {
+	ecu.mutation.ClearUndoTokenHash()
+	return ecu
+}
+
+// SetUndoExpiresAt sets the "undo_expires_at" field.
+func (ecu *EmailChangeUpdate) SetUndoExpiresAt(t time.Time) *EmailChangeUpdate {
+	ecu.mutation.SetUndoExpiresAt(t)
+	return ecu
+}
+
+// SetNillableUndoExpiresAt sets the "undo_expires_at" field if the given value is not nil.
+func (ecu *EmailChangeUpdate) SetNillableUndoExpiresAt(t *time.Time) *EmailChangeUpdate {
+	if t != nil {
+		ecu.SetUndoExpiresAt(*t)
+	}
+	return ecu
+}
+
+// ClearUndoExpiresAt clears the value of the "undo_expires_at" field.
+func (ecu *EmailChangeUpdate) ClearUndoExpiresAt() *EmailChangeUpdate {
+	ecu.mutation.ClearUndoExpiresAt()
+	return ecu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ecu *EmailChangeUpdate) SetUpdatedAt(t time.Time) *EmailChangeUpdate {
+	ecu.mutation.SetUpdatedAt(t)
+	return ecu
+}
+
+// Mutation returns the EmailChangeMutation object of the builder.
+func (ecu *EmailChangeUpdate) Mutation() *EmailChangeMutation {
+	return ecu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ecu *EmailChangeUpdate) Save(ctx context.Context) (int, error) {
+	ecu.defaults()
+	return withHooks(ctx, ecu.sqlSave, ecu.mutation, ecu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecu *EmailChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := ecu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ecu *EmailChangeUpdate) Exec(ctx context.Context) error {
+	_, err := ecu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecu *EmailChangeUpdate) ExecX(ctx context.Context) {
+	if err := ecu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ecu *EmailChangeUpdate) defaults() {
+	if _, ok := ecu.mutation.UpdatedAt(); !ok {
+		v := emailchange.UpdateDefaultUpdatedAt()
+		ecu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ecu *EmailChangeUpdate) check() error {
+	if v, ok := ecu.mutation.Status(); ok {
+		if err := emailchange.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailChange.status": %w`, err)}
+		}
+	}
+	if ecu.mutation.UserCleared() && len(ecu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailChange.user"`)
+	}
+	return nil
+}
+
+func (ecu *EmailChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ecu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailchange.Table, emailchange.Columns, sqlgraph.NewFieldSpec(emailchange.FieldID, field.TypeUUID))
+	if ps := ecu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ecu.mutation.Status(); ok {
+		_spec.SetField(emailchange.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ecu.mutation.UndoTokenHash(); ok {
+		_spec.SetField(emailchange.FieldUndoTokenHash, field.TypeString, value)
+	}
+	if ecu.mutation.UndoTokenHashCleared() {
+		_spec.ClearField(emailchange.FieldUndoTokenHash, field.TypeString)
+	}
+	if value, ok := ecu.mutation.UndoExpiresAt(); ok {
+		_spec.SetField(emailchange.FieldUndoExpiresAt, field.TypeTime, value)
+	}
+	if ecu.mutation.UndoExpiresAtCleared() {
+		_spec.ClearField(emailchange.FieldUndoExpiresAt, field.TypeTime)
+	}
+	if value, ok := ecu.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailchange.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ecu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ecu.mutation.done = true
+	return n, nil
+}
+
+// EmailChangeUpdateOne is the builder for updating a single EmailChange entity.
+type EmailChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailChangeMutation
+}
+
+// SetStatus sets the "status" field.
+func (ecuo *EmailChangeUpdateOne) SetStatus(e emailchange.Status) *EmailChangeUpdateOne {
+	ecuo.mutation.SetStatus(e)
+	return ecuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ecuo *EmailChangeUpdateOne) SetNillableStatus(e *emailchange.Status) *EmailChangeUpdateOne {
+	if e != nil {
+		ecuo.SetStatus(*e)
+	}
+	return ecuo
+}
+
+// SetUndoTokenHash sets the "undo_token_hash" field.
+func (ecuo *EmailChangeUpdateOne) SetUndoTokenHash(s string) *EmailChangeUpdateOne {
+	ecuo.mutation.SetUndoTokenHash(s)
+	return ecuo
+}
+
+// SetNillableUndoTokenHash sets the "undo_token_hash" field if the given value is not nil.
+func (ecuo *EmailChangeUpdateOne) SetNillableUndoTokenHash(s *string) *EmailChangeUpdateOne {
+	if s != nil {
+		ecuo.SetUndoTokenHash(*s)
+	}
+	return ecuo
+}
+
+// ClearUndoTokenHash clears the value of the "undo_token_hash" field.
+func (ecuo *EmailChangeUpdateOne) ClearUndoTokenHash() *EmailChangeUpdateOne {
+	ecuo.mutation.ClearUndoTokenHash()
+	return ecuo
+}
+
+// SetUndoExpiresAt sets the "undo_expires_at" field.
+func (ecuo *EmailChangeUpdateOne) SetUndoExpiresAt(t time.Time) *EmailChangeUpdateOne {
+	ecuo.mutation.SetUndoExpiresAt(t)
+	return ecuo
+}
+
+// SetNillableUndoExpiresAt sets the "undo_expires_at" field if the given value is not nil.
+func (ecuo *EmailChangeUpdateOne) SetNillableUndoExpiresAt(t *time.Time) *EmailChangeUpdateOne {
+	if t != nil {
+		ecuo.SetUndoExpiresAt(*t)
+	}
+	return ecuo
+}
+
+// ClearUndoExpiresAt clears the value of the "undo_expires_at" field.
+func (ecuo *EmailChangeUpdateOne) ClearUndoExpiresAt() *EmailChangeUpdateOne {
+	ecuo.mutation.ClearUndoExpiresAt()
+	return ecuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ecuo *EmailChangeUpdateOne) SetUpdatedAt(t time.Time) *EmailChangeUpdateOne {
+	ecuo.mutation.SetUpdatedAt(t)
+	return ecuo
+}
+
+// Mutation returns the EmailChangeMutation object of the builder.
+func (ecuo *EmailChangeUpdateOne) Mutation() *EmailChangeMutation {
+	return ecuo.mutation
+}
+
+// Where appends a list predicates to the EmailChangeUpdate builder.
+func (ecuo *EmailChangeUpdateOne) Where(ps ...predicate.EmailChange) *EmailChangeUpdateOne {
+	ecuo.mutation.Where(ps...)
+	return ecuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ecuo *EmailChangeUpdateOne) Select(field string, fields ...string) *EmailChangeUpdateOne {
+	ecuo.fields = append([]string{field}, fields...)
+	return ecuo
+}
+
+// Save executes the query and returns the updated EmailChange entity.
+func (ecuo *EmailChangeUpdateOne) Save(ctx context.Context) (*EmailChange, error) {
+	ecuo.defaults()
+	return withHooks(ctx, ecuo.sqlSave, ecuo.mutation, ecuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecuo *EmailChangeUpdateOne) SaveX(ctx context.Context) *EmailChange {
+	node, err := ecuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ecuo *EmailChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := ecuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecuo *EmailChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := ecuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ecuo *EmailChangeUpdateOne) defaults() {
+	if _, ok := ecuo.mutation.UpdatedAt(); !ok {
+		v := emailchange.UpdateDefaultUpdatedAt()
+		ecuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ecuo *EmailChangeUpdateOne) check() error {
+	if v, ok := ecuo.mutation.Status(); ok {
+		if err := emailchange.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailChange.status": %w`, err)}
+		}
+	}
+	if ecuo.mutation.UserCleared() && len(ecuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailChange.user"`)
+	}
+	return nil
+}
+
+func (ecuo *EmailChangeUpdateOne) sqlSave(ctx context.Context) (_node *EmailChange, err error) {
+	if err := ecuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailchange.Table, emailchange.Columns, sqlgraph.NewFieldSpec(emailchange.FieldID, field.TypeUUID))
+	id, ok := ecuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ecuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailchange.FieldID)
+		for _, f := range fields {
+			if !emailchange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ecuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ecuo.mutation.Status(); ok {
+		_spec.SetField(emailchange.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ecuo.mutation.UndoTokenHash(); ok {
+		_spec.SetField(emailchange.FieldUndoTokenHash, field.TypeString, value)
+	}
+	if ecuo.mutation.UndoTokenHashCleared() {
+		_spec.ClearField(emailchange.FieldUndoTokenHash, field.TypeString)
+	}
+	if value, ok := ecuo.mutation.UndoExpiresAt(); ok {
+		_spec.SetField(emailchange.FieldUndoExpiresAt, field.TypeTime, value)
+	}
+	if ecuo.mutation.UndoExpiresAtCleared() {
+		_spec.ClearField(emailchange.FieldUndoExpiresAt, field.TypeTime)
+	}
+	if value, ok := ecuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailchange.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &EmailChange{config: ecuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ecuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ecuo.mutation.done = true
+	return _node, nil
+}
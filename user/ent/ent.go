@@ -12,8 +12,21 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"mandacode.com/accounts/user/ent/auditentry"
+	"mandacode.com/accounts/user/ent/consentdocument"
+	"mandacode.com/accounts/user/ent/dataexport"
+	"mandacode.com/accounts/user/ent/emailchange"
+	"mandacode.com/accounts/user/ent/invitation"
+	"mandacode.com/accounts/user/ent/membership"
+	"mandacode.com/accounts/user/ent/organization"
+	"mandacode.com/accounts/user/ent/outboxdeadletter"
+	"mandacode.com/accounts/user/ent/outboxmessage"
+	"mandacode.com/accounts/user/ent/role"
+	"mandacode.com/accounts/user/ent/roleassignment"
 	"mandacode.com/accounts/user/ent/sentemail"
+	"mandacode.com/accounts/user/ent/signupsaga"
 	"mandacode.com/accounts/user/ent/user"
+	"mandacode.com/accounts/user/ent/userconsent"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -74,8 +87,21 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			sentemail.Table: sentemail.ValidColumn,
-			user.Table:      user.ValidColumn,
+			auditentry.Table:       auditentry.ValidColumn,
+			consentdocument.Table:  consentdocument.ValidColumn,
+			dataexport.Table:       dataexport.ValidColumn,
+			emailchange.Table:      emailchange.ValidColumn,
+			invitation.Table:       invitation.ValidColumn,
+			membership.Table:       membership.ValidColumn,
+			organization.Table:     organization.ValidColumn,
+			outboxdeadletter.Table: outboxdeadletter.ValidColumn,
+			outboxmessage.Table:    outboxmessage.ValidColumn,
+			role.Table:             role.ValidColumn,
+			roleassignment.Table:   roleassignment.ValidColumn,
+			sentemail.Table:        sentemail.ValidColumn,
+			signupsaga.Table:       signupsaga.ValidColumn,
+			user.Table:             user.ValidColumn,
+			userconsent.Table:      userconsent.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,31 @@
+-- Create "roles" table
+CREATE TABLE "public"."roles" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "description" character varying NULL,
+  "permissions" jsonb NOT NULL,
+  "is_system" boolean NOT NULL DEFAULT false,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "roles_name_key" to table: "roles"
+CREATE UNIQUE INDEX "roles_name_key" ON "public"."roles" ("name");
+-- Create "role_assignments" table
+CREATE TABLE "public"."role_assignments" (
+  "id" uuid NOT NULL,
+  "assigned_by" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  "role_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "role_assignments_roles_assignments" FOREIGN KEY ("role_id") REFERENCES "public"."roles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "role_assignments_users_role_assignments" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "roleassignment_user_id_role_id" to table: "role_assignments"
+CREATE UNIQUE INDEX "roleassignment_user_id_role_id" ON "public"."role_assignments" ("user_id", "role_id");
+-- Seed the system roles
+INSERT INTO "public"."roles" ("id", "name", "description", "permissions", "is_system", "created_at", "updated_at") VALUES
+  ('00000000-0000-0000-0000-000000000001', 'admin', 'Full access to every admin API', '["*"]', true, now(), now()),
+  ('00000000-0000-0000-0000-000000000002', 'support', 'Support staff, who can look up, block and restore users', '["users.read", "users.manage", "profiles.read"]', true, now(), now()),
+  ('00000000-0000-0000-0000-000000000003', 'user', 'Implicitly held by every user', '[]', true, now(), now());
@@ -0,0 +1,45 @@
+-- Create "organizations" table
+CREATE TABLE "public"."organizations" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "slug" character varying NOT NULL,
+  "created_by" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "organizations_slug_key" to table: "organizations"
+CREATE UNIQUE INDEX "organizations_slug_key" ON "public"."organizations" ("slug");
+-- Create "memberships" table
+CREATE TABLE "public"."memberships" (
+  "id" uuid NOT NULL,
+  "role" character varying NOT NULL DEFAULT 'member',
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "organization_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "memberships_organizations_memberships" FOREIGN KEY ("organization_id") REFERENCES "public"."organizations" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "memberships_users_memberships" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "membership_organization_id_user_id" to table: "memberships"
+CREATE UNIQUE INDEX "membership_organization_id_user_id" ON "public"."memberships" ("organization_id", "user_id");
+-- Create index "membership_user_id" to table: "memberships"
+CREATE INDEX "membership_user_id" ON "public"."memberships" ("user_id");
+-- Create "invitations" table
+CREATE TABLE "public"."invitations" (
+  "id" uuid NOT NULL,
+  "email" character varying NOT NULL,
+  "role" character varying NOT NULL DEFAULT 'member',
+  "token_hash" character varying NOT NULL,
+  "invited_by" uuid NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "organization_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "invitations_organizations_invitations" FOREIGN KEY ("organization_id") REFERENCES "public"."organizations" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "invitation_organization_id" to table: "invitations"
+CREATE INDEX "invitation_organization_id" ON "public"."invitations" ("organization_id");
+-- Create index "invitations_token_hash_key" to table: "invitations"
+CREATE UNIQUE INDEX "invitations_token_hash_key" ON "public"."invitations" ("token_hash");
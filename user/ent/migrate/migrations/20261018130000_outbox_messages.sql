@@ -0,0 +1,13 @@
+-- Create "outbox_messages" table
+CREATE TABLE "public"."outbox_messages" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "topic" character varying NOT NULL,
+  "key" bytea NOT NULL,
+  "value" bytea NOT NULL,
+  "headers" jsonb NULL,
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "last_error" character varying NULL,
+  "next_attempt_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
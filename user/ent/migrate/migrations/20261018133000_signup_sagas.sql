@@ -0,0 +1,18 @@
+-- Create "signup_sagas" table
+CREATE TABLE "public"."signup_sagas" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "kind" character varying NOT NULL,
+  "step" character varying NOT NULL DEFAULT 'user_created',
+  "status" character varying NOT NULL DEFAULT 'running',
+  "email" character varying NULL,
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "last_error" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "signup_sagas_user_id_key" to table: "signup_sagas"
+CREATE UNIQUE INDEX "signup_sagas_user_id_key" ON "public"."signup_sagas" ("user_id");
+-- Create index "signupsaga_status_updated_at" to table: "signup_sagas"
+CREATE INDEX "signupsaga_status_updated_at" ON "public"."signup_sagas" ("status", "updated_at");
@@ -0,0 +1,27 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "email" character varying NULL;
+-- Backfill the email address of existing users from the last verification mail sent to them
+UPDATE "public"."users" SET "email" = (
+  SELECT "email" FROM "public"."sent_emails"
+  WHERE "sent_emails"."user_id" = "users"."id"
+  ORDER BY "sent_at" DESC
+  LIMIT 1
+);
+-- Create "email_changes" table
+CREATE TABLE "public"."email_changes" (
+  "id" uuid NOT NULL,
+  "old_email" character varying NOT NULL,
+  "new_email" character varying NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "undo_token_hash" character varying NULL,
+  "undo_expires_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "email_changes_users_email_changes" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "email_changes_undo_token_hash_key" to table: "email_changes"
+CREATE UNIQUE INDEX "email_changes_undo_token_hash_key" ON "public"."email_changes" ("undo_token_hash");
+-- Create index "emailchange_user_id" to table: "email_changes"
+CREATE INDEX "emailchange_user_id" ON "public"."email_changes" ("user_id");
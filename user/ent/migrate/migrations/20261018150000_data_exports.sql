@@ -0,0 +1,20 @@
+-- Create "data_exports" table
+CREATE TABLE "public"."data_exports" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "object_key" character varying NULL,
+  "download_token_hash" character varying NULL,
+  "expires_at" timestamptz NULL,
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "last_error" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "data_exports_download_token_hash_key" to table: "data_exports"
+CREATE UNIQUE INDEX "data_exports_download_token_hash_key" ON "public"."data_exports" ("download_token_hash");
+-- Create index "dataexport_user_id" to table: "data_exports"
+CREATE INDEX "dataexport_user_id" ON "public"."data_exports" ("user_id");
+-- Create index "dataexport_status_created_at" to table: "data_exports"
+CREATE INDEX "dataexport_status_created_at" ON "public"."data_exports" ("status", "created_at");
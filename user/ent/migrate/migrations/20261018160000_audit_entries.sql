@@ -0,0 +1,40 @@
+-- Create "audit_entries" table
+CREATE TABLE "public"."audit_entries" (
+  "id" uuid NOT NULL,
+  "seq" bigint NOT NULL,
+  "service" character varying NOT NULL,
+  "actor_type" character varying NOT NULL,
+  "actor_id" character varying NOT NULL DEFAULT '',
+  "action" character varying NOT NULL,
+  "target_type" character varying NOT NULL,
+  "target_id" character varying NOT NULL,
+  "before" jsonb NULL,
+  "after" jsonb NULL,
+  "request_id" character varying NOT NULL DEFAULT '',
+  "ip" character varying NOT NULL DEFAULT '',
+  "occurred_at" timestamptz NOT NULL,
+  "recorded_at" timestamptz NOT NULL,
+  "prev_hash" character varying NOT NULL,
+  "hash" character varying NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "audit_entries_seq_key" to table: "audit_entries"
+CREATE UNIQUE INDEX "audit_entries_seq_key" ON "public"."audit_entries" ("seq");
+-- Create index "auditentry_actor_id" to table: "audit_entries"
+CREATE INDEX "auditentry_actor_id" ON "public"."audit_entries" ("actor_id");
+-- Create index "auditentry_action" to table: "audit_entries"
+CREATE INDEX "auditentry_action" ON "public"."audit_entries" ("action");
+-- Create index "auditentry_target_type_target_id" to table: "audit_entries"
+CREATE INDEX "auditentry_target_type_target_id" ON "public"."audit_entries" ("target_type", "target_id");
+-- Create index "auditentry_occurred_at" to table: "audit_entries"
+CREATE INDEX "auditentry_occurred_at" ON "public"."audit_entries" ("occurred_at");
+-- Reject changes to recorded audit entries, which are append-only
+CREATE FUNCTION "public"."audit_entries_append_only"() RETURNS trigger LANGUAGE plpgsql AS $$
+BEGIN
+  RAISE EXCEPTION 'audit_entries is append-only';
+END;
+$$;
+-- Create trigger "audit_entries_no_update_delete" to table: "audit_entries"
+CREATE TRIGGER "audit_entries_no_update_delete" BEFORE UPDATE OR DELETE ON "public"."audit_entries" FOR EACH ROW EXECUTE FUNCTION "public"."audit_entries_append_only"();
+-- Create trigger "audit_entries_no_truncate" to table: "audit_entries"
+CREATE TRIGGER "audit_entries_no_truncate" BEFORE TRUNCATE ON "public"."audit_entries" FOR EACH STATEMENT EXECUTE FUNCTION "public"."audit_entries_append_only"();
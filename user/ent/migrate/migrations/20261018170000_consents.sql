@@ -0,0 +1,37 @@
+-- Create "consent_documents" table
+CREATE TABLE "public"."consent_documents" (
+  "id" uuid NOT NULL,
+  "kind" character varying NOT NULL,
+  "version" bigint NOT NULL,
+  "title" character varying NOT NULL,
+  "content_url" character varying NOT NULL,
+  "mandatory" boolean NOT NULL,
+  "published_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "consentdocument_kind_version" to table: "consent_documents"
+CREATE UNIQUE INDEX "consentdocument_kind_version" ON "public"."consent_documents" ("kind", "version");
+-- Create "user_consents" table
+CREATE TABLE "public"."user_consents" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "accepted" boolean NOT NULL,
+  "ip" character varying NOT NULL DEFAULT '',
+  "user_agent" character varying NOT NULL DEFAULT '',
+  "recorded_at" timestamptz NOT NULL,
+  "document_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "user_consents_consent_documents_consents" FOREIGN KEY ("document_id") REFERENCES "public"."consent_documents" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "userconsent_user_id_recorded_at" to table: "user_consents"
+CREATE INDEX "userconsent_user_id_recorded_at" ON "public"."user_consents" ("user_id", "recorded_at");
+-- Reject changes to published documents and recorded consents, which are append-only
+CREATE FUNCTION "public"."consents_append_only"() RETURNS trigger LANGUAGE plpgsql AS $$
+BEGIN
+  RAISE EXCEPTION '% is append-only', TG_TABLE_NAME;
+END;
+$$;
+-- Create trigger "consent_documents_no_update_delete" to table: "consent_documents"
+CREATE TRIGGER "consent_documents_no_update_delete" BEFORE UPDATE OR DELETE ON "public"."consent_documents" FOR EACH ROW EXECUTE FUNCTION "public"."consents_append_only"();
+-- Create trigger "user_consents_no_update_delete" to table: "user_consents"
+CREATE TRIGGER "user_consents_no_update_delete" BEFORE UPDATE OR DELETE ON "public"."user_consents" FOR EACH ROW EXECUTE FUNCTION "public"."consents_append_only"();
@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "birth_date" date NULL, ADD COLUMN "is_minor" boolean NOT NULL DEFAULT false, ADD COLUMN "guardian_email" character varying NULL, ADD COLUMN "guardian_consent" character varying NOT NULL DEFAULT 'not_required', ADD COLUMN "guardian_consented_at" timestamptz NULL;
@@ -0,0 +1,19 @@
+-- Create index "outboxmessage_topic_key" to table: "outbox_messages"
+CREATE INDEX "outboxmessage_topic_key" ON "public"."outbox_messages" ("topic", "key");
+-- Create "outbox_dead_letters" table
+CREATE TABLE "public"."outbox_dead_letters" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "topic" character varying NOT NULL,
+  "key" bytea NOT NULL,
+  "value" bytea NOT NULL,
+  "headers" jsonb NULL,
+  "attempts" bigint NOT NULL,
+  "last_error" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "dead_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "outboxdeadletter_topic" to table: "outbox_dead_letters"
+CREATE INDEX "outboxdeadletter_topic" ON "public"."outbox_dead_letters" ("topic");
+-- Create index "outboxdeadletter_dead_at" to table: "outbox_dead_letters"
+CREATE INDEX "outboxdeadletter_dead_at" ON "public"."outbox_dead_letters" ("dead_at");
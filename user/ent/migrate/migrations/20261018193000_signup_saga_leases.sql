@@ -0,0 +1,7 @@
+-- Modify "signup_sagas" table
+ALTER TABLE "public"."signup_sagas" ADD COLUMN "lease_id" uuid NULL, ADD COLUMN "leased_until" timestamptz NULL;
+-- Running signups keep the recovery delay they had, which was counted from their last update
+UPDATE "public"."signup_sagas" SET "lease_id" = gen_random_uuid(), "leased_until" = "updated_at" + interval '5 minutes';
+ALTER TABLE "public"."signup_sagas" ALTER COLUMN "lease_id" SET NOT NULL, ALTER COLUMN "leased_until" SET NOT NULL;
+-- Create index "signupsaga_status_leased_until" to table: "signup_sagas"
+CREATE INDEX "signupsaga_status_leased_until" ON "public"."signup_sagas" ("status", "leased_until");
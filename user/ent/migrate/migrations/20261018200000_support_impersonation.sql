@@ -0,0 +1,2 @@
+-- Let the support staff impersonate users, which auth checks before issuing an impersonation token
+UPDATE "public"."roles" SET "permissions" = "permissions" || '["users.impersonate"]', "description" = 'Support staff, who can look up, block, restore and impersonate users', "updated_at" = now() WHERE "id" = '00000000-0000-0000-0000-000000000002';
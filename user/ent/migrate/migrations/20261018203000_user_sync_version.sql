@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "sync_version" bigint NOT NULL DEFAULT 1;
@@ -8,6 +8,334 @@ import (
 )
 
 var (
+	// AuditEntriesColumns holds the columns for the "audit_entries" table.
+	AuditEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "seq", Type: field.TypeInt64, Unique: true},
+		{Name: "service", Type: field.TypeString},
+		{Name: "actor_type", Type: field.TypeString},
+		{Name: "actor_id", Type: field.TypeString, Default: ""},
+		{Name: "action", Type: field.TypeString},
+		{Name: "target_type", Type: field.TypeString},
+		{Name: "target_id", Type: field.TypeString},
+		{Name: "before", Type: field.TypeJSON, Nullable: true},
+		{Name: "after", Type: field.TypeJSON, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Default: ""},
+		{Name: "ip", Type: field.TypeString, Default: ""},
+		{Name: "occurred_at", Type: field.TypeTime},
+		{Name: "recorded_at", Type: field.TypeTime},
+		{Name: "prev_hash", Type: field.TypeString},
+		{Name: "hash", Type: field.TypeString},
+	}
+	// AuditEntriesTable holds the schema information for the "audit_entries" table.
+	AuditEntriesTable = &schema.Table{
+		Name:       "audit_entries",
+		Columns:    AuditEntriesColumns,
+		PrimaryKey: []*schema.Column{AuditEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditentry_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[4]},
+			},
+			{
+				Name:    "auditentry_action",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[5]},
+			},
+			{
+				Name:    "auditentry_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[6], AuditEntriesColumns[7]},
+			},
+			{
+				Name:    "auditentry_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[12]},
+			},
+		},
+	}
+	// ConsentDocumentsColumns holds the columns for the "consent_documents" table.
+	ConsentDocumentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"terms_of_service", "privacy_policy", "marketing"}},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "title", Type: field.TypeString},
+		{Name: "content_url", Type: field.TypeString},
+		{Name: "mandatory", Type: field.TypeBool},
+		{Name: "published_at", Type: field.TypeTime},
+	}
+	// ConsentDocumentsTable holds the schema information for the "consent_documents" table.
+	ConsentDocumentsTable = &schema.Table{
+		Name:       "consent_documents",
+		Columns:    ConsentDocumentsColumns,
+		PrimaryKey: []*schema.Column{ConsentDocumentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "consentdocument_kind_version",
+				Unique:  true,
+				Columns: []*schema.Column{ConsentDocumentsColumns[1], ConsentDocumentsColumns[2]},
+			},
+		},
+	}
+	// DataExportsColumns holds the columns for the "data_exports" table.
+	DataExportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "completed", "failed", "expired"}, Default: "pending"},
+		{Name: "object_key", Type: field.TypeString, Nullable: true},
+		{Name: "download_token_hash", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DataExportsTable holds the schema information for the "data_exports" table.
+	DataExportsTable = &schema.Table{
+		Name:       "data_exports",
+		Columns:    DataExportsColumns,
+		PrimaryKey: []*schema.Column{DataExportsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dataexport_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{DataExportsColumns[1]},
+			},
+			{
+				Name:    "dataexport_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DataExportsColumns[2], DataExportsColumns[8]},
+			},
+		},
+	}
+	// EmailChangesColumns holds the columns for the "email_changes" table.
+	EmailChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "old_email", Type: field.TypeString},
+		{Name: "new_email", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "confirmed", "undone"}, Default: "pending"},
+		{Name: "undo_token_hash", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "undo_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// EmailChangesTable holds the schema information for the "email_changes" table.
+	EmailChangesTable = &schema.Table{
+		Name:       "email_changes",
+		Columns:    EmailChangesColumns,
+		PrimaryKey: []*schema.Column{EmailChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "email_changes_users_email_changes",
+				Columns:    []*schema.Column{EmailChangesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "emailchange_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{EmailChangesColumns[8]},
+			},
+		},
+	}
+	// InvitationsColumns holds the columns for the "invitations" table.
+	InvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "admin", "member"}, Default: "member"},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "invited_by", Type: field.TypeUUID},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeUUID},
+	}
+	// InvitationsTable holds the schema information for the "invitations" table.
+	InvitationsTable = &schema.Table{
+		Name:       "invitations",
+		Columns:    InvitationsColumns,
+		PrimaryKey: []*schema.Column{InvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invitations_organizations_invitations",
+				Columns:    []*schema.Column{InvitationsColumns[7]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invitation_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvitationsColumns[7]},
+			},
+		},
+	}
+	// MembershipsColumns holds the columns for the "memberships" table.
+	MembershipsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "admin", "member"}, Default: "member"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// MembershipsTable holds the schema information for the "memberships" table.
+	MembershipsTable = &schema.Table{
+		Name:       "memberships",
+		Columns:    MembershipsColumns,
+		PrimaryKey: []*schema.Column{MembershipsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "memberships_organizations_memberships",
+				Columns:    []*schema.Column{MembershipsColumns[4]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "memberships_users_memberships",
+				Columns:    []*schema.Column{MembershipsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "membership_organization_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{MembershipsColumns[4], MembershipsColumns[5]},
+			},
+			{
+				Name:    "membership_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{MembershipsColumns[5]},
+			},
+		},
+	}
+	// OrganizationsColumns holds the columns for the "organizations" table.
+	OrganizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "created_by", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// OrganizationsTable holds the schema information for the "organizations" table.
+	OrganizationsTable = &schema.Table{
+		Name:       "organizations",
+		Columns:    OrganizationsColumns,
+		PrimaryKey: []*schema.Column{OrganizationsColumns[0]},
+	}
+	// OutboxDeadLettersColumns holds the columns for the "outbox_dead_letters" table.
+	OutboxDeadLettersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "topic", Type: field.TypeString},
+		{Name: "key", Type: field.TypeBytes},
+		{Name: "value", Type: field.TypeBytes},
+		{Name: "headers", Type: field.TypeJSON, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt},
+		{Name: "last_error", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "dead_at", Type: field.TypeTime},
+	}
+	// OutboxDeadLettersTable holds the schema information for the "outbox_dead_letters" table.
+	OutboxDeadLettersTable = &schema.Table{
+		Name:       "outbox_dead_letters",
+		Columns:    OutboxDeadLettersColumns,
+		PrimaryKey: []*schema.Column{OutboxDeadLettersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxdeadletter_topic",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxDeadLettersColumns[1]},
+			},
+			{
+				Name:    "outboxdeadletter_dead_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxDeadLettersColumns[8]},
+			},
+		},
+	}
+	// OutboxMessagesColumns holds the columns for the "outbox_messages" table.
+	OutboxMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "topic", Type: field.TypeString},
+		{Name: "key", Type: field.TypeBytes},
+		{Name: "value", Type: field.TypeBytes},
+		{Name: "headers", Type: field.TypeJSON, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OutboxMessagesTable holds the schema information for the "outbox_messages" table.
+	OutboxMessagesTable = &schema.Table{
+		Name:       "outbox_messages",
+		Columns:    OutboxMessagesColumns,
+		PrimaryKey: []*schema.Column{OutboxMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxmessage_topic_key",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxMessagesColumns[1], OutboxMessagesColumns[2]},
+			},
+		},
+	}
+	// RolesColumns holds the columns for the "roles" table.
+	RolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "permissions", Type: field.TypeJSON},
+		{Name: "is_system", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RolesTable holds the schema information for the "roles" table.
+	RolesTable = &schema.Table{
+		Name:       "roles",
+		Columns:    RolesColumns,
+		PrimaryKey: []*schema.Column{RolesColumns[0]},
+	}
+	// RoleAssignmentsColumns holds the columns for the "role_assignments" table.
+	RoleAssignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "assigned_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "role_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// RoleAssignmentsTable holds the schema information for the "role_assignments" table.
+	RoleAssignmentsTable = &schema.Table{
+		Name:       "role_assignments",
+		Columns:    RoleAssignmentsColumns,
+		PrimaryKey: []*schema.Column{RoleAssignmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_assignments_roles_assignments",
+				Columns:    []*schema.Column{RoleAssignmentsColumns[3]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "role_assignments_users_role_assignments",
+				Columns:    []*schema.Column{RoleAssignmentsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "roleassignment_user_id_role_id",
+				Unique:  true,
+				Columns: []*schema.Column{RoleAssignmentsColumns[4], RoleAssignmentsColumns[3]},
+			},
+		},
+	}
 	// SentEmailsColumns holds the columns for the "sent_emails" table.
 	SentEmailsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -29,17 +357,57 @@ var (
 			},
 		},
 	}
+	// SignupSagasColumns holds the columns for the "signup_sagas" table.
+	SignupSagasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID, Unique: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"local", "oauth"}},
+		{Name: "step", Type: field.TypeEnum, Enums: []string{"user_created", "auth_created", "profile_created"}, Default: "user_created"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "completed", "compensated"}, Default: "running"},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "lease_id", Type: field.TypeUUID},
+		{Name: "leased_until", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SignupSagasTable holds the schema information for the "signup_sagas" table.
+	SignupSagasTable = &schema.Table{
+		Name:       "signup_sagas",
+		Columns:    SignupSagasColumns,
+		PrimaryKey: []*schema.Column{SignupSagasColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "signupsaga_status_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{SignupSagasColumns[4], SignupSagasColumns[11]},
+			},
+			{
+				Name:    "signupsaga_status_leased_until",
+				Unique:  false,
+				Columns: []*schema.Column{SignupSagasColumns[4], SignupSagasColumns[9]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
 		{Name: "is_blocked", Type: field.TypeBool, Default: false},
 		{Name: "sync_code", Type: field.TypeString, Nullable: true},
+		{Name: "sync_version", Type: field.TypeInt64, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "is_archived", Type: field.TypeBool, Default: false},
 		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
+		{Name: "email", Type: field.TypeString, Nullable: true},
 		{Name: "delete_after", Type: field.TypeTime, Nullable: true},
+		{Name: "birth_date", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "is_minor", Type: field.TypeBool, Default: false},
+		{Name: "guardian_email", Type: field.TypeString, Nullable: true},
+		{Name: "guardian_consent", Type: field.TypeEnum, Enums: []string{"not_required", "pending", "granted"}, Default: "not_required"},
+		{Name: "guardian_consented_at", Type: field.TypeTime, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -47,13 +415,64 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// UserConsentsColumns holds the columns for the "user_consents" table.
+	UserConsentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "accepted", Type: field.TypeBool},
+		{Name: "ip", Type: field.TypeString, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Default: ""},
+		{Name: "recorded_at", Type: field.TypeTime},
+		{Name: "document_id", Type: field.TypeUUID},
+	}
+	// UserConsentsTable holds the schema information for the "user_consents" table.
+	UserConsentsTable = &schema.Table{
+		Name:       "user_consents",
+		Columns:    UserConsentsColumns,
+		PrimaryKey: []*schema.Column{UserConsentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_consents_consent_documents_consents",
+				Columns:    []*schema.Column{UserConsentsColumns[6]},
+				RefColumns: []*schema.Column{ConsentDocumentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userconsent_user_id_recorded_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserConsentsColumns[1], UserConsentsColumns[5]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditEntriesTable,
+		ConsentDocumentsTable,
+		DataExportsTable,
+		EmailChangesTable,
+		InvitationsTable,
+		MembershipsTable,
+		OrganizationsTable,
+		OutboxDeadLettersTable,
+		OutboxMessagesTable,
+		RolesTable,
+		RoleAssignmentsTable,
 		SentEmailsTable,
+		SignupSagasTable,
 		UsersTable,
+		UserConsentsTable,
 	}
 )
 
 func init() {
+	EmailChangesTable.ForeignKeys[0].RefTable = UsersTable
+	InvitationsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	MembershipsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	MembershipsTable.ForeignKeys[1].RefTable = UsersTable
+	RoleAssignmentsTable.ForeignKeys[0].RefTable = RolesTable
+	RoleAssignmentsTable.ForeignKeys[1].RefTable = UsersTable
 	SentEmailsTable.ForeignKeys[0].RefTable = UsersTable
+	UserConsentsTable.ForeignKeys[0].RefTable = ConsentDocumentsTable
 }
@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"mandacode.com/accounts/user/ent/outboxdeadletter"
+)
+
+// OutboxDeadLetter is the model entity for the OutboxDeadLetter schema.
+type OutboxDeadLetter struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Identifier the message had in the outbox.
+	ID int64 `json:"id,omitempty"`
+	// Kafka topic the message was to be published to.
+	Topic string `json:"topic,omitempty"`
+	// Kafka key of the message.
+	Key []byte `json:"key,omitempty"`
+	// Kafka value of the message.
+	Value []byte `json:"value,omitempty"`
+	// Kafka headers of the message.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Number of failed attempts to publish the message before it was given up on.
+	Attempts int `json:"attempts,omitempty"`
+	// Error of the last failed attempt to publish the message.
+	LastError string `json:"last_error,omitempty"`
+	// Timestamp when the message was written to the outbox.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Timestamp when the message was given up on and moved out of the outbox.
+	DeadAt       time.Time `json:"dead_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OutboxDeadLetter) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case outboxdeadletter.FieldKey, outboxdeadletter.FieldValue, outboxdeadletter.FieldHeaders:
+			values[i] = new([]byte)
+		case outboxdeadletter.FieldID, outboxdeadletter.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case outboxdeadletter.FieldTopic, outboxdeadletter.FieldLastError:
+			values[i] = new(sql.NullString)
+		case outboxdeadletter.FieldCreatedAt, outboxdeadletter.FieldDeadAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OutboxDeadLetter fields.
+func (odl *OutboxDeadLetter) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case outboxdeadletter.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			odl.ID = int64(value.Int64)
+		case outboxdeadletter.FieldTopic:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field topic", values[i])
+			} else if value.Valid {
+				odl.Topic = value.String
+			}
+		case outboxdeadletter.FieldKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value != nil {
+				odl.Key = *value
+			}
+		case outboxdeadletter.FieldValue:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value != nil {
+				odl.Value = *value
+			}
+		case outboxdeadletter.FieldHeaders:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field headers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &odl.Headers); err != nil {
+					return fmt.Errorf("unmarshal field headers: %w", err)
+				}
+			}
+		case outboxdeadletter.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				odl.Attempts = int(value.Int64)
+			}
+		case outboxdeadletter.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				odl.LastError = value.String
+			}
+		case outboxdeadletter.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				odl.CreatedAt = value.Time
+			}
+		case outboxdeadletter.FieldDeadAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field dead_at", values[i])
+			} else if value.Valid {
+				odl.DeadAt = value.Time
+			}
+		default:
+			odl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the OutboxDeadLetter.
+// This includes values selected through modifiers, order, etc.
+func (odl *OutboxDeadLetter) GetValue(name string) (ent.Value, error) {
+	return odl.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OutboxDeadLetter.
+// Note that you need to call OutboxDeadLetter.Unwrap() before calling this method if this OutboxDeadLetter
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (odl *OutboxDeadLetter) Update() *OutboxDeadLetterUpdateOne {
+	return NewOutboxDeadLetterClient(odl.config).UpdateOne(odl)
+}
+
+// Unwrap unwraps the OutboxDeadLetter entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (odl *OutboxDeadLetter) Unwrap() *OutboxDeadLetter {
+	_tx, ok := odl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OutboxDeadLetter is not a transactional entity")
+	}
+	odl.config.driver = _tx.drv
+	return odl
+}
+
+// String implements the fmt.Stringer.
+func (odl *OutboxDeadLetter) String() string {
+	var builder strings.Builder
+	builder.WriteString("OutboxDeadLetter(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", odl.ID))
+	builder.WriteString("topic=")
+	builder.WriteString(odl.Topic)
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(fmt.Sprintf("%v", odl.Key))
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", odl.Value))
+	builder.WriteString(", ")
+	builder.WriteString("headers=")
+	builder.WriteString(fmt.Sprintf("%v", odl.Headers))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", odl.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(odl.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(odl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("dead_at=")
+	builder.WriteString(odl.DeadAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OutboxDeadLetters is a parsable slice of OutboxDeadLetter.
+type OutboxDeadLetters []*OutboxDeadLetter
@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"mandacode.com/accounts/user/ent/outboxdeadletter"
+)
+
+// OutboxDeadLetterCreate is the builder for creating a OutboxDeadLetter entity.
+type OutboxDeadLetterCreate struct {
+	config
+	mutation *OutboxDeadLetterMutation
+	hooks    []Hook
+}
+
+// SetTopic sets the "topic" field.
+func (odlc *OutboxDeadLetterCreate) SetTopic(s string) *OutboxDeadLetterCreate {
+	odlc.mutation.SetTopic(s)
+	return odlc
+}
+
+// SetKey sets the "key" field.
+func (odlc *OutboxDeadLetterCreate) SetKey(b []byte) *OutboxDeadLetterCreate {
+	odlc.mutation.SetKey(b)
+	return odlc
+}
+
+// SetValue sets the "value" field.
+func (odlc *OutboxDeadLetterCreate) SetValue(b []byte) *OutboxDeadLetterCreate {
+	odlc.mutation.SetValue(b)
+	return odlc
+}
+
+// SetHeaders sets the "headers" field.
+func (odlc *OutboxDeadLetterCreate) SetHeaders(m map[string]string) *OutboxDeadLetterCreate {
+	odlc.mutation.SetHeaders(m)
+	return odlc
+}
+
+// SetAttempts sets the "attempts" field.
+func (odlc *OutboxDeadLetterCreate) SetAttempts(i int) *OutboxDeadLetterCreate {
+	odlc.mutation.SetAttempts(i)
+	return odlc
+}
+
+// SetLastError sets the "last_error" field.
+func (odlc *OutboxDeadLetterCreate) SetLastError(s string) *OutboxDeadLetterCreate {
+	odlc.mutation.SetLastError(s)
+	return odlc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (odlc *OutboxDeadLetterCreate) SetCreatedAt(t time.Time) *OutboxDeadLetterCreate {
+	odlc.mutation.SetCreatedAt(t)
+	return odlc
+}
+
+// SetDeadAt sets the "dead_at" field.
+func (odlc *OutboxDeadLetterCreate) SetDeadAt(t time.Time) *OutboxDeadLetterCreate {
+	odlc.mutation.SetDeadAt(t)
+	return odlc
+}
+
+// SetNillableDeadAt sets the "dead_at" field if the given value is not nil.
+func (odlc *OutboxDeadLetterCreate) SetNillableDeadAt(t *time.Time) *OutboxDeadLetterCreate {
+	if t != nil {
+		odlc.SetDeadAt(*t)
+	}
+	return odlc
+}
+
+// SetID sets the "id" field.
+func (odlc *OutboxDeadLetterCreate) SetID(i int64) *OutboxDeadLetterCreate {
+	odlc.mutation.SetID(i)
+	return odlc
+}
+
+// Mutation returns the OutboxDeadLetterMutation object of the builder.
+func (odlc *OutboxDeadLetterCreate) Mutation() *OutboxDeadLetterMutation {
+	return odlc.mutation
+}
+
+// Save creates the OutboxDeadLetter in the database.
+func (odlc *OutboxDeadLetterCreate) Save(ctx context.Context) (*OutboxDeadLetter, error) {
+	odlc.defaults()
+	return withHooks(ctx, odlc.sqlSave, odlc.mutation, odlc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (odlc *OutboxDeadLetterCreate) SaveX(ctx context.Context) *OutboxDeadLetter {
+	v, err := odlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (odlc *OutboxDeadLetterCreate) Exec(ctx context.Context) error {
+	_, err := odlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (odlc *OutboxDeadLetterCreate) ExecX(ctx context.Context) {
+	if err := odlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (odlc *OutboxDeadLetterCreate) defaults() {
+	if _, ok := odlc.mutation.DeadAt(); !ok {
+		v := outboxdeadletter.DefaultDeadAt()
+		odlc.mutation.SetDeadAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (odlc *OutboxDeadLetterCreate) check() error {
+	if _, ok := odlc.mutation.Topic(); !ok {
+		return &ValidationError{Name: "topic", err: errors.New(`ent: missing required field "OutboxDeadLetter.topic"`)}
+	}
+	if v, ok := odlc.mutation.Topic(); ok {
+		if err := outboxdeadletter.TopicValidator(v); err != nil {
+			return &ValidationError{Name: "topic", err: fmt.Errorf(`ent: validator failed for field "OutboxDeadLetter.topic": %w`, err)}
+		}
+	}
+	if _, ok := odlc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "OutboxDeadLetter.key"`)}
+	}
+	if _, ok := odlc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "OutboxDeadLetter.value"`)}
+	}
+	if _, ok := odlc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "OutboxDeadLetter.attempts"`)}
+	}
+	if _, ok := odlc.mutation.LastError(); !ok {
+		return &ValidationError{Name: "last_error", err: errors.New(`ent: missing required field "OutboxDeadLetter.last_error"`)}
+	}
+	if _, ok := odlc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OutboxDeadLetter.created_at"`)}
+	}
+	if _, ok := odlc.mutation.DeadAt(); !ok {
+		return &ValidationError{Name: "dead_at", err: errors.New(`ent: missing required field "OutboxDeadLetter.dead_at"`)}
+	}
+	return nil
+}
+
+func (odlc *OutboxDeadLetterCreate) sqlSave(ctx context.Context) (*OutboxDeadLetter, error) {
+	if err := odlc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := odlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, odlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	odlc.mutation.id = &_node.ID
+	odlc.mutation.done = true
+	return _node, nil
+}
+
+func (odlc *OutboxDeadLetterCreate) createSpec() (*OutboxDeadLetter, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OutboxDeadLetter{config: odlc.config}
+		_spec = sqlgraph.NewCreateSpec(outboxdeadletter.Table, sqlgraph.NewFieldSpec(outboxdeadletter.FieldID, field.TypeInt64))
+	)
+	if id, ok := odlc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := odlc.mutation.Topic(); ok {
+		_spec.SetField(outboxdeadletter.FieldTopic, field.TypeString, value)
+		_node.Topic = value
+	}
+	if value, ok := odlc.mutation.Key(); ok {
+		_spec.SetField(outboxdeadletter.FieldKey, field.TypeBytes, value)
+		_node.Key = value
+	}
+	if value, ok := odlc.mutation.Value(); ok {
+		_spec.SetField(outboxdeadletter.FieldValue, field.TypeBytes, value)
+		_node.Value = value
+	}
+	if value, ok := odlc.mutation.Headers(); ok {
+		_spec.SetField(outboxdeadletter.FieldHeaders, field.TypeJSON, value)
+		_node.Headers = value
+	}
+	if value, ok := odlc.mutation.Attempts(); ok {
+		_spec.SetField(outboxdeadletter.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := odlc.mutation.LastError(); ok {
+		_spec.SetField(outboxdeadletter.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := odlc.mutation.CreatedAt(); ok {
+		_spec.SetField(outboxdeadletter.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := odlc.mutation.DeadAt(); ok {
+		_spec.SetField(outboxdeadletter.FieldDeadAt, field.TypeTime, value)
+		_node.DeadAt = value
+	}
+	return _node, _spec
+}
+
+// OutboxDeadLetterCreateBulk is the builder for creating many OutboxDeadLetter entities in bulk.
+type OutboxDeadLetterCreateBulk struct {
+	config
+	err      error
+	builders []*OutboxDeadLetterCreate
+}
+
+// Save creates the OutboxDeadLetter entities in the database.
+func (odlcb *OutboxDeadLetterCreateBulk) Save(ctx context.Context) ([]*OutboxDeadLetter, error) {
+	if odlcb.err != nil {
+		return nil, odlcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(odlcb.builders))
+	nodes := make([]*OutboxDeadLetter, len(odlcb.builders))
+	mutators := make([]Mutator, len(odlcb.builders))
+	for i := range odlcb.builders {
+		func(i int, root context.Context) {
+			builder := odlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OutboxDeadLetterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, odlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, odlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, odlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (odlcb *OutboxDeadLetterCreateBulk) SaveX(ctx context.Context) []*OutboxDeadLetter {
+	v, err := odlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (odlcb *OutboxDeadLetterCreateBulk) Exec(ctx context.Context) error {
+	_, err := odlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (odlcb *OutboxDeadLetterCreateBulk) ExecX(ctx context.Context) {
+	if err := odlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"mandacode.com/accounts/user/ent/outboxdeadletter"
+	"mandacode.com/accounts/user/ent/predicate"
+)
+
+// OutboxDeadLetterDelete is the builder for deleting a OutboxDeadLetter entity.
+type OutboxDeadLetterDelete struct {
+	config
+	hooks    []Hook
+	mutation *OutboxDeadLetterMutation
+}
+
+// Where appends a list predicates to the OutboxDeadLetterDelete builder.
+func (odld *OutboxDeadLetterDelete) Where(ps ...predicate.OutboxDeadLetter) *OutboxDeadLetterDelete {
+	odld.mutation.Where(ps...)
+	return odld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (odld *OutboxDeadLetterDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, odld.sqlExec, odld.mutation, odld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (odld *OutboxDeadLetterDelete) ExecX(ctx context.Context) int {
+	n, err := odld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (odld *OutboxDeadLetterDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(outboxdeadletter.Table, sqlgraph.NewFieldSpec(outboxdeadletter.FieldID, field.TypeInt64))
+	if ps := odld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, odld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	odld.mutation.done = true
+	return affected, err
+}
+
+// OutboxDeadLetterDeleteOne is the builder for deleting a single OutboxDeadLetter entity.
+type OutboxDeadLetterDeleteOne struct {
+	odld *OutboxDeadLetterDelete
+}
+
+// Where appends a list predicates to the OutboxDeadLetterDelete builder.
+func (odldo *OutboxDeadLetterDeleteOne) Where(ps ...predicate.OutboxDeadLetter) *OutboxDeadLetterDeleteOne {
+	odldo.odld.mutation.Where(ps...)
+	return odldo
+}
+
+// Exec executes the deletion query.
+func (odldo *OutboxDeadLetterDeleteOne) Exec(ctx context.Context) error {
+	n, err := odldo.odld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{outboxdeadletter.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (odldo *OutboxDeadLetterDeleteOne) ExecX(ctx context.Context) {
+	if err := odldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
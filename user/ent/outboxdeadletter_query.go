@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"mandacode.com/accounts/user/ent/outboxdeadletter"
+	"mandacode.com/accounts/user/ent/predicate"
+)
+
+// OutboxDeadLetterQuery is the builder for querying OutboxDeadLetter entities.
+type OutboxDeadLetterQuery struct {
+	config
+	ctx        *QueryContext
+	order      []outboxdeadletter.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OutboxDeadLetter
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OutboxDeadLetterQuery builder.
+func (odlq *OutboxDeadLetterQuery) Where(ps ...predicate.OutboxDeadLetter) *OutboxDeadLetterQuery {
+	odlq.predicates = append(odlq.predicates, ps...)
+	return odlq
+}
+
+// Limit the number of records to be returned by this query.
+func (odlq *OutboxDeadLetterQuery) Limit(limit int) *OutboxDeadLetterQuery {
+	odlq.ctx.Limit = &limit
+	return odlq
+}
+
+// Offset to start from.
+func (odlq *OutboxDeadLetterQuery) Offset(offset int) *OutboxDeadLetterQuery {
+	odlq.ctx.Offset = &offset
+	return odlq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (odlq *OutboxDeadLetterQuery) Unique(unique bool) *OutboxDeadLetterQuery {
+	odlq.ctx.Unique = &unique
+	return odlq
+}
+
+// Order specifies how the records should be ordered.
+func (odlq *OutboxDeadLetterQuery) Order(o ...outboxdeadletter.OrderOption) *OutboxDeadLetterQuery {
+	odlq.order = append(odlq.order, o...)
+	return odlq
+}
+
+// First returns the first OutboxDeadLetter entity from the query.
+// Returns a *NotFoundError when no OutboxDeadLetter was found.
+func (odlq *OutboxDeadLetterQuery) First(ctx context.Context) (*OutboxDeadLetter, error) {
+	nodes, err := odlq.Limit(1).All(setContextOp(ctx, odlq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{outboxdeadletter.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (odlq *OutboxDeadLetterQuery) FirstX(ctx context.Context) *OutboxDeadLetter {
+	node, err := odlq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OutboxDeadLetter ID from the query.
+// Returns a *NotFoundError when no OutboxDeadLetter ID was found.
+func (odlq *OutboxDeadLetterQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = odlq.Limit(1).IDs(setContextOp(ctx, odlq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{outboxdeadletter.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (odlq *OutboxDeadLetterQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := odlq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OutboxDeadLetter entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OutboxDeadLetter entity is found.
+// Returns a *NotFoundError when no OutboxDeadLetter entities are found.
+func (odlq *OutboxDeadLetterQuery) Only(ctx context.Context) (*OutboxDeadLetter, error) {
+	nodes, err := odlq.Limit(2).All(setContextOp(ctx, odlq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{outboxdeadletter.Label}
+	default:
+		return nil, &NotSingularError{outboxdeadletter.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (odlq *OutboxDeadLetterQuery) OnlyX(ctx context.Context) *OutboxDeadLetter {
+	node, err := odlq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OutboxDeadLetter ID in the query.
+// Returns a *NotSingularError when more than one OutboxDeadLetter ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (odlq *OutboxDeadLetterQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = odlq.Limit(2).IDs(setContextOp(ctx, odlq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{outboxdeadletter.Label}
+	default:
+		err = &NotSingularError{outboxdeadletter.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (odlq *OutboxDeadLetterQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := odlq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OutboxDeadLetters.
+func (odlq *OutboxDeadLetterQuery) All(ctx context.Context) ([]*OutboxDeadLetter, error) {
+	ctx = setContextOp(ctx, odlq.ctx, ent.OpQueryAll)
+	if err := odlq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OutboxDeadLetter, *OutboxDeadLetterQuery]()
+	return withInterceptors[[]*OutboxDeadLetter](ctx, odlq, qr, odlq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (odlq *OutboxDeadLetterQuery) AllX(ctx context.Context) []*OutboxDeadLetter {
+	nodes, err := odlq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OutboxDeadLetter IDs.
+func (odlq *OutboxDeadLetterQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if odlq.ctx.Unique == nil && odlq.path != nil {
+		odlq.Unique(true)
+	}
+	ctx = setContextOp(ctx, odlq.ctx, ent.OpQueryIDs)
+	if err = odlq.Select(outboxdeadletter.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (odlq *OutboxDeadLetterQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := odlq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (odlq *OutboxDeadLetterQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, odlq.ctx, ent.OpQueryCount)
+	if err := odlq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, odlq, querierCount[*OutboxDeadLetterQuery](), odlq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (odlq *OutboxDeadLetterQuery) CountX(ctx context.Context) int {
+	count, err := odlq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (odlq *OutboxDeadLetterQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, odlq.ctx, ent.OpQueryExist)
+	switch _, err := odlq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (odlq *OutboxDeadLetterQuery) ExistX(ctx context.Context) bool {
+	exist, err := odlq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OutboxDeadLetterQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (odlq *OutboxDeadLetterQuery) Clone() *OutboxDeadLetterQuery {
+	if odlq == nil {
+		return nil
+	}
+	return &OutboxDeadLetterQuery{
+		config:     odlq.config,
+		ctx:        odlq.ctx.Clone(),
+		order:      append([]outboxdeadletter.OrderOption{}, odlq.order...),
+		inters:     append([]Interceptor{}, odlq.inters...),
+		predicates: append([]predicate.OutboxDeadLetter{}, odlq.predicates...),
+		// clone intermediate query.
+		sql:  odlq.sql.Clone(),
+		path: odlq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Topic string `json:"topic,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OutboxDeadLetter.Query().
+//		GroupBy(outboxdeadletter.FieldTopic).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (odlq *OutboxDeadLetterQuery) GroupBy(field string, fields ...string) *OutboxDeadLetterGroupBy {
+	odlq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OutboxDeadLetterGroupBy{build: odlq}
+	grbuild.flds = &odlq.ctx.Fields
+	grbuild.label = outboxdeadletter.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Topic string `json:"topic,omitempty"`
+//	}
+//
+//	client.OutboxDeadLetter.Query().
+//		Select(outboxdeadletter.FieldTopic).
+//		Scan(ctx, &v)
+func (odlq *OutboxDeadLetterQuery) Select(fields ...string) *OutboxDeadLetterSelect {
+	odlq.ctx.Fields = append(odlq.ctx.Fields, fields...)
+	sbuild := &OutboxDeadLetterSelect{OutboxDeadLetterQuery: odlq}
+	sbuild.label = outboxdeadletter.Label
+	sbuild.flds, sbuild.scan = &odlq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OutboxDeadLetterSelect configured with the given aggregations.
+func (odlq *OutboxDeadLetterQuery) Aggregate(fns ...AggregateFunc) *OutboxDeadLetterSelect {
+	return odlq.Select().Aggregate(fns...)
+}
+
+func (odlq *OutboxDeadLetterQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range odlq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, odlq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range odlq.ctx.Fields {
+		if !outboxdeadletter.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if odlq.path != nil {
+		prev, err := odlq.path(ctx)
+		if err != nil {
+			return err
+		}
+		odlq.sql = prev
+	}
+	return nil
+}
+
+func (odlq *OutboxDeadLetterQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OutboxDeadLetter, error) {
+	var (
+		nodes = []*OutboxDeadLetter{}
+		_spec = odlq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OutboxDeadLetter).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OutboxDeadLetter{config: odlq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, odlq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (odlq *OutboxDeadLetterQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := odlq.querySpec()
+	_spec.Node.Columns = odlq.ctx.Fields
+	if len(odlq.ctx.Fields) > 0 {
+		_spec.Unique = odlq.ctx.Unique != nil && *odlq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, odlq.driver, _spec)
+}
+
+func (odlq *OutboxDeadLetterQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(outboxdeadletter.Table, outboxdeadletter.Columns, sqlgraph.NewFieldSpec(outboxdeadletter.FieldID, field.TypeInt64))
+	_spec.From = odlq.sql
+	if unique := odlq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if odlq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := odlq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxdeadletter.FieldID)
+		for i := range fields {
+			if fields[i] != outboxdeadletter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := odlq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := odlq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := odlq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := odlq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (odlq *OutboxDeadLetterQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(odlq.driver.Dialect())
+	t1 := builder.Table(outboxdeadletter.Table)
+	columns := odlq.ctx.Fields
+	if len(columns) == 0 {
+		columns = outboxdeadletter.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if odlq.sql != nil {
+		selector = odlq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if odlq.ctx.Unique != nil && *odlq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range odlq.predicates {
+		p(selector)
+	}
+	for _, p := range odlq.order {
+		p(selector)
+	}
+	if offset := odlq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := odlq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OutboxDeadLetterGroupBy is the group-by builder for OutboxDeadLetter entities.
+type OutboxDeadLetterGroupBy struct {
+	selector
+	build *OutboxDeadLetterQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (odlgb *OutboxDeadLetterGroupBy) Aggregate(fns ...AggregateFunc) *OutboxDeadLetterGroupBy {
+	odlgb.fns = append(odlgb.fns, fns...)
+	return odlgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (odlgb *OutboxDeadLetterGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, odlgb.build.ctx, ent.OpQueryGroupBy)
+	if err := odlgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OutboxDeadLetterQuery, *OutboxDeadLetterGroupBy](ctx, odlgb.build, odlgb, odlgb.build.inters, v)
+}
+
+func (odlgb *OutboxDeadLetterGroupBy) sqlScan(ctx context.Context, root *OutboxDeadLetterQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(odlgb.fns))
+	for _, fn := range odlgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*odlgb.flds)+len(odlgb.fns))
+		for _, f := range *odlgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*odlgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := odlgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OutboxDeadLetterSelect is the builder for selecting fields of OutboxDeadLetter entities.
+type OutboxDeadLetterSelect struct {
+	*OutboxDeadLetterQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (odls *OutboxDeadLetterSelect) Aggregate(fns ...AggregateFunc) *OutboxDeadLetterSelect {
+	odls.fns = append(odls.fns, fns...)
+	return odls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (odls *OutboxDeadLetterSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, odls.ctx, ent.OpQuerySelect)
+	if err := odls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OutboxDeadLetterQuery, *OutboxDeadLetterSelect](ctx, odls.OutboxDeadLetterQuery, odls, odls.inters, v)
+}
+
+func (odls *OutboxDeadLetterSelect) sqlScan(ctx context.Context, root *OutboxDeadLetterQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(odls.fns))
+	for _, fn := range odls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*odls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := odls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
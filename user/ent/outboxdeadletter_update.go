@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"mandacode.com/accounts/user/ent/outboxdeadletter"
+	"mandacode.com/accounts/user/ent/predicate"
+)
+
+// OutboxDeadLetterUpdate is the builder for updating OutboxDeadLetter entities.
+type OutboxDeadLetterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OutboxDeadLetterMutation
+}
+
+// Where appends a list predicates to the OutboxDeadLetterUpdate builder.
+func (odlu *OutboxDeadLetterUpdate) Where(ps ...predicate.OutboxDeadLetter) *OutboxDeadLetterUpdate {
+	odlu.mutation.Where(ps...)
+	return odlu
+}
+
+// Mutation returns the OutboxDeadLetterMutation object of the builder.
+func (odlu *OutboxDeadLetterUpdate) Mutation() *OutboxDeadLetterMutation {
+	return odlu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (odlu *OutboxDeadLetterUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, odlu.sqlSave, odlu.mutation, odlu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (odlu *OutboxDeadLetterUpdate) SaveX(ctx context.Context) int {
+	affected, err := odlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (odlu *OutboxDeadLetterUpdate) Exec(ctx context.Context) error {
+	_, err := odlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (odlu *OutboxDeadLetterUpdate) ExecX(ctx context.Context) {
+	if err := odlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (odlu *OutboxDeadLetterUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(outboxdeadletter.Table, outboxdeadletter.Columns, sqlgraph.NewFieldSpec(outboxdeadletter.FieldID, field.TypeInt64))
+	if ps := odlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if odlu.mutation.HeadersCleared() {
+		_spec.ClearField(outboxdeadletter.FieldHeaders, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, odlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxdeadletter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	odlu.mutation.done = true
+	return n, nil
+}
+
+// OutboxDeadLetterUpdateOne is the builder for updating a single OutboxDeadLetter entity.
+type OutboxDeadLetterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OutboxDeadLetterMutation
+}
+
+// Mutation returns the OutboxDeadLetterMutation object of the builder.
+func (odluo *OutboxDeadLetterUpdateOne) Mutation() *OutboxDeadLetterMutation {
+	return odluo.mutation
+}
+
+// Where appends a list predicates to the OutboxDeadLetterUpdate builder.
+func (odluo *OutboxDeadLetterUpdateOne) Where(ps ...predicate.OutboxDeadLetter) *OutboxDeadLetterUpdateOne {
+	odluo.mutation.Where(ps...)
+	return odluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (odluo *OutboxDeadLetterUpdateOne) Select(field string, fields ...string) *OutboxDeadLetterUpdateOne {
+	odluo.fields = append([]string{field}, fields...)
+	return odluo
+}
+
+// Save executes the query and returns the updated OutboxDeadLetter entity.
+func (odluo *OutboxDeadLetterUpdateOne) Save(ctx context.Context) (*OutboxDeadLetter, error) {
+	return withHooks(ctx, odluo.sqlSave, odluo.mutation, odluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (odluo *OutboxDeadLetterUpdateOne) SaveX(ctx context.Context) *OutboxDeadLetter {
+	node, err := odluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (odluo *OutboxDeadLetterUpdateOne) Exec(ctx context.Context) error {
+	_, err := odluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (odluo *OutboxDeadLetterUpdateOne) ExecX(ctx context.Context) {
+	if err := odluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (odluo *OutboxDeadLetterUpdateOne) sqlSave(ctx context.Context) (_node *OutboxDeadLetter, err error) {
+	_spec := sqlgraph.NewUpdateSpec(outboxdeadletter.Table, outboxdeadletter.Columns, sqlgraph.NewFieldSpec(outboxdeadletter.FieldID, field.TypeInt64))
+	id, ok := odluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OutboxDeadLetter.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := odluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxdeadletter.FieldID)
+		for _, f := range fields {
+			if !outboxdeadletter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != outboxdeadletter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := odluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if odluo.mutation.HeadersCleared() {
+		_spec.ClearField(outboxdeadletter.FieldHeaders, field.TypeJSON)
+	}
+	_node = &OutboxDeadLetter{config: odluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, odluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxdeadletter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	odluo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/role"
+	"mandacode.com/accounts/user/ent/roleassignment"
+	"mandacode.com/accounts/user/ent/user"
+)
+
+// RoleAssignment is the model entity for the RoleAssignment schema.
+type RoleAssignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique identifier for the role assignment. This is a UUID that is generated when the role is assigned.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Unique identifier for the user the role is assigned to.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Unique identifier for the assigned role.
+	RoleID uuid.UUID `json:"role_id,omitempty"`
+	// Identifier of the admin who assigned the role, for auditing purposes. This is optional.
+	AssignedBy string `json:"assigned_by,omitempty"`
+	// Timestamp when the role was assigned. This is set to the current time when the role is assigned.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RoleAssignmentQuery when eager-loading is set.
+	Edges        RoleAssignmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RoleAssignmentEdges holds the relations/edges for other nodes in the graph.
+type RoleAssignmentEdges struct {
+	// Edge to the User entity the role is assigned to.
+	User *User `json:"user,omitempty"`
+	// Edge to the assigned Role entity.
+	Role *Role `json:"role,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleAssignmentEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// RoleOrErr returns the Role value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleAssignmentEdges) RoleOrErr() (*Role, error) {
+	if e.Role != nil {
+		return e.Role, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: role.Label}
+	}
+	return nil, &NotLoadedError{edge: "role"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoleAssignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case roleassignment.FieldAssignedBy:
+			values[i] = new(sql.NullString)
+		case roleassignment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case roleassignment.FieldID, roleassignment.FieldUserID, roleassignment.FieldRoleID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoleAssignment fields.
+func (ra *RoleAssignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case roleassignment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ra.ID = *value
+			}
+		case roleassignment.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				ra.UserID = *value
+			}
+		case roleassignment.FieldRoleID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value != nil {
+				ra.RoleID = *value
+			}
+		case roleassignment.FieldAssignedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_by", values[i])
+			} else if value.Valid {
+				ra.AssignedBy = value.String
+			}
+		case roleassignment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ra.CreatedAt = value.Time
+			}
+		default:
+			ra.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoleAssignment.
+// This includes values selected through modifiers, order, etc.
+func (ra *RoleAssignment) Value(name string) (ent.Value, error) {
+	return ra.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the RoleAssignment entity.
+func (ra *RoleAssignment) QueryUser() *UserQuery {
+	return NewRoleAssignmentClient(ra.config).QueryUser(ra)
+}
+
+// QueryRole queries the "role" edge of the RoleAssignment entity.
+func (ra *RoleAssignment) QueryRole() *RoleQuery {
+	return NewRoleAssignmentClient(ra.config).QueryRole(ra)
+}
+
+// Update returns a builder for updating this RoleAssignment.
+// Note that you need to call RoleAssignment.Unwrap() before calling this method if this RoleAssignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ra *RoleAssignment) Update() *RoleAssignmentUpdateOne {
+	return NewRoleAssignmentClient(ra.config).UpdateOne(ra)
+}
+
+// Unwrap unwraps the RoleAssignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ra *RoleAssignment) Unwrap() *RoleAssignment {
+	_tx, ok := ra.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoleAssignment is not a transactional entity")
+	}
+	ra.config.driver = _tx.drv
+	return ra
+}
+
+// String implements the fmt.Stringer.
+func (ra *RoleAssignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoleAssignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ra.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ra.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("role_id=")
+	builder.WriteString(fmt.Sprintf("%v", ra.RoleID))
+	builder.WriteString(", ")
+	builder.WriteString("assigned_by=")
+	builder.WriteString(ra.AssignedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ra.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoleAssignments is a parsable slice of RoleAssignment.
+type RoleAssignments []*RoleAssignment
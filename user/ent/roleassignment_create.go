@@ -0,0 +1,314 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/role"
+	"mandacode.com/accounts/user/ent/roleassignment"
+	"mandacode.com/accounts/user/ent/user"
+)
+
+// RoleAssignmentCreate is the builder for creating a RoleAssignment entity.
+type RoleAssignmentCreate struct {
+	config
+	mutation *RoleAssignmentMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (rac *RoleAssignmentCreate) SetUserID(u uuid.UUID) *RoleAssignmentCreate {
+	rac.mutation.SetUserID(u)
+	return rac
+}
+
+// SetRoleID sets the "role_id" field.
+func (rac *RoleAssignmentCreate) SetRoleID(u uuid.UUID) *RoleAssignmentCreate {
+	rac.mutation.SetRoleID(u)
+	return rac
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (rac *RoleAssignmentCreate) SetAssignedBy(s string) *RoleAssignmentCreate {
+	rac.mutation.SetAssignedBy(s)
+	return rac
+}
+
+// SetNillableAssignedBy sets the "assigned_by" field if the given value is not nil.
+func (rac *RoleAssignmentCreate) SetNillableAssignedBy(s *string) *RoleAssignmentCreate {
+	if s != nil {
+		rac.SetAssignedBy(*s)
+	}
+	return rac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rac *RoleAssignmentCreate) SetCreatedAt(t time.Time) *RoleAssignmentCreate {
+	rac.mutation.SetCreatedAt(t)
+	return rac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rac *RoleAssignmentCreate) SetNillableCreatedAt(t *time.Time) *RoleAssignmentCreate {
+	if t != nil {
+		rac.SetCreatedAt(*t)
+	}
+	return rac
+}
+
+// SetID sets the "id" field.
+func (rac *RoleAssignmentCreate) SetID(u uuid.UUID) *RoleAssignmentCreate {
+	rac.mutation.SetID(u)
+	return rac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rac *RoleAssignmentCreate) SetNillableID(u *uuid.UUID) *RoleAssignmentCreate {
+	if u != nil {
+		rac.SetID(*u)
+	}
+	return rac
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rac *RoleAssignmentCreate) SetUser(u *User) *RoleAssignmentCreate {
+	return rac.SetUserID(u.ID)
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (rac *RoleAssignmentCreate) SetRole(r *Role) *RoleAssignmentCreate {
+	return rac.SetRoleID(r.ID)
+}
+
+// Mutation returns the RoleAssignmentMutation object of the builder.
+func (rac *RoleAssignmentCreate) Mutation() *RoleAssignmentMutation {
+	return rac.mutation
+}
+
+// Save creates the RoleAssignment in the database.
+func (rac *RoleAssignmentCreate) Save(ctx context.Context) (*RoleAssignment, error) {
+	rac.defaults()
+	return withHooks(ctx, rac.sqlSave, rac.mutation, rac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rac *RoleAssignmentCreate) SaveX(ctx context.Context) *RoleAssignment {
+	v, err := rac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rac *RoleAssignmentCreate) Exec(ctx context.Context) error {
+	_, err := rac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rac *RoleAssignmentCreate) ExecX(ctx context.Context) {
+	if err := rac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rac *RoleAssignmentCreate) defaults() {
+	if _, ok := rac.mutation.CreatedAt(); !ok {
+		v := roleassignment.DefaultCreatedAt()
+		rac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rac.mutation.ID(); !ok {
+		v := roleassignment.DefaultID()
+		rac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rac *RoleAssignmentCreate) check() error {
+	if _, ok := rac.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RoleAssignment.user_id"`)}
+	}
+	if _, ok := rac.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "RoleAssignment.role_id"`)}
+	}
+	if _, ok := rac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RoleAssignment.created_at"`)}
+	}
+	if len(rac.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "RoleAssignment.user"`)}
+	}
+	if len(rac.mutation.RoleIDs()) == 0 {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required edge "RoleAssignment.role"`)}
+	}
+	return nil
+}
+
+func (rac *RoleAssignmentCreate) sqlSave(ctx context.Context) (*RoleAssignment, error) {
+	if err := rac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rac.mutation.id = &_node.ID
+	rac.mutation.done = true
+	return _node, nil
+}
+
+func (rac *RoleAssignmentCreate) createSpec() (*RoleAssignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoleAssignment{config: rac.config}
+		_spec = sqlgraph.NewCreateSpec(roleassignment.Table, sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID))
+	)
+	if id, ok := rac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rac.mutation.AssignedBy(); ok {
+		_spec.SetField(roleassignment.FieldAssignedBy, field.TypeString, value)
+		_node.AssignedBy = value
+	}
+	if value, ok := rac.mutation.CreatedAt(); ok {
+		_spec.SetField(roleassignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rac.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roleassignment.UserTable,
+			Columns: []string{roleassignment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rac.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roleassignment.RoleTable,
+			Columns: []string{roleassignment.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RoleAssignmentCreateBulk is the builder for creating many RoleAssignment entities in bulk.
+type RoleAssignmentCreateBulk struct {
+	config
+	err      error
+	builders []*RoleAssignmentCreate
+}
+
+// Save creates the RoleAssignment entities in the database.
+func (racb *RoleAssignmentCreateBulk) Save(ctx context.Context) ([]*RoleAssignment, error) {
+	if racb.err != nil {
+		return nil, racb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(racb.builders))
+	nodes := make([]*RoleAssignment, len(racb.builders))
+	mutators := make([]Mutator, len(racb.builders))
+	for i := range racb.builders {
+		func(i int, root context.Context) {
+			builder := racb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoleAssignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, racb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, racb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, racb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (racb *RoleAssignmentCreateBulk) SaveX(ctx context.Context) []*RoleAssignment {
+	v, err := racb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (racb *RoleAssignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := racb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (racb *RoleAssignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := racb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"mandacode.com/accounts/user/ent/predicate"
+	"mandacode.com/accounts/user/ent/roleassignment"
+)
+
+// RoleAssignmentDelete is the builder for deleting a RoleAssignment entity.
+type RoleAssignmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *RoleAssignmentMutation
+}
+
+// Where appends a list predicates to the RoleAssignmentDelete builder.
+func (rad *RoleAssignmentDelete) Where(ps ...predicate.RoleAssignment) *RoleAssignmentDelete {
+	rad.mutation.Where(ps...)
+	return rad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rad *RoleAssignmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rad.sqlExec, rad.mutation, rad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rad *RoleAssignmentDelete) ExecX(ctx context.Context) int {
+	n, err := rad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rad *RoleAssignmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(roleassignment.Table, sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID))
+	if ps := rad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rad.mutation.done = true
+	return affected, err
+}
+
+// RoleAssignmentDeleteOne is the builder for deleting a single RoleAssignment entity.
+type RoleAssignmentDeleteOne struct {
+	rad *RoleAssignmentDelete
+}
+
+// Where appends a list predicates to the RoleAssignmentDelete builder.
+func (rado *RoleAssignmentDeleteOne) Where(ps ...predicate.RoleAssignment) *RoleAssignmentDeleteOne {
+	rado.rad.mutation.Where(ps...)
+	return rado
+}
+
+// Exec executes the deletion query.
+func (rado *RoleAssignmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := rado.rad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{roleassignment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rado *RoleAssignmentDeleteOne) ExecX(ctx context.Context) {
+	if err := rado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
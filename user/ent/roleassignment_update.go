@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"mandacode.com/accounts/user/ent/predicate"
+	"mandacode.com/accounts/user/ent/roleassignment"
+)
+
+// RoleAssignmentUpdate is the builder for updating RoleAssignment entities.
+type RoleAssignmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoleAssignmentMutation
+}
+
+// Where appends a list predicates to the RoleAssignmentUpdate builder.
+func (rau *RoleAssignmentUpdate) Where(ps ...predicate.RoleAssignment) *RoleAssignmentUpdate {
+	rau.mutation.Where(ps...)
+	return rau
+}
+
+// Mutation returns the RoleAssignmentMutation object of the builder.
+func (rau *RoleAssignmentUpdate) Mutation() *RoleAssignmentMutation {
+	return rau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rau *RoleAssignmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rau.sqlSave, rau.mutation, rau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rau *RoleAssignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := rau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rau *RoleAssignmentUpdate) Exec(ctx context.Context) error {
+	_, err := rau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rau *RoleAssignmentUpdate) ExecX(ctx context.Context) {
+	if err := rau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rau *RoleAssignmentUpdate) check() error {
+	if rau.mutation.UserCleared() && len(rau.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleAssignment.user"`)
+	}
+	if rau.mutation.RoleCleared() && len(rau.mutation.RoleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleAssignment.role"`)
+	}
+	return nil
+}
+
+func (rau *RoleAssignmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roleassignment.Table, roleassignment.Columns, sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID))
+	if ps := rau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rau.mutation.AssignedByCleared() {
+		_spec.ClearField(roleassignment.FieldAssignedBy, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roleassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rau.mutation.done = true
+	return n, nil
+}
+
+// RoleAssignmentUpdateOne is the builder for updating a single RoleAssignment entity.
+type RoleAssignmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoleAssignmentMutation
+}
+
+// Mutation returns the RoleAssignmentMutation object of the builder.
+func (rauo *RoleAssignmentUpdateOne) Mutation() *RoleAssignmentMutation {
+	return rauo.mutation
+}
+
+// Where appends a list predicates to the RoleAssignmentUpdate builder.
+func (rauo *RoleAssignmentUpdateOne) Where(ps ...predicate.RoleAssignment) *RoleAssignmentUpdateOne {
+	rauo.mutation.Where(ps...)
+	return rauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rauo *RoleAssignmentUpdateOne) Select(field string, fields ...string) *RoleAssignmentUpdateOne {
+	rauo.fields = append([]string{field}, fields...)
+	return rauo
+}
+
+// Save executes the query and returns the updated RoleAssignment entity.
+func (rauo *RoleAssignmentUpdateOne) Save(ctx context.Context) (*RoleAssignment, error) {
+	return withHooks(ctx, rauo.sqlSave, rauo.mutation, rauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rauo *RoleAssignmentUpdateOne) SaveX(ctx context.Context) *RoleAssignment {
+	node, err := rauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rauo *RoleAssignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := rauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rauo *RoleAssignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := rauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rauo *RoleAssignmentUpdateOne) check() error {
+	if rauo.mutation.UserCleared() && len(rauo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleAssignment.user"`)
+	}
+	if rauo.mutation.RoleCleared() && len(rauo.mutation.RoleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleAssignment.role"`)
+	}
+	return nil
+}
+
+func (rauo *RoleAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *RoleAssignment, err error) {
+	if err := rauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roleassignment.Table, roleassignment.Columns, sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID))
+	id, ok := rauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoleAssignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roleassignment.FieldID)
+		for _, f := range fields {
+			if !roleassignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != roleassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rauo.mutation.AssignedByCleared() {
+		_spec.ClearField(roleassignment.FieldAssignedBy, field.TypeString)
+	}
+	_node = &RoleAssignment{config: rauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roleassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rauo.mutation.done = true
+	return _node, nil
+}
@@ -6,15 +6,232 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/auditentry"
+	"mandacode.com/accounts/user/ent/consentdocument"
+	"mandacode.com/accounts/user/ent/dataexport"
+	"mandacode.com/accounts/user/ent/emailchange"
+	"mandacode.com/accounts/user/ent/invitation"
+	"mandacode.com/accounts/user/ent/membership"
+	"mandacode.com/accounts/user/ent/organization"
+	"mandacode.com/accounts/user/ent/outboxdeadletter"
+	"mandacode.com/accounts/user/ent/outboxmessage"
+	"mandacode.com/accounts/user/ent/role"
+	"mandacode.com/accounts/user/ent/roleassignment"
 	"mandacode.com/accounts/user/ent/schema"
 	"mandacode.com/accounts/user/ent/sentemail"
+	"mandacode.com/accounts/user/ent/signupsaga"
 	"mandacode.com/accounts/user/ent/user"
+	"mandacode.com/accounts/user/ent/userconsent"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	auditentryFields := schema.AuditEntry{}.Fields()
+	_ = auditentryFields
+	// auditentryDescActorID is the schema descriptor for actor_id field.
+	auditentryDescActorID := auditentryFields[4].Descriptor()
+	// auditentry.DefaultActorID holds the default value on creation for the actor_id field.
+	auditentry.DefaultActorID = auditentryDescActorID.Default.(string)
+	// auditentryDescRequestID is the schema descriptor for request_id field.
+	auditentryDescRequestID := auditentryFields[10].Descriptor()
+	// auditentry.DefaultRequestID holds the default value on creation for the request_id field.
+	auditentry.DefaultRequestID = auditentryDescRequestID.Default.(string)
+	// auditentryDescIP is the schema descriptor for ip field.
+	auditentryDescIP := auditentryFields[11].Descriptor()
+	// auditentry.DefaultIP holds the default value on creation for the ip field.
+	auditentry.DefaultIP = auditentryDescIP.Default.(string)
+	// auditentryDescRecordedAt is the schema descriptor for recorded_at field.
+	auditentryDescRecordedAt := auditentryFields[13].Descriptor()
+	// auditentry.DefaultRecordedAt holds the default value on creation for the recorded_at field.
+	auditentry.DefaultRecordedAt = auditentryDescRecordedAt.Default.(func() time.Time)
+	consentdocumentFields := schema.ConsentDocument{}.Fields()
+	_ = consentdocumentFields
+	// consentdocumentDescVersion is the schema descriptor for version field.
+	consentdocumentDescVersion := consentdocumentFields[2].Descriptor()
+	// consentdocument.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	consentdocument.VersionValidator = consentdocumentDescVersion.Validators[0].(func(int) error)
+	// consentdocumentDescTitle is the schema descriptor for title field.
+	consentdocumentDescTitle := consentdocumentFields[3].Descriptor()
+	// consentdocument.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	consentdocument.TitleValidator = consentdocumentDescTitle.Validators[0].(func(string) error)
+	// consentdocumentDescContentURL is the schema descriptor for content_url field.
+	consentdocumentDescContentURL := consentdocumentFields[4].Descriptor()
+	// consentdocument.ContentURLValidator is a validator for the "content_url" field. It is called by the builders before save.
+	consentdocument.ContentURLValidator = consentdocumentDescContentURL.Validators[0].(func(string) error)
+	// consentdocumentDescPublishedAt is the schema descriptor for published_at field.
+	consentdocumentDescPublishedAt := consentdocumentFields[6].Descriptor()
+	// consentdocument.DefaultPublishedAt holds the default value on creation for the published_at field.
+	consentdocument.DefaultPublishedAt = consentdocumentDescPublishedAt.Default.(func() time.Time)
+	// consentdocumentDescID is the schema descriptor for id field.
+	consentdocumentDescID := consentdocumentFields[0].Descriptor()
+	// consentdocument.DefaultID holds the default value on creation for the id field.
+	consentdocument.DefaultID = consentdocumentDescID.Default.(func() uuid.UUID)
+	dataexportFields := schema.DataExport{}.Fields()
+	_ = dataexportFields
+	// dataexportDescAttempts is the schema descriptor for attempts field.
+	dataexportDescAttempts := dataexportFields[6].Descriptor()
+	// dataexport.DefaultAttempts holds the default value on creation for the attempts field.
+	dataexport.DefaultAttempts = dataexportDescAttempts.Default.(int)
+	// dataexportDescCreatedAt is the schema descriptor for created_at field.
+	dataexportDescCreatedAt := dataexportFields[8].Descriptor()
+	// dataexport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dataexport.DefaultCreatedAt = dataexportDescCreatedAt.Default.(func() time.Time)
+	// dataexportDescUpdatedAt is the schema descriptor for updated_at field.
+	dataexportDescUpdatedAt := dataexportFields[9].Descriptor()
+	// dataexport.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dataexport.DefaultUpdatedAt = dataexportDescUpdatedAt.Default.(func() time.Time)
+	// dataexport.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dataexport.UpdateDefaultUpdatedAt = dataexportDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// dataexportDescID is the schema descriptor for id field.
+	dataexportDescID := dataexportFields[0].Descriptor()
+	// dataexport.DefaultID holds the default value on creation for the id field.
+	dataexport.DefaultID = dataexportDescID.Default.(func() uuid.UUID)
+	emailchangeFields := schema.EmailChange{}.Fields()
+	_ = emailchangeFields
+	// emailchangeDescOldEmail is the schema descriptor for old_email field.
+	emailchangeDescOldEmail := emailchangeFields[2].Descriptor()
+	// emailchange.OldEmailValidator is a validator for the "old_email" field. It is called by the builders before save.
+	emailchange.OldEmailValidator = emailchangeDescOldEmail.Validators[0].(func(string) error)
+	// emailchangeDescNewEmail is the schema descriptor for new_email field.
+	emailchangeDescNewEmail := emailchangeFields[3].Descriptor()
+	// emailchange.NewEmailValidator is a validator for the "new_email" field. It is called by the builders before save.
+	emailchange.NewEmailValidator = emailchangeDescNewEmail.Validators[0].(func(string) error)
+	// emailchangeDescCreatedAt is the schema descriptor for created_at field.
+	emailchangeDescCreatedAt := emailchangeFields[7].Descriptor()
+	// emailchange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailchange.DefaultCreatedAt = emailchangeDescCreatedAt.Default.(func() time.Time)
+	// emailchangeDescUpdatedAt is the schema descriptor for updated_at field.
+	emailchangeDescUpdatedAt := emailchangeFields[8].Descriptor()
+	// emailchange.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	emailchange.DefaultUpdatedAt = emailchangeDescUpdatedAt.Default.(func() time.Time)
+	// emailchange.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	emailchange.UpdateDefaultUpdatedAt = emailchangeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// emailchangeDescID is the schema descriptor for id field.
+	emailchangeDescID := emailchangeFields[0].Descriptor()
+	// emailchange.DefaultID holds the default value on creation for the id field.
+	emailchange.DefaultID = emailchangeDescID.Default.(func() uuid.UUID)
+	invitationFields := schema.Invitation{}.Fields()
+	_ = invitationFields
+	// invitationDescEmail is the schema descriptor for email field.
+	invitationDescEmail := invitationFields[2].Descriptor()
+	// invitation.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	invitation.EmailValidator = invitationDescEmail.Validators[0].(func(string) error)
+	// invitationDescTokenHash is the schema descriptor for token_hash field.
+	invitationDescTokenHash := invitationFields[4].Descriptor()
+	// invitation.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	invitation.TokenHashValidator = invitationDescTokenHash.Validators[0].(func(string) error)
+	// invitationDescCreatedAt is the schema descriptor for created_at field.
+	invitationDescCreatedAt := invitationFields[7].Descriptor()
+	// invitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invitation.DefaultCreatedAt = invitationDescCreatedAt.Default.(func() time.Time)
+	// invitationDescID is the schema descriptor for id field.
+	invitationDescID := invitationFields[0].Descriptor()
+	// invitation.DefaultID holds the default value on creation for the id field.
+	invitation.DefaultID = invitationDescID.Default.(func() uuid.UUID)
+	membershipFields := schema.Membership{}.Fields()
+	_ = membershipFields
+	// membershipDescCreatedAt is the schema descriptor for created_at field.
+	membershipDescCreatedAt := membershipFields[4].Descriptor()
+	// membership.DefaultCreatedAt holds the default value on creation for the created_at field.
+	membership.DefaultCreatedAt = membershipDescCreatedAt.Default.(func() time.Time)
+	// membershipDescUpdatedAt is the schema descriptor for updated_at field.
+	membershipDescUpdatedAt := membershipFields[5].Descriptor()
+	// membership.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	membership.DefaultUpdatedAt = membershipDescUpdatedAt.Default.(func() time.Time)
+	// membership.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	membership.UpdateDefaultUpdatedAt = membershipDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// membershipDescID is the schema descriptor for id field.
+	membershipDescID := membershipFields[0].Descriptor()
+	// membership.DefaultID holds the default value on creation for the id field.
+	membership.DefaultID = membershipDescID.Default.(func() uuid.UUID)
+	organizationFields := schema.Organization{}.Fields()
+	_ = organizationFields
+	// organizationDescName is the schema descriptor for name field.
+	organizationDescName := organizationFields[1].Descriptor()
+	// organization.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	organization.NameValidator = organizationDescName.Validators[0].(func(string) error)
+	// organizationDescSlug is the schema descriptor for slug field.
+	organizationDescSlug := organizationFields[2].Descriptor()
+	// organization.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	organization.SlugValidator = organizationDescSlug.Validators[0].(func(string) error)
+	// organizationDescCreatedAt is the schema descriptor for created_at field.
+	organizationDescCreatedAt := organizationFields[4].Descriptor()
+	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
+	// organizationDescUpdatedAt is the schema descriptor for updated_at field.
+	organizationDescUpdatedAt := organizationFields[5].Descriptor()
+	// organization.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	organization.DefaultUpdatedAt = organizationDescUpdatedAt.Default.(func() time.Time)
+	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	organization.UpdateDefaultUpdatedAt = organizationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// organizationDescID is the schema descriptor for id field.
+	organizationDescID := organizationFields[0].Descriptor()
+	// organization.DefaultID holds the default value on creation for the id field.
+	organization.DefaultID = organizationDescID.Default.(func() uuid.UUID)
+	outboxdeadletterFields := schema.OutboxDeadLetter{}.Fields()
+	_ = outboxdeadletterFields
+	// outboxdeadletterDescTopic is the schema descriptor for topic field.
+	outboxdeadletterDescTopic := outboxdeadletterFields[1].Descriptor()
+	// outboxdeadletter.TopicValidator is a validator for the "topic" field. It is called by the builders before save.
+	outboxdeadletter.TopicValidator = outboxdeadletterDescTopic.Validators[0].(func(string) error)
+	// outboxdeadletterDescDeadAt is the schema descriptor for dead_at field.
+	outboxdeadletterDescDeadAt := outboxdeadletterFields[8].Descriptor()
+	// outboxdeadletter.DefaultDeadAt holds the default value on creation for the dead_at field.
+	outboxdeadletter.DefaultDeadAt = outboxdeadletterDescDeadAt.Default.(func() time.Time)
+	outboxmessageFields := schema.OutboxMessage{}.Fields()
+	_ = outboxmessageFields
+	// outboxmessageDescTopic is the schema descriptor for topic field.
+	outboxmessageDescTopic := outboxmessageFields[1].Descriptor()
+	// outboxmessage.TopicValidator is a validator for the "topic" field. It is called by the builders before save.
+	outboxmessage.TopicValidator = outboxmessageDescTopic.Validators[0].(func(string) error)
+	// outboxmessageDescAttempts is the schema descriptor for attempts field.
+	outboxmessageDescAttempts := outboxmessageFields[5].Descriptor()
+	// outboxmessage.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxmessage.DefaultAttempts = outboxmessageDescAttempts.Default.(int)
+	// outboxmessageDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	outboxmessageDescNextAttemptAt := outboxmessageFields[7].Descriptor()
+	// outboxmessage.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	outboxmessage.DefaultNextAttemptAt = outboxmessageDescNextAttemptAt.Default.(func() time.Time)
+	// outboxmessageDescCreatedAt is the schema descriptor for created_at field.
+	outboxmessageDescCreatedAt := outboxmessageFields[8].Descriptor()
+	// outboxmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxmessage.DefaultCreatedAt = outboxmessageDescCreatedAt.Default.(func() time.Time)
+	roleFields := schema.Role{}.Fields()
+	_ = roleFields
+	// roleDescName is the schema descriptor for name field.
+	roleDescName := roleFields[1].Descriptor()
+	// role.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	role.NameValidator = roleDescName.Validators[0].(func(string) error)
+	// roleDescIsSystem is the schema descriptor for is_system field.
+	roleDescIsSystem := roleFields[4].Descriptor()
+	// role.DefaultIsSystem holds the default value on creation for the is_system field.
+	role.DefaultIsSystem = roleDescIsSystem.Default.(bool)
+	// roleDescCreatedAt is the schema descriptor for created_at field.
+	roleDescCreatedAt := roleFields[5].Descriptor()
+	// role.DefaultCreatedAt holds the default value on creation for the created_at field.
+	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
+	// roleDescUpdatedAt is the schema descriptor for updated_at field.
+	roleDescUpdatedAt := roleFields[6].Descriptor()
+	// role.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	role.DefaultUpdatedAt = roleDescUpdatedAt.Default.(func() time.Time)
+	// role.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	role.UpdateDefaultUpdatedAt = roleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// roleDescID is the schema descriptor for id field.
+	roleDescID := roleFields[0].Descriptor()
+	// role.DefaultID holds the default value on creation for the id field.
+	role.DefaultID = roleDescID.Default.(func() uuid.UUID)
+	roleassignmentFields := schema.RoleAssignment{}.Fields()
+	_ = roleassignmentFields
+	// roleassignmentDescCreatedAt is the schema descriptor for created_at field.
+	roleassignmentDescCreatedAt := roleassignmentFields[4].Descriptor()
+	// roleassignment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	roleassignment.DefaultCreatedAt = roleassignmentDescCreatedAt.Default.(func() time.Time)
+	// roleassignmentDescID is the schema descriptor for id field.
+	roleassignmentDescID := roleassignmentFields[0].Descriptor()
+	// roleassignment.DefaultID holds the default value on creation for the id field.
+	roleassignment.DefaultID = roleassignmentDescID.Default.(func() uuid.UUID)
 	sentemailFields := schema.SentEmail{}.Fields()
 	_ = sentemailFields
 	// sentemailDescEmail is the schema descriptor for email field.
@@ -29,6 +246,30 @@ func init() {
 	sentemailDescID := sentemailFields[0].Descriptor()
 	// sentemail.DefaultID holds the default value on creation for the id field.
 	sentemail.DefaultID = sentemailDescID.Default.(func() uuid.UUID)
+	signupsagaFields := schema.SignupSaga{}.Fields()
+	_ = signupsagaFields
+	// signupsagaDescAttempts is the schema descriptor for attempts field.
+	signupsagaDescAttempts := signupsagaFields[6].Descriptor()
+	// signupsaga.DefaultAttempts holds the default value on creation for the attempts field.
+	signupsaga.DefaultAttempts = signupsagaDescAttempts.Default.(int)
+	// signupsagaDescLeaseID is the schema descriptor for lease_id field.
+	signupsagaDescLeaseID := signupsagaFields[8].Descriptor()
+	// signupsaga.DefaultLeaseID holds the default value on creation for the lease_id field.
+	signupsaga.DefaultLeaseID = signupsagaDescLeaseID.Default.(func() uuid.UUID)
+	// signupsagaDescCreatedAt is the schema descriptor for created_at field.
+	signupsagaDescCreatedAt := signupsagaFields[10].Descriptor()
+	// signupsaga.DefaultCreatedAt holds the default value on creation for the created_at field.
+	signupsaga.DefaultCreatedAt = signupsagaDescCreatedAt.Default.(func() time.Time)
+	// signupsagaDescUpdatedAt is the schema descriptor for updated_at field.
+	signupsagaDescUpdatedAt := signupsagaFields[11].Descriptor()
+	// signupsaga.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	signupsaga.DefaultUpdatedAt = signupsagaDescUpdatedAt.Default.(func() time.Time)
+	// signupsaga.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	signupsaga.UpdateDefaultUpdatedAt = signupsagaDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// signupsagaDescID is the schema descriptor for id field.
+	signupsagaDescID := signupsagaFields[0].Descriptor()
+	// signupsaga.DefaultID holds the default value on creation for the id field.
+	signupsaga.DefaultID = signupsagaDescID.Default.(func() uuid.UUID)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescIsActive is the schema descriptor for is_active field.
@@ -39,22 +280,48 @@ func init() {
 	userDescIsBlocked := userFields[2].Descriptor()
 	// user.DefaultIsBlocked holds the default value on creation for the is_blocked field.
 	user.DefaultIsBlocked = userDescIsBlocked.Default.(bool)
+	// userDescSyncVersion is the schema descriptor for sync_version field.
+	userDescSyncVersion := userFields[4].Descriptor()
+	// user.DefaultSyncVersion holds the default value on creation for the sync_version field.
+	user.DefaultSyncVersion = userDescSyncVersion.Default.(int64)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[4].Descriptor()
+	userDescCreatedAt := userFields[5].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[5].Descriptor()
+	userDescUpdatedAt := userFields[6].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// userDescIsArchived is the schema descriptor for is_archived field.
-	userDescIsArchived := userFields[6].Descriptor()
+	userDescIsArchived := userFields[7].Descriptor()
 	// user.DefaultIsArchived holds the default value on creation for the is_archived field.
 	user.DefaultIsArchived = userDescIsArchived.Default.(bool)
+	// userDescIsMinor is the schema descriptor for is_minor field.
+	userDescIsMinor := userFields[12].Descriptor()
+	// user.DefaultIsMinor holds the default value on creation for the is_minor field.
+	user.DefaultIsMinor = userDescIsMinor.Default.(bool)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	userconsentFields := schema.UserConsent{}.Fields()
+	_ = userconsentFields
+	// userconsentDescIP is the schema descriptor for ip field.
+	userconsentDescIP := userconsentFields[4].Descriptor()
+	// userconsent.DefaultIP holds the default value on creation for the ip field.
+	userconsent.DefaultIP = userconsentDescIP.Default.(string)
+	// userconsentDescUserAgent is the schema descriptor for user_agent field.
+	userconsentDescUserAgent := userconsentFields[5].Descriptor()
+	// userconsent.DefaultUserAgent holds the default value on creation for the user_agent field.
+	userconsent.DefaultUserAgent = userconsentDescUserAgent.Default.(string)
+	// userconsentDescRecordedAt is the schema descriptor for recorded_at field.
+	userconsentDescRecordedAt := userconsentFields[6].Descriptor()
+	// userconsent.DefaultRecordedAt holds the default value on creation for the recorded_at field.
+	userconsent.DefaultRecordedAt = userconsentDescRecordedAt.Default.(func() time.Time)
+	// userconsentDescID is the schema descriptor for id field.
+	userconsentDescID := userconsentFields[0].Descriptor()
+	// userconsent.DefaultID holds the default value on creation for the id field.
+	userconsent.DefaultID = userconsentDescID.Default.(func() uuid.UUID)
 }
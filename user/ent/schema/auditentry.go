@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AuditEntry holds the schema definition for the AuditEntry entity.
+//
+// Entries are append-only: the migration creating the table adds triggers rejecting updates and deletes, and
+// each entry is chained to the previous one by its hash, so that tampering with the table is evident.
+type AuditEntry struct {
+	ent.Schema
+}
+
+// Fields of the AuditEntry.
+func (AuditEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Immutable().
+			Unique().
+			Comment("Unique identifier for the entry. This is the ID of the audit event, so that redelivered events are recorded once."),
+		field.Int64("seq").
+			Immutable().
+			Unique().
+			Comment("Position of the entry in the hash chain, starting at 1."),
+		field.String("service").
+			Immutable().
+			Comment("Service in which the action was applied."),
+		field.String("actor_type").
+			Immutable().
+			Comment("Type of the actor who applied the action, such as user, api_key or system."),
+		field.String("actor_id").
+			Immutable().
+			Default("").
+			Comment("Identifier of the actor who applied the action."),
+		field.String("action").
+			Immutable().
+			Comment("Action which was applied, such as user.block."),
+		field.String("target_type").
+			Immutable().
+			Comment("Type of the resource the action was applied to."),
+		field.String("target_id").
+			Immutable().
+			Comment("Identifier of the resource the action was applied to."),
+		field.JSON("before", map[string]any{}).
+			Optional().
+			Immutable().
+			Comment("Fields of the target which the action changed, before the action."),
+		field.JSON("after", map[string]any{}).
+			Optional().
+			Immutable().
+			Comment("Fields of the target which the action changed, after the action."),
+		field.String("request_id").
+			Immutable().
+			Default("").
+			Comment("Identifier of the request which applied the action."),
+		field.String("ip").
+			Immutable().
+			Default("").
+			Comment("IP address from which the request was made."),
+		field.Time("occurred_at").
+			Immutable().
+			Comment("Timestamp when the action was applied."),
+		field.Time("recorded_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the entry was recorded."),
+		field.String("prev_hash").
+			Immutable().
+			Comment("Hash of the previous entry in the chain, empty for the first entry."),
+		field.String("hash").
+			Immutable().
+			Comment("SHA-256 hash of the previous hash and the content of the entry."),
+	}
+}
+
+// Indexes of the AuditEntry.
+func (AuditEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("actor_id"),
+		index.Fields("action"),
+		index.Fields("target_type", "target_id"),
+		index.Fields("occurred_at"),
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ConsentDocument holds the schema definition for the ConsentDocument entity.
+//
+// Documents are versioned and never change once published, so that each recorded consent refers to the exact
+// text the user agreed to. A new version is published instead.
+type ConsentDocument struct {
+	ent.Schema
+}
+
+// Fields of the ConsentDocument.
+func (ConsentDocument) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique().
+			Comment("Unique identifier for the document. This is a UUID that is generated when the document is published."),
+		field.Enum("kind").
+			Values("terms_of_service", "privacy_policy", "marketing").
+			Immutable().
+			Comment("Kind of the document. The latest version of each kind is the current one."),
+		field.Int("version").
+			Positive().
+			Immutable().
+			Comment("Version of the document, increasing with each version of the same kind."),
+		field.String("title").
+			NotEmpty().
+			Immutable().
+			Comment("Title of the document, as shown to the user."),
+		field.String("content_url").
+			NotEmpty().
+			Immutable().
+			Comment("URL of the full text of the document."),
+		field.Bool("mandatory").
+			Immutable().
+			Comment("Whether users must accept the document to use the service. Optional documents, such as marketing consent, can be declined."),
+		field.Time("published_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the document was published."),
+	}
+}
+
+// Edges of the ConsentDocument.
+func (ConsentDocument) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("consents", UserConsent.Type),
+	}
+}
+
+// Indexes of the ConsentDocument.
+func (ConsentDocument) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "version").Unique(),
+	}
+}
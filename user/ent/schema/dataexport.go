@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DataExport holds the schema definition for the DataExport entity.
+type DataExport struct {
+	ent.Schema
+}
+
+// Fields of the DataExport.
+func (DataExport) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique().
+			Comment("Unique identifier for the export. This is a UUID that is generated when the export is requested."),
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable().
+			Comment("Unique identifier for the user whose data is exported. It has no foreign key, so that the archive of a deleted user is still removed when it expires."),
+		field.Enum("status").
+			Values("pending", "completed", "failed", "expired").
+			Default("pending").
+			Comment("Status of the export. Pending exports are built by the export worker."),
+		field.String("object_key").
+			Optional().
+			Nillable().
+			Comment("Key of the archive in the blob store, set when the export is completed."),
+		field.String("download_token_hash").
+			Optional().
+			Nillable().
+			Unique().
+			Sensitive().
+			Comment("SHA-256 hash of the token in the download link. The token itself is only sent by email."),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("Timestamp after which the archive can no longer be downloaded, and is deleted."),
+		field.Int("attempts").
+			Default(0).
+			Comment("Number of failed attempts to build the export."),
+		field.String("last_error").
+			Optional().
+			Nillable().
+			Comment("Error of the last failed attempt to build the export."),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the export was requested."),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Timestamp when the export was last updated."),
+	}
+}
+
+// Indexes of the DataExport.
+func (DataExport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("status", "created_at"),
+	}
+}
@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EmailChange holds the schema definition for the EmailChange entity.
+type EmailChange struct {
+	ent.Schema
+}
+
+// Fields of the EmailChange.
+func (EmailChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique().
+			Comment("Unique identifier for the email change. This is a UUID that is generated when the change is requested."),
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable().
+			Comment("Unique identifier for the user changing their email address."),
+		field.String("old_email").
+			NotEmpty().
+			Immutable().
+			Comment("Email address of the user when the change was requested. It is notified of the change, with a link undoing it."),
+		field.String("new_email").
+			NotEmpty().
+			Immutable().
+			Comment("Email address the user changes to. It receives the link confirming the change."),
+		field.Enum("status").
+			Values("pending", "confirmed", "undone").
+			Default("pending").
+			Comment("Status of the change."),
+		field.String("undo_token_hash").
+			Optional().
+			Nillable().
+			Unique().
+			Sensitive().
+			Comment("SHA-256 hash of the token in the undo link, set when the change is confirmed. The token itself is only sent by email."),
+		field.Time("undo_expires_at").
+			Optional().
+			Nillable().
+			Comment("Timestamp after which the change can no longer be undone."),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the change was requested."),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Timestamp when the change was last updated."),
+	}
+}
+
+// Indexes of the EmailChange.
+func (EmailChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+// Edges of the EmailChange.
+func (EmailChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("email_changes").
+			Unique().
+			Field("user_id").
+			Required().
+			Immutable().
+			Comment("Edge to the User entity changing their email address."),
+	}
+}
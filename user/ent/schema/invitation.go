@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Invitation holds the schema definition for the Invitation entity.
+type Invitation struct {
+	ent.Schema
+}
+
+// Fields of the Invitation.
+func (Invitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique().
+			Comment("Unique identifier for the invitation. This is a UUID that is generated when the invitation is sent."),
+		field.UUID("organization_id", uuid.UUID{}).
+			Immutable().
+			Comment("Unique identifier for the organization the invitation is for."),
+		field.String("email").
+			NotEmpty().
+			Immutable().
+			Comment("Email address the invitation was sent to."),
+		field.Enum("role").
+			Values("owner", "admin", "member").
+			Default("member").
+			Immutable().
+			Comment("Role the invited user gets when accepting the invitation."),
+		field.String("token_hash").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Sensitive().
+			Comment("SHA-256 hash of the token in the invitation link. The token itself is only sent by email."),
+		field.UUID("invited_by", uuid.UUID{}).
+			Immutable().
+			Comment("Unique identifier for the member who sent the invitation, for auditing purposes."),
+		field.Time("expires_at").
+			Comment("Timestamp after which the invitation can no longer be accepted."),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the invitation was sent."),
+	}
+}
+
+// Indexes of the Invitation.
+func (Invitation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id"),
+	}
+}
+
+// Edges of the Invitation.
+func (Invitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("invitations").
+			Unique().
+			Field("organization_id").
+			Required().
+			Immutable().
+			Comment("Edge to the Organization entity the invitation is for."),
+	}
+}
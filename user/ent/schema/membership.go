@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Membership holds the schema definition for the Membership entity.
+type Membership struct {
+	ent.Schema
+}
+
+// Fields of the Membership.
+func (Membership) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique().
+			Comment("Unique identifier for the membership. This is a UUID that is generated when the user joins the organization."),
+		field.UUID("organization_id", uuid.UUID{}).
+			Immutable().
+			Comment("Unique identifier for the organization."),
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable().
+			Comment("Unique identifier for the member."),
+		field.Enum("role").
+			Values("owner", "admin", "member").
+			Default("member").
+			Comment("Role of the member within the organization. Owners manage the organization, admins manage its members."),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the user joined the organization."),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Timestamp when the membership was last updated."),
+	}
+}
+
+// Indexes of the Membership.
+func (Membership) Indexes() []ent.Index {
+	return []ent.Index{
+		// A user is a member of an organization at most once.
+		index.Fields("organization_id", "user_id").
+			Unique(),
+		index.Fields("user_id"),
+	}
+}
+
+// Edges of the Membership.
+func (Membership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("memberships").
+			Unique().
+			Field("organization_id").
+			Required().
+			Immutable().
+			Comment("Edge to the Organization entity the user is a member of."),
+		edge.From("user", User.Type).
+			Ref("memberships").
+			Unique().
+			Field("user_id").
+			Required().
+			Immutable().
+			Comment("Edge to the User entity of the member."),
+	}
+}
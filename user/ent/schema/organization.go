@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Organization holds the schema definition for the Organization entity.
+type Organization struct {
+	ent.Schema
+}
+
+// Fields of the Organization.
+func (Organization) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique().
+			Comment("Unique identifier for the organization. This is a UUID that is generated when the organization is created."),
+		field.String("name").
+			NotEmpty().
+			Comment("Display name of the organization."),
+		field.String("slug").
+			NotEmpty().
+			Unique().
+			Comment("Unique URL friendly name of the organization."),
+		field.UUID("created_by", uuid.UUID{}).
+			Immutable().
+			Comment("Unique identifier for the user who created the organization, for auditing purposes."),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the organization was created. This is set to the current time when the organization is created."),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Timestamp when the organization was last updated. This is set to the current time whenever the organization is updated."),
+	}
+}
+
+// Edges of the Organization.
+func (Organization) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("memberships", Membership.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Edge to the Membership entity, linking the organization to its members."),
+		edge.To("invitations", Invitation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Edge to the Invitation entity, linking the organization to its pending invitations."),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OutboxDeadLetter holds the schema definition for the OutboxDeadLetter entity.
+type OutboxDeadLetter struct {
+	ent.Schema
+}
+
+// Fields of the OutboxDeadLetter.
+func (OutboxDeadLetter) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Immutable().
+			Unique().
+			Comment("Identifier the message had in the outbox."),
+		field.String("topic").
+			NotEmpty().
+			Immutable().
+			Comment("Kafka topic the message was to be published to."),
+		field.Bytes("key").
+			Immutable().
+			Comment("Kafka key of the message."),
+		field.Bytes("value").
+			Immutable().
+			Comment("Kafka value of the message."),
+		field.JSON("headers", map[string]string{}).
+			Optional().
+			Immutable().
+			Comment("Kafka headers of the message."),
+		field.Int("attempts").
+			Immutable().
+			Comment("Number of failed attempts to publish the message before it was given up on."),
+		field.String("last_error").
+			Immutable().
+			Comment("Error of the last failed attempt to publish the message."),
+		field.Time("created_at").
+			Immutable().
+			Comment("Timestamp when the message was written to the outbox."),
+		field.Time("dead_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the message was given up on and moved out of the outbox."),
+	}
+}
+
+// Indexes of the OutboxDeadLetter.
+func (OutboxDeadLetter) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("topic"),
+		index.Fields("dead_at"),
+	}
+}
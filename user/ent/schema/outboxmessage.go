@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OutboxMessage holds the schema definition for the OutboxMessage entity.
+type OutboxMessage struct {
+	ent.Schema
+}
+
+// Fields of the OutboxMessage.
+func (OutboxMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Immutable().
+			Unique().
+			Comment("Sequential identifier for the message. Messages are published in the order of their identifiers."),
+		field.String("topic").
+			NotEmpty().
+			Immutable().
+			Comment("Kafka topic the message is published to."),
+		field.Bytes("key").
+			Immutable().
+			Comment("Kafka key of the message. Messages sharing a key are published one at a time, in order."),
+		field.Bytes("value").
+			Immutable().
+			Comment("Kafka value of the message."),
+		field.JSON("headers", map[string]string{}).
+			Optional().
+			Immutable().
+			Comment("Kafka headers of the message."),
+		field.Int("attempts").
+			Default(0).
+			Comment("Number of failed attempts to publish the message."),
+		field.String("last_error").
+			Optional().
+			Nillable().
+			Comment("Error of the last failed attempt to publish the message."),
+		field.Time("next_attempt_at").
+			Default(time.Now).
+			Comment("Timestamp before which the message is not published, backing off after failed attempts."),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the message was written, in the same transaction as the change it announces."),
+	}
+}
+
+// Indexes of the OutboxMessage.
+func (OutboxMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		// The relay looks up the oldest message of each key
+		index.Fields("topic", "key"),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Role holds the schema definition for the Role entity.
+type Role struct {
+	ent.Schema
+}
+
+// Fields of the Role.
+func (Role) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique().
+			Comment("Unique identifier for the role. This is a UUID that is generated when the role is created."),
+		field.String("name").
+			NotEmpty().
+			Unique().
+			Comment("Unique name of the role, used to assign it and in role change events."),
+		field.String("description").
+			Optional().
+			Comment("Human readable description of the role. This is optional."),
+		field.Strings("permissions").
+			Comment("Permissions granted by the role. The wildcard permission grants every permission."),
+		field.Bool("is_system").
+			Default(false).
+			Immutable().
+			Comment("Indicates if the role is a system role. System roles are seeded by migrations and cannot be changed or deleted."),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the role was created. This is set to the current time when the role is created."),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Timestamp when the role was last updated. This is set to the current time whenever the role is updated."),
+	}
+}
+
+// Edges of the Role.
+func (Role) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("assignments", RoleAssignment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Edge to the RoleAssignment entity, linking the role to the users it is assigned to."),
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RoleAssignment holds the schema definition for the RoleAssignment entity.
+type RoleAssignment struct {
+	ent.Schema
+}
+
+// Fields of the RoleAssignment.
+func (RoleAssignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique().
+			Comment("Unique identifier for the role assignment. This is a UUID that is generated when the role is assigned."),
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable().
+			Comment("Unique identifier for the user the role is assigned to."),
+		field.UUID("role_id", uuid.UUID{}).
+			Immutable().
+			Comment("Unique identifier for the assigned role."),
+		field.String("assigned_by").
+			Optional().
+			Immutable().
+			Comment("Identifier of the admin who assigned the role, for auditing purposes. This is optional."),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the role was assigned. This is set to the current time when the role is assigned."),
+	}
+}
+
+// Indexes of the RoleAssignment.
+func (RoleAssignment) Indexes() []ent.Index {
+	return []ent.Index{
+		// A role is assigned to a user at most once.
+		index.Fields("user_id", "role_id").
+			Unique(),
+	}
+}
+
+// Edges of the RoleAssignment.
+func (RoleAssignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("role_assignments").
+			Unique().
+			Field("user_id").
+			Required().
+			Immutable().
+			Comment("Edge to the User entity the role is assigned to."),
+		edge.From("role", Role.Type).
+			Ref("assignments").
+			Unique().
+			Field("role_id").
+			Required().
+			Immutable().
+			Comment("Edge to the assigned Role entity."),
+	}
+}
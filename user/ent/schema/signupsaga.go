@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SignupSaga holds the schema definition for the SignupSaga entity.
+type SignupSaga struct {
+	ent.Schema
+}
+
+// Fields of the SignupSaga.
+func (SignupSaga) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique().
+			Comment("Unique identifier for the signup."),
+		field.UUID("user_id", uuid.UUID{}).
+			Unique().
+			Immutable().
+			Comment("Unique identifier for the user being signed up. It is generated for the signup, and is the idempotency key of the calls to the auth and profile services."),
+		field.Enum("kind").
+			Values("local", "oauth").
+			Immutable().
+			Comment("Kind of the signup."),
+		field.Enum("step").
+			Values("user_created", "auth_created", "profile_created").
+			Default("user_created").
+			Comment("Last step of the signup which completed."),
+		field.Enum("status").
+			Values("running", "completed", "compensated").
+			Default("running").
+			Comment("Status of the signup. Running signups past their lease are resumed or compensated by the recovery worker."),
+		field.String("email").
+			Optional().
+			Nillable().
+			Comment("Email address of the user, known once the auth step completed. The profile step needs it to be resumed."),
+		field.Int("attempts").
+			Default(0).
+			Comment("Number of failed attempts to complete or compensate the signup."),
+		field.String("last_error").
+			Optional().
+			Nillable().
+			Comment("Error of the last failed attempt to complete or compensate the signup."),
+		field.UUID("lease_id", uuid.UUID{}).
+			Default(uuid.New).
+			Comment("Identifier of the lease held by the runner of the signup. The runner records the progress of the signup only while it holds the lease, so that a signup is never run twice at a time."),
+		field.Time("leased_until").
+			Comment("Timestamp when the lease of the runner expires. Running signups past their lease are taken over by the recovery worker."),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the signup started, in the same transaction as the creation of the user."),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Timestamp when the signup was last updated. Finished signups are deleted once no longer updated for their retention."),
+	}
+}
+
+// Indexes of the SignupSaga.
+func (SignupSaga) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "updated_at"),
+		index.Fields("status", "leased_until"),
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -31,6 +33,9 @@ func (User) Fields() []ent.Field {
 		field.String("sync_code").
 			Optional().
 			Comment("A code used for synchronizing user data across different systems. This is optional and can be used for integration purposes."),
+		field.Int64("sync_version").
+			Default(1).
+			Comment("The version of the user, incremented with every change announced by a user event, so that the consumers apply the events in order."),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
@@ -46,10 +51,37 @@ func (User) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Timestamp when the user was archived. This is set when the user is archived and can be used for auditing purposes."),
+		field.String("email").
+			Optional().
+			Nillable().
+			Comment("Email address of the user, as last set in the auth service at signup or by an email change."),
 		field.Time("delete_after").
 			Optional().
 			Nillable().
 			Comment("Timestamp after which the user will be deleted. This is set when the user is archived and can be used to schedule deletion of the user data."),
+		field.Time("birth_date").
+			Optional().
+			Nillable().
+			Immutable().
+			SchemaType(map[string]string{dialect.Postgres: "date"}).
+			Comment("Birth date of the user, as given at signup. Users who signed up before it was asked have none."),
+		field.Bool("is_minor").
+			Default(false).
+			Immutable().
+			Comment("Indicates if the user was under 14 at signup, which requires the consent of a guardian under PIPA."),
+		field.String("guardian_email").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Email address of the guardian of a minor, to which the consent link is sent."),
+		field.Enum("guardian_consent").
+			Values("not_required", "pending", "granted").
+			Default("not_required").
+			Comment("Status of the consent of the guardian of a minor. The account of a minor stays inactive while it is pending."),
+		field.Time("guardian_consented_at").
+			Optional().
+			Nillable().
+			Comment("Timestamp when the guardian of a minor consented to their account."),
 	}
 }
 
@@ -58,5 +90,14 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("sent_emails", SentEmail.Type).
 			Comment("Edge to the SentEmail entity. This establishes a relationship between the User and SentEmail entities, linking the user to the emails they have sent."),
+		edge.To("role_assignments", RoleAssignment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Edge to the RoleAssignment entity, linking the user to the roles assigned to them."),
+		edge.To("memberships", Membership.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Edge to the Membership entity, linking the user to the organizations they are a member of."),
+		edge.To("email_changes", EmailChange.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Edge to the EmailChange entity, linking the user to the changes of their email address."),
 	}
 }
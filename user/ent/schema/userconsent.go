@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// UserConsent holds the schema definition for the UserConsent entity.
+//
+// Consents are append-only: each choice of a user is recorded as a new consent, so that the full history is
+// kept, and the latest consent to a document is the one in effect.
+type UserConsent struct {
+	ent.Schema
+}
+
+// Fields of the UserConsent.
+func (UserConsent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique().
+			Comment("Unique identifier for the consent. This is a UUID that is generated when the consent is recorded."),
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable().
+			Comment("Unique identifier for the user who made the choice. It has no foreign key, so that the history outlives the user for as long as regulations require."),
+		field.UUID("document_id", uuid.UUID{}).
+			Immutable().
+			Comment("Unique identifier for the document the choice was made on."),
+		field.Bool("accepted").
+			Immutable().
+			Comment("Whether the user accepted the document. Only optional documents can be declined."),
+		field.String("ip").
+			Immutable().
+			Default("").
+			Comment("IP address from which the choice was made."),
+		field.String("user_agent").
+			Immutable().
+			Default("").
+			Comment("User agent with which the choice was made."),
+		field.Time("recorded_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the choice was recorded."),
+	}
+}
+
+// Edges of the UserConsent.
+func (UserConsent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("document", ConsentDocument.Type).
+			Ref("consents").
+			Field("document_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the UserConsent.
+func (UserConsent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "recorded_at"),
+	}
+}
@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/signupsaga"
+)
+
+// SignupSaga is the model entity for the SignupSaga schema.
+type SignupSaga struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique identifier for the signup.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Unique identifier for the user being signed up. It is generated for the signup, and is the idempotency key of the calls to the auth and profile services.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Kind of the signup.
+	Kind signupsaga.Kind `json:"kind,omitempty"`
+	// Last step of the signup which completed.
+	Step signupsaga.Step `json:"step,omitempty"`
+	// Status of the signup. Running signups past their lease are resumed or compensated by the recovery worker.
+	Status signupsaga.Status `json:"status,omitempty"`
+	// Email address of the user, known once the auth step completed. The profile step needs it to be resumed.
+	Email *string `json:"email,omitempty"`
+	// Number of failed attempts to complete or compensate the signup.
+	Attempts int `json:"attempts,omitempty"`
+	// Error of the last failed attempt to complete or compensate the signup.
+	LastError *string `json:"last_error,omitempty"`
+	// Identifier of the lease held by the runner of the signup. The runner records the progress of the signup only while it holds the lease, so that a signup is never run twice at a time.
+	LeaseID uuid.UUID `json:"lease_id,omitempty"`
+	// Timestamp when the lease of the runner expires. Running signups past their lease are taken over by the recovery worker.
+	LeasedUntil time.Time `json:"leased_until,omitempty"`
+	// Timestamp when the signup started, in the same transaction as the creation of the user.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Timestamp when the signup was last updated. Finished signups are deleted once no longer updated for their retention.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SignupSaga) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case signupsaga.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case signupsaga.FieldKind, signupsaga.FieldStep, signupsaga.FieldStatus, signupsaga.FieldEmail, signupsaga.FieldLastError:
+			values[i] = new(sql.NullString)
+		case signupsaga.FieldLeasedUntil, signupsaga.FieldCreatedAt, signupsaga.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case signupsaga.FieldID, signupsaga.FieldUserID, signupsaga.FieldLeaseID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SignupSaga fields.
+func (ss *SignupSaga) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case signupsaga.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ss.ID = *value
+			}
+		case signupsaga.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				ss.UserID = *value
+			}
+		case signupsaga.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				ss.Kind = signupsaga.Kind(value.String)
+			}
+		case signupsaga.FieldStep:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field step", values[i])
+			} else if value.Valid {
+				ss.Step = signupsaga.Step(value.String)
+			}
+		case signupsaga.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ss.Status = signupsaga.Status(value.String)
+			}
+		case signupsaga.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				ss.Email = new(string)
+				*ss.Email = value.String
+			}
+		case signupsaga.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				ss.Attempts = int(value.Int64)
+			}
+		case signupsaga.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				ss.LastError = new(string)
+				*ss.LastError = value.String
+			}
+		case signupsaga.FieldLeaseID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_id", values[i])
+			} else if value != nil {
+				ss.LeaseID = *value
+			}
+		case signupsaga.FieldLeasedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field leased_until", values[i])
+			} else if value.Valid {
+				ss.LeasedUntil = value.Time
+			}
+		case signupsaga.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ss.CreatedAt = value.Time
+			}
+		case signupsaga.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ss.UpdatedAt = value.Time
+			}
+		default:
+			ss.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SignupSaga.
+// This includes values selected through modifiers, order, etc.
+func (ss *SignupSaga) Value(name string) (ent.Value, error) {
+	return ss.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SignupSaga.
+// Note that you need to call SignupSaga.Unwrap() before calling this method if this SignupSaga
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ss *SignupSaga) Update() *SignupSagaUpdateOne {
+	return NewSignupSagaClient(ss.config).UpdateOne(ss)
+}
+
+// Unwrap unwraps the SignupSaga entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ss *SignupSaga) Unwrap() *SignupSaga {
+	_tx, ok := ss.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SignupSaga is not a transactional entity")
+	}
+	ss.config.driver = _tx.drv
+	return ss
+}
+
+// String implements the fmt.Stringer.
+func (ss *SignupSaga) String() string {
+	var builder strings.Builder
+	builder.WriteString("SignupSaga(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ss.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ss.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", ss.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("step=")
+	builder.WriteString(fmt.Sprintf("%v", ss.Step))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ss.Status))
+	builder.WriteString(", ")
+	if v := ss.Email; v != nil {
+		builder.WriteString("email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", ss.Attempts))
+	builder.WriteString(", ")
+	if v := ss.LastError; v != nil {
+		builder.WriteString("last_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("lease_id=")
+	builder.WriteString(fmt.Sprintf("%v", ss.LeaseID))
+	builder.WriteString(", ")
+	builder.WriteString("leased_until=")
+	builder.WriteString(ss.LeasedUntil.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ss.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ss.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SignupSagas is a parsable slice of SignupSaga.
+type SignupSagas []*SignupSaga
@@ -0,0 +1,561 @@
+// Code generated by ent, DO NOT EDIT.
+
+package signupsaga
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldUserID, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldEmail, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldAttempts, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldLastError, v))
+}
+
+// LeaseID applies equality check predicate on the "lease_id" field. It's identical to LeaseIDEQ.
+func LeaseID(v uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldLeaseID, v))
+}
+
+// LeasedUntil applies equality check predicate on the "leased_until" field. It's identical to LeasedUntilEQ.
+func LeasedUntil(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldLeasedUntil, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLTE(FieldUserID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// StepEQ applies the EQ predicate on the "step" field.
+func StepEQ(v Step) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldStep, v))
+}
+
+// StepNEQ applies the NEQ predicate on the "step" field.
+func StepNEQ(v Step) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNEQ(FieldStep, v))
+}
+
+// StepIn applies the In predicate on the "step" field.
+func StepIn(vs ...Step) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldIn(FieldStep, vs...))
+}
+
+// StepNotIn applies the NotIn predicate on the "step" field.
+func StepNotIn(vs ...Step) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNotIn(FieldStep, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldIsNull(FieldEmail))
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNotNull(FieldEmail))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLTE(FieldAttempts, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// LeaseIDEQ applies the EQ predicate on the "lease_id" field.
+func LeaseIDEQ(v uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldLeaseID, v))
+}
+
+// LeaseIDNEQ applies the NEQ predicate on the "lease_id" field.
+func LeaseIDNEQ(v uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNEQ(FieldLeaseID, v))
+}
+
+// LeaseIDIn applies the In predicate on the "lease_id" field.
+func LeaseIDIn(vs ...uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldIn(FieldLeaseID, vs...))
+}
+
+// LeaseIDNotIn applies the NotIn predicate on the "lease_id" field.
+func LeaseIDNotIn(vs ...uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNotIn(FieldLeaseID, vs...))
+}
+
+// LeaseIDGT applies the GT predicate on the "lease_id" field.
+func LeaseIDGT(v uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGT(FieldLeaseID, v))
+}
+
+// LeaseIDGTE applies the GTE predicate on the "lease_id" field.
+func LeaseIDGTE(v uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGTE(FieldLeaseID, v))
+}
+
+// LeaseIDLT applies the LT predicate on the "lease_id" field.
+func LeaseIDLT(v uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLT(FieldLeaseID, v))
+}
+
+// LeaseIDLTE applies the LTE predicate on the "lease_id" field.
+func LeaseIDLTE(v uuid.UUID) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLTE(FieldLeaseID, v))
+}
+
+// LeasedUntilEQ applies the EQ predicate on the "leased_until" field.
+func LeasedUntilEQ(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldLeasedUntil, v))
+}
+
+// LeasedUntilNEQ applies the NEQ predicate on the "leased_until" field.
+func LeasedUntilNEQ(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNEQ(FieldLeasedUntil, v))
+}
+
+// LeasedUntilIn applies the In predicate on the "leased_until" field.
+func LeasedUntilIn(vs ...time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldIn(FieldLeasedUntil, vs...))
+}
+
+// LeasedUntilNotIn applies the NotIn predicate on the "leased_until" field.
+func LeasedUntilNotIn(vs ...time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNotIn(FieldLeasedUntil, vs...))
+}
+
+// LeasedUntilGT applies the GT predicate on the "leased_until" field.
+func LeasedUntilGT(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGT(FieldLeasedUntil, v))
+}
+
+// LeasedUntilGTE applies the GTE predicate on the "leased_until" field.
+func LeasedUntilGTE(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGTE(FieldLeasedUntil, v))
+}
+
+// LeasedUntilLT applies the LT predicate on the "leased_until" field.
+func LeasedUntilLT(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLT(FieldLeasedUntil, v))
+}
+
+// LeasedUntilLTE applies the LTE predicate on the "leased_until" field.
+func LeasedUntilLTE(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLTE(FieldLeasedUntil, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SignupSaga) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SignupSaga) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SignupSaga) predicate.SignupSaga {
+	return predicate.SignupSaga(sql.NotPredicates(p))
+}
@@ -0,0 +1,441 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/signupsaga"
+)
+
+// SignupSagaCreate is the builder for creating a SignupSaga entity.
+type SignupSagaCreate struct {
+	config
+	mutation *SignupSagaMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (ssc *SignupSagaCreate) SetUserID(u uuid.UUID) *SignupSagaCreate {
+	ssc.mutation.SetUserID(u)
+	return ssc
+}
+
+// SetKind sets the "kind" field.
+func (ssc *SignupSagaCreate) SetKind(s signupsaga.Kind) *SignupSagaCreate {
+	ssc.mutation.SetKind(s)
+	return ssc
+}
+
+// SetStep sets the "step" field.
+func (ssc *SignupSagaCreate) SetStep(s signupsaga.Step) *SignupSagaCreate {
+	ssc.mutation.SetStep(s)
+	return ssc
+}
+
+// SetNillableStep sets the "step" field if the given value is not nil.
+func (ssc *SignupSagaCreate) SetNillableStep(s *signupsaga.Step) *SignupSagaCreate {
+	if s != nil {
+		ssc.SetStep(*s)
+	}
+	return ssc
+}
+
+// SetStatus sets the "status" field.
+func (ssc *SignupSagaCreate) SetStatus(s signupsaga.Status) *SignupSagaCreate {
+	ssc.mutation.SetStatus(s)
+	return ssc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ssc *SignupSagaCreate) SetNillableStatus(s *signupsaga.Status) *SignupSagaCreate {
+	if s != nil {
+		ssc.SetStatus(*s)
+	}
+	return ssc
+}
+
+// SetEmail sets the "email" field.
+func (ssc *SignupSagaCreate) SetEmail(s string) *SignupSagaCreate {
+	ssc.mutation.SetEmail(s)
+	return ssc
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (ssc *SignupSagaCreate) SetNillableEmail(s *string) *SignupSagaCreate {
+	if s != nil {
+		ssc.SetEmail(*s)
+	}
+	return ssc
+}
+
+// SetAttempts sets the "attempts" field.
+func (ssc *SignupSagaCreate) SetAttempts(i int) *SignupSagaCreate {
+	ssc.mutation.SetAttempts(i)
+	return ssc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ssc *SignupSagaCreate) SetNillableAttempts(i *int) *SignupSagaCreate {
+	if i != nil {
+		ssc.SetAttempts(*i)
+	}
+	return ssc
+}
+
+// SetLastError sets the "last_error" field.
+func (ssc *SignupSagaCreate) SetLastError(s string) *SignupSagaCreate {
+	ssc.mutation.SetLastError(s)
+	return ssc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ssc *SignupSagaCreate) SetNillableLastError(s *string) *SignupSagaCreate {
+	if s != nil {
+		ssc.SetLastError(*s)
+	}
+	return ssc
+}
+
+// SetLeaseID sets the "lease_id" field.
+func (ssc *SignupSagaCreate) SetLeaseID(u uuid.UUID) *SignupSagaCreate {
+	ssc.mutation.SetLeaseID(u)
+	return ssc
+}
+
+// SetNillableLeaseID sets the "lease_id" field if the given value is not nil.
+func (ssc *SignupSagaCreate) SetNillableLeaseID(u *uuid.UUID) *SignupSagaCreate {
+	if u != nil {
+		ssc.SetLeaseID(*u)
+	}
+	return ssc
+}
+
+// SetLeasedUntil sets the "leased_until" field.
+func (ssc *SignupSagaCreate) SetLeasedUntil(t time.Time) *SignupSagaCreate {
+	ssc.mutation.SetLeasedUntil(t)
+	return ssc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ssc *SignupSagaCreate) SetCreatedAt(t time.Time) *SignupSagaCreate {
+	ssc.mutation.SetCreatedAt(t)
+	return ssc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ssc *SignupSagaCreate) SetNillableCreatedAt(t *time.Time) *SignupSagaCreate {
+	if t != nil {
+		ssc.SetCreatedAt(*t)
+	}
+	return ssc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ssc *SignupSagaCreate) SetUpdatedAt(t time.Time) *SignupSagaCreate {
+	ssc.mutation.SetUpdatedAt(t)
+	return ssc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ssc *SignupSagaCreate) SetNillableUpdatedAt(t *time.Time) *SignupSagaCreate {
+	if t != nil {
+		ssc.SetUpdatedAt(*t)
+	}
+	return ssc
+}
+
+// SetID sets the "id" field.
+func (ssc *SignupSagaCreate) SetID(u uuid.UUID) *SignupSagaCreate {
+	ssc.mutation.SetID(u)
+	return ssc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ssc *SignupSagaCreate) SetNillableID(u *uuid.UUID) *SignupSagaCreate {
+	if u != nil {
+		ssc.SetID(*u)
+	}
+	return ssc
+}
+
+// Mutation returns the SignupSagaMutation object of the builder.
+func (ssc *SignupSagaCreate) Mutation() *SignupSagaMutation {
+	return ssc.mutation
+}
+
+// Save creates the SignupSaga in the database.
+func (ssc *SignupSagaCreate) Save(ctx context.Context) (*SignupSaga, error) {
+	ssc.defaults()
+	return withHooks(ctx, ssc.sqlSave, ssc.mutation, ssc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ssc *SignupSagaCreate) SaveX(ctx context.Context) *SignupSaga {
+	v, err := ssc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ssc *SignupSagaCreate) Exec(ctx context.Context) error {
+	_, err := ssc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssc *SignupSagaCreate) ExecX(ctx context.Context) {
+	if err := ssc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssc *SignupSagaCreate) defaults() {
+	if _, ok := ssc.mutation.Step(); !ok {
+		v := signupsaga.DefaultStep
+		ssc.mutation.SetStep(v)
+	}
+	if _, ok := ssc.mutation.Status(); !ok {
+		v := signupsaga.DefaultStatus
+		ssc.mutation.SetStatus(v)
+	}
+	if _, ok := ssc.mutation.Attempts(); !ok {
+		v := signupsaga.DefaultAttempts
+		ssc.mutation.SetAttempts(v)
+	}
+	if _, ok := ssc.mutation.LeaseID(); !ok {
+		v := signupsaga.DefaultLeaseID()
+		ssc.mutation.SetLeaseID(v)
+	}
+	if _, ok := ssc.mutation.CreatedAt(); !ok {
+		v := signupsaga.DefaultCreatedAt()
+		ssc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ssc.mutation.UpdatedAt(); !ok {
+		v := signupsaga.DefaultUpdatedAt()
+		ssc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ssc.mutation.ID(); !ok {
+		v := signupsaga.DefaultID()
+		ssc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssc *SignupSagaCreate) check() error {
+	if _, ok := ssc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SignupSaga.user_id"`)}
+	}
+	if _, ok := ssc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "SignupSaga.kind"`)}
+	}
+	if v, ok := ssc.mutation.Kind(); ok {
+		if err := signupsaga.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SignupSaga.kind": %w`, err)}
+		}
+	}
+	if _, ok := ssc.mutation.Step(); !ok {
+		return &ValidationError{Name: "step", err: errors.New(`ent: missing required field "SignupSaga.step"`)}
+	}
+	if v, ok := ssc.mutation.Step(); ok {
+		if err := signupsaga.StepValidator(v); err != nil {
+			return &ValidationError{Name: "step", err: fmt.Errorf(`ent: validator failed for field "SignupSaga.step": %w`, err)}
+		}
+	}
+	if _, ok := ssc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SignupSaga.status"`)}
+	}
+	if v, ok := ssc.mutation.Status(); ok {
+		if err := signupsaga.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SignupSaga.status": %w`, err)}
+		}
+	}
+	if _, ok := ssc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "SignupSaga.attempts"`)}
+	}
+	if _, ok := ssc.mutation.LeaseID(); !ok {
+		return &ValidationError{Name: "lease_id", err: errors.New(`ent: missing required field "SignupSaga.lease_id"`)}
+	}
+	if _, ok := ssc.mutation.LeasedUntil(); !ok {
+		return &ValidationError{Name: "leased_until", err: errors.New(`ent: missing required field "SignupSaga.leased_until"`)}
+	}
+	if _, ok := ssc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SignupSaga.created_at"`)}
+	}
+	if _, ok := ssc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SignupSaga.updated_at"`)}
+	}
+	return nil
+}
+
+func (ssc *SignupSagaCreate) sqlSave(ctx context.Context) (*SignupSaga, error) {
+	if err := ssc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ssc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ssc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ssc.mutation.id = &_node.ID
+	ssc.mutation.done = true
+	return _node, nil
+}
+
+func (ssc *SignupSagaCreate) createSpec() (*SignupSaga, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SignupSaga{config: ssc.config}
+		_spec = sqlgraph.NewCreateSpec(signupsaga.Table, sqlgraph.NewFieldSpec(signupsaga.FieldID, field.TypeUUID))
+	)
+	if id, ok := ssc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ssc.mutation.UserID(); ok {
+		_spec.SetField(signupsaga.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := ssc.mutation.Kind(); ok {
+		_spec.SetField(signupsaga.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := ssc.mutation.Step(); ok {
+		_spec.SetField(signupsaga.FieldStep, field.TypeEnum, value)
+		_node.Step = value
+	}
+	if value, ok := ssc.mutation.Status(); ok {
+		_spec.SetField(signupsaga.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ssc.mutation.Email(); ok {
+		_spec.SetField(signupsaga.FieldEmail, field.TypeString, value)
+		_node.Email = &value
+	}
+	if value, ok := ssc.mutation.Attempts(); ok {
+		_spec.SetField(signupsaga.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := ssc.mutation.LastError(); ok {
+		_spec.SetField(signupsaga.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := ssc.mutation.LeaseID(); ok {
+		_spec.SetField(signupsaga.FieldLeaseID, field.TypeUUID, value)
+		_node.LeaseID = value
+	}
+	if value, ok := ssc.mutation.LeasedUntil(); ok {
+		_spec.SetField(signupsaga.FieldLeasedUntil, field.TypeTime, value)
+		_node.LeasedUntil = value
+	}
+	if value, ok := ssc.mutation.CreatedAt(); ok {
+		_spec.SetField(signupsaga.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ssc.mutation.UpdatedAt(); ok {
+		_spec.SetField(signupsaga.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// SignupSagaCreateBulk is the builder for creating many SignupSaga entities in bulk.
+type SignupSagaCreateBulk struct {
+	config
+	err      error
+	builders []*SignupSagaCreate
+}
+
+// Save creates the SignupSaga entities in the database.
+func (sscb *SignupSagaCreateBulk) Save(ctx context.Context) ([]*SignupSaga, error) {
+	if sscb.err != nil {
+		return nil, sscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sscb.builders))
+	nodes := make([]*SignupSaga, len(sscb.builders))
+	mutators := make([]Mutator, len(sscb.builders))
+	for i := range sscb.builders {
+		func(i int, root context.Context) {
+			builder := sscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SignupSagaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sscb *SignupSagaCreateBulk) SaveX(ctx context.Context) []*SignupSaga {
+	v, err := sscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sscb *SignupSagaCreateBulk) Exec(ctx context.Context) error {
+	_, err := sscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscb *SignupSagaCreateBulk) ExecX(ctx context.Context) {
+	if err := sscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
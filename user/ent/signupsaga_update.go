@@ -0,0 +1,543 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/predicate"
+	"mandacode.com/accounts/user/ent/signupsaga"
+)
+
+// SignupSagaUpdate is the builder for updating SignupSaga entities.
+type SignupSagaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SignupSagaMutation
+}
+
+// Where appends a list predicates to the SignupSagaUpdate builder.
+func (ssu *SignupSagaUpdate) Where(ps ...predicate.SignupSaga) *SignupSagaUpdate {
+	ssu.mutation.Where(ps...)
+	return ssu
+}
+
+// SetStep sets the "step" field.
+func (ssu *SignupSagaUpdate) SetStep(s signupsaga.Step) *SignupSagaUpdate {
+	ssu.mutation.SetStep(s)
+	return ssu
+}
+
+// SetNillableStep sets the "step" field if the given value is not nil.
+func (ssu *SignupSagaUpdate) SetNillableStep(s *signupsaga.Step) *SignupSagaUpdate {
+	if s != nil {
+		ssu.SetStep(*s)
+	}
+	return ssu
+}
+
+// SetStatus sets the "status" field.
+func (ssu *SignupSagaUpdate) SetStatus(s signupsaga.Status) *SignupSagaUpdate {
+	ssu.mutation.SetStatus(s)
+	return ssu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ssu *SignupSagaUpdate) SetNillableStatus(s *signupsaga.Status) *SignupSagaUpdate {
+	if s != nil {
+		ssu.SetStatus(*s)
+	}
+	return ssu
+}
+
+// SetEmail sets the "email" field.
+func (ssu *SignupSagaUpdate) SetEmail(s string) *SignupSagaUpdate {
+	ssu.mutation.SetEmail(s)
+	return ssu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (ssu *SignupSagaUpdate) SetNillableEmail(s *string) *SignupSagaUpdate {
+	if s != nil {
+		ssu.SetEmail(*s)
+	}
+	return ssu
+}
+
+// ClearEmail clears the value of the "email" field.
+func (ssu *SignupSagaUpdate) ClearEmail() *SignupSagaUpdate {
+	ssu.mutation.ClearEmail()
+	return ssu
+}
+
+// SetAttempts sets the "attempts" field.
+func (ssu *SignupSagaUpdate) SetAttempts(i int) *SignupSagaUpdate {
+	ssu.mutation.ResetAttempts()
+	ssu.mutation.SetAttempts(i)
+	return ssu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ssu *SignupSagaUpdate) SetNillableAttempts(i *int) *SignupSagaUpdate {
+	if i != nil {
+		ssu.SetAttempts(*i)
+	}
+	return ssu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (ssu *SignupSagaUpdate) AddAttempts(i int) *SignupSagaUpdate {
+	ssu.mutation.AddAttempts(i)
+	return ssu
+}
+
+// SetLastError sets the "last_error" field.
+func (ssu *SignupSagaUpdate) SetLastError(s string) *SignupSagaUpdate {
+	ssu.mutation.SetLastError(s)
+	return ssu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ssu *SignupSagaUpdate) SetNillableLastError(s *string) *SignupSagaUpdate {
+	if s != nil {
+		ssu.SetLastError(*s)
+	}
+	return ssu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (ssu *SignupSagaUpdate) ClearLastError() *SignupSagaUpdate {
+	ssu.mutation.ClearLastError()
+	return ssu
+}
+
+// SetLeaseID sets the "lease_id" field.
+func (ssu *SignupSagaUpdate) SetLeaseID(u uuid.UUID) *SignupSagaUpdate {
+	ssu.mutation.SetLeaseID(u)
+	return ssu
+}
+
+// SetNillableLeaseID sets the "lease_id" field if the given value is not nil.
+func (ssu *SignupSagaUpdate) SetNillableLeaseID(u *uuid.UUID) *SignupSagaUpdate {
+	if u != nil {
+		ssu.SetLeaseID(*u)
+	}
+	return ssu
+}
+
+// SetLeasedUntil sets the "leased_until" field.
+func (ssu *SignupSagaUpdate) SetLeasedUntil(t time.Time) *SignupSagaUpdate {
+	ssu.mutation.SetLeasedUntil(t)
+	return ssu
+}
+
+// SetNillableLeasedUntil sets the "leased_until" field if the given value is not nil.
+func (ssu *SignupSagaUpdate) SetNillableLeasedUntil(t *time.Time) *SignupSagaUpdate {
+	if t != nil {
+		ssu.SetLeasedUntil(*t)
+	}
+	return ssu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ssu *SignupSagaUpdate) SetUpdatedAt(t time.Time) *SignupSagaUpdate {
+	ssu.mutation.SetUpdatedAt(t)
+	return ssu
+}
+
+// Mutation returns the SignupSagaMutation object of the builder.
+func (ssu *SignupSagaUpdate) Mutation() *SignupSagaMutation {
+	return ssu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ssu *SignupSagaUpdate) Save(ctx context.Context) (int, error) {
+	ssu.defaults()
+	return withHooks(ctx, ssu.sqlSave, ssu.mutation, ssu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssu *SignupSagaUpdate) SaveX(ctx context.Context) int {
+	affected, err := ssu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ssu *SignupSagaUpdate) Exec(ctx context.Context) error {
+	_, err := ssu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssu *SignupSagaUpdate) ExecX(ctx context.Context) {
+	if err := ssu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssu *SignupSagaUpdate) defaults() {
+	if _, ok := ssu.mutation.UpdatedAt(); !ok {
+		v := signupsaga.UpdateDefaultUpdatedAt()
+		ssu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssu *SignupSagaUpdate) check() error {
+	if v, ok := ssu.mutation.Step(); ok {
+		if err := signupsaga.StepValidator(v); err != nil {
+			return &ValidationError{Name: "step", err: fmt.Errorf(`ent: validator failed for field "SignupSaga.step": %w`, err)}
+		}
+	}
+	if v, ok := ssu.mutation.Status(); ok {
+		if err := signupsaga.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SignupSaga.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ssu *SignupSagaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ssu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(signupsaga.Table, signupsaga.Columns, sqlgraph.NewFieldSpec(signupsaga.FieldID, field.TypeUUID))
+	if ps := ssu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssu.mutation.Step(); ok {
+		_spec.SetField(signupsaga.FieldStep, field.TypeEnum, value)
+	}
+	if value, ok := ssu.mutation.Status(); ok {
+		_spec.SetField(signupsaga.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ssu.mutation.Email(); ok {
+		_spec.SetField(signupsaga.FieldEmail, field.TypeString, value)
+	}
+	if ssu.mutation.EmailCleared() {
+		_spec.ClearField(signupsaga.FieldEmail, field.TypeString)
+	}
+	if value, ok := ssu.mutation.Attempts(); ok {
+		_spec.SetField(signupsaga.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ssu.mutation.AddedAttempts(); ok {
+		_spec.AddField(signupsaga.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ssu.mutation.LastError(); ok {
+		_spec.SetField(signupsaga.FieldLastError, field.TypeString, value)
+	}
+	if ssu.mutation.LastErrorCleared() {
+		_spec.ClearField(signupsaga.FieldLastError, field.TypeString)
+	}
+	if value, ok := ssu.mutation.LeaseID(); ok {
+		_spec.SetField(signupsaga.FieldLeaseID, field.TypeUUID, value)
+	}
+	if value, ok := ssu.mutation.LeasedUntil(); ok {
+		_spec.SetField(signupsaga.FieldLeasedUntil, field.TypeTime, value)
+	}
+	if value, ok := ssu.mutation.UpdatedAt(); ok {
+		_spec.SetField(signupsaga.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ssu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signupsaga.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ssu.mutation.done = true
+	return n, nil
+}
+
+// SignupSagaUpdateOne is the builder for updating a single SignupSaga entity.
+type SignupSagaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SignupSagaMutation
+}
+
+// SetStep sets the "step" field.
+func (ssuo *SignupSagaUpdateOne) SetStep(s signupsaga.Step) *SignupSagaUpdateOne {
+	ssuo.mutation.SetStep(s)
+	return ssuo
+}
+
+// SetNillableStep sets the "step" field if the given value is not nil.
+func (ssuo *SignupSagaUpdateOne) SetNillableStep(s *signupsaga.Step) *SignupSagaUpdateOne {
+	if s != nil {
+		ssuo.SetStep(*s)
+	}
+	return ssuo
+}
+
+// SetStatus sets the "status" field.
+func (ssuo *SignupSagaUpdateOne) SetStatus(s signupsaga.Status) *SignupSagaUpdateOne {
+	ssuo.mutation.SetStatus(s)
+	return ssuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ssuo *SignupSagaUpdateOne) SetNillableStatus(s *signupsaga.Status) *SignupSagaUpdateOne {
+	if s != nil {
+		ssuo.SetStatus(*s)
+	}
+	return ssuo
+}
+
+// SetEmail sets the "email" field.
+func (ssuo *SignupSagaUpdateOne) SetEmail(s string) *SignupSagaUpdateOne {
+	ssuo.mutation.SetEmail(s)
+	return ssuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (ssuo *SignupSagaUpdateOne) SetNillableEmail(s *string) *SignupSagaUpdateOne {
+	if s != nil {
+		ssuo.SetEmail(*s)
+	}
+	return ssuo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (ssuo *SignupSagaUpdateOne) ClearEmail() *SignupSagaUpdateOne {
+	ssuo.mutation.ClearEmail()
+	return ssuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (ssuo *SignupSagaUpdateOne) SetAttempts(i int) *SignupSagaUpdateOne {
+	ssuo.mutation.ResetAttempts()
+	ssuo.mutation.SetAttempts(i)
+	return ssuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ssuo *SignupSagaUpdateOne) SetNillableAttempts(i *int) *SignupSagaUpdateOne {
+	if i != nil {
+		ssuo.SetAttempts(*i)
+	}
+	return ssuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (ssuo *SignupSagaUpdateOne) AddAttempts(i int) *SignupSagaUpdateOne {
+	ssuo.mutation.AddAttempts(i)
+	return ssuo
+}
+
+// SetLastError sets the "last_error" field.
+func (ssuo *SignupSagaUpdateOne) SetLastError(s string) *SignupSagaUpdateOne {
+	ssuo.mutation.SetLastError(s)
+	return ssuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ssuo *SignupSagaUpdateOne) SetNillableLastError(s *string) *SignupSagaUpdateOne {
+	if s != nil {
+		ssuo.SetLastError(*s)
+	}
+	return ssuo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (ssuo *SignupSagaUpdateOne) ClearLastError() *SignupSagaUpdateOne {
+	ssuo.mutation.ClearLastError()
+	return ssuo
+}
+
+// SetLeaseID sets the "lease_id" field.
+func (ssuo *SignupSagaUpdateOne) SetLeaseID(u uuid.UUID) *SignupSagaUpdateOne {
+	ssuo.mutation.SetLeaseID(u)
+	return ssuo
+}
+
+// SetNillableLeaseID sets the "lease_id" field if the given value is not nil.
+func (ssuo *SignupSagaUpdateOne) SetNillableLeaseID(u *uuid.UUID) *SignupSagaUpdateOne {
+	if u != nil {
+		ssuo.SetLeaseID(*u)
+	}
+	return ssuo
+}
+
+// SetLeasedUntil sets the "leased_until" field.
+func (ssuo *SignupSagaUpdateOne) SetLeasedUntil(t time.Time) *SignupSagaUpdateOne {
+	ssuo.mutation.SetLeasedUntil(t)
+	return ssuo
+}
+
+// SetNillableLeasedUntil sets the "leased_until" field if the given value is not nil.
+func (ssuo *SignupSagaUpdateOne) SetNillableLeasedUntil(t *time.Time) *SignupSagaUpdateOne {
+	if t != nil {
+		ssuo.SetLeasedUntil(*t)
+	}
+	return ssuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ssuo *SignupSagaUpdateOne) SetUpdatedAt(t time.Time) *SignupSagaUpdateOne {
+	ssuo.mutation.SetUpdatedAt(t)
+	return ssuo
+}
+
+// Mutation returns the SignupSagaMutation object of the builder.
+func (ssuo *SignupSagaUpdateOne) Mutation() *SignupSagaMutation {
+	return ssuo.mutation
+}
+
+// Where appends a list predicates to the SignupSagaUpdate builder.
+func (ssuo *SignupSagaUpdateOne) Where(ps ...predicate.SignupSaga) *SignupSagaUpdateOne {
+	ssuo.mutation.Where(ps...)
+	return ssuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ssuo *SignupSagaUpdateOne) Select(field string, fields ...string) *SignupSagaUpdateOne {
+	ssuo.fields = append([]string{field}, fields...)
+	return ssuo
+}
+
+// Save executes the query and returns the updated SignupSaga entity.
+func (ssuo *SignupSagaUpdateOne) Save(ctx context.Context) (*SignupSaga, error) {
+	ssuo.defaults()
+	return withHooks(ctx, ssuo.sqlSave, ssuo.mutation, ssuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssuo *SignupSagaUpdateOne) SaveX(ctx context.Context) *SignupSaga {
+	node, err := ssuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ssuo *SignupSagaUpdateOne) Exec(ctx context.Context) error {
+	_, err := ssuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssuo *SignupSagaUpdateOne) ExecX(ctx context.Context) {
+	if err := ssuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssuo *SignupSagaUpdateOne) defaults() {
+	if _, ok := ssuo.mutation.UpdatedAt(); !ok {
+		v := signupsaga.UpdateDefaultUpdatedAt()
+		ssuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssuo *SignupSagaUpdateOne) check() error {
+	if v, ok := ssuo.mutation.Step(); ok {
+		if err := signupsaga.StepValidator(v); err != nil {
+			return &ValidationError{Name: "step", err: fmt.Errorf(`ent: validator failed for field "SignupSaga.step": %w`, err)}
+		}
+	}
+	if v, ok := ssuo.mutation.Status(); ok {
+		if err := signupsaga.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SignupSaga.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ssuo *SignupSagaUpdateOne) sqlSave(ctx context.Context) (_node *SignupSaga, err error) {
+	if err := ssuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(signupsaga.Table, signupsaga.Columns, sqlgraph.NewFieldSpec(signupsaga.FieldID, field.TypeUUID))
+	id, ok := ssuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SignupSaga.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ssuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, signupsaga.FieldID)
+		for _, f := range fields {
+			if !signupsaga.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != signupsaga.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ssuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssuo.mutation.Step(); ok {
+		_spec.SetField(signupsaga.FieldStep, field.TypeEnum, value)
+	}
+	if value, ok := ssuo.mutation.Status(); ok {
+		_spec.SetField(signupsaga.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ssuo.mutation.Email(); ok {
+		_spec.SetField(signupsaga.FieldEmail, field.TypeString, value)
+	}
+	if ssuo.mutation.EmailCleared() {
+		_spec.ClearField(signupsaga.FieldEmail, field.TypeString)
+	}
+	if value, ok := ssuo.mutation.Attempts(); ok {
+		_spec.SetField(signupsaga.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ssuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(signupsaga.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ssuo.mutation.LastError(); ok {
+		_spec.SetField(signupsaga.FieldLastError, field.TypeString, value)
+	}
+	if ssuo.mutation.LastErrorCleared() {
+		_spec.ClearField(signupsaga.FieldLastError, field.TypeString)
+	}
+	if value, ok := ssuo.mutation.LeaseID(); ok {
+		_spec.SetField(signupsaga.FieldLeaseID, field.TypeUUID, value)
+	}
+	if value, ok := ssuo.mutation.LeasedUntil(); ok {
+		_spec.SetField(signupsaga.FieldLeasedUntil, field.TypeTime, value)
+	}
+	if value, ok := ssuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(signupsaga.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &SignupSaga{config: ssuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ssuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signupsaga.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ssuo.mutation.done = true
+	return _node, nil
+}
@@ -25,6 +25,8 @@ type User struct {
 	IsBlocked bool `json:"is_blocked,omitempty"`
 	// A code used for synchronizing user data across different systems. This is optional and can be used for integration purposes.
 	SyncCode string `json:"sync_code,omitempty"`
+	// The version of the user, incremented with every change announced by a user event, so that the consumers apply the events in order.
+	SyncVersion int64 `json:"sync_version,omitempty"`
 	// Timestamp when the user was created. This is set to the current time when the user is created.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Timestamp when the user was last updated. This is set to the current time whenever the user is updated.
@@ -33,8 +35,20 @@ type User struct {
 	IsArchived bool `json:"is_archived,omitempty"`
 	// Timestamp when the user was archived. This is set when the user is archived and can be used for auditing purposes.
 	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// Email address of the user, as last set in the auth service at signup or by an email change.
+	Email *string `json:"email,omitempty"`
 	// Timestamp after which the user will be deleted. This is set when the user is archived and can be used to schedule deletion of the user data.
 	DeleteAfter *time.Time `json:"delete_after,omitempty"`
+	// Birth date of the user, as given at signup. Users who signed up before it was asked have none.
+	BirthDate *time.Time `json:"birth_date,omitempty"`
+	// Indicates if the user was under 14 at signup, which requires the consent of a guardian under PIPA.
+	IsMinor bool `json:"is_minor,omitempty"`
+	// Email address of the guardian of a minor, to which the consent link is sent.
+	GuardianEmail *string `json:"guardian_email,omitempty"`
+	// Status of the consent of the guardian of a minor. The account of a minor stays inactive while it is pending.
+	GuardianConsent user.GuardianConsent `json:"guardian_consent,omitempty"`
+	// Timestamp when the guardian of a minor consented to their account.
+	GuardianConsentedAt *time.Time `json:"guardian_consented_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -45,9 +59,15 @@ type User struct {
 type UserEdges struct {
 	// Edge to the SentEmail entity. This establishes a relationship between the User and SentEmail entities, linking the user to the emails they have sent.
 	SentEmails []*SentEmail `json:"sent_emails,omitempty"`
+	// Edge to the RoleAssignment entity, linking the user to the roles assigned to them.
+	RoleAssignments []*RoleAssignment `json:"role_assignments,omitempty"`
+	// Edge to the Membership entity, linking the user to the organizations they are a member of.
+	Memberships []*Membership `json:"memberships,omitempty"`
+	// Edge to the EmailChange entity, linking the user to the changes of their email address.
+	EmailChanges []*EmailChange `json:"email_changes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // SentEmailsOrErr returns the SentEmails value or an error if the edge
@@ -59,16 +79,45 @@ func (e UserEdges) SentEmailsOrErr() ([]*SentEmail, error) {
 	return nil, &NotLoadedError{edge: "sent_emails"}
 }
 
+// RoleAssignmentsOrErr returns the RoleAssignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RoleAssignmentsOrErr() ([]*RoleAssignment, error) {
+	if e.loadedTypes[1] {
+		return e.RoleAssignments, nil
+	}
+	return nil, &NotLoadedError{edge: "role_assignments"}
+}
+
+// MembershipsOrErr returns the Memberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MembershipsOrErr() ([]*Membership, error) {
+	if e.loadedTypes[2] {
+		return e.Memberships, nil
+	}
+	return nil, &NotLoadedError{edge: "memberships"}
+}
+
+// EmailChangesOrErr returns the EmailChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EmailChangesOrErr() ([]*EmailChange, error) {
+	if e.loadedTypes[3] {
+		return e.EmailChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "email_changes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldIsActive, user.FieldIsBlocked, user.FieldIsArchived:
+		case user.FieldIsActive, user.FieldIsBlocked, user.FieldIsArchived, user.FieldIsMinor:
 			values[i] = new(sql.NullBool)
-		case user.FieldSyncCode:
+		case user.FieldSyncVersion:
+			values[i] = new(sql.NullInt64)
+		case user.FieldSyncCode, user.FieldEmail, user.FieldGuardianEmail, user.FieldGuardianConsent:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldArchivedAt, user.FieldDeleteAfter:
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldArchivedAt, user.FieldDeleteAfter, user.FieldBirthDate, user.FieldGuardianConsentedAt:
 			values[i] = new(sql.NullTime)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
@@ -111,6 +160,12 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.SyncCode = value.String
 			}
+		case user.FieldSyncVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sync_version", values[i])
+			} else if value.Valid {
+				u.SyncVersion = value.Int64
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -136,6 +191,13 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.ArchivedAt = new(time.Time)
 				*u.ArchivedAt = value.Time
 			}
+		case user.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				u.Email = new(string)
+				*u.Email = value.String
+			}
 		case user.FieldDeleteAfter:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field delete_after", values[i])
@@ -143,6 +205,39 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.DeleteAfter = new(time.Time)
 				*u.DeleteAfter = value.Time
 			}
+		case user.FieldBirthDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field birth_date", values[i])
+			} else if value.Valid {
+				u.BirthDate = new(time.Time)
+				*u.BirthDate = value.Time
+			}
+		case user.FieldIsMinor:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_minor", values[i])
+			} else if value.Valid {
+				u.IsMinor = value.Bool
+			}
+		case user.FieldGuardianEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guardian_email", values[i])
+			} else if value.Valid {
+				u.GuardianEmail = new(string)
+				*u.GuardianEmail = value.String
+			}
+		case user.FieldGuardianConsent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guardian_consent", values[i])
+			} else if value.Valid {
+				u.GuardianConsent = user.GuardianConsent(value.String)
+			}
+		case user.FieldGuardianConsentedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field guardian_consented_at", values[i])
+			} else if value.Valid {
+				u.GuardianConsentedAt = new(time.Time)
+				*u.GuardianConsentedAt = value.Time
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -161,6 +256,21 @@ func (u *User) QuerySentEmails() *SentEmailQuery {
 	return NewUserClient(u.config).QuerySentEmails(u)
 }
 
+// QueryRoleAssignments queries the "role_assignments" edge of the User entity.
+func (u *User) QueryRoleAssignments() *RoleAssignmentQuery {
+	return NewUserClient(u.config).QueryRoleAssignments(u)
+}
+
+// QueryMemberships queries the "memberships" edge of the User entity.
+func (u *User) QueryMemberships() *MembershipQuery {
+	return NewUserClient(u.config).QueryMemberships(u)
+}
+
+// QueryEmailChanges queries the "email_changes" edge of the User entity.
+func (u *User) QueryEmailChanges() *EmailChangeQuery {
+	return NewUserClient(u.config).QueryEmailChanges(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -193,6 +303,9 @@ func (u *User) String() string {
 	builder.WriteString("sync_code=")
 	builder.WriteString(u.SyncCode)
 	builder.WriteString(", ")
+	builder.WriteString("sync_version=")
+	builder.WriteString(fmt.Sprintf("%v", u.SyncVersion))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -207,10 +320,36 @@ func (u *User) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := u.Email; v != nil {
+		builder.WriteString("email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := u.DeleteAfter; v != nil {
 		builder.WriteString("delete_after=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := u.BirthDate; v != nil {
+		builder.WriteString("birth_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_minor=")
+	builder.WriteString(fmt.Sprintf("%v", u.IsMinor))
+	builder.WriteString(", ")
+	if v := u.GuardianEmail; v != nil {
+		builder.WriteString("guardian_email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("guardian_consent=")
+	builder.WriteString(fmt.Sprintf("%v", u.GuardianConsent))
+	builder.WriteString(", ")
+	if v := u.GuardianConsentedAt; v != nil {
+		builder.WriteString("guardian_consented_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package user
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -21,6 +22,8 @@ const (
 	FieldIsBlocked = "is_blocked"
 	// FieldSyncCode holds the string denoting the sync_code field in the database.
 	FieldSyncCode = "sync_code"
+	// FieldSyncVersion holds the string denoting the sync_version field in the database.
+	FieldSyncVersion = "sync_version"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -29,10 +32,28 @@ const (
 	FieldIsArchived = "is_archived"
 	// FieldArchivedAt holds the string denoting the archived_at field in the database.
 	FieldArchivedAt = "archived_at"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
 	// FieldDeleteAfter holds the string denoting the delete_after field in the database.
 	FieldDeleteAfter = "delete_after"
+	// FieldBirthDate holds the string denoting the birth_date field in the database.
+	FieldBirthDate = "birth_date"
+	// FieldIsMinor holds the string denoting the is_minor field in the database.
+	FieldIsMinor = "is_minor"
+	// FieldGuardianEmail holds the string denoting the guardian_email field in the database.
+	FieldGuardianEmail = "guardian_email"
+	// FieldGuardianConsent holds the string denoting the guardian_consent field in the database.
+	FieldGuardianConsent = "guardian_consent"
+	// FieldGuardianConsentedAt holds the string denoting the guardian_consented_at field in the database.
+	FieldGuardianConsentedAt = "guardian_consented_at"
 	// EdgeSentEmails holds the string denoting the sent_emails edge name in mutations.
 	EdgeSentEmails = "sent_emails"
+	// EdgeRoleAssignments holds the string denoting the role_assignments edge name in mutations.
+	EdgeRoleAssignments = "role_assignments"
+	// EdgeMemberships holds the string denoting the memberships edge name in mutations.
+	EdgeMemberships = "memberships"
+	// EdgeEmailChanges holds the string denoting the email_changes edge name in mutations.
+	EdgeEmailChanges = "email_changes"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// SentEmailsTable is the table that holds the sent_emails relation/edge.
@@ -42,6 +63,27 @@ const (
 	SentEmailsInverseTable = "sent_emails"
 	// SentEmailsColumn is the table column denoting the sent_emails relation/edge.
 	SentEmailsColumn = "user_id"
+	// RoleAssignmentsTable is the table that holds the role_assignments relation/edge.
+	RoleAssignmentsTable = "role_assignments"
+	// RoleAssignmentsInverseTable is the table name for the RoleAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "roleassignment" package.
+	RoleAssignmentsInverseTable = "role_assignments"
+	// RoleAssignmentsColumn is the table column denoting the role_assignments relation/edge.
+	RoleAssignmentsColumn = "user_id"
+	// MembershipsTable is the table that holds the memberships relation/edge.
+	MembershipsTable = "memberships"
+	// MembershipsInverseTable is the table name for the Membership entity.
+	// It exists in this package in order to avoid circular dependency with the "membership" package.
+	MembershipsInverseTable = "memberships"
+	// MembershipsColumn is the table column denoting the memberships relation/edge.
+	MembershipsColumn = "user_id"
+	// EmailChangesTable is the table that holds the email_changes relation/edge.
+	EmailChangesTable = "email_changes"
+	// EmailChangesInverseTable is the table name for the EmailChange entity.
+	// It exists in this package in order to avoid circular dependency with the "emailchange" package.
+	EmailChangesInverseTable = "email_changes"
+	// EmailChangesColumn is the table column denoting the email_changes relation/edge.
+	EmailChangesColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -50,11 +92,18 @@ var Columns = []string{
 	FieldIsActive,
 	FieldIsBlocked,
 	FieldSyncCode,
+	FieldSyncVersion,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldIsArchived,
 	FieldArchivedAt,
+	FieldEmail,
 	FieldDeleteAfter,
+	FieldBirthDate,
+	FieldIsMinor,
+	FieldGuardianEmail,
+	FieldGuardianConsent,
+	FieldGuardianConsentedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -72,6 +121,8 @@ var (
 	DefaultIsActive bool
 	// DefaultIsBlocked holds the default value on creation for the "is_blocked" field.
 	DefaultIsBlocked bool
+	// DefaultSyncVersion holds the default value on creation for the "sync_version" field.
+	DefaultSyncVersion int64
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -80,10 +131,39 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultIsArchived holds the default value on creation for the "is_archived" field.
 	DefaultIsArchived bool
+	// DefaultIsMinor holds the default value on creation for the "is_minor" field.
+	DefaultIsMinor bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// GuardianConsent defines the type for the "guardian_consent" enum field.
+type GuardianConsent string
+
+// GuardianConsentNotRequired is the default value of the GuardianConsent enum.
+const DefaultGuardianConsent = GuardianConsentNotRequired
+
+// GuardianConsent values.
+const (
+	GuardianConsentNotRequired GuardianConsent = "not_required"
+	GuardianConsentPending     GuardianConsent = "pending"
+	GuardianConsentGranted     GuardianConsent = "granted"
+)
+
+func (gc GuardianConsent) String() string {
+	return string(gc)
+}
+
+// GuardianConsentValidator is a validator for the "guardian_consent" field enum values. It is called by the builders before save.
+func GuardianConsentValidator(gc GuardianConsent) error {
+	switch gc {
+	case GuardianConsentNotRequired, GuardianConsentPending, GuardianConsentGranted:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for guardian_consent field: %q", gc)
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -107,6 +187,11 @@ func BySyncCode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSyncCode, opts...).ToFunc()
 }
 
+// BySyncVersion orders the results by the sync_version field.
+func BySyncVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSyncVersion, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -127,11 +212,41 @@ func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
 }
 
+// ByEmail orders the results by the email field.
+func ByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmail, opts...).ToFunc()
+}
+
 // ByDeleteAfter orders the results by the delete_after field.
 func ByDeleteAfter(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeleteAfter, opts...).ToFunc()
 }
 
+// ByBirthDate orders the results by the birth_date field.
+func ByBirthDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBirthDate, opts...).ToFunc()
+}
+
+// ByIsMinor orders the results by the is_minor field.
+func ByIsMinor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsMinor, opts...).ToFunc()
+}
+
+// ByGuardianEmail orders the results by the guardian_email field.
+func ByGuardianEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuardianEmail, opts...).ToFunc()
+}
+
+// ByGuardianConsent orders the results by the guardian_consent field.
+func ByGuardianConsent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuardianConsent, opts...).ToFunc()
+}
+
+// ByGuardianConsentedAt orders the results by the guardian_consented_at field.
+func ByGuardianConsentedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuardianConsentedAt, opts...).ToFunc()
+}
+
 // BySentEmailsCount orders the results by sent_emails count.
 func BySentEmailsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -145,6 +260,48 @@ func BySentEmails(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSentEmailsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRoleAssignmentsCount orders the results by role_assignments count.
+func ByRoleAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRoleAssignmentsStep(), opts...)
+	}
+}
+
+// ByRoleAssignments orders the results by role_assignments terms.
+func ByRoleAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRoleAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMembershipsCount orders the results by memberships count.
+func ByMembershipsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMembershipsStep(), opts...)
+	}
+}
+
+// ByMemberships orders the results by memberships terms.
+func ByMemberships(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMembershipsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEmailChangesCount orders the results by email_changes count.
+func ByEmailChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEmailChangesStep(), opts...)
+	}
+}
+
+// ByEmailChanges orders the results by email_changes terms.
+func ByEmailChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmailChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSentEmailsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -152,3 +309,24 @@ func newSentEmailsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, SentEmailsTable, SentEmailsColumn),
 	)
 }
+func newRoleAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RoleAssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RoleAssignmentsTable, RoleAssignmentsColumn),
+	)
+}
+func newMembershipsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MembershipsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MembershipsTable, MembershipsColumn),
+	)
+}
+func newEmailChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmailChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EmailChangesTable, EmailChangesColumn),
+	)
+}
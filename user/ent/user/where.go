@@ -71,6 +71,11 @@ func SyncCode(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldSyncCode, v))
 }
 
+// SyncVersion applies equality check predicate on the "sync_version" field. It's identical to SyncVersionEQ.
+func SyncVersion(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSyncVersion, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -91,11 +96,36 @@ func ArchivedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldArchivedAt, v))
 }
 
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmail, v))
+}
+
 // DeleteAfter applies equality check predicate on the "delete_after" field. It's identical to DeleteAfterEQ.
 func DeleteAfter(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldDeleteAfter, v))
 }
 
+// BirthDate applies equality check predicate on the "birth_date" field. It's identical to BirthDateEQ.
+func BirthDate(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBirthDate, v))
+}
+
+// IsMinor applies equality check predicate on the "is_minor" field. It's identical to IsMinorEQ.
+func IsMinor(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldIsMinor, v))
+}
+
+// GuardianEmail applies equality check predicate on the "guardian_email" field. It's identical to GuardianEmailEQ.
+func GuardianEmail(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldGuardianEmail, v))
+}
+
+// GuardianConsentedAt applies equality check predicate on the "guardian_consented_at" field. It's identical to GuardianConsentedAtEQ.
+func GuardianConsentedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldGuardianConsentedAt, v))
+}
+
 // IsActiveEQ applies the EQ predicate on the "is_active" field.
 func IsActiveEQ(v bool) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldIsActive, v))
@@ -191,6 +221,46 @@ func SyncCodeContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldSyncCode, v))
 }
 
+// SyncVersionEQ applies the EQ predicate on the "sync_version" field.
+func SyncVersionEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSyncVersion, v))
+}
+
+// SyncVersionNEQ applies the NEQ predicate on the "sync_version" field.
+func SyncVersionNEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldSyncVersion, v))
+}
+
+// SyncVersionIn applies the In predicate on the "sync_version" field.
+func SyncVersionIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldIn(FieldSyncVersion, vs...))
+}
+
+// SyncVersionNotIn applies the NotIn predicate on the "sync_version" field.
+func SyncVersionNotIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldSyncVersion, vs...))
+}
+
+// SyncVersionGT applies the GT predicate on the "sync_version" field.
+func SyncVersionGT(v int64) predicate.User {
+	return predicate.User(sql.FieldGT(FieldSyncVersion, v))
+}
+
+// SyncVersionGTE applies the GTE predicate on the "sync_version" field.
+func SyncVersionGTE(v int64) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldSyncVersion, v))
+}
+
+// SyncVersionLT applies the LT predicate on the "sync_version" field.
+func SyncVersionLT(v int64) predicate.User {
+	return predicate.User(sql.FieldLT(FieldSyncVersion, v))
+}
+
+// SyncVersionLTE applies the LTE predicate on the "sync_version" field.
+func SyncVersionLTE(v int64) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldSyncVersion, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -331,6 +401,81 @@ func ArchivedAtNotNil() predicate.User {
 	return predicate.User(sql.FieldNotNull(FieldArchivedAt))
 }
 
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldEmail))
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldEmail))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldEmail, v))
+}
+
 // DeleteAfterEQ applies the EQ predicate on the "delete_after" field.
 func DeleteAfterEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldDeleteAfter, v))
@@ -381,6 +526,211 @@ func DeleteAfterNotNil() predicate.User {
 	return predicate.User(sql.FieldNotNull(FieldDeleteAfter))
 }
 
+// BirthDateEQ applies the EQ predicate on the "birth_date" field.
+func BirthDateEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBirthDate, v))
+}
+
+// BirthDateNEQ applies the NEQ predicate on the "birth_date" field.
+func BirthDateNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldBirthDate, v))
+}
+
+// BirthDateIn applies the In predicate on the "birth_date" field.
+func BirthDateIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldBirthDate, vs...))
+}
+
+// BirthDateNotIn applies the NotIn predicate on the "birth_date" field.
+func BirthDateNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldBirthDate, vs...))
+}
+
+// BirthDateGT applies the GT predicate on the "birth_date" field.
+func BirthDateGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldBirthDate, v))
+}
+
+// BirthDateGTE applies the GTE predicate on the "birth_date" field.
+func BirthDateGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldBirthDate, v))
+}
+
+// BirthDateLT applies the LT predicate on the "birth_date" field.
+func BirthDateLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldBirthDate, v))
+}
+
+// BirthDateLTE applies the LTE predicate on the "birth_date" field.
+func BirthDateLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldBirthDate, v))
+}
+
+// BirthDateIsNil applies the IsNil predicate on the "birth_date" field.
+func BirthDateIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldBirthDate))
+}
+
+// BirthDateNotNil applies the NotNil predicate on the "birth_date" field.
+func BirthDateNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldBirthDate))
+}
+
+// IsMinorEQ applies the EQ predicate on the "is_minor" field.
+func IsMinorEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldIsMinor, v))
+}
+
+// IsMinorNEQ applies the NEQ predicate on the "is_minor" field.
+func IsMinorNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldIsMinor, v))
+}
+
+// GuardianEmailEQ applies the EQ predicate on the "guardian_email" field.
+func GuardianEmailEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldGuardianEmail, v))
+}
+
+// GuardianEmailNEQ applies the NEQ predicate on the "guardian_email" field.
+func GuardianEmailNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldGuardianEmail, v))
+}
+
+// GuardianEmailIn applies the In predicate on the "guardian_email" field.
+func GuardianEmailIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldGuardianEmail, vs...))
+}
+
+// GuardianEmailNotIn applies the NotIn predicate on the "guardian_email" field.
+func GuardianEmailNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldGuardianEmail, vs...))
+}
+
+// GuardianEmailGT applies the GT predicate on the "guardian_email" field.
+func GuardianEmailGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldGuardianEmail, v))
+}
+
+// GuardianEmailGTE applies the GTE predicate on the "guardian_email" field.
+func GuardianEmailGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldGuardianEmail, v))
+}
+
+// GuardianEmailLT applies the LT predicate on the "guardian_email" field.
+func GuardianEmailLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldGuardianEmail, v))
+}
+
+// GuardianEmailLTE applies the LTE predicate on the "guardian_email" field.
+func GuardianEmailLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldGuardianEmail, v))
+}
+
+// GuardianEmailContains applies the Contains predicate on the "guardian_email" field.
+func GuardianEmailContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldGuardianEmail, v))
+}
+
+// GuardianEmailHasPrefix applies the HasPrefix predicate on the "guardian_email" field.
+func GuardianEmailHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldGuardianEmail, v))
+}
+
+// GuardianEmailHasSuffix applies the HasSuffix predicate on the "guardian_email" field.
+func GuardianEmailHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldGuardianEmail, v))
+}
+
+// GuardianEmailIsNil applies the IsNil predicate on the "guardian_email" field.
+func GuardianEmailIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldGuardianEmail))
+}
+
+// GuardianEmailNotNil applies the NotNil predicate on the "guardian_email" field.
+func GuardianEmailNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldGuardianEmail))
+}
+
+// GuardianEmailEqualFold applies the EqualFold predicate on the "guardian_email" field.
+func GuardianEmailEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldGuardianEmail, v))
+}
+
+// GuardianEmailContainsFold applies the ContainsFold predicate on the "guardian_email" field.
+func GuardianEmailContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldGuardianEmail, v))
+}
+
+// GuardianConsentEQ applies the EQ predicate on the "guardian_consent" field.
+func GuardianConsentEQ(v GuardianConsent) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldGuardianConsent, v))
+}
+
+// GuardianConsentNEQ applies the NEQ predicate on the "guardian_consent" field.
+func GuardianConsentNEQ(v GuardianConsent) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldGuardianConsent, v))
+}
+
+// GuardianConsentIn applies the In predicate on the "guardian_consent" field.
+func GuardianConsentIn(vs ...GuardianConsent) predicate.User {
+	return predicate.User(sql.FieldIn(FieldGuardianConsent, vs...))
+}
+
+// GuardianConsentNotIn applies the NotIn predicate on the "guardian_consent" field.
+func GuardianConsentNotIn(vs ...GuardianConsent) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldGuardianConsent, vs...))
+}
+
+// GuardianConsentedAtEQ applies the EQ predicate on the "guardian_consented_at" field.
+func GuardianConsentedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldGuardianConsentedAt, v))
+}
+
+// GuardianConsentedAtNEQ applies the NEQ predicate on the "guardian_consented_at" field.
+func GuardianConsentedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldGuardianConsentedAt, v))
+}
+
+// GuardianConsentedAtIn applies the In predicate on the "guardian_consented_at" field.
+func GuardianConsentedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldGuardianConsentedAt, vs...))
+}
+
+// GuardianConsentedAtNotIn applies the NotIn predicate on the "guardian_consented_at" field.
+func GuardianConsentedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldGuardianConsentedAt, vs...))
+}
+
+// GuardianConsentedAtGT applies the GT predicate on the "guardian_consented_at" field.
+func GuardianConsentedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldGuardianConsentedAt, v))
+}
+
+// GuardianConsentedAtGTE applies the GTE predicate on the "guardian_consented_at" field.
+func GuardianConsentedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldGuardianConsentedAt, v))
+}
+
+// GuardianConsentedAtLT applies the LT predicate on the "guardian_consented_at" field.
+func GuardianConsentedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldGuardianConsentedAt, v))
+}
+
+// GuardianConsentedAtLTE applies the LTE predicate on the "guardian_consented_at" field.
+func GuardianConsentedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldGuardianConsentedAt, v))
+}
+
+// GuardianConsentedAtIsNil applies the IsNil predicate on the "guardian_consented_at" field.
+func GuardianConsentedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldGuardianConsentedAt))
+}
+
+// GuardianConsentedAtNotNil applies the NotNil predicate on the "guardian_consented_at" field.
+func GuardianConsentedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldGuardianConsentedAt))
+}
+
 // HasSentEmails applies the HasEdge predicate on the "sent_emails" edge.
 func HasSentEmails() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -404,6 +754,75 @@ func HasSentEmailsWith(preds ...predicate.SentEmail) predicate.User {
 	})
 }
 
+// HasRoleAssignments applies the HasEdge predicate on the "role_assignments" edge.
+func HasRoleAssignments() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RoleAssignmentsTable, RoleAssignmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoleAssignmentsWith applies the HasEdge predicate on the "role_assignments" edge with a given conditions (other predicates).
+func HasRoleAssignmentsWith(preds ...predicate.RoleAssignment) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRoleAssignmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMemberships applies the HasEdge predicate on the "memberships" edge.
+func HasMemberships() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MembershipsTable, MembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMembershipsWith applies the HasEdge predicate on the "memberships" edge with a given conditions (other predicates).
+func HasMembershipsWith(preds ...predicate.Membership) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMembershipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEmailChanges applies the HasEdge predicate on the "email_changes" edge.
+func HasEmailChanges() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EmailChangesTable, EmailChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmailChangesWith applies the HasEdge predicate on the "email_changes" edge with a given conditions (other predicates).
+func HasEmailChangesWith(preds ...predicate.EmailChange) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newEmailChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
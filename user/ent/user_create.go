@@ -11,6 +11,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/emailchange"
+	"mandacode.com/accounts/user/ent/membership"
+	"mandacode.com/accounts/user/ent/roleassignment"
 	"mandacode.com/accounts/user/ent/sentemail"
 	"mandacode.com/accounts/user/ent/user"
 )
@@ -64,6 +67,20 @@ func (uc *UserCreate) SetNillableSyncCode(s *string) *UserCreate {
 	return uc
 }
 
+// SetSyncVersion sets the "sync_version" field.
+func (uc *UserCreate) SetSyncVersion(i int64) *UserCreate {
+	uc.mutation.SetSyncVersion(i)
+	return uc
+}
+
+// SetNillableSyncVersion sets the "sync_version" field if the given value is not nil.
+func (uc *UserCreate) SetNillableSyncVersion(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetSyncVersion(*i)
+	}
+	return uc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uc *UserCreate) SetCreatedAt(t time.Time) *UserCreate {
 	uc.mutation.SetCreatedAt(t)
@@ -120,6 +137,20 @@ func (uc *UserCreate) SetNillableArchivedAt(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetEmail sets the "email" field.
+func (uc *UserCreate) SetEmail(s string) *UserCreate {
+	uc.mutation.SetEmail(s)
+	return uc
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmail(s *string) *UserCreate {
+	if s != nil {
+		uc.SetEmail(*s)
+	}
+	return uc
+}
+
 // SetDeleteAfter sets the "delete_after" field.
 func (uc *UserCreate) SetDeleteAfter(t time.Time) *UserCreate {
 	uc.mutation.SetDeleteAfter(t)
@@ -134,6 +165,76 @@ func (uc *UserCreate) SetNillableDeleteAfter(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetBirthDate sets the "birth_date" field.
+func (uc *UserCreate) SetBirthDate(t time.Time) *UserCreate {
+	uc.mutation.SetBirthDate(t)
+	return uc
+}
+
+// SetNillableBirthDate sets the "birth_date" field if the given value is not nil.
+func (uc *UserCreate) SetNillableBirthDate(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetBirthDate(*t)
+	}
+	return uc
+}
+
+// SetIsMinor sets the "is_minor" field.
+func (uc *UserCreate) SetIsMinor(b bool) *UserCreate {
+	uc.mutation.SetIsMinor(b)
+	return uc
+}
+
+// SetNillableIsMinor sets the "is_minor" field if the given value is not nil.
+func (uc *UserCreate) SetNillableIsMinor(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetIsMinor(*b)
+	}
+	return uc
+}
+
+// SetGuardianEmail sets the "guardian_email" field.
+func (uc *UserCreate) SetGuardianEmail(s string) *UserCreate {
+	uc.mutation.SetGuardianEmail(s)
+	return uc
+}
+
+// SetNillableGuardianEmail sets the "guardian_email" field if the given value is not nil.
+func (uc *UserCreate) SetNillableGuardianEmail(s *string) *UserCreate {
+	if s != nil {
+		uc.SetGuardianEmail(*s)
+	}
+	return uc
+}
+
+// SetGuardianConsent sets the "guardian_consent" field.
+func (uc *UserCreate) SetGuardianConsent(value user.GuardianConsent) *UserCreate {
+	uc.mutation.SetGuardianConsent(value)
+	return uc
+}
+
+// SetNillableGuardianConsent sets the "guardian_consent" field if the given value is not nil.
+func (uc *UserCreate) SetNillableGuardianConsent(value *user.GuardianConsent) *UserCreate {
+	if value != nil {
+		uc.SetGuardianConsent(*value)
+	}
+	return uc
+}
+
+// SetGuardianConsentedAt sets the "guardian_consented_at" field.
+func (uc *UserCreate) SetGuardianConsentedAt(t time.Time) *UserCreate {
+	uc.mutation.SetGuardianConsentedAt(t)
+	return uc
+}
+
+// SetNillableGuardianConsentedAt sets the "guardian_consented_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableGuardianConsentedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetGuardianConsentedAt(*t)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(u uuid.UUID) *UserCreate {
 	uc.mutation.SetID(u)
@@ -163,6 +264,51 @@ func (uc *UserCreate) AddSentEmails(s ...*SentEmail) *UserCreate {
 	return uc.AddSentEmailIDs(ids...)
 }
 
+// AddRoleAssignmentIDs adds the "role_assignments" edge to the RoleAssignment entity by IDs.
+func (uc *UserCreate) AddRoleAssignmentIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddRoleAssignmentIDs(ids...)
+	return uc
+}
+
+// AddRoleAssignments adds the "role_assignments" edges to the RoleAssignment entity.
+func (uc *UserCreate) AddRoleAssignments(r ...*RoleAssignment) *UserCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRoleAssignmentIDs(ids...)
+}
+
+// AddMembershipIDs adds the "memberships" edge to the Membership entity by IDs.
+func (uc *UserCreate) AddMembershipIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddMembershipIDs(ids...)
+	return uc
+}
+
+// AddMemberships adds the "memberships" edges to the Membership entity.
+func (uc *UserCreate) AddMemberships(m ...*Membership) *UserCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMembershipIDs(ids...)
+}
+
+// AddEmailChangeIDs adds the "email_changes" edge to the EmailChange entity by IDs.
+func (uc *UserCreate) AddEmailChangeIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddEmailChangeIDs(ids...)
+	return uc
+}
+
+// AddEmailChanges adds the "email_changes" edges to the EmailChange entity.
+func (uc *UserCreate) AddEmailChanges(e ...*EmailChange) *UserCreate {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uc.AddEmailChangeIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -206,6 +352,10 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultIsBlocked
 		uc.mutation.SetIsBlocked(v)
 	}
+	if _, ok := uc.mutation.SyncVersion(); !ok {
+		v := user.DefaultSyncVersion
+		uc.mutation.SetSyncVersion(v)
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		uc.mutation.SetCreatedAt(v)
@@ -218,6 +368,14 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultIsArchived
 		uc.mutation.SetIsArchived(v)
 	}
+	if _, ok := uc.mutation.IsMinor(); !ok {
+		v := user.DefaultIsMinor
+		uc.mutation.SetIsMinor(v)
+	}
+	if _, ok := uc.mutation.GuardianConsent(); !ok {
+		v := user.DefaultGuardianConsent
+		uc.mutation.SetGuardianConsent(v)
+	}
 	if _, ok := uc.mutation.ID(); !ok {
 		v := user.DefaultID()
 		uc.mutation.SetID(v)
@@ -232,6 +390,9 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.IsBlocked(); !ok {
 		return &ValidationError{Name: "is_blocked", err: errors.New(`ent: missing required field "User.is_blocked"`)}
 	}
+	if _, ok := uc.mutation.SyncVersion(); !ok {
+		return &ValidationError{Name: "sync_version", err: errors.New(`ent: missing required field "User.sync_version"`)}
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -241,6 +402,17 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.IsArchived(); !ok {
 		return &ValidationError{Name: "is_archived", err: errors.New(`ent: missing required field "User.is_archived"`)}
 	}
+	if _, ok := uc.mutation.IsMinor(); !ok {
+		return &ValidationError{Name: "is_minor", err: errors.New(`ent: missing required field "User.is_minor"`)}
+	}
+	if _, ok := uc.mutation.GuardianConsent(); !ok {
+		return &ValidationError{Name: "guardian_consent", err: errors.New(`ent: missing required field "User.guardian_consent"`)}
+	}
+	if v, ok := uc.mutation.GuardianConsent(); ok {
+		if err := user.GuardianConsentValidator(v); err != nil {
+			return &ValidationError{Name: "guardian_consent", err: fmt.Errorf(`ent: validator failed for field "User.guardian_consent": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -288,6 +460,10 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldSyncCode, field.TypeString, value)
 		_node.SyncCode = value
 	}
+	if value, ok := uc.mutation.SyncVersion(); ok {
+		_spec.SetField(user.FieldSyncVersion, field.TypeInt64, value)
+		_node.SyncVersion = value
+	}
 	if value, ok := uc.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -304,10 +480,34 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldArchivedAt, field.TypeTime, value)
 		_node.ArchivedAt = &value
 	}
+	if value, ok := uc.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+		_node.Email = &value
+	}
 	if value, ok := uc.mutation.DeleteAfter(); ok {
 		_spec.SetField(user.FieldDeleteAfter, field.TypeTime, value)
 		_node.DeleteAfter = &value
 	}
+	if value, ok := uc.mutation.BirthDate(); ok {
+		_spec.SetField(user.FieldBirthDate, field.TypeTime, value)
+		_node.BirthDate = &value
+	}
+	if value, ok := uc.mutation.IsMinor(); ok {
+		_spec.SetField(user.FieldIsMinor, field.TypeBool, value)
+		_node.IsMinor = value
+	}
+	if value, ok := uc.mutation.GuardianEmail(); ok {
+		_spec.SetField(user.FieldGuardianEmail, field.TypeString, value)
+		_node.GuardianEmail = &value
+	}
+	if value, ok := uc.mutation.GuardianConsent(); ok {
+		_spec.SetField(user.FieldGuardianConsent, field.TypeEnum, value)
+		_node.GuardianConsent = value
+	}
+	if value, ok := uc.mutation.GuardianConsentedAt(); ok {
+		_spec.SetField(user.FieldGuardianConsentedAt, field.TypeTime, value)
+		_node.GuardianConsentedAt = &value
+	}
 	if nodes := uc.mutation.SentEmailsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -324,6 +524,54 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.RoleAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleAssignmentsTable,
+			Columns: []string{user.RoleAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.EmailChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailChangesTable,
+			Columns: []string{user.EmailChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
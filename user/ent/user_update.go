@@ -12,7 +12,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/emailchange"
+	"mandacode.com/accounts/user/ent/membership"
 	"mandacode.com/accounts/user/ent/predicate"
+	"mandacode.com/accounts/user/ent/roleassignment"
 	"mandacode.com/accounts/user/ent/sentemail"
 	"mandacode.com/accounts/user/ent/user"
 )
@@ -78,6 +81,27 @@ func (uu *UserUpdate) ClearSyncCode() *UserUpdate {
 	return uu
 }
 
+// SetSyncVersion sets the "sync_version" field.
+func (uu *UserUpdate) SetSyncVersion(i int64) *UserUpdate {
+	uu.mutation.ResetSyncVersion()
+	uu.mutation.SetSyncVersion(i)
+	return uu
+}
+
+// SetNillableSyncVersion sets the "sync_version" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableSyncVersion(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetSyncVersion(*i)
+	}
+	return uu
+}
+
+// AddSyncVersion adds i to the "sync_version" field.
+func (uu *UserUpdate) AddSyncVersion(i int64) *UserUpdate {
+	uu.mutation.AddSyncVersion(i)
+	return uu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (uu *UserUpdate) SetUpdatedAt(t time.Time) *UserUpdate {
 	uu.mutation.SetUpdatedAt(t)
@@ -118,6 +142,26 @@ func (uu *UserUpdate) ClearArchivedAt() *UserUpdate {
 	return uu
 }
 
+// SetEmail sets the "email" field.
+func (uu *UserUpdate) SetEmail(s string) *UserUpdate {
+	uu.mutation.SetEmail(s)
+	return uu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableEmail(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetEmail(*s)
+	}
+	return uu
+}
+
+// ClearEmail clears the value of the "email" field.
+func (uu *UserUpdate) ClearEmail() *UserUpdate {
+	uu.mutation.ClearEmail()
+	return uu
+}
+
 // SetDeleteAfter sets the "delete_after" field.
 func (uu *UserUpdate) SetDeleteAfter(t time.Time) *UserUpdate {
 	uu.mutation.SetDeleteAfter(t)
@@ -138,6 +182,40 @@ func (uu *UserUpdate) ClearDeleteAfter() *UserUpdate {
 	return uu
 }
 
+// SetGuardianConsent sets the "guardian_consent" field.
+func (uu *UserUpdate) SetGuardianConsent(uc user.GuardianConsent) *UserUpdate {
+	uu.mutation.SetGuardianConsent(uc)
+	return uu
+}
+
+// SetNillableGuardianConsent sets the "guardian_consent" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableGuardianConsent(uc *user.GuardianConsent) *UserUpdate {
+	if uc != nil {
+		uu.SetGuardianConsent(*uc)
+	}
+	return uu
+}
+
+// SetGuardianConsentedAt sets the "guardian_consented_at" field.
+func (uu *UserUpdate) SetGuardianConsentedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetGuardianConsentedAt(t)
+	return uu
+}
+
+// SetNillableGuardianConsentedAt sets the "guardian_consented_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableGuardianConsentedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetGuardianConsentedAt(*t)
+	}
+	return uu
+}
+
+// ClearGuardianConsentedAt clears the value of the "guardian_consented_at" field.
+func (uu *UserUpdate) ClearGuardianConsentedAt() *UserUpdate {
+	uu.mutation.ClearGuardianConsentedAt()
+	return uu
+}
+
 // AddSentEmailIDs adds the "sent_emails" edge to the SentEmail entity by IDs.
 func (uu *UserUpdate) AddSentEmailIDs(ids ...uuid.UUID) *UserUpdate {
 	uu.mutation.AddSentEmailIDs(ids...)
@@ -153,6 +231,51 @@ func (uu *UserUpdate) AddSentEmails(s ...*SentEmail) *UserUpdate {
 	return uu.AddSentEmailIDs(ids...)
 }
 
+// AddRoleAssignmentIDs adds the "role_assignments" edge to the RoleAssignment entity by IDs.
+func (uu *UserUpdate) AddRoleAssignmentIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddRoleAssignmentIDs(ids...)
+	return uu
+}
+
+// AddRoleAssignments adds the "role_assignments" edges to the RoleAssignment entity.
+func (uu *UserUpdate) AddRoleAssignments(r ...*RoleAssignment) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddRoleAssignmentIDs(ids...)
+}
+
+// AddMembershipIDs adds the "memberships" edge to the Membership entity by IDs.
+func (uu *UserUpdate) AddMembershipIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddMembershipIDs(ids...)
+	return uu
+}
+
+// AddMemberships adds the "memberships" edges to the Membership entity.
+func (uu *UserUpdate) AddMemberships(m ...*Membership) *UserUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMembershipIDs(ids...)
+}
+
+// AddEmailChangeIDs adds the "email_changes" edge to the EmailChange entity by IDs.
+func (uu *UserUpdate) AddEmailChangeIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddEmailChangeIDs(ids...)
+	return uu
+}
+
+// AddEmailChanges adds the "email_changes" edges to the EmailChange entity.
+func (uu *UserUpdate) AddEmailChanges(e ...*EmailChange) *UserUpdate {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uu.AddEmailChangeIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -179,6 +302,69 @@ func (uu *UserUpdate) RemoveSentEmails(s ...*SentEmail) *UserUpdate {
 	return uu.RemoveSentEmailIDs(ids...)
 }
 
+// ClearRoleAssignments clears all "role_assignments" edges to the RoleAssignment entity.
+func (uu *UserUpdate) ClearRoleAssignments() *UserUpdate {
+	uu.mutation.ClearRoleAssignments()
+	return uu
+}
+
+// RemoveRoleAssignmentIDs removes the "role_assignments" edge to RoleAssignment entities by IDs.
+func (uu *UserUpdate) RemoveRoleAssignmentIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveRoleAssignmentIDs(ids...)
+	return uu
+}
+
+// RemoveRoleAssignments removes "role_assignments" edges to RoleAssignment entities.
+func (uu *UserUpdate) RemoveRoleAssignments(r ...*RoleAssignment) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveRoleAssignmentIDs(ids...)
+}
+
+// ClearMemberships clears all "memberships" edges to the Membership entity.
+func (uu *UserUpdate) ClearMemberships() *UserUpdate {
+	uu.mutation.ClearMemberships()
+	return uu
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to Membership entities by IDs.
+func (uu *UserUpdate) RemoveMembershipIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveMembershipIDs(ids...)
+	return uu
+}
+
+// RemoveMemberships removes "memberships" edges to Membership entities.
+func (uu *UserUpdate) RemoveMemberships(m ...*Membership) *UserUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.RemoveMembershipIDs(ids...)
+}
+
+// ClearEmailChanges clears all "email_changes" edges to the EmailChange entity.
+func (uu *UserUpdate) ClearEmailChanges() *UserUpdate {
+	uu.mutation.ClearEmailChanges()
+	return uu
+}
+
+// RemoveEmailChangeIDs removes the "email_changes" edge to EmailChange entities by IDs.
+func (uu *UserUpdate) RemoveEmailChangeIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveEmailChangeIDs(ids...)
+	return uu
+}
+
+// RemoveEmailChanges removes "email_changes" edges to EmailChange entities.
+func (uu *UserUpdate) RemoveEmailChanges(e ...*EmailChange) *UserUpdate {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uu.RemoveEmailChangeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	uu.defaults()
@@ -215,7 +401,20 @@ func (uu *UserUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uu *UserUpdate) check() error {
+	if v, ok := uu.mutation.GuardianConsent(); ok {
+		if err := user.GuardianConsentValidator(v); err != nil {
+			return &ValidationError{Name: "guardian_consent", err: fmt.Errorf(`ent: validator failed for field "User.guardian_consent": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
 	if ps := uu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -236,6 +435,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.SyncCodeCleared() {
 		_spec.ClearField(user.FieldSyncCode, field.TypeString)
 	}
+	if value, ok := uu.mutation.SyncVersion(); ok {
+		_spec.SetField(user.FieldSyncVersion, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedSyncVersion(); ok {
+		_spec.AddField(user.FieldSyncVersion, field.TypeInt64, value)
+	}
 	if value, ok := uu.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -248,12 +453,33 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.ArchivedAtCleared() {
 		_spec.ClearField(user.FieldArchivedAt, field.TypeTime)
 	}
+	if value, ok := uu.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if uu.mutation.EmailCleared() {
+		_spec.ClearField(user.FieldEmail, field.TypeString)
+	}
 	if value, ok := uu.mutation.DeleteAfter(); ok {
 		_spec.SetField(user.FieldDeleteAfter, field.TypeTime, value)
 	}
 	if uu.mutation.DeleteAfterCleared() {
 		_spec.ClearField(user.FieldDeleteAfter, field.TypeTime)
 	}
+	if uu.mutation.BirthDateCleared() {
+		_spec.ClearField(user.FieldBirthDate, field.TypeTime)
+	}
+	if uu.mutation.GuardianEmailCleared() {
+		_spec.ClearField(user.FieldGuardianEmail, field.TypeString)
+	}
+	if value, ok := uu.mutation.GuardianConsent(); ok {
+		_spec.SetField(user.FieldGuardianConsent, field.TypeEnum, value)
+	}
+	if value, ok := uu.mutation.GuardianConsentedAt(); ok {
+		_spec.SetField(user.FieldGuardianConsentedAt, field.TypeTime, value)
+	}
+	if uu.mutation.GuardianConsentedAtCleared() {
+		_spec.ClearField(user.FieldGuardianConsentedAt, field.TypeTime)
+	}
 	if uu.mutation.SentEmailsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -299,6 +525,141 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.RoleAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleAssignmentsTable,
+			Columns: []string{user.RoleAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedRoleAssignmentsIDs(); len(nodes) > 0 && !uu.mutation.RoleAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleAssignmentsTable,
+			Columns: []string{user.RoleAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RoleAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleAssignmentsTable,
+			Columns: []string{user.RoleAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !uu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.EmailChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailChangesTable,
+			Columns: []string{user.EmailChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailchange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedEmailChangesIDs(); len(nodes) > 0 && !uu.mutation.EmailChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailChangesTable,
+			Columns: []string{user.EmailChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.EmailChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailChangesTable,
+			Columns: []string{user.EmailChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -367,6 +728,27 @@ func (uuo *UserUpdateOne) ClearSyncCode() *UserUpdateOne {
 	return uuo
 }
 
+// SetSyncVersion sets the "sync_version" field.
+func (uuo *UserUpdateOne) SetSyncVersion(i int64) *UserUpdateOne {
+	uuo.mutation.ResetSyncVersion()
+	uuo.mutation.SetSyncVersion(i)
+	return uuo
+}
+
+// SetNillableSyncVersion sets the "sync_version" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSyncVersion(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetSyncVersion(*i)
+	}
+	return uuo
+}
+
+// AddSyncVersion adds i to the "sync_version" field.
+func (uuo *UserUpdateOne) AddSyncVersion(i int64) *UserUpdateOne {
+	uuo.mutation.AddSyncVersion(i)
+	return uuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
 	uuo.mutation.SetUpdatedAt(t)
@@ -407,6 +789,26 @@ func (uuo *UserUpdateOne) ClearArchivedAt() *UserUpdateOne {
 	return uuo
 }
 
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (uuo *UserUpdateOne) ClearEmail() *UserUpdateOne {
+	uuo.mutation.ClearEmail()
+	return uuo
+}
+
 // SetDeleteAfter sets the "delete_after" field.
 func (uuo *UserUpdateOne) SetDeleteAfter(t time.Time) *UserUpdateOne {
 	uuo.mutation.SetDeleteAfter(t)
@@ -427,6 +829,40 @@ func (uuo *UserUpdateOne) ClearDeleteAfter() *UserUpdateOne {
 	return uuo
 }
 
+// SetGuardianConsent sets the "guardian_consent" field.
+func (uuo *UserUpdateOne) SetGuardianConsent(uc user.GuardianConsent) *UserUpdateOne {
+	uuo.mutation.SetGuardianConsent(uc)
+	return uuo
+}
+
+// SetNillableGuardianConsent sets the "guardian_consent" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableGuardianConsent(uc *user.GuardianConsent) *UserUpdateOne {
+	if uc != nil {
+		uuo.SetGuardianConsent(*uc)
+	}
+	return uuo
+}
+
+// SetGuardianConsentedAt sets the "guardian_consented_at" field.
+func (uuo *UserUpdateOne) SetGuardianConsentedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetGuardianConsentedAt(t)
+	return uuo
+}
+
+// SetNillableGuardianConsentedAt sets the "guardian_consented_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableGuardianConsentedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetGuardianConsentedAt(*t)
+	}
+	return uuo
+}
+
+// ClearGuardianConsentedAt clears the value of the "guardian_consented_at" field.
+func (uuo *UserUpdateOne) ClearGuardianConsentedAt() *UserUpdateOne {
+	uuo.mutation.ClearGuardianConsentedAt()
+	return uuo
+}
+
 // AddSentEmailIDs adds the "sent_emails" edge to the SentEmail entity by IDs.
 func (uuo *UserUpdateOne) AddSentEmailIDs(ids ...uuid.UUID) *UserUpdateOne {
 	uuo.mutation.AddSentEmailIDs(ids...)
@@ -442,6 +878,51 @@ func (uuo *UserUpdateOne) AddSentEmails(s ...*SentEmail) *UserUpdateOne {
 	return uuo.AddSentEmailIDs(ids...)
 }
 
+// AddRoleAssignmentIDs adds the "role_assignments" edge to the RoleAssignment entity by IDs.
+func (uuo *UserUpdateOne) AddRoleAssignmentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddRoleAssignmentIDs(ids...)
+	return uuo
+}
+
+// AddRoleAssignments adds the "role_assignments" edges to the RoleAssignment entity.
+func (uuo *UserUpdateOne) AddRoleAssignments(r ...*RoleAssignment) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddRoleAssignmentIDs(ids...)
+}
+
+// AddMembershipIDs adds the "memberships" edge to the Membership entity by IDs.
+func (uuo *UserUpdateOne) AddMembershipIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddMembershipIDs(ids...)
+	return uuo
+}
+
+// AddMemberships adds the "memberships" edges to the Membership entity.
+func (uuo *UserUpdateOne) AddMemberships(m ...*Membership) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMembershipIDs(ids...)
+}
+
+// AddEmailChangeIDs adds the "email_changes" edge to the EmailChange entity by IDs.
+func (uuo *UserUpdateOne) AddEmailChangeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddEmailChangeIDs(ids...)
+	return uuo
+}
+
+// AddEmailChanges adds the "email_changes" edges to the EmailChange entity.
+func (uuo *UserUpdateOne) AddEmailChanges(e ...*EmailChange) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uuo.AddEmailChangeIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -468,6 +949,69 @@ func (uuo *UserUpdateOne) RemoveSentEmails(s ...*SentEmail) *UserUpdateOne {
 	return uuo.RemoveSentEmailIDs(ids...)
 }
 
+// ClearRoleAssignments clears all "role_assignments" edges to the RoleAssignment entity.
+func (uuo *UserUpdateOne) ClearRoleAssignments() *UserUpdateOne {
+	uuo.mutation.ClearRoleAssignments()
+	return uuo
+}
+
+// RemoveRoleAssignmentIDs removes the "role_assignments" edge to RoleAssignment entities by IDs.
+func (uuo *UserUpdateOne) RemoveRoleAssignmentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveRoleAssignmentIDs(ids...)
+	return uuo
+}
+
+// RemoveRoleAssignments removes "role_assignments" edges to RoleAssignment entities.
+func (uuo *UserUpdateOne) RemoveRoleAssignments(r ...*RoleAssignment) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveRoleAssignmentIDs(ids...)
+}
+
+// ClearMemberships clears all "memberships" edges to the Membership entity.
+func (uuo *UserUpdateOne) ClearMemberships() *UserUpdateOne {
+	uuo.mutation.ClearMemberships()
+	return uuo
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to Membership entities by IDs.
+func (uuo *UserUpdateOne) RemoveMembershipIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveMembershipIDs(ids...)
+	return uuo
+}
+
+// RemoveMemberships removes "memberships" edges to Membership entities.
+func (uuo *UserUpdateOne) RemoveMemberships(m ...*Membership) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMembershipIDs(ids...)
+}
+
+// ClearEmailChanges clears all "email_changes" edges to the EmailChange entity.
+func (uuo *UserUpdateOne) ClearEmailChanges() *UserUpdateOne {
+	uuo.mutation.ClearEmailChanges()
+	return uuo
+}
+
+// RemoveEmailChangeIDs removes the "email_changes" edge to EmailChange entities by IDs.
+func (uuo *UserUpdateOne) RemoveEmailChangeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveEmailChangeIDs(ids...)
+	return uuo
+}
+
+// RemoveEmailChanges removes "email_changes" edges to EmailChange entities.
+func (uuo *UserUpdateOne) RemoveEmailChanges(e ...*EmailChange) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uuo.RemoveEmailChangeIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	uuo.mutation.Where(ps...)
@@ -517,7 +1061,20 @@ func (uuo *UserUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.GuardianConsent(); ok {
+		if err := user.GuardianConsentValidator(v); err != nil {
+			return &ValidationError{Name: "guardian_consent", err: fmt.Errorf(`ent: validator failed for field "User.guardian_consent": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := uuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
 	id, ok := uuo.mutation.ID()
 	if !ok {
@@ -555,6 +1112,12 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if uuo.mutation.SyncCodeCleared() {
 		_spec.ClearField(user.FieldSyncCode, field.TypeString)
 	}
+	if value, ok := uuo.mutation.SyncVersion(); ok {
+		_spec.SetField(user.FieldSyncVersion, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedSyncVersion(); ok {
+		_spec.AddField(user.FieldSyncVersion, field.TypeInt64, value)
+	}
 	if value, ok := uuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -567,12 +1130,33 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if uuo.mutation.ArchivedAtCleared() {
 		_spec.ClearField(user.FieldArchivedAt, field.TypeTime)
 	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if uuo.mutation.EmailCleared() {
+		_spec.ClearField(user.FieldEmail, field.TypeString)
+	}
 	if value, ok := uuo.mutation.DeleteAfter(); ok {
 		_spec.SetField(user.FieldDeleteAfter, field.TypeTime, value)
 	}
 	if uuo.mutation.DeleteAfterCleared() {
 		_spec.ClearField(user.FieldDeleteAfter, field.TypeTime)
 	}
+	if uuo.mutation.BirthDateCleared() {
+		_spec.ClearField(user.FieldBirthDate, field.TypeTime)
+	}
+	if uuo.mutation.GuardianEmailCleared() {
+		_spec.ClearField(user.FieldGuardianEmail, field.TypeString)
+	}
+	if value, ok := uuo.mutation.GuardianConsent(); ok {
+		_spec.SetField(user.FieldGuardianConsent, field.TypeEnum, value)
+	}
+	if value, ok := uuo.mutation.GuardianConsentedAt(); ok {
+		_spec.SetField(user.FieldGuardianConsentedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.GuardianConsentedAtCleared() {
+		_spec.ClearField(user.FieldGuardianConsentedAt, field.TypeTime)
+	}
 	if uuo.mutation.SentEmailsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -618,6 +1202,141 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.RoleAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleAssignmentsTable,
+			Columns: []string{user.RoleAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedRoleAssignmentsIDs(); len(nodes) > 0 && !uuo.mutation.RoleAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleAssignmentsTable,
+			Columns: []string{user.RoleAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RoleAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleAssignmentsTable,
+			Columns: []string{user.RoleAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !uuo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.EmailChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailChangesTable,
+			Columns: []string{user.EmailChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailchange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedEmailChangesIDs(); len(nodes) > 0 && !uuo.mutation.EmailChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailChangesTable,
+			Columns: []string{user.EmailChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.EmailChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailChangesTable,
+			Columns: []string{user.EmailChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailchange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
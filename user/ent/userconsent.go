@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/consentdocument"
+	"mandacode.com/accounts/user/ent/userconsent"
+)
+
+// UserConsent is the model entity for the UserConsent schema.
+type UserConsent struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique identifier for the consent. This is a UUID that is generated when the consent is recorded.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Unique identifier for the user who made the choice. It has no foreign key, so that the history outlives the user for as long as regulations require.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Unique identifier for the document the choice was made on.
+	DocumentID uuid.UUID `json:"document_id,omitempty"`
+	// Whether the user accepted the document. Only optional documents can be declined.
+	Accepted bool `json:"accepted,omitempty"`
+	// IP address from which the choice was made.
+	IP string `json:"ip,omitempty"`
+	// User agent with which the choice was made.
+	UserAgent string `json:"user_agent,omitempty"`
+	// Timestamp when the choice was recorded.
+	RecordedAt time.Time `json:"recorded_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserConsentQuery when eager-loading is set.
+	Edges        UserConsentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UserConsentEdges holds the relations/edges for other nodes in the graph.
+type UserConsentEdges struct {
+	// Document holds the value of the document edge.
+	Document *ConsentDocument `json:"document,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DocumentOrErr returns the Document value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserConsentEdges) DocumentOrErr() (*ConsentDocument, error) {
+	if e.Document != nil {
+		return e.Document, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: consentdocument.Label}
+	}
+	return nil, &NotLoadedError{edge: "document"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserConsent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userconsent.FieldAccepted:
+			values[i] = new(sql.NullBool)
+		case userconsent.FieldIP, userconsent.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case userconsent.FieldRecordedAt:
+			values[i] = new(sql.NullTime)
+		case userconsent.FieldID, userconsent.FieldUserID, userconsent.FieldDocumentID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserConsent fields.
+func (uc *UserConsent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userconsent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				uc.ID = *value
+			}
+		case userconsent.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				uc.UserID = *value
+			}
+		case userconsent.FieldDocumentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field document_id", values[i])
+			} else if value != nil {
+				uc.DocumentID = *value
+			}
+		case userconsent.FieldAccepted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted", values[i])
+			} else if value.Valid {
+				uc.Accepted = value.Bool
+			}
+		case userconsent.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				uc.IP = value.String
+			}
+		case userconsent.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				uc.UserAgent = value.String
+			}
+		case userconsent.FieldRecordedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field recorded_at", values[i])
+			} else if value.Valid {
+				uc.RecordedAt = value.Time
+			}
+		default:
+			uc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserConsent.
+// This includes values selected through modifiers, order, etc.
+func (uc *UserConsent) Value(name string) (ent.Value, error) {
+	return uc.selectValues.Get(name)
+}
+
+// QueryDocument queries the "document" edge of the UserConsent entity.
+func (uc *UserConsent) QueryDocument() *ConsentDocumentQuery {
+	return NewUserConsentClient(uc.config).QueryDocument(uc)
+}
+
+// Update returns a builder for updating this UserConsent.
+// Note that you need to call UserConsent.Unwrap() before calling this method if this UserConsent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (uc *UserConsent) Update() *UserConsentUpdateOne {
+	return NewUserConsentClient(uc.config).UpdateOne(uc)
+}
+
+// Unwrap unwraps the UserConsent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (uc *UserConsent) Unwrap() *UserConsent {
+	_tx, ok := uc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserConsent is not a transactional entity")
+	}
+	uc.config.driver = _tx.drv
+	return uc
+}
+
+// String implements the fmt.Stringer.
+func (uc *UserConsent) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserConsent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", uc.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", uc.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("document_id=")
+	builder.WriteString(fmt.Sprintf("%v", uc.DocumentID))
+	builder.WriteString(", ")
+	builder.WriteString("accepted=")
+	builder.WriteString(fmt.Sprintf("%v", uc.Accepted))
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(uc.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(uc.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("recorded_at=")
+	builder.WriteString(uc.RecordedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserConsents is a parsable slice of UserConsent.
+type UserConsents []*UserConsent
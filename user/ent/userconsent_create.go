@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent/consentdocument"
+	"mandacode.com/accounts/user/ent/userconsent"
+)
+
+// UserConsentCreate is the builder for creating a UserConsent entity.
+type UserConsentCreate struct {
+	config
+	mutation *UserConsentMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (ucc *UserConsentCreate) SetUserID(u uuid.UUID) *UserConsentCreate {
+	ucc.mutation.SetUserID(u)
+	return ucc
+}
+
+// SetDocumentID sets the "document_id" field.
+func (ucc *UserConsentCreate) SetDocumentID(u uuid.UUID) *UserConsentCreate {
+	ucc.mutation.SetDocumentID(u)
+	return ucc
+}
+
+// SetAccepted sets the "accepted" field.
+func (ucc *UserConsentCreate) SetAccepted(b bool) *UserConsentCreate {
+	ucc.mutation.SetAccepted(b)
+	return ucc
+}
+
+// SetIP sets the "ip" field.
+func (ucc *UserConsentCreate) SetIP(s string) *UserConsentCreate {
+	ucc.mutation.SetIP(s)
+	return ucc
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (ucc *UserConsentCreate) SetNillableIP(s *string) *UserConsentCreate {
+	if s != nil {
+		ucc.SetIP(*s)
+	}
+	return ucc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (ucc *UserConsentCreate) SetUserAgent(s string) *UserConsentCreate {
+	ucc.mutation.SetUserAgent(s)
+	return ucc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (ucc *UserConsentCreate) SetNillableUserAgent(s *string) *UserConsentCreate {
+	if s != nil {
+		ucc.SetUserAgent(*s)
+	}
+	return ucc
+}
+
+// SetRecordedAt sets the "recorded_at" field.
+func (ucc *UserConsentCreate) SetRecordedAt(t time.Time) *UserConsentCreate {
+	ucc.mutation.SetRecordedAt(t)
+	return ucc
+}
+
+// SetNillableRecordedAt sets the "recorded_at" field if the given value is not nil.
+func (ucc *UserConsentCreate) SetNillableRecordedAt(t *time.Time) *UserConsentCreate {
+	if t != nil {
+		ucc.SetRecordedAt(*t)
+	}
+	return ucc
+}
+
+// SetID sets the "id" field.
+func (ucc *UserConsentCreate) SetID(u uuid.UUID) *UserConsentCreate {
+	ucc.mutation.SetID(u)
+	return ucc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ucc *UserConsentCreate) SetNillableID(u *uuid.UUID) *UserConsentCreate {
+	if u != nil {
+		ucc.SetID(*u)
+	}
+	return ucc
+}
+
+// SetDocument sets the "document" edge to the ConsentDocument entity.
+func (ucc *UserConsentCreate) SetDocument(c *ConsentDocument) *UserConsentCreate {
+	return ucc.SetDocumentID(c.ID)
+}
+
+// Mutation returns the UserConsentMutation object of the builder.
+func (ucc *UserConsentCreate) Mutation() *UserConsentMutation {
+	return ucc.mutation
+}
+
+// Save creates the UserConsent in the database.
+func (ucc *UserConsentCreate) Save(ctx context.Context) (*UserConsent, error) {
+	ucc.defaults()
+	return withHooks(ctx, ucc.sqlSave, ucc.mutation, ucc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ucc *UserConsentCreate) SaveX(ctx context.Context) *UserConsent {
+	v, err := ucc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ucc *UserConsentCreate) Exec(ctx context.Context) error {
+	_, err := ucc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucc *UserConsentCreate) ExecX(ctx context.Context) {
+	if err := ucc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ucc *UserConsentCreate) defaults() {
+	if _, ok := ucc.mutation.IP(); !ok {
+		v := userconsent.DefaultIP
+		ucc.mutation.SetIP(v)
+	}
+	if _, ok := ucc.mutation.UserAgent(); !ok {
+		v := userconsent.DefaultUserAgent
+		ucc.mutation.SetUserAgent(v)
+	}
+	if _, ok := ucc.mutation.RecordedAt(); !ok {
+		v := userconsent.DefaultRecordedAt()
+		ucc.mutation.SetRecordedAt(v)
+	}
+	if _, ok := ucc.mutation.ID(); !ok {
+		v := userconsent.DefaultID()
+		ucc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucc *UserConsentCreate) check() error {
+	if _, ok := ucc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserConsent.user_id"`)}
+	}
+	if _, ok := ucc.mutation.DocumentID(); !ok {
+		return &ValidationError{Name: "document_id", err: errors.New(`ent: missing required field "UserConsent.document_id"`)}
+	}
+	if _, ok := ucc.mutation.Accepted(); !ok {
+		return &ValidationError{Name: "accepted", err: errors.New(`ent: missing required field "UserConsent.accepted"`)}
+	}
+	if _, ok := ucc.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "UserConsent.ip"`)}
+	}
+	if _, ok := ucc.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "UserConsent.user_agent"`)}
+	}
+	if _, ok := ucc.mutation.RecordedAt(); !ok {
+		return &ValidationError{Name: "recorded_at", err: errors.New(`ent: missing required field "UserConsent.recorded_at"`)}
+	}
+	if len(ucc.mutation.DocumentIDs()) == 0 {
+		return &ValidationError{Name: "document", err: errors.New(`ent: missing required edge "UserConsent.document"`)}
+	}
+	return nil
+}
+
+func (ucc *UserConsentCreate) sqlSave(ctx context.Context) (*UserConsent, error) {
+	if err := ucc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ucc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ucc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ucc.mutation.id = &_node.ID
+	ucc.mutation.done = true
+	return _node, nil
+}
+
+func (ucc *UserConsentCreate) createSpec() (*UserConsent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserConsent{config: ucc.config}
+		_spec = sqlgraph.NewCreateSpec(userconsent.Table, sqlgraph.NewFieldSpec(userconsent.FieldID, field.TypeUUID))
+	)
+	if id, ok := ucc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ucc.mutation.UserID(); ok {
+		_spec.SetField(userconsent.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := ucc.mutation.Accepted(); ok {
+		_spec.SetField(userconsent.FieldAccepted, field.TypeBool, value)
+		_node.Accepted = value
+	}
+	if value, ok := ucc.mutation.IP(); ok {
+		_spec.SetField(userconsent.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := ucc.mutation.UserAgent(); ok {
+		_spec.SetField(userconsent.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := ucc.mutation.RecordedAt(); ok {
+		_spec.SetField(userconsent.FieldRecordedAt, field.TypeTime, value)
+		_node.RecordedAt = value
+	}
+	if nodes := ucc.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userconsent.DocumentTable,
+			Columns: []string{userconsent.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(consentdocument.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DocumentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UserConsentCreateBulk is the builder for creating many UserConsent entities in bulk.
+type UserConsentCreateBulk struct {
+	config
+	err      error
+	builders []*UserConsentCreate
+}
+
+// Save creates the UserConsent entities in the database.
+func (uccb *UserConsentCreateBulk) Save(ctx context.Context) ([]*UserConsent, error) {
+	if uccb.err != nil {
+		return nil, uccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uccb.builders))
+	nodes := make([]*UserConsent, len(uccb.builders))
+	mutators := make([]Mutator, len(uccb.builders))
+	for i := range uccb.builders {
+		func(i int, root context.Context) {
+			builder := uccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserConsentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uccb *UserConsentCreateBulk) SaveX(ctx context.Context) []*UserConsent {
+	v, err := uccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uccb *UserConsentCreateBulk) Exec(ctx context.Context) error {
+	_, err := uccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uccb *UserConsentCreateBulk) ExecX(ctx context.Context) {
+	if err := uccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"mandacode.com/accounts/user/ent/predicate"
+	"mandacode.com/accounts/user/ent/userconsent"
+)
+
+// UserConsentUpdate is the builder for updating UserConsent entities.
+type UserConsentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserConsentMutation
+}
+
+// Where appends a list predicates to the UserConsentUpdate builder.
+func (ucu *UserConsentUpdate) Where(ps ...predicate.UserConsent) *UserConsentUpdate {
+	ucu.mutation.Where(ps...)
+	return ucu
+}
+
+// Mutation returns the UserConsentMutation object of the builder.
+func (ucu *UserConsentUpdate) Mutation() *UserConsentMutation {
+	return ucu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ucu *UserConsentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ucu.sqlSave, ucu.mutation, ucu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucu *UserConsentUpdate) SaveX(ctx context.Context) int {
+	affected, err := ucu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ucu *UserConsentUpdate) Exec(ctx context.Context) error {
+	_, err := ucu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucu *UserConsentUpdate) ExecX(ctx context.Context) {
+	if err := ucu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucu *UserConsentUpdate) check() error {
+	if ucu.mutation.DocumentCleared() && len(ucu.mutation.DocumentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserConsent.document"`)
+	}
+	return nil
+}
+
+func (ucu *UserConsentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ucu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userconsent.Table, userconsent.Columns, sqlgraph.NewFieldSpec(userconsent.FieldID, field.TypeUUID))
+	if ps := ucu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ucu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userconsent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ucu.mutation.done = true
+	return n, nil
+}
+
+// UserConsentUpdateOne is the builder for updating a single UserConsent entity.
+type UserConsentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserConsentMutation
+}
+
+// Mutation returns the UserConsentMutation object of the builder.
+func (ucuo *UserConsentUpdateOne) Mutation() *UserConsentMutation {
+	return ucuo.mutation
+}
+
+// Where appends a list predicates to the UserConsentUpdate builder.
+func (ucuo *UserConsentUpdateOne) Where(ps ...predicate.UserConsent) *UserConsentUpdateOne {
+	ucuo.mutation.Where(ps...)
+	return ucuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ucuo *UserConsentUpdateOne) Select(field string, fields ...string) *UserConsentUpdateOne {
+	ucuo.fields = append([]string{field}, fields...)
+	return ucuo
+}
+
+// Save executes the query and returns the updated UserConsent entity.
+func (ucuo *UserConsentUpdateOne) Save(ctx context.Context) (*UserConsent, error) {
+	return withHooks(ctx, ucuo.sqlSave, ucuo.mutation, ucuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucuo *UserConsentUpdateOne) SaveX(ctx context.Context) *UserConsent {
+	node, err := ucuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ucuo *UserConsentUpdateOne) Exec(ctx context.Context) error {
+	_, err := ucuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucuo *UserConsentUpdateOne) ExecX(ctx context.Context) {
+	if err := ucuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucuo *UserConsentUpdateOne) check() error {
+	if ucuo.mutation.DocumentCleared() && len(ucuo.mutation.DocumentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserConsent.document"`)
+	}
+	return nil
+}
+
+func (ucuo *UserConsentUpdateOne) sqlSave(ctx context.Context) (_node *UserConsent, err error) {
+	if err := ucuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userconsent.Table, userconsent.Columns, sqlgraph.NewFieldSpec(userconsent.FieldID, field.TypeUUID))
+	id, ok := ucuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserConsent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ucuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userconsent.FieldID)
+		for _, f := range fields {
+			if !userconsent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userconsent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ucuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &UserConsent{config: ucuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ucuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userconsent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ucuo.mutation.done = true
+	return _node, nil
+}
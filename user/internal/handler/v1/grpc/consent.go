@@ -0,0 +1,114 @@
+package grpchandlerv1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userv1 "github.com/mandacode-com/accounts-proto/go/user/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	consentmodels "mandacode.com/accounts/user/internal/models/consent"
+	"mandacode.com/accounts/user/internal/usecase/consent"
+)
+
+type ConsentHandler struct {
+	userv1.UnimplementedConsentServiceServer
+	consent *consent.ConsentUsecase
+	logger  *zap.Logger
+}
+
+// ListPendingConsents implements userv1.ConsentServiceServer.
+func (h *ConsentHandler) ListPendingConsents(ctx context.Context, req *userv1.ListPendingConsentsRequest) (*userv1.ListPendingConsentsResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("ListPendingConsents request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Error("Invalid user ID format", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	documents, err := h.consent.PendingDocuments(ctx, userID)
+	if err != nil {
+		h.logger.Error("Failed to list pending consents", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, consentError(err, "failed to list pending consents")
+	}
+
+	out := make([]*userv1.ConsentDocument, 0, len(documents))
+	for _, document := range documents {
+		out = append(out, &userv1.ConsentDocument{
+			DocumentId:  document.ID.String(),
+			Kind:        string(document.Kind),
+			Version:     int32(document.Version),
+			Title:       document.Title,
+			ContentUrl:  document.ContentURL,
+			Mandatory:   document.Mandatory,
+			PublishedAt: timestamppb.New(document.PublishedAt),
+		})
+	}
+	return &userv1.ListPendingConsentsResponse{
+		Documents: out,
+	}, nil
+}
+
+// RecordConsents implements userv1.ConsentServiceServer.
+func (h *ConsentHandler) RecordConsents(ctx context.Context, req *userv1.RecordConsentsRequest) (*userv1.RecordConsentsResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("RecordConsents request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Error("Invalid user ID format", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+	choices := make([]consentmodels.Choice, 0, len(req.Consents))
+	for _, choice := range req.Consents {
+		documentID, err := uuid.Parse(choice.DocumentId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid document ID: %v", err)
+		}
+		choices = append(choices, consentmodels.Choice{
+			DocumentID: documentID,
+			Accepted:   choice.Accepted,
+		})
+	}
+
+	ctx, actor := withActor(ctx, req.ActorId)
+	err = h.consent.RecordForUser(ctx, userID, choices, consentmodels.Source{
+		IP:        req.Ip,
+		UserAgent: req.UserAgent,
+	})
+	if err != nil {
+		if !errors.Is(err, errcode.ErrInvalidInput) {
+			h.logger.Error("Failed to record consents", zap.Error(err), zap.String("user_id", req.UserId))
+		}
+		return nil, consentError(err, "failed to record consents")
+	}
+	h.logger.Info("consents recorded", zap.String("user_id", req.UserId), zap.String("actor", actor))
+
+	return &userv1.RecordConsentsResponse{}, nil
+}
+
+// consentError converts an error of the consent usecase to a gRPC status.
+func consentError(err error, message string) error {
+	if appErr, ok := err.(*errors.AppError); ok {
+		return status.Error(errcode.MapCodeToGRPC(appErr.Code()), appErr.Public())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+// NewConsentHandler creates a new ConsentHandler.
+func NewConsentHandler(consent *consent.ConsentUsecase, logger *zap.Logger) userv1.ConsentServiceServer {
+	return &ConsentHandler{
+		consent: consent,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,73 @@
+package grpchandlerv1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userv1 "github.com/mandacode-com/accounts-proto/go/user/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"mandacode.com/accounts/user/internal/usecase/organization"
+)
+
+type OrganizationHandler struct {
+	userv1.UnimplementedOrganizationServiceServer
+	organization *organization.OrganizationUsecase
+	logger       *zap.Logger
+}
+
+// GetMembership implements userv1.OrganizationServiceServer.
+func (h *OrganizationHandler) GetMembership(ctx context.Context, req *userv1.GetMembershipRequest) (*userv1.GetMembershipResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("GetMembership request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	orgID, err := uuid.Parse(req.OrgId)
+	if err != nil {
+		h.logger.Error("Invalid organization ID format", zap.Error(err), zap.String("org_id", req.OrgId))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization ID: %v", err)
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Error("Invalid user ID format", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	membership, err := h.organization.GetMembership(ctx, orgID, userID)
+	if err != nil {
+		if !errors.Is(err, errcode.ErrNotFound) {
+			h.logger.Error("Failed to get membership", zap.Error(err), zap.String("org_id", req.OrgId), zap.String("user_id", req.UserId))
+		}
+		return nil, organizationError(err, "failed to get membership")
+	}
+
+	return &userv1.GetMembershipResponse{
+		Membership: &userv1.Membership{
+			OrgId:     membership.OrganizationID.String(),
+			UserId:    membership.UserID.String(),
+			Role:      string(membership.Role),
+			CreatedAt: timestamppb.New(membership.CreatedAt),
+		},
+	}, nil
+}
+
+// organizationError converts an error of the organization usecase to a gRPC status.
+func organizationError(err error, message string) error {
+	if appErr, ok := err.(*errors.AppError); ok {
+		return status.Error(errcode.MapCodeToGRPC(appErr.Code()), appErr.Public())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+// NewOrganizationHandler creates a new OrganizationHandler.
+func NewOrganizationHandler(organization *organization.OrganizationUsecase, logger *zap.Logger) userv1.OrganizationServiceServer {
+	return &OrganizationHandler{
+		organization: organization,
+		logger:       logger,
+	}
+}
@@ -0,0 +1,257 @@
+package grpchandlerv1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userv1 "github.com/mandacode-com/accounts-proto/go/user/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	auditmodels "mandacode.com/accounts/user/internal/models/audit"
+	rolemodels "mandacode.com/accounts/user/internal/models/role"
+	"mandacode.com/accounts/user/internal/usecase/rbac"
+)
+
+type RBACHandler struct {
+	userv1.UnimplementedRBACServiceServer
+	rbac   *rbac.RBACUsecase
+	logger *zap.Logger
+}
+
+// ListRoles implements userv1.RBACServiceServer.
+func (h *RBACHandler) ListRoles(ctx context.Context, req *userv1.ListRolesRequest) (*userv1.ListRolesResponse, error) {
+	roles, err := h.rbac.ListRoles(ctx)
+	if err != nil {
+		h.logger.Error("Failed to list roles", zap.Error(err))
+		return nil, rbacError(err, "failed to list roles")
+	}
+
+	out := make([]*userv1.Role, 0, len(roles))
+	for _, role := range roles {
+		out = append(out, newRole(role))
+	}
+	return &userv1.ListRolesResponse{
+		Roles: out,
+	}, nil
+}
+
+// CreateRole implements userv1.RBACServiceServer.
+func (h *RBACHandler) CreateRole(ctx context.Context, req *userv1.CreateRoleRequest) (*userv1.CreateRoleResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("CreateRole request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	ctx, actor := withActor(ctx, req.ActorId)
+	role, err := h.rbac.CreateRole(ctx, req.Name, req.Description, req.Permissions)
+	if err != nil {
+		h.logger.Error("Failed to create role", zap.Error(err), zap.String("role", req.Name))
+		return nil, rbacError(err, "failed to create role")
+	}
+	h.logger.Info("admin action applied", zap.String("action", "create_role"), zap.String("role", role.Name), zap.String("actor", actor))
+
+	return &userv1.CreateRoleResponse{
+		Role: newRole(role),
+	}, nil
+}
+
+// UpdateRole implements userv1.RBACServiceServer.
+func (h *RBACHandler) UpdateRole(ctx context.Context, req *userv1.UpdateRoleRequest) (*userv1.UpdateRoleResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("UpdateRole request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	roleID, err := uuid.Parse(req.RoleId)
+	if err != nil {
+		h.logger.Error("Invalid role ID format", zap.Error(err), zap.String("role_id", req.RoleId))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role ID: %v", err)
+	}
+
+	ctx, actor := withActor(ctx, req.ActorId)
+	role, err := h.rbac.UpdateRole(ctx, roleID, req.Description, req.Permissions)
+	if err != nil {
+		h.logger.Error("Failed to update role", zap.Error(err), zap.String("role_id", req.RoleId))
+		return nil, rbacError(err, "failed to update role")
+	}
+	h.logger.Info("admin action applied", zap.String("action", "update_role"), zap.String("role", role.Name), zap.String("actor", actor))
+
+	return &userv1.UpdateRoleResponse{
+		Role: newRole(role),
+	}, nil
+}
+
+// DeleteRole implements userv1.RBACServiceServer.
+func (h *RBACHandler) DeleteRole(ctx context.Context, req *userv1.DeleteRoleRequest) (*userv1.DeleteRoleResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("DeleteRole request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	roleID, err := uuid.Parse(req.RoleId)
+	if err != nil {
+		h.logger.Error("Invalid role ID format", zap.Error(err), zap.String("role_id", req.RoleId))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role ID: %v", err)
+	}
+
+	ctx, actor := withActor(ctx, req.ActorId)
+	if err := h.rbac.DeleteRole(ctx, roleID); err != nil {
+		h.logger.Error("Failed to delete role", zap.Error(err), zap.String("role_id", req.RoleId))
+		return nil, rbacError(err, "failed to delete role")
+	}
+	h.logger.Info("admin action applied", zap.String("action", "delete_role"), zap.String("role", req.RoleId), zap.String("actor", actor))
+
+	return &userv1.DeleteRoleResponse{
+		RoleId: req.RoleId,
+	}, nil
+}
+
+// GetUserRoles implements userv1.RBACServiceServer.
+func (h *RBACHandler) GetUserRoles(ctx context.Context, req *userv1.GetUserRolesRequest) (*userv1.GetUserRolesResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("GetUserRoles request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Error("Invalid user ID format", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	roles, err := h.rbac.GetUserRoles(ctx, userID)
+	if err != nil {
+		h.logger.Error("Failed to get user roles", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, rbacError(err, "failed to get user roles")
+	}
+
+	return &userv1.GetUserRolesResponse{
+		Roles: newUserRoles(roles),
+	}, nil
+}
+
+// AssignRole implements userv1.RBACServiceServer.
+func (h *RBACHandler) AssignRole(ctx context.Context, req *userv1.AssignRoleRequest) (*userv1.AssignRoleResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("AssignRole request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Error("Invalid user ID format", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	ctx, actor := withActor(ctx, req.ActorId)
+	roles, err := h.rbac.AssignRole(ctx, userID, req.Role, actor)
+	if err != nil {
+		h.logger.Error("Failed to assign role", zap.Error(err), zap.String("user_id", req.UserId), zap.String("role", req.Role))
+		return nil, rbacError(err, "failed to assign role")
+	}
+	h.logger.Info("admin action applied", zap.String("action", "assign_role:"+req.Role), zap.String("user_id", req.UserId), zap.String("actor", actor))
+
+	return &userv1.AssignRoleResponse{
+		Roles: newUserRoles(roles),
+	}, nil
+}
+
+// RevokeRole implements userv1.RBACServiceServer.
+func (h *RBACHandler) RevokeRole(ctx context.Context, req *userv1.RevokeRoleRequest) (*userv1.RevokeRoleResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("RevokeRole request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Error("Invalid user ID format", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	ctx, actor := withActor(ctx, req.ActorId)
+	roles, err := h.rbac.RevokeRole(ctx, userID, req.Role)
+	if err != nil {
+		h.logger.Error("Failed to revoke role", zap.Error(err), zap.String("user_id", req.UserId), zap.String("role", req.Role))
+		return nil, rbacError(err, "failed to revoke role")
+	}
+	h.logger.Info("admin action applied", zap.String("action", "revoke_role:"+req.Role), zap.String("user_id", req.UserId), zap.String("actor", actor))
+
+	return &userv1.RevokeRoleResponse{
+		Roles: newUserRoles(roles),
+	}, nil
+}
+
+// CheckPermission implements userv1.RBACServiceServer.
+func (h *RBACHandler) CheckPermission(ctx context.Context, req *userv1.CheckPermissionRequest) (*userv1.CheckPermissionResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("CheckPermission request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Error("Invalid user ID format", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	allowed, err := h.rbac.HasPermission(ctx, userID, req.Permission)
+	if err != nil {
+		h.logger.Error("Failed to check permission", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, rbacError(err, "failed to check permission")
+	}
+
+	return &userv1.CheckPermissionResponse{
+		Allowed: allowed,
+	}, nil
+}
+
+// withActor records the admin applying a change in the context, for the audit trail, and returns how they are
+// identified in the logs. Changes without an admin are applied by the calling service, as the system.
+func withActor(ctx context.Context, actorID *string) (context.Context, string) {
+	if actorID == nil {
+		return auditmodels.WithActor(ctx, auditmodels.Actor{Type: auditmodels.ActorTypeSystem}), auditmodels.ActorTypeSystem
+	}
+	return auditmodels.WithActor(ctx, auditmodels.Actor{Type: auditmodels.ActorTypeUser, ID: *actorID}), *actorID
+}
+
+// rbacError converts an error of the RBAC usecase to a gRPC status.
+func rbacError(err error, message string) error {
+	if appErr, ok := err.(*errors.AppError); ok {
+		return status.Error(errcode.MapCodeToGRPC(appErr.Code()), appErr.Public())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+// newRole converts a role to its protobuf message.
+func newRole(role *rolemodels.SecureRole) *userv1.Role {
+	return &userv1.Role{
+		RoleId:      role.ID.String(),
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		System:      role.IsSystem,
+		CreatedAt:   timestamppb.New(role.CreatedAt),
+		UpdatedAt:   timestamppb.New(role.UpdatedAt),
+	}
+}
+
+// newUserRoles converts the roles of a user to their protobuf message.
+func newUserRoles(roles *rolemodels.UserRoles) *userv1.UserRoles {
+	return &userv1.UserRoles{
+		UserId:      roles.UserID.String(),
+		Roles:       roles.Roles,
+		Permissions: roles.Permissions,
+	}
+}
+
+func NewRBACHandler(rbac *rbac.RBACUsecase, logger *zap.Logger) userv1.RBACServiceServer {
+	return &RBACHandler{
+		rbac:   rbac,
+		logger: logger,
+	}
+}
@@ -0,0 +1,56 @@
+package grpchandlerv1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userv1 "github.com/mandacode-com/accounts-proto/go/user/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	manage "mandacode.com/accounts/user/internal/usecase/management"
+)
+
+type UserManagementHandler struct {
+	userv1.UnimplementedUserManagementServiceServer
+	manage *manage.AdminManageUsecase
+	logger *zap.Logger
+}
+
+// RestoreUser implements userv1.UserManagementServiceServer.
+func (h *UserManagementHandler) RestoreUser(ctx context.Context, req *userv1.RestoreUserRequest) (*userv1.RestoreUserResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("RestoreUser request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Error("Invalid user ID format", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	ctx, actor := withActor(ctx, req.ActorId)
+	if _, err := h.manage.RestoreUser(ctx, userID); err != nil {
+		if !errors.Is(err, errcode.ErrNotFound) {
+			h.logger.Error("Failed to restore user", zap.Error(err), zap.String("user_id", req.UserId))
+		}
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, status.Error(errcode.MapCodeToGRPC(appErr.Code()), appErr.Public())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to restore user: %v", err)
+	}
+	h.logger.Info("admin action applied", zap.String("action", "restore"), zap.String("user_id", req.UserId), zap.String("actor", actor))
+
+	return &userv1.RestoreUserResponse{}, nil
+}
+
+// NewUserManagementHandler creates a new UserManagementHandler.
+func NewUserManagementHandler(manage *manage.AdminManageUsecase, logger *zap.Logger) userv1.UserManagementServiceServer {
+	return &UserManagementHandler{
+		manage: manage,
+		logger: logger,
+	}
+}
@@ -0,0 +1,75 @@
+package grpchandlerv1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userv1 "github.com/mandacode-com/accounts-proto/go/user/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"mandacode.com/accounts/user/internal/usecase/userstatus"
+)
+
+type UserStatusHandler struct {
+	userv1.UnimplementedUserStatusServiceServer
+	userStatus *userstatus.UserStatusUsecase
+	logger     *zap.Logger
+}
+
+// ListUserStatuses implements userv1.UserStatusServiceServer.
+func (h *UserStatusHandler) ListUserStatuses(ctx context.Context, req *userv1.ListUserStatusesRequest) (*userv1.ListUserStatusesResponse, error) {
+	if err := req.Validate(); err != nil {
+		h.logger.Error("ListUserStatuses request validation failed", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	var after *uuid.UUID
+	if req.AfterUserId != nil {
+		id, err := uuid.Parse(req.GetAfterUserId())
+		if err != nil {
+			h.logger.Error("Invalid user ID format", zap.Error(err), zap.String("after_user_id", req.GetAfterUserId()))
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+		}
+		after = &id
+	}
+
+	statuses, err := h.userStatus.ListUserStatuses(ctx, after, int(req.PageSize))
+	if err != nil {
+		h.logger.Error("Failed to list user statuses", zap.Error(err))
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, status.Error(errcode.MapCodeToGRPC(appErr.Code()), appErr.Public())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list user statuses: %v", err)
+	}
+
+	resp := &userv1.ListUserStatusesResponse{
+		Statuses: make([]*userv1.UserStatus, 0, len(statuses)),
+	}
+	for _, s := range statuses {
+		resp.Statuses = append(resp.Statuses, &userv1.UserStatus{
+			UserId:      s.User.ID.String(),
+			IsActive:    s.User.IsActive,
+			IsBlocked:   s.User.IsBlocked,
+			IsArchived:  s.User.IsArchived,
+			Roles:       s.Roles.Roles,
+			SyncVersion: s.User.SyncVersion,
+		})
+	}
+	// A full page may be followed by another
+	if len(statuses) == int(req.PageSize) {
+		next := statuses[len(statuses)-1].User.ID.String()
+		resp.NextUserId = &next
+	}
+	return resp, nil
+}
+
+// NewUserStatusHandler creates a new UserStatusHandler.
+func NewUserStatusHandler(userStatus *userstatus.UserStatusUsecase, logger *zap.Logger) userv1.UserStatusServiceServer {
+	return &UserStatusHandler{
+		userStatus: userStatus,
+		logger:     logger,
+	}
+}
@@ -1,29 +1,263 @@
 package httphandlerv1
 
 import (
+	"context"
+	"net/http"
+	"time"
+
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	httpmiddleware "mandacode.com/accounts/user/internal/middleware/http"
+	auditmodels "mandacode.com/accounts/user/internal/models/audit"
+	rolemodels "mandacode.com/accounts/user/internal/models/role"
+	usermodels "mandacode.com/accounts/user/internal/models/user"
 	"mandacode.com/accounts/user/internal/usecase/admin"
+	"mandacode.com/accounts/user/internal/usecase/audit"
+	"mandacode.com/accounts/user/internal/usecase/consent"
 	manage "mandacode.com/accounts/user/internal/usecase/management"
+	"mandacode.com/accounts/user/internal/usecase/rbac"
 )
 
+// adminActorKey is the context key of the admin who made the request.
+const adminActorKey = "admin_actor"
+
 type AdminHandler struct {
-	adminUsecase  *admin.AdminUsecase
-	manageUsecase *manage.AdminManageUsecase
+	adminUsecase       *admin.AdminUsecase
+	manageUsecase      *manage.AdminManageUsecase
+	rbacUsecase        *rbac.RBACUsecase
+	auditUsecase       *audit.AuditUsecase
+	consentUsecase     *consent.ConsentUsecase
+	uidHeader          string
+	impersonatorHeader string
+	adminKeyHeader     string
+	logger             *zap.Logger
+}
+
+// listUsersQuery are the query parameters of the user list.
+type listUsersQuery struct {
+	Blocked       *bool      `form:"blocked"`
+	Archived      *bool      `form:"archived"`
+	CreatedAfter  *time.Time `form:"created_after" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedBefore *time.Time `form:"created_before" time_format:"2006-01-02T15:04:05Z07:00"`
+	Limit         int        `form:"limit"`
+	Offset        int        `form:"offset"`
 }
 
 // NewAdminHandler creates a new AdminHandler with the provided use cases.
-func NewAdminHandler(adminUsecase *admin.AdminUsecase, manageUsecase *manage.AdminManageUsecase) *AdminHandler {
+//
+// Admins are identified by the user ID in uidHeader, or by the admin API key in adminKeyHeader. Requests which the
+// gateway marks with an impersonator in impersonatorHeader are refused.
+func NewAdminHandler(adminUsecase *admin.AdminUsecase, manageUsecase *manage.AdminManageUsecase, rbacUsecase *rbac.RBACUsecase, auditUsecase *audit.AuditUsecase, consentUsecase *consent.ConsentUsecase, uidHeader string, impersonatorHeader string, adminKeyHeader string, logger *zap.Logger) *AdminHandler {
 	return &AdminHandler{
-		adminUsecase:  adminUsecase,
-		manageUsecase: manageUsecase,
+		adminUsecase:       adminUsecase,
+		manageUsecase:      manageUsecase,
+		rbacUsecase:        rbacUsecase,
+		auditUsecase:       auditUsecase,
+		consentUsecase:     consentUsecase,
+		uidHeader:          uidHeader,
+		impersonatorHeader: impersonatorHeader,
+		adminKeyHeader:     adminKeyHeader,
+		logger:             logger,
 	}
 }
 
 // RegisterRoutes registers the admin routes with the provided router.
 func (h *AdminHandler) RegisterRoutes(router *gin.RouterGroup) {
-	// Define your admin routes here
-	// Example:
-	// router.GET("/admin/users", h.GetUsers)
-	// router.POST("/admin/users", h.CreateUser)
-	// router.DELETE("/admin/users/:id", h.DeleteUser)
+	users := router.Group("/users")
+	users.GET("", h.authorize(rolemodels.PermissionUsersRead), h.ListUsers)
+	users.GET("/:user_id", h.authorize(rolemodels.PermissionUsersRead), h.GetUser)
+	users.DELETE("/:user_id", h.authorize(rolemodels.PermissionUsersDelete), h.DeleteUser)
+	users.POST("/:user_id/archive", h.authorize(rolemodels.PermissionUsersManage), h.ArchiveUser)
+	users.POST("/:user_id/restore", h.authorize(rolemodels.PermissionUsersManage), h.RestoreUser)
+	users.POST("/:user_id/block", h.authorize(rolemodels.PermissionUsersManage), h.BlockUser)
+	users.POST("/:user_id/unblock", h.authorize(rolemodels.PermissionUsersManage), h.UnblockUser)
+	users.POST("/:user_id/activate", h.authorize(rolemodels.PermissionUsersManage), h.ActivateUser)
+	users.POST("/:user_id/deactivate", h.authorize(rolemodels.PermissionUsersManage), h.DeactivateUser)
+	users.GET("/:user_id/roles", h.authorize(rolemodels.PermissionRolesRead), h.GetUserRoles)
+	users.POST("/:user_id/roles", h.authorize(rolemodels.PermissionRolesManage), h.AssignRole)
+	users.DELETE("/:user_id/roles/:role", h.authorize(rolemodels.PermissionRolesManage), h.RevokeRole)
+	users.GET("/:user_id/consents", h.authorize(rolemodels.PermissionConsentsRead), h.GetUserConsents)
+	users.GET("/:user_id/consents/pending", h.authorize(rolemodels.PermissionConsentsRead), h.GetUserPendingConsents)
+
+	roles := router.Group("/roles")
+	roles.GET("", h.authorize(rolemodels.PermissionRolesRead), h.ListRoles)
+	roles.POST("", h.authorize(rolemodels.PermissionRolesManage), h.CreateRole)
+	roles.PUT("/:role_id", h.authorize(rolemodels.PermissionRolesManage), h.UpdateRole)
+	roles.DELETE("/:role_id", h.authorize(rolemodels.PermissionRolesManage), h.DeleteRole)
+
+	consentDocuments := router.Group("/consent-documents")
+	consentDocuments.GET("", h.authorize(rolemodels.PermissionConsentsRead), h.ListConsentDocuments)
+	consentDocuments.POST("", h.authorize(rolemodels.PermissionConsentsManage), h.PublishConsentDocument)
+
+	auditTrail := router.Group("/audit")
+	auditTrail.GET("", h.authorize(rolemodels.PermissionAuditRead), h.ListAuditEntries)
+	auditTrail.GET("/verify", h.authorize(rolemodels.PermissionAuditRead), h.VerifyAuditChain)
+}
+
+// authorize only lets requests through which present the admin API key, come from a bootstrap admin,
+// or come from a user whose roles grant the permission. The admin is recorded as the actor of the request.
+//
+// Impersonated requests are refused first: their user ID is the impersonated user, whose permissions the
+// impersonating admin must not gain.
+func (h *AdminHandler) authorize(permission string) gin.HandlerFunc {
+	requirePermission := httpmiddleware.RequirePermission(h.rbacUsecase, h.uidHeader, permission)
+	return func(ctx *gin.Context) {
+		if ctx.GetHeader(h.impersonatorHeader) != "" {
+			ctx.Error(errors.New("impersonated requests cannot use the admin API", "Forbidden", errcode.ErrForbidden))
+			ctx.Abort()
+			return
+		}
+		if h.adminUsecase.ValidateAPIKey(ctx.GetHeader(h.adminKeyHeader)) {
+			ctx.Set(adminActorKey, "api_key")
+			setActor(ctx, auditmodels.Actor{Type: auditmodels.ActorTypeAPIKey})
+			ctx.Next()
+			return
+		}
+
+		userID := ctx.GetHeader(h.uidHeader)
+		ctx.Set(adminActorKey, userID)
+		setActor(ctx, auditmodels.Actor{Type: auditmodels.ActorTypeUser, ID: userID})
+		if h.adminUsecase.ValidateAdmin(userID) {
+			ctx.Next()
+			return
+		}
+		requirePermission(ctx)
+	}
+}
+
+// ListUsers handles the retrieval of a page of users.
+func (h *AdminHandler) ListUsers(ctx *gin.Context) {
+	var query listUsersQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid query parameters", errcode.ErrInvalidInput))
+		return
+	}
+	if query.CreatedAfter != nil && query.CreatedBefore != nil && !query.CreatedAfter.Before(*query.CreatedBefore) {
+		ctx.Error(errors.New("created_after must be before created_before", "Invalid query parameters", errcode.ErrInvalidInput))
+		return
+	}
+
+	filter := usermodels.UserFilter{
+		IsBlocked:     query.Blocked,
+		IsArchived:    query.Archived,
+		CreatedAfter:  query.CreatedAfter,
+		CreatedBefore: query.CreatedBefore,
+		Limit:         query.Limit,
+		Offset:        query.Offset,
+	}
+	users, total, err := h.manageUsecase.ListUsers(ctx.Request.Context(), filter)
+	if err != nil {
+		ctx.Error(errors.Join(err, "List users handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"users": users,
+		"total": total,
+	})
+}
+
+// GetUser handles the retrieval of a user by their ID.
+func (h *AdminHandler) GetUser(ctx *gin.Context) {
+	userID, ok := h.userID(ctx)
+	if !ok {
+		return
+	}
+	user, err := h.manageUsecase.GetUserByID(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Get user handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"user": user,
+	})
+}
+
+// DeleteUser handles the immediate deletion of a user by their ID.
+func (h *AdminHandler) DeleteUser(ctx *gin.Context) {
+	userID, ok := h.userID(ctx)
+	if !ok {
+		return
+	}
+	if err := h.manageUsecase.DeleteUser(ctx.Request.Context(), userID); err != nil {
+		ctx.Error(errors.Join(err, "Delete user handler failed"))
+		return
+	}
+	h.logAction(ctx, "delete", userID)
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "User deleted successfully",
+	})
+}
+
+// ArchiveUser handles the archiving of a user by their ID.
+func (h *AdminHandler) ArchiveUser(ctx *gin.Context) {
+	h.updateUser(ctx, "archive", h.manageUsecase.ArchiveUser)
+}
+
+// RestoreUser handles the restoring of an archived user by their ID.
+func (h *AdminHandler) RestoreUser(ctx *gin.Context) {
+	h.updateUser(ctx, "restore", h.manageUsecase.RestoreUser)
+}
+
+// BlockUser handles the blocking of a user by their ID.
+func (h *AdminHandler) BlockUser(ctx *gin.Context) {
+	h.updateUser(ctx, "block", h.manageUsecase.BlockUser)
+}
+
+// UnblockUser handles the unblocking of a user by their ID.
+func (h *AdminHandler) UnblockUser(ctx *gin.Context) {
+	h.updateUser(ctx, "unblock", h.manageUsecase.UnblockUser)
+}
+
+// ActivateUser handles the activation of a user by their ID.
+func (h *AdminHandler) ActivateUser(ctx *gin.Context) {
+	h.updateUser(ctx, "activate", h.manageUsecase.ActivateUser)
+}
+
+// DeactivateUser handles the deactivation of a user by their ID.
+func (h *AdminHandler) DeactivateUser(ctx *gin.Context) {
+	h.updateUser(ctx, "deactivate", h.manageUsecase.DeactivateUser)
+}
+
+// updateUser applies the update to the user in the path and responds with the updated user.
+func (h *AdminHandler) updateUser(ctx *gin.Context, action string, update func(context.Context, uuid.UUID) (*usermodels.SecureUser, error)) {
+	userID, ok := h.userID(ctx)
+	if !ok {
+		return
+	}
+	user, err := update(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Failed to "+action+" user"))
+		return
+	}
+	h.logAction(ctx, action, userID)
+	ctx.JSON(http.StatusOK, gin.H{
+		"user": user,
+	})
+}
+
+// userID parses the user ID in the path, recording an error if it is invalid.
+func (h *AdminHandler) userID(ctx *gin.Context) (uuid.UUID, bool) {
+	userID, err := uuid.Parse(ctx.Param("user_id"))
+	if err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid user ID format", errcode.ErrInvalidInput))
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// setActor records the actor of the request in the request context, for the audit trail.
+func setActor(ctx *gin.Context, actor auditmodels.Actor) {
+	ctx.Request = ctx.Request.WithContext(auditmodels.WithActor(ctx.Request.Context(), actor))
+}
+
+// logAction records who applied an admin action to a user.
+func (h *AdminHandler) logAction(ctx *gin.Context, action string, userID uuid.UUID) {
+	h.logger.Info("admin action applied",
+		zap.String("action", action),
+		zap.String("user_id", userID.String()),
+		zap.String("actor", ctx.GetString(adminActorKey)),
+	)
 }
@@ -0,0 +1,102 @@
+package httphandlerv1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	"mandacode.com/accounts/user/ent/consentdocument"
+	consentmodels "mandacode.com/accounts/user/internal/models/consent"
+)
+
+// publishDocumentRequest is the body of document publications.
+type publishDocumentRequest struct {
+	Kind       consentdocument.Kind `json:"kind" binding:"required,oneof=terms_of_service privacy_policy marketing"`
+	Title      string               `json:"title" binding:"required,max=200"`
+	ContentURL string               `json:"content_url" binding:"required,url"`
+	Mandatory  bool                 `json:"mandatory"`
+}
+
+// listDocumentsQuery are the query parameters of the document list.
+type listDocumentsQuery struct {
+	Kind *consentdocument.Kind `form:"kind"`
+}
+
+// ListConsentDocuments handles the retrieval of every published version of the documents.
+func (h *AdminHandler) ListConsentDocuments(ctx *gin.Context) {
+	var query listDocumentsQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid query parameters", errcode.ErrInvalidInput))
+		return
+	}
+
+	documents, err := h.consentUsecase.ListDocuments(ctx.Request.Context(), query.Kind)
+	if err != nil {
+		ctx.Error(errors.Join(err, "List consent documents handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"documents": documents,
+	})
+}
+
+// PublishConsentDocument handles the publication of a new version of a document.
+func (h *AdminHandler) PublishConsentDocument(ctx *gin.Context) {
+	var req publishDocumentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid request data", errcode.ErrInvalidInput))
+		return
+	}
+
+	document, err := h.consentUsecase.PublishDocument(ctx.Request.Context(), consentmodels.PublishInput{
+		Kind:       req.Kind,
+		Title:      req.Title,
+		ContentURL: req.ContentURL,
+		Mandatory:  req.Mandatory,
+	})
+	if err != nil {
+		ctx.Error(errors.Join(err, "Publish consent document handler failed"))
+		return
+	}
+	h.logger.Info("admin action applied",
+		zap.String("action", "publish_consent_document"),
+		zap.String("document_id", document.ID.String()),
+		zap.String("actor", ctx.GetString(adminActorKey)),
+	)
+	ctx.JSON(http.StatusCreated, document)
+}
+
+// GetUserConsents handles the retrieval of the consent history of a user.
+func (h *AdminHandler) GetUserConsents(ctx *gin.Context) {
+	userID, ok := h.userID(ctx)
+	if !ok {
+		return
+	}
+	consents, err := h.consentUsecase.History(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Get user consents handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"consents": consents,
+	})
+}
+
+// GetUserPendingConsents handles the retrieval of the mandatory documents a user has yet to accept, which hold
+// back their sign in.
+func (h *AdminHandler) GetUserPendingConsents(ctx *gin.Context) {
+	userID, ok := h.userID(ctx)
+	if !ok {
+		return
+	}
+	documents, err := h.consentUsecase.PendingDocuments(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Get user pending consents handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"documents": documents,
+	})
+}
@@ -0,0 +1,85 @@
+package httphandlerv1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	auditmodels "mandacode.com/accounts/user/internal/models/audit"
+)
+
+// listAuditQuery are the query parameters of the audit trail.
+type listAuditQuery struct {
+	Service    string     `form:"service"`
+	ActorID    string     `form:"actor_id"`
+	Action     string     `form:"action"`
+	TargetType string     `form:"target_type"`
+	TargetID   string     `form:"target_id"`
+	From       *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To         *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	BeforeSeq  *int64     `form:"before_seq" binding:"omitempty,min=1"`
+	Limit      int        `form:"limit"`
+}
+
+// verifyAuditQuery are the query parameters of the verification of the audit trail.
+type verifyAuditQuery struct {
+	FromSeq int64 `form:"from_seq"`
+	Limit   int   `form:"limit"`
+}
+
+// ListAuditEntries handles the retrieval of a page of the audit trail, newest first.
+//
+// The next page is selected by passing the returned next_before_seq as before_seq.
+func (h *AdminHandler) ListAuditEntries(ctx *gin.Context) {
+	var query listAuditQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid query parameters", errcode.ErrInvalidInput))
+		return
+	}
+	if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+		ctx.Error(errors.New("from must be before to", "Invalid query parameters", errcode.ErrInvalidInput))
+		return
+	}
+
+	entries, err := h.auditUsecase.ListEntries(ctx.Request.Context(), auditmodels.EntryFilter{
+		Service:    query.Service,
+		ActorID:    query.ActorID,
+		Action:     query.Action,
+		TargetType: query.TargetType,
+		TargetID:   query.TargetID,
+		From:       query.From,
+		To:         query.To,
+		BeforeSeq:  query.BeforeSeq,
+		Limit:      query.Limit,
+	})
+	if err != nil {
+		ctx.Error(errors.Join(err, "List audit entries handler failed"))
+		return
+	}
+
+	response := gin.H{
+		"entries": entries,
+	}
+	if len(entries) > 0 {
+		response["next_before_seq"] = entries[len(entries)-1].Seq
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// VerifyAuditChain handles the verification of a range of the hash chain of the audit trail.
+func (h *AdminHandler) VerifyAuditChain(ctx *gin.Context) {
+	var query verifyAuditQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid query parameters", errcode.ErrInvalidInput))
+		return
+	}
+
+	verification, err := h.auditUsecase.VerifyChain(ctx.Request.Context(), query.FromSeq, query.Limit)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Verify audit chain handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, verification)
+}
@@ -0,0 +1,125 @@
+package httphandlerv1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	consentmodels "mandacode.com/accounts/user/internal/models/consent"
+	"mandacode.com/accounts/user/internal/usecase/consent"
+)
+
+type ConsentHandler struct {
+	consentUsecase *consent.ConsentUsecase
+	uidHeader      string
+	logger         *zap.Logger
+}
+
+// recordConsentsRequest is the body of consent choices.
+type recordConsentsRequest struct {
+	Consents []consentmodels.Choice `json:"consents" binding:"required,min=1,dive"`
+}
+
+// NewConsentHandler creates a new ConsentHandler with the provided use case.
+func NewConsentHandler(consentUsecase *consent.ConsentUsecase, uidHeader string, logger *zap.Logger) *ConsentHandler {
+	return &ConsentHandler{
+		consentUsecase: consentUsecase,
+		uidHeader:      uidHeader,
+		logger:         logger,
+	}
+}
+
+// RegisterRoutes registers the consent routes of signed in users with the provided router. Consents must be
+// given by the users themselves, so noImpersonate guards the route recording them.
+func (h *ConsentHandler) RegisterRoutes(router *gin.RouterGroup, noImpersonate gin.HandlerFunc) {
+	router.GET("/consents", h.GetHistory)
+	router.GET("/consents/pending", h.GetPending)
+	router.POST("/consents", noImpersonate, h.RecordConsents)
+}
+
+// RegisterPublicRoutes registers the route of the current documents with the provided router, which users read
+// before they sign up.
+func (h *ConsentHandler) RegisterPublicRoutes(router *gin.RouterGroup) {
+	router.GET("", h.GetCurrentDocuments)
+}
+
+// GetCurrentDocuments handles the retrieval of the current version of each document.
+func (h *ConsentHandler) GetCurrentDocuments(ctx *gin.Context) {
+	documents, err := h.consentUsecase.CurrentDocuments(ctx.Request.Context())
+	if err != nil {
+		ctx.Error(errors.Join(err, "Get current consent documents handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"documents": documents,
+	})
+}
+
+// GetHistory handles the request of a user to list every consent choice they made.
+func (h *ConsentHandler) GetHistory(ctx *gin.Context) {
+	userID, err := uuid.Parse(ctx.GetHeader(h.uidHeader))
+	if err != nil {
+		ctx.Error(errors.New("user ID is missing or invalid", "Unauthorized", errcode.ErrUnauthorized))
+		return
+	}
+
+	consents, err := h.consentUsecase.History(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Get consent history handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"consents": consents,
+	})
+}
+
+// GetPending handles the request of a user to list the mandatory documents they have yet to accept.
+func (h *ConsentHandler) GetPending(ctx *gin.Context) {
+	userID, err := uuid.Parse(ctx.GetHeader(h.uidHeader))
+	if err != nil {
+		ctx.Error(errors.New("user ID is missing or invalid", "Unauthorized", errcode.ErrUnauthorized))
+		return
+	}
+
+	documents, err := h.consentUsecase.PendingDocuments(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Get pending consents handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"documents": documents,
+	})
+}
+
+// RecordConsents handles the consent choices of a user, such as withdrawing their marketing consent.
+func (h *ConsentHandler) RecordConsents(ctx *gin.Context) {
+	userID, err := uuid.Parse(ctx.GetHeader(h.uidHeader))
+	if err != nil {
+		ctx.Error(errors.New("user ID is missing or invalid", "Unauthorized", errcode.ErrUnauthorized))
+		return
+	}
+	var req recordConsentsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid request data", errcode.ErrInvalidInput))
+		return
+	}
+
+	if err := h.consentUsecase.Record(ctx.Request.Context(), userID, req.Consents, consentSource(ctx)); err != nil {
+		ctx.Error(errors.Join(err, "Record consents handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": "Consents recorded successfully",
+	})
+}
+
+// consentSource returns where the consent choices of the request are made.
+func consentSource(ctx *gin.Context) consentmodels.Source {
+	return consentmodels.Source{
+		IP:        ctx.ClientIP(),
+		UserAgent: ctx.Request.UserAgent(),
+	}
+}
@@ -0,0 +1,112 @@
+package httphandlerv1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	"mandacode.com/accounts/user/internal/usecase/emailchange"
+)
+
+type EmailChangeHandler struct {
+	emailChangeUsecase *emailchange.EmailChangeUsecase
+	uidHeader          string
+	logger             *zap.Logger
+}
+
+// requestEmailChangeRequest is the body of email change requests.
+type requestEmailChangeRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// emailChangeTokenRequest is the body of email change confirmations and undos.
+type emailChangeTokenRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// NewEmailChangeHandler creates a new EmailChangeHandler with the provided use case.
+func NewEmailChangeHandler(emailChangeUsecase *emailchange.EmailChangeUsecase, uidHeader string, logger *zap.Logger) *EmailChangeHandler {
+	return &EmailChangeHandler{
+		emailChangeUsecase: emailChangeUsecase,
+		uidHeader:          uidHeader,
+		logger:             logger,
+	}
+}
+
+// RegisterRoutes registers the email change routes of signed in users with the provided router. The email
+// address is a credential, so noImpersonate guards the routes.
+func (h *EmailChangeHandler) RegisterRoutes(router *gin.RouterGroup, noImpersonate gin.HandlerFunc) {
+	router.POST("/email", noImpersonate, h.RequestEmailChange)
+}
+
+// RegisterPublicRoutes registers the routes of the links sent by email with the provided router.
+//
+// They do not require the user to be signed in, as the undo link is meant for users who lost access to their
+// account.
+func (h *EmailChangeHandler) RegisterPublicRoutes(router *gin.RouterGroup) {
+	router.POST("/confirm", h.ConfirmEmailChange)
+	router.POST("/undo", h.UndoEmailChange)
+}
+
+// RequestEmailChange handles the request of a user to change their email address.
+func (h *EmailChangeHandler) RequestEmailChange(ctx *gin.Context) {
+	userID, err := uuid.Parse(ctx.GetHeader(h.uidHeader))
+	if err != nil {
+		ctx.Error(errors.New("user ID is missing or invalid", "Unauthorized", errcode.ErrUnauthorized))
+		return
+	}
+	var req requestEmailChangeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid request data", errcode.ErrInvalidInput))
+		return
+	}
+
+	change, err := h.emailChangeUsecase.RequestEmailChange(ctx.Request.Context(), userID, req.Email)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Request email change handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusAccepted, gin.H{
+		"message":      "Verification email sent to the new address",
+		"email_change": change,
+	})
+}
+
+// ConfirmEmailChange handles the link sent to the new address of a user changing it.
+func (h *EmailChangeHandler) ConfirmEmailChange(ctx *gin.Context) {
+	var req emailChangeTokenRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid request data", errcode.ErrInvalidInput))
+		return
+	}
+
+	change, err := h.emailChangeUsecase.ConfirmEmailChange(ctx.Request.Context(), req.Token)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Confirm email change handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":      "Email address changed",
+		"email_change": change,
+	})
+}
+
+// UndoEmailChange handles the link sent to the old address of a user who changed it.
+func (h *EmailChangeHandler) UndoEmailChange(ctx *gin.Context) {
+	var req emailChangeTokenRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid request data", errcode.ErrInvalidInput))
+		return
+	}
+
+	if err := h.emailChangeUsecase.UndoEmailChange(ctx.Request.Context(), req.Token); err != nil {
+		ctx.Error(errors.Join(err, "Undo email change handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Email change undone",
+	})
+}
@@ -0,0 +1,99 @@
+package httphandlerv1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	"mandacode.com/accounts/user/internal/usecase/dataexport"
+)
+
+type DataExportHandler struct {
+	exportUsecase *dataexport.ExportUsecase
+	uidHeader     string
+	logger        *zap.Logger
+}
+
+// NewDataExportHandler creates a new DataExportHandler with the provided use case.
+func NewDataExportHandler(exportUsecase *dataexport.ExportUsecase, uidHeader string, logger *zap.Logger) *DataExportHandler {
+	return &DataExportHandler{
+		exportUsecase: exportUsecase,
+		uidHeader:     uidHeader,
+		logger:        logger,
+	}
+}
+
+// RegisterRoutes registers the data export routes of signed in users with the provided router.
+func (h *DataExportHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/export", h.RequestExport)
+	router.GET("/export", h.ListExports)
+}
+
+// RegisterPublicRoutes registers the route of the download links sent by email with the provided router.
+//
+// The token of the link is the only credential, as the archive is downloaded from the mail.
+func (h *DataExportHandler) RegisterPublicRoutes(router *gin.RouterGroup) {
+	router.GET("/download", h.Download)
+}
+
+// RequestExport handles the request of a user to export their data.
+func (h *DataExportHandler) RequestExport(ctx *gin.Context) {
+	userID, err := uuid.Parse(ctx.GetHeader(h.uidHeader))
+	if err != nil {
+		ctx.Error(errors.New("user ID is missing or invalid", "Unauthorized", errcode.ErrUnauthorized))
+		return
+	}
+
+	export, err := h.exportUsecase.RequestExport(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Request data export handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusAccepted, gin.H{
+		"message": "Data export requested, a download link will be sent by email",
+		"export":  export,
+	})
+}
+
+// ListExports handles the request of a user to list their exports.
+func (h *DataExportHandler) ListExports(ctx *gin.Context) {
+	userID, err := uuid.Parse(ctx.GetHeader(h.uidHeader))
+	if err != nil {
+		ctx.Error(errors.New("user ID is missing or invalid", "Unauthorized", errcode.ErrUnauthorized))
+		return
+	}
+
+	exports, err := h.exportUsecase.ListExports(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.Error(errors.Join(err, "List data exports handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"exports": exports,
+	})
+}
+
+// Download handles the download link of an export, streaming its archive.
+func (h *DataExportHandler) Download(ctx *gin.Context) {
+	token := ctx.Query("token")
+	if token == "" {
+		ctx.Error(errors.New("download token is missing", "Invalid request data", errcode.ErrInvalidInput))
+		return
+	}
+
+	archive, export, err := h.exportUsecase.OpenDownload(ctx.Request.Context(), token)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Download data export handler failed"))
+		return
+	}
+	defer archive.Close()
+
+	filename := "mandacode-data-" + export.CreatedAt.UTC().Format("20060102") + ".zip"
+	ctx.DataFromReader(http.StatusOK, -1, "application/zip", archive, map[string]string{
+		"Content-Disposition": `attachment; filename="` + filename + `"`,
+		"Cache-Control":       "no-store",
+	})
+}
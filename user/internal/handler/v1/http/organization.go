@@ -0,0 +1,335 @@
+package httphandlerv1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	"mandacode.com/accounts/user/ent/membership"
+	orgmodels "mandacode.com/accounts/user/internal/models/organization"
+	"mandacode.com/accounts/user/internal/usecase/organization"
+)
+
+type OrganizationHandler struct {
+	orgUsecase *organization.OrganizationUsecase
+	uidHeader  string
+	logger     *zap.Logger
+}
+
+// createOrganizationRequest is the body of organization creations.
+type createOrganizationRequest struct {
+	Name string `json:"name" binding:"required"`
+	Slug string `json:"slug" binding:"required"`
+}
+
+// memberRoleRequest is the body of member role updates.
+type memberRoleRequest struct {
+	Role string `json:"role" binding:"required"`
+}
+
+// inviteMemberRequest is the body of invitations.
+type inviteMemberRequest struct {
+	Email string `json:"email" binding:"required,email"`
+	Role  string `json:"role"`
+}
+
+// acceptInvitationRequest is the body of invitation acceptances.
+type acceptInvitationRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// NewOrganizationHandler creates a new OrganizationHandler with the provided use case.
+func NewOrganizationHandler(orgUsecase *organization.OrganizationUsecase, uidHeader string, logger *zap.Logger) *OrganizationHandler {
+	return &OrganizationHandler{
+		orgUsecase: orgUsecase,
+		uidHeader:  uidHeader,
+		logger:     logger,
+	}
+}
+
+// RegisterRoutes registers the organization routes with the provided router.
+func (h *OrganizationHandler) RegisterRoutes(router *gin.RouterGroup) {
+	orgs := router.Group("/orgs")
+	orgs.POST("", h.CreateOrganization)
+	orgs.GET("", h.ListOrganizations)
+	orgs.GET("/:org_id", h.GetOrganization)
+	orgs.DELETE("/:org_id", h.DeleteOrganization)
+	orgs.GET("/:org_id/members", h.ListMembers)
+	orgs.PUT("/:org_id/members/:member_id", h.UpdateMemberRole)
+	orgs.DELETE("/:org_id/members/:member_id", h.RemoveMember)
+	orgs.POST("/:org_id/invitations", h.InviteMember)
+	orgs.GET("/:org_id/invitations", h.ListInvitations)
+	orgs.DELETE("/:org_id/invitations/:invitation_id", h.RevokeInvitation)
+
+	router.POST("/invitations/accept", h.AcceptInvitation)
+}
+
+// CreateOrganization handles the creation of an organization owned by the user.
+func (h *OrganizationHandler) CreateOrganization(ctx *gin.Context) {
+	userID, ok := h.userID(ctx)
+	if !ok {
+		return
+	}
+	var req createOrganizationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid request data", errcode.ErrInvalidInput))
+		return
+	}
+
+	org, err := h.orgUsecase.CreateOrganization(ctx.Request.Context(), userID, req.Name, req.Slug)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Create organization handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusCreated, gin.H{
+		"organization": org,
+	})
+}
+
+// ListOrganizations handles the retrieval of the organizations the user is a member of.
+func (h *OrganizationHandler) ListOrganizations(ctx *gin.Context) {
+	userID, ok := h.userID(ctx)
+	if !ok {
+		return
+	}
+	memberships, err := h.orgUsecase.ListOrganizations(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.Error(errors.Join(err, "List organizations handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"memberships": memberships,
+	})
+}
+
+// GetOrganization handles the retrieval of an organization the user is a member of.
+func (h *OrganizationHandler) GetOrganization(ctx *gin.Context) {
+	userID, orgID, ok := h.orgParams(ctx)
+	if !ok {
+		return
+	}
+	org, err := h.orgUsecase.GetOrganization(ctx.Request.Context(), userID, orgID)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Get organization handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"organization": org,
+	})
+}
+
+// DeleteOrganization handles the deletion of an organization owned by the user.
+func (h *OrganizationHandler) DeleteOrganization(ctx *gin.Context) {
+	userID, orgID, ok := h.orgParams(ctx)
+	if !ok {
+		return
+	}
+	if err := h.orgUsecase.DeleteOrganization(ctx.Request.Context(), userID, orgID); err != nil {
+		ctx.Error(errors.Join(err, "Delete organization handler failed"))
+		return
+	}
+	h.logger.Info("organization deleted",
+		zap.String("organization_id", orgID.String()),
+		zap.String("user_id", userID.String()),
+	)
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Organization deleted successfully",
+	})
+}
+
+// ListMembers handles the retrieval of the members of an organization.
+func (h *OrganizationHandler) ListMembers(ctx *gin.Context) {
+	userID, orgID, ok := h.orgParams(ctx)
+	if !ok {
+		return
+	}
+	members, err := h.orgUsecase.ListMembers(ctx.Request.Context(), userID, orgID)
+	if err != nil {
+		ctx.Error(errors.Join(err, "List members handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"members": members,
+	})
+}
+
+// UpdateMemberRole handles the change of the role of a member.
+func (h *OrganizationHandler) UpdateMemberRole(ctx *gin.Context) {
+	userID, orgID, ok := h.orgParams(ctx)
+	if !ok {
+		return
+	}
+	memberID, ok := h.pathID(ctx, "member_id")
+	if !ok {
+		return
+	}
+	var req memberRoleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid request data", errcode.ErrInvalidInput))
+		return
+	}
+	role, ok := h.role(ctx, req.Role)
+	if !ok {
+		return
+	}
+
+	member, err := h.orgUsecase.UpdateMemberRole(ctx.Request.Context(), userID, orgID, memberID, role)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Update member role handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"member": member,
+	})
+}
+
+// RemoveMember handles the removal of a member, including the user leaving the organization.
+func (h *OrganizationHandler) RemoveMember(ctx *gin.Context) {
+	userID, orgID, ok := h.orgParams(ctx)
+	if !ok {
+		return
+	}
+	memberID, ok := h.pathID(ctx, "member_id")
+	if !ok {
+		return
+	}
+	if err := h.orgUsecase.RemoveMember(ctx.Request.Context(), userID, orgID, memberID); err != nil {
+		ctx.Error(errors.Join(err, "Remove member handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Member removed successfully",
+	})
+}
+
+// InviteMember handles the invitation of a new member by email.
+func (h *OrganizationHandler) InviteMember(ctx *gin.Context) {
+	userID, orgID, ok := h.orgParams(ctx)
+	if !ok {
+		return
+	}
+	var req inviteMemberRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid request data", errcode.ErrInvalidInput))
+		return
+	}
+	if req.Role == "" {
+		req.Role = string(orgmodels.RoleMember)
+	}
+	role, ok := h.role(ctx, req.Role)
+	if !ok {
+		return
+	}
+
+	invitation, err := h.orgUsecase.InviteMember(ctx.Request.Context(), userID, orgID, req.Email, role)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Invite member handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusCreated, gin.H{
+		"invitation": invitation,
+	})
+}
+
+// ListInvitations handles the retrieval of the pending invitations of an organization.
+func (h *OrganizationHandler) ListInvitations(ctx *gin.Context) {
+	userID, orgID, ok := h.orgParams(ctx)
+	if !ok {
+		return
+	}
+	invitations, err := h.orgUsecase.ListInvitations(ctx.Request.Context(), userID, orgID)
+	if err != nil {
+		ctx.Error(errors.Join(err, "List invitations handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"invitations": invitations,
+	})
+}
+
+// RevokeInvitation handles the revocation of a pending invitation.
+func (h *OrganizationHandler) RevokeInvitation(ctx *gin.Context) {
+	userID, orgID, ok := h.orgParams(ctx)
+	if !ok {
+		return
+	}
+	invitationID, ok := h.pathID(ctx, "invitation_id")
+	if !ok {
+		return
+	}
+	if err := h.orgUsecase.RevokeInvitation(ctx.Request.Context(), userID, orgID, invitationID); err != nil {
+		ctx.Error(errors.Join(err, "Revoke invitation handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Invitation revoked successfully",
+	})
+}
+
+// AcceptInvitation handles the acceptance of an invitation by the user.
+func (h *OrganizationHandler) AcceptInvitation(ctx *gin.Context) {
+	userID, ok := h.userID(ctx)
+	if !ok {
+		return
+	}
+	var req acceptInvitationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid request data", errcode.ErrInvalidInput))
+		return
+	}
+
+	member, err := h.orgUsecase.AcceptInvitation(ctx.Request.Context(), userID, req.Token)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Accept invitation handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"member": member,
+	})
+}
+
+// userID parses the user ID in the header, recording an error if it is missing or invalid.
+func (h *OrganizationHandler) userID(ctx *gin.Context) (uuid.UUID, bool) {
+	userID, err := uuid.Parse(ctx.GetHeader(h.uidHeader))
+	if err != nil {
+		ctx.Error(errors.New("user ID is missing or invalid", "Unauthorized", errcode.ErrUnauthorized))
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// orgParams parses the user ID in the header and the organization ID in the path.
+func (h *OrganizationHandler) orgParams(ctx *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID, ok := h.userID(ctx)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+	orgID, ok := h.pathID(ctx, "org_id")
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+	return userID, orgID, true
+}
+
+// pathID parses an ID in the path, recording an error if it is invalid.
+func (h *OrganizationHandler) pathID(ctx *gin.Context, param string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(ctx.Param(param))
+	if err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid "+param+" format", errcode.ErrInvalidInput))
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// role parses an organization role, recording an error if it is invalid.
+func (h *OrganizationHandler) role(ctx *gin.Context, role string) (membership.Role, bool) {
+	parsed, ok := orgmodels.ParseRole(role)
+	if !ok {
+		ctx.Error(errors.New("unknown organization role: "+role, "Invalid Role", errcode.ErrInvalidInput))
+		return "", false
+	}
+	return parsed, true
+}
@@ -0,0 +1,161 @@
+package httphandlerv1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+)
+
+// roleRequest is the body of role creations and updates.
+type roleRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions" binding:"required"`
+}
+
+// assignRoleRequest is the body of role assignments.
+type assignRoleRequest struct {
+	Role string `json:"role" binding:"required"`
+}
+
+// ListRoles handles the retrieval of all roles.
+func (h *AdminHandler) ListRoles(ctx *gin.Context) {
+	roles, err := h.rbacUsecase.ListRoles(ctx.Request.Context())
+	if err != nil {
+		ctx.Error(errors.Join(err, "List roles handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"roles": roles,
+	})
+}
+
+// CreateRole handles the creation of a custom role.
+func (h *AdminHandler) CreateRole(ctx *gin.Context) {
+	var req roleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid request data", errcode.ErrInvalidInput))
+		return
+	}
+	if req.Name == "" {
+		ctx.Error(errors.New("role name is required", "Invalid request data", errcode.ErrInvalidInput))
+		return
+	}
+
+	role, err := h.rbacUsecase.CreateRole(ctx.Request.Context(), req.Name, req.Description, req.Permissions)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Create role handler failed"))
+		return
+	}
+	h.logRoleAction(ctx, "create_role", role.Name)
+	ctx.JSON(http.StatusCreated, gin.H{
+		"role": role,
+	})
+}
+
+// UpdateRole handles the update of a custom role.
+func (h *AdminHandler) UpdateRole(ctx *gin.Context) {
+	roleID, err := uuid.Parse(ctx.Param("role_id"))
+	if err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid role ID format", errcode.ErrInvalidInput))
+		return
+	}
+	var req roleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid request data", errcode.ErrInvalidInput))
+		return
+	}
+
+	role, err := h.rbacUsecase.UpdateRole(ctx.Request.Context(), roleID, req.Description, req.Permissions)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Update role handler failed"))
+		return
+	}
+	h.logRoleAction(ctx, "update_role", role.Name)
+	ctx.JSON(http.StatusOK, gin.H{
+		"role": role,
+	})
+}
+
+// DeleteRole handles the deletion of a custom role.
+func (h *AdminHandler) DeleteRole(ctx *gin.Context) {
+	roleID, err := uuid.Parse(ctx.Param("role_id"))
+	if err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid role ID format", errcode.ErrInvalidInput))
+		return
+	}
+
+	if err := h.rbacUsecase.DeleteRole(ctx.Request.Context(), roleID); err != nil {
+		ctx.Error(errors.Join(err, "Delete role handler failed"))
+		return
+	}
+	h.logRoleAction(ctx, "delete_role", roleID.String())
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Role deleted successfully",
+	})
+}
+
+// GetUserRoles handles the retrieval of the roles of a user.
+func (h *AdminHandler) GetUserRoles(ctx *gin.Context) {
+	userID, ok := h.userID(ctx)
+	if !ok {
+		return
+	}
+	roles, err := h.rbacUsecase.GetUserRoles(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Get user roles handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, roles)
+}
+
+// AssignRole handles the assignment of a role to a user.
+func (h *AdminHandler) AssignRole(ctx *gin.Context) {
+	userID, ok := h.userID(ctx)
+	if !ok {
+		return
+	}
+	var req assignRoleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(errors.New(err.Error(), "Invalid request data", errcode.ErrInvalidInput))
+		return
+	}
+
+	roles, err := h.rbacUsecase.AssignRole(ctx.Request.Context(), userID, req.Role, ctx.GetString(adminActorKey))
+	if err != nil {
+		ctx.Error(errors.Join(err, "Assign role handler failed"))
+		return
+	}
+	h.logAction(ctx, "assign_role:"+req.Role, userID)
+	ctx.JSON(http.StatusOK, roles)
+}
+
+// RevokeRole handles the revocation of a role from a user.
+func (h *AdminHandler) RevokeRole(ctx *gin.Context) {
+	userID, ok := h.userID(ctx)
+	if !ok {
+		return
+	}
+	roleName := ctx.Param("role")
+
+	roles, err := h.rbacUsecase.RevokeRole(ctx.Request.Context(), userID, roleName)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Revoke role handler failed"))
+		return
+	}
+	h.logAction(ctx, "revoke_role:"+roleName, userID)
+	ctx.JSON(http.StatusOK, roles)
+}
+
+// logRoleAction records who changed a role.
+func (h *AdminHandler) logRoleAction(ctx *gin.Context, action string, role string) {
+	h.logger.Info("admin action applied",
+		zap.String("action", action),
+		zap.String("role", role),
+		zap.String("actor", ctx.GetString(adminActorKey)),
+	)
+}
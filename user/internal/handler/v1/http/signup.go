@@ -7,7 +7,10 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
 	"go.uber.org/zap"
+	consentmodels "mandacode.com/accounts/user/internal/models/consent"
 	"mandacode.com/accounts/user/internal/models/provider"
 	"mandacode.com/accounts/user/internal/usecase/signup"
 	signupdto "mandacode.com/accounts/user/internal/usecase/signup/dto"
@@ -16,14 +19,21 @@ import (
 type SignupHandler struct {
 	signup      *signup.SingupUsecase
 	verifyEmail *signup.VerifyEmailUsecase
+	guardian    *signup.GuardianConsentUsecase
 	validator   *validator.Validate
 	logger      *zap.Logger
 }
 
+// guardianConsentRequest is the body of guardian consent confirmations.
+type guardianConsentRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
 // NewSignupHandler creates a new SignupHandler with the provided use case and logger.
 func NewSignupHandler(
 	signup *signup.SingupUsecase,
 	verifyEmail *signup.VerifyEmailUsecase,
+	guardian *signup.GuardianConsentUsecase,
 	validator *validator.Validate,
 	logger *zap.Logger,
 ) *SignupHandler {
@@ -35,6 +45,10 @@ func NewSignupHandler(
 		logger.Error("verifyEmail use case cannot be nil")
 		return nil
 	}
+	if guardian == nil {
+		logger.Error("guardian consent use case cannot be nil")
+		return nil
+	}
 	if validator == nil {
 		logger.Error("validator cannot be nil")
 		return nil
@@ -46,17 +60,23 @@ func NewSignupHandler(
 	return &SignupHandler{
 		signup:      signup,
 		verifyEmail: verifyEmail,
+		guardian:    guardian,
 		validator:   validator,
 		logger:      logger,
 	}
 }
 
 // RegisterRoutes registers the user routes with the provided router.
-func (h *SignupHandler) RegisterRoutes(router *gin.RouterGroup) {
-	router.POST("/", h.LocalSignup)
+//
+// captcha guards the routes which create accounts or send emails. guardianResendLimit limits the consent links
+// sent to the guardian of each minor, as anyone knowing the user ID of the minor may ask to resend it.
+func (h *SignupHandler) RegisterRoutes(router *gin.RouterGroup, captcha gin.HandlerFunc, guardianResendLimit gin.HandlerFunc) {
+	router.POST("/", captcha, h.LocalSignup)
 	router.GET("/verify-email", h.VerifyEmail)
-	router.POST("/verify-email/resend/:user_id", h.ResendVerificationEmail)
+	router.POST("/verify-email/resend/:user_id", captcha, h.ResendVerificationEmail)
 	router.GET("/o/:provider", h.OAuthSignup)
+	router.POST("/guardian-consent", h.ConfirmGuardianConsent)
+	router.POST("/guardian-consent/resend/:user_id", captcha, guardianResendLimit, h.ResendGuardianConsent)
 }
 
 // LocalSignup handles the local signup process.
@@ -74,9 +94,14 @@ func (h *SignupHandler) LocalSignup(ctx *gin.Context) {
 		return
 	}
 
+	req.Source = consentSource(ctx)
 	signupRes, err := h.signup.LocalSignup(ctx, &req)
 	if err != nil {
 		h.logger.Error("Local signup failed", zap.Error(err))
+		if appErr, ok := err.(*errors.AppError); ok && errors.Is(err, errcode.ErrInvalidInput) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": appErr.Public()})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Local signup failed"})
 		return
 	}
@@ -85,11 +110,19 @@ func (h *SignupHandler) LocalSignup(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send verification email"})
 		return
 	}
+	if signupRes.GuardianConsentPending {
+		if err := h.guardian.RequestConsent(ctx, signupRes.UserID); err != nil {
+			h.logger.Error("Failed to send guardian consent email", zap.Error(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send guardian consent email"})
+			return
+		}
+	}
 
 	ctx.JSON(http.StatusOK, gin.H{
-		"user_id":    signupRes.UserID,
-		"email":      signupRes.Email,
-		"created_at": signupRes.CreatedAt,
+		"user_id":                  signupRes.UserID,
+		"email":                    signupRes.Email,
+		"created_at":               signupRes.CreatedAt,
+		"guardian_consent_pending": signupRes.GuardianConsentPending,
 	})
 }
 
@@ -142,15 +175,53 @@ func (h *SignupHandler) OAuthSignup(ctx *gin.Context) {
 		return
 	}
 
-	signupRes, err := h.signup.OAuthSignup(ctx, &signupdto.OAuthSignupRequest{
+	// The documents which the user accepted are optional, as the auth service collects the missing consents
+	// when the user signs in
+	var consents []consentmodels.Choice
+	for _, documentID := range ctx.QueryArray("consent") {
+		documentUID, err := uuid.Parse(documentID)
+		if err != nil {
+			h.logger.Error("Invalid consent document ID for OAuth signup", zap.String("document_id", documentID), zap.Error(err))
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid consent document ID"})
+			return
+		}
+		consents = append(consents, consentmodels.Choice{DocumentID: documentUID, Accepted: true})
+	}
+
+	// Providers do not share the birth date, which the auth service collects from the user
+	req := &signupdto.OAuthSignupRequest{
 		Provider:    providerEnum,
 		AccessToken: accessToken,
-	})
+		BirthDate:   ctx.Query("birth_date"),
+		Consents:    consents,
+		Source:      consentSource(ctx),
+	}
+	if guardianEmail, ok := ctx.GetQuery("guardian_email"); ok {
+		req.GuardianEmail = &guardianEmail
+	}
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed for OAuth signup", zap.Error(err))
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data", "details": err.Error()})
+		return
+	}
+
+	signupRes, err := h.signup.OAuthSignup(ctx, req)
 	if err != nil {
 		h.logger.Error("OAuth signup failed", zap.String("provider", providerParam), zap.Error(err))
+		if appErr, ok := err.(*errors.AppError); ok && errors.Is(err, errcode.ErrInvalidInput) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": appErr.Public()})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "OAuth signup failed"})
 		return
 	}
+	if signupRes.GuardianConsentPending {
+		if err := h.guardian.RequestConsent(ctx, signupRes.UserID); err != nil {
+			h.logger.Error("Failed to send guardian consent email", zap.Error(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send guardian consent email"})
+			return
+		}
+	}
 
 	ctx.JSON(http.StatusOK, signupRes)
 }
@@ -191,3 +262,57 @@ func (h *SignupHandler) ResendVerificationEmail(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, gin.H{"message": "Verification email resent successfully"})
 }
+
+// ConfirmGuardianConsent handles the link sent to the guardian of a user under 14, which activates their account.
+func (h *SignupHandler) ConfirmGuardianConsent(ctx *gin.Context) {
+	var req guardianConsentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON for guardian consent", zap.Error(err))
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed for guardian consent", zap.Error(err))
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data", "details": err.Error()})
+		return
+	}
+
+	user, err := h.guardian.Confirm(ctx, req.Token)
+	if err != nil {
+		h.logger.Error("Guardian consent failed", zap.Error(err))
+		if appErr, ok := err.(*errors.AppError); ok {
+			ctx.JSON(errcode.MapCodeToHTTP(appErr.Code()), gin.H{"error": appErr.Public()})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Guardian consent failed"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"user_id": user.ID,
+		"message": "Guardian consent recorded successfully",
+	})
+}
+
+// ResendGuardianConsent handles the request to resend the consent link to the guardian of a user under 14.
+func (h *SignupHandler) ResendGuardianConsent(ctx *gin.Context) {
+	userUID, err := uuid.Parse(ctx.Param("user_id"))
+	if err != nil {
+		h.logger.Error("Invalid User ID format for resend guardian consent", zap.String("user_id", ctx.Param("user_id")), zap.Error(err))
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid User ID format"})
+		return
+	}
+
+	if err := h.guardian.RequestConsent(ctx, userUID); err != nil {
+		h.logger.Error("Failed to resend guardian consent email", zap.Error(err))
+		if appErr, ok := err.(*errors.AppError); ok {
+			ctx.JSON(errcode.MapCodeToHTTP(appErr.Code()), gin.H{"error": appErr.Public()})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to resend guardian consent email"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Guardian consent email resent successfully"})
+}
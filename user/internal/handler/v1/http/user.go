@@ -9,27 +9,48 @@ import (
 	"github.com/mandacode-com/golib/errors/errcode"
 	"go.uber.org/zap"
 	manage "mandacode.com/accounts/user/internal/usecase/management"
+	"mandacode.com/accounts/user/internal/usecase/rbac"
 )
 
 type UserHandler struct {
 	manageUsecase *manage.SelfManageUsecase
+	rbacUsecase   *rbac.RBACUsecase
 	uidHeader     string
 	logger        *zap.Logger
 }
 
 // NewUserHandler creates a new UserHandler with the provided use case.
-func NewUserHandler(manageUsecase *manage.SelfManageUsecase, uidHeader string, logger *zap.Logger) *UserHandler {
+func NewUserHandler(manageUsecase *manage.SelfManageUsecase, rbacUsecase *rbac.RBACUsecase, uidHeader string, logger *zap.Logger) *UserHandler {
 	return &UserHandler{
 		manageUsecase: manageUsecase,
+		rbacUsecase:   rbacUsecase,
 		uidHeader:     uidHeader,
 		logger:        logger,
 	}
 }
 
-// RegisterRoutes registers the user routes with the provided router.
-func (h *UserHandler) RegisterRoutes(router *gin.RouterGroup) {
+// RegisterRoutes registers the user routes with the provided router. noImpersonate guards the deletion of
+// the account.
+func (h *UserHandler) RegisterRoutes(router *gin.RouterGroup, noImpersonate gin.HandlerFunc) {
 	router.GET("/", h.GetUser)
-	router.DELETE("/", h.DeleteUser)
+	router.DELETE("/", noImpersonate, h.DeleteUser)
+	router.GET("/roles", h.GetRoles)
+}
+
+// GetRoles handles the retrieval of the roles of the user and the permissions they grant.
+func (h *UserHandler) GetRoles(ctx *gin.Context) {
+	userID, err := uuid.Parse(ctx.GetHeader(h.uidHeader))
+	if err != nil {
+		ctx.Error(errors.New("user ID is missing or invalid", "Unauthorized", errcode.ErrUnauthorized))
+		return
+	}
+
+	roles, err := h.rbacUsecase.GetUserRoles(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.Error(errors.Join(err, "Get roles handler failed"))
+		return
+	}
+	ctx.JSON(http.StatusOK, roles)
 }
 
 // GetUser handles the retrieval of a user by their ID.
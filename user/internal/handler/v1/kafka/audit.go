@@ -0,0 +1,35 @@
+package kafkahandlerv1
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	kafka "github.com/segmentio/kafka-go"
+	kafkaserver "mandacode.com/accounts/user/cmd/server/kafka"
+	auditmodels "mandacode.com/accounts/user/internal/models/audit"
+	"mandacode.com/accounts/user/internal/usecase/audit"
+)
+
+type AuditEventHandler struct {
+	audit *audit.AuditUsecase
+}
+
+// HandleMessage implements kafkaserver.KafkaHandler.
+func (h *AuditEventHandler) HandleMessage(ctx context.Context, m kafka.Message) error {
+	var event auditmodels.Event
+	if err := json.Unmarshal(m.Value, &event); err != nil {
+		return errors.Upgrade(err, "Invalid Audit Event Message", errcode.ErrInvalidInput)
+	}
+	if err := h.audit.Record(ctx, event); err != nil {
+		return errors.Join(err, "Failed to record audit event")
+	}
+	return nil
+}
+
+func NewAuditEventHandler(audit *audit.AuditUsecase) kafkaserver.KafkaHandler {
+	return &AuditEventHandler{
+		audit: audit,
+	}
+}
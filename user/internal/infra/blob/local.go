@@ -0,0 +1,91 @@
+package blobinfra
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+)
+
+// LocalStore is a Store on the local filesystem. Replicas only share it if dir is on a shared volume.
+type LocalStore struct {
+	dir string
+}
+
+// NewLocalStore creates a LocalStore in dir, creating the directory if needed.
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, errors.New(err.Error(), "Failed to create blob store directory", errcode.ErrInternalFailure)
+	}
+	return &LocalStore{dir: dir}, nil
+}
+
+// Put implements Store.
+//
+// The blob is written to a temporary file first, so that it is never opened half written.
+func (s *LocalStore) Put(ctx context.Context, key string, r io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return errors.New(err.Error(), "Failed to store blob", errcode.ErrInternalFailure)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return errors.New(err.Error(), "Failed to store blob", errcode.ErrInternalFailure)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return errors.New(err.Error(), "Failed to store blob", errcode.ErrInternalFailure)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.New(err.Error(), "Failed to store blob", errcode.ErrInternalFailure)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return errors.New(err.Error(), "Failed to store blob", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// Open implements Store.
+func (s *LocalStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.New("blob not found", "Not Found", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to open blob", errcode.ErrInternalFailure)
+	}
+	return file, nil
+}
+
+// Delete implements Store.
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.New(err.Error(), "Failed to delete blob", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// path returns the path of the blob stored under key, refusing keys which escape the directory of the store.
+func (s *LocalStore) path(key string) (string, error) {
+	local := filepath.FromSlash(key)
+	if !filepath.IsLocal(local) {
+		return "", errors.New("blob key is not local: "+key, "Invalid blob key", errcode.ErrInvalidInput)
+	}
+	return filepath.Join(s.dir, local), nil
+}
@@ -0,0 +1,18 @@
+package blobinfra
+
+import (
+	"context"
+	"io"
+)
+
+// Store keeps the files the service hands out, such as data export archives.
+//
+// Keys are slash separated paths relative to the root of the store, as in "exports/<id>.zip".
+type Store interface {
+	// Put stores the content of r under key, replacing any existing blob.
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Open opens the blob stored under key. It returns an ErrNotFound error if there is none.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete deletes the blob stored under key. Deleting a missing blob is not an error.
+	Delete(ctx context.Context, key string) error
+}
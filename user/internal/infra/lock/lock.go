@@ -0,0 +1,56 @@
+package lockinfra
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"github.com/redis/go-redis/v9"
+)
+
+// releaseScript only deletes the lock while it is still held by the same owner, so that an expired lock
+// taken over by another replica is left alone.
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// RedisLock is a lock shared by the replicas of a service, which expires after ttl in case its holder
+// dies before releasing it.
+type RedisLock struct {
+	client *redis.Client
+	key    string
+	owner  string
+	ttl    time.Duration
+}
+
+// NewRedisLock creates a new RedisLock on the key, owned by this process.
+func NewRedisLock(client *redis.Client, key string, ttl time.Duration) *RedisLock {
+	return &RedisLock{
+		client: client,
+		key:    key,
+		owner:  uuid.NewString(),
+		ttl:    ttl,
+	}
+}
+
+// TryAcquire takes the lock without waiting, reporting whether it was taken.
+func (l *RedisLock) TryAcquire(ctx context.Context) (bool, error) {
+	ok, err := l.client.SetNX(ctx, l.key, l.owner, l.ttl).Result()
+	if err != nil {
+		return false, errors.New(err.Error(), "Failed to acquire lock", errcode.ErrInternalFailure)
+	}
+	return ok, nil
+}
+
+// Release releases the lock if it is still held by this process.
+func (l *RedisLock) Release(ctx context.Context) error {
+	if err := releaseScript.Run(ctx, l.client, []string{l.key}, l.owner).Err(); err != nil {
+		return errors.New(err.Error(), "Failed to release lock", errcode.ErrInternalFailure)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package httpmiddleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+)
+
+// DenyImpersonation rejects requests made by an admin impersonating the user, which the gateway marks with the ID
+// of the admin in impersonatorHeader, as taken from the act claim of the access token. It guards the routes
+// changing the credentials of the user or deleting their account.
+func DenyImpersonation(impersonatorHeader string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if ctx.GetHeader(impersonatorHeader) != "" {
+			ctx.Error(errors.New("impersonated requests cannot change credentials or delete the account", "Forbidden", errcode.ErrForbidden))
+			ctx.Abort()
+			return
+		}
+		ctx.Next()
+	}
+}
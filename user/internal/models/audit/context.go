@@ -0,0 +1,43 @@
+package auditmodels
+
+import "context"
+
+// Actor is who applies the actions of a request.
+type Actor struct {
+	Type string
+	ID   string
+}
+
+// RequestInfo describes the request in which actions are applied.
+type RequestInfo struct {
+	RequestID string
+	IP        string
+}
+
+type actorKey struct{}
+
+type requestInfoKey struct{}
+
+// WithActor returns a copy of ctx carrying the actor.
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor carried by ctx, which is the system outside requests.
+func ActorFromContext(ctx context.Context) Actor {
+	if actor, ok := ctx.Value(actorKey{}).(Actor); ok {
+		return actor
+	}
+	return Actor{Type: ActorTypeSystem}
+}
+
+// WithRequestInfo returns a copy of ctx carrying the request info.
+func WithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// RequestInfoFromContext returns the request info carried by ctx, which is empty outside requests.
+func RequestInfoFromContext(ctx context.Context) RequestInfo {
+	info, _ := ctx.Value(requestInfoKey{}).(RequestInfo)
+	return info
+}
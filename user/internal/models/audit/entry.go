@@ -0,0 +1,72 @@
+package auditmodels
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent"
+)
+
+// Entry is a recorded audit event, chained to the previous entry by its hash.
+type Entry struct {
+	ID         uuid.UUID      `json:"id"`
+	Seq        int64          `json:"seq"`
+	Service    string         `json:"service"`
+	ActorType  string         `json:"actor_type"`
+	ActorID    string         `json:"actor_id"`
+	Action     string         `json:"action"`
+	TargetType string         `json:"target_type"`
+	TargetID   string         `json:"target_id"`
+	Before     map[string]any `json:"before,omitempty"`
+	After      map[string]any `json:"after,omitempty"`
+	RequestID  string         `json:"request_id"`
+	IP         string         `json:"ip"`
+	OccurredAt time.Time      `json:"occurred_at"`
+	RecordedAt time.Time      `json:"recorded_at"`
+	PrevHash   string         `json:"prev_hash"`
+	Hash       string         `json:"hash"`
+}
+
+func NewEntry(entry *ent.AuditEntry) *Entry {
+	return &Entry{
+		ID:         entry.ID,
+		Seq:        entry.Seq,
+		Service:    entry.Service,
+		ActorType:  entry.ActorType,
+		ActorID:    entry.ActorID,
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		Before:     entry.Before,
+		After:      entry.After,
+		RequestID:  entry.RequestID,
+		IP:         entry.IP,
+		OccurredAt: entry.OccurredAt,
+		RecordedAt: entry.RecordedAt,
+		PrevHash:   entry.PrevHash,
+		Hash:       entry.Hash,
+	}
+}
+
+// EntryFilter selects a page of entries, newest first. Empty and nil fields do not filter.
+type EntryFilter struct {
+	Service    string
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	From       *time.Time // Inclusive, on the time the action occurred
+	To         *time.Time // Exclusive, on the time the action occurred
+	BeforeSeq  *int64     // Selects the entries before this position in the chain, to page through them
+	Limit      int
+}
+
+// ChainVerification is the result of verifying a range of the hash chain.
+type ChainVerification struct {
+	Valid     bool   `json:"valid"`
+	Checked   int    `json:"checked"`
+	FirstSeq  int64  `json:"first_seq,omitempty"`
+	LastSeq   int64  `json:"last_seq,omitempty"`
+	BrokenSeq *int64 `json:"broken_seq,omitempty"` // Position of the first entry failing verification
+	Reason    string `json:"reason,omitempty"`
+}
@@ -0,0 +1,115 @@
+package auditmodels
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Services recording audit events.
+const (
+	ServiceUser = "user"
+)
+
+// Types of the actors applying audited actions.
+const (
+	ActorTypeUser   = "user"    // A user, identified by their ID
+	ActorTypeAPIKey = "api_key" // The admin API key
+	ActorTypeSystem = "system"  // A worker of the service, outside any request
+)
+
+// Types of the targets of the actions audited by the user service.
+const (
+	TargetTypeUser            = "user"
+	TargetTypeRole            = "role"
+	TargetTypeConsentDocument = "consent_document"
+)
+
+// Actions audited by the user service.
+const (
+	ActionUserArchive    = "user.archive"
+	ActionUserRestore    = "user.restore"
+	ActionUserDelete     = "user.delete"
+	ActionUserBlock      = "user.block"
+	ActionUserUnblock    = "user.unblock"
+	ActionUserActivate   = "user.activate"
+	ActionUserDeactivate = "user.deactivate"
+	ActionRoleCreate     = "role.create"
+	ActionRoleUpdate     = "role.update"
+	ActionRoleDelete     = "role.delete"
+	ActionRoleAssign     = "user.role_assign"
+	ActionRoleRevoke     = "user.role_revoke"
+	ActionConsentPublish = "consent_document.publish"
+	ActionConsentRecord  = "user.consent_record"
+	ActionGuardianGrant  = "user.guardian_consent"
+)
+
+// Event is an audit event, published by the services to the audit topic as JSON, from which the user service
+// records it and the SIEM consumes it.
+type Event struct {
+	ID         uuid.UUID      `json:"id"` // Identifies the event, so that redelivered events are recorded once
+	Service    string         `json:"service"`
+	ActorType  string         `json:"actor_type"`
+	ActorID    string         `json:"actor_id"`
+	Action     string         `json:"action"`
+	TargetType string         `json:"target_type"`
+	TargetID   string         `json:"target_id"`
+	Before     map[string]any `json:"before,omitempty"` // Changed fields before the action
+	After      map[string]any `json:"after,omitempty"`  // Changed fields after the action
+	RequestID  string         `json:"request_id"`
+	IP         string         `json:"ip"`
+	OccurredAt time.Time      `json:"occurred_at"`
+}
+
+// Diff returns the fields of the JSON encodings of before and after which differ, as they were before and after.
+//
+// Either of before and after can be nil, in which case all the fields of the other one differ.
+func Diff(before any, after any) (map[string]any, map[string]any, error) {
+	beforeFields, err := fields(before)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterFields, err := fields(after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changedBefore := make(map[string]any)
+	for key, value := range beforeFields {
+		if afterValue, ok := afterFields[key]; !ok || !reflect.DeepEqual(value, afterValue) {
+			changedBefore[key] = value
+		}
+	}
+	changedAfter := make(map[string]any)
+	for key, value := range afterFields {
+		if beforeValue, ok := beforeFields[key]; !ok || !reflect.DeepEqual(value, beforeValue) {
+			changedAfter[key] = value
+		}
+	}
+	return nilIfEmpty(changedBefore), nilIfEmpty(changedAfter), nil
+}
+
+// fields returns the fields of the JSON encoding of v, which is nil for nil values.
+func fields(v any) (map[string]any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func nilIfEmpty(m map[string]any) map[string]any {
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
@@ -0,0 +1,84 @@
+package consentmodels
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/consentdocument"
+)
+
+// Document is a published version of a document which users consent to.
+type Document struct {
+	ID          uuid.UUID            `json:"id"`
+	Kind        consentdocument.Kind `json:"kind"`
+	Version     int                  `json:"version"`
+	Title       string               `json:"title"`
+	ContentURL  string               `json:"content_url"`
+	Mandatory   bool                 `json:"mandatory"`
+	PublishedAt time.Time            `json:"published_at"`
+}
+
+func NewDocument(document *ent.ConsentDocument) *Document {
+	return &Document{
+		ID:          document.ID,
+		Kind:        document.Kind,
+		Version:     document.Version,
+		Title:       document.Title,
+		ContentURL:  document.ContentURL,
+		Mandatory:   document.Mandatory,
+		PublishedAt: document.PublishedAt,
+	}
+}
+
+// Consent is a recorded choice of a user on a document. The latest consent of a user to a document is the one
+// in effect.
+type Consent struct {
+	ID         uuid.UUID            `json:"id"`
+	UserID     uuid.UUID            `json:"user_id"`
+	DocumentID uuid.UUID            `json:"document_id"`
+	Kind       consentdocument.Kind `json:"kind"`
+	Version    int                  `json:"version"`
+	Accepted   bool                 `json:"accepted"`
+	IP         string               `json:"ip"`
+	UserAgent  string               `json:"user_agent"`
+	RecordedAt time.Time            `json:"recorded_at"`
+}
+
+// NewConsent creates a new Consent from an ent UserConsent, whose document must be loaded.
+func NewConsent(consent *ent.UserConsent) *Consent {
+	c := &Consent{
+		ID:         consent.ID,
+		UserID:     consent.UserID,
+		DocumentID: consent.DocumentID,
+		Accepted:   consent.Accepted,
+		IP:         consent.IP,
+		UserAgent:  consent.UserAgent,
+		RecordedAt: consent.RecordedAt,
+	}
+	if document := consent.Edges.Document; document != nil {
+		c.Kind = document.Kind
+		c.Version = document.Version
+	}
+	return c
+}
+
+// Choice is the choice of a user on a document.
+type Choice struct {
+	DocumentID uuid.UUID `json:"document_id" validate:"required"`
+	Accepted   bool      `json:"accepted"`
+}
+
+// Source describes where the choices of a user were made.
+type Source struct {
+	IP        string `json:"ip"`
+	UserAgent string `json:"user_agent"`
+}
+
+// PublishInput is a document to publish, as the next version of its kind.
+type PublishInput struct {
+	Kind       consentdocument.Kind
+	Title      string
+	ContentURL string
+	Mandatory  bool
+}
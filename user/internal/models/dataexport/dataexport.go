@@ -0,0 +1,32 @@
+package dataexportmodels
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/dataexport"
+)
+
+// SecureDataExport is an export of the data of a user, without its download token hash.
+type SecureDataExport struct {
+	ID        uuid.UUID         `json:"id"`
+	UserID    uuid.UUID         `json:"user_id"`
+	Status    dataexport.Status `json:"status"`
+	ObjectKey *string           `json:"-"`
+	ExpiresAt *time.Time        `json:"expires_at,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+func NewSecureDataExport(export *ent.DataExport) *SecureDataExport {
+	return &SecureDataExport{
+		ID:        export.ID,
+		UserID:    export.UserID,
+		Status:    export.Status,
+		ObjectKey: export.ObjectKey,
+		ExpiresAt: export.ExpiresAt,
+		CreatedAt: export.CreatedAt,
+		UpdatedAt: export.UpdatedAt,
+	}
+}
@@ -0,0 +1,34 @@
+package emailchangemodels
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/emailchange"
+)
+
+// SecureEmailChange is a change of the email address of a user, without its undo token hash.
+type SecureEmailChange struct {
+	ID            uuid.UUID          `json:"id"`
+	UserID        uuid.UUID          `json:"user_id"`
+	OldEmail      string             `json:"old_email"`
+	NewEmail      string             `json:"new_email"`
+	Status        emailchange.Status `json:"status"`
+	UndoExpiresAt *time.Time         `json:"undo_expires_at,omitempty"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+}
+
+func NewSecureEmailChange(change *ent.EmailChange) *SecureEmailChange {
+	return &SecureEmailChange{
+		ID:            change.ID,
+		UserID:        change.UserID,
+		OldEmail:      change.OldEmail,
+		NewEmail:      change.NewEmail,
+		Status:        change.Status,
+		UndoExpiresAt: change.UndoExpiresAt,
+		CreatedAt:     change.CreatedAt,
+		UpdatedAt:     change.UpdatedAt,
+	}
+}
@@ -0,0 +1,107 @@
+package orgmodels
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/membership"
+)
+
+// Roles of the members of an organization.
+const (
+	RoleOwner  = membership.RoleOwner  // Manages the organization, including its owners
+	RoleAdmin  = membership.RoleAdmin  // Manages members and invitations
+	RoleMember = membership.RoleMember // Belongs to the organization
+)
+
+// roleRanks orders the roles, so that members can only manage members of lower roles.
+var roleRanks = map[membership.Role]int{
+	RoleMember: 1,
+	RoleAdmin:  2,
+	RoleOwner:  3,
+}
+
+// ParseRole parses an organization role, reporting whether it is valid.
+func ParseRole(role string) (membership.Role, bool) {
+	r := membership.Role(role)
+	_, ok := roleRanks[r]
+	return r, ok
+}
+
+// Outranks reports whether the role ranks above the other role. Owners rank equal to each other but
+// may manage one another.
+func Outranks(role membership.Role, other membership.Role) bool {
+	return role == RoleOwner || roleRanks[role] > roleRanks[other]
+}
+
+// Covers reports whether the role ranks at least as high as the other role.
+func Covers(role membership.Role, other membership.Role) bool {
+	return roleRanks[role] >= roleRanks[other]
+}
+
+type SecureOrganization struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	CreatedBy uuid.UUID `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewSecureOrganization creates a new SecureOrganization from an ent Organization.
+func NewSecureOrganization(org *ent.Organization) *SecureOrganization {
+	return &SecureOrganization{
+		ID:        org.ID,
+		Name:      org.Name,
+		Slug:      org.Slug,
+		CreatedBy: org.CreatedBy,
+		CreatedAt: org.CreatedAt,
+		UpdatedAt: org.UpdatedAt,
+	}
+}
+
+type SecureMembership struct {
+	OrganizationID uuid.UUID           `json:"organization_id"`
+	UserID         uuid.UUID           `json:"user_id"`
+	Role           membership.Role     `json:"role"`
+	CreatedAt      time.Time           `json:"created_at"`
+	Organization   *SecureOrganization `json:"organization,omitempty"`
+}
+
+// NewSecureMembership creates a new SecureMembership from an ent Membership, including its organization if loaded.
+func NewSecureMembership(m *ent.Membership) *SecureMembership {
+	secure := &SecureMembership{
+		OrganizationID: m.OrganizationID,
+		UserID:         m.UserID,
+		Role:           m.Role,
+		CreatedAt:      m.CreatedAt,
+	}
+	if m.Edges.Organization != nil {
+		secure.Organization = NewSecureOrganization(m.Edges.Organization)
+	}
+	return secure
+}
+
+type SecureInvitation struct {
+	ID             uuid.UUID       `json:"id"`
+	OrganizationID uuid.UUID       `json:"organization_id"`
+	Email          string          `json:"email"`
+	Role           membership.Role `json:"role"`
+	InvitedBy      uuid.UUID       `json:"invited_by"`
+	ExpiresAt      time.Time       `json:"expires_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// NewSecureInvitation creates a new SecureInvitation from an ent Invitation.
+func NewSecureInvitation(inv *ent.Invitation) *SecureInvitation {
+	return &SecureInvitation{
+		ID:             inv.ID,
+		OrganizationID: inv.OrganizationID,
+		Email:          inv.Email,
+		Role:           membership.Role(inv.Role),
+		InvitedBy:      inv.InvitedBy,
+		ExpiresAt:      inv.ExpiresAt,
+		CreatedAt:      inv.CreatedAt,
+	}
+}
@@ -0,0 +1,30 @@
+package outboxmodels
+
+import (
+	"time"
+
+	"mandacode.com/accounts/user/ent"
+)
+
+// Message is a Kafka message waiting in the outbox to be published.
+type Message struct {
+	ID          int64             `json:"id"`
+	Topic       string            `json:"topic"`
+	Key         []byte            `json:"key"`
+	Value       []byte            `json:"value"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Attempts    int               `json:"attempts"`
+	NextAttempt time.Time         `json:"next_attempt_at"`
+}
+
+func NewMessage(message *ent.OutboxMessage) *Message {
+	return &Message{
+		ID:          message.ID,
+		Topic:       message.Topic,
+		Key:         message.Key,
+		Value:       message.Value,
+		Headers:     message.Headers,
+		Attempts:    message.Attempts,
+		NextAttempt: message.NextAttemptAt,
+	}
+}
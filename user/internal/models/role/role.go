@@ -0,0 +1,103 @@
+package rolemodels
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent"
+)
+
+// System roles, seeded by migrations.
+const (
+	RoleAdmin   = "admin"
+	RoleSupport = "support"
+	RoleUser    = "user" // Implicitly held by every user, so it is never assigned
+)
+
+// Permissions checked by the services.
+const (
+	PermissionAll              = "*" // Grants every permission
+	PermissionUsersRead        = "users.read"
+	PermissionUsersManage      = "users.manage"
+	PermissionUsersDelete      = "users.delete"
+	PermissionUsersImpersonate = "users.impersonate" // Checked by auth before issuing an impersonation token
+	PermissionRolesRead        = "roles.read"
+	PermissionRolesManage      = "roles.manage"
+	PermissionProfilesRead     = "profiles.read"
+	PermissionProfilesManage   = "profiles.manage"
+	PermissionAuditRead        = "audit.read"
+	PermissionConsentsRead     = "consents.read"
+	PermissionConsentsManage   = "consents.manage"
+)
+
+// Permissions are all the permissions which roles can grant.
+var Permissions = []string{
+	PermissionAll,
+	PermissionUsersRead,
+	PermissionUsersManage,
+	PermissionUsersDelete,
+	PermissionUsersImpersonate,
+	PermissionRolesRead,
+	PermissionRolesManage,
+	PermissionProfilesRead,
+	PermissionProfilesManage,
+	PermissionAuditRead,
+	PermissionConsentsRead,
+	PermissionConsentsManage,
+}
+
+type SecureRole struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Permissions []string  `json:"permissions"`
+	IsSystem    bool      `json:"is_system"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// NewSecureRole creates a new SecureRole from an ent Role.
+func NewSecureRole(role *ent.Role) *SecureRole {
+	return &SecureRole{
+		ID:          role.ID,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		IsSystem:    role.IsSystem,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
+
+// UserRoles are the roles of a user and the permissions they grant.
+type UserRoles struct {
+	UserID      uuid.UUID `json:"user_id"`
+	Roles       []string  `json:"roles"`
+	Permissions []string  `json:"permissions"`
+}
+
+// NewUserRoles merges the roles of a user, including the implicit user role.
+func NewUserRoles(userID uuid.UUID, roles []*SecureRole) *UserRoles {
+	userRoles := &UserRoles{
+		UserID:      userID,
+		Roles:       []string{RoleUser},
+		Permissions: []string{},
+	}
+	for _, role := range roles {
+		if role.Name != RoleUser {
+			userRoles.Roles = append(userRoles.Roles, role.Name)
+		}
+		for _, permission := range role.Permissions {
+			if !slices.Contains(userRoles.Permissions, permission) {
+				userRoles.Permissions = append(userRoles.Permissions, permission)
+			}
+		}
+	}
+	return userRoles
+}
+
+// HasPermission reports whether the roles grant the permission.
+func (r *UserRoles) HasPermission(permission string) bool {
+	return slices.Contains(r.Permissions, PermissionAll) || slices.Contains(r.Permissions, permission)
+}
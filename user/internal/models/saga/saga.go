@@ -0,0 +1,40 @@
+package sagamodels
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/signupsaga"
+)
+
+// SignupSaga records the progress of a signup, which spans the user, auth and profile services.
+type SignupSaga struct {
+	ID          uuid.UUID         `json:"id"`
+	UserID      uuid.UUID         `json:"user_id"`
+	Kind        signupsaga.Kind   `json:"kind"`
+	Step        signupsaga.Step   `json:"step"`
+	Status      signupsaga.Status `json:"status"`
+	Email       *string           `json:"email,omitempty"`
+	Attempts    int               `json:"attempts"`
+	LeaseID     uuid.UUID         `json:"lease_id"`
+	LeasedUntil time.Time         `json:"leased_until"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+func NewSignupSaga(saga *ent.SignupSaga) *SignupSaga {
+	return &SignupSaga{
+		ID:          saga.ID,
+		UserID:      saga.UserID,
+		Kind:        saga.Kind,
+		Step:        saga.Step,
+		Status:      saga.Status,
+		Email:       saga.Email,
+		Attempts:    saga.Attempts,
+		LeaseID:     saga.LeaseID,
+		LeasedUntil: saga.LeasedUntil,
+		CreatedAt:   saga.CreatedAt,
+		UpdatedAt:   saga.UpdatedAt,
+	}
+}
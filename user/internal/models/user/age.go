@@ -0,0 +1,26 @@
+package usermodels
+
+import "time"
+
+// GuardianConsentAge is the age under which the consent of a guardian is required to create an account, as
+// set by PIPA.
+const GuardianConsentAge = 14
+
+// BirthDateLayout is the layout of the birth dates given at signup.
+const BirthDateLayout = "2006-01-02"
+
+// Age describes the age of a user signing up.
+type Age struct {
+	BirthDate     time.Time
+	IsMinor       bool
+	GuardianEmail *string // Set for minors only
+}
+
+// AgeAt returns the age in full years of a person born on birthDate, at now.
+func AgeAt(birthDate time.Time, now time.Time) int {
+	age := now.Year() - birthDate.Year()
+	if now.Month() < birthDate.Month() || (now.Month() == birthDate.Month() && now.Day() < birthDate.Day()) {
+		age--
+	}
+	return age
+}
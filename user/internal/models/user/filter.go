@@ -0,0 +1,13 @@
+package usermodels
+
+import "time"
+
+// UserFilter selects a page of users. Nil fields do not filter.
+type UserFilter struct {
+	IsBlocked     *bool
+	IsArchived    *bool
+	CreatedAfter  *time.Time // Inclusive
+	CreatedBefore *time.Time // Exclusive
+	Limit         int
+	Offset        int
+}
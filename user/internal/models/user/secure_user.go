@@ -5,31 +5,46 @@ import (
 
 	"github.com/google/uuid"
 	"mandacode.com/accounts/user/ent"
+	entuser "mandacode.com/accounts/user/ent/user"
 )
 
 type SecureUser struct {
-	ID          uuid.UUID  `json:"id"`
-	SyncCode    string     `json:"sync_code"`
-	IsActive    bool       `json:"is_active"`
-	IsBlocked   bool       `json:"is_blocked"`
-	IsArchived  bool       `json:"is_archived"`
-	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
-	DeleteAfter *time.Time `json:"delete_after,omitempty"`
+	ID                  uuid.UUID               `json:"id"`
+	SyncCode            string                  `json:"sync_code"`
+	SyncVersion         int64                   `json:"sync_version"`
+	Email               *string                 `json:"email,omitempty"`
+	IsActive            bool                    `json:"is_active"`
+	IsBlocked           bool                    `json:"is_blocked"`
+	IsArchived          bool                    `json:"is_archived"`
+	ArchivedAt          *time.Time              `json:"archived_at,omitempty"`
+	CreatedAt           time.Time               `json:"created_at"`
+	UpdatedAt           time.Time               `json:"updated_at"`
+	DeleteAfter         *time.Time              `json:"delete_after,omitempty"`
+	BirthDate           *time.Time              `json:"birth_date,omitempty"`
+	IsMinor             bool                    `json:"is_minor"`
+	GuardianEmail       *string                 `json:"guardian_email,omitempty"`
+	GuardianConsent     entuser.GuardianConsent `json:"guardian_consent"`
+	GuardianConsentedAt *time.Time              `json:"guardian_consented_at,omitempty"`
 }
 
 // NewSecureUser creates a new SecureUser with the current time for CreatedAt and UpdatedAt.
 func NewSecureUser(user *ent.User) *SecureUser {
 	return &SecureUser{
-		ID:        user.ID,
-		SyncCode:  user.SyncCode,
-		IsActive:  user.IsActive,
-		IsBlocked: user.IsBlocked,
-		IsArchived: user.IsArchived,
-		ArchivedAt:  user.ArchivedAt,
-		CreatedAt:   user.CreatedAt,
-		UpdatedAt:   user.UpdatedAt,
-		DeleteAfter: user.DeleteAfter,
+		ID:                  user.ID,
+		SyncCode:            user.SyncCode,
+		SyncVersion:         user.SyncVersion,
+		Email:               user.Email,
+		IsActive:            user.IsActive,
+		IsBlocked:           user.IsBlocked,
+		IsArchived:          user.IsArchived,
+		ArchivedAt:          user.ArchivedAt,
+		CreatedAt:           user.CreatedAt,
+		UpdatedAt:           user.UpdatedAt,
+		DeleteAfter:         user.DeleteAfter,
+		BirthDate:           user.BirthDate,
+		IsMinor:             user.IsMinor,
+		GuardianEmail:       user.GuardianEmail,
+		GuardianConsent:     user.GuardianConsent,
+		GuardianConsentedAt: user.GuardianConsentedAt,
 	}
 }
@@ -0,0 +1,9 @@
+package usermodels
+
+import rolemodels "mandacode.com/accounts/user/internal/models/role"
+
+// UserStatus is the status and roles of a user, as of their sync version.
+type UserStatus struct {
+	User  *SecureUser
+	Roles *rolemodels.UserRoles
+}
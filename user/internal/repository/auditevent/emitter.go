@@ -0,0 +1,68 @@
+package auditeventrepo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	auditmodels "mandacode.com/accounts/user/internal/models/audit"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+)
+
+// AuditEventEmitter writes the audit events of the user service to the outbox, from which they are published to
+// the audit topic.
+//
+// The events are written in the transaction carried by the context, if any, so that an action is audited if and
+// only if it is committed.
+type AuditEventEmitter struct {
+	outbox *dbrepo.OutboxRepository
+	topic  string
+}
+
+// NewAuditEventEmitter creates a new AuditEventEmitter publishing to the topic through the outbox.
+func NewAuditEventEmitter(outbox *dbrepo.OutboxRepository, topic string) *AuditEventEmitter {
+	return &AuditEventEmitter{
+		outbox: outbox,
+		topic:  topic,
+	}
+}
+
+// Emit emits the audit event of an action applied to a target, recording the fields which it changed.
+//
+// before and after are the states of the target before and after the action, nil if it did not exist. The actor
+// and request are read from ctx.
+func (e *AuditEventEmitter) Emit(ctx context.Context, action string, targetType string, targetID string, before any, after any) error {
+	changedBefore, changedAfter, err := auditmodels.Diff(before, after)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to diff audited target", errcode.ErrInternalFailure)
+	}
+
+	actor := auditmodels.ActorFromContext(ctx)
+	request := auditmodels.RequestInfoFromContext(ctx)
+	event := auditmodels.Event{
+		ID:         uuid.New(),
+		Service:    auditmodels.ServiceUser,
+		ActorType:  actor.Type,
+		ActorID:    actor.ID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Before:     changedBefore,
+		After:      changedAfter,
+		RequestID:  request.RequestID,
+		IP:         request.IP,
+		OccurredAt: time.Now().UTC(),
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to marshal audit event", errcode.ErrInternalFailure)
+	}
+
+	if err := e.outbox.Enqueue(ctx, e.topic, []byte(targetID), data, nil); err != nil {
+		return errors.Join(err, "failed to enqueue audit event")
+	}
+	return nil
+}
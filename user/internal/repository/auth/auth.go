@@ -2,26 +2,49 @@ package authrepo
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/google/uuid"
 	authv1 "github.com/mandacode-com/accounts-proto/go/auth/v1"
 	"github.com/mandacode-com/golib/errors"
 	"github.com/mandacode-com/golib/errors/errcode"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	authrepodto "mandacode.com/accounts/user/internal/repository/auth/dto"
 )
 
 type AuthRepository struct {
 	localUserClient authv1.LocalUserServiceClient
 	oauthUserClient authv1.OAuthUserServiceClient
+	exportClient    authv1.DataExportServiceClient
 }
 
 // NewAuthRepository creates a new instance of AuthRepository with the provided clients.
-func NewAuthRepository(localUserClient authv1.LocalUserServiceClient, oauthUserClient authv1.OAuthUserServiceClient) *AuthRepository {
+func NewAuthRepository(localUserClient authv1.LocalUserServiceClient, oauthUserClient authv1.OAuthUserServiceClient, exportClient authv1.DataExportServiceClient) *AuthRepository {
 	return &AuthRepository{
 		localUserClient: localUserClient,
 		oauthUserClient: oauthUserClient,
+		exportClient:    exportClient,
 	}
 }
 
+// ExportUserData returns everything the auth service holds about the user, as a JSON document without secrets.
+func (a *AuthRepository) ExportUserData(ctx context.Context, userID uuid.UUID) (json.RawMessage, error) {
+	res, err := a.exportClient.ExportUserData(ctx, &authv1.ExportUserDataRequest{
+		UserId: userID.String(),
+	})
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to export user data from auth service", errcode.ErrInternalFailure)
+	}
+	if err := res.ValidateAll(); err != nil {
+		return nil, errors.Upgrade(err, "Invalid response from auth service", errcode.ErrInternalFailure)
+	}
+	if !json.Valid(res.Data) {
+		return nil, errors.New("auth service returned invalid JSON", "Invalid response from auth service", errcode.ErrInternalFailure)
+	}
+	return res.Data, nil
+}
+
 func (a *AuthRepository) CreateLocalUser(ctx context.Context, req *authrepodto.CreateLocalUserRequest) (*authrepodto.CreateLocalUserResponse, error) {
 	protoRes, err := a.localUserClient.CreateLocalUser(ctx, req.ToProto())
 	if err != nil {
@@ -89,6 +112,9 @@ func (a *AuthRepository) DeleteOAuthUser(ctx context.Context, req *authrepodto.D
 func (a *AuthRepository) UpdateLocalUserEmail(ctx context.Context, req *authrepodto.UpdateLocalUserEmailRequest) (*authrepodto.UpdateLocalUserEmailResponse, error) {
 	protoRes, err := a.localUserClient.UpdateLocalUserEmail(ctx, req.ToProto())
 	if err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return nil, errors.New(err.Error(), "Email Already In Use", errcode.ErrConflict)
+		}
 		return nil, errors.Upgrade(err, "Failed to update local user email", errcode.ErrInternalFailure)
 	}
 	if err := protoRes.ValidateAll(); err != nil {
@@ -102,6 +128,18 @@ func (a *AuthRepository) UpdateLocalUserEmail(ctx context.Context, req *authrepo
 	return res, nil
 }
 
+// IsEmailAvailable reports whether no local user of the auth service has the email address.
+func (a *AuthRepository) IsEmailAvailable(ctx context.Context, email string) (bool, error) {
+	protoRes, err := a.localUserClient.IsEmailAvailable(ctx, &authv1.IsEmailAvailableRequest{Email: email})
+	if err != nil {
+		return false, errors.Upgrade(err, "Failed to check email availability", errcode.ErrInternalFailure)
+	}
+	if err := protoRes.ValidateAll(); err != nil {
+		return false, errors.Upgrade(err, "Invalid response from local user service", errcode.ErrInternalFailure)
+	}
+	return protoRes.Available, nil
+}
+
 func (a *AuthRepository) UpdateLocalUserEmailVerification(ctx context.Context, req *authrepodto.UpdateEmailVerificationRequest) (*authrepodto.UpdateEmailVerificationResponse, error) {
 	protoRes, err := a.localUserClient.UpdateEmailVerification(ctx, req.ToProto())
 	if err != nil {
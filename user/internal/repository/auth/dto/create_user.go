@@ -14,6 +14,7 @@ type CreateLocalUserRequest struct {
 	UserID   uuid.UUID `json:"user_id"`
 	Email    string    `json:"email"`
 	Password string    `json:"password"`
+	IsActive *bool     `json:"is_active,omitempty"` // Status of the user, which the auth service holds to until the user events arrive
 }
 
 func (r *CreateLocalUserRequest) ToProto() *authv1.CreateLocalUserRequest {
@@ -24,6 +25,7 @@ func (r *CreateLocalUserRequest) ToProto() *authv1.CreateLocalUserRequest {
 		UserId:   r.UserID.String(),
 		Email:    r.Email,
 		Password: r.Password,
+		IsActive: r.IsActive,
 	}
 }
 
@@ -51,6 +53,7 @@ type CreateOAuthUserRequest struct {
 	Provider    provider.ProviderType `json:"provider"`
 	AccessToken *string               `json:"access_token"`
 	Code        *string               `json:"code"`
+	IsActive    *bool                 `json:"is_active,omitempty"` // As for CreateLocalUserRequest
 }
 
 func (r *CreateOAuthUserRequest) ToProto() *authv1.CreateOAuthUserRequest {
@@ -62,6 +65,7 @@ func (r *CreateOAuthUserRequest) ToProto() *authv1.CreateOAuthUserRequest {
 		Provider:    r.Provider.ToProto(),
 		AccessToken: r.AccessToken,
 		Code:        r.Code,
+		IsActive:    r.IsActive,
 	}
 }
 
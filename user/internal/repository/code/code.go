@@ -68,7 +68,7 @@ func (l *CodeManager) ValidateCode(ctx context.Context, userID uuid.UUID, code s
 	}
 
 	// Delete the code after successful validation
-	err = l.codeStore.Del(ctx, code).Err()
+	err = l.codeStore.Del(ctx, key).Err()
 	if err != nil {
 		return false, errors.New(err.Error(), "Failed to delete login code from store", errcode.ErrInternalFailure)
 	}
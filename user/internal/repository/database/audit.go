@@ -0,0 +1,155 @@
+package dbrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/auditentry"
+	"mandacode.com/accounts/user/ent/predicate"
+	auditmodels "mandacode.com/accounts/user/internal/models/audit"
+)
+
+// AuditRepository appends to and reads the audit trail. The table only accepts inserts.
+type AuditRepository struct {
+	client *ent.Client
+}
+
+// NewAuditRepository creates a new AuditRepository with the provided database client.
+func NewAuditRepository(client *ent.Client) *AuditRepository {
+	return &AuditRepository{
+		client: client,
+	}
+}
+
+// AuditEntryExists reports whether the entry of the audit event is recorded.
+func (r *AuditRepository) AuditEntryExists(ctx context.Context, id uuid.UUID) (bool, error) {
+	exists, err := r.client.AuditEntry.Query().
+		Where(auditentry.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		return false, errors.New(err.Error(), "Failed to check AuditEntry", errcode.ErrInternalFailure)
+	}
+	return exists, nil
+}
+
+// GetLastAuditEntry retrieves the entry at the end of the chain.
+func (r *AuditRepository) GetLastAuditEntry(ctx context.Context) (*auditmodels.Entry, error) {
+	entry, err := r.client.AuditEntry.Query().
+		Order(ent.Desc(auditentry.FieldSeq)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("AuditEntry not found", "NotFound", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to get last AuditEntry", errcode.ErrInternalFailure)
+	}
+	return auditmodels.NewEntry(entry), nil
+}
+
+// GetAuditEntryBySeq retrieves the entry at a position of the chain.
+func (r *AuditRepository) GetAuditEntryBySeq(ctx context.Context, seq int64) (*auditmodels.Entry, error) {
+	entry, err := r.client.AuditEntry.Query().
+		Where(auditentry.Seq(seq)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("AuditEntry not found", "NotFound", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to get AuditEntry", errcode.ErrInternalFailure)
+	}
+	return auditmodels.NewEntry(entry), nil
+}
+
+// CreateAuditEntry appends the entry to the chain.
+//
+// Fails with errcode.ErrConflict if an entry with the same ID or position is already recorded.
+func (r *AuditRepository) CreateAuditEntry(ctx context.Context, entry *auditmodels.Entry) error {
+	err := r.client.AuditEntry.Create().
+		SetID(entry.ID).
+		SetSeq(entry.Seq).
+		SetService(entry.Service).
+		SetActorType(entry.ActorType).
+		SetActorID(entry.ActorID).
+		SetAction(entry.Action).
+		SetTargetType(entry.TargetType).
+		SetTargetID(entry.TargetID).
+		SetBefore(entry.Before).
+		SetAfter(entry.After).
+		SetRequestID(entry.RequestID).
+		SetIP(entry.IP).
+		SetOccurredAt(entry.OccurredAt).
+		SetRecordedAt(entry.RecordedAt).
+		SetPrevHash(entry.PrevHash).
+		SetHash(entry.Hash).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return errors.New("AuditEntry already exists", "Conflict", errcode.ErrConflict)
+		}
+		return errors.New(err.Error(), "Failed to create AuditEntry", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// ListAuditEntries retrieves the entries matching the filter, newest first.
+func (r *AuditRepository) ListAuditEntries(ctx context.Context, filter auditmodels.EntryFilter) ([]*auditmodels.Entry, error) {
+	var predicates []predicate.AuditEntry
+	if filter.Service != "" {
+		predicates = append(predicates, auditentry.Service(filter.Service))
+	}
+	if filter.ActorID != "" {
+		predicates = append(predicates, auditentry.ActorID(filter.ActorID))
+	}
+	if filter.Action != "" {
+		predicates = append(predicates, auditentry.Action(filter.Action))
+	}
+	if filter.TargetType != "" {
+		predicates = append(predicates, auditentry.TargetType(filter.TargetType))
+	}
+	if filter.TargetID != "" {
+		predicates = append(predicates, auditentry.TargetID(filter.TargetID))
+	}
+	if filter.From != nil {
+		predicates = append(predicates, auditentry.OccurredAtGTE(*filter.From))
+	}
+	if filter.To != nil {
+		predicates = append(predicates, auditentry.OccurredAtLT(*filter.To))
+	}
+	if filter.BeforeSeq != nil {
+		predicates = append(predicates, auditentry.SeqLT(*filter.BeforeSeq))
+	}
+
+	entries, err := r.client.AuditEntry.Query().
+		Where(predicates...).
+		Order(ent.Desc(auditentry.FieldSeq)).
+		Limit(filter.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list AuditEntries", errcode.ErrInternalFailure)
+	}
+	return newEntries(entries), nil
+}
+
+// ListAuditEntriesFrom retrieves up to limit entries of the chain from the position fromSeq, in chain order.
+func (r *AuditRepository) ListAuditEntriesFrom(ctx context.Context, fromSeq int64, limit int) ([]*auditmodels.Entry, error) {
+	entries, err := r.client.AuditEntry.Query().
+		Where(auditentry.SeqGTE(fromSeq)).
+		Order(ent.Asc(auditentry.FieldSeq)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list AuditEntries", errcode.ErrInternalFailure)
+	}
+	return newEntries(entries), nil
+}
+
+func newEntries(entries []*ent.AuditEntry) []*auditmodels.Entry {
+	converted := make([]*auditmodels.Entry, 0, len(entries))
+	for _, entry := range entries {
+		converted = append(converted, auditmodels.NewEntry(entry))
+	}
+	return converted
+}
@@ -0,0 +1,136 @@
+package dbrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/consentdocument"
+	"mandacode.com/accounts/user/ent/userconsent"
+	consentmodels "mandacode.com/accounts/user/internal/models/consent"
+)
+
+type ConsentRepository struct {
+	client *ent.Client
+}
+
+// NewConsentRepository creates a new ConsentRepository with the provided database client.
+func NewConsentRepository(client *ent.Client) *ConsentRepository {
+	return &ConsentRepository{
+		client: client,
+	}
+}
+
+func toDocuments(documents []*ent.ConsentDocument) []*consentmodels.Document {
+	result := make([]*consentmodels.Document, 0, len(documents))
+	for _, d := range documents {
+		result = append(result, consentmodels.NewDocument(d))
+	}
+	return result
+}
+
+// CreateDocument publishes a document as the given version of its kind.
+//
+// Returns an ErrConflict error if the version of the kind is already published.
+func (r *ConsentRepository) CreateDocument(ctx context.Context, input consentmodels.PublishInput, version int) (*consentmodels.Document, error) {
+	document, err := clientFromContext(ctx, r.client).ConsentDocument.Create().
+		SetKind(input.Kind).
+		SetVersion(version).
+		SetTitle(input.Title).
+		SetContentURL(input.ContentURL).
+		SetMandatory(input.Mandatory).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("ConsentDocument already exists", "Conflict", errcode.ErrConflict)
+		}
+		return nil, errors.New(err.Error(), "Failed to create ConsentDocument", errcode.ErrInternalFailure)
+	}
+	return consentmodels.NewDocument(document), nil
+}
+
+// GetLatestVersion retrieves the latest published version of a kind, which is 0 if none is published.
+func (r *ConsentRepository) GetLatestVersion(ctx context.Context, kind consentdocument.Kind) (int, error) {
+	document, err := clientFromContext(ctx, r.client).ConsentDocument.Query().
+		Where(consentdocument.KindEQ(kind)).
+		Order(ent.Desc(consentdocument.FieldVersion)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, errors.New(err.Error(), "Failed to get latest ConsentDocument version", errcode.ErrInternalFailure)
+	}
+	return document.Version, nil
+}
+
+// ListDocuments retrieves the published documents, of every kind if kind is nil, newest version first.
+func (r *ConsentRepository) ListDocuments(ctx context.Context, kind *consentdocument.Kind) ([]*consentmodels.Document, error) {
+	query := clientFromContext(ctx, r.client).ConsentDocument.Query()
+	if kind != nil {
+		query = query.Where(consentdocument.KindEQ(*kind))
+	}
+	documents, err := query.
+		Order(ent.Asc(consentdocument.FieldKind), ent.Desc(consentdocument.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list ConsentDocuments", errcode.ErrInternalFailure)
+	}
+	return toDocuments(documents), nil
+}
+
+// ListCurrentDocuments retrieves the latest published version of each kind.
+func (r *ConsentRepository) ListCurrentDocuments(ctx context.Context) ([]*consentmodels.Document, error) {
+	documents, err := r.ListDocuments(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	current := make([]*consentmodels.Document, 0)
+	for _, document := range documents {
+		// Documents are ordered by kind then newest version first, so the first of each kind is the current one
+		if len(current) == 0 || current[len(current)-1].Kind != document.Kind {
+			current = append(current, document)
+		}
+	}
+	return current, nil
+}
+
+// CreateConsents records the choices of a user on documents.
+func (r *ConsentRepository) CreateConsents(ctx context.Context, userID uuid.UUID, choices []consentmodels.Choice, source consentmodels.Source) error {
+	client := clientFromContext(ctx, r.client)
+	builders := make([]*ent.UserConsentCreate, 0, len(choices))
+	for _, choice := range choices {
+		builders = append(builders, client.UserConsent.Create().
+			SetUserID(userID).
+			SetDocumentID(choice.DocumentID).
+			SetAccepted(choice.Accepted).
+			SetIP(source.IP).
+			SetUserAgent(source.UserAgent))
+	}
+	if err := client.UserConsent.CreateBulk(builders...).Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return errors.New("ConsentDocument not found", "NotFound", errcode.ErrNotFound)
+		}
+		return errors.New(err.Error(), "Failed to create UserConsents", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// ListConsentsByUserID retrieves the consent history of a user, oldest first.
+func (r *ConsentRepository) ListConsentsByUserID(ctx context.Context, userID uuid.UUID) ([]*consentmodels.Consent, error) {
+	consents, err := clientFromContext(ctx, r.client).UserConsent.Query().
+		Where(userconsent.UserID(userID)).
+		WithDocument().
+		Order(ent.Asc(userconsent.FieldRecordedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list UserConsents", errcode.ErrInternalFailure)
+	}
+	result := make([]*consentmodels.Consent, 0, len(consents))
+	for _, c := range consents {
+		result = append(result, consentmodels.NewConsent(c))
+	}
+	return result, nil
+}
@@ -0,0 +1,188 @@
+package dbrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/dataexport"
+	dataexportmodels "mandacode.com/accounts/user/internal/models/dataexport"
+)
+
+type DataExportRepository struct {
+	client *ent.Client
+}
+
+// NewDataExportRepository creates a new DataExportRepository with the provided database client.
+func NewDataExportRepository(client *ent.Client) *DataExportRepository {
+	return &DataExportRepository{
+		client: client,
+	}
+}
+
+// CreateDataExport records a pending export of the data of a user.
+func (r *DataExportRepository) CreateDataExport(ctx context.Context, userID uuid.UUID) (*dataexportmodels.SecureDataExport, error) {
+	export, err := r.client.DataExport.Create().
+		SetUserID(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to create DataExport", errcode.ErrInternalFailure)
+	}
+	return dataexportmodels.NewSecureDataExport(export), nil
+}
+
+// HasPendingDataExport reports whether the user has an export which is not built yet.
+func (r *DataExportRepository) HasPendingDataExport(ctx context.Context, userID uuid.UUID) (bool, error) {
+	exists, err := r.client.DataExport.Query().
+		Where(
+			dataexport.UserID(userID),
+			dataexport.StatusEQ(dataexport.StatusPending),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, errors.New(err.Error(), "Failed to check pending DataExports", errcode.ErrInternalFailure)
+	}
+	return exists, nil
+}
+
+// ListDataExportsByUserID retrieves the exports of a user, newest first.
+func (r *DataExportRepository) ListDataExportsByUserID(ctx context.Context, userID uuid.UUID) ([]*dataexportmodels.SecureDataExport, error) {
+	exports, err := r.client.DataExport.Query().
+		Where(dataexport.UserID(userID)).
+		Order(ent.Desc(dataexport.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list DataExports", errcode.ErrInternalFailure)
+	}
+	secureExports := make([]*dataexportmodels.SecureDataExport, 0, len(exports))
+	for _, export := range exports {
+		secureExports = append(secureExports, dataexportmodels.NewSecureDataExport(export))
+	}
+	return secureExports, nil
+}
+
+// ListPendingDataExports retrieves up to limit exports which are not built yet, oldest first.
+func (r *DataExportRepository) ListPendingDataExports(ctx context.Context, limit int) ([]*dataexportmodels.SecureDataExport, error) {
+	exports, err := r.client.DataExport.Query().
+		Where(dataexport.StatusEQ(dataexport.StatusPending)).
+		Order(ent.Asc(dataexport.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list pending DataExports", errcode.ErrInternalFailure)
+	}
+	pending := make([]*dataexportmodels.SecureDataExport, 0, len(exports))
+	for _, export := range exports {
+		pending = append(pending, dataexportmodels.NewSecureDataExport(export))
+	}
+	return pending, nil
+}
+
+// CompleteDataExport records that the archive of a pending export was stored under objectKey, and can be
+// downloaded with the token of the hash until expiresAt. It runs in the transaction carried by ctx if any.
+func (r *DataExportRepository) CompleteDataExport(ctx context.Context, id uuid.UUID, objectKey string, downloadTokenHash string, expiresAt time.Time) error {
+	err := clientFromContext(ctx, r.client).DataExport.UpdateOneID(id).
+		Where(dataexport.StatusEQ(dataexport.StatusPending)).
+		SetStatus(dataexport.StatusCompleted).
+		SetObjectKey(objectKey).
+		SetDownloadTokenHash(downloadTokenHash).
+		SetExpiresAt(expiresAt).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("Pending DataExport not found", "NotFound", errcode.ErrNotFound)
+		}
+		return errors.New(err.Error(), "Failed to complete DataExport", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// RecordFailure records a failed attempt to build a pending export. The export is given up once it failed
+// maxAttempts times.
+//
+// Returns:
+//   - Whether the export was given up.
+//   - An error if the failure could not be recorded.
+func (r *DataExportRepository) RecordFailure(ctx context.Context, id uuid.UUID, cause string, maxAttempts int) (bool, error) {
+	export, err := r.client.DataExport.UpdateOneID(id).
+		Where(dataexport.StatusEQ(dataexport.StatusPending)).
+		AddAttempts(1).
+		SetLastError(cause).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, errors.New("Pending DataExport not found", "NotFound", errcode.ErrNotFound)
+		}
+		return false, errors.New(err.Error(), "Failed to update DataExport", errcode.ErrInternalFailure)
+	}
+	if export.Attempts < maxAttempts {
+		return false, nil
+	}
+	err = r.client.DataExport.UpdateOneID(id).
+		SetStatus(dataexport.StatusFailed).
+		Exec(ctx)
+	if err != nil {
+		return false, errors.New(err.Error(), "Failed to update DataExport status", errcode.ErrInternalFailure)
+	}
+	return true, nil
+}
+
+// GetDownloadableDataExport retrieves the completed export whose download link carries the token hash, if it
+// has not expired.
+func (r *DataExportRepository) GetDownloadableDataExport(ctx context.Context, downloadTokenHash string) (*dataexportmodels.SecureDataExport, error) {
+	export, err := r.client.DataExport.Query().
+		Where(
+			dataexport.DownloadTokenHash(downloadTokenHash),
+			dataexport.StatusEQ(dataexport.StatusCompleted),
+			dataexport.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("DataExport not found or expired", "NotFound", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to get DataExport", errcode.ErrInternalFailure)
+	}
+	return dataexportmodels.NewSecureDataExport(export), nil
+}
+
+// ListExpiredDataExports retrieves up to limit completed exports which expired before the given time.
+func (r *DataExportRepository) ListExpiredDataExports(ctx context.Context, before time.Time, limit int) ([]*dataexportmodels.SecureDataExport, error) {
+	exports, err := r.client.DataExport.Query().
+		Where(
+			dataexport.StatusEQ(dataexport.StatusCompleted),
+			dataexport.ExpiresAtLTE(before),
+		).
+		Order(ent.Asc(dataexport.FieldExpiresAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list expired DataExports", errcode.ErrInternalFailure)
+	}
+	expired := make([]*dataexportmodels.SecureDataExport, 0, len(exports))
+	for _, export := range exports {
+		expired = append(expired, dataexportmodels.NewSecureDataExport(export))
+	}
+	return expired, nil
+}
+
+// ExpireDataExport records that the archive of a completed export was deleted, so that its download link can
+// no longer be used.
+func (r *DataExportRepository) ExpireDataExport(ctx context.Context, id uuid.UUID) error {
+	err := r.client.DataExport.UpdateOneID(id).
+		Where(dataexport.StatusEQ(dataexport.StatusCompleted)).
+		SetStatus(dataexport.StatusExpired).
+		ClearObjectKey().
+		ClearDownloadTokenHash().
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("Completed DataExport not found", "NotFound", errcode.ErrNotFound)
+		}
+		return errors.New(err.Error(), "Failed to expire DataExport", errcode.ErrInternalFailure)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package dbrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/emailchange"
+	emailchangemodels "mandacode.com/accounts/user/internal/models/emailchange"
+)
+
+type EmailChangeRepository struct {
+	client *ent.Client
+}
+
+// NewEmailChangeRepository creates a new EmailChangeRepository with the provided database client.
+func NewEmailChangeRepository(client *ent.Client) *EmailChangeRepository {
+	return &EmailChangeRepository{
+		client: client,
+	}
+}
+
+// CreateEmailChange records a pending change of the email address of a user.
+func (r *EmailChangeRepository) CreateEmailChange(ctx context.Context, userID uuid.UUID, oldEmail string, newEmail string) (*emailchangemodels.SecureEmailChange, error) {
+	change, err := clientFromContext(ctx, r.client).EmailChange.Create().
+		SetUserID(userID).
+		SetOldEmail(oldEmail).
+		SetNewEmail(newEmail).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("User not found", "NotFound", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to create EmailChange", errcode.ErrInternalFailure)
+	}
+	return emailchangemodels.NewSecureEmailChange(change), nil
+}
+
+// GetPendingEmailChange retrieves the latest pending change of the email address of a user to newEmail.
+func (r *EmailChangeRepository) GetPendingEmailChange(ctx context.Context, userID uuid.UUID, newEmail string) (*emailchangemodels.SecureEmailChange, error) {
+	change, err := clientFromContext(ctx, r.client).EmailChange.Query().
+		Where(
+			emailchange.UserID(userID),
+			emailchange.NewEmail(newEmail),
+			emailchange.StatusEQ(emailchange.StatusPending),
+		).
+		Order(ent.Desc(emailchange.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("Pending EmailChange not found", "NotFound", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to get EmailChange", errcode.ErrInternalFailure)
+	}
+	return emailchangemodels.NewSecureEmailChange(change), nil
+}
+
+// GetUndoableEmailChange retrieves the confirmed change whose undo link carries the token hash, if it can
+// still be undone.
+func (r *EmailChangeRepository) GetUndoableEmailChange(ctx context.Context, undoTokenHash string) (*emailchangemodels.SecureEmailChange, error) {
+	change, err := clientFromContext(ctx, r.client).EmailChange.Query().
+		Where(
+			emailchange.UndoTokenHash(undoTokenHash),
+			emailchange.StatusEQ(emailchange.StatusConfirmed),
+			emailchange.UndoExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("EmailChange not found or expired", "NotFound", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to get EmailChange", errcode.ErrInternalFailure)
+	}
+	return emailchangemodels.NewSecureEmailChange(change), nil
+}
+
+// ConfirmEmailChange records that a pending change was applied, along with the hash of the token undoing it
+// until undoExpiresAt.
+//
+// Other pending changes of the user are dropped, as their address was not the one confirmed.
+func (r *EmailChangeRepository) ConfirmEmailChange(ctx context.Context, id uuid.UUID, undoTokenHash string, undoExpiresAt time.Time) (*emailchangemodels.SecureEmailChange, error) {
+	client := clientFromContext(ctx, r.client)
+	change, err := client.EmailChange.UpdateOneID(id).
+		Where(emailchange.StatusEQ(emailchange.StatusPending)).
+		SetStatus(emailchange.StatusConfirmed).
+		SetUndoTokenHash(undoTokenHash).
+		SetUndoExpiresAt(undoExpiresAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// Confirmed concurrently
+			return nil, errors.New("Pending EmailChange not found", "NotFound", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to confirm EmailChange", errcode.ErrInternalFailure)
+	}
+	_, err = client.EmailChange.Delete().
+		Where(
+			emailchange.UserID(change.UserID),
+			emailchange.StatusEQ(emailchange.StatusPending),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to delete pending EmailChanges", errcode.ErrInternalFailure)
+	}
+	return emailchangemodels.NewSecureEmailChange(change), nil
+}
+
+// UndoEmailChange records that a confirmed change was undone, so that its undo link cannot be used again.
+func (r *EmailChangeRepository) UndoEmailChange(ctx context.Context, id uuid.UUID) error {
+	err := clientFromContext(ctx, r.client).EmailChange.UpdateOneID(id).
+		Where(emailchange.StatusEQ(emailchange.StatusConfirmed)).
+		SetStatus(emailchange.StatusUndone).
+		ClearUndoTokenHash().
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// Undone concurrently
+			return errors.New("Confirmed EmailChange not found", "NotFound", errcode.ErrNotFound)
+		}
+		return errors.New(err.Error(), "Failed to undo EmailChange", errcode.ErrInternalFailure)
+	}
+	return nil
+}
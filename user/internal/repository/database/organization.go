@@ -0,0 +1,336 @@
+package dbrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/invitation"
+	"mandacode.com/accounts/user/ent/membership"
+	orgmodels "mandacode.com/accounts/user/internal/models/organization"
+)
+
+type OrganizationRepository struct {
+	client *ent.Client
+}
+
+// NewOrganizationRepository creates a new OrganizationRepository with the provided database client.
+func NewOrganizationRepository(client *ent.Client) *OrganizationRepository {
+	return &OrganizationRepository{
+		client: client,
+	}
+}
+
+// CreateOrganization creates an organization owned by its creator.
+func (r *OrganizationRepository) CreateOrganization(ctx context.Context, name string, slug string, createdBy uuid.UUID) (*orgmodels.SecureOrganization, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to start transaction", errcode.ErrInternalFailure)
+	}
+	defer tx.Rollback()
+
+	org, err := tx.Organization.Create().
+		SetName(name).
+		SetSlug(slug).
+		SetCreatedBy(createdBy).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("Organization slug already taken", "Conflict", errcode.ErrConflict)
+		}
+		return nil, errors.New(err.Error(), "Failed to create Organization", errcode.ErrInternalFailure)
+	}
+	_, err = tx.Membership.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(createdBy).
+		SetRole(orgmodels.RoleOwner).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to create Membership", errcode.ErrInternalFailure)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.New(err.Error(), "Failed to commit transaction", errcode.ErrInternalFailure)
+	}
+	return orgmodels.NewSecureOrganization(org), nil
+}
+
+// GetOrganization retrieves an organization by its ID.
+func (r *OrganizationRepository) GetOrganization(ctx context.Context, id uuid.UUID) (*orgmodels.SecureOrganization, error) {
+	org, err := r.client.Organization.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("Organization not found", "NotFound", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to get Organization by ID", errcode.ErrInternalFailure)
+	}
+	return orgmodels.NewSecureOrganization(org), nil
+}
+
+// DeleteOrganization deletes an organization, along with its memberships and invitations.
+func (r *OrganizationRepository) DeleteOrganization(ctx context.Context, id uuid.UUID) error {
+	if err := r.client.Organization.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("Organization not found", "NotFound", errcode.ErrNotFound)
+		}
+		return errors.New(err.Error(), "Failed to delete Organization", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// ListUserMemberships retrieves the memberships of a user, along with their organizations.
+func (r *OrganizationRepository) ListUserMemberships(ctx context.Context, userID uuid.UUID) ([]*orgmodels.SecureMembership, error) {
+	memberships, err := r.client.Membership.Query().
+		Where(membership.UserID(userID)).
+		WithOrganization().
+		Order(ent.Asc(membership.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list Memberships by UserID", errcode.ErrInternalFailure)
+	}
+	return toSecureMemberships(memberships), nil
+}
+
+// ListMembers retrieves the memberships of an organization.
+func (r *OrganizationRepository) ListMembers(ctx context.Context, orgID uuid.UUID) ([]*orgmodels.SecureMembership, error) {
+	memberships, err := r.client.Membership.Query().
+		Where(membership.OrganizationID(orgID)).
+		Order(ent.Asc(membership.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list Memberships by OrganizationID", errcode.ErrInternalFailure)
+	}
+	return toSecureMemberships(memberships), nil
+}
+
+// GetMembership retrieves the membership of a user in an organization.
+func (r *OrganizationRepository) GetMembership(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) (*orgmodels.SecureMembership, error) {
+	m, err := r.client.Membership.Query().
+		Where(
+			membership.OrganizationID(orgID),
+			membership.UserID(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("Membership not found", "NotFound", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to get Membership", errcode.ErrInternalFailure)
+	}
+	return orgmodels.NewSecureMembership(m), nil
+}
+
+// CountOwners counts the owners of an organization.
+func (r *OrganizationRepository) CountOwners(ctx context.Context, orgID uuid.UUID) (int, error) {
+	count, err := r.client.Membership.Query().
+		Where(
+			membership.OrganizationID(orgID),
+			membership.RoleEQ(orgmodels.RoleOwner),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, errors.New(err.Error(), "Failed to count owners", errcode.ErrInternalFailure)
+	}
+	return count, nil
+}
+
+// UpdateMemberRole changes the role of a member.
+func (r *OrganizationRepository) UpdateMemberRole(ctx context.Context, orgID uuid.UUID, userID uuid.UUID, role membership.Role) (*orgmodels.SecureMembership, error) {
+	updated, err := r.client.Membership.Update().
+		Where(
+			membership.OrganizationID(orgID),
+			membership.UserID(userID),
+		).
+		SetRole(role).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to update Membership", errcode.ErrInternalFailure)
+	}
+	if updated == 0 {
+		return nil, errors.New("Membership not found", "NotFound", errcode.ErrNotFound)
+	}
+	return r.GetMembership(ctx, orgID, userID)
+}
+
+// RemoveMember removes a member from an organization.
+func (r *OrganizationRepository) RemoveMember(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) error {
+	deleted, err := r.client.Membership.Delete().
+		Where(
+			membership.OrganizationID(orgID),
+			membership.UserID(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to delete Membership", errcode.ErrInternalFailure)
+	}
+	if deleted == 0 {
+		return errors.New("Membership not found", "NotFound", errcode.ErrNotFound)
+	}
+	return nil
+}
+
+// RemoveUserMemberships removes a user from all their organizations. Organizations left without
+// members are deleted, and organizations left without owners are handed to their longest standing
+// member, preferring admins.
+//
+// It must run in the transaction deleting the user, before the deletion cascades to the memberships.
+func (r *OrganizationRepository) RemoveUserMemberships(ctx context.Context, userID uuid.UUID) error {
+	client := clientFromContext(ctx, r.client)
+	memberships, err := client.Membership.Query().
+		Where(membership.UserID(userID)).
+		All(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to list Memberships by UserID", errcode.ErrInternalFailure)
+	}
+	if _, err := client.Membership.Delete().Where(membership.UserID(userID)).Exec(ctx); err != nil {
+		return errors.New(err.Error(), "Failed to delete Memberships", errcode.ErrInternalFailure)
+	}
+
+	for _, m := range memberships {
+		remaining, err := client.Membership.Query().
+			Where(membership.OrganizationID(m.OrganizationID)).
+			Order(ent.Asc(membership.FieldCreatedAt)).
+			All(ctx)
+		if err != nil {
+			return errors.New(err.Error(), "Failed to list Memberships by OrganizationID", errcode.ErrInternalFailure)
+		}
+		if len(remaining) == 0 {
+			if err := client.Organization.DeleteOneID(m.OrganizationID).Exec(ctx); err != nil {
+				return errors.New(err.Error(), "Failed to delete Organization", errcode.ErrInternalFailure)
+			}
+			continue
+		}
+		if successor := successorOf(remaining); successor != nil {
+			if err := client.Membership.UpdateOne(successor).SetRole(orgmodels.RoleOwner).Exec(ctx); err != nil {
+				return errors.New(err.Error(), "Failed to update Membership", errcode.ErrInternalFailure)
+			}
+		}
+	}
+	return nil
+}
+
+// successorOf returns the member to promote to owner, or nil if the organization still has an owner.
+// The memberships are ordered by age.
+func successorOf(memberships []*ent.Membership) *ent.Membership {
+	var successor *ent.Membership
+	for _, m := range memberships {
+		switch {
+		case m.Role == orgmodels.RoleOwner:
+			return nil
+		case m.Role == orgmodels.RoleAdmin && (successor == nil || successor.Role != orgmodels.RoleAdmin):
+			successor = m
+		case successor == nil:
+			successor = m
+		}
+	}
+	return successor
+}
+
+// CreateInvitation records an invitation to an organization.
+func (r *OrganizationRepository) CreateInvitation(ctx context.Context, orgID uuid.UUID, email string, role membership.Role, tokenHash string, invitedBy uuid.UUID, expiresAt time.Time) (*orgmodels.SecureInvitation, error) {
+	inv, err := r.client.Invitation.Create().
+		SetOrganizationID(orgID).
+		SetEmail(email).
+		SetRole(invitation.Role(role)).
+		SetTokenHash(tokenHash).
+		SetInvitedBy(invitedBy).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to create Invitation", errcode.ErrInternalFailure)
+	}
+	return orgmodels.NewSecureInvitation(inv), nil
+}
+
+// ListInvitations retrieves the pending invitations of an organization.
+func (r *OrganizationRepository) ListInvitations(ctx context.Context, orgID uuid.UUID) ([]*orgmodels.SecureInvitation, error) {
+	invitations, err := r.client.Invitation.Query().
+		Where(
+			invitation.OrganizationID(orgID),
+			invitation.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(invitation.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list Invitations", errcode.ErrInternalFailure)
+	}
+	secureInvitations := make([]*orgmodels.SecureInvitation, 0, len(invitations))
+	for _, inv := range invitations {
+		secureInvitations = append(secureInvitations, orgmodels.NewSecureInvitation(inv))
+	}
+	return secureInvitations, nil
+}
+
+// AcceptInvitation adds the user to the organization of the pending invitation with the token hash,
+// and deletes the invitation.
+func (r *OrganizationRepository) AcceptInvitation(ctx context.Context, tokenHash string, userID uuid.UUID) (*orgmodels.SecureMembership, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to start transaction", errcode.ErrInternalFailure)
+	}
+	defer tx.Rollback()
+
+	inv, err := tx.Invitation.Query().
+		Where(
+			invitation.TokenHash(tokenHash),
+			invitation.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("Invitation not found or expired", "NotFound", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to get Invitation", errcode.ErrInternalFailure)
+	}
+	// Deleting the invitation first makes concurrent acceptances of the same invitation fail
+	deleted, err := tx.Invitation.Delete().Where(invitation.ID(inv.ID)).Exec(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to delete Invitation", errcode.ErrInternalFailure)
+	}
+	if deleted == 0 {
+		return nil, errors.New("Invitation not found or expired", "NotFound", errcode.ErrNotFound)
+	}
+	m, err := tx.Membership.Create().
+		SetOrganizationID(inv.OrganizationID).
+		SetUserID(userID).
+		SetRole(membership.Role(inv.Role)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("User is already a member", "Conflict", errcode.ErrConflict)
+		}
+		return nil, errors.New(err.Error(), "Failed to create Membership", errcode.ErrInternalFailure)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.New(err.Error(), "Failed to commit transaction", errcode.ErrInternalFailure)
+	}
+	return orgmodels.NewSecureMembership(m), nil
+}
+
+// DeleteInvitation revokes an invitation of an organization.
+func (r *OrganizationRepository) DeleteInvitation(ctx context.Context, orgID uuid.UUID, id uuid.UUID) error {
+	deleted, err := r.client.Invitation.Delete().
+		Where(
+			invitation.ID(id),
+			invitation.OrganizationID(orgID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to delete Invitation", errcode.ErrInternalFailure)
+	}
+	if deleted == 0 {
+		return errors.New("Invitation not found", "NotFound", errcode.ErrNotFound)
+	}
+	return nil
+}
+
+func toSecureMemberships(memberships []*ent.Membership) []*orgmodels.SecureMembership {
+	secureMemberships := make([]*orgmodels.SecureMembership, 0, len(memberships))
+	for _, m := range memberships {
+		secureMemberships = append(secureMemberships, orgmodels.NewSecureMembership(m))
+	}
+	return secureMemberships
+}
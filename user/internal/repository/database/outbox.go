@@ -0,0 +1,135 @@
+package dbrepo
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/outboxmessage"
+	"mandacode.com/accounts/user/ent/predicate"
+	outboxmodels "mandacode.com/accounts/user/internal/models/outbox"
+)
+
+type OutboxRepository struct {
+	client *ent.Client
+}
+
+// NewOutboxRepository creates a new OutboxRepository with the provided database client.
+func NewOutboxRepository(client *ent.Client) *OutboxRepository {
+	return &OutboxRepository{
+		client: client,
+	}
+}
+
+// Enqueue writes a message to the outbox, in the transaction carried by ctx if any.
+func (r *OutboxRepository) Enqueue(ctx context.Context, topic string, key []byte, value []byte, headers map[string]string) error {
+	_, err := clientFromContext(ctx, r.client).OutboxMessage.Create().
+		SetTopic(topic).
+		SetKey(key).
+		SetValue(value).
+		SetHeaders(headers).
+		Save(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to create OutboxMessage", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// ListDue retrieves up to limit messages to publish, oldest first: the oldest message of each key, if it is due
+// at now.
+//
+// A message which is not due holds back the messages after it with the same key, which are not returned either.
+func (r *OutboxRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*outboxmodels.Message, error) {
+	messages, err := r.client.OutboxMessage.Query().
+		Where(
+			outboxmessage.NextAttemptAtLTE(now),
+			oldestOfKey(),
+		).
+		Order(ent.Asc(outboxmessage.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list OutboxMessages", errcode.ErrInternalFailure)
+	}
+	pending := make([]*outboxmodels.Message, 0, len(messages))
+	for _, m := range messages {
+		pending = append(pending, outboxmodels.NewMessage(m))
+	}
+	return pending, nil
+}
+
+// DeletePublished deletes the messages which were published.
+func (r *OutboxRepository) DeletePublished(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := r.client.OutboxMessage.Delete().
+		Where(outboxmessage.IDIn(ids...)).
+		Exec(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to delete published OutboxMessages", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// DeadLetter gives up on a message which failed its last attempt with cause, moving it from the outbox to the
+// dead letters so that the messages after it with the same key are published. It must run in a transaction.
+func (r *OutboxRepository) DeadLetter(ctx context.Context, id int64, cause string) error {
+	client := clientFromContext(ctx, r.client)
+	message, err := client.OutboxMessage.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New(err.Error(), "OutboxMessage not found", errcode.ErrNotFound)
+		}
+		return errors.New(err.Error(), "Failed to get OutboxMessage", errcode.ErrInternalFailure)
+	}
+	_, err = client.OutboxDeadLetter.Create().
+		SetID(message.ID).
+		SetTopic(message.Topic).
+		SetKey(message.Key).
+		SetValue(message.Value).
+		SetHeaders(message.Headers).
+		SetAttempts(message.Attempts + 1).
+		SetLastError(cause).
+		SetCreatedAt(message.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to create OutboxDeadLetter", errcode.ErrInternalFailure)
+	}
+	if err := client.OutboxMessage.DeleteOneID(id).Exec(ctx); err != nil {
+		return errors.New(err.Error(), "Failed to delete OutboxMessage", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// RecordFailure records a failed attempt to publish a message, which is retried after nextAttempt.
+func (r *OutboxRepository) RecordFailure(ctx context.Context, id int64, cause string, nextAttempt time.Time) error {
+	err := r.client.OutboxMessage.UpdateOneID(id).
+		AddAttempts(1).
+		SetLastError(cause).
+		SetNextAttemptAt(nextAttempt).
+		Exec(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to update OutboxMessage", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// oldestOfKey matches the messages with no older message of the same key in the outbox.
+func oldestOfKey() predicate.OutboxMessage {
+	return func(s *sql.Selector) {
+		older := sql.Table(outboxmessage.Table).As("older")
+		s.Where(sql.Not(sql.Exists(
+			sql.Select(older.C(outboxmessage.FieldID)).
+				From(older).
+				Where(sql.And(
+					sql.ColumnsEQ(older.C(outboxmessage.FieldTopic), s.C(outboxmessage.FieldTopic)),
+					sql.ColumnsEQ(older.C(outboxmessage.FieldKey), s.C(outboxmessage.FieldKey)),
+					sql.ColumnsLT(older.C(outboxmessage.FieldID), s.C(outboxmessage.FieldID)),
+				)),
+		)))
+	}
+}
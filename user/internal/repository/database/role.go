@@ -0,0 +1,171 @@
+package dbrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/role"
+	"mandacode.com/accounts/user/ent/roleassignment"
+	rolemodels "mandacode.com/accounts/user/internal/models/role"
+)
+
+type RoleRepository struct {
+	client *ent.Client
+}
+
+// NewRoleRepository creates a new RoleRepository with the provided database client.
+func NewRoleRepository(client *ent.Client) *RoleRepository {
+	return &RoleRepository{
+		client: client,
+	}
+}
+
+func toSecureRoles(roles []*ent.Role) []*rolemodels.SecureRole {
+	secureRoles := make([]*rolemodels.SecureRole, 0, len(roles))
+	for _, r := range roles {
+		secureRoles = append(secureRoles, rolemodels.NewSecureRole(r))
+	}
+	return secureRoles
+}
+
+// ListRoles retrieves all roles, ordered by name.
+func (r *RoleRepository) ListRoles(ctx context.Context) ([]*rolemodels.SecureRole, error) {
+	roles, err := clientFromContext(ctx, r.client).Role.Query().
+		Order(ent.Asc(role.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list Roles", errcode.ErrInternalFailure)
+	}
+	return toSecureRoles(roles), nil
+}
+
+// GetRoleByID retrieves a role by its ID.
+func (r *RoleRepository) GetRoleByID(ctx context.Context, id uuid.UUID) (*rolemodels.SecureRole, error) {
+	found, err := clientFromContext(ctx, r.client).Role.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("Role not found", "NotFound", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to get Role by ID", errcode.ErrInternalFailure)
+	}
+	return rolemodels.NewSecureRole(found), nil
+}
+
+// GetRoleByName retrieves a role by its name.
+func (r *RoleRepository) GetRoleByName(ctx context.Context, name string) (*rolemodels.SecureRole, error) {
+	found, err := clientFromContext(ctx, r.client).Role.Query().
+		Where(role.Name(name)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("Role not found", "NotFound", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to get Role by name", errcode.ErrInternalFailure)
+	}
+	return rolemodels.NewSecureRole(found), nil
+}
+
+// CreateRole creates a new custom role.
+func (r *RoleRepository) CreateRole(ctx context.Context, name string, description string, permissions []string) (*rolemodels.SecureRole, error) {
+	created, err := clientFromContext(ctx, r.client).Role.Create().
+		SetName(name).
+		SetDescription(description).
+		SetPermissions(permissions).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("Role already exists", "Conflict", errcode.ErrConflict)
+		}
+		return nil, errors.New(err.Error(), "Failed to create Role", errcode.ErrInternalFailure)
+	}
+	return rolemodels.NewSecureRole(created), nil
+}
+
+// UpdateRole updates the description and permissions of a role.
+func (r *RoleRepository) UpdateRole(ctx context.Context, id uuid.UUID, description string, permissions []string) (*rolemodels.SecureRole, error) {
+	updated, err := clientFromContext(ctx, r.client).Role.UpdateOneID(id).
+		SetDescription(description).
+		SetPermissions(permissions).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("Role not found", "NotFound", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to update Role", errcode.ErrInternalFailure)
+	}
+	return rolemodels.NewSecureRole(updated), nil
+}
+
+// DeleteRole deletes a role by its ID, along with its assignments.
+func (r *RoleRepository) DeleteRole(ctx context.Context, id uuid.UUID) error {
+	if err := clientFromContext(ctx, r.client).Role.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("Role not found", "NotFound", errcode.ErrNotFound)
+		}
+		return errors.New(err.Error(), "Failed to delete Role", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// ListUserRoles retrieves the roles assigned to a user, ordered by name.
+func (r *RoleRepository) ListUserRoles(ctx context.Context, userID uuid.UUID) ([]*rolemodels.SecureRole, error) {
+	roles, err := clientFromContext(ctx, r.client).Role.Query().
+		Where(role.HasAssignmentsWith(roleassignment.UserID(userID))).
+		Order(ent.Asc(role.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list Roles by UserID", errcode.ErrInternalFailure)
+	}
+	return toSecureRoles(roles), nil
+}
+
+// ListRoleUserIDs retrieves the IDs of the users a role is assigned to.
+func (r *RoleRepository) ListRoleUserIDs(ctx context.Context, roleID uuid.UUID) ([]uuid.UUID, error) {
+	assignments, err := clientFromContext(ctx, r.client).RoleAssignment.Query().
+		Where(roleassignment.RoleID(roleID)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list UserIDs by RoleID", errcode.ErrInternalFailure)
+	}
+	userIDs := make([]uuid.UUID, 0, len(assignments))
+	for _, assignment := range assignments {
+		userIDs = append(userIDs, assignment.UserID)
+	}
+	return userIDs, nil
+}
+
+// AssignRole assigns a role to a user.
+func (r *RoleRepository) AssignRole(ctx context.Context, userID uuid.UUID, roleID uuid.UUID, assignedBy string) error {
+	_, err := clientFromContext(ctx, r.client).RoleAssignment.Create().
+		SetUserID(userID).
+		SetRoleID(roleID).
+		SetAssignedBy(assignedBy).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return errors.New("Role already assigned or user not found", "Conflict", errcode.ErrConflict)
+		}
+		return errors.New(err.Error(), "Failed to assign Role", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// RevokeRole revokes a role from a user.
+func (r *RoleRepository) RevokeRole(ctx context.Context, userID uuid.UUID, roleID uuid.UUID) error {
+	deleted, err := clientFromContext(ctx, r.client).RoleAssignment.Delete().
+		Where(
+			roleassignment.UserID(userID),
+			roleassignment.RoleID(roleID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to revoke Role", errcode.ErrInternalFailure)
+	}
+	if deleted == 0 {
+		return errors.New("Role not assigned", "NotFound", errcode.ErrNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package dbrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/signupsaga"
+	sagamodels "mandacode.com/accounts/user/internal/models/saga"
+)
+
+type SignupSagaRepository struct {
+	client *ent.Client
+}
+
+// NewSignupSagaRepository creates a new SignupSagaRepository with the provided database client.
+func NewSignupSagaRepository(client *ent.Client) *SignupSagaRepository {
+	return &SignupSagaRepository{
+		client: client,
+	}
+}
+
+// CreateSignupSaga starts recording the signup of a user, in the transaction carried by ctx if any. The caller
+// holds the lease of the signup until leasedUntil.
+func (r *SignupSagaRepository) CreateSignupSaga(ctx context.Context, userID uuid.UUID, kind signupsaga.Kind, leasedUntil time.Time) (*sagamodels.SignupSaga, error) {
+	saga, err := clientFromContext(ctx, r.client).SignupSaga.Create().
+		SetUserID(userID).
+		SetKind(kind).
+		SetLeasedUntil(leasedUntil).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("SignupSaga already exists", "Conflict", errcode.ErrConflict)
+		}
+		return nil, errors.New(err.Error(), "Failed to create SignupSaga", errcode.ErrInternalFailure)
+	}
+	return sagamodels.NewSignupSaga(saga), nil
+}
+
+// ClaimSignupSaga takes over a running signup whose lease expired, holding a new lease until leasedUntil.
+//
+// Returns an ErrConflict error if the signup is no longer running, or another runner holds its lease.
+func (r *SignupSagaRepository) ClaimSignupSaga(ctx context.Context, id uuid.UUID, leasedUntil time.Time) (*sagamodels.SignupSaga, error) {
+	saga, err := r.client.SignupSaga.UpdateOneID(id).
+		Where(
+			signupsaga.StatusEQ(signupsaga.StatusRunning),
+			signupsaga.LeasedUntilLT(time.Now()),
+		).
+		SetLeaseID(uuid.New()).
+		SetLeasedUntil(leasedUntil).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("SignupSaga is not running or already leased", "Conflict", errcode.ErrConflict)
+		}
+		return nil, errors.New(err.Error(), "Failed to claim SignupSaga", errcode.ErrInternalFailure)
+	}
+	return sagamodels.NewSignupSaga(saga), nil
+}
+
+// CompleteStep records that a step of a running signup completed, along with the email address of the user
+// if it became known, and renews the lease of the signup until leasedUntil.
+//
+// Returns an ErrConflict error if the signup is no longer running, or the lease was taken over.
+func (r *SignupSagaRepository) CompleteStep(ctx context.Context, saga *sagamodels.SignupSaga, step signupsaga.Step, email *string, leasedUntil time.Time) error {
+	err := clientFromContext(ctx, r.client).SignupSaga.UpdateOneID(saga.ID).
+		Where(
+			signupsaga.StatusEQ(signupsaga.StatusRunning),
+			signupsaga.LeaseIDEQ(saga.LeaseID),
+		).
+		SetStep(step).
+		SetNillableEmail(email).
+		SetLeasedUntil(leasedUntil).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("SignupSaga lease lost", "Conflict", errcode.ErrConflict)
+		}
+		return errors.New(err.Error(), "Failed to update SignupSaga step", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// FinishSignupSaga records that a running signup completed or was compensated, in the transaction carried by
+// ctx if any.
+//
+// Returns an ErrConflict error if the signup is no longer running, or the lease was taken over.
+func (r *SignupSagaRepository) FinishSignupSaga(ctx context.Context, saga *sagamodels.SignupSaga, status signupsaga.Status) error {
+	err := clientFromContext(ctx, r.client).SignupSaga.UpdateOneID(saga.ID).
+		Where(
+			signupsaga.StatusEQ(signupsaga.StatusRunning),
+			signupsaga.LeaseIDEQ(saga.LeaseID),
+		).
+		SetStatus(status).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("SignupSaga lease lost", "Conflict", errcode.ErrConflict)
+		}
+		return errors.New(err.Error(), "Failed to update SignupSaga status", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// RecordFailure records a failed attempt to complete or compensate a signup.
+func (r *SignupSagaRepository) RecordFailure(ctx context.Context, id uuid.UUID, cause string) error {
+	err := r.client.SignupSaga.UpdateOneID(id).
+		AddAttempts(1).
+		SetLastError(cause).
+		Exec(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to update SignupSaga", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// ListStaleSignupSagas retrieves up to limit running signups whose lease expired before the given time, oldest
+// first.
+func (r *SignupSagaRepository) ListStaleSignupSagas(ctx context.Context, before time.Time, limit int) ([]*sagamodels.SignupSaga, error) {
+	sagas, err := r.client.SignupSaga.Query().
+		Where(
+			signupsaga.StatusEQ(signupsaga.StatusRunning),
+			signupsaga.LeasedUntilLT(before),
+		).
+		Order(ent.Asc(signupsaga.FieldLeasedUntil)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list stale SignupSagas", errcode.ErrInternalFailure)
+	}
+	stale := make([]*sagamodels.SignupSaga, 0, len(sagas))
+	for _, s := range sagas {
+		stale = append(stale, sagamodels.NewSignupSaga(s))
+	}
+	return stale, nil
+}
+
+// DeleteFinishedSignupSagas deletes the completed and compensated signups which were last updated before the
+// given time, returning how many were deleted.
+func (r *SignupSagaRepository) DeleteFinishedSignupSagas(ctx context.Context, before time.Time) (int, error) {
+	deleted, err := r.client.SignupSaga.Delete().
+		Where(
+			signupsaga.StatusNEQ(signupsaga.StatusRunning),
+			signupsaga.UpdatedAtLT(before),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, errors.New(err.Error(), "Failed to delete finished SignupSagas", errcode.ErrInternalFailure)
+	}
+	return deleted, nil
+}
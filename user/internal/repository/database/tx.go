@@ -0,0 +1,54 @@
+package dbrepo
+
+import (
+	"context"
+
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/user/ent"
+)
+
+// TxManager runs the steps of a use case in a single transaction.
+//
+// The transaction is carried by the context, so that the repositories resolving their client with
+// clientFromContext take part in it.
+type TxManager struct {
+	client *ent.Client
+}
+
+// NewTxManager creates a new TxManager.
+func NewTxManager(client *ent.Client) *TxManager {
+	return &TxManager{
+		client: client,
+	}
+}
+
+// WithTx runs fn in a transaction, which is committed if fn succeeds and rolled back otherwise. If ctx
+// already carries a transaction, fn joins it.
+func (m *TxManager) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ent.TxFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	tx, err := m.client.Tx(ctx)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to start transaction", errcode.ErrInternalFailure)
+	}
+	defer tx.Rollback()
+
+	if err := fn(ent.NewTxContext(ctx, tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.New(err.Error(), "Failed to commit transaction", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// clientFromContext returns the client of the transaction carried by ctx, or client outside transactions.
+func clientFromContext(ctx context.Context, client *ent.Client) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return client
+}
@@ -28,7 +28,7 @@ func NewUserRepository(client *ent.Client, syncCodeGenerator *util.RandomStringG
 
 // GetUserByID retrieves a user by their ID.
 func (r *UserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*usermodels.SecureUser, error) {
-	user, err := r.client.User.Query().
+	user, err := clientFromContext(ctx, r.client).User.Query().
 		Where(user.IDEQ(id)).
 		Only(ctx)
 	if err != nil {
@@ -40,15 +40,62 @@ func (r *UserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*usermo
 	return usermodels.NewSecureUser(user), nil
 }
 
+// ListUsers retrieves the users matching the filter, newest first, along with the total count of matching users.
+func (r *UserRepository) ListUsers(ctx context.Context, filter usermodels.UserFilter) ([]*usermodels.SecureUser, int, error) {
+	query := clientFromContext(ctx, r.client).User.Query()
+	if filter.IsBlocked != nil {
+		query = query.Where(user.IsBlocked(*filter.IsBlocked))
+	}
+	if filter.IsArchived != nil {
+		query = query.Where(user.IsArchived(*filter.IsArchived))
+	}
+	if filter.CreatedAfter != nil {
+		query = query.Where(user.CreatedAtGTE(*filter.CreatedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		query = query.Where(user.CreatedAtLT(*filter.CreatedBefore))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, errors.New(err.Error(), "Failed to count Users", errcode.ErrInternalFailure)
+	}
+	users, err := query.
+		Order(ent.Desc(user.FieldCreatedAt), ent.Asc(user.FieldID)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, errors.New(err.Error(), "Failed to list Users", errcode.ErrInternalFailure)
+	}
+
+	secureUsers := make([]*usermodels.SecureUser, 0, len(users))
+	for _, u := range users {
+		secureUsers = append(secureUsers, usermodels.NewSecureUser(u))
+	}
+	return secureUsers, total, nil
+}
+
 // CreateUser creates a new user with the provided details.
-func (r *UserRepository) CreateUser(ctx context.Context, id uuid.UUID) (*usermodels.SecureUser, error) {
+//
+// The age is optional. Minors are created inactive, pending the consent of their guardian.
+func (r *UserRepository) CreateUser(ctx context.Context, id uuid.UUID, age *usermodels.Age) (*usermodels.SecureUser, error) {
 	syncCode, err := r.syncCodeGenerator.Generate()
 	if err != nil {
 		return nil, errors.New(err.Error(), "Failed to generate sync code", errcode.ErrInternalFailure)
 	}
-	create := r.client.User.Create().
+	create := clientFromContext(ctx, r.client).User.Create().
 		SetID(id).
 		SetSyncCode(syncCode)
+	if age != nil {
+		create.SetBirthDate(age.BirthDate).
+			SetIsMinor(age.IsMinor)
+		if age.IsMinor {
+			create.SetIsActive(false).
+				SetNillableGuardianEmail(age.GuardianEmail).
+				SetGuardianConsent(user.GuardianConsentPending)
+		}
+	}
 
 	user, err := create.Save(ctx)
 	if err != nil {
@@ -66,9 +113,10 @@ func (r *UserRepository) UpdateIsActive(ctx context.Context, id uuid.UUID, isAct
 	if err != nil {
 		return nil, errors.New(err.Error(), "Failed to generate sync code", errcode.ErrInternalFailure)
 	}
-	user, err := r.client.User.UpdateOneID(id).
+	user, err := clientFromContext(ctx, r.client).User.UpdateOneID(id).
 		SetIsActive(isActive).
 		SetSyncCode(syncCode).
+		AddSyncVersion(1).
 		Save(ctx)
 
 	if err != nil {
@@ -80,9 +128,59 @@ func (r *UserRepository) UpdateIsActive(ctx context.Context, id uuid.UUID, isAct
 	return usermodels.NewSecureUser(user), nil
 }
 
+// GrantGuardianConsent records the consent of the guardian of a minor whose account was pending it, and
+// activates the account.
+func (r *UserRepository) GrantGuardianConsent(ctx context.Context, id uuid.UUID) (*usermodels.SecureUser, error) {
+	syncCode, err := r.syncCodeGenerator.Generate()
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to generate sync code", errcode.ErrInternalFailure)
+	}
+	client := clientFromContext(ctx, r.client)
+	// Only a pending consent can be granted, so that concurrent grants succeed once
+	n, err := client.User.Update().
+		Where(user.ID(id), user.GuardianConsentEQ(user.GuardianConsentPending)).
+		SetGuardianConsent(user.GuardianConsentGranted).
+		SetGuardianConsentedAt(time.Now()).
+		SetIsActive(true).
+		SetSyncCode(syncCode).
+		AddSyncVersion(1).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to update User guardian consent", errcode.ErrInternalFailure)
+	}
+	if n == 0 {
+		return nil, errors.New("Guardian consent is not pending", "Conflict", errcode.ErrConflict)
+	}
+	updated, err := client.User.Get(ctx, id)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to get User", errcode.ErrInternalFailure)
+	}
+	return usermodels.NewSecureUser(updated), nil
+}
+
+// UpdateEmail updates the email address of a user, in the transaction carried by ctx if any.
+func (r *UserRepository) UpdateEmail(ctx context.Context, id uuid.UUID, email string) (*usermodels.SecureUser, error) {
+	syncCode, err := r.syncCodeGenerator.Generate()
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to generate sync code", errcode.ErrInternalFailure)
+	}
+	user, err := clientFromContext(ctx, r.client).User.UpdateOneID(id).
+		SetEmail(email).
+		SetSyncCode(syncCode).
+		AddSyncVersion(1).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("User not found", "NotFound", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to update User email", errcode.ErrInternalFailure)
+	}
+	return usermodels.NewSecureUser(user), nil
+}
+
 // DeleteUser deletes a user by their ID.
 func (r *UserRepository) DeleteUser(ctx context.Context, id uuid.UUID) error {
-	del := r.client.User.DeleteOneID(id)
+	del := clientFromContext(ctx, r.client).User.DeleteOneID(id)
 
 	err := del.Exec(ctx)
 	if err != nil {
@@ -94,17 +192,55 @@ func (r *UserRepository) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// ListExpiredUserIDs retrieves the IDs of archived users whose deletion delay passed before now, oldest first.
+func (r *UserRepository) ListExpiredUserIDs(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error) {
+	users, err := clientFromContext(ctx, r.client).User.Query().
+		Where(
+			user.IsArchived(true),
+			user.DeleteAfterLT(now),
+		).
+		Order(ent.Asc(user.FieldDeleteAfter)).
+		Limit(limit).
+		Select(user.FieldID).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list expired Users", errcode.ErrInternalFailure)
+	}
+	ids := make([]uuid.UUID, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	return ids, nil
+}
+
+// DeleteExpiredUser deletes a user by their ID if they are still archived and their deletion delay passed
+// before now, reporting whether they were deleted. Users restored in the meantime are kept.
+func (r *UserRepository) DeleteExpiredUser(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	deleted, err := clientFromContext(ctx, r.client).User.Delete().
+		Where(
+			user.IDEQ(id),
+			user.IsArchived(true),
+			user.DeleteAfterLT(now),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, errors.New(err.Error(), "Failed to delete expired User", errcode.ErrInternalFailure)
+	}
+	return deleted > 0, nil
+}
+
 // ArchiveUser archives a user by their ID.
 func (r *UserRepository) ArchiveUser(ctx context.Context, id uuid.UUID, duration time.Duration) (*usermodels.SecureUser, error) {
 	syncCode, err := r.syncCodeGenerator.Generate()
 	if err != nil {
 		return nil, errors.New(err.Error(), "Failed to generate sync code", errcode.ErrInternalFailure)
 	}
-	user, err := r.client.User.UpdateOneID(id).
+	user, err := clientFromContext(ctx, r.client).User.UpdateOneID(id).
 		SetIsArchived(true).
 		SetArchivedAt(time.Now()).
 		SetDeleteAfter(time.Now().Add(duration)).
 		SetSyncCode(syncCode).
+		AddSyncVersion(1).
 		Save(ctx)
 
 	if err != nil {
@@ -117,26 +253,40 @@ func (r *UserRepository) ArchiveUser(ctx context.Context, id uuid.UUID, duration
 	return usermodels.NewSecureUser(user), nil
 }
 
-// RestoreUser restores a user by their ID.
+// RestoreUser restores an archived user by their ID if their deletion delay has yet to pass. Users whose
+// delay passed are left to the purge.
 func (r *UserRepository) RestoreUser(ctx context.Context, id uuid.UUID) (*usermodels.SecureUser, error) {
 	syncCode, err := r.syncCodeGenerator.Generate()
 	if err != nil {
 		return nil, errors.New(err.Error(), "Failed to generate sync code", errcode.ErrInternalFailure)
 	}
-	user, err := r.client.User.UpdateOneID(id).
+	client := clientFromContext(ctx, r.client)
+	restored, err := client.User.UpdateOneID(id).
+		Where(
+			user.IsArchived(true),
+			user.DeleteAfterGT(time.Now()),
+		).
 		SetIsArchived(false).
-		SetNillableArchivedAt(nil).
-		SetNillableDeleteAfter(nil).
+		ClearArchivedAt().
+		ClearDeleteAfter().
 		SetSyncCode(syncCode).
+		AddSyncVersion(1).
 		Save(ctx)
 
 	if err != nil {
 		if ent.IsNotFound(err) {
+			exists, existErr := client.User.Query().Where(user.IDEQ(id)).Exist(ctx)
+			if existErr != nil {
+				return nil, errors.New(existErr.Error(), "Failed to check User", errcode.ErrInternalFailure)
+			}
+			if exists {
+				return nil, errors.New("User is not archived or can no longer be restored", "Conflict", errcode.ErrConflict)
+			}
 			return nil, errors.New("User not found", "NotFound", errcode.ErrNotFound)
 		}
 		return nil, errors.New(err.Error(), "Failed to restore User", errcode.ErrInternalFailure)
 	}
-	return usermodels.NewSecureUser(user), nil
+	return usermodels.NewSecureUser(restored), nil
 }
 
 // BlockUser blocks a user by their ID.
@@ -145,9 +295,10 @@ func (r *UserRepository) BlockUser(ctx context.Context, id uuid.UUID, isBlocked
 	if err != nil {
 		return nil, errors.New(err.Error(), "Failed to generate sync code", errcode.ErrInternalFailure)
 	}
-	user, err := r.client.User.UpdateOneID(id).
+	user, err := clientFromContext(ctx, r.client).User.UpdateOneID(id).
 		SetIsBlocked(isBlocked).
 		SetSyncCode(syncCode).
+		AddSyncVersion(1).
 		Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -157,3 +308,44 @@ func (r *UserRepository) BlockUser(ctx context.Context, id uuid.UUID, isBlocked
 	}
 	return usermodels.NewSecureUser(user), nil
 }
+
+// BumpSyncVersion increments the version of a user whose change is announced by a user event, such as a change
+// of their roles, in the transaction carried by ctx if any.
+func (r *UserRepository) BumpSyncVersion(ctx context.Context, id uuid.UUID) (*usermodels.SecureUser, error) {
+	syncCode, err := r.syncCodeGenerator.Generate()
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to generate sync code", errcode.ErrInternalFailure)
+	}
+	user, err := clientFromContext(ctx, r.client).User.UpdateOneID(id).
+		SetSyncCode(syncCode).
+		AddSyncVersion(1).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("User not found", "NotFound", errcode.ErrNotFound)
+		}
+		return nil, errors.New(err.Error(), "Failed to update User sync version", errcode.ErrInternalFailure)
+	}
+	return usermodels.NewSecureUser(user), nil
+}
+
+// ListUsersAfter retrieves a page of users ordered by ID, starting after the given ID if any.
+func (r *UserRepository) ListUsersAfter(ctx context.Context, after *uuid.UUID, limit int) ([]*usermodels.SecureUser, error) {
+	query := clientFromContext(ctx, r.client).User.Query()
+	if after != nil {
+		query = query.Where(user.IDGT(*after))
+	}
+	users, err := query.
+		Order(ent.Asc(user.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to list Users", errcode.ErrInternalFailure)
+	}
+
+	secureUsers := make([]*usermodels.SecureUser, 0, len(users))
+	for _, u := range users {
+		secureUsers = append(secureUsers, usermodels.NewSecureUser(u))
+	}
+	return secureUsers, nil
+}
@@ -2,25 +2,80 @@ package maileventrepo
 
 import (
 	"context"
+	"time"
 
 	mailerv1 "github.com/mandacode-com/accounts-proto/go/mailer/v1"
 	"github.com/mandacode-com/golib/errors"
 	"github.com/mandacode-com/golib/errors/errcode"
-	"github.com/segmentio/kafka-go"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
 )
 
+// MailTypeHeader is the Kafka header selecting the mail template. Messages without it are email verification mails.
+const MailTypeHeader = "mail_type"
+
+// MailTypeOrganizationInvitation selects the mail inviting a user to an organization, whose payload is a
+// mailerv1.OrganizationInvitationEvent.
+const MailTypeOrganizationInvitation = "organization_invitation"
+
+// OrganizationInvitation describes an invitation to join an organization.
+type OrganizationInvitation struct {
+	Email            string
+	OrganizationName string
+	Role             string
+	InvitationLink   string
+	ExpiresAt        time.Time
+}
+
+// MailTypeEmailChanged selects the notice sent to the old address of a user who changed it, whose payload is a
+// mailerv1.EmailChangedEvent.
+const MailTypeEmailChanged = "email_changed"
+
+// EmailChanged tells a user their email address changed, with a link undoing the change.
+type EmailChanged struct {
+	Email         string
+	NewEmail      string
+	UndoLink      string
+	UndoExpiresAt time.Time
+}
+
+// MailTypeDataExportReady selects the mail with the link downloading the export of the data of a user, whose
+// payload is a mailerv1.DataExportReadyEvent.
+const MailTypeDataExportReady = "data_export_ready"
+
+// DataExportReady tells a user the export of their data can be downloaded.
+type DataExportReady struct {
+	Email        string
+	DownloadLink string
+	ExpiresAt    time.Time
+}
+
+// MailTypeGuardianConsentRequest selects the mail asking the guardian of a minor to consent to their account,
+// whose payload is a mailerv1.GuardianConsentRequestEvent.
+const MailTypeGuardianConsentRequest = "guardian_consent_request"
+
+// GuardianConsentRequest asks the guardian of a user under 14 to consent to their account.
+type GuardianConsentRequest struct {
+	Email       string
+	MinorEmail  string
+	ConsentLink string
+	ExpiresAt   time.Time
+}
+
+// MailEventEmitter writes the mail events to the outbox, from which they are published to the topic.
 type MailEventEmitter struct {
-	writer *kafka.Writer
+	outbox *dbrepo.OutboxRepository
+	topic  string
 }
 
 // SendEmailVerificationMail sends an email verification mail to the user.
 //
 // Parameters:
+//   - ctx: The context, whose transaction the mail event is written in, if any.
 //   - email: The email address of the user to send the verification mail to.
 //   - verificationLink: The link to be included in the email for verification.
-func (m *MailEventEmitter) SendEmailVerificationMail(email string, verificationLink string) error {
+func (m *MailEventEmitter) SendEmailVerificationMail(ctx context.Context, email string, verificationLink string) error {
 	event := &mailerv1.EmailVerificationEvent{
 		Email:            email,
 		VerificationLink: verificationLink,
@@ -32,18 +87,105 @@ func (m *MailEventEmitter) SendEmailVerificationMail(email string, verificationL
 		return errors.New(err.Error(), "Failed to marshal email verification event", errcode.ErrInternalFailure)
 	}
 
-	// Create a message to send to Kafka
-	message := kafka.Message{
-		Key:   []byte(email),
-		Value: data,
+	return m.outbox.Enqueue(ctx, m.topic, []byte(email), data, nil)
+}
+
+// SendOrganizationInvitationMail sends an invitation to join an organization.
+//
+// Parameters:
+//   - ctx: The context, whose transaction the mail event is written in, if any.
+//   - invitation: The invitation and the link accepting it.
+func (m *MailEventEmitter) SendOrganizationInvitationMail(ctx context.Context, invitation OrganizationInvitation) error {
+	event := &mailerv1.OrganizationInvitationEvent{
+		Email:            invitation.Email,
+		OrganizationName: invitation.OrganizationName,
+		Role:             invitation.Role,
+		InvitationLink:   invitation.InvitationLink,
+		ExpiresAt:        timestamppb.New(invitation.ExpiresAt),
+		EventTime:        timestamppb.Now(),
+	}
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to marshal organization invitation", errcode.ErrInternalFailure)
+	}
+
+	return m.outbox.Enqueue(ctx, m.topic, []byte(invitation.Email), data, map[string]string{
+		MailTypeHeader: MailTypeOrganizationInvitation,
+	})
+}
+
+// SendEmailChangedMail sends the notice of an email change to the old address of the user.
+//
+// Parameters:
+//   - ctx: The context, whose transaction the mail event is written in, if any.
+//   - notice: The change and the link undoing it.
+func (m *MailEventEmitter) SendEmailChangedMail(ctx context.Context, notice EmailChanged) error {
+	event := &mailerv1.EmailChangedEvent{
+		Email:         notice.Email,
+		NewEmail:      notice.NewEmail,
+		UndoLink:      notice.UndoLink,
+		UndoExpiresAt: timestamppb.New(notice.UndoExpiresAt),
+		EventTime:     timestamppb.Now(),
+	}
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to marshal email changed notice", errcode.ErrInternalFailure)
+	}
+
+	return m.outbox.Enqueue(ctx, m.topic, []byte(notice.Email), data, map[string]string{
+		MailTypeHeader: MailTypeEmailChanged,
+	})
+}
+
+// SendDataExportReadyMail sends the link downloading the export of the data of a user.
+//
+// Parameters:
+//   - ctx: The context, whose transaction the mail event is written in, if any.
+//   - ready: The address of the user and the download link.
+func (m *MailEventEmitter) SendDataExportReadyMail(ctx context.Context, ready DataExportReady) error {
+	event := &mailerv1.DataExportReadyEvent{
+		Email:        ready.Email,
+		DownloadLink: ready.DownloadLink,
+		ExpiresAt:    timestamppb.New(ready.ExpiresAt),
+		EventTime:    timestamppb.Now(),
+	}
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to marshal data export notice", errcode.ErrInternalFailure)
+	}
+
+	return m.outbox.Enqueue(ctx, m.topic, []byte(ready.Email), data, map[string]string{
+		MailTypeHeader: MailTypeDataExportReady,
+	})
+}
+
+// SendGuardianConsentRequestMail sends the link consenting to the account of a minor to their guardian.
+//
+// Parameters:
+//   - ctx: The context, whose transaction the mail event is written in, if any.
+//   - request: The addresses of the guardian and the minor, and the consent link.
+func (m *MailEventEmitter) SendGuardianConsentRequestMail(ctx context.Context, request GuardianConsentRequest) error {
+	event := &mailerv1.GuardianConsentRequestEvent{
+		Email:       request.Email,
+		MinorEmail:  request.MinorEmail,
+		ConsentLink: request.ConsentLink,
+		ExpiresAt:   timestamppb.New(request.ExpiresAt),
+		EventTime:   timestamppb.Now(),
+	}
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to marshal guardian consent request", errcode.ErrInternalFailure)
 	}
 
-	return m.writer.WriteMessages(context.Background(), message)
+	return m.outbox.Enqueue(ctx, m.topic, []byte(request.Email), data, map[string]string{
+		MailTypeHeader: MailTypeGuardianConsentRequest,
+	})
 }
 
-// NewMailEventEmitter creates a new Mailer instance with the provided Kafka writer.
-func NewMailEventEmitter(writer *kafka.Writer) *MailEventEmitter {
+// NewMailEventEmitter creates a new MailEventEmitter publishing to the topic through the outbox.
+func NewMailEventEmitter(outbox *dbrepo.OutboxRepository, topic string) *MailEventEmitter {
 	return &MailEventEmitter{
-		writer: writer,
+		outbox: outbox,
+		topic:  topic,
 	}
 }
@@ -0,0 +1,84 @@
+package outboxrepo
+
+import (
+	"context"
+	stdErrors "errors"
+
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"github.com/segmentio/kafka-go"
+	outboxmodels "mandacode.com/accounts/user/internal/models/outbox"
+)
+
+// Writer writes messages to a topic, as *kafka.Writer does.
+type Writer interface {
+	WriteMessages(ctx context.Context, messages ...kafka.Message) error
+}
+
+// Publisher publishes the messages of the outbox with the Kafka writer of their topic.
+type Publisher struct {
+	writers map[string]Writer
+}
+
+// NewPublisher creates a new Publisher with the provided writers, keyed by their topic.
+func NewPublisher(writers map[string]Writer) *Publisher {
+	return &Publisher{
+		writers: writers,
+	}
+}
+
+// Publish publishes the messages, writing the messages of each topic in a single batch.
+//
+// Returns the error of each message, which is nil for the messages which were published.
+func (p *Publisher) Publish(ctx context.Context, messages []*outboxmodels.Message) []error {
+	errs := make([]error, len(messages))
+	indexes := make(map[string][]int)
+	for i, message := range messages {
+		indexes[message.Topic] = append(indexes[message.Topic], i)
+	}
+
+	for topic, topicIndexes := range indexes {
+		writer, ok := p.writers[topic]
+		if !ok {
+			for _, i := range topicIndexes {
+				errs[i] = errors.New("no writer for topic "+topic, "Failed to publish message", errcode.ErrInternalFailure)
+			}
+			continue
+		}
+
+		batch := make([]kafka.Message, 0, len(topicIndexes))
+		for _, i := range topicIndexes {
+			batch = append(batch, kafkaMessage(messages[i]))
+		}
+		err := writer.WriteMessages(ctx, batch...)
+		if err == nil {
+			continue
+		}
+		var writeErrs kafka.WriteErrors
+		if stdErrors.As(err, &writeErrs) && len(writeErrs) == len(batch) {
+			for j, i := range topicIndexes {
+				if writeErrs[j] != nil {
+					errs[i] = errors.New(writeErrs[j].Error(), "Failed to publish message", errcode.ErrDependencyFailure)
+				}
+			}
+			continue
+		}
+		for _, i := range topicIndexes {
+			errs[i] = errors.New(err.Error(), "Failed to publish message", errcode.ErrDependencyFailure)
+		}
+	}
+	return errs
+}
+
+// kafkaMessage converts a message of the outbox to a Kafka message.
+func kafkaMessage(message *outboxmodels.Message) kafka.Message {
+	headers := make([]kafka.Header, 0, len(message.Headers))
+	for key, value := range message.Headers {
+		headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+	}
+	return kafka.Message{
+		Key:     message.Key,
+		Value:   message.Value,
+		Headers: headers,
+	}
+}
@@ -2,22 +2,45 @@ package profilerepo
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/google/uuid"
 	profilev1 "github.com/mandacode-com/accounts-proto/go/profile/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
 	profilerepodto "mandacode.com/accounts/user/internal/repository/profile/dto"
 )
 
 type ProfileRepository struct {
-	client profilev1.ProfileServiceClient
+	client       profilev1.ProfileServiceClient
+	exportClient profilev1.DataExportServiceClient
 }
 
 // NewProfileRepository creates a new instance of ProfileRepository with the provided client.
-func NewProfileRepository(client profilev1.ProfileServiceClient) *ProfileRepository {
+func NewProfileRepository(client profilev1.ProfileServiceClient, exportClient profilev1.DataExportServiceClient) *ProfileRepository {
 	return &ProfileRepository{
-		client: client,
+		client:       client,
+		exportClient: exportClient,
 	}
 }
 
+// ExportUserData returns everything the profile service holds about the user, as a JSON document.
+func (p *ProfileRepository) ExportUserData(ctx context.Context, userID uuid.UUID) (json.RawMessage, error) {
+	res, err := p.exportClient.ExportUserData(ctx, &profilev1.ExportUserDataRequest{
+		UserId: userID.String(),
+	})
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to export user data from profile service", errcode.ErrInternalFailure)
+	}
+	if err := res.ValidateAll(); err != nil {
+		return nil, errors.Upgrade(err, "Invalid response from profile service", errcode.ErrInternalFailure)
+	}
+	if !json.Valid(res.Data) {
+		return nil, errors.New("profile service returned invalid JSON", "Invalid response from profile service", errcode.ErrInternalFailure)
+	}
+	return res.Data, nil
+}
+
 func (p *ProfileRepository) CreateProfileUser(ctx context.Context, req *profilerepodto.CreateProfileUserRequest) (*profilerepodto.CreateProfileUserResponse, error) {
 	protoRes, err := p.client.InitUser(ctx, req.ToProto())
 	if err != nil {
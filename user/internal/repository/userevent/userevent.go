@@ -9,19 +9,45 @@ import (
 	"github.com/mandacode-com/golib/errors/errcode"
 	"github.com/segmentio/kafka-go"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	rolemodels "mandacode.com/accounts/user/internal/models/role"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
 )
 
+// UserEventEmitter writes the user events to the outbox, from which they are published to the topic.
+//
+// The events are written in the transaction carried by the context, if any, so that they are published if and
+// only if the change they announce is committed.
 type UserEventEmitter struct {
-	writer *kafka.Writer
+	outbox *dbrepo.OutboxRepository
+	topic  string
 }
 
-// NewUserEventEmitter creates a new UserEventEmitter with the provided Kafka writer.
-func NewUserEventEmitter(writer *kafka.Writer) *UserEventEmitter {
+// NewUserEventEmitter creates a new UserEventEmitter publishing to the topic through the outbox.
+func NewUserEventEmitter(outbox *dbrepo.OutboxRepository, topic string) *UserEventEmitter {
 	return &UserEventEmitter{
-		writer: writer,
+		outbox: outbox,
+		topic:  topic,
 	}
 }
 
+// enqueue writes the message to the outbox.
+func (e *UserEventEmitter) enqueue(ctx context.Context, message kafka.Message) error {
+	return e.outbox.Enqueue(ctx, e.topic, message.Key, message.Value, outboxHeaders(message.Headers))
+}
+
+// outboxHeaders converts Kafka headers to the headers stored in the outbox.
+func outboxHeaders(headers []kafka.Header) map[string]string {
+	if len(headers) == 0 {
+		return nil
+	}
+	converted := make(map[string]string, len(headers))
+	for _, header := range headers {
+		converted[header.Key] = string(header.Value)
+	}
+	return converted
+}
+
 // EmitUserDeletedEvent emits a user deleted event to Kafka.
 func (e *UserEventEmitter) EmitUserDeletedEvent(ctx context.Context, userID uuid.UUID) error {
 	event := &usereventv1.UserEvent{
@@ -42,18 +68,19 @@ func (e *UserEventEmitter) EmitUserDeletedEvent(ctx context.Context, userID uuid
 		Value: data,
 	}
 
-	if err := e.writer.WriteMessages(ctx, message); err != nil {
-		return errors.New(err.Error(), "Failed to write user deleted event to Kafka", errcode.ErrInternalFailure)
+	if err := e.enqueue(ctx, message); err != nil {
+		return errors.Join(err, "failed to enqueue user deleted event")
 	}
 	return nil
 }
 
 // EmitUserArchivedEvent emits a user archived event to Kafka.
-func (e *UserEventEmitter) EmitUserArchivedEvent(ctx context.Context, userID uuid.UUID, syncCode string) error {
+func (e *UserEventEmitter) EmitUserArchivedEvent(ctx context.Context, userID uuid.UUID, syncCode string, syncVersion int64) error {
 	event := &usereventv1.UserEvent{
-		EventType: usereventv1.EventType_USER_ARCHIVED,
-		UserId:    userID.String(),
-		SyncCode:  &syncCode,
+		EventType:   usereventv1.EventType_USER_ARCHIVED,
+		UserId:      userID.String(),
+		SyncCode:    &syncCode,
+		SyncVersion: syncVersion,
 	}
 
 	// Marshal the event to protobuf bytes
@@ -68,18 +95,19 @@ func (e *UserEventEmitter) EmitUserArchivedEvent(ctx context.Context, userID uui
 		Value: data,
 	}
 
-	if err := e.writer.WriteMessages(ctx, message); err != nil {
-		return errors.New(err.Error(), "Failed to write user archived event to Kafka", errcode.ErrInternalFailure)
+	if err := e.enqueue(ctx, message); err != nil {
+		return errors.Join(err, "failed to enqueue user archived event")
 	}
 	return nil
 }
 
 // EmitUserRestoredEvent emits a user restored event to Kafka.
-func (e *UserEventEmitter) EmitUserRestoredEvent(ctx context.Context, userID uuid.UUID, syncCode string) error {
+func (e *UserEventEmitter) EmitUserRestoredEvent(ctx context.Context, userID uuid.UUID, syncCode string, syncVersion int64) error {
 	event := &usereventv1.UserEvent{
-		EventType: usereventv1.EventType_USER_RESTORED,
-		UserId:    userID.String(),
-		SyncCode:  &syncCode,
+		EventType:   usereventv1.EventType_USER_RESTORED,
+		UserId:      userID.String(),
+		SyncCode:    &syncCode,
+		SyncVersion: syncVersion,
 	}
 
 	// Marshal the event to protobuf bytes
@@ -94,18 +122,19 @@ func (e *UserEventEmitter) EmitUserRestoredEvent(ctx context.Context, userID uui
 		Value: data,
 	}
 
-	if err := e.writer.WriteMessages(ctx, message); err != nil {
-		return errors.New(err.Error(), "Failed to write user restored event to Kafka", errcode.ErrInternalFailure)
+	if err := e.enqueue(ctx, message); err != nil {
+		return errors.Join(err, "failed to enqueue user restored event")
 	}
 	return nil
 }
 
 // EmitUserBlockedEvent emits a user blocked event to Kafka.
-func (e *UserEventEmitter) EmitUserBlockedEvent(ctx context.Context, userID uuid.UUID, syncCode string) error {
+func (e *UserEventEmitter) EmitUserBlockedEvent(ctx context.Context, userID uuid.UUID, syncCode string, syncVersion int64) error {
 	event := &usereventv1.UserEvent{
-		EventType: usereventv1.EventType_USER_BLOCKED,
-		UserId:    userID.String(),
-		SyncCode:  &syncCode,
+		EventType:   usereventv1.EventType_USER_BLOCKED,
+		UserId:      userID.String(),
+		SyncCode:    &syncCode,
+		SyncVersion: syncVersion,
 	}
 
 	// Marshal the event to protobuf bytes
@@ -120,18 +149,19 @@ func (e *UserEventEmitter) EmitUserBlockedEvent(ctx context.Context, userID uuid
 		Value: data,
 	}
 
-	if err := e.writer.WriteMessages(ctx, message); err != nil {
-		return errors.New(err.Error(), "Failed to write user blocked event to Kafka", errcode.ErrInternalFailure)
+	if err := e.enqueue(ctx, message); err != nil {
+		return errors.Join(err, "failed to enqueue user blocked event")
 	}
 	return nil
 }
 
 // EmitUserUnblockedEvent emits a user unblocked event to Kafka.
-func (e *UserEventEmitter) EmitUserUnblockedEvent(ctx context.Context, userID uuid.UUID, syncCode string) error {
+func (e *UserEventEmitter) EmitUserUnblockedEvent(ctx context.Context, userID uuid.UUID, syncCode string, syncVersion int64) error {
 	event := &usereventv1.UserEvent{
-		EventType: usereventv1.EventType_USER_UNBLOCKED,
-		UserId:    userID.String(),
-		SyncCode:  &syncCode,
+		EventType:   usereventv1.EventType_USER_UNBLOCKED,
+		UserId:      userID.String(),
+		SyncCode:    &syncCode,
+		SyncVersion: syncVersion,
 	}
 
 	// Marshal the event to protobuf bytes
@@ -146,8 +176,145 @@ func (e *UserEventEmitter) EmitUserUnblockedEvent(ctx context.Context, userID uu
 		Value: data,
 	}
 
-	if err := e.writer.WriteMessages(ctx, message); err != nil {
-		return errors.New(err.Error(), "Failed to write user unblocked event to Kafka", errcode.ErrInternalFailure)
+	if err := e.enqueue(ctx, message); err != nil {
+		return errors.Join(err, "failed to enqueue user unblocked event")
+	}
+	return nil
+}
+
+// EmitUserActiveChangedEvent emits a user active changed event to Kafka.
+func (e *UserEventEmitter) EmitUserActiveChangedEvent(ctx context.Context, userID uuid.UUID, isActive bool, syncCode string, syncVersion int64) error {
+	event := &usereventv1.UserEvent{
+		EventType:   usereventv1.EventType_USER_ACTIVE_CHANGED,
+		UserId:      userID.String(),
+		SyncCode:    &syncCode,
+		SyncVersion: syncVersion,
+		EventTime:   timestamppb.Now(),
+		Payload: &usereventv1.UserEvent_ActiveChanged{
+			ActiveChanged: &usereventv1.ActiveChanged{
+				IsActive: isActive,
+			},
+		},
+	}
+
+	// Marshal the event to protobuf bytes
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to marshal user active changed event", errcode.ErrInternalFailure)
+	}
+
+	// Create a message to send to Kafka
+	message := kafka.Message{
+		Key:   []byte(event.UserId),
+		Value: data,
+	}
+
+	if err := e.enqueue(ctx, message); err != nil {
+		return errors.Join(err, "failed to enqueue user active changed event")
+	}
+	return nil
+}
+
+// EmitUserEmailChangedEvent emits a user email changed event to Kafka.
+//
+// reverted marks the undoing of a change from the link sent to the old address, which suggests the account was
+// taken over.
+func (e *UserEventEmitter) EmitUserEmailChangedEvent(ctx context.Context, userID uuid.UUID, email string, reverted bool, syncCode string, syncVersion int64) error {
+	event := &usereventv1.UserEvent{
+		EventType:   usereventv1.EventType_USER_EMAIL_CHANGED,
+		UserId:      userID.String(),
+		SyncCode:    &syncCode,
+		SyncVersion: syncVersion,
+		EventTime:   timestamppb.Now(),
+		Payload: &usereventv1.UserEvent_EmailChanged{
+			EmailChanged: &usereventv1.EmailChanged{
+				Email:    email,
+				Reverted: reverted,
+			},
+		},
+	}
+
+	// Marshal the event to protobuf bytes
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to marshal user email changed event", errcode.ErrInternalFailure)
+	}
+
+	// Create a message to send to Kafka
+	message := kafka.Message{
+		Key:   []byte(event.UserId),
+		Value: data,
+	}
+
+	if err := e.enqueue(ctx, message); err != nil {
+		return errors.Join(err, "failed to enqueue user email changed event")
+	}
+	return nil
+}
+
+// EmitUserMinorStatusChangedEvent emits a user minor status changed event to Kafka.
+func (e *UserEventEmitter) EmitUserMinorStatusChangedEvent(ctx context.Context, userID uuid.UUID, isMinor bool, guardianConsent string, syncCode string, syncVersion int64) error {
+	event := &usereventv1.UserEvent{
+		EventType:   usereventv1.EventType_USER_MINOR_STATUS_CHANGED,
+		UserId:      userID.String(),
+		SyncCode:    &syncCode,
+		SyncVersion: syncVersion,
+		EventTime:   timestamppb.Now(),
+		Payload: &usereventv1.UserEvent_MinorStatusChanged{
+			MinorStatusChanged: &usereventv1.MinorStatusChanged{
+				IsMinor:         isMinor,
+				GuardianConsent: guardianConsent,
+			},
+		},
+	}
+
+	// Marshal the event to protobuf bytes
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to marshal user minor status changed event", errcode.ErrInternalFailure)
+	}
+
+	// Create a message to send to Kafka
+	message := kafka.Message{
+		Key:   []byte(event.UserId),
+		Value: data,
+	}
+
+	if err := e.enqueue(ctx, message); err != nil {
+		return errors.Join(err, "failed to enqueue user minor status changed event")
+	}
+	return nil
+}
+
+// EmitRolesChangedEvent emits a roles changed event to Kafka.
+func (e *UserEventEmitter) EmitRolesChangedEvent(ctx context.Context, roles *rolemodels.UserRoles, syncVersion int64) error {
+	event := &usereventv1.UserEvent{
+		EventType:   usereventv1.EventType_ROLES_CHANGED,
+		UserId:      roles.UserID.String(),
+		SyncVersion: syncVersion,
+		EventTime:   timestamppb.Now(),
+		Payload: &usereventv1.UserEvent_RolesChanged{
+			RolesChanged: &usereventv1.RolesChanged{
+				Roles:       roles.Roles,
+				Permissions: roles.Permissions,
+			},
+		},
+	}
+
+	// Marshal the event to protobuf bytes
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return errors.New(err.Error(), "Failed to marshal roles changed event", errcode.ErrInternalFailure)
+	}
+
+	// Create a message to send to Kafka
+	message := kafka.Message{
+		Key:   []byte(event.UserId),
+		Value: data,
+	}
+
+	if err := e.enqueue(ctx, message); err != nil {
+		return errors.Join(err, "failed to enqueue roles changed event")
 	}
 	return nil
 }
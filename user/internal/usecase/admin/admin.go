@@ -1,15 +1,45 @@
 package admin
 
+import (
+	"crypto/subtle"
+
+	"github.com/google/uuid"
+)
+
 type AdminUsecase struct {
+	apiKey   string
+	adminIDs map[uuid.UUID]struct{}
 }
 
 // NewAdminUsecase creates a new AdminUsecase.
-func NewAdminUsecase() *AdminUsecase {
-	return &AdminUsecase{}
+//
+// apiKey is the shared key of trusted backends, which is disabled if empty, and adminIDs are the users
+// allowed to call the admin API.
+func NewAdminUsecase(apiKey string, adminIDs []uuid.UUID) *AdminUsecase {
+	ids := make(map[uuid.UUID]struct{}, len(adminIDs))
+	for _, id := range adminIDs {
+		ids[id] = struct{}{}
+	}
+	return &AdminUsecase{
+		apiKey:   apiKey,
+		adminIDs: ids,
+	}
 }
 
 // ValidateAdmin checks if the admin is valid.
 func (a *AdminUsecase) ValidateAdmin(adminID string) bool {
-	// Implement validation logic here
-	return false
+	id, err := uuid.Parse(adminID)
+	if err != nil {
+		return false
+	}
+	_, ok := a.adminIDs[id]
+	return ok
+}
+
+// ValidateAPIKey checks if the key is the admin API key.
+func (a *AdminUsecase) ValidateAPIKey(key string) bool {
+	if a.apiKey == "" || key == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(key), []byte(a.apiKey)) == 1
 }
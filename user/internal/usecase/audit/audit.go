@@ -0,0 +1,233 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	auditmodels "mandacode.com/accounts/user/internal/models/audit"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+)
+
+const (
+	defaultListLimit   = 50
+	maxListLimit       = 200
+	defaultVerifyLimit = 1000
+	maxVerifyLimit     = 10000
+
+	// maxAppendAttempts bounds the attempts to append an entry while other consumers append to the chain.
+	maxAppendAttempts = 10
+)
+
+// AuditUsecase records the audit events of the services in a hash chain, and reads and verifies it.
+//
+// Each entry is hashed with the hash of the previous entry, so that changing, removing or reordering recorded
+// entries breaks the chain from that entry on.
+type AuditUsecase struct {
+	auditRepo *dbrepo.AuditRepository
+}
+
+// NewAuditUsecase creates a new AuditUsecase with the provided repository.
+func NewAuditUsecase(auditRepo *dbrepo.AuditRepository) *AuditUsecase {
+	return &AuditUsecase{
+		auditRepo: auditRepo,
+	}
+}
+
+// Record appends the audit event to the chain. Events which are already recorded are skipped.
+func (u *AuditUsecase) Record(ctx context.Context, event auditmodels.Event) error {
+	if event.ID == uuid.Nil || event.Service == "" || event.ActorType == "" || event.Action == "" || event.TargetType == "" || event.TargetID == "" {
+		return errors.New("audit event is missing required fields", "Invalid Audit Event", errcode.ErrInvalidInput)
+	}
+	if event.OccurredAt.IsZero() {
+		return errors.New("audit event is missing its time", "Invalid Audit Event", errcode.ErrInvalidInput)
+	}
+
+	recordedAt := time.Now()
+	for range maxAppendAttempts {
+		exists, err := u.auditRepo.AuditEntryExists(ctx, event.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return nil
+		}
+
+		entry, err := u.nextEntry(ctx, event, recordedAt)
+		if err != nil {
+			return err
+		}
+		err = u.auditRepo.CreateAuditEntry(ctx, entry)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, errcode.ErrConflict) {
+			return err
+		}
+		// Another consumer appended first, or recorded the same event
+	}
+	return errors.New("too many concurrent appends", "Failed to record audit event", errcode.ErrConflict)
+}
+
+// nextEntry builds the entry of the event at the end of the chain.
+func (u *AuditUsecase) nextEntry(ctx context.Context, event auditmodels.Event, recordedAt time.Time) (*auditmodels.Entry, error) {
+	seq, prevHash := int64(1), ""
+	last, err := u.auditRepo.GetLastAuditEntry(ctx)
+	if err != nil {
+		if !errors.Is(err, errcode.ErrNotFound) {
+			return nil, err
+		}
+	} else {
+		seq, prevHash = last.Seq+1, last.Hash
+	}
+
+	entry := &auditmodels.Entry{
+		ID:         event.ID,
+		Seq:        seq,
+		Service:    event.Service,
+		ActorType:  event.ActorType,
+		ActorID:    event.ActorID,
+		Action:     event.Action,
+		TargetType: event.TargetType,
+		TargetID:   event.TargetID,
+		Before:     event.Before,
+		After:      event.After,
+		RequestID:  event.RequestID,
+		IP:         event.IP,
+		// The database keeps microseconds, so the hash must not cover more
+		OccurredAt: event.OccurredAt.UTC().Truncate(time.Microsecond),
+		RecordedAt: recordedAt,
+		PrevHash:   prevHash,
+	}
+	hash, err := entryHash(entry)
+	if err != nil {
+		return nil, err
+	}
+	entry.Hash = hash
+	return entry, nil
+}
+
+// ListEntries retrieves a page of the entries matching the filter, newest first.
+//
+// Non-positive limits select the default page size, and limits are capped at maxListLimit.
+func (u *AuditUsecase) ListEntries(ctx context.Context, filter auditmodels.EntryFilter) ([]*auditmodels.Entry, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListLimit
+	}
+	filter.Limit = min(filter.Limit, maxListLimit)
+	return u.auditRepo.ListAuditEntries(ctx, filter)
+}
+
+// VerifyChain verifies up to limit entries of the chain from the position fromSeq, checking that they follow each
+// other and that their hashes match their content.
+//
+// Non-positive limits select the default number of entries, and limits are capped at maxVerifyLimit.
+func (u *AuditUsecase) VerifyChain(ctx context.Context, fromSeq int64, limit int) (*auditmodels.ChainVerification, error) {
+	fromSeq = max(fromSeq, 1)
+	if limit <= 0 {
+		limit = defaultVerifyLimit
+	}
+	limit = min(limit, maxVerifyLimit)
+
+	prevHash := ""
+	if fromSeq > 1 {
+		prev, err := u.auditRepo.GetAuditEntryBySeq(ctx, fromSeq-1)
+		if err != nil {
+			return nil, err
+		}
+		prevHash = prev.Hash
+	}
+	entries, err := u.auditRepo.ListAuditEntriesFrom(ctx, fromSeq, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	verification := &auditmodels.ChainVerification{Valid: true}
+	expectedSeq := fromSeq
+	for _, entry := range entries {
+		reason := ""
+		switch {
+		case entry.Seq != expectedSeq:
+			reason = "entry " + strconv.FormatInt(expectedSeq, 10) + " is missing"
+		case entry.PrevHash != prevHash:
+			reason = "previous hash does not match the previous entry"
+		default:
+			hash, err := entryHash(entry)
+			if err != nil {
+				return nil, err
+			}
+			if hash != entry.Hash {
+				reason = "hash does not match the content of the entry"
+			}
+		}
+		if reason != "" {
+			brokenSeq := expectedSeq
+			verification.Valid = false
+			verification.BrokenSeq = &brokenSeq
+			verification.Reason = reason
+			return verification, nil
+		}
+
+		if verification.Checked == 0 {
+			verification.FirstSeq = entry.Seq
+		}
+		verification.LastSeq = entry.Seq
+		verification.Checked++
+		prevHash = entry.Hash
+		expectedSeq++
+	}
+	return verification, nil
+}
+
+// chainedContent is the content of an entry covered by its hash, in a fixed encoding.
+type chainedContent struct {
+	Seq        int64          `json:"seq"`
+	ID         string         `json:"id"`
+	Service    string         `json:"service"`
+	ActorType  string         `json:"actor_type"`
+	ActorID    string         `json:"actor_id"`
+	Action     string         `json:"action"`
+	TargetType string         `json:"target_type"`
+	TargetID   string         `json:"target_id"`
+	Before     map[string]any `json:"before"`
+	After      map[string]any `json:"after"`
+	RequestID  string         `json:"request_id"`
+	IP         string         `json:"ip"`
+	OccurredAt string         `json:"occurred_at"`
+}
+
+// entryHash returns the SHA-256 hash of the previous hash of the entry and its content, hex encoded.
+//
+// The content is encoded as JSON, whose object keys encoding/json sorts, so that the hash does not depend on how
+// the database orders the keys of before and after.
+func entryHash(entry *auditmodels.Entry) (string, error) {
+	content, err := json.Marshal(chainedContent{
+		Seq:        entry.Seq,
+		ID:         entry.ID.String(),
+		Service:    entry.Service,
+		ActorType:  entry.ActorType,
+		ActorID:    entry.ActorID,
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		Before:     entry.Before,
+		After:      entry.After,
+		RequestID:  entry.RequestID,
+		IP:         entry.IP,
+		OccurredAt: entry.OccurredAt.UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return "", errors.New(err.Error(), "Failed to encode audit entry", errcode.ErrInternalFailure)
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(entry.PrevHash))
+	hash.Write(content)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,182 @@
+package consent
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/user/ent/consentdocument"
+	auditmodels "mandacode.com/accounts/user/internal/models/audit"
+	consentmodels "mandacode.com/accounts/user/internal/models/consent"
+	auditeventrepo "mandacode.com/accounts/user/internal/repository/auditevent"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+)
+
+// ConsentUsecase publishes the documents which users consent to, and records the choices of the users on them.
+//
+// The latest version of each kind of document is the current one. A user has to accept the current version of
+// every mandatory kind, so publishing a new mandatory version makes every user consent again.
+type ConsentUsecase struct {
+	consentRepo  *dbrepo.ConsentRepository
+	txManager    *dbrepo.TxManager
+	auditEmitter *auditeventrepo.AuditEventEmitter
+}
+
+// NewConsentUsecase creates a new ConsentUsecase with the provided repositories.
+func NewConsentUsecase(consentRepo *dbrepo.ConsentRepository, txManager *dbrepo.TxManager, auditEmitter *auditeventrepo.AuditEventEmitter) *ConsentUsecase {
+	return &ConsentUsecase{
+		consentRepo:  consentRepo,
+		txManager:    txManager,
+		auditEmitter: auditEmitter,
+	}
+}
+
+// PublishDocument publishes a document as the next version of its kind, which becomes the current one.
+func (u *ConsentUsecase) PublishDocument(ctx context.Context, input consentmodels.PublishInput) (*consentmodels.Document, error) {
+	if !validKind(input.Kind) {
+		return nil, errors.New("unknown document kind: "+string(input.Kind), "Invalid Consent Document", errcode.ErrInvalidInput)
+	}
+	if input.Kind == consentdocument.KindMarketing && input.Mandatory {
+		return nil, errors.New("marketing consent cannot be mandatory", "Invalid Consent Document", errcode.ErrInvalidInput)
+	}
+
+	var document *consentmodels.Document
+	err := u.txManager.WithTx(ctx, func(ctx context.Context) error {
+		latest, err := u.consentRepo.GetLatestVersion(ctx, input.Kind)
+		if err != nil {
+			return err
+		}
+		// Concurrent publications of the same kind conflict on the version, and all but one fail
+		document, err = u.consentRepo.CreateDocument(ctx, input, latest+1)
+		if err != nil {
+			return err
+		}
+		return u.auditEmitter.Emit(ctx, auditmodels.ActionConsentPublish, auditmodels.TargetTypeConsentDocument, document.ID.String(), nil, document)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// ListDocuments retrieves every published version of the documents, of a single kind if kind is not nil.
+func (u *ConsentUsecase) ListDocuments(ctx context.Context, kind *consentdocument.Kind) ([]*consentmodels.Document, error) {
+	if kind != nil && !validKind(*kind) {
+		return nil, errors.New("unknown document kind: "+string(*kind), "Invalid Consent Document", errcode.ErrInvalidInput)
+	}
+	return u.consentRepo.ListDocuments(ctx, kind)
+}
+
+// CurrentDocuments retrieves the current version of each kind of document, which are the ones users are asked
+// to consent to.
+func (u *ConsentUsecase) CurrentDocuments(ctx context.Context) ([]*consentmodels.Document, error) {
+	return u.consentRepo.ListCurrentDocuments(ctx)
+}
+
+// ValidateSignupChoices checks the choices of a user signing up: they must accept every current mandatory
+// document.
+func (u *ConsentUsecase) ValidateSignupChoices(ctx context.Context, choices []consentmodels.Choice) error {
+	current, err := u.consentRepo.ListCurrentDocuments(ctx)
+	if err != nil {
+		return err
+	}
+	if err := validateChoices(current, choices); err != nil {
+		return err
+	}
+	if missing := missingMandatory(current, nil, choices); len(missing) > 0 {
+		return errors.New("current mandatory consent documents are not accepted", "Consent Required", errcode.ErrInvalidInput)
+	}
+	return nil
+}
+
+// Record records the choices of a user on current documents. Mandatory documents cannot be declined.
+func (u *ConsentUsecase) Record(ctx context.Context, userID uuid.UUID, choices []consentmodels.Choice, source consentmodels.Source) error {
+	current, err := u.consentRepo.ListCurrentDocuments(ctx)
+	if err != nil {
+		return err
+	}
+	if err := validateChoices(current, choices); err != nil {
+		return err
+	}
+	return u.consentRepo.CreateConsents(ctx, userID, choices, source)
+}
+
+// RecordForUser records the choices of a user which were collected by another service, such as the auth service
+// when the user signs in, auditing that they were recorded on behalf of the user.
+func (u *ConsentUsecase) RecordForUser(ctx context.Context, userID uuid.UUID, choices []consentmodels.Choice, source consentmodels.Source) error {
+	return u.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.Record(ctx, userID, choices, source); err != nil {
+			return err
+		}
+		return u.auditEmitter.Emit(ctx, auditmodels.ActionConsentRecord, auditmodels.TargetTypeUser, userID.String(), nil, map[string]any{"consents": choices})
+	})
+}
+
+// PendingDocuments retrieves the current mandatory documents which the user has not accepted, which they must
+// accept before they can sign in.
+func (u *ConsentUsecase) PendingDocuments(ctx context.Context, userID uuid.UUID) ([]*consentmodels.Document, error) {
+	current, err := u.consentRepo.ListCurrentDocuments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	history, err := u.consentRepo.ListConsentsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return missingMandatory(current, history, nil), nil
+}
+
+// History retrieves every choice the user made, oldest first.
+func (u *ConsentUsecase) History(ctx context.Context, userID uuid.UUID) ([]*consentmodels.Consent, error) {
+	return u.consentRepo.ListConsentsByUserID(ctx, userID)
+}
+
+// validateChoices checks that the choices are on distinct current documents, and do not decline mandatory ones.
+func validateChoices(current []*consentmodels.Document, choices []consentmodels.Choice) error {
+	if len(choices) == 0 {
+		return errors.New("no consent choices", "Invalid Consent", errcode.ErrInvalidInput)
+	}
+	documents := make(map[uuid.UUID]*consentmodels.Document, len(current))
+	for _, document := range current {
+		documents[document.ID] = document
+	}
+	seen := make(map[uuid.UUID]bool, len(choices))
+	for _, choice := range choices {
+		document, ok := documents[choice.DocumentID]
+		if !ok {
+			return errors.New("consent document is not current: "+choice.DocumentID.String(), "Invalid Consent", errcode.ErrInvalidInput)
+		}
+		if seen[choice.DocumentID] {
+			return errors.New("duplicate consent choice: "+choice.DocumentID.String(), "Invalid Consent", errcode.ErrInvalidInput)
+		}
+		seen[choice.DocumentID] = true
+		if document.Mandatory && !choice.Accepted {
+			return errors.New("mandatory consent document cannot be declined: "+choice.DocumentID.String(), "Invalid Consent", errcode.ErrInvalidInput)
+		}
+	}
+	return nil
+}
+
+// missingMandatory returns the current mandatory documents whose latest choice, in the history followed by the
+// new choices, is not an acceptance.
+func missingMandatory(current []*consentmodels.Document, history []*consentmodels.Consent, choices []consentmodels.Choice) []*consentmodels.Document {
+	accepted := make(map[uuid.UUID]bool)
+	for _, consent := range history {
+		accepted[consent.DocumentID] = consent.Accepted
+	}
+	for _, choice := range choices {
+		accepted[choice.DocumentID] = choice.Accepted
+	}
+	missing := make([]*consentmodels.Document, 0)
+	for _, document := range current {
+		if document.Mandatory && !accepted[document.ID] {
+			missing = append(missing, document)
+		}
+	}
+	return missing
+}
+
+func validKind(kind consentdocument.Kind) bool {
+	return consentdocument.KindValidator(kind) == nil
+}
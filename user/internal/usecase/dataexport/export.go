@@ -0,0 +1,313 @@
+package dataexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	blobinfra "mandacode.com/accounts/user/internal/infra/blob"
+	dataexportmodels "mandacode.com/accounts/user/internal/models/dataexport"
+	authrepo "mandacode.com/accounts/user/internal/repository/auth"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	maileventrepo "mandacode.com/accounts/user/internal/repository/mailevent"
+	profilerepo "mandacode.com/accounts/user/internal/repository/profile"
+	"mandacode.com/accounts/user/internal/util"
+)
+
+// ProcessResult summarizes a run of the export worker.
+type ProcessResult struct {
+	Completed int // Exports built and mailed
+	Retried   int // Exports which failed and are retried in a later run
+	Failed    int // Exports given up after too many failures
+	Expired   int // Archives deleted after their download link expired
+}
+
+// sentEmail is a verification mail sent to a user, as written to their export.
+type sentEmail struct {
+	ID     uuid.UUID `json:"id"`
+	Email  string    `json:"email"`
+	SentAt time.Time `json:"sent_at"`
+}
+
+type ExportUsecase struct {
+	exportRepo     *dbrepo.DataExportRepository
+	userRepo       *dbrepo.UserRepository
+	sentEmailRepo  *dbrepo.SentEmailRepository
+	consentRepo    *dbrepo.ConsentRepository
+	authRepo       *authrepo.AuthRepository
+	profileRepo    *profilerepo.ProfileRepository
+	store          blobinfra.Store
+	txManager      *dbrepo.TxManager
+	mailEvent      *maileventrepo.MailEventEmitter
+	tokenGenerator *util.RandomStringGenerator
+	downloadLink   string
+	linkTTL        time.Duration
+	batchSize      int
+	maxAttempts    int
+	logger         *zap.Logger
+}
+
+// NewExportUsecase creates a new ExportUsecase which builds up to batchSize exports per run, and gives an
+// export up after maxAttempts failures.
+//
+// downloadLink is the page downloading exports, which receives the download token in the token query
+// parameter. An archive can be downloaded for linkTTL after it is built, and is deleted afterwards.
+func NewExportUsecase(
+	exportRepo *dbrepo.DataExportRepository,
+	userRepo *dbrepo.UserRepository,
+	sentEmailRepo *dbrepo.SentEmailRepository,
+	consentRepo *dbrepo.ConsentRepository,
+	authRepo *authrepo.AuthRepository,
+	profileRepo *profilerepo.ProfileRepository,
+	store blobinfra.Store,
+	txManager *dbrepo.TxManager,
+	mailEvent *maileventrepo.MailEventEmitter,
+	downloadLink string,
+	linkTTL time.Duration,
+	batchSize int,
+	maxAttempts int,
+	logger *zap.Logger,
+) *ExportUsecase {
+	return &ExportUsecase{
+		exportRepo:     exportRepo,
+		userRepo:       userRepo,
+		sentEmailRepo:  sentEmailRepo,
+		consentRepo:    consentRepo,
+		authRepo:       authRepo,
+		profileRepo:    profileRepo,
+		store:          store,
+		txManager:      txManager,
+		mailEvent:      mailEvent,
+		tokenGenerator: util.NewRandomStringGenerator(32),
+		downloadLink:   downloadLink,
+		linkTTL:        linkTTL,
+		batchSize:      batchSize,
+		maxAttempts:    maxAttempts,
+		logger:         logger,
+	}
+}
+
+// RequestExport records a request of a user to export their data. The export is built by the export worker,
+// which mails the user a download link.
+func (u *ExportUsecase) RequestExport(ctx context.Context, userID uuid.UUID) (*dataexportmodels.SecureDataExport, error) {
+	user, err := u.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Email == nil {
+		return nil, errors.New("email address of the user is unknown", "Data Export Unavailable", errcode.ErrInvalidInput)
+	}
+	pending, err := u.exportRepo.HasPendingDataExport(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, errors.New("an export of the user is already pending", "Data Export Already Requested", errcode.ErrConflict)
+	}
+	return u.exportRepo.CreateDataExport(ctx, userID)
+}
+
+// ListExports lists the exports of a user, newest first.
+func (u *ExportUsecase) ListExports(ctx context.Context, userID uuid.UUID) ([]*dataexportmodels.SecureDataExport, error) {
+	return u.exportRepo.ListDataExportsByUserID(ctx, userID)
+}
+
+// OpenDownload opens the archive of the export whose download link carries the token. The caller closes it.
+func (u *ExportUsecase) OpenDownload(ctx context.Context, token string) (io.ReadCloser, *dataexportmodels.SecureDataExport, error) {
+	export, err := u.exportRepo.GetDownloadableDataExport(ctx, hashToken(token))
+	if err != nil {
+		return nil, nil, err
+	}
+	if export.ObjectKey == nil {
+		return nil, nil, errors.New("completed export has no archive", "Not Found", errcode.ErrNotFound)
+	}
+	archive, err := u.store.Open(ctx, *export.ObjectKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return archive, export, nil
+}
+
+// ProcessExports builds the pending exports and mails their download links, then deletes the archives whose
+// link expired.
+//
+// A failure to build one export does not stop the run; the export is retried in a later run. The result is
+// returned even if the run failed, counting what was done until then.
+func (u *ExportUsecase) ProcessExports(ctx context.Context) (*ProcessResult, error) {
+	result := &ProcessResult{}
+	exports, err := u.exportRepo.ListPendingDataExports(ctx, u.batchSize)
+	if err != nil {
+		return result, err
+	}
+
+	for _, export := range exports {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := u.build(ctx, export); err != nil {
+			u.logger.Error("failed to build data export",
+				zap.String("export_id", export.ID.String()),
+				zap.String("user_id", export.UserID.String()),
+				zap.Error(err),
+			)
+			// Recorded outside of ctx, which may be why the export failed
+			failed, recordErr := u.exportRepo.RecordFailure(context.Background(), export.ID, err.Error(), u.maxAttempts)
+			if recordErr != nil {
+				u.logger.Error("failed to record data export failure", zap.String("export_id", export.ID.String()), zap.Error(recordErr))
+			}
+			if failed {
+				result.Failed++
+			} else {
+				result.Retried++
+			}
+			continue
+		}
+		result.Completed++
+	}
+
+	result.Expired, err = u.expire(ctx)
+	return result, err
+}
+
+// build assembles the archive of an export, stores it, then records the export as completed along with the
+// mail carrying its download link, in one transaction.
+func (u *ExportUsecase) build(ctx context.Context, export *dataexportmodels.SecureDataExport) error {
+	user, err := u.userRepo.GetUserByID(ctx, export.UserID)
+	if err != nil {
+		return err
+	}
+	if user.Email == nil {
+		return errors.New("email address of the user is unknown", "Data Export Unavailable", errcode.ErrInvalidInput)
+	}
+	sent, err := u.sentEmailRepo.GetSentEmailsByUserID(ctx, export.UserID)
+	if err != nil {
+		return err
+	}
+	sentEmails := make([]sentEmail, 0, len(sent))
+	for _, s := range sent {
+		sentEmails = append(sentEmails, sentEmail{ID: s.ID, Email: s.Email, SentAt: s.SentAt})
+	}
+	consents, err := u.consentRepo.ListConsentsByUserID(ctx, export.UserID)
+	if err != nil {
+		return err
+	}
+	authData, err := u.authRepo.ExportUserData(ctx, export.UserID)
+	if err != nil {
+		return err
+	}
+	profileData, err := u.profileRepo.ExportUserData(ctx, export.UserID)
+	if err != nil {
+		return err
+	}
+
+	archive, err := buildArchive([]archiveFile{
+		{name: "user.json", value: user},
+		{name: "sent_emails.json", value: sentEmails},
+		{name: "consents.json", value: consents},
+		{name: "auth.json", value: authData},
+		{name: "profile.json", value: profileData},
+	})
+	if err != nil {
+		return err
+	}
+
+	token, err := u.tokenGenerator.Generate()
+	if err != nil {
+		return errors.New(err.Error(), "Failed to generate download token", errcode.ErrInternalFailure)
+	}
+	link, err := url.Parse(u.downloadLink)
+	if err != nil {
+		return errors.New(err.Error(), "Invalid download link", errcode.ErrInternalFailure)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	objectKey := "exports/" + export.ID.String() + ".zip"
+	if err := u.store.Put(ctx, objectKey, bytes.NewReader(archive)); err != nil {
+		return err
+	}
+	expiresAt := time.Now().Add(u.linkTTL)
+	err = u.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.exportRepo.CompleteDataExport(ctx, export.ID, objectKey, hashToken(token), expiresAt); err != nil {
+			return err
+		}
+		return u.mailEvent.SendDataExportReadyMail(ctx, maileventrepo.DataExportReady{
+			Email:        *user.Email,
+			DownloadLink: link.String(),
+			ExpiresAt:    expiresAt,
+		})
+	})
+	if err != nil {
+		_ = u.store.Delete(context.Background(), objectKey)
+		return err
+	}
+	return nil
+}
+
+// expire deletes the archives of up to batchSize exports whose download link expired, returning how many were
+// deleted.
+func (u *ExportUsecase) expire(ctx context.Context) (int, error) {
+	exports, err := u.exportRepo.ListExpiredDataExports(ctx, time.Now(), u.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	expired := 0
+	for _, export := range exports {
+		if export.ObjectKey != nil {
+			if err := u.store.Delete(ctx, *export.ObjectKey); err != nil {
+				return expired, err
+			}
+		}
+		if err := u.exportRepo.ExpireDataExport(ctx, export.ID); err != nil {
+			return expired, err
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// archiveFile is a file of an export archive, holding the JSON encoded value.
+type archiveFile struct {
+	name  string
+	value any
+}
+
+// buildArchive returns a ZIP archive of the files.
+func buildArchive(files []archiveFile) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for _, f := range files {
+		data, err := json.MarshalIndent(f.value, "", "  ")
+		if err != nil {
+			return nil, errors.New(err.Error(), "Failed to encode "+f.name, errcode.ErrInternalFailure)
+		}
+		file, err := writer.Create(f.name)
+		if err != nil {
+			return nil, errors.New(err.Error(), "Failed to build export archive", errcode.ErrInternalFailure)
+		}
+		if _, err := file.Write(data); err != nil {
+			return nil, errors.New(err.Error(), "Failed to build export archive", errcode.ErrInternalFailure)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, errors.New(err.Error(), "Failed to build export archive", errcode.ErrInternalFailure)
+	}
+	return buf.Bytes(), nil
+}
+
+// hashToken returns the SHA-256 hash of a download token, as stored in the database.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,254 @@
+package emailchange
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	emailchangemodels "mandacode.com/accounts/user/internal/models/emailchange"
+	authrepo "mandacode.com/accounts/user/internal/repository/auth"
+	authrepodto "mandacode.com/accounts/user/internal/repository/auth/dto"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	maileventrepo "mandacode.com/accounts/user/internal/repository/mailevent"
+	profilerepo "mandacode.com/accounts/user/internal/repository/profile"
+	profilerepodto "mandacode.com/accounts/user/internal/repository/profile/dto"
+	usereventrepo "mandacode.com/accounts/user/internal/repository/userevent"
+	"mandacode.com/accounts/user/internal/usecase/signup"
+	"mandacode.com/accounts/user/internal/util"
+)
+
+type EmailChangeUsecase struct {
+	userRepo        *dbrepo.UserRepository
+	emailChangeRepo *dbrepo.EmailChangeRepository
+	authRepo        *authrepo.AuthRepository
+	profileRepo     *profilerepo.ProfileRepository
+	verifyEmail     *signup.VerifyEmailUsecase
+	txManager       *dbrepo.TxManager
+	userEvent       *usereventrepo.UserEventEmitter
+	mailEvent       *maileventrepo.MailEventEmitter
+	tokenGenerator  *util.RandomStringGenerator
+	undoLink        string
+	undoTTL         time.Duration
+}
+
+// NewEmailChangeUsecase creates a new EmailChangeUsecase.
+//
+// undoLink is the page undoing email changes, which receives the undo token in the token query parameter. A
+// change can be undone for undoTTL after it is confirmed.
+func NewEmailChangeUsecase(
+	userRepo *dbrepo.UserRepository,
+	emailChangeRepo *dbrepo.EmailChangeRepository,
+	authRepo *authrepo.AuthRepository,
+	profileRepo *profilerepo.ProfileRepository,
+	verifyEmail *signup.VerifyEmailUsecase,
+	txManager *dbrepo.TxManager,
+	userEvent *usereventrepo.UserEventEmitter,
+	mailEvent *maileventrepo.MailEventEmitter,
+	undoLink string,
+	undoTTL time.Duration,
+) *EmailChangeUsecase {
+	return &EmailChangeUsecase{
+		userRepo:        userRepo,
+		emailChangeRepo: emailChangeRepo,
+		authRepo:        authRepo,
+		profileRepo:     profileRepo,
+		verifyEmail:     verifyEmail,
+		txManager:       txManager,
+		userEvent:       userEvent,
+		mailEvent:       mailEvent,
+		tokenGenerator:  util.NewRandomStringGenerator(32),
+		undoLink:        undoLink,
+		undoTTL:         undoTTL,
+	}
+}
+
+// RequestEmailChange records a change of the email address of the user, and sends a link confirming it to the
+// new address. The new address must not belong to another user; as it may be taken before the change is
+// confirmed, the auth service checks it again when the change is applied.
+func (u *EmailChangeUsecase) RequestEmailChange(ctx context.Context, userID uuid.UUID, newEmail string) (*emailchangemodels.SecureEmailChange, error) {
+	user, err := u.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Email == nil {
+		return nil, errors.New("email address of the user is unknown", "Email Change Unavailable", errcode.ErrInvalidInput)
+	}
+	if strings.EqualFold(*user.Email, newEmail) {
+		return nil, errors.New("new email address is the current one", "Same Email", errcode.ErrInvalidInput)
+	}
+	available, err := u.authRepo.IsEmailAvailable(ctx, newEmail)
+	if err != nil {
+		return nil, err
+	}
+	if !available {
+		return nil, errors.New("new email address is already in use", "Email Already In Use", errcode.ErrConflict)
+	}
+
+	change, err := u.emailChangeRepo.CreateEmailChange(ctx, userID, *user.Email, newEmail)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.verifyEmail.SendEmailChangeVerification(ctx, userID, newEmail); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// ConfirmEmailChange applies the change verified by the token sent to the new address, and sends a notice with
+// a link undoing the change to the old address.
+//
+// The email address is updated in the auth and profile services first, then in the user service along with
+// the user event and the notice. If a later update fails, the earlier ones are reverted.
+func (u *EmailChangeUsecase) ConfirmEmailChange(ctx context.Context, token string) (*emailchangemodels.SecureEmailChange, error) {
+	userID, newEmail, err := u.verifyEmail.VerifyEmailChangeToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	change, err := u.emailChangeRepo.GetPendingEmailChange(ctx, userID, newEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	undoToken, err := u.tokenGenerator.Generate()
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to generate undo token", errcode.ErrInternalFailure)
+	}
+	undoLink, err := linkWithToken(u.undoLink, undoToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.applyEmail(ctx, userID, newEmail, change.OldEmail); err != nil {
+		return nil, err
+	}
+	var confirmed *emailchangemodels.SecureEmailChange
+	err = u.txManager.WithTx(ctx, func(ctx context.Context) error {
+		user, err := u.userRepo.UpdateEmail(ctx, userID, newEmail)
+		if err != nil {
+			return err
+		}
+		undoExpiresAt := time.Now().Add(u.undoTTL)
+		if confirmed, err = u.emailChangeRepo.ConfirmEmailChange(ctx, change.ID, hashToken(undoToken), undoExpiresAt); err != nil {
+			return err
+		}
+		if err := u.userEvent.EmitUserEmailChangedEvent(ctx, userID, newEmail, false, user.SyncCode, user.SyncVersion); err != nil {
+			return err
+		}
+		return u.mailEvent.SendEmailChangedMail(ctx, maileventrepo.EmailChanged{
+			Email:         change.OldEmail,
+			NewEmail:      newEmail,
+			UndoLink:      undoLink,
+			UndoExpiresAt: undoExpiresAt,
+		})
+	})
+	if err != nil {
+		u.revertEmail(ctx, userID, change.OldEmail)
+		return nil, err
+	}
+	return confirmed, nil
+}
+
+// UndoEmailChange restores the old email address of a confirmed change, from the link sent to the old address.
+//
+// The change can only be undone while the new address is still the current one.
+func (u *EmailChangeUsecase) UndoEmailChange(ctx context.Context, undoToken string) error {
+	change, err := u.emailChangeRepo.GetUndoableEmailChange(ctx, hashToken(undoToken))
+	if err != nil {
+		return err
+	}
+	user, err := u.userRepo.GetUserByID(ctx, change.UserID)
+	if err != nil {
+		return err
+	}
+	if user.Email == nil || *user.Email != change.NewEmail {
+		return errors.New("email address changed again since", "Email Changed Again", errcode.ErrConflict)
+	}
+
+	if err := u.applyEmail(ctx, change.UserID, change.OldEmail, change.NewEmail); err != nil {
+		return err
+	}
+	err = u.txManager.WithTx(ctx, func(ctx context.Context) error {
+		user, err := u.userRepo.UpdateEmail(ctx, change.UserID, change.OldEmail)
+		if err != nil {
+			return err
+		}
+		if err := u.emailChangeRepo.UndoEmailChange(ctx, change.ID); err != nil {
+			return err
+		}
+		return u.userEvent.EmitUserEmailChangedEvent(ctx, change.UserID, change.OldEmail, true, user.SyncCode, user.SyncVersion)
+	})
+	if err != nil {
+		u.revertEmail(ctx, change.UserID, change.NewEmail)
+		return err
+	}
+	return nil
+}
+
+// applyEmail sets the email address of the user in the auth and profile services, restoring the previous one
+// in the auth service if the profile service fails.
+func (u *EmailChangeUsecase) applyEmail(ctx context.Context, userID uuid.UUID, email string, previous string) error {
+	if err := u.updateAuthEmail(ctx, userID, email); err != nil {
+		return err
+	}
+	if err := u.updateProfileEmail(ctx, userID, email); err != nil {
+		_ = u.updateAuthEmail(ctx, userID, previous)
+		return err
+	}
+	return nil
+}
+
+// revertEmail restores the email address of the user in the auth and profile services, after the user service
+// failed to apply a change.
+//
+// It is best effort: the user service keeps the address it had, which is the one to set again if it fails.
+func (u *EmailChangeUsecase) revertEmail(ctx context.Context, userID uuid.UUID, email string) {
+	_ = u.updateAuthEmail(ctx, userID, email)
+	_ = u.updateProfileEmail(ctx, userID, email)
+}
+
+func (u *EmailChangeUsecase) updateAuthEmail(ctx context.Context, userID uuid.UUID, email string) error {
+	if _, err := u.authRepo.UpdateLocalUserEmail(ctx, &authrepodto.UpdateLocalUserEmailRequest{
+		UserID:   userID,
+		NewEmail: email,
+	}); err != nil {
+		if errors.Is(err, errcode.ErrConflict) {
+			return err
+		}
+		return errors.Upgrade(err, "Failed to update email address", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+func (u *EmailChangeUsecase) updateProfileEmail(ctx context.Context, userID uuid.UUID, email string) error {
+	if _, err := u.profileRepo.UpdateEmail(ctx, &profilerepodto.UpdateEmailRequest{
+		UserID: userID,
+		Email:  email,
+	}); err != nil {
+		return errors.Upgrade(err, "Failed to update profile email address", errcode.ErrInternalFailure)
+	}
+	return nil
+}
+
+// linkWithToken returns the link with the token in its token query parameter.
+func linkWithToken(link string, token string) (string, error) {
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return "", errors.New(err.Error(), "Invalid undo link", errcode.ErrInternalFailure)
+	}
+	query := parsed.Query()
+	query.Set("token", token)
+	parsed.RawQuery = query.Encode()
+	return parsed.String(), nil
+}
+
+// hashToken returns the SHA-256 hash of an undo token, as stored in the database.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -5,22 +5,38 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	entuser "mandacode.com/accounts/user/ent/user"
+	auditmodels "mandacode.com/accounts/user/internal/models/audit"
 	usermodels "mandacode.com/accounts/user/internal/models/user"
+	auditeventrepo "mandacode.com/accounts/user/internal/repository/auditevent"
 	dbrepo "mandacode.com/accounts/user/internal/repository/database"
 	usereventrepo "mandacode.com/accounts/user/internal/repository/userevent"
 )
 
+const (
+	defaultListLimit = 50
+	maxListLimit     = 200
+)
+
 type AdminManageUsecase struct {
 	userRepo     *dbrepo.UserRepository
+	orgRepo      *dbrepo.OrganizationRepository
+	txManager    *dbrepo.TxManager
 	eventEmitter *usereventrepo.UserEventEmitter
+	auditEmitter *auditeventrepo.AuditEventEmitter
 	deleteDelay  time.Duration
 }
 
 // NewAdminManageUsecase creates a new ManageUsecase with the provided repositories.
-func NewAdminManageUsecase(userRepo *dbrepo.UserRepository, eventEmitter *usereventrepo.UserEventEmitter) *AdminManageUsecase {
+func NewAdminManageUsecase(userRepo *dbrepo.UserRepository, orgRepo *dbrepo.OrganizationRepository, txManager *dbrepo.TxManager, eventEmitter *usereventrepo.UserEventEmitter, auditEmitter *auditeventrepo.AuditEventEmitter) *AdminManageUsecase {
 	return &AdminManageUsecase{
 		userRepo:     userRepo,
+		orgRepo:      orgRepo,
+		txManager:    txManager,
 		eventEmitter: eventEmitter,
+		auditEmitter: auditEmitter,
 		deleteDelay:  24 * time.Hour, // Default delete delay of 24 hours
 	}
 }
@@ -34,76 +50,128 @@ func (m *AdminManageUsecase) GetUserByID(ctx context.Context, id uuid.UUID) (*us
 	return user, nil
 }
 
-// ArchiveUser archives a user by their ID.
-func (m *AdminManageUsecase) ArchiveUser(ctx context.Context, id uuid.UUID) (*usermodels.SecureUser, error) {
-	user, err := m.userRepo.ArchiveUser(ctx, id, m.deleteDelay)
-	if err != nil {
-		return nil, err
-	}
-
-	// Emit an event for archiving the user
-	if err := m.eventEmitter.EmitUserArchivedEvent(ctx, user.ID, user.SyncCode); err != nil {
-		return nil, err
+// ListUsers retrieves a page of the users matching the filter, along with the total count of matching users.
+//
+// Non-positive limits select the default page size, and limits are capped at maxListLimit.
+func (m *AdminManageUsecase) ListUsers(ctx context.Context, filter usermodels.UserFilter) ([]*usermodels.SecureUser, int, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListLimit
 	}
+	filter.Limit = min(filter.Limit, maxListLimit)
+	filter.Offset = max(filter.Offset, 0)
+	return m.userRepo.ListUsers(ctx, filter)
+}
 
-	return user, nil
+// ArchiveUser archives a user by their ID.
+func (m *AdminManageUsecase) ArchiveUser(ctx context.Context, id uuid.UUID) (*usermodels.SecureUser, error) {
+	return updateAndEmit(ctx, m.txManager, m.audited(auditmodels.ActionUserArchive, id, func(ctx context.Context) (*usermodels.SecureUser, error) {
+		return m.userRepo.ArchiveUser(ctx, id, m.deleteDelay)
+	}), func(ctx context.Context, user *usermodels.SecureUser) error {
+		// Emit an event for archiving the user
+		return m.eventEmitter.EmitUserArchivedEvent(ctx, user.ID, user.SyncCode, user.SyncVersion)
+	})
 }
 
-// RestoreUser restores an archived user by their ID.
+// RestoreUser restores an archived user by their ID, as long as their deletion delay has yet to pass.
 func (m *AdminManageUsecase) RestoreUser(ctx context.Context, id uuid.UUID) (*usermodels.SecureUser, error) {
-	user, err := m.userRepo.RestoreUser(ctx, id)
-	if err != nil {
-		return nil, err
-	}
-
-	// Emit an event for restoring the user
-	if err := m.eventEmitter.EmitUserRestoredEvent(ctx, user.ID, user.SyncCode); err != nil {
-		return nil, err
-	}
-
-	return user, nil
+	return updateAndEmit(ctx, m.txManager, m.audited(auditmodels.ActionUserRestore, id, func(ctx context.Context) (*usermodels.SecureUser, error) {
+		return m.userRepo.RestoreUser(ctx, id)
+	}), func(ctx context.Context, user *usermodels.SecureUser) error {
+		// Emit an event for restoring the user
+		return m.eventEmitter.EmitUserRestoredEvent(ctx, user.ID, user.SyncCode, user.SyncVersion)
+	})
 }
 
 // DeleteUser deletes a user by their ID.
 func (m *AdminManageUsecase) DeleteUser(ctx context.Context, id uuid.UUID) error {
-	if err := m.userRepo.DeleteUser(ctx, id); err != nil {
-		return err
-	}
-
-	// Emit a user deletion event
-	if err := m.eventEmitter.EmitUserDeletedEvent(ctx, id); err != nil {
-		return err
-	}
-
-	return nil
+	return m.txManager.WithTx(ctx, func(ctx context.Context) error {
+		user, err := m.userRepo.GetUserByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		// Hand over or delete the organizations the user leaves without owners
+		if err := m.orgRepo.RemoveUserMemberships(ctx, id); err != nil {
+			return err
+		}
+		if err := m.userRepo.DeleteUser(ctx, id); err != nil {
+			return err
+		}
+		if err := m.auditEmitter.Emit(ctx, auditmodels.ActionUserDelete, auditmodels.TargetTypeUser, id.String(), user, nil); err != nil {
+			return err
+		}
+
+		// Emit a user deletion event
+		return m.eventEmitter.EmitUserDeletedEvent(ctx, id)
+	})
 }
 
 // BlockUser blocks a user by their ID.
 func (m *AdminManageUsecase) BlockUser(ctx context.Context, id uuid.UUID) (*usermodels.SecureUser, error) {
-	user, err := m.userRepo.BlockUser(ctx, id, true)
-	if err != nil {
-		return nil, err
-	}
+	return updateAndEmit(ctx, m.txManager, m.audited(auditmodels.ActionUserBlock, id, func(ctx context.Context) (*usermodels.SecureUser, error) {
+		return m.userRepo.BlockUser(ctx, id, true)
+	}), func(ctx context.Context, user *usermodels.SecureUser) error {
+		// Emit a user blocked event
+		return m.eventEmitter.EmitUserBlockedEvent(ctx, user.ID, user.SyncCode, user.SyncVersion)
+	})
+}
 
-	// Emit a user blocked event
-	if err := m.eventEmitter.EmitUserBlockedEvent(ctx, user.ID, user.SyncCode); err != nil {
-		return nil, err
-	}
+// ActivateUser activates a user by their ID. Minors whose guardian has yet to consent cannot be activated.
+func (m *AdminManageUsecase) ActivateUser(ctx context.Context, id uuid.UUID) (*usermodels.SecureUser, error) {
+	return m.setActive(ctx, id, true)
+}
 
-	return user, nil
+// DeactivateUser deactivates a user by their ID. Inactive users cannot sign in.
+func (m *AdminManageUsecase) DeactivateUser(ctx context.Context, id uuid.UUID) (*usermodels.SecureUser, error) {
+	return m.setActive(ctx, id, false)
+}
+
+func (m *AdminManageUsecase) setActive(ctx context.Context, id uuid.UUID, isActive bool) (*usermodels.SecureUser, error) {
+	action := auditmodels.ActionUserDeactivate
+	if isActive {
+		action = auditmodels.ActionUserActivate
+	}
+	return updateAndEmit(ctx, m.txManager, m.audited(action, id, func(ctx context.Context) (*usermodels.SecureUser, error) {
+		if isActive {
+			user, err := m.userRepo.GetUserByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if user.GuardianConsent == entuser.GuardianConsentPending {
+				return nil, errors.New("guardian consent is pending", "Conflict", errcode.ErrConflict)
+			}
+		}
+		return m.userRepo.UpdateIsActive(ctx, id, isActive)
+	}), func(ctx context.Context, user *usermodels.SecureUser) error {
+		// Emit a user active changed event
+		return m.eventEmitter.EmitUserActiveChangedEvent(ctx, user.ID, user.IsActive, user.SyncCode, user.SyncVersion)
+	})
 }
 
 // UnblockUser unblocks a user by their ID.
 func (m *AdminManageUsecase) UnblockUser(ctx context.Context, id uuid.UUID) (*usermodels.SecureUser, error) {
-	user, err := m.userRepo.BlockUser(ctx, id, false)
-	if err != nil {
-		return nil, err
-	}
+	return updateAndEmit(ctx, m.txManager, m.audited(auditmodels.ActionUserUnblock, id, func(ctx context.Context) (*usermodels.SecureUser, error) {
+		return m.userRepo.BlockUser(ctx, id, false)
+	}), func(ctx context.Context, user *usermodels.SecureUser) error {
+		// Emit a user unblocked event
+		return m.eventEmitter.EmitUserUnblockedEvent(ctx, user.ID, user.SyncCode, user.SyncVersion)
+	})
+}
 
-	// Emit a user unblocked event
-	if err := m.eventEmitter.EmitUserUnblockedEvent(ctx, user.ID, user.SyncCode); err != nil {
-		return nil, err
+// audited wraps an update of a user so that it also emits the audit event of the action, with the user before and
+// after the update.
+func (m *AdminManageUsecase) audited(action string, id uuid.UUID, update func(ctx context.Context) (*usermodels.SecureUser, error)) func(ctx context.Context) (*usermodels.SecureUser, error) {
+	return func(ctx context.Context) (*usermodels.SecureUser, error) {
+		before, err := m.userRepo.GetUserByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		after, err := update(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.auditEmitter.Emit(ctx, action, auditmodels.TargetTypeUser, id.String(), before, after); err != nil {
+			return nil, err
+		}
+		return after, nil
 	}
-
-	return user, nil
 }
@@ -0,0 +1,31 @@
+package manage
+
+import (
+	"context"
+
+	usermodels "mandacode.com/accounts/user/internal/models/user"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+)
+
+// updateAndEmit applies an update to a user and emits its event in a single transaction, so that the event is
+// published if and only if the update is committed.
+func updateAndEmit(
+	ctx context.Context,
+	txManager *dbrepo.TxManager,
+	update func(ctx context.Context) (*usermodels.SecureUser, error),
+	emit func(ctx context.Context, user *usermodels.SecureUser) error,
+) (*usermodels.SecureUser, error) {
+	var user *usermodels.SecureUser
+	err := txManager.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		user, err = update(ctx)
+		if err != nil {
+			return err
+		}
+		return emit(ctx, user)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
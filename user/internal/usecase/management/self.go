@@ -13,15 +13,17 @@ import (
 
 type SelfManageUsecase struct {
 	userRepo      *dbrepo.UserRepository
+	txManager     *dbrepo.TxManager
 	eventEmitter  *usereventrepo.UserEventEmitter
 	deleteDelay   time.Duration
 	codeGenerator *util.RandomStringGenerator
 }
 
 // NewManageUsecase creates a new ManageUsecase with the provided repositories.
-func NewSelfManageUsecase(userRepo *dbrepo.UserRepository, eventEmitter *usereventrepo.UserEventEmitter) *SelfManageUsecase {
+func NewSelfManageUsecase(userRepo *dbrepo.UserRepository, txManager *dbrepo.TxManager, eventEmitter *usereventrepo.UserEventEmitter) *SelfManageUsecase {
 	return &SelfManageUsecase{
 		userRepo:      userRepo,
+		txManager:     txManager,
 		eventEmitter:  eventEmitter,
 		deleteDelay:   24 * time.Hour,                    // Default delete delay of 24 hours
 		codeGenerator: util.NewRandomStringGenerator(32), // Default code length of 32 characters
@@ -39,30 +41,20 @@ func (m *SelfManageUsecase) GetUserByID(ctx context.Context, id uuid.UUID) (*use
 
 // ArchiveUser archives a user by their ID.
 func (m *SelfManageUsecase) ArchiveUser(ctx context.Context, id uuid.UUID) (*usermodels.SecureUser, error) {
-	user, err := m.userRepo.ArchiveUser(ctx, id, m.deleteDelay)
-	if err != nil {
-		return nil, err
-	}
-
-	// Emit an event for archiving the user
-	if err := m.eventEmitter.EmitUserArchivedEvent(ctx, user.ID, user.SyncCode); err != nil {
-		return nil, err
-	}
-
-	return user, nil
+	return updateAndEmit(ctx, m.txManager, func(ctx context.Context) (*usermodels.SecureUser, error) {
+		return m.userRepo.ArchiveUser(ctx, id, m.deleteDelay)
+	}, func(ctx context.Context, user *usermodels.SecureUser) error {
+		// Emit an event for archiving the user
+		return m.eventEmitter.EmitUserArchivedEvent(ctx, user.ID, user.SyncCode, user.SyncVersion)
+	})
 }
 
-// RestoreUser restores an archived user by their ID.
+// RestoreUser restores an archived user by their ID, as long as their deletion delay has yet to pass.
 func (m *SelfManageUsecase) RestoreUser(ctx context.Context, id uuid.UUID) (*usermodels.SecureUser, error) {
-	user, err := m.userRepo.RestoreUser(ctx, id)
-	if err != nil {
-		return nil, err
-	}
-
-	// Emit an event for restoring the user
-	if err := m.eventEmitter.EmitUserRestoredEvent(ctx, user.ID, user.SyncCode); err != nil {
-		return nil, err
-	}
-
-	return user, nil
+	return updateAndEmit(ctx, m.txManager, func(ctx context.Context) (*usermodels.SecureUser, error) {
+		return m.userRepo.RestoreUser(ctx, id)
+	}, func(ctx context.Context, user *usermodels.SecureUser) error {
+		// Emit an event for restoring the user
+		return m.eventEmitter.EmitUserRestoredEvent(ctx, user.ID, user.SyncCode, user.SyncVersion)
+	})
 }
@@ -0,0 +1,237 @@
+package organization
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"mandacode.com/accounts/user/ent/membership"
+	orgmodels "mandacode.com/accounts/user/internal/models/organization"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	maileventrepo "mandacode.com/accounts/user/internal/repository/mailevent"
+	"mandacode.com/accounts/user/internal/util"
+)
+
+// slugPattern matches lowercase URL friendly organization slugs, such as "mandacode-team".
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+type OrganizationUsecase struct {
+	orgRepo        *dbrepo.OrganizationRepository
+	mailEvent      *maileventrepo.MailEventEmitter
+	tokenGenerator *util.RandomStringGenerator
+	invitationLink string
+	invitationTTL  time.Duration
+}
+
+// NewOrganizationUsecase creates a new OrganizationUsecase.
+//
+// invitationLink is the page accepting invitations, which receives the invitation token in the token query parameter.
+func NewOrganizationUsecase(orgRepo *dbrepo.OrganizationRepository, mailEvent *maileventrepo.MailEventEmitter, invitationLink string, invitationTTL time.Duration) *OrganizationUsecase {
+	return &OrganizationUsecase{
+		orgRepo:        orgRepo,
+		mailEvent:      mailEvent,
+		tokenGenerator: util.NewRandomStringGenerator(32),
+		invitationLink: invitationLink,
+		invitationTTL:  invitationTTL,
+	}
+}
+
+// CreateOrganization creates an organization owned by the user.
+func (u *OrganizationUsecase) CreateOrganization(ctx context.Context, userID uuid.UUID, name string, slug string) (*orgmodels.SecureOrganization, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return nil, errors.New("organization name must be 1 to 100 characters", "Invalid Name", errcode.ErrInvalidInput)
+	}
+	if len(slug) < 3 || len(slug) > 63 || !slugPattern.MatchString(slug) {
+		return nil, errors.New("organization slug must be 3 to 63 lowercase letters, digits or hyphens", "Invalid Slug", errcode.ErrInvalidInput)
+	}
+	return u.orgRepo.CreateOrganization(ctx, name, slug, userID)
+}
+
+// ListOrganizations retrieves the memberships of the user, along with their organizations.
+func (u *OrganizationUsecase) ListOrganizations(ctx context.Context, userID uuid.UUID) ([]*orgmodels.SecureMembership, error) {
+	return u.orgRepo.ListUserMemberships(ctx, userID)
+}
+
+// GetOrganization retrieves an organization the user is a member of.
+func (u *OrganizationUsecase) GetOrganization(ctx context.Context, userID uuid.UUID, orgID uuid.UUID) (*orgmodels.SecureOrganization, error) {
+	if _, err := u.requireRole(ctx, orgID, userID, orgmodels.RoleMember); err != nil {
+		return nil, err
+	}
+	return u.orgRepo.GetOrganization(ctx, orgID)
+}
+
+// DeleteOrganization deletes an organization owned by the user.
+func (u *OrganizationUsecase) DeleteOrganization(ctx context.Context, userID uuid.UUID, orgID uuid.UUID) error {
+	if _, err := u.requireRole(ctx, orgID, userID, orgmodels.RoleOwner); err != nil {
+		return err
+	}
+	return u.orgRepo.DeleteOrganization(ctx, orgID)
+}
+
+// GetMembership retrieves the membership of a user in an organization. It is how other services, such as
+// auth when minting an organization access token, check the active organization of a user.
+func (u *OrganizationUsecase) GetMembership(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) (*orgmodels.SecureMembership, error) {
+	return u.orgRepo.GetMembership(ctx, orgID, userID)
+}
+
+// ListMembers retrieves the members of an organization the user is a member of.
+func (u *OrganizationUsecase) ListMembers(ctx context.Context, userID uuid.UUID, orgID uuid.UUID) ([]*orgmodels.SecureMembership, error) {
+	if _, err := u.requireRole(ctx, orgID, userID, orgmodels.RoleMember); err != nil {
+		return nil, err
+	}
+	return u.orgRepo.ListMembers(ctx, orgID)
+}
+
+// UpdateMemberRole changes the role of a member. Members can only manage members of lower roles and
+// grant roles up to their own; owners can manage everyone.
+func (u *OrganizationUsecase) UpdateMemberRole(ctx context.Context, userID uuid.UUID, orgID uuid.UUID, memberID uuid.UUID, role membership.Role) (*orgmodels.SecureMembership, error) {
+	actor, err := u.requireRole(ctx, orgID, userID, orgmodels.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	target, err := u.orgRepo.GetMembership(ctx, orgID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if !orgmodels.Outranks(actor.Role, target.Role) || !orgmodels.Covers(actor.Role, role) {
+		return nil, errors.New("insufficient organization role", "Forbidden", errcode.ErrForbidden)
+	}
+	if target.Role == orgmodels.RoleOwner && role != orgmodels.RoleOwner {
+		if err := u.checkNotLastOwner(ctx, orgID); err != nil {
+			return nil, err
+		}
+	}
+	return u.orgRepo.UpdateMemberRole(ctx, orgID, memberID, role)
+}
+
+// RemoveMember removes a member from an organization. Members can always leave, except for the last owner.
+func (u *OrganizationUsecase) RemoveMember(ctx context.Context, userID uuid.UUID, orgID uuid.UUID, memberID uuid.UUID) error {
+	target, err := u.orgRepo.GetMembership(ctx, orgID, memberID)
+	if err != nil {
+		return err
+	}
+	if memberID != userID {
+		actor, err := u.requireRole(ctx, orgID, userID, orgmodels.RoleAdmin)
+		if err != nil {
+			return err
+		}
+		if !orgmodels.Outranks(actor.Role, target.Role) {
+			return errors.New("insufficient organization role", "Forbidden", errcode.ErrForbidden)
+		}
+	}
+	if target.Role == orgmodels.RoleOwner {
+		if err := u.checkNotLastOwner(ctx, orgID); err != nil {
+			return err
+		}
+	}
+	return u.orgRepo.RemoveMember(ctx, orgID, memberID)
+}
+
+// InviteMember emails an invitation to join the organization with a role up to the user's own.
+func (u *OrganizationUsecase) InviteMember(ctx context.Context, userID uuid.UUID, orgID uuid.UUID, email string, role membership.Role) (*orgmodels.SecureInvitation, error) {
+	actor, err := u.requireRole(ctx, orgID, userID, orgmodels.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if !orgmodels.Covers(actor.Role, role) {
+		return nil, errors.New("insufficient organization role", "Forbidden", errcode.ErrForbidden)
+	}
+	org, err := u.orgRepo.GetOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := u.tokenGenerator.Generate()
+	if err != nil {
+		return nil, errors.New(err.Error(), "Failed to generate invitation token", errcode.ErrInternalFailure)
+	}
+	invitation, err := u.orgRepo.CreateInvitation(ctx, orgID, email, role, hashToken(token), userID, time.Now().Add(u.invitationTTL))
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := url.Parse(u.invitationLink)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Invalid invitation link", errcode.ErrInternalFailure)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	if err := u.mailEvent.SendOrganizationInvitationMail(ctx, maileventrepo.OrganizationInvitation{
+		Email:            email,
+		OrganizationName: org.Name,
+		Role:             string(role),
+		InvitationLink:   link.String(),
+		ExpiresAt:        invitation.ExpiresAt,
+	}); err != nil {
+		return nil, errors.Upgrade(err, "Failed to send invitation", errcode.ErrInternalFailure)
+	}
+	return invitation, nil
+}
+
+// ListInvitations retrieves the pending invitations of an organization.
+func (u *OrganizationUsecase) ListInvitations(ctx context.Context, userID uuid.UUID, orgID uuid.UUID) ([]*orgmodels.SecureInvitation, error) {
+	if _, err := u.requireRole(ctx, orgID, userID, orgmodels.RoleAdmin); err != nil {
+		return nil, err
+	}
+	return u.orgRepo.ListInvitations(ctx, orgID)
+}
+
+// RevokeInvitation revokes a pending invitation of an organization.
+func (u *OrganizationUsecase) RevokeInvitation(ctx context.Context, userID uuid.UUID, orgID uuid.UUID, invitationID uuid.UUID) error {
+	if _, err := u.requireRole(ctx, orgID, userID, orgmodels.RoleAdmin); err != nil {
+		return err
+	}
+	return u.orgRepo.DeleteInvitation(ctx, orgID, invitationID)
+}
+
+// AcceptInvitation makes the user a member of the organization of the invitation.
+//
+// The invitation token is only sent to the invited email address, so holding it proves the invitation
+// reached the user.
+func (u *OrganizationUsecase) AcceptInvitation(ctx context.Context, userID uuid.UUID, token string) (*orgmodels.SecureMembership, error) {
+	return u.orgRepo.AcceptInvitation(ctx, hashToken(token), userID)
+}
+
+// requireRole retrieves the membership of the user, which must have at least the role.
+func (u *OrganizationUsecase) requireRole(ctx context.Context, orgID uuid.UUID, userID uuid.UUID, role membership.Role) (*orgmodels.SecureMembership, error) {
+	m, err := u.orgRepo.GetMembership(ctx, orgID, userID)
+	if err != nil {
+		if errors.Is(err, errcode.ErrNotFound) {
+			// Do not reveal organizations to non-members
+			return nil, errors.New("user is not a member of the organization", "Organization not found", errcode.ErrNotFound)
+		}
+		return nil, err
+	}
+	if !orgmodels.Covers(m.Role, role) {
+		return nil, errors.New("insufficient organization role", "Forbidden", errcode.ErrForbidden)
+	}
+	return m, nil
+}
+
+// checkNotLastOwner rejects changes which would leave the organization without owners.
+func (u *OrganizationUsecase) checkNotLastOwner(ctx context.Context, orgID uuid.UUID) error {
+	owners, err := u.orgRepo.CountOwners(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if owners <= 1 {
+		return errors.New("organization must keep an owner", "Last Owner", errcode.ErrConflict)
+	}
+	return nil
+}
+
+// hashToken returns the SHA-256 hash of an invitation token, as stored in the database.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
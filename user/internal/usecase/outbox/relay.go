@@ -0,0 +1,134 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	outboxrepo "mandacode.com/accounts/user/internal/repository/outbox"
+)
+
+const (
+	minBackoff = time.Second
+	maxBackoff = 5 * time.Minute
+)
+
+// RelayResult summarizes a relay run.
+type RelayResult struct {
+	Published    int // Messages published
+	Failed       int // Failed attempts to publish a message, which is retried later
+	DeadLettered int // Messages given up on after their last attempt failed
+}
+
+type RelayUsecase struct {
+	outboxRepo  *dbrepo.OutboxRepository
+	txManager   *dbrepo.TxManager
+	publisher   *outboxrepo.Publisher
+	batchSize   int
+	maxAttempts int
+	logger      *zap.Logger
+}
+
+// NewRelayUsecase creates a new RelayUsecase which reads up to batchSize messages of the outbox per round, and
+// gives up on a message after maxAttempts failed attempts.
+func NewRelayUsecase(outboxRepo *dbrepo.OutboxRepository, txManager *dbrepo.TxManager, publisher *outboxrepo.Publisher, batchSize int, maxAttempts int, logger *zap.Logger) *RelayUsecase {
+	return &RelayUsecase{
+		outboxRepo:  outboxRepo,
+		txManager:   txManager,
+		publisher:   publisher,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		logger:      logger,
+	}
+}
+
+// RelayPending publishes the messages of the outbox in rounds, until no message is due or ctx is done.
+//
+// Each round publishes the oldest message of each key, so that the messages of a user are published one at a
+// time and in order, even when some of them fail. A failed message is retried with an exponential backoff, and
+// holds back the messages after it with the same key. After its last attempt, it is moved to the dead letters
+// and the messages after it are published.
+//
+// Messages are deleted once published. A message published but not deleted is published again, so consumers
+// must tolerate duplicates.
+func (u *RelayUsecase) RelayPending(ctx context.Context) (*RelayResult, error) {
+	result := &RelayResult{}
+	for ctx.Err() == nil {
+		round, err := u.relayRound(ctx)
+		result.Published += round.Published
+		result.Failed += round.Failed
+		result.DeadLettered += round.DeadLettered
+		if err != nil {
+			return result, err
+		}
+		if round.Published == 0 && round.DeadLettered == 0 {
+			break
+		}
+	}
+	return result, nil
+}
+
+// relayRound publishes the oldest message of each key, if it is due.
+func (u *RelayUsecase) relayRound(ctx context.Context) (*RelayResult, error) {
+	result := &RelayResult{}
+	now := time.Now()
+	due, err := u.outboxRepo.ListDue(ctx, now, u.batchSize)
+	if err != nil {
+		return result, err
+	}
+	if len(due) == 0 {
+		return result, nil
+	}
+
+	errs := u.publisher.Publish(ctx, due)
+	publishedIDs := make([]int64, 0, len(due))
+	for i, message := range due {
+		if errs[i] == nil {
+			publishedIDs = append(publishedIDs, message.ID)
+			continue
+		}
+		attempts := message.Attempts + 1
+		if attempts >= u.maxAttempts {
+			u.logger.Error("giving up on outbox message",
+				zap.Int64("id", message.ID),
+				zap.String("topic", message.Topic),
+				zap.Int("attempts", attempts),
+				zap.Error(errs[i]),
+			)
+			err := u.txManager.WithTx(ctx, func(ctx context.Context) error {
+				return u.outboxRepo.DeadLetter(ctx, message.ID, errs[i].Error())
+			})
+			if err != nil {
+				return result, err
+			}
+			result.DeadLettered++
+			continue
+		}
+
+		result.Failed++
+		u.logger.Warn("failed to publish outbox message",
+			zap.Int64("id", message.ID),
+			zap.String("topic", message.Topic),
+			zap.Int("attempts", attempts),
+			zap.Error(errs[i]),
+		)
+		if err := u.outboxRepo.RecordFailure(ctx, message.ID, errs[i].Error(), now.Add(backoff(attempts))); err != nil {
+			return result, err
+		}
+	}
+	if err := u.outboxRepo.DeletePublished(ctx, publishedIDs); err != nil {
+		return result, err
+	}
+	result.Published = len(publishedIDs)
+	return result, nil
+}
+
+// backoff returns the delay before the next attempt to publish a message which failed attempts times.
+func backoff(attempts int) time.Duration {
+	delay := minBackoff
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff)
+}
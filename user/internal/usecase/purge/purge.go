@@ -0,0 +1,98 @@
+package purge
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	usereventrepo "mandacode.com/accounts/user/internal/repository/userevent"
+)
+
+// PurgeResult summarizes a purge run.
+type PurgeResult struct {
+	Purged int // Users deleted
+	Failed int // Users which could not be deleted
+}
+
+type PurgeUsecase struct {
+	userRepo     *dbrepo.UserRepository
+	orgRepo      *dbrepo.OrganizationRepository
+	txManager    *dbrepo.TxManager
+	eventEmitter *usereventrepo.UserEventEmitter
+	batchSize    int
+	logger       *zap.Logger
+}
+
+// NewPurgeUsecase creates a new PurgeUsecase which deletes up to batchSize users per run.
+func NewPurgeUsecase(userRepo *dbrepo.UserRepository, orgRepo *dbrepo.OrganizationRepository, txManager *dbrepo.TxManager, eventEmitter *usereventrepo.UserEventEmitter, batchSize int, logger *zap.Logger) *PurgeUsecase {
+	return &PurgeUsecase{
+		userRepo:     userRepo,
+		orgRepo:      orgRepo,
+		txManager:    txManager,
+		eventEmitter: eventEmitter,
+		batchSize:    batchSize,
+		logger:       logger,
+	}
+}
+
+// PurgeExpiredUsers deletes the archived users whose deletion delay passed, and notifies the services
+// so that they delete the data of these users as well.
+//
+// A failure to delete one user does not stop the run; the user is retried in the next run.
+func (u *PurgeUsecase) PurgeExpiredUsers(ctx context.Context) (*PurgeResult, error) {
+	now := time.Now()
+	ids, err := u.userRepo.ListExpiredUserIDs(ctx, now, u.batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PurgeResult{}
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+		deleted, err := u.purgeUser(ctx, id, now)
+		if err != nil {
+			u.logger.Error("failed to purge user", zap.String("user_id", id.String()), zap.Error(err))
+			result.Failed++
+			continue
+		}
+		if deleted {
+			result.Purged++
+		}
+	}
+	return result, nil
+}
+
+// purgeUser deletes an expired user, reporting whether they were deleted.
+func (u *PurgeUsecase) purgeUser(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	restored := false
+	err := u.txManager.WithTx(ctx, func(ctx context.Context) error {
+		// Hand over or delete the organizations the user leaves without owners. The memberships are
+		// removed before the user, whose deletion would cascade to them.
+		if err := u.orgRepo.RemoveUserMemberships(ctx, id); err != nil {
+			return err
+		}
+		deleted, err := u.userRepo.DeleteExpiredUser(ctx, id, now)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			// The user was restored since they were listed, so they keep their memberships
+			restored = true
+			return errors.New("User was restored", "Conflict", errcode.ErrConflict)
+		}
+		return u.eventEmitter.EmitUserDeletedEvent(ctx, id)
+	})
+	if restored {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
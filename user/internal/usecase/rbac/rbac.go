@@ -0,0 +1,245 @@
+package rbac
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	auditmodels "mandacode.com/accounts/user/internal/models/audit"
+	rolemodels "mandacode.com/accounts/user/internal/models/role"
+	auditeventrepo "mandacode.com/accounts/user/internal/repository/auditevent"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	usereventrepo "mandacode.com/accounts/user/internal/repository/userevent"
+)
+
+type RBACUsecase struct {
+	roleRepo     *dbrepo.RoleRepository
+	userRepo     *dbrepo.UserRepository
+	txManager    *dbrepo.TxManager
+	eventEmitter *usereventrepo.UserEventEmitter
+	auditEmitter *auditeventrepo.AuditEventEmitter
+}
+
+// NewRBACUsecase creates a new RBACUsecase with the provided repositories.
+func NewRBACUsecase(roleRepo *dbrepo.RoleRepository, userRepo *dbrepo.UserRepository, txManager *dbrepo.TxManager, eventEmitter *usereventrepo.UserEventEmitter, auditEmitter *auditeventrepo.AuditEventEmitter) *RBACUsecase {
+	return &RBACUsecase{
+		roleRepo:     roleRepo,
+		userRepo:     userRepo,
+		txManager:    txManager,
+		eventEmitter: eventEmitter,
+		auditEmitter: auditEmitter,
+	}
+}
+
+// validatePermissions checks that roles only grant known permissions.
+func validatePermissions(permissions []string) error {
+	for _, permission := range permissions {
+		if !slices.Contains(rolemodels.Permissions, permission) {
+			return errors.New("unknown permission: "+permission, "Invalid Permission", errcode.ErrInvalidInput)
+		}
+	}
+	return nil
+}
+
+// ListRoles retrieves all roles.
+func (u *RBACUsecase) ListRoles(ctx context.Context) ([]*rolemodels.SecureRole, error) {
+	return u.roleRepo.ListRoles(ctx)
+}
+
+// CreateRole creates a custom role.
+func (u *RBACUsecase) CreateRole(ctx context.Context, name string, description string, permissions []string) (*rolemodels.SecureRole, error) {
+	if err := validatePermissions(permissions); err != nil {
+		return nil, err
+	}
+
+	var role *rolemodels.SecureRole
+	err := u.txManager.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		role, err = u.roleRepo.CreateRole(ctx, name, description, permissions)
+		if err != nil {
+			return err
+		}
+		return u.auditEmitter.Emit(ctx, auditmodels.ActionRoleCreate, auditmodels.TargetTypeRole, role.ID.String(), nil, role)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// UpdateRole updates the description and permissions of a custom role, and notifies the services
+// about the changed permissions of its users.
+func (u *RBACUsecase) UpdateRole(ctx context.Context, id uuid.UUID, description string, permissions []string) (*rolemodels.SecureRole, error) {
+	if err := validatePermissions(permissions); err != nil {
+		return nil, err
+	}
+
+	var role *rolemodels.SecureRole
+	err := u.txManager.WithTx(ctx, func(ctx context.Context) error {
+		before, err := u.customRole(ctx, id)
+		if err != nil {
+			return err
+		}
+		role, err = u.roleRepo.UpdateRole(ctx, id, description, permissions)
+		if err != nil {
+			return err
+		}
+		if err := u.auditEmitter.Emit(ctx, auditmodels.ActionRoleUpdate, auditmodels.TargetTypeRole, id.String(), before, role); err != nil {
+			return err
+		}
+
+		userIDs, err := u.roleRepo.ListRoleUserIDs(ctx, id)
+		if err != nil {
+			return err
+		}
+		return u.emitRolesChanged(ctx, userIDs...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// DeleteRole deletes a custom role, revoking it from its users.
+func (u *RBACUsecase) DeleteRole(ctx context.Context, id uuid.UUID) error {
+	return u.txManager.WithTx(ctx, func(ctx context.Context) error {
+		before, err := u.customRole(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		userIDs, err := u.roleRepo.ListRoleUserIDs(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := u.roleRepo.DeleteRole(ctx, id); err != nil {
+			return err
+		}
+		if err := u.auditEmitter.Emit(ctx, auditmodels.ActionRoleDelete, auditmodels.TargetTypeRole, id.String(), before, nil); err != nil {
+			return err
+		}
+		return u.emitRolesChanged(ctx, userIDs...)
+	})
+}
+
+// GetUserRoles retrieves the roles of a user, including the implicit user role.
+func (u *RBACUsecase) GetUserRoles(ctx context.Context, userID uuid.UUID) (*rolemodels.UserRoles, error) {
+	roles, err := u.roleRepo.ListUserRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	userRole, err := u.roleRepo.GetRoleByName(ctx, rolemodels.RoleUser)
+	if err != nil {
+		if !errors.Is(err, errcode.ErrNotFound) {
+			return nil, err
+		}
+	} else {
+		roles = append([]*rolemodels.SecureRole{userRole}, roles...)
+	}
+	return rolemodels.NewUserRoles(userID, roles), nil
+}
+
+// HasPermission reports whether the roles of a user grant the permission.
+func (u *RBACUsecase) HasPermission(ctx context.Context, userID uuid.UUID, permission string) (bool, error) {
+	roles, err := u.GetUserRoles(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return roles.HasPermission(permission), nil
+}
+
+// AssignRole assigns the role to a user and notifies the services.
+//
+// assignedBy identifies the admin assigning the role, for auditing purposes.
+func (u *RBACUsecase) AssignRole(ctx context.Context, userID uuid.UUID, roleName string, assignedBy string) (*rolemodels.UserRoles, error) {
+	role, err := u.assignableRole(ctx, roleName)
+	if err != nil {
+		return nil, err
+	}
+	return u.changeUserRoles(ctx, auditmodels.ActionRoleAssign, userID, func(ctx context.Context) error {
+		return u.roleRepo.AssignRole(ctx, userID, role.ID, assignedBy)
+	})
+}
+
+// RevokeRole revokes the role from a user and notifies the services.
+func (u *RBACUsecase) RevokeRole(ctx context.Context, userID uuid.UUID, roleName string) (*rolemodels.UserRoles, error) {
+	role, err := u.assignableRole(ctx, roleName)
+	if err != nil {
+		return nil, err
+	}
+	return u.changeUserRoles(ctx, auditmodels.ActionRoleRevoke, userID, func(ctx context.Context) error {
+		return u.roleRepo.RevokeRole(ctx, userID, role.ID)
+	})
+}
+
+// changeUserRoles applies a change to the roles of a user, audits it and notifies the services, in a single
+// transaction.
+func (u *RBACUsecase) changeUserRoles(ctx context.Context, action string, userID uuid.UUID, change func(ctx context.Context) error) (*rolemodels.UserRoles, error) {
+	var roles *rolemodels.UserRoles
+	err := u.txManager.WithTx(ctx, func(ctx context.Context) error {
+		before, err := u.GetUserRoles(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if err := change(ctx); err != nil {
+			return err
+		}
+		roles, err = u.notifyUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		return u.auditEmitter.Emit(ctx, action, auditmodels.TargetTypeUser, userID.String(), before, roles)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// customRole retrieves a role which can be changed, rejecting changes to system roles.
+func (u *RBACUsecase) customRole(ctx context.Context, id uuid.UUID) (*rolemodels.SecureRole, error) {
+	role, err := u.roleRepo.GetRoleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if role.IsSystem {
+		return nil, errors.New("system roles cannot be changed", "Forbidden", errcode.ErrForbidden)
+	}
+	return role, nil
+}
+
+// assignableRole retrieves a role which can be assigned explicitly.
+func (u *RBACUsecase) assignableRole(ctx context.Context, roleName string) (*rolemodels.SecureRole, error) {
+	if roleName == rolemodels.RoleUser {
+		return nil, errors.New("the user role is held implicitly", "Invalid Role", errcode.ErrInvalidInput)
+	}
+	return u.roleRepo.GetRoleByName(ctx, roleName)
+}
+
+// notifyUser emits the roles of a user after they changed, as a new version of the user.
+func (u *RBACUsecase) notifyUser(ctx context.Context, userID uuid.UUID) (*rolemodels.UserRoles, error) {
+	user, err := u.userRepo.BumpSyncVersion(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := u.GetUserRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.eventEmitter.EmitRolesChangedEvent(ctx, roles, user.SyncVersion); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// emitRolesChanged emits the roles of each user after they changed.
+func (u *RBACUsecase) emitRolesChanged(ctx context.Context, userIDs ...uuid.UUID) error {
+	for _, userID := range userIDs {
+		if _, err := u.notifyUser(ctx, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -4,32 +4,50 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	consentmodels "mandacode.com/accounts/user/internal/models/consent"
 	"mandacode.com/accounts/user/internal/models/provider"
 )
 
+// LocalSignupRequest is the request of a local signup.
+//
+// Users under 14 must give the email address of their guardian, who has to consent to their account.
 type LocalSignupRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email         string                 `json:"email"`
+	Password      string                 `json:"password"`
+	BirthDate     string                 `json:"birth_date" validate:"required,datetime=2006-01-02"`
+	GuardianEmail *string                `json:"guardian_email,omitempty" validate:"omitempty,email"`
+	Consents      []consentmodels.Choice `json:"consents" validate:"required,min=1,dive"`
+	Source        consentmodels.Source   `json:"-"` // Set by the handler from the request
 }
 
 type LocalSignupResponse struct {
-	UserID    uuid.UUID `json:"user_id"`
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"created_at"`
+	UserID                 uuid.UUID `json:"user_id"`
+	Email                  string    `json:"email"`
+	CreatedAt              time.Time `json:"created_at"`
+	GuardianConsentPending bool      `json:"guardian_consent_pending"` // The account is inactive until the guardian consents
 }
 
+// OAuthSignupRequest is the request of an OAuth signup.
+//
+// As for local signups, the birth date is required, and users under 14 must give the email address of their
+// guardian.
 type OAuthSignupRequest struct {
-	Provider    provider.ProviderType `json:"provider"`
-	AccessToken string                `json:"access_token,omitempty"`
+	Provider      provider.ProviderType  `json:"provider"`
+	AccessToken   string                 `json:"access_token,omitempty"`
+	BirthDate     string                 `json:"birth_date" validate:"required,datetime=2006-01-02"`
+	GuardianEmail *string                `json:"guardian_email,omitempty" validate:"omitempty,email"`
+	Consents      []consentmodels.Choice `json:"consents,omitempty" validate:"omitempty,dive"`
+	Source        consentmodels.Source   `json:"-"` // Set by the handler from the request
 }
 
 type OAuthSignupResponse struct {
-	UserID     uuid.UUID             `json:"user_id"`
-	Provider   provider.ProviderType `json:"provider"`
-	ProviderID string                `json:"provider_id"`
-	Email      string                `json:"email"`
-	IsVerified bool                  `json:"is_verified"` // Email verification status
-	CreatedAt  time.Time             `json:"created_at"`
+	UserID                 uuid.UUID             `json:"user_id"`
+	Provider               provider.ProviderType `json:"provider"`
+	ProviderID             string                `json:"provider_id"`
+	Email                  string                `json:"email"`
+	IsVerified             bool                  `json:"is_verified"` // Email verification status
+	CreatedAt              time.Time             `json:"created_at"`
+	GuardianConsentPending bool                  `json:"guardian_consent_pending"` // The account is inactive until the guardian consents
 }
 
 type SendVerificationEmailRequest struct {
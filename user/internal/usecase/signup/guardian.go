@@ -0,0 +1,97 @@
+package signup
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	entuser "mandacode.com/accounts/user/ent/user"
+	auditmodels "mandacode.com/accounts/user/internal/models/audit"
+	usermodels "mandacode.com/accounts/user/internal/models/user"
+	auditeventrepo "mandacode.com/accounts/user/internal/repository/auditevent"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	usereventrepo "mandacode.com/accounts/user/internal/repository/userevent"
+)
+
+// GuardianConsentUsecase collects the consent of the guardians of users under 14, whose accounts are inactive
+// until their guardian consents through the link emailed to them.
+type GuardianConsentUsecase struct {
+	userRepo         *dbrepo.UserRepository
+	verifyEmail      *VerifyEmailUsecase
+	txManager        *dbrepo.TxManager
+	userEventEmitter *usereventrepo.UserEventEmitter
+	auditEmitter     *auditeventrepo.AuditEventEmitter
+}
+
+// NewGuardianConsentUsecase creates a new GuardianConsentUsecase with the provided repositories.
+func NewGuardianConsentUsecase(
+	userRepo *dbrepo.UserRepository,
+	verifyEmail *VerifyEmailUsecase,
+	txManager *dbrepo.TxManager,
+	userEventEmitter *usereventrepo.UserEventEmitter,
+	auditEmitter *auditeventrepo.AuditEventEmitter,
+) *GuardianConsentUsecase {
+	return &GuardianConsentUsecase{
+		userRepo:         userRepo,
+		verifyEmail:      verifyEmail,
+		txManager:        txManager,
+		userEventEmitter: userEventEmitter,
+		auditEmitter:     auditEmitter,
+	}
+}
+
+// RequestConsent emails the consent link to the guardian of a minor whose account is pending their consent.
+func (g *GuardianConsentUsecase) RequestConsent(ctx context.Context, userID uuid.UUID) error {
+	user, err := g.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.GuardianConsent != entuser.GuardianConsentPending || user.GuardianEmail == nil {
+		return errors.New("guardian consent is not pending", "Conflict", errcode.ErrConflict)
+	}
+	if user.Email == nil {
+		return errors.New("email of the user is not known yet", "Conflict", errcode.ErrConflict)
+	}
+	return g.verifyEmail.SendGuardianConsentEmail(ctx, user.ID, *user.GuardianEmail, *user.Email)
+}
+
+// Confirm records the consent of the guardian of a minor from the token of the consent link, and activates
+// the account of the minor.
+//
+// Returns:
+//   - The user after the consent.
+//   - An error if the token is invalid, was not sent to the current guardian of the user, or the consent is not
+//     pending.
+func (g *GuardianConsentUsecase) Confirm(ctx context.Context, token string) (*usermodels.SecureUser, error) {
+	userID, guardianEmail, err := g.verifyEmail.VerifyGuardianConsentToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *usermodels.SecureUser
+	err = g.txManager.WithTx(ctx, func(ctx context.Context) error {
+		user, err := g.userRepo.GetUserByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if user.GuardianEmail == nil || !strings.EqualFold(*user.GuardianEmail, guardianEmail) {
+			return errors.New("guardian consent token was not sent to the guardian of the user", "Invalid Guardian Consent", errcode.ErrInvalidToken)
+		}
+		if updated, err = g.userRepo.GrantGuardianConsent(ctx, userID); err != nil {
+			return err
+		}
+		if err := g.userEventEmitter.EmitUserActiveChangedEvent(ctx, updated.ID, updated.IsActive, updated.SyncCode, updated.SyncVersion); err != nil {
+			return err
+		}
+		if err := g.userEventEmitter.EmitUserMinorStatusChangedEvent(ctx, updated.ID, updated.IsMinor, string(updated.GuardianConsent), updated.SyncCode, updated.SyncVersion); err != nil {
+			return err
+		}
+		return g.auditEmitter.Emit(ctx, auditmodels.ActionGuardianGrant, auditmodels.TargetTypeUser, userID.String(), user, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
@@ -0,0 +1,92 @@
+package signup
+
+import (
+	"context"
+	"time"
+
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+)
+
+// RecoveryResult summarizes a recovery run.
+type RecoveryResult struct {
+	Completed   int // Signups resumed up to completion
+	Compensated int // Signups undone
+	Failed      int // Signups which could be neither completed nor compensated
+	Deleted     int // Finished signups deleted after their retention
+}
+
+type RecoveryUsecase struct {
+	signup    *SingupUsecase
+	sagaRepo  *dbrepo.SignupSagaRepository
+	retention time.Duration
+	batchSize int
+	logger    *zap.Logger
+}
+
+// NewRecoveryUsecase creates a new RecoveryUsecase which recovers up to batchSize signups per run.
+//
+// A running signup is recovered once its lease expired, and a finished signup is deleted once it was not
+// updated for retention.
+func NewRecoveryUsecase(signup *SingupUsecase, sagaRepo *dbrepo.SignupSagaRepository, retention time.Duration, batchSize int, logger *zap.Logger) *RecoveryUsecase {
+	return &RecoveryUsecase{
+		signup:    signup,
+		sagaRepo:  sagaRepo,
+		retention: retention,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+// RecoverStaleSignups resumes or compensates the signups which stopped running halfway, for instance because
+// the replica running them crashed, then deletes the finished signups past their retention.
+//
+// Each signup is claimed before it is recovered, so that it is never run by its original runner and the recovery
+// worker at the same time. A failure to recover one signup does not stop the run; the signup is retried once
+// the lease of the run expires.
+func (u *RecoveryUsecase) RecoverStaleSignups(ctx context.Context) (*RecoveryResult, error) {
+	now := time.Now()
+	sagas, err := u.sagaRepo.ListStaleSignupSagas(ctx, now, u.batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RecoveryResult{}
+	for _, saga := range sagas {
+		if ctx.Err() != nil {
+			break
+		}
+		claimed, err := u.sagaRepo.ClaimSignupSaga(ctx, saga.ID, time.Now().Add(u.signup.leaseTTL))
+		if err != nil {
+			if errors.Is(err, errcode.ErrConflict) {
+				// Finished or renewed since it was listed
+				continue
+			}
+			return nil, err
+		}
+		completed, err := u.signup.Resume(ctx, claimed)
+		if err != nil {
+			u.logger.Error("failed to recover signup",
+				zap.String("saga_id", saga.ID.String()),
+				zap.String("user_id", saga.UserID.String()),
+				zap.Error(err),
+			)
+			result.Failed++
+			continue
+		}
+		if completed {
+			result.Completed++
+		} else {
+			result.Compensated++
+		}
+	}
+
+	deleted, err := u.sagaRepo.DeleteFinishedSignupSagas(ctx, now.Add(-u.retention))
+	if err != nil {
+		return nil, err
+	}
+	result.Deleted = deleted
+	return result, nil
+}
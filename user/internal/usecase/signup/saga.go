@@ -0,0 +1,196 @@
+package signup
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	"mandacode.com/accounts/user/ent/signupsaga"
+	consentmodels "mandacode.com/accounts/user/internal/models/consent"
+	sagamodels "mandacode.com/accounts/user/internal/models/saga"
+	usermodels "mandacode.com/accounts/user/internal/models/user"
+	profilerepodto "mandacode.com/accounts/user/internal/repository/profile/dto"
+)
+
+// sagaStep is a step of a signup, run against another service.
+//
+// The ID of the user, which is generated for each signup, is the idempotency key of the step: the services
+// treat a repeated call for the same user as done.
+type sagaStep struct {
+	step signupsaga.Step
+	// run runs the step, returning the email address of the user if the step learned it.
+	run func(ctx context.Context) (email *string, err error)
+}
+
+// startSaga creates a user along with the record of their signup and their consent choices, in one transaction.
+// The caller holds the lease of the signup, which it renews as each step completes.
+//
+// Minors are created inactive, which is announced along with their minor status, as the services otherwise
+// treat users they have not heard of as active adults.
+//
+// The choices of a signup which is later compensated are kept, as consents are append-only, and the ID of the
+// user is never reused.
+func (s *SingupUsecase) startSaga(ctx context.Context, kind signupsaga.Kind, age *usermodels.Age, choices []consentmodels.Choice, source consentmodels.Source) (*sagamodels.SignupSaga, *usermodels.SecureUser, error) {
+	var saga *sagamodels.SignupSaga
+	var dbUser *usermodels.SecureUser
+	err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		if dbUser, err = s.dbUserRepo.CreateUser(ctx, uuid.New(), age); err != nil {
+			return err
+		}
+		if dbUser.IsMinor {
+			if err := s.userEventEmitter.EmitUserActiveChangedEvent(ctx, dbUser.ID, dbUser.IsActive, dbUser.SyncCode, dbUser.SyncVersion); err != nil {
+				return err
+			}
+			if err := s.userEventEmitter.EmitUserMinorStatusChangedEvent(ctx, dbUser.ID, dbUser.IsMinor, string(dbUser.GuardianConsent), dbUser.SyncCode, dbUser.SyncVersion); err != nil {
+				return err
+			}
+		}
+		if saga, err = s.sagaRepo.CreateSignupSaga(ctx, dbUser.ID, kind, time.Now().Add(s.leaseTTL)); err != nil {
+			return err
+		}
+		if len(choices) == 0 {
+			return nil
+		}
+		return s.consent.Record(ctx, dbUser.ID, choices, source)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return saga, dbUser, nil
+}
+
+// runSaga runs the steps of a signup in order, recording each of them as it completes, then records the signup
+// as completed.
+//
+// When a step fails, the signup is compensated and the error of the step is returned. If the compensation
+// fails too, its error is returned instead, and the recovery worker compensates the signup later.
+//
+// If the lease of the signup was taken over, which happens when a step outlasts it, the signup is left to the
+// new runner and the error is returned without compensating it.
+//
+// Returns:
+//   - Whether the signup was compensated.
+//   - An error if a step or the compensation failed, or the lease was lost.
+func (s *SingupUsecase) runSaga(ctx context.Context, saga *sagamodels.SignupSaga, steps []sagaStep) (bool, error) {
+	for _, step := range steps {
+		email, err := step.run(ctx)
+		if err == nil {
+			err = s.completeStep(ctx, saga, step.step, email)
+			if errors.Is(err, errcode.ErrConflict) {
+				return false, err
+			}
+		}
+		if err != nil {
+			if compErr := s.compensate(ctx, saga, err); compErr != nil {
+				return false, compErr
+			}
+			return true, err
+		}
+	}
+	if err := s.sagaRepo.FinishSignupSaga(ctx, saga, signupsaga.StatusCompleted); err != nil {
+		// The user is fully signed up at this point, so the signup does not fail: the recovery worker records
+		// it as completed once its lease expires.
+		s.logger.Warn("failed to record completed signup",
+			zap.String("saga_id", saga.ID.String()),
+			zap.String("user_id", saga.UserID.String()),
+			zap.Error(err),
+		)
+	}
+	return false, nil
+}
+
+// completeStep records that a step of a signup completed, along with the email address of the user if the step
+// learned it.
+func (s *SingupUsecase) completeStep(ctx context.Context, saga *sagamodels.SignupSaga, step signupsaga.Step, email *string) error {
+	leasedUntil := time.Now().Add(s.leaseTTL)
+	if email == nil {
+		return s.sagaRepo.CompleteStep(ctx, saga, step, nil, leasedUntil)
+	}
+	return s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := s.dbUserRepo.UpdateEmail(ctx, saga.UserID, *email); err != nil {
+			return err
+		}
+		return s.sagaRepo.CompleteStep(ctx, saga, step, email, leasedUntil)
+	})
+}
+
+// compensate undoes a signup which failed: it deletes the user and announces the deletion to the services
+// which may have created data for them, in the same transaction as recording the signup as compensated.
+//
+// If the compensation fails, the failure is recorded along with its cause. A signup whose lease was taken over is
+// not compensated, as recording it fails and rolls the transaction back.
+func (s *SingupUsecase) compensate(ctx context.Context, saga *sagamodels.SignupSaga, cause error) error {
+	err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.dbUserRepo.DeleteUser(ctx, saga.UserID); err != nil && !errors.Is(err, errcode.ErrNotFound) {
+			return err
+		}
+		if err := s.userEventEmitter.EmitUserDeletedEvent(ctx, saga.UserID); err != nil {
+			return err
+		}
+		return s.sagaRepo.FinishSignupSaga(ctx, saga, signupsaga.StatusCompensated)
+	})
+	if err != nil {
+		// Recorded outside of ctx, which may be why the compensation failed
+		_ = s.sagaRepo.RecordFailure(context.Background(), saga.ID, cause.Error())
+		return err
+	}
+	return nil
+}
+
+// profileStep creates the profile of a user.
+func (s *SingupUsecase) profileStep(userID uuid.UUID, email string, syncCode string) sagaStep {
+	return sagaStep{
+		step: signupsaga.StepProfileCreated,
+		run: func(ctx context.Context) (*string, error) {
+			_, err := s.profileRepo.CreateProfileUser(ctx, &profilerepodto.CreateProfileUserRequest{
+				UserID:   userID,
+				Email:    email,
+				SyncCode: syncCode,
+			})
+			return nil, err
+		},
+	}
+}
+
+// Resume completes or compensates a signup which stopped running halfway, reporting whether it completed. The
+// caller must hold the lease of the signup, claimed with SignupSagaRepository.ClaimSignupSaga.
+//
+// The credentials of the user are not kept, so a signup which stopped before the auth step completed can only
+// be compensated.
+func (s *SingupUsecase) Resume(ctx context.Context, saga *sagamodels.SignupSaga) (bool, error) {
+	switch saga.Step {
+	case signupsaga.StepProfileCreated:
+		if err := s.sagaRepo.FinishSignupSaga(ctx, saga, signupsaga.StatusCompleted); err != nil {
+			return false, err
+		}
+		return true, nil
+	case signupsaga.StepAuthCreated:
+		if saga.Email == nil {
+			break
+		}
+		dbUser, err := s.dbUserRepo.GetUserByID(ctx, saga.UserID)
+		if err != nil {
+			if !errors.Is(err, errcode.ErrNotFound) {
+				return false, err
+			}
+			break
+		}
+		compensated, err := s.runSaga(ctx, saga, []sagaStep{s.profileStep(saga.UserID, *saga.Email, dbUser.SyncCode)})
+		if compensated {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	cause := errors.New("signup stopped at step "+saga.Step.String(), "Signup Interrupted", errcode.ErrInternalFailure)
+	if err := s.compensate(ctx, saga, cause); err != nil {
+		return false, err
+	}
+	return false, nil
+}
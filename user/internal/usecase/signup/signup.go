@@ -2,14 +2,21 @@ package signup
 
 import (
 	"context"
+	"strings"
+	"time"
 
-	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	"go.uber.org/zap"
+	"mandacode.com/accounts/user/ent/signupsaga"
+	entuser "mandacode.com/accounts/user/ent/user"
+	usermodels "mandacode.com/accounts/user/internal/models/user"
 	authrepo "mandacode.com/accounts/user/internal/repository/auth"
 	authrepodto "mandacode.com/accounts/user/internal/repository/auth/dto"
 	dbrepo "mandacode.com/accounts/user/internal/repository/database"
 	profilerepo "mandacode.com/accounts/user/internal/repository/profile"
-	profilerepodto "mandacode.com/accounts/user/internal/repository/profile/dto"
 	usereventrepo "mandacode.com/accounts/user/internal/repository/userevent"
+	"mandacode.com/accounts/user/internal/usecase/consent"
 	signupdto "mandacode.com/accounts/user/internal/usecase/signup/dto"
 )
 
@@ -17,112 +24,185 @@ type SingupUsecase struct {
 	authRepo              *authrepo.AuthRepository
 	profileRepo           *profilerepo.ProfileRepository
 	dbUserRepo            *dbrepo.UserRepository
+	sagaRepo              *dbrepo.SignupSagaRepository
+	txManager             *dbrepo.TxManager
 	userEventEmitter      *usereventrepo.UserEventEmitter
+	consent               *consent.ConsentUsecase
+	leaseTTL              time.Duration
+	logger                *zap.Logger
 	emailVerificationLink string
 }
 
 // NewSignupUsecase creates a new instance of LocalSingupUsecase with the provided repositories.
+//
+// A signup is leased to its runner for leaseTTL after it starts and after each of its steps, past which the
+// recovery worker takes it over.
 func NewSignupUsecase(
 	authRepo *authrepo.AuthRepository,
 	profileRepo *profilerepo.ProfileRepository,
 	dbUserRepo *dbrepo.UserRepository,
+	sagaRepo *dbrepo.SignupSagaRepository,
+	txManager *dbrepo.TxManager,
 	userEventEmitter *usereventrepo.UserEventEmitter,
+	consent *consent.ConsentUsecase,
+	leaseTTL time.Duration,
+	logger *zap.Logger,
 ) *SingupUsecase {
 	return &SingupUsecase{
 		authRepo:         authRepo,
 		profileRepo:      profileRepo,
 		dbUserRepo:       dbUserRepo,
+		sagaRepo:         sagaRepo,
+		txManager:        txManager,
 		userEventEmitter: userEventEmitter,
+		consent:          consent,
+		leaseTTL:         leaseTTL,
+		logger:           logger,
 	}
 }
 
-// LocalSignup performs the signup process for a new user.
+// LocalSignup performs the signup process for a new user, who must accept the current mandatory consent
+// documents.
+//
+// The account of a user under 14 is inactive until their guardian consents to it. The auth service is given
+// the status along with the account, so that the account cannot sign in before the user events arrive.
 func (s *SingupUsecase) LocalSignup(ctx context.Context, req *signupdto.LocalSignupRequest) (*signupdto.LocalSignupResponse, error) {
-	userID := uuid.New()
-	dbUser, err := s.dbUserRepo.CreateUser(ctx, userID)
+	age, err := resolveAge(req.BirthDate, req.GuardianEmail, &req.Email)
 	if err != nil {
 		return nil, err
 	}
-
-	_, err = s.authRepo.CreateLocalUser(ctx, &authrepodto.CreateLocalUserRequest{
-		UserID:   userID,
-		Email:    req.Email,
-		Password: req.Password,
-	})
+	if err := s.consent.ValidateSignupChoices(ctx, req.Consents); err != nil {
+		return nil, err
+	}
+	saga, dbUser, err := s.startSaga(ctx, signupsaga.KindLocal, age, req.Consents, req.Source)
 	if err != nil {
-		if dbErr := s.dbUserRepo.DeleteUser(ctx, userID); dbErr != nil {
-			return nil, dbErr
-		}
-		if err := s.userEventEmitter.EmitUserDeletedEvent(ctx, userID); err != nil {
-			return nil, err
-		}
 		return nil, err
 	}
-	_, err = s.profileRepo.CreateProfileUser(ctx, &profilerepodto.CreateProfileUserRequest{
-		UserID:   userID,
-		Email:    req.Email,
-		SyncCode: dbUser.SyncCode,
+
+	_, err = s.runSaga(ctx, saga, []sagaStep{
+		{
+			step: signupsaga.StepAuthCreated,
+			run: func(ctx context.Context) (*string, error) {
+				_, err := s.authRepo.CreateLocalUser(ctx, &authrepodto.CreateLocalUserRequest{
+					UserID:   saga.UserID,
+					Email:    req.Email,
+					Password: req.Password,
+					IsActive: &dbUser.IsActive,
+				})
+				return &req.Email, err
+			},
+		},
+		s.profileStep(saga.UserID, req.Email, dbUser.SyncCode),
 	})
 	if err != nil {
-		if dbErr := s.dbUserRepo.DeleteUser(ctx, userID); dbErr != nil {
-			return nil, dbErr
-		}
-		if err := s.userEventEmitter.EmitUserDeletedEvent(ctx, userID); err != nil {
-			return nil, err
-		}
 		return nil, err
 	}
 
 	return &signupdto.LocalSignupResponse{
-		UserID:    userID,
-		Email:     req.Email,
-		CreatedAt: dbUser.CreatedAt,
+		UserID:                 saga.UserID,
+		Email:                  req.Email,
+		CreatedAt:              dbUser.CreatedAt,
+		GuardianConsentPending: dbUser.GuardianConsent == entuser.GuardianConsentPending,
 	}, nil
 }
 
 // OAuthSignup performs the OAuth signup process for a new user.
+//
+// OAuth signups happen during the first sign in of the user, before the user could be shown the consent
+// documents, so the choices are optional. If they are given, they must accept the current mandatory documents.
+// Otherwise, the auth service collects them before issuing any token, as for users who have to consent again.
+//
+// Providers do not share the birth date, so the auth service collects it, along with the email address of
+// the guardian of users under 14, before signing up the user. As for local signups, the account of a user
+// under 14 is inactive until their guardian consents to it.
 func (s *SingupUsecase) OAuthSignup(ctx context.Context, req *signupdto.OAuthSignupRequest) (*signupdto.OAuthSignupResponse, error) {
-	userID := uuid.New()
-	dbUser, err := s.dbUserRepo.CreateUser(ctx, userID)
+	age, err := resolveAge(req.BirthDate, req.GuardianEmail, nil)
 	if err != nil {
 		return nil, err
 	}
-
-	authUser, err := s.authRepo.CreateOAuthUser(ctx, &authrepodto.CreateOAuthUserRequest{
-		UserID:      userID,
-		Provider:    req.Provider,
-		AccessToken: &req.AccessToken,
-	})
-	if err != nil {
-		if dbErr := s.dbUserRepo.DeleteUser(ctx, userID); dbErr != nil {
-			return nil, dbErr
-		}
-		if err := s.userEventEmitter.EmitUserDeletedEvent(ctx, userID); err != nil {
+	if len(req.Consents) > 0 {
+		if err := s.consent.ValidateSignupChoices(ctx, req.Consents); err != nil {
 			return nil, err
 		}
+	}
+	saga, dbUser, err := s.startSaga(ctx, signupsaga.KindOauth, age, req.Consents, req.Source)
+	if err != nil {
 		return nil, err
 	}
-	_, err = s.profileRepo.CreateProfileUser(ctx, &profilerepodto.CreateProfileUserRequest{
-		UserID:   userID,
-		Email:    authUser.Email,
-		SyncCode: dbUser.SyncCode,
+
+	// The email address of the user is only known once the auth step completed
+	var authUser *authrepodto.CreateOAuthUserResponse
+	_, err = s.runSaga(ctx, saga, []sagaStep{
+		{
+			step: signupsaga.StepAuthCreated,
+			run: func(ctx context.Context) (*string, error) {
+				var err error
+				authUser, err = s.authRepo.CreateOAuthUser(ctx, &authrepodto.CreateOAuthUserRequest{
+					UserID:      saga.UserID,
+					Provider:    req.Provider,
+					AccessToken: &req.AccessToken,
+					IsActive:    &dbUser.IsActive,
+				})
+				if err != nil {
+					return nil, err
+				}
+				return &authUser.Email, nil
+			},
+		},
+		{
+			step: signupsaga.StepProfileCreated,
+			run: func(ctx context.Context) (*string, error) {
+				return s.profileStep(saga.UserID, authUser.Email, dbUser.SyncCode).run(ctx)
+			},
+		},
 	})
 	if err != nil {
-		if dbErr := s.dbUserRepo.DeleteUser(ctx, userID); dbErr != nil {
-			return nil, dbErr
-		}
-		if err := s.userEventEmitter.EmitUserDeletedEvent(ctx, userID); err != nil {
-			return nil, err
-		}
 		return nil, err
 	}
 
 	return &signupdto.OAuthSignupResponse{
-		UserID:     userID,
-		Provider:   req.Provider,
-		Email:      authUser.Email,
-		ProviderID: authUser.ProviderID,
-		IsVerified: authUser.IsVerified,
-		CreatedAt:  dbUser.CreatedAt,
+		UserID:                 saga.UserID,
+		Provider:               req.Provider,
+		Email:                  authUser.Email,
+		ProviderID:             authUser.ProviderID,
+		IsVerified:             authUser.IsVerified,
+		CreatedAt:              dbUser.CreatedAt,
+		GuardianConsentPending: dbUser.GuardianConsent == entuser.GuardianConsentPending,
+	}, nil
+}
+
+// resolveAge checks the birth date given at signup, and whether the user needs the consent of a guardian.
+//
+// Parameters:
+//   - birthDate: The birth date of the user, in the BirthDateLayout.
+//   - guardianEmail: The email address of the guardian, required for users under 14.
+//   - email: The email address of the user, if known, which the guardian's must differ from.
+//
+// Returns:
+//   - The age of the user.
+//   - An error if the birth date is invalid, or the guardian email is missing.
+func resolveAge(birthDate string, guardianEmail *string, email *string) (*usermodels.Age, error) {
+	born, err := time.Parse(usermodels.BirthDateLayout, birthDate)
+	if err != nil {
+		return nil, errors.New(err.Error(), "Invalid Birth Date", errcode.ErrInvalidInput)
+	}
+	now := time.Now()
+	if born.After(now) || born.Year() < 1900 {
+		return nil, errors.New("birth date is out of range: "+birthDate, "Invalid Birth Date", errcode.ErrInvalidInput)
+	}
+
+	if usermodels.AgeAt(born, now) >= usermodels.GuardianConsentAge {
+		return &usermodels.Age{BirthDate: born}, nil
+	}
+	if guardianEmail == nil || *guardianEmail == "" {
+		return nil, errors.New("guardian email is required for users under 14", "Guardian Email Required", errcode.ErrInvalidInput)
+	}
+	if email != nil && strings.EqualFold(*guardianEmail, *email) {
+		return nil, errors.New("guardian email is the email of the user", "Invalid Guardian Email", errcode.ErrInvalidInput)
+	}
+	return &usermodels.Age{
+		BirthDate:     born,
+		IsMinor:       true,
+		GuardianEmail: guardianEmail,
 	}, nil
 }
@@ -13,6 +13,7 @@ import (
 	dbrepo "mandacode.com/accounts/user/internal/repository/database"
 	maileventrepo "mandacode.com/accounts/user/internal/repository/mailevent"
 	tokenrepo "mandacode.com/accounts/user/internal/repository/token"
+	tokenrepodto "mandacode.com/accounts/user/internal/repository/token/dto"
 )
 
 type VerifyEmailUsecase struct {
@@ -22,11 +23,19 @@ type VerifyEmailUsecase struct {
 	mailEventEmitter      *maileventrepo.MailEventEmitter
 	codeManager           *coderepo.CodeManager
 	emailVerificationLink string
+	changeCodeManager     *coderepo.CodeManager
+	emailChangeLink       string
+	guardianCodeManager   *coderepo.CodeManager
+	guardianConsentLink   string
 	maxSentEmails         int
 	maxSentEmailsDuration time.Duration
 }
 
 // NewVerifyEmailUsecase creates a new instance of VerifyEmailUsecase with the provided repositories.
+//
+// The links verifying the address of a new user, the new address of a user changing it, and the consent of the
+// guardian of a minor are issued with the codes of codeManager, changeCodeManager and guardianCodeManager
+// respectively, so that no kind of link verifies another.
 func NewVerifyEmailUsecase(
 	sentEmailRepo *dbrepo.SentEmailRepository,
 	authRepo *authrepo.AuthRepository,
@@ -34,6 +43,10 @@ func NewVerifyEmailUsecase(
 	mailEventEmitter *maileventrepo.MailEventEmitter,
 	codeManager *coderepo.CodeManager,
 	emailVerificationLink string,
+	changeCodeManager *coderepo.CodeManager,
+	emailChangeLink string,
+	guardianCodeManager *coderepo.CodeManager,
+	guardianConsentLink string,
 	maxSentEmails int,
 	maxSentEmailsDuration time.Duration,
 ) *VerifyEmailUsecase {
@@ -44,6 +57,10 @@ func NewVerifyEmailUsecase(
 		mailEventEmitter:      mailEventEmitter,
 		codeManager:           codeManager,
 		emailVerificationLink: emailVerificationLink,
+		changeCodeManager:     changeCodeManager,
+		emailChangeLink:       emailChangeLink,
+		guardianCodeManager:   guardianCodeManager,
+		guardianConsentLink:   guardianConsentLink,
 		maxSentEmails:         maxSentEmails,
 		maxSentEmailsDuration: maxSentEmailsDuration,
 	}
@@ -64,30 +81,78 @@ func (v *VerifyEmailUsecase) canSendVerificationEmail(ctx context.Context, userI
 
 // SendVerificationEmail sends a verification email to the user.
 func (v *VerifyEmailUsecase) SendVerificationEmail(ctx context.Context, userID uuid.UUID, email string) error {
+	return v.sendVerification(ctx, v.codeManager, v.emailVerificationLink, userID, email)
+}
+
+// SendEmailChangeVerification sends a verification email to the new address of a user changing it.
+func (v *VerifyEmailUsecase) SendEmailChangeVerification(ctx context.Context, userID uuid.UUID, newEmail string) error {
+	return v.sendVerification(ctx, v.changeCodeManager, v.emailChangeLink, userID, newEmail)
+}
+
+// SendGuardianConsentEmail sends the link consenting to the account of a minor to their guardian.
+//
+// The link is bound to the address of the guardian, so that it cannot confirm the consent for another one.
+func (v *VerifyEmailUsecase) SendGuardianConsentEmail(ctx context.Context, userID uuid.UUID, guardianEmail string, minorEmail string) error {
+	token, expiresAt, err := v.issueToken(ctx, v.guardianCodeManager, userID, guardianEmail)
+	if err != nil {
+		return err
+	}
+	if err := v.mailEventEmitter.SendGuardianConsentRequestMail(ctx, maileventrepo.GuardianConsentRequest{
+		Email:       guardianEmail,
+		MinorEmail:  minorEmail,
+		ConsentLink: v.guardianConsentLink + "?token=" + token,
+		ExpiresAt:   time.Unix(expiresAt, 0),
+	}); err != nil {
+		return errors.Upgrade(err, "Failed to send guardian consent request mail", errcode.ErrInternalFailure)
+	}
+	return v.recordSentEmail(ctx, userID, guardianEmail)
+}
+
+// sendVerification sends a link verifying the email address, issued with a code of codeManager.
+func (v *VerifyEmailUsecase) sendVerification(ctx context.Context, codeManager *coderepo.CodeManager, link string, userID uuid.UUID, email string) error {
+	token, _, err := v.issueToken(ctx, codeManager, userID, email)
+	if err != nil {
+		return err
+	}
+	verificationLink := link + "?token=" + token
+	if err := v.mailEventEmitter.SendEmailVerificationMail(ctx, email, verificationLink); err != nil {
+		return errors.Upgrade(err, "Failed to send email verification mail", errcode.ErrInternalFailure)
+	}
+	return v.recordSentEmail(ctx, userID, email)
+}
+
+// issueToken issues a code of codeManager and the token carrying it to the email address, unless the user
+// reached the maximum number of sent emails.
+//
+// Returns:
+//   - The token.
+//   - The Unix time at which the token expires.
+//   - An error if too many emails were sent, or the token could not be issued.
+func (v *VerifyEmailUsecase) issueToken(ctx context.Context, codeManager *coderepo.CodeManager, userID uuid.UUID, email string) (string, int64, error) {
 	// Check if Verification Email can be sent
 	canSend, err := v.canSendVerificationEmail(ctx, userID)
 	if err != nil {
-		return errors.Upgrade(err, "Failed to check if verification email can be sent", errcode.ErrInternalFailure)
+		return "", 0, errors.Upgrade(err, "Failed to check if verification email can be sent", errcode.ErrInternalFailure)
 	}
 	if !canSend {
-		return errors.New("Too many verification emails sent", "You have reached the maximum number of verification emails sent", errcode.ErrTooManyRequests)
+		return "", 0, errors.New("Too many verification emails sent", "You have reached the maximum number of verification emails sent", errcode.ErrTooManyRequests)
 	}
 
 	// Issue a verification code and generate a token
-	code, err := v.codeManager.IssueCode(ctx, userID)
+	code, err := codeManager.IssueCode(ctx, userID)
 	if err != nil {
-		return errors.Upgrade(err, "Failed to issue verification code", errcode.ErrInternalFailure)
+		return "", 0, errors.Upgrade(err, "Failed to issue verification code", errcode.ErrInternalFailure)
 	}
-	token, _, err := v.mailTokenRepo.GenerateEmailVerificationToken(ctx, userID, email, code)
+	token, expiresAt, err := v.mailTokenRepo.GenerateEmailVerificationToken(ctx, userID, email, code)
 	if err != nil {
-		return errors.Upgrade(err, "Failed to generate email verification token", errcode.ErrInternalFailure)
+		return "", 0, errors.Upgrade(err, "Failed to generate email verification token", errcode.ErrInternalFailure)
 	}
-	verificationLink := v.emailVerificationLink + "?token=" + token
-	if err := v.mailEventEmitter.SendEmailVerificationMail(email, verificationLink); err != nil {
-		return errors.Upgrade(err, "Failed to send email verification mail", errcode.ErrInternalFailure)
-	}
-	_, err = v.sentEmailRepo.CreateSentEmail(ctx, userID, email)
-	if err != nil {
+	return token, expiresAt, nil
+}
+
+// recordSentEmail records an email sent to the user, counting towards the maximum number of sent emails.
+func (v *VerifyEmailUsecase) recordSentEmail(ctx context.Context, userID uuid.UUID, email string) error {
+	if _, err := v.sentEmailRepo.CreateSentEmail(ctx, userID, email); err != nil {
 		return errors.Upgrade(err, "Failed to create sent email record", errcode.ErrInternalFailure)
 	}
 	return nil
@@ -95,24 +160,11 @@ func (v *VerifyEmailUsecase) SendVerificationEmail(ctx context.Context, userID u
 
 // VerifyEmailToken verifies the email verification token and returns the user ID if valid.
 func (v *VerifyEmailUsecase) VerifyEmailToken(ctx context.Context, token string) (uuid.UUID, error) {
-	result, err := v.mailTokenRepo.VerifyEmailVerificationToken(ctx, token)
-	if err != nil {
-		return uuid.Nil, errors.Upgrade(err, "Failed to verify email verification token", errcode.ErrInternalFailure)
-	}
-	if result == nil {
-		return uuid.Nil, errors.New("Invalid email verification token", "The provided email verification token is invalid", errcode.ErrInvalidToken)
-	}
-	userID, err := uuid.Parse(result.UserID.String())
-	if err != nil {
-		return uuid.Nil, errors.Upgrade(err, "Invalid user ID in response", errcode.ErrInternalFailure)
-	}
-	valid, err := v.codeManager.ValidateCode(ctx, userID, result.Code)
+	result, err := v.verifyToken(ctx, v.codeManager, token)
 	if err != nil {
-		return uuid.Nil, errors.Upgrade(err, "Failed to validate verification code", errcode.ErrInternalFailure)
-	}
-	if !valid {
-		return uuid.Nil, errors.New("Invalid verification code", "The provided verification code is invalid", errcode.ErrInvalidToken)
+		return uuid.Nil, err
 	}
+	userID := result.UserID
 
 	_, err = v.authRepo.UpdateLocalUserEmailVerification(ctx, &authrepodto.UpdateEmailVerificationRequest{
 		UserID:   userID,
@@ -124,3 +176,52 @@ func (v *VerifyEmailUsecase) VerifyEmailToken(ctx context.Context, token string)
 
 	return userID, nil
 }
+
+// VerifyEmailChangeToken verifies the token sent to the new address of a user changing it.
+//
+// Returns:
+//   - The ID of the user.
+//   - The new email address of the user, which the token verified.
+//   - An error if the token is invalid or was already used.
+func (v *VerifyEmailUsecase) VerifyEmailChangeToken(ctx context.Context, token string) (uuid.UUID, string, error) {
+	result, err := v.verifyToken(ctx, v.changeCodeManager, token)
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+	return result.UserID, result.Email, nil
+}
+
+// VerifyGuardianConsentToken verifies the token sent to the guardian of a minor.
+//
+// Returns:
+//   - The ID of the minor.
+//   - The email address of the guardian, which the token was sent to.
+//   - An error if the token is invalid or was already used.
+func (v *VerifyEmailUsecase) VerifyGuardianConsentToken(ctx context.Context, token string) (uuid.UUID, string, error) {
+	result, err := v.verifyToken(ctx, v.guardianCodeManager, token)
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+	return result.UserID, result.Email, nil
+}
+
+// verifyToken verifies an email verification token, whose code must have been issued by codeManager.
+//
+// The code is consumed, so that each token is used once.
+func (v *VerifyEmailUsecase) verifyToken(ctx context.Context, codeManager *coderepo.CodeManager, token string) (*tokenrepodto.EmailVerificationResult, error) {
+	result, err := v.mailTokenRepo.VerifyEmailVerificationToken(ctx, token)
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to verify email verification token", errcode.ErrInternalFailure)
+	}
+	if result == nil || !result.Valid {
+		return nil, errors.New("Invalid email verification token", "The provided email verification token is invalid", errcode.ErrInvalidToken)
+	}
+	valid, err := codeManager.ValidateCode(ctx, result.UserID, result.Code)
+	if err != nil {
+		return nil, errors.Upgrade(err, "Failed to validate verification code", errcode.ErrInternalFailure)
+	}
+	if !valid {
+		return nil, errors.New("Invalid verification code", "The provided verification code is invalid", errcode.ErrInvalidToken)
+	}
+	return result, nil
+}
@@ -0,0 +1,48 @@
+package userstatus
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	usermodels "mandacode.com/accounts/user/internal/models/user"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	"mandacode.com/accounts/user/internal/usecase/rbac"
+)
+
+type UserStatusUsecase struct {
+	userRepo *dbrepo.UserRepository
+	rbac     *rbac.RBACUsecase
+}
+
+// NewUserStatusUsecase creates a new UserStatusUsecase.
+func NewUserStatusUsecase(userRepo *dbrepo.UserRepository, rbac *rbac.RBACUsecase) *UserStatusUsecase {
+	return &UserStatusUsecase{
+		userRepo: userRepo,
+		rbac:     rbac,
+	}
+}
+
+// ListUserStatuses retrieves the status and roles of a page of users ordered by ID, starting after the given ID
+// if any.
+//
+// The roles are read after the users, so that a change of roles in between is announced by an event with a
+// higher version than the one listed, which the consumers still apply.
+func (u *UserStatusUsecase) ListUserStatuses(ctx context.Context, after *uuid.UUID, limit int) ([]*usermodels.UserStatus, error) {
+	users, err := u.userRepo.ListUsersAfter(ctx, after, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*usermodels.UserStatus, 0, len(users))
+	for _, user := range users {
+		roles, err := u.rbac.GetUserRoles(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, &usermodels.UserStatus{
+			User:  user,
+			Roles: roles,
+		})
+	}
+	return statuses, nil
+}
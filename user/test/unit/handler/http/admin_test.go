@@ -0,0 +1,83 @@
+package httphandlerv1_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	httphandlerv1 "mandacode.com/accounts/user/internal/handler/v1/http"
+	httpmiddleware "mandacode.com/accounts/user/internal/middleware/http"
+	"mandacode.com/accounts/user/internal/usecase/admin"
+)
+
+const (
+	uidHeader          = "X-User-ID"
+	impersonatorHeader = "X-Impersonator-ID"
+	adminKeyHeader     = "X-Admin-Key"
+	adminAPIKey        = "admin-api-key"
+)
+
+type MockAdminHandler struct {
+	adminID uuid.UUID
+	engine  *gin.Engine
+}
+
+// Setup registers the admin routes for a bootstrap admin. The routes are only reached with an invalid user ID,
+// which they reject before using the usecases.
+func (m *MockAdminHandler) Setup(t *testing.T) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	m.adminID = uuid.New()
+	adminUsecase := admin.NewAdminUsecase(adminAPIKey, []uuid.UUID{m.adminID})
+	handler := httphandlerv1.NewAdminHandler(adminUsecase, nil, nil, nil, nil, uidHeader, impersonatorHeader, adminKeyHeader, zap.NewNop())
+
+	m.engine = gin.New()
+	m.engine.Use(httpmiddleware.ErrorHandler(zap.NewNop()))
+	handler.RegisterRoutes(m.engine.Group("/v1/admin"))
+}
+
+func (m *MockAdminHandler) request(headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/v1/admin/users/not-a-uuid", nil)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	recorder := httptest.NewRecorder()
+	m.engine.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestAdminHandler_Authorize(t *testing.T) {
+	t.Run("Authorize_Admin", func(t *testing.T) {
+		mock := &MockAdminHandler{}
+		mock.Setup(t)
+
+		// The admin reaches the route, which rejects the user ID
+		if recorder := mock.request(map[string]string{uidHeader: mock.adminID.String()}); recorder.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, recorder.Code)
+		}
+	})
+
+	t.Run("Authorize_ImpersonatedAdmin", func(t *testing.T) {
+		mock := &MockAdminHandler{}
+		mock.Setup(t)
+
+		// An admin impersonating another admin must not gain their permissions
+		recorder := mock.request(map[string]string{uidHeader: mock.adminID.String(), impersonatorHeader: uuid.NewString()})
+		if recorder.Code != http.StatusForbidden {
+			t.Errorf("expected status %d, got %d", http.StatusForbidden, recorder.Code)
+		}
+	})
+
+	t.Run("Authorize_ImpersonatedWithAPIKey", func(t *testing.T) {
+		mock := &MockAdminHandler{}
+		mock.Setup(t)
+
+		recorder := mock.request(map[string]string{adminKeyHeader: adminAPIKey, impersonatorHeader: uuid.NewString()})
+		if recorder.Code != http.StatusForbidden {
+			t.Errorf("expected status %d, got %d", http.StatusForbidden, recorder.Code)
+		}
+	})
+}
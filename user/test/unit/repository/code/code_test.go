@@ -0,0 +1,70 @@
+package coderepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	coderepo "mandacode.com/accounts/user/internal/repository/code"
+	"mandacode.com/accounts/user/internal/util"
+)
+
+type MockCodeManager struct {
+	store   *miniredis.Miniredis
+	manager *coderepo.CodeManager
+}
+
+func (m *MockCodeManager) Setup(t *testing.T) {
+	t.Helper()
+	m.store = miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: m.store.Addr()})
+	t.Cleanup(func() { client.Close() })
+	m.manager = coderepo.NewCodeManager(util.NewRandomStringGenerator(32), time.Minute, client, "email_verification:")
+}
+
+func TestCodeManager_ValidateCode(t *testing.T) {
+	mock := &MockCodeManager{}
+	mock.Setup(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("ValidateCode_SingleUse", func(t *testing.T) {
+		code, err := mock.manager.IssueCode(ctx, userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		valid, err := mock.manager.ValidateCode(ctx, userID, code)
+		if err != nil || !valid {
+			t.Fatalf("expected the code to be valid, got %v, %v", valid, err)
+		}
+		if mock.store.Exists("email_verification:" + code) {
+			t.Errorf("expected the code to be deleted once validated")
+		}
+
+		valid, err = mock.manager.ValidateCode(ctx, userID, code)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if valid {
+			t.Errorf("expected a validated code to be rejected")
+		}
+	})
+
+	t.Run("ValidateCode_OtherUser", func(t *testing.T) {
+		code, err := mock.manager.IssueCode(ctx, userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if valid, err := mock.manager.ValidateCode(ctx, uuid.New(), code); err == nil || valid {
+			t.Errorf("expected the code of another user to be rejected, got %v, %v", valid, err)
+		}
+		if !mock.store.Exists("email_verification:" + code) {
+			t.Errorf("expected a rejected code to be kept")
+		}
+	})
+}
@@ -0,0 +1,167 @@
+package dbrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/enttest"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+)
+
+type MockOutboxRepository struct {
+	client    *ent.Client
+	repo      *dbrepo.OutboxRepository
+	txManager *dbrepo.TxManager
+}
+
+func (m *MockOutboxRepository) Setup(t *testing.T) {
+	t.Helper()
+	m.client = enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { m.client.Close() })
+	m.repo = dbrepo.NewOutboxRepository(m.client)
+	m.txManager = dbrepo.NewTxManager(m.client)
+}
+
+func (m *MockOutboxRepository) enqueue(t *testing.T, topic string, key string) {
+	t.Helper()
+	if err := m.repo.Enqueue(context.Background(), topic, []byte(key), []byte("value"), nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func dueKeys(t *testing.T, repo *dbrepo.OutboxRepository, now time.Time) []string {
+	t.Helper()
+	due, err := repo.ListDue(context.Background(), now, 10)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	keys := make([]string, 0, len(due))
+	for _, message := range due {
+		keys = append(keys, message.Topic+"/"+string(message.Key))
+	}
+	return keys
+}
+
+func TestOutboxRepository_ListDue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ListDue_OldestOfEachKey", func(t *testing.T) {
+		mock := &MockOutboxRepository{}
+		mock.Setup(t)
+		mock.enqueue(t, "user", "a")
+		mock.enqueue(t, "user", "a")
+		mock.enqueue(t, "user", "b")
+		mock.enqueue(t, "mail", "a")
+
+		due, err := mock.repo.ListDue(ctx, time.Now(), 10)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(due) != 3 {
+			t.Fatalf("expected 3 messages, got %d", len(due))
+		}
+		if due[0].ID != 1 || due[1].ID != 3 || due[2].ID != 4 {
+			t.Errorf("expected messages 1, 3 and 4, got %d, %d and %d", due[0].ID, due[1].ID, due[2].ID)
+		}
+	})
+
+	t.Run("ListDue_HeadNotDue", func(t *testing.T) {
+		mock := &MockOutboxRepository{}
+		mock.Setup(t)
+		mock.enqueue(t, "user", "a")
+		mock.enqueue(t, "user", "a")
+		mock.enqueue(t, "user", "b")
+
+		now := time.Now()
+		if err := mock.repo.RecordFailure(ctx, 1, "unavailable", now.Add(time.Minute)); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		keys := dueKeys(t, mock.repo, now)
+		if len(keys) != 1 || keys[0] != "user/b" {
+			t.Errorf("expected only user/b to be due, got %v", keys)
+		}
+
+		keys = dueKeys(t, mock.repo, now.Add(2*time.Minute))
+		if len(keys) != 2 || keys[0] != "user/a" || keys[1] != "user/b" {
+			t.Errorf("expected user/a and user/b to be due, got %v", keys)
+		}
+	})
+
+	t.Run("ListDue_BeyondLimit", func(t *testing.T) {
+		mock := &MockOutboxRepository{}
+		mock.Setup(t)
+		for range 3 {
+			mock.enqueue(t, "user", "a")
+		}
+		mock.enqueue(t, "user", "b")
+
+		due, err := mock.repo.ListDue(ctx, time.Now(), 1)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(due) != 1 || due[0].ID != 1 {
+			t.Fatalf("expected message 1, got %v", due)
+		}
+
+		if err := mock.repo.DeletePublished(ctx, []int64{1, 2, 3}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		keys := dueKeys(t, mock.repo, time.Now())
+		if len(keys) != 1 || keys[0] != "user/b" {
+			t.Errorf("expected only user/b to be due, got %v", keys)
+		}
+	})
+}
+
+func TestOutboxRepository_DeadLetter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("DeadLetter_ReleasesKey", func(t *testing.T) {
+		mock := &MockOutboxRepository{}
+		mock.Setup(t)
+		mock.enqueue(t, "user", "a")
+		mock.enqueue(t, "user", "a")
+		if err := mock.repo.RecordFailure(ctx, 1, "unavailable", time.Now()); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		err := mock.txManager.WithTx(ctx, func(ctx context.Context) error {
+			return mock.repo.DeadLetter(ctx, 1, "still unavailable")
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		deadLetter, err := mock.client.OutboxDeadLetter.Get(ctx, 1)
+		if err != nil {
+			t.Fatalf("expected the message to be dead lettered, got %v", err)
+		}
+		if deadLetter.Attempts != 2 || deadLetter.LastError != "still unavailable" || string(deadLetter.Key) != "a" {
+			t.Errorf("expected the dead letter to keep the message, got %+v", deadLetter)
+		}
+
+		due, err := mock.repo.ListDue(ctx, time.Now(), 10)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(due) != 1 || due[0].ID != 2 {
+			t.Errorf("expected message 2 to be due, got %v", due)
+		}
+	})
+
+	t.Run("DeadLetter_NotFound", func(t *testing.T) {
+		mock := &MockOutboxRepository{}
+		mock.Setup(t)
+
+		err := mock.txManager.WithTx(ctx, func(ctx context.Context) error {
+			return mock.repo.DeadLetter(ctx, 1, "unavailable")
+		})
+		if err == nil {
+			t.Errorf("expected an error for a missing message")
+		}
+	})
+}
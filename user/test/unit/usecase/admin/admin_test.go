@@ -0,0 +1,45 @@
+package admin_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"mandacode.com/accounts/user/internal/usecase/admin"
+)
+
+func TestAdminUsecase_ValidateAdmin(t *testing.T) {
+	adminID := uuid.New()
+	usecase := admin.NewAdminUsecase("", []uuid.UUID{adminID})
+
+	if !usecase.ValidateAdmin(adminID.String()) {
+		t.Errorf("expected the admin to be valid")
+	}
+	for _, id := range []string{uuid.NewString(), "", "not-a-uuid"} {
+		if usecase.ValidateAdmin(id) {
+			t.Errorf("expected %q not to be an admin", id)
+		}
+	}
+}
+
+func TestAdminUsecase_ValidateAPIKey(t *testing.T) {
+	t.Run("ValidateAPIKey_Configured", func(t *testing.T) {
+		usecase := admin.NewAdminUsecase("backend-key", nil)
+
+		if !usecase.ValidateAPIKey("backend-key") {
+			t.Errorf("expected the admin API key to be valid")
+		}
+		for _, key := range []string{"", "backend", "backend-key2"} {
+			if usecase.ValidateAPIKey(key) {
+				t.Errorf("expected %q not to be the admin API key", key)
+			}
+		}
+	})
+
+	t.Run("ValidateAPIKey_Disabled", func(t *testing.T) {
+		usecase := admin.NewAdminUsecase("", nil)
+
+		if usecase.ValidateAPIKey("") {
+			t.Errorf("expected no key to be valid without an admin API key")
+		}
+	})
+}
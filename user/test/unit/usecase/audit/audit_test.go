@@ -0,0 +1,176 @@
+package audit_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/enttest"
+	auditmodels "mandacode.com/accounts/user/internal/models/audit"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	"mandacode.com/accounts/user/internal/usecase/audit"
+)
+
+type MockAuditUsecase struct {
+	client *ent.Client
+	db     *sql.DB
+	audit  *audit.AuditUsecase
+}
+
+func (m *MockAuditUsecase) Setup(t *testing.T) {
+	t.Helper()
+	dsn := "file:" + t.Name() + "?mode=memory&cache=shared&_fk=1"
+	m.client = enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { m.client.Close() })
+	// The entries cannot be updated through ent, so they are tampered with over a raw connection
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	m.db = db
+	m.audit = audit.NewAuditUsecase(dbrepo.NewAuditRepository(m.client))
+}
+
+// record records an event of the action, and returns it.
+func (m *MockAuditUsecase) record(t *testing.T, action string) auditmodels.Event {
+	t.Helper()
+	event := auditmodels.Event{
+		ID:         uuid.New(),
+		Service:    "user",
+		ActorType:  auditmodels.ActorTypeUser,
+		ActorID:    uuid.NewString(),
+		Action:     action,
+		TargetType: auditmodels.TargetTypeUser,
+		TargetID:   uuid.NewString(),
+		Before:     map[string]any{"is_blocked": false},
+		After:      map[string]any{"is_blocked": true},
+		OccurredAt: time.Now(),
+	}
+	if err := m.audit.Record(context.Background(), event); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return event
+}
+
+func (m *MockAuditUsecase) verify(t *testing.T, fromSeq int64) *auditmodels.ChainVerification {
+	t.Helper()
+	verification, err := m.audit.VerifyChain(context.Background(), fromSeq, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return verification
+}
+
+func TestAuditUsecase_Record(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Record_ChainsEntries", func(t *testing.T) {
+		mock := &MockAuditUsecase{}
+		mock.Setup(t)
+		mock.record(t, "user.block")
+		mock.record(t, "user.unblock")
+
+		entries, err := mock.audit.ListEntries(ctx, auditmodels.EntryFilter{})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(entries) != 2 {
+			t.Fatalf("expected 2 entries, got %d", len(entries))
+		}
+		// Newest first
+		if entries[1].Seq != 1 || entries[1].PrevHash != "" {
+			t.Errorf("expected the first entry to start the chain, got seq %d and previous hash %q", entries[1].Seq, entries[1].PrevHash)
+		}
+		if entries[0].Seq != 2 || entries[0].PrevHash != entries[1].Hash {
+			t.Errorf("expected the second entry to follow the first, got seq %d and previous hash %q", entries[0].Seq, entries[0].PrevHash)
+		}
+	})
+
+	t.Run("Record_SkipsRecordedEvents", func(t *testing.T) {
+		mock := &MockAuditUsecase{}
+		mock.Setup(t)
+		event := mock.record(t, "user.block")
+
+		if err := mock.audit.Record(ctx, event); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if count := mock.client.AuditEntry.Query().CountX(ctx); count != 1 {
+			t.Errorf("expected the event to be recorded once, got %d entries", count)
+		}
+	})
+
+	t.Run("Record_RejectsIncompleteEvents", func(t *testing.T) {
+		mock := &MockAuditUsecase{}
+		mock.Setup(t)
+
+		err := mock.audit.Record(ctx, auditmodels.Event{ID: uuid.New(), Service: "user", OccurredAt: time.Now()})
+		if err == nil {
+			t.Fatal("expected an error, got nil")
+		}
+	})
+}
+
+func TestAuditUsecase_VerifyChain(t *testing.T) {
+	t.Run("VerifyChain_Valid", func(t *testing.T) {
+		mock := &MockAuditUsecase{}
+		mock.Setup(t)
+		for range 3 {
+			mock.record(t, "user.block")
+		}
+
+		verification := mock.verify(t, 1)
+		if !verification.Valid || verification.Checked != 3 || verification.FirstSeq != 1 || verification.LastSeq != 3 {
+			t.Errorf("expected the chain to be valid, got %+v", verification)
+		}
+	})
+
+	t.Run("VerifyChain_FromPosition", func(t *testing.T) {
+		mock := &MockAuditUsecase{}
+		mock.Setup(t)
+		for range 3 {
+			mock.record(t, "user.block")
+		}
+
+		verification := mock.verify(t, 2)
+		if !verification.Valid || verification.Checked != 2 || verification.FirstSeq != 2 {
+			t.Errorf("expected the end of the chain to be valid, got %+v", verification)
+		}
+	})
+
+	t.Run("VerifyChain_DetectsChangedEntry", func(t *testing.T) {
+		mock := &MockAuditUsecase{}
+		mock.Setup(t)
+		for range 3 {
+			mock.record(t, "user.block")
+		}
+		if _, err := mock.db.Exec("UPDATE audit_entries SET action = 'user.unblock' WHERE seq = 2"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		verification := mock.verify(t, 1)
+		if verification.Valid || verification.BrokenSeq == nil || *verification.BrokenSeq != 2 {
+			t.Errorf("expected the chain to break at entry 2, got %+v", verification)
+		}
+	})
+
+	t.Run("VerifyChain_DetectsRemovedEntry", func(t *testing.T) {
+		mock := &MockAuditUsecase{}
+		mock.Setup(t)
+		for range 3 {
+			mock.record(t, "user.block")
+		}
+		if _, err := mock.db.Exec("DELETE FROM audit_entries WHERE seq = 2"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		verification := mock.verify(t, 1)
+		if verification.Valid || verification.BrokenSeq == nil || *verification.BrokenSeq != 2 {
+			t.Errorf("expected the chain to break at entry 2, got %+v", verification)
+		}
+	})
+}
@@ -0,0 +1,208 @@
+package consent_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	_ "github.com/mattn/go-sqlite3"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/consentdocument"
+	"mandacode.com/accounts/user/ent/enttest"
+	consentmodels "mandacode.com/accounts/user/internal/models/consent"
+	auditeventrepo "mandacode.com/accounts/user/internal/repository/auditevent"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	"mandacode.com/accounts/user/internal/usecase/consent"
+	"mandacode.com/accounts/user/internal/util"
+)
+
+type MockConsentUsecase struct {
+	client   *ent.Client
+	userRepo *dbrepo.UserRepository
+	consent  *consent.ConsentUsecase
+}
+
+func (m *MockConsentUsecase) Setup(t *testing.T) {
+	t.Helper()
+	m.client = enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { m.client.Close() })
+	m.userRepo = dbrepo.NewUserRepository(m.client, util.NewRandomStringGenerator(16))
+	auditEmitter := auditeventrepo.NewAuditEventEmitter(dbrepo.NewOutboxRepository(m.client), "audit")
+	m.consent = consent.NewConsentUsecase(dbrepo.NewConsentRepository(m.client), dbrepo.NewTxManager(m.client), auditEmitter)
+}
+
+func (m *MockConsentUsecase) publish(t *testing.T, kind consentdocument.Kind, mandatory bool) *consentmodels.Document {
+	t.Helper()
+	document, err := m.consent.PublishDocument(context.Background(), consentmodels.PublishInput{
+		Kind:       kind,
+		Title:      string(kind),
+		ContentURL: "https://example.com/" + string(kind),
+		Mandatory:  mandatory,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return document
+}
+
+func (m *MockConsentUsecase) createUser(t *testing.T) uuid.UUID {
+	t.Helper()
+	user, err := m.userRepo.CreateUser(context.Background(), uuid.New(), nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return user.ID
+}
+
+func (m *MockConsentUsecase) pending(t *testing.T, userID uuid.UUID) []*consentmodels.Document {
+	t.Helper()
+	documents, err := m.consent.PendingDocuments(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return documents
+}
+
+func accept(documents ...*consentmodels.Document) []consentmodels.Choice {
+	choices := make([]consentmodels.Choice, 0, len(documents))
+	for _, document := range documents {
+		choices = append(choices, consentmodels.Choice{DocumentID: document.ID, Accepted: true})
+	}
+	return choices
+}
+
+func TestConsentUsecase_PublishDocument(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("PublishDocument_NextVersion", func(t *testing.T) {
+		mock := &MockConsentUsecase{}
+		mock.Setup(t)
+
+		first := mock.publish(t, consentdocument.KindTermsOfService, true)
+		second := mock.publish(t, consentdocument.KindTermsOfService, true)
+		privacy := mock.publish(t, consentdocument.KindPrivacyPolicy, true)
+		if first.Version != 1 || second.Version != 2 || privacy.Version != 1 {
+			t.Errorf("expected versions to count per kind, got %d, %d and %d", first.Version, second.Version, privacy.Version)
+		}
+		if count := mock.client.OutboxMessage.Query().CountX(ctx); count != 3 {
+			t.Errorf("expected every publication to be audited, got %d messages", count)
+		}
+	})
+
+	t.Run("PublishDocument_Invalid", func(t *testing.T) {
+		mock := &MockConsentUsecase{}
+		mock.Setup(t)
+
+		_, err := mock.consent.PublishDocument(ctx, consentmodels.PublishInput{Kind: "cookies", Title: "Cookies", ContentURL: "https://example.com"})
+		if !errors.Is(err, errcode.ErrInvalidInput) {
+			t.Errorf("expected an invalid input error for an unknown kind, got %v", err)
+		}
+		_, err = mock.consent.PublishDocument(ctx, consentmodels.PublishInput{Kind: consentdocument.KindMarketing, Title: "Marketing", ContentURL: "https://example.com", Mandatory: true})
+		if !errors.Is(err, errcode.ErrInvalidInput) {
+			t.Errorf("expected an invalid input error for mandatory marketing, got %v", err)
+		}
+	})
+}
+
+func TestConsentUsecase_Record(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Record_InvalidChoices", func(t *testing.T) {
+		mock := &MockConsentUsecase{}
+		mock.Setup(t)
+		outdated := mock.publish(t, consentdocument.KindTermsOfService, true)
+		terms := mock.publish(t, consentdocument.KindTermsOfService, true)
+		marketing := mock.publish(t, consentdocument.KindMarketing, false)
+		userID := mock.createUser(t)
+
+		cases := map[string][]consentmodels.Choice{
+			"no choices":         nil,
+			"outdated document":  accept(outdated),
+			"unknown document":   {{DocumentID: uuid.New(), Accepted: true}},
+			"duplicate choice":   accept(terms, terms),
+			"declined mandatory": {{DocumentID: terms.ID, Accepted: false}, {DocumentID: marketing.ID, Accepted: true}},
+		}
+		for name, choices := range cases {
+			if err := mock.consent.Record(ctx, userID, choices, consentmodels.Source{}); !errors.Is(err, errcode.ErrInvalidInput) {
+				t.Errorf("expected an invalid input error for %s, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("Record_OptionalDeclined", func(t *testing.T) {
+		mock := &MockConsentUsecase{}
+		mock.Setup(t)
+		marketing := mock.publish(t, consentdocument.KindMarketing, false)
+		userID := mock.createUser(t)
+
+		choices := []consentmodels.Choice{{DocumentID: marketing.ID, Accepted: false}}
+		if err := mock.consent.Record(ctx, userID, choices, consentmodels.Source{IP: "192.0.2.1"}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		history, err := mock.consent.History(ctx, userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(history) != 1 || history[0].Accepted || history[0].Kind != consentdocument.KindMarketing || history[0].IP != "192.0.2.1" {
+			t.Errorf("expected the declined marketing consent, got %+v", history)
+		}
+	})
+
+	t.Run("RecordForUser_Audited", func(t *testing.T) {
+		mock := &MockConsentUsecase{}
+		mock.Setup(t)
+		terms := mock.publish(t, consentdocument.KindTermsOfService, true)
+		userID := mock.createUser(t)
+
+		if err := mock.consent.RecordForUser(ctx, userID, accept(terms), consentmodels.Source{}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if count := mock.client.OutboxMessage.Query().CountX(ctx); count != 2 {
+			t.Errorf("expected the choices to be audited after the publication, got %d messages", count)
+		}
+	})
+}
+
+func TestConsentUsecase_PendingDocuments(t *testing.T) {
+	mock := &MockConsentUsecase{}
+	mock.Setup(t)
+	terms := mock.publish(t, consentdocument.KindTermsOfService, true)
+	privacy := mock.publish(t, consentdocument.KindPrivacyPolicy, true)
+	mock.publish(t, consentdocument.KindMarketing, false)
+	userID := mock.createUser(t)
+
+	// Optional documents are never pending
+	if pending := mock.pending(t, userID); len(pending) != 2 {
+		t.Fatalf("expected the 2 mandatory documents, got %d", len(pending))
+	}
+	if err := mock.consent.Record(context.Background(), userID, accept(terms, privacy), consentmodels.Source{}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if pending := mock.pending(t, userID); len(pending) != 0 {
+		t.Fatalf("expected no pending documents, got %d", len(pending))
+	}
+
+	// A new mandatory version makes the user consent again
+	updated := mock.publish(t, consentdocument.KindTermsOfService, true)
+	pending := mock.pending(t, userID)
+	if len(pending) != 1 || pending[0].ID != updated.ID {
+		t.Errorf("expected the new terms of service, got %+v", pending)
+	}
+}
+
+func TestConsentUsecase_ValidateSignupChoices(t *testing.T) {
+	ctx := context.Background()
+	mock := &MockConsentUsecase{}
+	mock.Setup(t)
+	terms := mock.publish(t, consentdocument.KindTermsOfService, true)
+	privacy := mock.publish(t, consentdocument.KindPrivacyPolicy, true)
+
+	if err := mock.consent.ValidateSignupChoices(ctx, accept(terms)); !errors.Is(err, errcode.ErrInvalidInput) {
+		t.Errorf("expected an invalid input error without every mandatory document, got %v", err)
+	}
+	if err := mock.consent.ValidateSignupChoices(ctx, accept(terms, privacy)); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
@@ -0,0 +1,287 @@
+package dataexport_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	authv1 "github.com/mandacode-com/accounts-proto/go/auth/v1"
+	mailerv1 "github.com/mandacode-com/accounts-proto/go/mailer/v1"
+	profilev1 "github.com/mandacode-com/accounts-proto/go/profile/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	_ "github.com/mattn/go-sqlite3"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"mandacode.com/accounts/user/ent"
+	entdataexport "mandacode.com/accounts/user/ent/dataexport"
+	"mandacode.com/accounts/user/ent/enttest"
+	"mandacode.com/accounts/user/ent/outboxmessage"
+	blobinfra "mandacode.com/accounts/user/internal/infra/blob"
+	authrepo "mandacode.com/accounts/user/internal/repository/auth"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	maileventrepo "mandacode.com/accounts/user/internal/repository/mailevent"
+	profilerepo "mandacode.com/accounts/user/internal/repository/profile"
+	"mandacode.com/accounts/user/internal/usecase/dataexport"
+	"mandacode.com/accounts/user/internal/util"
+)
+
+// stubAuthExportClient stands in for the export service of auth, and fails while unavailable.
+type stubAuthExportClient struct {
+	authv1.DataExportServiceClient
+	unavailable bool
+}
+
+func (s *stubAuthExportClient) ExportUserData(ctx context.Context, in *authv1.ExportUserDataRequest, opts ...grpc.CallOption) (*authv1.ExportUserDataResponse, error) {
+	if s.unavailable {
+		return nil, status.Error(codes.Unavailable, "auth service unavailable")
+	}
+	return &authv1.ExportUserDataResponse{Data: []byte(`{"user_id":"` + in.UserId + `","sessions":[]}`)}, nil
+}
+
+// stubProfileExportClient stands in for the export service of profile.
+type stubProfileExportClient struct {
+	profilev1.DataExportServiceClient
+}
+
+func (s *stubProfileExportClient) ExportUserData(ctx context.Context, in *profilev1.ExportUserDataRequest, opts ...grpc.CallOption) (*profilev1.ExportUserDataResponse, error) {
+	return &profilev1.ExportUserDataResponse{Data: []byte(`{"user_id":"` + in.UserId + `","profile":null}`)}, nil
+}
+
+type MockExportUsecase struct {
+	client   *ent.Client
+	userRepo *dbrepo.UserRepository
+	auth     *stubAuthExportClient
+	export   *dataexport.ExportUsecase
+}
+
+// Setup builds the exports, whose download links are valid for linkTTL and which are given up after two
+// failures.
+func (m *MockExportUsecase) Setup(t *testing.T, linkTTL time.Duration) {
+	t.Helper()
+	m.client = enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { m.client.Close() })
+	store, err := blobinfra.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	m.userRepo = dbrepo.NewUserRepository(m.client, util.NewRandomStringGenerator(16))
+	m.auth = &stubAuthExportClient{}
+	m.export = dataexport.NewExportUsecase(
+		dbrepo.NewDataExportRepository(m.client),
+		m.userRepo,
+		dbrepo.NewSentEmailRepository(m.client),
+		dbrepo.NewConsentRepository(m.client),
+		authrepo.NewAuthRepository(nil, nil, m.auth),
+		profilerepo.NewProfileRepository(nil, &stubProfileExportClient{}),
+		store,
+		dbrepo.NewTxManager(m.client),
+		maileventrepo.NewMailEventEmitter(dbrepo.NewOutboxRepository(m.client), "mail"),
+		"https://accounts.example.com/exports/download",
+		linkTTL,
+		10,
+		2,
+		zap.NewNop(),
+	)
+}
+
+// createUser creates a user, whose email address is known unless it is empty.
+func (m *MockExportUsecase) createUser(t *testing.T, email string) uuid.UUID {
+	t.Helper()
+	ctx := context.Background()
+	user, err := m.userRepo.CreateUser(ctx, uuid.New(), nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if email != "" {
+		if _, err := m.userRepo.UpdateEmail(ctx, user.ID, email); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	return user.ID
+}
+
+func (m *MockExportUsecase) requestExport(t *testing.T, userID uuid.UUID) {
+	t.Helper()
+	if _, err := m.export.RequestExport(context.Background(), userID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+// downloadToken returns the token of the download link of the last export ready mail.
+func (m *MockExportUsecase) downloadToken(t *testing.T) string {
+	t.Helper()
+	message, err := m.client.OutboxMessage.Query().
+		Where(outboxmessage.Topic("mail")).
+		Order(ent.Desc(outboxmessage.FieldID)).
+		First(context.Background())
+	if err != nil {
+		t.Fatalf("expected an export ready mail, got %v", err)
+	}
+	event := &mailerv1.DataExportReadyEvent{}
+	if err := proto.Unmarshal(message.Value, event); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	link, err := url.Parse(event.DownloadLink)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return link.Query().Get("token")
+}
+
+func TestExportUsecase_RequestExport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("RequestExport_Success", func(t *testing.T) {
+		mock := &MockExportUsecase{}
+		mock.Setup(t, time.Hour)
+		userID := mock.createUser(t, "user@example.com")
+
+		export, err := mock.export.RequestExport(ctx, userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if export.Status != entdataexport.StatusPending {
+			t.Errorf("expected a pending export, got %+v", export)
+		}
+	})
+
+	t.Run("RequestExport_AlreadyPending", func(t *testing.T) {
+		mock := &MockExportUsecase{}
+		mock.Setup(t, time.Hour)
+		userID := mock.createUser(t, "user@example.com")
+		mock.requestExport(t, userID)
+
+		if _, err := mock.export.RequestExport(ctx, userID); !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected a conflict error, got %v", err)
+		}
+	})
+
+	t.Run("RequestExport_UnknownEmail", func(t *testing.T) {
+		mock := &MockExportUsecase{}
+		mock.Setup(t, time.Hour)
+		userID := mock.createUser(t, "")
+
+		// The download link could not be mailed
+		if _, err := mock.export.RequestExport(ctx, userID); !errors.Is(err, errcode.ErrInvalidInput) {
+			t.Errorf("expected an invalid input error, got %v", err)
+		}
+	})
+}
+
+func TestExportUsecase_ProcessExports(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ProcessExports_Success", func(t *testing.T) {
+		mock := &MockExportUsecase{}
+		mock.Setup(t, time.Hour)
+		userID := mock.createUser(t, "user@example.com")
+		mock.requestExport(t, userID)
+
+		result, err := mock.export.ProcessExports(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Completed != 1 || result.Retried != 0 || result.Expired != 0 {
+			t.Errorf("expected the export to be completed, got %+v", result)
+		}
+
+		archive, export, err := mock.export.OpenDownload(ctx, mock.downloadToken(t))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		defer archive.Close()
+		if export.UserID != userID || export.Status != entdataexport.StatusCompleted {
+			t.Errorf("expected the completed export of the user, got %+v", export)
+		}
+		data, err := io.ReadAll(archive)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		names := map[string]bool{}
+		for _, file := range reader.File {
+			names[file.Name] = true
+		}
+		for _, name := range []string{"user.json", "sent_emails.json", "consents.json", "auth.json", "profile.json"} {
+			if !names[name] {
+				t.Errorf("expected %s in the archive, got %v", name, names)
+			}
+		}
+	})
+
+	t.Run("ProcessExports_ServiceUnavailable", func(t *testing.T) {
+		mock := &MockExportUsecase{}
+		mock.Setup(t, time.Hour)
+		userID := mock.createUser(t, "user@example.com")
+		mock.requestExport(t, userID)
+		mock.auth.unavailable = true
+
+		result, err := mock.export.ProcessExports(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Completed != 0 || result.Retried != 1 {
+			t.Errorf("expected the export to be retried, got %+v", result)
+		}
+		result, err = mock.export.ProcessExports(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Failed != 1 {
+			t.Errorf("expected the export to be given up after its last attempt, got %+v", result)
+		}
+		exports, err := mock.export.ListExports(ctx, userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(exports) != 1 || exports[0].Status != entdataexport.StatusFailed {
+			t.Errorf("expected the export to have failed, got %+v", exports)
+		}
+		if count := mock.client.OutboxMessage.Query().CountX(ctx); count != 0 {
+			t.Errorf("expected no mail to be sent, got %d messages", count)
+		}
+
+		// Users can ask again once the export failed
+		mock.requestExport(t, userID)
+	})
+
+	t.Run("ProcessExports_Expired", func(t *testing.T) {
+		mock := &MockExportUsecase{}
+		mock.Setup(t, -time.Minute)
+		userID := mock.createUser(t, "user@example.com")
+		mock.requestExport(t, userID)
+
+		result, err := mock.export.ProcessExports(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Completed != 1 || result.Expired != 1 {
+			t.Errorf("expected the export to expire once its link did, got %+v", result)
+		}
+		if _, _, err := mock.export.OpenDownload(ctx, mock.downloadToken(t)); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+	})
+
+	t.Run("OpenDownload_InvalidToken", func(t *testing.T) {
+		mock := &MockExportUsecase{}
+		mock.Setup(t, time.Hour)
+
+		if _, _, err := mock.export.OpenDownload(ctx, "forged"); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+	})
+}
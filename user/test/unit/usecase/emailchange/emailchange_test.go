@@ -0,0 +1,389 @@
+package emailchange_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	authv1 "github.com/mandacode-com/accounts-proto/go/auth/v1"
+	mailerv1 "github.com/mandacode-com/accounts-proto/go/mailer/v1"
+	profilev1 "github.com/mandacode-com/accounts-proto/go/profile/v1"
+	tokenv1 "github.com/mandacode-com/accounts-proto/go/token/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"mandacode.com/accounts/user/ent"
+	entemailchange "mandacode.com/accounts/user/ent/emailchange"
+	"mandacode.com/accounts/user/ent/enttest"
+	"mandacode.com/accounts/user/ent/outboxmessage"
+	authrepo "mandacode.com/accounts/user/internal/repository/auth"
+	coderepo "mandacode.com/accounts/user/internal/repository/code"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	maileventrepo "mandacode.com/accounts/user/internal/repository/mailevent"
+	profilerepo "mandacode.com/accounts/user/internal/repository/profile"
+	tokenrepo "mandacode.com/accounts/user/internal/repository/token"
+	usereventrepo "mandacode.com/accounts/user/internal/repository/userevent"
+	"mandacode.com/accounts/user/internal/usecase/emailchange"
+	"mandacode.com/accounts/user/internal/usecase/signup"
+	"mandacode.com/accounts/user/internal/util"
+)
+
+const (
+	oldEmail = "old@example.com"
+	newEmail = "new@example.com"
+)
+
+// stubTokenClient stands in for the token service, issuing opaque tokens which carry the claims they were
+// generated with.
+type stubTokenClient struct {
+	tokenv1.TokenServiceClient
+	tokens map[string]*tokenv1.GenerateEmailVerificationTokenRequest
+	last   string
+}
+
+func (s *stubTokenClient) GenerateEmailVerificationToken(ctx context.Context, in *tokenv1.GenerateEmailVerificationTokenRequest, opts ...grpc.CallOption) (*tokenv1.GenerateEmailVerificationTokenResponse, error) {
+	s.last = uuid.NewString()
+	s.tokens[s.last] = in
+	return &tokenv1.GenerateEmailVerificationTokenResponse{
+		Token:     s.last,
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+	}, nil
+}
+
+func (s *stubTokenClient) VerifyEmailVerificationToken(ctx context.Context, in *tokenv1.VerifyEmailVerificationTokenRequest, opts ...grpc.CallOption) (*tokenv1.VerifyEmailVerificationTokenResponse, error) {
+	claims, ok := s.tokens[in.Token]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+	return &tokenv1.VerifyEmailVerificationTokenResponse{
+		Valid:  true,
+		UserId: &claims.UserId,
+		Email:  &claims.Email,
+		Code:   &claims.Code,
+	}, nil
+}
+
+// stubLocalUserClient stands in for the auth service, keeping the email addresses of the local users.
+type stubLocalUserClient struct {
+	authv1.LocalUserServiceClient
+	emails map[string]string // Email addresses by user ID
+}
+
+func (s *stubLocalUserClient) inUse(email string, userID string) bool {
+	for id, used := range s.emails {
+		if used == email && id != userID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *stubLocalUserClient) IsEmailAvailable(ctx context.Context, in *authv1.IsEmailAvailableRequest, opts ...grpc.CallOption) (*authv1.IsEmailAvailableResponse, error) {
+	return &authv1.IsEmailAvailableResponse{Email: in.Email, Available: !s.inUse(in.Email, "")}, nil
+}
+
+func (s *stubLocalUserClient) UpdateLocalUserEmail(ctx context.Context, in *authv1.UpdateLocalUserEmailRequest, opts ...grpc.CallOption) (*authv1.UpdateLocalUserEmailResponse, error) {
+	if s.inUse(in.NewEmail, in.UserId) {
+		return nil, status.Error(codes.AlreadyExists, "email already in use")
+	}
+	s.emails[in.UserId] = in.NewEmail
+	return &authv1.UpdateLocalUserEmailResponse{UserId: in.UserId, UpdatedEmail: in.NewEmail, UpdatedAt: timestamppb.Now()}, nil
+}
+
+// stubProfileClient stands in for the profile service, keeping the email addresses of the profiles.
+type stubProfileClient struct {
+	profilev1.ProfileServiceClient
+	emails      map[string]string // Email addresses by user ID
+	unavailable bool
+}
+
+func (s *stubProfileClient) UpdateEmail(ctx context.Context, in *profilev1.UpdateEmailRequest, opts ...grpc.CallOption) (*profilev1.UpdateEmailResponse, error) {
+	if s.unavailable {
+		return nil, status.Error(codes.Unavailable, "profile service unavailable")
+	}
+	s.emails[in.UserId] = in.NewEmail
+	return &profilev1.UpdateEmailResponse{UserId: in.UserId, UpdatedEmail: in.NewEmail, UpdatedAt: timestamppb.Now()}, nil
+}
+
+type MockEmailChangeUsecase struct {
+	client      *ent.Client
+	userRepo    *dbrepo.UserRepository
+	tokens      *stubTokenClient
+	localUsers  *stubLocalUserClient
+	profiles    *stubProfileClient
+	emailChange *emailchange.EmailChangeUsecase
+}
+
+func (m *MockEmailChangeUsecase) Setup(t *testing.T) {
+	t.Helper()
+	m.client = enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { m.client.Close() })
+	store := miniredis.RunT(t)
+	codeStore := redis.NewClient(&redis.Options{Addr: store.Addr()})
+	t.Cleanup(func() { codeStore.Close() })
+
+	m.userRepo = dbrepo.NewUserRepository(m.client, util.NewRandomStringGenerator(16))
+	m.tokens = &stubTokenClient{tokens: make(map[string]*tokenv1.GenerateEmailVerificationTokenRequest)}
+	m.localUsers = &stubLocalUserClient{emails: map[string]string{}}
+	m.profiles = &stubProfileClient{emails: map[string]string{}}
+	outboxRepo := dbrepo.NewOutboxRepository(m.client)
+	mailEvent := maileventrepo.NewMailEventEmitter(outboxRepo, "mail")
+	authRepo := authrepo.NewAuthRepository(m.localUsers, nil, nil)
+	codeGen := util.NewRandomStringGenerator(32)
+	verifyEmail := signup.NewVerifyEmailUsecase(
+		dbrepo.NewSentEmailRepository(m.client),
+		authRepo,
+		tokenrepo.NewTokenRepository(m.tokens),
+		mailEvent,
+		coderepo.NewCodeManager(codeGen, time.Minute, codeStore, "email_verification:"),
+		"https://accounts.example.com/verify",
+		coderepo.NewCodeManager(codeGen, time.Minute, codeStore, "email_change:"),
+		"https://accounts.example.com/email-change",
+		coderepo.NewCodeManager(codeGen, time.Minute, codeStore, "guardian_consent:"),
+		"https://accounts.example.com/guardian-consent",
+		5,
+		time.Hour,
+	)
+	m.emailChange = emailchange.NewEmailChangeUsecase(
+		m.userRepo,
+		dbrepo.NewEmailChangeRepository(m.client),
+		authRepo,
+		profilerepo.NewProfileRepository(m.profiles, nil),
+		verifyEmail,
+		dbrepo.NewTxManager(m.client),
+		usereventrepo.NewUserEventEmitter(outboxRepo, "user"),
+		mailEvent,
+		"https://accounts.example.com/email-change/undo",
+		time.Hour,
+	)
+}
+
+// createUser creates a user with the email address in every service.
+func (m *MockEmailChangeUsecase) createUser(t *testing.T, email string) uuid.UUID {
+	t.Helper()
+	ctx := context.Background()
+	user, err := m.userRepo.CreateUser(ctx, uuid.New(), nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := m.userRepo.UpdateEmail(ctx, user.ID, email); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	m.localUsers.emails[user.ID.String()] = email
+	m.profiles.emails[user.ID.String()] = email
+	return user.ID
+}
+
+// requestChange requests the change of the email address of the user to newEmail, and returns the token
+// verifying it.
+func (m *MockEmailChangeUsecase) requestChange(t *testing.T, userID uuid.UUID) string {
+	t.Helper()
+	if _, err := m.emailChange.RequestEmailChange(context.Background(), userID, newEmail); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return m.tokens.last
+}
+
+// undoToken returns the token of the undo link of the last email changed notice.
+func (m *MockEmailChangeUsecase) undoToken(t *testing.T) string {
+	t.Helper()
+	message, err := m.client.OutboxMessage.Query().
+		Where(outboxmessage.Topic("mail")).
+		Order(ent.Desc(outboxmessage.FieldID)).
+		First(context.Background())
+	if err != nil {
+		t.Fatalf("expected an email changed notice, got %v", err)
+	}
+	event := &mailerv1.EmailChangedEvent{}
+	if err := proto.Unmarshal(message.Value, event); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if event.Email != oldEmail || event.NewEmail != newEmail {
+		t.Fatalf("expected the notice to be sent to the old address, got %+v", event)
+	}
+	link, err := url.Parse(event.UndoLink)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return link.Query().Get("token")
+}
+
+// expectEmail checks the email address of the user in every service.
+func (m *MockEmailChangeUsecase) expectEmail(t *testing.T, userID uuid.UUID, email string) {
+	t.Helper()
+	user, err := m.userRepo.GetUserByID(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if user.Email == nil || *user.Email != email {
+		t.Errorf("expected the user service to have %s, got %v", email, user.Email)
+	}
+	if got := m.localUsers.emails[userID.String()]; got != email {
+		t.Errorf("expected the auth service to have %s, got %s", email, got)
+	}
+	if got := m.profiles.emails[userID.String()]; got != email {
+		t.Errorf("expected the profile service to have %s, got %s", email, got)
+	}
+}
+
+func TestEmailChangeUsecase_RequestEmailChange(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("RequestEmailChange_Success", func(t *testing.T) {
+		mock := &MockEmailChangeUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t, oldEmail)
+
+		change, err := mock.emailChange.RequestEmailChange(ctx, userID, newEmail)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if change.Status != entemailchange.StatusPending || change.OldEmail != oldEmail || change.NewEmail != newEmail {
+			t.Errorf("expected a pending change, got %+v", change)
+		}
+		if claims := mock.tokens.tokens[mock.tokens.last]; claims == nil || claims.Email != newEmail {
+			t.Errorf("expected the verification to be sent to the new address, got %+v", claims)
+		}
+		// The address only changes once the new address is verified
+		mock.expectEmail(t, userID, oldEmail)
+	})
+
+	t.Run("RequestEmailChange_SameEmail", func(t *testing.T) {
+		mock := &MockEmailChangeUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t, oldEmail)
+
+		if _, err := mock.emailChange.RequestEmailChange(ctx, userID, "OLD@example.com"); !errors.Is(err, errcode.ErrInvalidInput) {
+			t.Errorf("expected an invalid input error, got %v", err)
+		}
+	})
+
+	t.Run("RequestEmailChange_InUse", func(t *testing.T) {
+		mock := &MockEmailChangeUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t, oldEmail)
+		mock.createUser(t, newEmail)
+
+		if _, err := mock.emailChange.RequestEmailChange(ctx, userID, newEmail); !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected a conflict error, got %v", err)
+		}
+	})
+}
+
+func TestEmailChangeUsecase_ConfirmEmailChange(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ConfirmEmailChange_Success", func(t *testing.T) {
+		mock := &MockEmailChangeUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t, oldEmail)
+		token := mock.requestChange(t, userID)
+
+		change, err := mock.emailChange.ConfirmEmailChange(ctx, token)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if change.Status != entemailchange.StatusConfirmed || change.UndoExpiresAt == nil {
+			t.Errorf("expected the change to be confirmed and undoable, got %+v", change)
+		}
+		mock.expectEmail(t, userID, newEmail)
+		if count := mock.client.OutboxMessage.Query().Where(outboxmessage.Topic("user")).CountX(ctx); count != 1 {
+			t.Errorf("expected the change to be announced, got %d messages", count)
+		}
+		if token := mock.undoToken(t); token == "" {
+			t.Errorf("expected the notice to carry an undo link")
+		}
+
+		// The verification token can only be used once
+		if _, err := mock.emailChange.ConfirmEmailChange(ctx, token); err == nil {
+			t.Errorf("expected the used token to be rejected")
+		}
+	})
+
+	t.Run("ConfirmEmailChange_ProfileUnavailable", func(t *testing.T) {
+		mock := &MockEmailChangeUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t, oldEmail)
+		token := mock.requestChange(t, userID)
+		mock.profiles.unavailable = true
+
+		if _, err := mock.emailChange.ConfirmEmailChange(ctx, token); err == nil {
+			t.Errorf("expected the change to fail while the profile service is unavailable")
+		}
+		// The auth service gets the old address back
+		mock.expectEmail(t, userID, oldEmail)
+	})
+
+	t.Run("ConfirmEmailChange_TakenMeanwhile", func(t *testing.T) {
+		mock := &MockEmailChangeUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t, oldEmail)
+		token := mock.requestChange(t, userID)
+		mock.createUser(t, newEmail)
+
+		if _, err := mock.emailChange.ConfirmEmailChange(ctx, token); !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected a conflict error, got %v", err)
+		}
+		mock.expectEmail(t, userID, oldEmail)
+	})
+}
+
+func TestEmailChangeUsecase_UndoEmailChange(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("UndoEmailChange_Success", func(t *testing.T) {
+		mock := &MockEmailChangeUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t, oldEmail)
+		if _, err := mock.emailChange.ConfirmEmailChange(ctx, mock.requestChange(t, userID)); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		undoToken := mock.undoToken(t)
+
+		if err := mock.emailChange.UndoEmailChange(ctx, undoToken); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		mock.expectEmail(t, userID, oldEmail)
+		if count := mock.client.OutboxMessage.Query().Where(outboxmessage.Topic("user")).CountX(ctx); count != 2 {
+			t.Errorf("expected the undoing to be announced, got %d messages", count)
+		}
+		if err := mock.emailChange.UndoEmailChange(ctx, undoToken); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected a not found error for an undone change, got %v", err)
+		}
+	})
+
+	t.Run("UndoEmailChange_InvalidToken", func(t *testing.T) {
+		mock := &MockEmailChangeUsecase{}
+		mock.Setup(t)
+
+		if err := mock.emailChange.UndoEmailChange(ctx, "forged"); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+	})
+
+	t.Run("UndoEmailChange_ChangedAgain", func(t *testing.T) {
+		mock := &MockEmailChangeUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t, oldEmail)
+		if _, err := mock.emailChange.ConfirmEmailChange(ctx, mock.requestChange(t, userID)); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		undoToken := mock.undoToken(t)
+		if _, err := mock.userRepo.UpdateEmail(ctx, userID, "third@example.com"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if err := mock.emailChange.UndoEmailChange(ctx, undoToken); !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected a conflict error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,173 @@
+package manage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	_ "github.com/mattn/go-sqlite3"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/enttest"
+	"mandacode.com/accounts/user/ent/outboxmessage"
+	usermodels "mandacode.com/accounts/user/internal/models/user"
+	auditeventrepo "mandacode.com/accounts/user/internal/repository/auditevent"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	usereventrepo "mandacode.com/accounts/user/internal/repository/userevent"
+	manage "mandacode.com/accounts/user/internal/usecase/management"
+	"mandacode.com/accounts/user/internal/util"
+)
+
+type MockAdminManageUsecase struct {
+	client   *ent.Client
+	userRepo *dbrepo.UserRepository
+	manage   *manage.AdminManageUsecase
+}
+
+func (m *MockAdminManageUsecase) Setup(t *testing.T) {
+	t.Helper()
+	m.client = enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { m.client.Close() })
+	m.userRepo = dbrepo.NewUserRepository(m.client, util.NewRandomStringGenerator(16))
+	outboxRepo := dbrepo.NewOutboxRepository(m.client)
+	m.manage = manage.NewAdminManageUsecase(
+		m.userRepo,
+		dbrepo.NewOrganizationRepository(m.client),
+		dbrepo.NewTxManager(m.client),
+		usereventrepo.NewUserEventEmitter(outboxRepo, "user"),
+		auditeventrepo.NewAuditEventEmitter(outboxRepo, "audit"),
+	)
+}
+
+func (m *MockAdminManageUsecase) createUser(t *testing.T) uuid.UUID {
+	t.Helper()
+	user, err := m.userRepo.CreateUser(context.Background(), uuid.New(), nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return user.ID
+}
+
+// messages counts the outbox messages of the topic.
+func (m *MockAdminManageUsecase) messages(topic string) int {
+	return m.client.OutboxMessage.Query().Where(outboxmessage.Topic(topic)).CountX(context.Background())
+}
+
+func TestAdminManageUsecase_ListUsers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ListUsers_Pagination", func(t *testing.T) {
+		mock := &MockAdminManageUsecase{}
+		mock.Setup(t)
+		for range 3 {
+			mock.createUser(t)
+		}
+
+		first, total, err := mock.manage.ListUsers(ctx, usermodels.UserFilter{Limit: 2})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if total != 3 || len(first) != 2 {
+			t.Errorf("expected 2 of 3 users, got %d of %d", len(first), total)
+		}
+		rest, total, err := mock.manage.ListUsers(ctx, usermodels.UserFilter{Limit: 2, Offset: 2})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if total != 3 || len(rest) != 1 || rest[0].ID == first[0].ID || rest[0].ID == first[1].ID {
+			t.Errorf("expected the last user on the second page, got %d of %d", len(rest), total)
+		}
+	})
+
+	t.Run("ListUsers_Filter", func(t *testing.T) {
+		mock := &MockAdminManageUsecase{}
+		mock.Setup(t)
+		mock.createUser(t)
+		blockedID := mock.createUser(t)
+		if _, err := mock.manage.BlockUser(ctx, blockedID); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		blocked := true
+		users, total, err := mock.manage.ListUsers(ctx, usermodels.UserFilter{IsBlocked: &blocked, Limit: -1, Offset: -1})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if total != 1 || len(users) != 1 || users[0].ID != blockedID {
+			t.Errorf("expected only the blocked user, got %d of %d", len(users), total)
+		}
+	})
+}
+
+func TestAdminManageUsecase_BlockUser(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("BlockUser_Success", func(t *testing.T) {
+		mock := &MockAdminManageUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t)
+
+		blocked, err := mock.manage.BlockUser(ctx, userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !blocked.IsBlocked {
+			t.Errorf("expected the user to be blocked, got %+v", blocked)
+		}
+		unblocked, err := mock.manage.UnblockUser(ctx, userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if unblocked.IsBlocked || unblocked.SyncVersion <= blocked.SyncVersion {
+			t.Errorf("expected the user to be unblocked in a new version, got %+v", unblocked)
+		}
+		if count := mock.messages("user"); count != 2 {
+			t.Errorf("expected both changes to be announced, got %d messages", count)
+		}
+		if count := mock.messages("audit"); count != 2 {
+			t.Errorf("expected both changes to be audited, got %d messages", count)
+		}
+	})
+
+	t.Run("BlockUser_NotFound", func(t *testing.T) {
+		mock := &MockAdminManageUsecase{}
+		mock.Setup(t)
+
+		if _, err := mock.manage.BlockUser(ctx, uuid.New()); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+		if count := mock.client.OutboxMessage.Query().CountX(ctx); count != 0 {
+			t.Errorf("expected nothing to be announced, got %d messages", count)
+		}
+	})
+}
+
+func TestAdminManageUsecase_DeleteUser(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("DeleteUser_Success", func(t *testing.T) {
+		mock := &MockAdminManageUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t)
+
+		if err := mock.manage.DeleteUser(ctx, userID); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := mock.manage.GetUserByID(ctx, userID); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected the user to be deleted, got %v", err)
+		}
+		if mock.messages("user") != 1 || mock.messages("audit") != 1 {
+			t.Errorf("expected the deletion to be announced and audited")
+		}
+	})
+
+	t.Run("DeleteUser_NotFound", func(t *testing.T) {
+		mock := &MockAdminManageUsecase{}
+		mock.Setup(t)
+
+		if err := mock.manage.DeleteUser(ctx, uuid.New()); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+	})
+}
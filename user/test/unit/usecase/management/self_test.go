@@ -0,0 +1,108 @@
+package manage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	_ "github.com/mattn/go-sqlite3"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/enttest"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	usereventrepo "mandacode.com/accounts/user/internal/repository/userevent"
+	manage "mandacode.com/accounts/user/internal/usecase/management"
+	"mandacode.com/accounts/user/internal/util"
+)
+
+type MockSelfManageUsecase struct {
+	client   *ent.Client
+	userRepo *dbrepo.UserRepository
+	manage   *manage.SelfManageUsecase
+}
+
+func (m *MockSelfManageUsecase) Setup(t *testing.T) {
+	t.Helper()
+	m.client = enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { m.client.Close() })
+	m.userRepo = dbrepo.NewUserRepository(m.client, util.NewRandomStringGenerator(16))
+	eventEmitter := usereventrepo.NewUserEventEmitter(dbrepo.NewOutboxRepository(m.client), "user")
+	m.manage = manage.NewSelfManageUsecase(m.userRepo, dbrepo.NewTxManager(m.client), eventEmitter)
+}
+
+// archivedUser creates a user archived with the deletion delay, which may already have passed.
+func (m *MockSelfManageUsecase) archivedUser(t *testing.T, delay time.Duration) uuid.UUID {
+	t.Helper()
+	ctx := context.Background()
+	user, err := m.userRepo.CreateUser(ctx, uuid.New(), nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := m.userRepo.ArchiveUser(ctx, user.ID, delay); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return user.ID
+}
+
+func TestSelfManageUsecase_RestoreUser(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("RestoreUser_WithinDelay", func(t *testing.T) {
+		mock := &MockSelfManageUsecase{}
+		mock.Setup(t)
+		userID := mock.archivedUser(t, time.Hour)
+
+		restored, err := mock.manage.RestoreUser(ctx, userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if restored.IsArchived || restored.DeleteAfter != nil {
+			t.Errorf("expected the user to be restored, got %+v", restored)
+		}
+		if count := mock.client.OutboxMessage.Query().CountX(ctx); count != 1 {
+			t.Errorf("expected the restoring to be announced, got %d messages", count)
+		}
+	})
+
+	t.Run("RestoreUser_DelayPassed", func(t *testing.T) {
+		mock := &MockSelfManageUsecase{}
+		mock.Setup(t)
+		userID := mock.archivedUser(t, -time.Hour)
+
+		// The purge may already have announced the deletion
+		if _, err := mock.manage.RestoreUser(ctx, userID); !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected a conflict error, got %v", err)
+		}
+		user, err := mock.userRepo.GetUserByID(ctx, userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !user.IsArchived {
+			t.Errorf("expected the user to stay archived")
+		}
+	})
+
+	t.Run("RestoreUser_NotArchived", func(t *testing.T) {
+		mock := &MockSelfManageUsecase{}
+		mock.Setup(t)
+		user, err := mock.userRepo.CreateUser(ctx, uuid.New(), nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if _, err := mock.manage.RestoreUser(ctx, user.ID); !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected a conflict error, got %v", err)
+		}
+	})
+
+	t.Run("RestoreUser_UnknownUser", func(t *testing.T) {
+		mock := &MockSelfManageUsecase{}
+		mock.Setup(t)
+
+		if _, err := mock.manage.RestoreUser(ctx, uuid.New()); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+	})
+}
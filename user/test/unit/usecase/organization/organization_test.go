@@ -0,0 +1,262 @@
+package organization_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	mailerv1 "github.com/mandacode-com/accounts-proto/go/mailer/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/protobuf/proto"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/enttest"
+	"mandacode.com/accounts/user/ent/membership"
+	"mandacode.com/accounts/user/ent/outboxmessage"
+	orgmodels "mandacode.com/accounts/user/internal/models/organization"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	maileventrepo "mandacode.com/accounts/user/internal/repository/mailevent"
+	"mandacode.com/accounts/user/internal/usecase/organization"
+	"mandacode.com/accounts/user/internal/util"
+)
+
+type MockOrganizationUsecase struct {
+	client   *ent.Client
+	userRepo *dbrepo.UserRepository
+	org      *organization.OrganizationUsecase
+}
+
+func (m *MockOrganizationUsecase) Setup(t *testing.T) {
+	t.Helper()
+	m.client = enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { m.client.Close() })
+	m.userRepo = dbrepo.NewUserRepository(m.client, util.NewRandomStringGenerator(16))
+	mailEvent := maileventrepo.NewMailEventEmitter(dbrepo.NewOutboxRepository(m.client), "mail")
+	m.org = organization.NewOrganizationUsecase(dbrepo.NewOrganizationRepository(m.client), mailEvent, "https://accounts.example.com/invitations", time.Hour)
+}
+
+func (m *MockOrganizationUsecase) createUser(t *testing.T) uuid.UUID {
+	t.Helper()
+	user, err := m.userRepo.CreateUser(context.Background(), uuid.New(), nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return user.ID
+}
+
+// createOrganization creates an organization owned by a new user, returning both.
+func (m *MockOrganizationUsecase) createOrganization(t *testing.T) (uuid.UUID, *orgmodels.SecureOrganization) {
+	t.Helper()
+	ownerID := m.createUser(t)
+	org, err := m.org.CreateOrganization(context.Background(), ownerID, "Mandacode", "mandacode-"+uuid.NewString()[:8])
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return ownerID, org
+}
+
+// invitationToken returns the token of the last invitation mailed.
+func (m *MockOrganizationUsecase) invitationToken(t *testing.T) string {
+	t.Helper()
+	message, err := m.client.OutboxMessage.Query().
+		Where(outboxmessage.Topic("mail")).
+		Order(ent.Desc(outboxmessage.FieldID)).
+		First(context.Background())
+	if err != nil {
+		t.Fatalf("expected an invitation mail, got %v", err)
+	}
+	event := &mailerv1.OrganizationInvitationEvent{}
+	if err := proto.Unmarshal(message.Value, event); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	link, err := url.Parse(event.InvitationLink)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return link.Query().Get("token")
+}
+
+// join invites a new user to the organization with the role, and accepts the invitation.
+func (m *MockOrganizationUsecase) join(t *testing.T, inviterID uuid.UUID, orgID uuid.UUID, role membership.Role) uuid.UUID {
+	t.Helper()
+	ctx := context.Background()
+	if _, err := m.org.InviteMember(ctx, inviterID, orgID, "member@example.com", role); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	memberID := m.createUser(t)
+	if _, err := m.org.AcceptInvitation(ctx, memberID, m.invitationToken(t)); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return memberID
+}
+
+func TestOrganizationUsecase_CreateOrganization(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("CreateOrganization_Success", func(t *testing.T) {
+		mock := &MockOrganizationUsecase{}
+		mock.Setup(t)
+		ownerID, org := mock.createOrganization(t)
+
+		memberships, err := mock.org.ListOrganizations(ctx, ownerID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(memberships) != 1 || memberships[0].OrganizationID != org.ID || memberships[0].Role != orgmodels.RoleOwner {
+			t.Errorf("expected the creator to own the organization, got %+v", memberships)
+		}
+	})
+
+	t.Run("CreateOrganization_InvalidInput", func(t *testing.T) {
+		mock := &MockOrganizationUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t)
+
+		for _, slug := range []string{"ab", "Mandacode", "manda--code", "-mandacode"} {
+			if _, err := mock.org.CreateOrganization(ctx, userID, "Mandacode", slug); !errors.Is(err, errcode.ErrInvalidInput) {
+				t.Errorf("expected an invalid input error for %q, got %v", slug, err)
+			}
+		}
+		if _, err := mock.org.CreateOrganization(ctx, userID, "  ", "mandacode"); !errors.Is(err, errcode.ErrInvalidInput) {
+			t.Errorf("expected an invalid input error for a blank name, got %v", err)
+		}
+	})
+
+	t.Run("CreateOrganization_SlugTaken", func(t *testing.T) {
+		mock := &MockOrganizationUsecase{}
+		mock.Setup(t)
+		_, org := mock.createOrganization(t)
+
+		if _, err := mock.org.CreateOrganization(ctx, mock.createUser(t), "Other", org.Slug); !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected a conflict error, got %v", err)
+		}
+	})
+}
+
+func TestOrganizationUsecase_Invitations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("AcceptInvitation_Success", func(t *testing.T) {
+		mock := &MockOrganizationUsecase{}
+		mock.Setup(t)
+		ownerID, org := mock.createOrganization(t)
+
+		memberID := mock.join(t, ownerID, org.ID, orgmodels.RoleAdmin)
+		member, err := mock.org.GetMembership(ctx, org.ID, memberID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if member.Role != orgmodels.RoleAdmin {
+			t.Errorf("expected the role of the invitation, got %s", member.Role)
+		}
+		invitations, err := mock.org.ListInvitations(ctx, ownerID, org.ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(invitations) != 0 {
+			t.Errorf("expected the invitation to be used up, got %+v", invitations)
+		}
+	})
+
+	t.Run("AcceptInvitation_InvalidToken", func(t *testing.T) {
+		mock := &MockOrganizationUsecase{}
+		mock.Setup(t)
+		ownerID, org := mock.createOrganization(t)
+		if _, err := mock.org.InviteMember(ctx, ownerID, org.ID, "member@example.com", orgmodels.RoleMember); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		token := mock.invitationToken(t)
+
+		if _, err := mock.org.AcceptInvitation(ctx, mock.createUser(t), "forged"); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected a not found error for a forged token, got %v", err)
+		}
+		if _, err := mock.org.AcceptInvitation(ctx, mock.createUser(t), token); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := mock.org.AcceptInvitation(ctx, mock.createUser(t), token); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected a not found error for a used token, got %v", err)
+		}
+	})
+
+	t.Run("InviteMember_InsufficientRole", func(t *testing.T) {
+		mock := &MockOrganizationUsecase{}
+		mock.Setup(t)
+		ownerID, org := mock.createOrganization(t)
+		adminID := mock.join(t, ownerID, org.ID, orgmodels.RoleAdmin)
+		memberID := mock.join(t, ownerID, org.ID, orgmodels.RoleMember)
+
+		if _, err := mock.org.InviteMember(ctx, memberID, org.ID, "other@example.com", orgmodels.RoleMember); !errors.Is(err, errcode.ErrForbidden) {
+			t.Errorf("expected members not to invite, got %v", err)
+		}
+		if _, err := mock.org.InviteMember(ctx, adminID, org.ID, "other@example.com", orgmodels.RoleOwner); !errors.Is(err, errcode.ErrForbidden) {
+			t.Errorf("expected admins not to invite owners, got %v", err)
+		}
+		if _, err := mock.org.InviteMember(ctx, mock.createUser(t), org.ID, "other@example.com", orgmodels.RoleMember); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected the organization to be hidden from non-members, got %v", err)
+		}
+	})
+}
+
+func TestOrganizationUsecase_Members(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("UpdateMemberRole_Success", func(t *testing.T) {
+		mock := &MockOrganizationUsecase{}
+		mock.Setup(t)
+		ownerID, org := mock.createOrganization(t)
+		memberID := mock.join(t, ownerID, org.ID, orgmodels.RoleMember)
+
+		updated, err := mock.org.UpdateMemberRole(ctx, ownerID, org.ID, memberID, orgmodels.RoleAdmin)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if updated.Role != orgmodels.RoleAdmin {
+			t.Errorf("expected the member to become an admin, got %s", updated.Role)
+		}
+	})
+
+	t.Run("UpdateMemberRole_InsufficientRole", func(t *testing.T) {
+		mock := &MockOrganizationUsecase{}
+		mock.Setup(t)
+		ownerID, org := mock.createOrganization(t)
+		adminID := mock.join(t, ownerID, org.ID, orgmodels.RoleAdmin)
+		otherAdminID := mock.join(t, ownerID, org.ID, orgmodels.RoleAdmin)
+
+		if _, err := mock.org.UpdateMemberRole(ctx, adminID, org.ID, otherAdminID, orgmodels.RoleMember); !errors.Is(err, errcode.ErrForbidden) {
+			t.Errorf("expected admins not to demote admins, got %v", err)
+		}
+		if _, err := mock.org.UpdateMemberRole(ctx, adminID, org.ID, ownerID, orgmodels.RoleMember); !errors.Is(err, errcode.ErrForbidden) {
+			t.Errorf("expected admins not to demote owners, got %v", err)
+		}
+	})
+
+	t.Run("RemoveMember_LastOwner", func(t *testing.T) {
+		mock := &MockOrganizationUsecase{}
+		mock.Setup(t)
+		ownerID, org := mock.createOrganization(t)
+
+		if err := mock.org.RemoveMember(ctx, ownerID, org.ID, ownerID); !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected the last owner not to leave, got %v", err)
+		}
+		if _, err := mock.org.UpdateMemberRole(ctx, ownerID, org.ID, ownerID, orgmodels.RoleMember); !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected the last owner not to step down, got %v", err)
+		}
+	})
+
+	t.Run("RemoveMember_Leave", func(t *testing.T) {
+		mock := &MockOrganizationUsecase{}
+		mock.Setup(t)
+		ownerID, org := mock.createOrganization(t)
+		memberID := mock.join(t, ownerID, org.ID, orgmodels.RoleMember)
+
+		if err := mock.org.RemoveMember(ctx, memberID, org.ID, memberID); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := mock.org.GetOrganization(ctx, memberID, org.ID); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected the organization to be hidden once the member left, got %v", err)
+		}
+	})
+}
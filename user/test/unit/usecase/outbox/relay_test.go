@@ -0,0 +1,167 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/enttest"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	outboxrepo "mandacode.com/accounts/user/internal/repository/outbox"
+	"mandacode.com/accounts/user/internal/usecase/outbox"
+)
+
+// fakeWriter records the messages it writes, and fails while unavailable.
+type fakeWriter struct {
+	unavailable bool
+	written     []string
+}
+
+func (w *fakeWriter) WriteMessages(ctx context.Context, messages ...kafka.Message) error {
+	if w.unavailable {
+		return errors.New("broker unavailable")
+	}
+	for _, message := range messages {
+		w.written = append(w.written, string(message.Key)+":"+string(message.Value))
+	}
+	return nil
+}
+
+type MockRelayUsecase struct {
+	client     *ent.Client
+	outboxRepo *dbrepo.OutboxRepository
+	writer     *fakeWriter
+	relay      *outbox.RelayUsecase
+}
+
+func (m *MockRelayUsecase) Setup(t *testing.T, maxAttempts int) {
+	t.Helper()
+	m.client = enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { m.client.Close() })
+	m.outboxRepo = dbrepo.NewOutboxRepository(m.client)
+	m.writer = &fakeWriter{}
+	publisher := outboxrepo.NewPublisher(map[string]outboxrepo.Writer{"user": m.writer})
+	m.relay = outbox.NewRelayUsecase(m.outboxRepo, dbrepo.NewTxManager(m.client), publisher, 10, maxAttempts, zap.NewNop())
+}
+
+func (m *MockRelayUsecase) enqueue(t *testing.T, key string, value string) {
+	t.Helper()
+	if err := m.outboxRepo.Enqueue(context.Background(), "user", []byte(key), []byte(value), nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+// makeDue makes the retries of the outbox due now.
+func (m *MockRelayUsecase) makeDue(t *testing.T) {
+	t.Helper()
+	if err := m.client.OutboxMessage.Update().SetNextAttemptAt(time.Now().Add(-time.Second)).Exec(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestRelayUsecase_RelayPending(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("RelayPending_InOrder", func(t *testing.T) {
+		mock := &MockRelayUsecase{}
+		mock.Setup(t, 3)
+		mock.enqueue(t, "a", "1")
+		mock.enqueue(t, "b", "1")
+		mock.enqueue(t, "a", "2")
+		mock.enqueue(t, "a", "3")
+
+		result, err := mock.relay.RelayPending(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Published != 4 || result.Failed != 0 {
+			t.Errorf("expected 4 messages published, got %+v", result)
+		}
+		want := []string{"a:1", "b:1", "a:2", "a:3"}
+		if len(mock.writer.written) != len(want) {
+			t.Fatalf("expected %v, got %v", want, mock.writer.written)
+		}
+		for i := range want {
+			if mock.writer.written[i] != want[i] {
+				t.Fatalf("expected %v, got %v", want, mock.writer.written)
+			}
+		}
+	})
+
+	t.Run("RelayPending_FailureHoldsBackKey", func(t *testing.T) {
+		mock := &MockRelayUsecase{}
+		mock.Setup(t, 3)
+		mock.enqueue(t, "a", "1")
+		mock.enqueue(t, "a", "2")
+		mock.writer.unavailable = true
+
+		result, err := mock.relay.RelayPending(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Published != 0 || result.Failed != 1 {
+			t.Errorf("expected a single failed attempt, got %+v", result)
+		}
+
+		mock.writer.unavailable = false
+		result, err = mock.relay.RelayPending(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Published != 0 {
+			t.Errorf("expected the key to be held back until the retry is due, got %+v", result)
+		}
+
+		mock.makeDue(t)
+		result, err = mock.relay.RelayPending(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Published != 2 || len(mock.writer.written) != 2 || mock.writer.written[0] != "a:1" {
+			t.Errorf("expected both messages published in order, got %+v, %v", result, mock.writer.written)
+		}
+	})
+
+	t.Run("RelayPending_DeadLetter", func(t *testing.T) {
+		mock := &MockRelayUsecase{}
+		mock.Setup(t, 2)
+		mock.enqueue(t, "a", "1")
+		mock.enqueue(t, "a", "2")
+		mock.writer.unavailable = true
+
+		result, err := mock.relay.RelayPending(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Failed != 1 || result.DeadLettered != 0 {
+			t.Errorf("expected a failed attempt, got %+v", result)
+		}
+
+		mock.makeDue(t)
+		result, err = mock.relay.RelayPending(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.DeadLettered != 1 || result.Failed != 1 {
+			t.Errorf("expected the message to be dead lettered after its last attempt, and the next one attempted, got %+v", result)
+		}
+		if _, err := mock.client.OutboxDeadLetter.Get(ctx, 1); err != nil {
+			t.Errorf("expected message 1 in the dead letters, got %v", err)
+		}
+
+		mock.writer.unavailable = false
+		mock.makeDue(t)
+		result, err = mock.relay.RelayPending(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Published != 1 || len(mock.writer.written) != 1 || mock.writer.written[0] != "a:2" {
+			t.Errorf("expected the next message of the key to be published, got %+v, %v", result, mock.writer.written)
+		}
+	})
+}
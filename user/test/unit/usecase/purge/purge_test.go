@@ -0,0 +1,146 @@
+package purge_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"go.uber.org/zap"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/enttest"
+	orgmodels "mandacode.com/accounts/user/internal/models/organization"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	usereventrepo "mandacode.com/accounts/user/internal/repository/userevent"
+	"mandacode.com/accounts/user/internal/usecase/purge"
+	"mandacode.com/accounts/user/internal/util"
+)
+
+type MockPurgeUsecase struct {
+	client   *ent.Client
+	userRepo *dbrepo.UserRepository
+	orgRepo  *dbrepo.OrganizationRepository
+	purge    *purge.PurgeUsecase
+}
+
+func (m *MockPurgeUsecase) Setup(t *testing.T) {
+	t.Helper()
+	m.client = enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { m.client.Close() })
+	m.userRepo = dbrepo.NewUserRepository(m.client, util.NewRandomStringGenerator(16))
+	m.orgRepo = dbrepo.NewOrganizationRepository(m.client)
+	eventEmitter := usereventrepo.NewUserEventEmitter(dbrepo.NewOutboxRepository(m.client), "user")
+	m.purge = purge.NewPurgeUsecase(m.userRepo, m.orgRepo, dbrepo.NewTxManager(m.client), eventEmitter, 10, zap.NewNop())
+}
+
+func (m *MockPurgeUsecase) createUser(t *testing.T) uuid.UUID {
+	t.Helper()
+	user, err := m.userRepo.CreateUser(context.Background(), uuid.New(), nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return user.ID
+}
+
+// createOrganization creates an organization owned by the owner, which the member joins afterwards.
+func (m *MockPurgeUsecase) createOrganization(t *testing.T, ownerID uuid.UUID, memberID uuid.UUID) uuid.UUID {
+	t.Helper()
+	ctx := context.Background()
+	org, err := m.orgRepo.CreateOrganization(ctx, "Acme", "acme-"+ownerID.String(), ownerID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	m.client.Membership.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(memberID).
+		SetRole(orgmodels.RoleMember).
+		SaveX(ctx)
+	return org.ID
+}
+
+// expire archives the user with a deletion delay which already passed.
+func (m *MockPurgeUsecase) expire(t *testing.T, userID uuid.UUID) {
+	t.Helper()
+	if _, err := m.userRepo.ArchiveUser(context.Background(), userID, -time.Hour); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestPurgeUsecase_PurgeExpiredUsers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("PurgeExpiredUsers_HandsOverOwnership", func(t *testing.T) {
+		mock := &MockPurgeUsecase{}
+		mock.Setup(t)
+		ownerID := mock.createUser(t)
+		memberID := mock.createUser(t)
+		orgID := mock.createOrganization(t, ownerID, memberID)
+		mock.expire(t, ownerID)
+
+		result, err := mock.purge.PurgeExpiredUsers(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Purged != 1 || result.Failed != 0 {
+			t.Fatalf("expected 1 purged user, got %+v", result)
+		}
+		member, err := mock.orgRepo.GetMembership(ctx, orgID, memberID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if member.Role != orgmodels.RoleOwner {
+			t.Errorf("expected the member to own the organization, got %s", member.Role)
+		}
+		if count := mock.client.OutboxMessage.Query().CountX(ctx); count != 1 {
+			t.Errorf("expected the deletion to be announced, got %d messages", count)
+		}
+	})
+
+	t.Run("PurgeExpiredUsers_DeletesEmptyOrganizations", func(t *testing.T) {
+		mock := &MockPurgeUsecase{}
+		mock.Setup(t)
+		ownerID := mock.createUser(t)
+		org, err := mock.orgRepo.CreateOrganization(ctx, "Acme", "acme", ownerID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		mock.expire(t, ownerID)
+
+		if _, err := mock.purge.PurgeExpiredUsers(ctx); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := mock.orgRepo.GetOrganization(ctx, org.ID); err == nil {
+			t.Errorf("expected the organization to be deleted")
+		}
+	})
+
+	t.Run("PurgeExpiredUsers_SkipsRestoredUser", func(t *testing.T) {
+		mock := &MockPurgeUsecase{}
+		mock.Setup(t)
+		ownerID := mock.createUser(t)
+		memberID := mock.createUser(t)
+		orgID := mock.createOrganization(t, ownerID, memberID)
+		if _, err := mock.userRepo.ArchiveUser(ctx, ownerID, time.Hour); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := mock.userRepo.RestoreUser(ctx, ownerID); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		result, err := mock.purge.PurgeExpiredUsers(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Purged != 0 {
+			t.Errorf("expected no purged users, got %+v", result)
+		}
+		owner, err := mock.orgRepo.GetMembership(ctx, orgID, ownerID)
+		if err != nil {
+			t.Fatalf("expected the restored user to keep their membership, got %v", err)
+		}
+		if owner.Role != orgmodels.RoleOwner {
+			t.Errorf("expected the restored user to keep owning the organization, got %s", owner.Role)
+		}
+	})
+}
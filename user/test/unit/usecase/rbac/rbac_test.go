@@ -0,0 +1,262 @@
+package rbac_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	_ "github.com/mattn/go-sqlite3"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/enttest"
+	"mandacode.com/accounts/user/ent/outboxmessage"
+	rolemodels "mandacode.com/accounts/user/internal/models/role"
+	auditeventrepo "mandacode.com/accounts/user/internal/repository/auditevent"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	usereventrepo "mandacode.com/accounts/user/internal/repository/userevent"
+	"mandacode.com/accounts/user/internal/usecase/rbac"
+	"mandacode.com/accounts/user/internal/util"
+)
+
+type MockRBACUsecase struct {
+	client   *ent.Client
+	userRepo *dbrepo.UserRepository
+	rbac     *rbac.RBACUsecase
+}
+
+// Setup builds the RBAC usecase along with the system roles, which the migrations seed.
+func (m *MockRBACUsecase) Setup(t *testing.T) {
+	t.Helper()
+	ctx := context.Background()
+	m.client = enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { m.client.Close() })
+
+	m.client.Role.Create().SetName(rolemodels.RoleAdmin).SetPermissions([]string{rolemodels.PermissionAll}).SetIsSystem(true).SaveX(ctx)
+	m.client.Role.Create().SetName(rolemodels.RoleSupport).SetPermissions([]string{rolemodels.PermissionUsersRead}).SetIsSystem(true).SaveX(ctx)
+	m.client.Role.Create().SetName(rolemodels.RoleUser).SetPermissions([]string{}).SetIsSystem(true).SaveX(ctx)
+
+	m.userRepo = dbrepo.NewUserRepository(m.client, util.NewRandomStringGenerator(16))
+	outboxRepo := dbrepo.NewOutboxRepository(m.client)
+	m.rbac = rbac.NewRBACUsecase(
+		dbrepo.NewRoleRepository(m.client),
+		m.userRepo,
+		dbrepo.NewTxManager(m.client),
+		usereventrepo.NewUserEventEmitter(outboxRepo, "user"),
+		auditeventrepo.NewAuditEventEmitter(outboxRepo, "audit"),
+	)
+}
+
+func (m *MockRBACUsecase) createUser(t *testing.T) uuid.UUID {
+	t.Helper()
+	user, err := m.userRepo.CreateUser(context.Background(), uuid.New(), nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return user.ID
+}
+
+// messages counts the outbox messages of the topic.
+func (m *MockRBACUsecase) messages(topic string) int {
+	return m.client.OutboxMessage.Query().Where(outboxmessage.Topic(topic)).CountX(context.Background())
+}
+
+func TestRBACUsecase_AssignRole(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("AssignRole_Success", func(t *testing.T) {
+		mock := &MockRBACUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t)
+
+		roles, err := mock.rbac.AssignRole(ctx, userID, rolemodels.RoleSupport, "admin")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !slices.Equal(roles.Roles, []string{rolemodels.RoleUser, rolemodels.RoleSupport}) {
+			t.Errorf("expected the user and support roles, got %v", roles.Roles)
+		}
+		if mock.messages("user") != 1 || mock.messages("audit") != 1 {
+			t.Errorf("expected the assignment to be announced and audited")
+		}
+
+		granted, err := mock.rbac.HasPermission(ctx, userID, rolemodels.PermissionUsersRead)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		denied, err := mock.rbac.HasPermission(ctx, userID, rolemodels.PermissionUsersDelete)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !granted || denied {
+			t.Errorf("expected only the permissions of the support role, got %v and %v", granted, denied)
+		}
+	})
+
+	t.Run("AssignRole_Admin", func(t *testing.T) {
+		mock := &MockRBACUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t)
+		if _, err := mock.rbac.AssignRole(ctx, userID, rolemodels.RoleAdmin, "admin"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		// The admin role grants every permission
+		granted, err := mock.rbac.HasPermission(ctx, userID, rolemodels.PermissionUsersDelete)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !granted {
+			t.Errorf("expected the admin to be granted the permission")
+		}
+	})
+
+	t.Run("AssignRole_AlreadyAssigned", func(t *testing.T) {
+		mock := &MockRBACUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t)
+		if _, err := mock.rbac.AssignRole(ctx, userID, rolemodels.RoleSupport, "admin"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if _, err := mock.rbac.AssignRole(ctx, userID, rolemodels.RoleSupport, "admin"); !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected a conflict error, got %v", err)
+		}
+		if count := mock.messages("user"); count != 1 {
+			t.Errorf("expected the failed assignment not to be announced, got %d messages", count)
+		}
+	})
+
+	t.Run("AssignRole_InvalidRole", func(t *testing.T) {
+		mock := &MockRBACUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t)
+
+		if _, err := mock.rbac.AssignRole(ctx, userID, rolemodels.RoleUser, "admin"); !errors.Is(err, errcode.ErrInvalidInput) {
+			t.Errorf("expected an invalid input error for the implicit role, got %v", err)
+		}
+		if _, err := mock.rbac.AssignRole(ctx, userID, "unknown", "admin"); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected a not found error for an unknown role, got %v", err)
+		}
+	})
+}
+
+func TestRBACUsecase_RevokeRole(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("RevokeRole_Success", func(t *testing.T) {
+		mock := &MockRBACUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t)
+		if _, err := mock.rbac.AssignRole(ctx, userID, rolemodels.RoleSupport, "admin"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		roles, err := mock.rbac.RevokeRole(ctx, userID, rolemodels.RoleSupport)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !slices.Equal(roles.Roles, []string{rolemodels.RoleUser}) || roles.HasPermission(rolemodels.PermissionUsersRead) {
+			t.Errorf("expected only the user role, got %+v", roles)
+		}
+		if count := mock.messages("user"); count != 2 {
+			t.Errorf("expected the revocation to be announced, got %d messages", count)
+		}
+	})
+
+	t.Run("RevokeRole_NotAssigned", func(t *testing.T) {
+		mock := &MockRBACUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t)
+
+		if _, err := mock.rbac.RevokeRole(ctx, userID, rolemodels.RoleSupport); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+		if count := mock.client.OutboxMessage.Query().CountX(ctx); count != 0 {
+			t.Errorf("expected nothing to be announced, got %d messages", count)
+		}
+	})
+}
+
+func TestRBACUsecase_CustomRoles(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("CreateRole_UnknownPermission", func(t *testing.T) {
+		mock := &MockRBACUsecase{}
+		mock.Setup(t)
+
+		if _, err := mock.rbac.CreateRole(ctx, "auditor", "", []string{"everything"}); !errors.Is(err, errcode.ErrInvalidInput) {
+			t.Errorf("expected an invalid input error, got %v", err)
+		}
+	})
+
+	t.Run("UpdateRole_NotifiesUsers", func(t *testing.T) {
+		mock := &MockRBACUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t)
+		role, err := mock.rbac.CreateRole(ctx, "auditor", "Reads the audit log", []string{rolemodels.PermissionAuditRead})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := mock.rbac.AssignRole(ctx, userID, "auditor", "admin"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if _, err := mock.rbac.UpdateRole(ctx, role.ID, "Reads the audit log and users", []string{rolemodels.PermissionAuditRead, rolemodels.PermissionUsersRead}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		granted, err := mock.rbac.HasPermission(ctx, userID, rolemodels.PermissionUsersRead)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !granted {
+			t.Errorf("expected the user to be granted the new permission")
+		}
+		if count := mock.messages("user"); count != 2 {
+			t.Errorf("expected the changed permissions to be announced, got %d messages", count)
+		}
+	})
+
+	t.Run("DeleteRole_RevokesRole", func(t *testing.T) {
+		mock := &MockRBACUsecase{}
+		mock.Setup(t)
+		userID := mock.createUser(t)
+		role, err := mock.rbac.CreateRole(ctx, "auditor", "", []string{rolemodels.PermissionAuditRead})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := mock.rbac.AssignRole(ctx, userID, "auditor", "admin"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if err := mock.rbac.DeleteRole(ctx, role.ID); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		roles, err := mock.rbac.GetUserRoles(ctx, userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if roles.HasPermission(rolemodels.PermissionAuditRead) {
+			t.Errorf("expected the permissions of the deleted role to be revoked, got %+v", roles)
+		}
+	})
+
+	t.Run("UpdateRole_SystemRole", func(t *testing.T) {
+		mock := &MockRBACUsecase{}
+		mock.Setup(t)
+		roles, err := mock.rbac.ListRoles(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		for _, role := range roles {
+			if _, err := mock.rbac.UpdateRole(ctx, role.ID, "", []string{rolemodels.PermissionAll}); !errors.Is(err, errcode.ErrForbidden) {
+				t.Errorf("expected a forbidden error for %s, got %v", role.Name, err)
+			}
+			if err := mock.rbac.DeleteRole(ctx, role.ID); !errors.Is(err, errcode.ErrForbidden) {
+				t.Errorf("expected a forbidden error for %s, got %v", role.Name, err)
+			}
+		}
+	})
+}
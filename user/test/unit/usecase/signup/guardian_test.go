@@ -0,0 +1,220 @@
+package signup_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	tokenv1 "github.com/mandacode-com/accounts-proto/go/token/v1"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"mandacode.com/accounts/user/ent/enttest"
+	entuser "mandacode.com/accounts/user/ent/user"
+	usermodels "mandacode.com/accounts/user/internal/models/user"
+	auditeventrepo "mandacode.com/accounts/user/internal/repository/auditevent"
+	coderepo "mandacode.com/accounts/user/internal/repository/code"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	maileventrepo "mandacode.com/accounts/user/internal/repository/mailevent"
+	tokenrepo "mandacode.com/accounts/user/internal/repository/token"
+	usereventrepo "mandacode.com/accounts/user/internal/repository/userevent"
+	"mandacode.com/accounts/user/internal/usecase/signup"
+	"mandacode.com/accounts/user/internal/util"
+)
+
+const guardianEmail = "guardian@example.com"
+
+// stubTokenClient stands in for the token service, issuing opaque tokens which carry the claims they were
+// generated with.
+type stubTokenClient struct {
+	tokenv1.TokenServiceClient
+	tokens map[string]*tokenv1.GenerateEmailVerificationTokenRequest
+	last   string
+}
+
+func (s *stubTokenClient) GenerateEmailVerificationToken(ctx context.Context, in *tokenv1.GenerateEmailVerificationTokenRequest, opts ...grpc.CallOption) (*tokenv1.GenerateEmailVerificationTokenResponse, error) {
+	s.last = uuid.NewString()
+	s.tokens[s.last] = in
+	return &tokenv1.GenerateEmailVerificationTokenResponse{
+		Token:     s.last,
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+	}, nil
+}
+
+func (s *stubTokenClient) VerifyEmailVerificationToken(ctx context.Context, in *tokenv1.VerifyEmailVerificationTokenRequest, opts ...grpc.CallOption) (*tokenv1.VerifyEmailVerificationTokenResponse, error) {
+	claims, ok := s.tokens[in.Token]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+	return &tokenv1.VerifyEmailVerificationTokenResponse{
+		Valid:  true,
+		UserId: &claims.UserId,
+		Email:  &claims.Email,
+		Code:   &claims.Code,
+	}, nil
+}
+
+type MockGuardianConsentUsecase struct {
+	userRepo    *dbrepo.UserRepository
+	tokens      *stubTokenClient
+	verifyEmail *signup.VerifyEmailUsecase
+	guardian    *signup.GuardianConsentUsecase
+}
+
+func (m *MockGuardianConsentUsecase) Setup(t *testing.T) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	store := miniredis.RunT(t)
+	codeStore := redis.NewClient(&redis.Options{Addr: store.Addr()})
+	t.Cleanup(func() { codeStore.Close() })
+
+	m.userRepo = dbrepo.NewUserRepository(client, util.NewRandomStringGenerator(16))
+	m.tokens = &stubTokenClient{tokens: make(map[string]*tokenv1.GenerateEmailVerificationTokenRequest)}
+	outboxRepo := dbrepo.NewOutboxRepository(client)
+	codeGen := util.NewRandomStringGenerator(32)
+	m.verifyEmail = signup.NewVerifyEmailUsecase(
+		dbrepo.NewSentEmailRepository(client),
+		nil,
+		tokenrepo.NewTokenRepository(m.tokens),
+		maileventrepo.NewMailEventEmitter(outboxRepo, "mail"),
+		coderepo.NewCodeManager(codeGen, time.Minute, codeStore, "email_verification:"),
+		"https://accounts.example.com/verify",
+		coderepo.NewCodeManager(codeGen, time.Minute, codeStore, "email_change:"),
+		"https://accounts.example.com/email-change",
+		coderepo.NewCodeManager(codeGen, time.Minute, codeStore, "guardian_consent:"),
+		"https://accounts.example.com/guardian-consent",
+		5,
+		time.Hour,
+	)
+	m.guardian = signup.NewGuardianConsentUsecase(
+		m.userRepo,
+		m.verifyEmail,
+		dbrepo.NewTxManager(client),
+		usereventrepo.NewUserEventEmitter(outboxRepo, "user"),
+		auditeventrepo.NewAuditEventEmitter(outboxRepo, "audit"),
+	)
+}
+
+// createMinor creates a user under 14 whose email is known, as after the auth account was created.
+func (m *MockGuardianConsentUsecase) createMinor(t *testing.T) uuid.UUID {
+	t.Helper()
+	ctx := context.Background()
+	email := guardianEmail
+	minor, err := m.userRepo.CreateUser(ctx, uuid.New(), &usermodels.Age{
+		BirthDate:     time.Now().AddDate(-10, 0, 0),
+		IsMinor:       true,
+		GuardianEmail: &email,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := m.userRepo.UpdateEmail(ctx, minor.ID, minor.ID.String()+"@example.com"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return minor.ID
+}
+
+// requestConsent mails the consent link to the guardian, and returns its token.
+func (m *MockGuardianConsentUsecase) requestConsent(t *testing.T, userID uuid.UUID) string {
+	t.Helper()
+	if err := m.guardian.RequestConsent(context.Background(), userID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return m.tokens.last
+}
+
+func TestGuardianConsentUsecase_RequestConsent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("RequestConsent_NotPending", func(t *testing.T) {
+		mock := &MockGuardianConsentUsecase{}
+		mock.Setup(t)
+		adult, err := mock.userRepo.CreateUser(ctx, uuid.New(), &usermodels.Age{BirthDate: time.Now().AddDate(-30, 0, 0)})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if err := mock.guardian.RequestConsent(ctx, adult.ID); !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected a conflict error, got %v", err)
+		}
+	})
+
+	t.Run("RequestConsent_EmailUnknown", func(t *testing.T) {
+		mock := &MockGuardianConsentUsecase{}
+		mock.Setup(t)
+		email := guardianEmail
+		minor, err := mock.userRepo.CreateUser(ctx, uuid.New(), &usermodels.Age{BirthDate: time.Now().AddDate(-10, 0, 0), IsMinor: true, GuardianEmail: &email})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if err := mock.guardian.RequestConsent(ctx, minor.ID); !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected a conflict error, got %v", err)
+		}
+	})
+}
+
+func TestGuardianConsentUsecase_Confirm(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Confirm_ActivatesMinor", func(t *testing.T) {
+		mock := &MockGuardianConsentUsecase{}
+		mock.Setup(t)
+		userID := mock.createMinor(t)
+		token := mock.requestConsent(t, userID)
+
+		updated, err := mock.guardian.Confirm(ctx, token)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !updated.IsActive || updated.GuardianConsent != entuser.GuardianConsentGranted || updated.GuardianConsentedAt == nil {
+			t.Errorf("expected the account to be active with the consent granted, got %+v", updated)
+		}
+		// The link is single use
+		if _, err := mock.guardian.Confirm(ctx, token); !errors.Is(err, errcode.ErrInvalidToken) {
+			t.Errorf("expected an invalid token error, got %v", err)
+		}
+	})
+
+	t.Run("Confirm_AlreadyGranted", func(t *testing.T) {
+		mock := &MockGuardianConsentUsecase{}
+		mock.Setup(t)
+		userID := mock.createMinor(t)
+		first := mock.requestConsent(t, userID)
+		second := mock.requestConsent(t, userID)
+
+		if _, err := mock.guardian.Confirm(ctx, first); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := mock.guardian.Confirm(ctx, second); !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected a conflict error, got %v", err)
+		}
+	})
+
+	t.Run("Confirm_OtherGuardian", func(t *testing.T) {
+		mock := &MockGuardianConsentUsecase{}
+		mock.Setup(t)
+		userID := mock.createMinor(t)
+		if err := mock.verifyEmail.SendGuardianConsentEmail(ctx, userID, "stranger@example.com", userID.String()+"@example.com"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if _, err := mock.guardian.Confirm(ctx, mock.tokens.last); !errors.Is(err, errcode.ErrInvalidToken) {
+			t.Errorf("expected an invalid token error, got %v", err)
+		}
+		minor, err := mock.userRepo.GetUserByID(ctx, userID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if minor.IsActive || minor.GuardianConsent != entuser.GuardianConsentPending {
+			t.Errorf("expected the account to stay pending, got %+v", minor)
+		}
+	})
+}
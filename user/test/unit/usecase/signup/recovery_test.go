@@ -0,0 +1,159 @@
+package signup_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandacode-com/golib/errors"
+	"github.com/mandacode-com/golib/errors/errcode"
+	_ "github.com/mattn/go-sqlite3"
+	"go.uber.org/zap"
+	"mandacode.com/accounts/user/ent"
+	"mandacode.com/accounts/user/ent/enttest"
+	"mandacode.com/accounts/user/ent/signupsaga"
+	sagamodels "mandacode.com/accounts/user/internal/models/saga"
+	dbrepo "mandacode.com/accounts/user/internal/repository/database"
+	usereventrepo "mandacode.com/accounts/user/internal/repository/userevent"
+	"mandacode.com/accounts/user/internal/usecase/signup"
+	"mandacode.com/accounts/user/internal/util"
+)
+
+type MockRecoveryUsecase struct {
+	client   *ent.Client
+	userRepo *dbrepo.UserRepository
+	sagaRepo *dbrepo.SignupSagaRepository
+	recovery *signup.RecoveryUsecase
+}
+
+func (m *MockRecoveryUsecase) Setup(t *testing.T) {
+	t.Helper()
+	m.client = enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { m.client.Close() })
+	m.userRepo = dbrepo.NewUserRepository(m.client, util.NewRandomStringGenerator(16))
+	m.sagaRepo = dbrepo.NewSignupSagaRepository(m.client)
+	txManager := dbrepo.NewTxManager(m.client)
+	userEventEmitter := usereventrepo.NewUserEventEmitter(dbrepo.NewOutboxRepository(m.client), "user")
+	signupUsecase := signup.NewSignupUsecase(nil, nil, m.userRepo, m.sagaRepo, txManager, userEventEmitter, nil, time.Minute, zap.NewNop())
+	m.recovery = signup.NewRecoveryUsecase(signupUsecase, m.sagaRepo, time.Hour, 10, zap.NewNop())
+}
+
+// startSignup creates a user and a signup which reached step, leased until leasedUntil.
+func (m *MockRecoveryUsecase) startSignup(t *testing.T, step signupsaga.Step, leasedUntil time.Time) *sagamodels.SignupSaga {
+	t.Helper()
+	ctx := context.Background()
+	dbUser, err := m.userRepo.CreateUser(ctx, uuid.New(), nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	saga, err := m.sagaRepo.CreateSignupSaga(ctx, dbUser.ID, signupsaga.KindLocal, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if step != signupsaga.StepUserCreated {
+		email := "user@example.com"
+		if err := m.sagaRepo.CompleteStep(ctx, saga, step, &email, leasedUntil); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	} else if err := m.client.SignupSaga.UpdateOneID(saga.ID).SetLeasedUntil(leasedUntil).Exec(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return saga
+}
+
+func (m *MockRecoveryUsecase) status(t *testing.T, id uuid.UUID) signupsaga.Status {
+	t.Helper()
+	saga, err := m.client.SignupSaga.Get(context.Background(), id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return saga.Status
+}
+
+func TestRecoveryUsecase_RecoverStaleSignups(t *testing.T) {
+	ctx := context.Background()
+	expired := time.Now().Add(-time.Second)
+
+	t.Run("RecoverStaleSignups_Completes", func(t *testing.T) {
+		mock := &MockRecoveryUsecase{}
+		mock.Setup(t)
+		saga := mock.startSignup(t, signupsaga.StepProfileCreated, expired)
+
+		result, err := mock.recovery.RecoverStaleSignups(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Completed != 1 {
+			t.Errorf("expected the signup to be completed, got %+v", result)
+		}
+		if status := mock.status(t, saga.ID); status != signupsaga.StatusCompleted {
+			t.Errorf("expected status completed, got %s", status)
+		}
+	})
+
+	t.Run("RecoverStaleSignups_Compensates", func(t *testing.T) {
+		mock := &MockRecoveryUsecase{}
+		mock.Setup(t)
+		saga := mock.startSignup(t, signupsaga.StepUserCreated, expired)
+
+		result, err := mock.recovery.RecoverStaleSignups(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Compensated != 1 {
+			t.Errorf("expected the signup to be compensated, got %+v", result)
+		}
+		if status := mock.status(t, saga.ID); status != signupsaga.StatusCompensated {
+			t.Errorf("expected status compensated, got %s", status)
+		}
+		if _, err := mock.userRepo.GetUserByID(ctx, saga.UserID); !errors.Is(err, errcode.ErrNotFound) {
+			t.Errorf("expected the user to be deleted, got %v", err)
+		}
+		if count := mock.client.OutboxMessage.Query().CountX(ctx); count != 1 {
+			t.Errorf("expected the deletion to be announced, got %d messages", count)
+		}
+	})
+
+	t.Run("RecoverStaleSignups_SkipsLeased", func(t *testing.T) {
+		mock := &MockRecoveryUsecase{}
+		mock.Setup(t)
+		saga := mock.startSignup(t, signupsaga.StepUserCreated, time.Now().Add(time.Minute))
+
+		result, err := mock.recovery.RecoverStaleSignups(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Completed != 0 || result.Compensated != 0 || result.Failed != 0 {
+			t.Errorf("expected a leased signup to be left to its runner, got %+v", result)
+		}
+		if status := mock.status(t, saga.ID); status != signupsaga.StatusRunning {
+			t.Errorf("expected status running, got %s", status)
+		}
+	})
+
+	t.Run("RecoverStaleSignups_FencesOriginalRunner", func(t *testing.T) {
+		mock := &MockRecoveryUsecase{}
+		mock.Setup(t)
+		saga := mock.startSignup(t, signupsaga.StepAuthCreated, expired)
+
+		claimed, err := mock.sagaRepo.ClaimSignupSaga(ctx, saga.ID, time.Now().Add(time.Minute))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := mock.sagaRepo.ClaimSignupSaga(ctx, saga.ID, time.Now().Add(time.Minute)); !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected a leased signup not to be claimed twice, got %v", err)
+		}
+
+		err = mock.sagaRepo.CompleteStep(ctx, saga, signupsaga.StepProfileCreated, nil, time.Now().Add(time.Minute))
+		if !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected the original runner to lose the lease, got %v", err)
+		}
+		if err := mock.sagaRepo.FinishSignupSaga(ctx, saga, signupsaga.StatusCompensated); !errors.Is(err, errcode.ErrConflict) {
+			t.Errorf("expected the original runner not to compensate, got %v", err)
+		}
+		if err := mock.sagaRepo.FinishSignupSaga(ctx, claimed, signupsaga.StatusCompleted); err != nil {
+			t.Errorf("expected the new runner to finish the signup, got %v", err)
+		}
+	})
+}